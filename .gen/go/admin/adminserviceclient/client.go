@@ -36,12 +36,36 @@ import (
 
 // Interface is a client for the AdminService service.
 type Interface interface {
+	AddSearchAttribute(
+		ctx context.Context,
+		Request *admin.AddSearchAttributeRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	AddWorkflowExecutionAnnotation(
+		ctx context.Context,
+		Request *admin.AddWorkflowExecutionAnnotationRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShardDistribution(
+		ctx context.Context,
+		Request *admin.DescribeShardDistributionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeShardDistributionResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -53,6 +77,66 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	MergeReplicationDLQ(
+		ctx context.Context,
+		Request *shared.MergeReplicationDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.MergeReplicationDLQResponse, error)
+
+	MergeTransferDLQ(
+		ctx context.Context,
+		Request *shared.MergeTransferDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.MergeTransferDLQResponse, error)
+
+	PurgeReplicationDLQ(
+		ctx context.Context,
+		Request *shared.PurgeReplicationDLQRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PurgeTransferDLQ(
+		ctx context.Context,
+		Request *shared.PurgeTransferDLQRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ReadReplicationDLQ(
+		ctx context.Context,
+		Request *shared.ReadReplicationDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReadReplicationDLQResponse, error)
+
+	ReadTransferDLQ(
+		ctx context.Context,
+		Request *shared.ReadTransferDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReadTransferDLQResponse, error)
+
+	RemoveTask(
+		ctx context.Context,
+		Request *shared.RemoveTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ResetWorkflowExecution(
+		ctx context.Context,
+		Request *shared.ResetWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ResetWorkflowExecutionResponse, error)
+
+	RollbackDynamicConfig(
+		ctx context.Context,
+		Request *admin.RollbackDynamicConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.RollbackDynamicConfigResponse, error)
+
+	UpdateDynamicConfig(
+		ctx context.Context,
+		Request *admin.UpdateDynamicConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.UpdateDynamicConfigResponse, error)
 }
 
 // New builds a new client for the AdminService service.
@@ -79,6 +163,75 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) AddSearchAttribute(
+	ctx context.Context,
+	_Request *admin.AddSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_AddSearchAttribute_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_AddSearchAttribute_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_AddSearchAttribute_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) AddWorkflowExecutionAnnotation(
+	ctx context.Context,
+	_Request *admin.AddWorkflowExecutionAnnotationRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_AddWorkflowExecutionAnnotation_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_AddWorkflowExecutionAnnotation_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_AddWorkflowExecutionAnnotation_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) CloseShard(
+	ctx context.Context,
+	_Request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_CloseShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_CloseShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_CloseShard_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -102,6 +255,29 @@ func (c client) DescribeHistoryHost(
 	return
 }
 
+func (c client) DescribeShardDistribution(
+	ctx context.Context,
+	_Request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeShardDistributionResponse, err error) {
+
+	args := admin.AdminService_DescribeShardDistribution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeShardDistribution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeShardDistribution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -147,3 +323,233 @@ func (c client) GetWorkflowExecutionRawHistory(
 	success, err = admin.AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) MergeReplicationDLQ(
+	ctx context.Context,
+	_Request *shared.MergeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MergeReplicationDLQResponse, err error) {
+
+	args := admin.AdminService_MergeReplicationDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MergeReplicationDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MergeReplicationDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) MergeTransferDLQ(
+	ctx context.Context,
+	_Request *shared.MergeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MergeTransferDLQResponse, err error) {
+
+	args := admin.AdminService_MergeTransferDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MergeTransferDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MergeTransferDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeReplicationDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PurgeReplicationDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PurgeReplicationDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PurgeReplicationDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeTransferDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PurgeTransferDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PurgeTransferDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PurgeTransferDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReadReplicationDLQ(
+	ctx context.Context,
+	_Request *shared.ReadReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadReplicationDLQResponse, err error) {
+
+	args := admin.AdminService_ReadReplicationDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReadReplicationDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ReadReplicationDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReadTransferDLQ(
+	ctx context.Context,
+	_Request *shared.ReadTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadTransferDLQResponse, err error) {
+
+	args := admin.AdminService_ReadTransferDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReadTransferDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ReadTransferDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RemoveTask(
+	ctx context.Context,
+	_Request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RemoveTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RemoveTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RemoveTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ResetWorkflowExecution(
+	ctx context.Context,
+	_Request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ResetWorkflowExecutionResponse, err error) {
+
+	args := admin.AdminService_ResetWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResetWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ResetWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RollbackDynamicConfig(
+	ctx context.Context,
+	_Request *admin.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RollbackDynamicConfigResponse, err error) {
+
+	args := admin.AdminService_RollbackDynamicConfig_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RollbackDynamicConfig_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_RollbackDynamicConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateDynamicConfig(
+	ctx context.Context,
+	_Request *admin.UpdateDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateDynamicConfigResponse, err error) {
+
+	args := admin.AdminService_UpdateDynamicConfig_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateDynamicConfig_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_UpdateDynamicConfig_Helper.UnwrapResponse(&result)
+	return
+}
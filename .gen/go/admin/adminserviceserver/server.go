@@ -34,11 +34,31 @@ import (
 
 // Interface is the server-side interface for the AdminService service.
 type Interface interface {
+	AddSearchAttribute(
+		ctx context.Context,
+		Request *admin.AddSearchAttributeRequest,
+	) error
+
+	AddWorkflowExecutionAnnotation(
+		ctx context.Context,
+		Request *admin.AddWorkflowExecutionAnnotationRequest,
+	) error
+
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShardDistribution(
+		ctx context.Context,
+		Request *admin.DescribeShardDistributionRequest,
+	) (*admin.DescribeShardDistributionResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -48,6 +68,56 @@ type Interface interface {
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	MergeReplicationDLQ(
+		ctx context.Context,
+		Request *shared.MergeReplicationDLQRequest,
+	) (*shared.MergeReplicationDLQResponse, error)
+
+	MergeTransferDLQ(
+		ctx context.Context,
+		Request *shared.MergeTransferDLQRequest,
+	) (*shared.MergeTransferDLQResponse, error)
+
+	PurgeReplicationDLQ(
+		ctx context.Context,
+		Request *shared.PurgeReplicationDLQRequest,
+	) error
+
+	PurgeTransferDLQ(
+		ctx context.Context,
+		Request *shared.PurgeTransferDLQRequest,
+	) error
+
+	ReadReplicationDLQ(
+		ctx context.Context,
+		Request *shared.ReadReplicationDLQRequest,
+	) (*shared.ReadReplicationDLQResponse, error)
+
+	ReadTransferDLQ(
+		ctx context.Context,
+		Request *shared.ReadTransferDLQRequest,
+	) (*shared.ReadTransferDLQResponse, error)
+
+	RemoveTask(
+		ctx context.Context,
+		Request *shared.RemoveTaskRequest,
+	) error
+
+	ResetWorkflowExecution(
+		ctx context.Context,
+		Request *shared.ResetWorkflowExecutionRequest,
+	) (*shared.ResetWorkflowExecutionResponse, error)
+
+	RollbackDynamicConfig(
+		ctx context.Context,
+		Request *admin.RollbackDynamicConfigRequest,
+	) (*admin.RollbackDynamicConfigResponse, error)
+
+	UpdateDynamicConfig(
+		ctx context.Context,
+		Request *admin.UpdateDynamicConfigRequest,
+	) (*admin.UpdateDynamicConfigResponse, error)
 }
 
 // New prepares an implementation of the AdminService service for
@@ -61,6 +131,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "AdminService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "AddSearchAttribute",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.AddSearchAttribute),
+				},
+				Signature:    "AddSearchAttribute(Request *admin.AddSearchAttributeRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "AddWorkflowExecutionAnnotation",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.AddWorkflowExecutionAnnotation),
+				},
+				Signature:    "AddWorkflowExecutionAnnotation(Request *admin.AddWorkflowExecutionAnnotationRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "CloseShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CloseShard),
+				},
+				Signature:    "CloseShard(Request *shared.CloseShardRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -72,6 +175,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeShardDistribution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeShardDistribution),
+				},
+				Signature:    "DescribeShardDistribution(Request *admin.DescribeShardDistributionRequest) (*admin.DescribeShardDistributionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -93,16 +207,183 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "GetWorkflowExecutionRawHistory(GetRequest *admin.GetWorkflowExecutionRawHistoryRequest) (*admin.GetWorkflowExecutionRawHistoryResponse)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "MergeReplicationDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MergeReplicationDLQ),
+				},
+				Signature:    "MergeReplicationDLQ(Request *shared.MergeReplicationDLQRequest) (*shared.MergeReplicationDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "MergeTransferDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MergeTransferDLQ),
+				},
+				Signature:    "MergeTransferDLQ(Request *shared.MergeTransferDLQRequest) (*shared.MergeTransferDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PurgeReplicationDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeReplicationDLQ),
+				},
+				Signature:    "PurgeReplicationDLQ(Request *shared.PurgeReplicationDLQRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PurgeTransferDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeTransferDLQ),
+				},
+				Signature:    "PurgeTransferDLQ(Request *shared.PurgeTransferDLQRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReadReplicationDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadReplicationDLQ),
+				},
+				Signature:    "ReadReplicationDLQ(Request *shared.ReadReplicationDLQRequest) (*shared.ReadReplicationDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReadTransferDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadTransferDLQ),
+				},
+				Signature:    "ReadTransferDLQ(Request *shared.ReadTransferDLQRequest) (*shared.ReadTransferDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RemoveTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RemoveTask),
+				},
+				Signature:    "RemoveTask(Request *shared.RemoveTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ResetWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResetWorkflowExecution),
+				},
+				Signature:    "ResetWorkflowExecution(Request *shared.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RollbackDynamicConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RollbackDynamicConfig),
+				},
+				Signature:    "RollbackDynamicConfig(Request *admin.RollbackDynamicConfigRequest) (*admin.RollbackDynamicConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateDynamicConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateDynamicConfig),
+				},
+				Signature:    "UpdateDynamicConfig(Request *admin.UpdateDynamicConfigRequest) (*admin.UpdateDynamicConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 3)
+	procedures := make([]transport.Procedure, 0, 18)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_AddSearchAttribute_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.AddSearchAttribute(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) AddWorkflowExecutionAnnotation(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_AddWorkflowExecutionAnnotation_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.AddWorkflowExecutionAnnotation(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_AddWorkflowExecutionAnnotation_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_CloseShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.CloseShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_CloseShard_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -122,6 +403,25 @@ func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
+func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeShardDistribution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeShardDistribution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -159,3 +459,193 @@ func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.V
 	}
 	return response, err
 }
+
+func (h handler) MergeReplicationDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MergeReplicationDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MergeReplicationDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_MergeReplicationDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) MergeTransferDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MergeTransferDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MergeTransferDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_MergeTransferDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PurgeReplicationDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeReplicationDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PurgeReplicationDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PurgeReplicationDLQ_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PurgeTransferDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeTransferDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PurgeTransferDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PurgeTransferDLQ_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReadReplicationDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadReplicationDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ReadReplicationDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ReadReplicationDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReadTransferDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadTransferDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ReadTransferDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ReadTransferDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RemoveTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RemoveTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ResetWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ResetWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResetWorkflowExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RollbackDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RollbackDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.RollbackDynamicConfig(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RollbackDynamicConfig_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.UpdateDynamicConfig(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UpdateDynamicConfig_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
@@ -263,6 +263,8 @@ func init() {
 			return true
 		case *shared.ClientVersionNotSupportedError:
 			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
@@ -309,6 +311,11 @@ func init() {
 				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_PollForActivityTask_Result.ClientVersionNotSupportedError")
 			}
 			return &WorkflowService_PollForActivityTask_Result{ClientVersionNotSupportedError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_PollForActivityTask_Result.AccessDeniedError")
+			}
+			return &WorkflowService_PollForActivityTask_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
@@ -342,6 +349,10 @@ func init() {
 			err = result.ClientVersionNotSupportedError
 			return
 		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -369,6 +380,7 @@ type WorkflowService_PollForActivityTask_Result struct {
 	EntityNotExistError            *shared.EntityNotExistsError           `json:"entityNotExistError,omitempty"`
 	DomainNotActiveError           *shared.DomainNotActiveError           `json:"domainNotActiveError,omitempty"`
 	ClientVersionNotSupportedError *shared.ClientVersionNotSupportedError `json:"clientVersionNotSupportedError,omitempty"`
+	AccessDeniedError              *shared.AccessDeniedError              `json:"accessDeniedError,omitempty"`
 }
 
 // ToWire translates a WorkflowService_PollForActivityTask_Result struct into a Thrift-level intermediate
@@ -388,7 +400,7 @@ type WorkflowService_PollForActivityTask_Result struct {
 //   }
 func (v *WorkflowService_PollForActivityTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -458,6 +470,14 @@ func (v *WorkflowService_PollForActivityTask_Result) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 7, Value: w}
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
 
 	if i != 1 {
 		return wire.Value{}, fmt.Errorf("WorkflowService_PollForActivityTask_Result should have exactly one field: got %v fields", i)
@@ -472,6 +492,12 @@ func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTas
 	return &v, err
 }
 
+func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
+	var v shared.AccessDeniedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a WorkflowService_PollForActivityTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -557,6 +583,14 @@ func (v *WorkflowService_PollForActivityTask_Result) FromWire(w wire.Value) erro
 					return err
 				}
 
+			}
+		case 8:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -586,6 +620,9 @@ func (v *WorkflowService_PollForActivityTask_Result) FromWire(w wire.Value) erro
 	if v.ClientVersionNotSupportedError != nil {
 		count++
 	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("WorkflowService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
 	}
@@ -600,7 +637,7 @@ func (v *WorkflowService_PollForActivityTask_Result) String() string {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [9]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -634,6 +671,10 @@ func (v *WorkflowService_PollForActivityTask_Result) String() string {
 		fields[i] = fmt.Sprintf("ClientVersionNotSupportedError: %v", v.ClientVersionNotSupportedError)
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowService_PollForActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
@@ -672,6 +713,9 @@ func (v *WorkflowService_PollForActivityTask_Result) Equals(rhs *WorkflowService
 	if !((v.ClientVersionNotSupportedError == nil && rhs.ClientVersionNotSupportedError == nil) || (v.ClientVersionNotSupportedError != nil && rhs.ClientVersionNotSupportedError != nil && v.ClientVersionNotSupportedError.Equals(rhs.ClientVersionNotSupportedError))) {
 		return false
 	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
@@ -706,6 +750,9 @@ func (v *WorkflowService_PollForActivityTask_Result) MarshalLogObject(enc zapcor
 	if v.ClientVersionNotSupportedError != nil {
 		err = multierr.Append(err, enc.AddObject("clientVersionNotSupportedError", v.ClientVersionNotSupportedError))
 	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
 	return err
 }
 
@@ -829,6 +876,21 @@ func (v *WorkflowService_PollForActivityTask_Result) IsSetClientVersionNotSuppor
 	return v != nil && v.ClientVersionNotSupportedError != nil
 }
 
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *WorkflowService_PollForActivityTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *WorkflowService_PollForActivityTask_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -36,6 +36,18 @@ import (
 
 // Interface is a client for the HistoryService service.
 type Interface interface {
+	AddWorkflowExecutionAnnotation(
+		ctx context.Context,
+		AnnotationRequest *history.AddWorkflowExecutionAnnotationRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -60,6 +72,48 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	MergeReplicationDLQ(
+		ctx context.Context,
+		Request *shared.MergeReplicationDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.MergeReplicationDLQResponse, error)
+
+	MergeTransferDLQ(
+		ctx context.Context,
+		Request *shared.MergeTransferDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.MergeTransferDLQResponse, error)
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PurgeReplicationDLQ(
+		ctx context.Context,
+		Request *shared.PurgeReplicationDLQRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PurgeTransferDLQ(
+		ctx context.Context,
+		Request *shared.PurgeTransferDLQRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ReadReplicationDLQ(
+		ctx context.Context,
+		Request *shared.ReadReplicationDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReadReplicationDLQResponse, error)
+
+	ReadTransferDLQ(
+		ctx context.Context,
+		Request *shared.ReadTransferDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ReadTransferDLQResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -90,6 +144,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RemoveTask(
+		ctx context.Context,
+		Request *shared.RemoveTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ReplicateEvents(
 		ctx context.Context,
 		ReplicateRequest *history.ReplicateEventsRequest,
@@ -150,6 +210,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *history.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -217,6 +283,52 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) AddWorkflowExecutionAnnotation(
+	ctx context.Context,
+	_AnnotationRequest *history.AddWorkflowExecutionAnnotationRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_AddWorkflowExecutionAnnotation_Helper.Args(_AnnotationRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_AddWorkflowExecutionAnnotation_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_AddWorkflowExecutionAnnotation_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) CloseShard(
+	ctx context.Context,
+	_Request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_CloseShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_CloseShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_CloseShard_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -309,6 +421,167 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) MergeReplicationDLQ(
+	ctx context.Context,
+	_Request *shared.MergeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MergeReplicationDLQResponse, err error) {
+
+	args := history.HistoryService_MergeReplicationDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_MergeReplicationDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_MergeReplicationDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) MergeTransferDLQ(
+	ctx context.Context,
+	_Request *shared.MergeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.MergeTransferDLQResponse, err error) {
+
+	args := history.HistoryService_MergeTransferDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_MergeTransferDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_MergeTransferDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeReplicationDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PurgeReplicationDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PurgeReplicationDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PurgeReplicationDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeTransferDLQ(
+	ctx context.Context,
+	_Request *shared.PurgeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PurgeTransferDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PurgeTransferDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PurgeTransferDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReadReplicationDLQ(
+	ctx context.Context,
+	_Request *shared.ReadReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadReplicationDLQResponse, err error) {
+
+	args := history.HistoryService_ReadReplicationDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ReadReplicationDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ReadReplicationDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReadTransferDLQ(
+	ctx context.Context,
+	_Request *shared.ReadTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ReadTransferDLQResponse, err error) {
+
+	args := history.HistoryService_ReadTransferDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ReadTransferDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ReadTransferDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -424,6 +697,29 @@ func (c client) RemoveSignalMutableState(
 	return
 }
 
+func (c client) RemoveTask(
+	ctx context.Context,
+	_Request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RemoveTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RemoveTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RemoveTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReplicateEvents(
 	ctx context.Context,
 	_ReplicateRequest *history.ReplicateEventsRequest,
@@ -654,6 +950,29 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ResumeWorkflowExecution_Helper.Args(_ResumeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResumeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
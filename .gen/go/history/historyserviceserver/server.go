@@ -34,6 +34,16 @@ import (
 
 // Interface is the server-side interface for the HistoryService service.
 type Interface interface {
+	AddWorkflowExecutionAnnotation(
+		ctx context.Context,
+		AnnotationRequest *history.AddWorkflowExecutionAnnotationRequest,
+	) error
+
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -54,6 +64,41 @@ type Interface interface {
 		GetRequest *history.GetMutableStateRequest,
 	) (*history.GetMutableStateResponse, error)
 
+	MergeReplicationDLQ(
+		ctx context.Context,
+		Request *shared.MergeReplicationDLQRequest,
+	) (*shared.MergeReplicationDLQResponse, error)
+
+	MergeTransferDLQ(
+		ctx context.Context,
+		Request *shared.MergeTransferDLQRequest,
+	) (*shared.MergeTransferDLQResponse, error)
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+	) error
+
+	PurgeReplicationDLQ(
+		ctx context.Context,
+		Request *shared.PurgeReplicationDLQRequest,
+	) error
+
+	PurgeTransferDLQ(
+		ctx context.Context,
+		Request *shared.PurgeTransferDLQRequest,
+	) error
+
+	ReadReplicationDLQ(
+		ctx context.Context,
+		Request *shared.ReadReplicationDLQRequest,
+	) (*shared.ReadReplicationDLQResponse, error)
+
+	ReadTransferDLQ(
+		ctx context.Context,
+		Request *shared.ReadTransferDLQRequest,
+	) (*shared.ReadTransferDLQResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -79,6 +124,11 @@ type Interface interface {
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
 	) error
 
+	RemoveTask(
+		ctx context.Context,
+		Request *shared.RemoveTaskRequest,
+	) error
+
 	ReplicateEvents(
 		ctx context.Context,
 		ReplicateRequest *history.ReplicateEventsRequest,
@@ -129,6 +179,11 @@ type Interface interface {
 		FailedRequest *history.RespondDecisionTaskFailedRequest,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -176,6 +231,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "HistoryService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "AddWorkflowExecutionAnnotation",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.AddWorkflowExecutionAnnotation),
+				},
+				Signature:    "AddWorkflowExecutionAnnotation(AnnotationRequest *history.AddWorkflowExecutionAnnotationRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "CloseShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CloseShard),
+				},
+				Signature:    "CloseShard(Request *shared.CloseShardRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -220,6 +297,83 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MergeReplicationDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MergeReplicationDLQ),
+				},
+				Signature:    "MergeReplicationDLQ(Request *shared.MergeReplicationDLQRequest) (*shared.MergeReplicationDLQResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "MergeTransferDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MergeTransferDLQ),
+				},
+				Signature:    "MergeTransferDLQ(Request *shared.MergeTransferDLQRequest) (*shared.MergeTransferDLQResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseWorkflowExecution),
+				},
+				Signature:    "PauseWorkflowExecution(PauseRequest *history.PauseWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PurgeReplicationDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeReplicationDLQ),
+				},
+				Signature:    "PurgeReplicationDLQ(Request *shared.PurgeReplicationDLQRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PurgeTransferDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PurgeTransferDLQ),
+				},
+				Signature:    "PurgeTransferDLQ(Request *shared.PurgeTransferDLQRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReadReplicationDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadReplicationDLQ),
+				},
+				Signature:    "ReadReplicationDLQ(Request *shared.ReadReplicationDLQRequest) (*shared.ReadReplicationDLQResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReadTransferDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadTransferDLQ),
+				},
+				Signature:    "ReadTransferDLQ(Request *shared.ReadTransferDLQRequest) (*shared.ReadTransferDLQResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RecordActivityTaskHeartbeat",
 				HandlerSpec: thrift.HandlerSpec{
@@ -275,6 +429,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RemoveTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RemoveTask),
+				},
+				Signature:    "RemoveTask(Request *shared.RemoveTaskRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReplicateEvents",
 				HandlerSpec: thrift.HandlerSpec{
@@ -385,6 +550,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResumeWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeWorkflowExecution),
+				},
+				Signature:    "ResumeWorkflowExecution(ResumeRequest *history.ResumeWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ScheduleDecisionTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -464,13 +640,51 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 26)
+	procedures := make([]transport.Procedure, 0, 37)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) AddWorkflowExecutionAnnotation(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_AddWorkflowExecutionAnnotation_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.AddWorkflowExecutionAnnotation(ctx, args.AnnotationRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_AddWorkflowExecutionAnnotation_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_CloseShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.CloseShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_CloseShard_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -547,6 +761,139 @@ func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) MergeReplicationDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_MergeReplicationDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MergeReplicationDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_MergeReplicationDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) MergeTransferDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_MergeTransferDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MergeTransferDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_MergeTransferDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PauseWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PauseWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseWorkflowExecution(ctx, args.PauseRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_PauseWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PurgeReplicationDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PurgeReplicationDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PurgeReplicationDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_PurgeReplicationDLQ_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PurgeTransferDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PurgeTransferDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PurgeTransferDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_PurgeTransferDLQ_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReadReplicationDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReadReplicationDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ReadReplicationDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ReadReplicationDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReadTransferDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReadTransferDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ReadTransferDLQ(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ReadTransferDLQ_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_RecordActivityTaskHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
@@ -642,6 +989,25 @@ func (h handler) RemoveSignalMutableState(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RemoveTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RemoveTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_RemoveTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) ReplicateEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ReplicateEvents_Args
 	if err := args.FromWire(body); err != nil {
@@ -832,6 +1198,25 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) ResumeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResumeWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResumeWorkflowExecution(ctx, args.ResumeRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ResumeWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ScheduleDecisionTask_Args
 	if err := args.FromWire(body); err != nil {
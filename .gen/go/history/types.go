@@ -35,12 +35,13 @@ import (
 	strings "strings"
 )
 
-type DescribeMutableStateRequest struct {
-	DomainUUID *string                   `json:"domainUUID,omitempty"`
-	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+type AddWorkflowExecutionAnnotationRequest struct {
+	DomainUUID *string                             `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution           `json:"execution,omitempty"`
+	Annotation *shared.WorkflowExecutionAnnotation `json:"annotation,omitempty"`
 }
 
-// ToWire translates a DescribeMutableStateRequest struct into a Thrift-level intermediate
+// ToWire translates a AddWorkflowExecutionAnnotationRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -55,9 +56,9 @@ type DescribeMutableStateRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeMutableStateRequest) ToWire() (wire.Value, error) {
+func (v *AddWorkflowExecutionAnnotationRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -79,6 +80,14 @@ func (v *DescribeMutableStateRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Annotation != nil {
+		w, err = v.Annotation.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -89,11 +98,17 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 	return &v, err
 }
 
-// FromWire deserializes a DescribeMutableStateRequest struct from its Thrift-level
+func _WorkflowExecutionAnnotation_Read(w wire.Value) (*shared.WorkflowExecutionAnnotation, error) {
+	var v shared.WorkflowExecutionAnnotation
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AddWorkflowExecutionAnnotationRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeMutableStateRequest struct
+// An error is returned if we were unable to build a AddWorkflowExecutionAnnotationRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -101,12 +116,12 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeMutableStateRequest
+//   var v AddWorkflowExecutionAnnotationRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeMutableStateRequest) FromWire(w wire.Value) error {
+func (v *AddWorkflowExecutionAnnotationRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -128,6 +143,14 @@ func (v *DescribeMutableStateRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Annotation, err = _WorkflowExecutionAnnotation_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -135,14 +158,14 @@ func (v *DescribeMutableStateRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeMutableStateRequest
+// String returns a readable string representation of a AddWorkflowExecutionAnnotationRequest
 // struct.
-func (v *DescribeMutableStateRequest) String() string {
+func (v *AddWorkflowExecutionAnnotationRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -152,8 +175,12 @@ func (v *DescribeMutableStateRequest) String() string {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
+	if v.Annotation != nil {
+		fields[i] = fmt.Sprintf("Annotation: %v", v.Annotation)
+		i++
+	}
 
-	return fmt.Sprintf("DescribeMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AddWorkflowExecutionAnnotationRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
 func _String_EqualsPtr(lhs, rhs *string) bool {
@@ -166,6 +193,207 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
+// Equals returns true if all the fields of this AddWorkflowExecutionAnnotationRequest match the
+// provided AddWorkflowExecutionAnnotationRequest.
+//
+// This function performs a deep comparison.
+func (v *AddWorkflowExecutionAnnotationRequest) Equals(rhs *AddWorkflowExecutionAnnotationRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !((v.Annotation == nil && rhs.Annotation == nil) || (v.Annotation != nil && rhs.Annotation != nil && v.Annotation.Equals(rhs.Annotation))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AddWorkflowExecutionAnnotationRequest.
+func (v *AddWorkflowExecutionAnnotationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.Annotation != nil {
+		err = multierr.Append(err, enc.AddObject("annotation", v.Annotation))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *AddWorkflowExecutionAnnotationRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *AddWorkflowExecutionAnnotationRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *AddWorkflowExecutionAnnotationRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *AddWorkflowExecutionAnnotationRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetAnnotation returns the value of Annotation if it is set or its
+// zero value if it is unset.
+func (v *AddWorkflowExecutionAnnotationRequest) GetAnnotation() (o *shared.WorkflowExecutionAnnotation) {
+	if v != nil && v.Annotation != nil {
+		return v.Annotation
+	}
+
+	return
+}
+
+// IsSetAnnotation returns true if Annotation is not nil.
+func (v *AddWorkflowExecutionAnnotationRequest) IsSetAnnotation() bool {
+	return v != nil && v.Annotation != nil
+}
+
+type DescribeMutableStateRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a DescribeMutableStateRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeMutableStateRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeMutableStateRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeMutableStateRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeMutableStateRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeMutableStateRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeMutableStateRequest
+// struct.
+func (v *DescribeMutableStateRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("DescribeMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
 // Equals returns true if all the fields of this DescribeMutableStateRequest match the
 // provided DescribeMutableStateRequest.
 //
@@ -2109,6 +2337,192 @@ func (v *ParentExecutionInfo) IsSetInitiatedId() bool {
 	return v != nil && v.InitiatedId != nil
 }
 
+type PauseWorkflowExecutionRequest struct {
+	DomainUUID   *string                               `json:"domainUUID,omitempty"`
+	PauseRequest *shared.PauseWorkflowExecutionRequest `json:"pauseRequest,omitempty"`
+}
+
+// ToWire translates a PauseWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PauseWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PauseRequest != nil {
+		w, err = v.PauseRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PauseWorkflowExecutionRequest_Read(w wire.Value) (*shared.PauseWorkflowExecutionRequest, error) {
+	var v shared.PauseWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PauseWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PauseWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PauseWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PauseWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.PauseRequest, err = _PauseWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PauseWorkflowExecutionRequest
+// struct.
+func (v *PauseWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.PauseRequest != nil {
+		fields[i] = fmt.Sprintf("PauseRequest: %v", v.PauseRequest)
+		i++
+	}
+
+	return fmt.Sprintf("PauseWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PauseWorkflowExecutionRequest match the
+// provided PauseWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *PauseWorkflowExecutionRequest) Equals(rhs *PauseWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.PauseRequest == nil && rhs.PauseRequest == nil) || (v.PauseRequest != nil && rhs.PauseRequest != nil && v.PauseRequest.Equals(rhs.PauseRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PauseWorkflowExecutionRequest.
+func (v *PauseWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.PauseRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pauseRequest", v.PauseRequest))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *PauseWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *PauseWorkflowExecutionRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetPauseRequest returns the value of PauseRequest if it is set or its
+// zero value if it is unset.
+func (v *PauseWorkflowExecutionRequest) GetPauseRequest() (o *shared.PauseWorkflowExecutionRequest) {
+	if v != nil && v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+
+	return
+}
+
+// IsSetPauseRequest returns true if PauseRequest is not nil.
+func (v *PauseWorkflowExecutionRequest) IsSetPauseRequest() bool {
+	return v != nil && v.PauseRequest != nil
+}
+
 type RecordActivityTaskHeartbeatRequest struct {
 	DomainUUID       *string                                    `json:"domainUUID,omitempty"`
 	HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `json:"heartbeatRequest,omitempty"`
@@ -3371,12 +3785,15 @@ func (v *RecordChildExecutionCompletedRequest) IsSetCompletionEvent() bool {
 }
 
 type RecordDecisionTaskStartedRequest struct {
-	DomainUUID        *string                            `json:"domainUUID,omitempty"`
-	WorkflowExecution *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
-	ScheduleId        *int64                             `json:"scheduleId,omitempty"`
-	TaskId            *int64                             `json:"taskId,omitempty"`
-	RequestId         *string                            `json:"requestId,omitempty"`
-	PollRequest       *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+	DomainUUID           *string                            `json:"domainUUID,omitempty"`
+	WorkflowExecution    *shared.WorkflowExecution          `json:"workflowExecution,omitempty"`
+	ScheduleId           *int64                             `json:"scheduleId,omitempty"`
+	TaskId               *int64                             `json:"taskId,omitempty"`
+	RequestId            *string                            `json:"requestId,omitempty"`
+	PollRequest          *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+	TaskCreatedTimestamp *int64                             `json:"taskCreatedTimestamp,omitempty"`
+	PollStartedTimestamp *int64                             `json:"pollStartedTimestamp,omitempty"`
+	BacklogCountHint     *int64                             `json:"backlogCountHint,omitempty"`
 }
 
 // ToWire translates a RecordDecisionTaskStartedRequest struct into a Thrift-level intermediate
@@ -3396,7 +3813,7 @@ type RecordDecisionTaskStartedRequest struct {
 //   }
 func (v *RecordDecisionTaskStartedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3450,6 +3867,30 @@ func (v *RecordDecisionTaskStartedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.TaskCreatedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.TaskCreatedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.PollStartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.PollStartedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -3537,6 +3978,36 @@ func (v *RecordDecisionTaskStartedRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskCreatedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PollStartedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.BacklogCountHint = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -3551,7 +4022,7 @@ func (v *RecordDecisionTaskStartedRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [9]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -3577,6 +4048,18 @@ func (v *RecordDecisionTaskStartedRequest) String() string {
 		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
+	if v.TaskCreatedTimestamp != nil {
+		fields[i] = fmt.Sprintf("TaskCreatedTimestamp: %v", *(v.TaskCreatedTimestamp))
+		i++
+	}
+	if v.PollStartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("PollStartedTimestamp: %v", *(v.PollStartedTimestamp))
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
+		i++
+	}
 
 	return fmt.Sprintf("RecordDecisionTaskStartedRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -3609,6 +4092,15 @@ func (v *RecordDecisionTaskStartedRequest) Equals(rhs *RecordDecisionTaskStarted
 	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.TaskCreatedTimestamp, rhs.TaskCreatedTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PollStartedTimestamp, rhs.PollStartedTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
+		return false
+	}
 
 	return true
 }
@@ -3637,6 +4129,15 @@ func (v *RecordDecisionTaskStartedRequest) MarshalLogObject(enc zapcore.ObjectEn
 	if v.PollRequest != nil {
 		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
 	}
+	if v.TaskCreatedTimestamp != nil {
+		enc.AddInt64("taskCreatedTimestamp", *v.TaskCreatedTimestamp)
+	}
+	if v.PollStartedTimestamp != nil {
+		enc.AddInt64("pollStartedTimestamp", *v.PollStartedTimestamp)
+	}
+	if v.BacklogCountHint != nil {
+		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
+	}
 	return err
 }
 
@@ -3730,6 +4231,51 @@ func (v *RecordDecisionTaskStartedRequest) IsSetPollRequest() bool {
 	return v != nil && v.PollRequest != nil
 }
 
+// GetTaskCreatedTimestamp returns the value of TaskCreatedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetTaskCreatedTimestamp() (o int64) {
+	if v != nil && v.TaskCreatedTimestamp != nil {
+		return *v.TaskCreatedTimestamp
+	}
+
+	return
+}
+
+// IsSetTaskCreatedTimestamp returns true if TaskCreatedTimestamp is not nil.
+func (v *RecordDecisionTaskStartedRequest) IsSetTaskCreatedTimestamp() bool {
+	return v != nil && v.TaskCreatedTimestamp != nil
+}
+
+// GetPollStartedTimestamp returns the value of PollStartedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetPollStartedTimestamp() (o int64) {
+	if v != nil && v.PollStartedTimestamp != nil {
+		return *v.PollStartedTimestamp
+	}
+
+	return
+}
+
+// IsSetPollStartedTimestamp returns true if PollStartedTimestamp is not nil.
+func (v *RecordDecisionTaskStartedRequest) IsSetPollStartedTimestamp() bool {
+	return v != nil && v.PollStartedTimestamp != nil
+}
+
+// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedRequest) GetBacklogCountHint() (o int64) {
+	if v != nil && v.BacklogCountHint != nil {
+		return *v.BacklogCountHint
+	}
+
+	return
+}
+
+// IsSetBacklogCountHint returns true if BacklogCountHint is not nil.
+func (v *RecordDecisionTaskStartedRequest) IsSetBacklogCountHint() bool {
+	return v != nil && v.BacklogCountHint != nil
+}
+
 type RecordDecisionTaskStartedResponse struct {
 	WorkflowType              *shared.WorkflowType          `json:"workflowType,omitempty"`
 	PreviousStartedEventId    *int64                        `json:"previousStartedEventId,omitempty"`
@@ -3742,6 +4288,8 @@ type RecordDecisionTaskStartedResponse struct {
 	WorkflowExecutionTaskList *shared.TaskList              `json:"WorkflowExecutionTaskList,omitempty"`
 	EventStoreVersion         *int32                        `json:"eventStoreVersion,omitempty"`
 	BranchToken               []byte                        `json:"branchToken,omitempty"`
+	WorkflowStateSnapshot     *shared.WorkflowStateSnapshot `json:"workflowStateSnapshot,omitempty"`
+	ContinueAsNewSuggested    *bool                         `json:"continueAsNewSuggested,omitempty"`
 }
 
 // ToWire translates a RecordDecisionTaskStartedResponse struct into a Thrift-level intermediate
@@ -3761,7 +4309,7 @@ type RecordDecisionTaskStartedResponse struct {
 //   }
 func (v *RecordDecisionTaskStartedResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3855,6 +4403,22 @@ func (v *RecordDecisionTaskStartedResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.WorkflowStateSnapshot != nil {
+		w, err = v.WorkflowStateSnapshot.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ContinueAsNewSuggested != nil {
+		w, err = wire.NewValueBool(*(v.ContinueAsNewSuggested)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -3865,6 +4429,12 @@ func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, e
 	return &v, err
 }
 
+func _WorkflowStateSnapshot_Read(w wire.Value) (*shared.WorkflowStateSnapshot, error) {
+	var v shared.WorkflowStateSnapshot
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a RecordDecisionTaskStartedResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -3988,6 +4558,24 @@ func (v *RecordDecisionTaskStartedResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowStateSnapshot, err = _WorkflowStateSnapshot_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ContinueAsNewSuggested = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4002,7 +4590,7 @@ func (v *RecordDecisionTaskStartedResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [13]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -4048,6 +4636,14 @@ func (v *RecordDecisionTaskStartedResponse) String() string {
 		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
 		i++
 	}
+	if v.WorkflowStateSnapshot != nil {
+		fields[i] = fmt.Sprintf("WorkflowStateSnapshot: %v", v.WorkflowStateSnapshot)
+		i++
+	}
+	if v.ContinueAsNewSuggested != nil {
+		fields[i] = fmt.Sprintf("ContinueAsNewSuggested: %v", *(v.ContinueAsNewSuggested))
+		i++
+	}
 
 	return fmt.Sprintf("RecordDecisionTaskStartedResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -4095,6 +4691,12 @@ func (v *RecordDecisionTaskStartedResponse) Equals(rhs *RecordDecisionTaskStarte
 	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
 		return false
 	}
+	if !((v.WorkflowStateSnapshot == nil && rhs.WorkflowStateSnapshot == nil) || (v.WorkflowStateSnapshot != nil && rhs.WorkflowStateSnapshot != nil && v.WorkflowStateSnapshot.Equals(rhs.WorkflowStateSnapshot))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ContinueAsNewSuggested, rhs.ContinueAsNewSuggested) {
+		return false
+	}
 
 	return true
 }
@@ -4138,6 +4740,12 @@ func (v *RecordDecisionTaskStartedResponse) MarshalLogObject(enc zapcore.ObjectE
 	if v.BranchToken != nil {
 		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
 	}
+	if v.WorkflowStateSnapshot != nil {
+		err = multierr.Append(err, enc.AddObject("workflowStateSnapshot", v.WorkflowStateSnapshot))
+	}
+	if v.ContinueAsNewSuggested != nil {
+		enc.AddBool("continueAsNewSuggested", *v.ContinueAsNewSuggested)
+	}
 	return err
 }
 
@@ -4306,6 +4914,36 @@ func (v *RecordDecisionTaskStartedResponse) IsSetBranchToken() bool {
 	return v != nil && v.BranchToken != nil
 }
 
+// GetWorkflowStateSnapshot returns the value of WorkflowStateSnapshot if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetWorkflowStateSnapshot() (o *shared.WorkflowStateSnapshot) {
+	if v != nil && v.WorkflowStateSnapshot != nil {
+		return v.WorkflowStateSnapshot
+	}
+
+	return
+}
+
+// IsSetWorkflowStateSnapshot returns true if WorkflowStateSnapshot is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetWorkflowStateSnapshot() bool {
+	return v != nil && v.WorkflowStateSnapshot != nil
+}
+
+// GetContinueAsNewSuggested returns the value of ContinueAsNewSuggested if it is set or its
+// zero value if it is unset.
+func (v *RecordDecisionTaskStartedResponse) GetContinueAsNewSuggested() (o bool) {
+	if v != nil && v.ContinueAsNewSuggested != nil {
+		return *v.ContinueAsNewSuggested
+	}
+
+	return
+}
+
+// IsSetContinueAsNewSuggested returns true if ContinueAsNewSuggested is not nil.
+func (v *RecordDecisionTaskStartedResponse) IsSetContinueAsNewSuggested() bool {
+	return v != nil && v.ContinueAsNewSuggested != nil
+}
+
 type RemoveSignalMutableStateRequest struct {
 	DomainUUID        *string                   `json:"domainUUID,omitempty"`
 	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -7438,6 +8076,192 @@ func (v *RespondDecisionTaskFailedRequest) IsSetFailedRequest() bool {
 	return v != nil && v.FailedRequest != nil
 }
 
+type ResumeWorkflowExecutionRequest struct {
+	DomainUUID    *string                                `json:"domainUUID,omitempty"`
+	ResumeRequest *shared.ResumeWorkflowExecutionRequest `json:"resumeRequest,omitempty"`
+}
+
+// ToWire translates a ResumeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResumeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ResumeRequest != nil {
+		w, err = v.ResumeRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ResumeWorkflowExecutionRequest_Read(w wire.Value) (*shared.ResumeWorkflowExecutionRequest, error) {
+	var v shared.ResumeWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ResumeWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResumeWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResumeWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResumeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.ResumeRequest, err = _ResumeWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResumeWorkflowExecutionRequest
+// struct.
+func (v *ResumeWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.ResumeRequest != nil {
+		fields[i] = fmt.Sprintf("ResumeRequest: %v", v.ResumeRequest)
+		i++
+	}
+
+	return fmt.Sprintf("ResumeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResumeWorkflowExecutionRequest match the
+// provided ResumeWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *ResumeWorkflowExecutionRequest) Equals(rhs *ResumeWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.ResumeRequest == nil && rhs.ResumeRequest == nil) || (v.ResumeRequest != nil && rhs.ResumeRequest != nil && v.ResumeRequest.Equals(rhs.ResumeRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResumeWorkflowExecutionRequest.
+func (v *ResumeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.ResumeRequest != nil {
+		err = multierr.Append(err, enc.AddObject("resumeRequest", v.ResumeRequest))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetResumeRequest returns the value of ResumeRequest if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetResumeRequest() (o *shared.ResumeWorkflowExecutionRequest) {
+	if v != nil && v.ResumeRequest != nil {
+		return v.ResumeRequest
+	}
+
+	return
+}
+
+// IsSetResumeRequest returns true if ResumeRequest is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetResumeRequest() bool {
+	return v != nil && v.ResumeRequest != nil
+}
+
 type ScheduleDecisionTaskRequest struct {
 	DomainUUID        *string                   `json:"domainUUID,omitempty"`
 	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -37,6 +37,352 @@ import (
 	strings "strings"
 )
 
+type BatchTaskAttributes struct {
+	Tasks          []byte   `json:"tasks,omitempty"`
+	TaskCount      *int32   `json:"taskCount,omitempty"`
+	ShardId        *int32   `json:"shardId,omitempty"`
+	TargetClusters []string `json:"targetClusters,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a BatchTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *BatchTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Tasks != nil {
+		w, err = wire.NewValueBinary(v.Tasks), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskCount != nil {
+		w, err = wire.NewValueI32(*(v.TaskCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ShardId != nil {
+		w, err = wire.NewValueI32(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TargetClusters != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a BatchTaskAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a BatchTaskAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v BatchTaskAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *BatchTaskAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.Tasks, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a BatchTaskAttributes
+// struct.
+func (v *BatchTaskAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Tasks != nil {
+		fields[i] = fmt.Sprintf("Tasks: %v", v.Tasks)
+		i++
+	}
+	if v.TaskCount != nil {
+		fields[i] = fmt.Sprintf("TaskCount: %v", *(v.TaskCount))
+		i++
+	}
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
+	if v.TargetClusters != nil {
+		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
+		i++
+	}
+
+	return fmt.Sprintf("BatchTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this BatchTaskAttributes match the
+// provided BatchTaskAttributes.
+//
+// This function performs a deep comparison.
+func (v *BatchTaskAttributes) Equals(rhs *BatchTaskAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Tasks == nil && rhs.Tasks == nil) || (v.Tasks != nil && rhs.Tasks != nil && bytes.Equal(v.Tasks, rhs.Tasks))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.TaskCount, rhs.TaskCount) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of BatchTaskAttributes.
+func (v *BatchTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Tasks != nil {
+		enc.AddString("tasks", base64.StdEncoding.EncodeToString(v.Tasks))
+	}
+	if v.TaskCount != nil {
+		enc.AddInt32("taskCount", *v.TaskCount)
+	}
+	if v.ShardId != nil {
+		enc.AddInt32("shardId", *v.ShardId)
+	}
+	if v.TargetClusters != nil {
+		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
+	}
+	return err
+}
+
+// GetTasks returns the value of Tasks if it is set or its
+// zero value if it is unset.
+func (v *BatchTaskAttributes) GetTasks() (o []byte) {
+	if v != nil && v.Tasks != nil {
+		return v.Tasks
+	}
+
+	return
+}
+
+// IsSetTasks returns true if Tasks is not nil.
+func (v *BatchTaskAttributes) IsSetTasks() bool {
+	return v != nil && v.Tasks != nil
+}
+
+// GetTaskCount returns the value of TaskCount if it is set or its
+// zero value if it is unset.
+func (v *BatchTaskAttributes) GetTaskCount() (o int32) {
+	if v != nil && v.TaskCount != nil {
+		return *v.TaskCount
+	}
+
+	return
+}
+
+// IsSetTaskCount returns true if TaskCount is not nil.
+func (v *BatchTaskAttributes) IsSetTaskCount() bool {
+	return v != nil && v.TaskCount != nil
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *BatchTaskAttributes) GetShardId() (o int32) {
+	if v != nil && v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// IsSetShardId returns true if ShardId is not nil.
+func (v *BatchTaskAttributes) IsSetShardId() bool {
+	return v != nil && v.ShardId != nil
+}
+
+// GetTargetClusters returns the value of TargetClusters if it is set or its
+// zero value if it is unset.
+func (v *BatchTaskAttributes) GetTargetClusters() (o []string) {
+	if v != nil && v.TargetClusters != nil {
+		return v.TargetClusters
+	}
+
+	return
+}
+
+// IsSetTargetClusters returns true if TargetClusters is not nil.
+func (v *BatchTaskAttributes) IsSetTargetClusters() bool {
+	return v != nil && v.TargetClusters != nil
+}
+
 type DomainOperation int32
 
 const (
@@ -665,41 +1011,15 @@ type HistoryTaskAttributes struct {
 	RunId                   *string                            `json:"runId,omitempty"`
 	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
 	NextEventId             *int64                             `json:"nextEventId,omitempty"`
-	Version                 *int64                             `json:"version,omitempty"`
-	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	History                 *shared.History                    `json:"history,omitempty"`
-	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
-	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
-	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
-	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
-}
-
-type _List_String_ValueList []string
-
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_String_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
+	Version                 *int64                             `json:"version,omitempty"`
+	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	History                 *shared.History                    `json:"history,omitempty"`
+	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
+	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
+	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
+	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
 }
 
-func (_List_String_ValueList) Close() {}
-
 type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
 
 func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
@@ -869,24 +1189,6 @@ func (v *HistoryTaskAttributes) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
-		return nil, nil
-	}
-
-	o := make([]string, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
 func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
 	var v shared.ReplicationInfo
 	err := v.FromWire(w)
@@ -1142,21 +1444,6 @@ func (v *HistoryTaskAttributes) String() string {
 	return fmt.Sprintf("HistoryTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
 func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
 	if len(lhs) != len(rhs) {
 		return false
@@ -1174,16 +1461,6 @@ func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationI
 	return true
 }
 
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 func _Bool_EqualsPtr(lhs, rhs *bool) bool {
 	if lhs != nil && rhs != nil {
 
@@ -1247,17 +1524,6 @@ func (v *HistoryTaskAttributes) Equals(rhs *HistoryTaskAttributes) bool {
 	return true
 }
 
-type _List_String_Zapper []string
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendString(v)
-	}
-	return err
-}
-
 type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
@@ -1518,6 +1784,7 @@ type ReplicationTask struct {
 	HistoryTaskAttributes         *HistoryTaskAttributes         `json:"historyTaskAttributes,omitempty"`
 	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
 	SyncActicvityTaskAttributes   *SyncActicvityTaskAttributes   `json:"syncActicvityTaskAttributes,omitempty"`
+	BatchTaskAttributes           *BatchTaskAttributes           `json:"batchTaskAttributes,omitempty"`
 }
 
 // ToWire translates a ReplicationTask struct into a Thrift-level intermediate
@@ -1537,7 +1804,7 @@ type ReplicationTask struct {
 //   }
 func (v *ReplicationTask) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1583,6 +1850,14 @@ func (v *ReplicationTask) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.BatchTaskAttributes != nil {
+		w, err = v.BatchTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1617,6 +1892,12 @@ func _SyncActicvityTaskAttributes_Read(w wire.Value) (*SyncActicvityTaskAttribut
 	return &v, err
 }
 
+func _BatchTaskAttributes_Read(w wire.Value) (*BatchTaskAttributes, error) {
+	var v BatchTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a ReplicationTask struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -1680,6 +1961,14 @@ func (v *ReplicationTask) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.BatchTaskAttributes, err = _BatchTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1694,7 +1983,7 @@ func (v *ReplicationTask) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.TaskType != nil {
 		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
@@ -1716,6 +2005,10 @@ func (v *ReplicationTask) String() string {
 		fields[i] = fmt.Sprintf("SyncActicvityTaskAttributes: %v", v.SyncActicvityTaskAttributes)
 		i++
 	}
+	if v.BatchTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("BatchTaskAttributes: %v", v.BatchTaskAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1755,6 +2048,9 @@ func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
 	if !((v.SyncActicvityTaskAttributes == nil && rhs.SyncActicvityTaskAttributes == nil) || (v.SyncActicvityTaskAttributes != nil && rhs.SyncActicvityTaskAttributes != nil && v.SyncActicvityTaskAttributes.Equals(rhs.SyncActicvityTaskAttributes))) {
 		return false
 	}
+	if !((v.BatchTaskAttributes == nil && rhs.BatchTaskAttributes == nil) || (v.BatchTaskAttributes != nil && rhs.BatchTaskAttributes != nil && v.BatchTaskAttributes.Equals(rhs.BatchTaskAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -1780,6 +2076,9 @@ func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error
 	if v.SyncActicvityTaskAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("syncActicvityTaskAttributes", v.SyncActicvityTaskAttributes))
 	}
+	if v.BatchTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("batchTaskAttributes", v.BatchTaskAttributes))
+	}
 	return err
 }
 
@@ -1858,6 +2157,236 @@ func (v *ReplicationTask) IsSetSyncActicvityTaskAttributes() bool {
 	return v != nil && v.SyncActicvityTaskAttributes != nil
 }
 
+// GetBatchTaskAttributes returns the value of BatchTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetBatchTaskAttributes() (o *BatchTaskAttributes) {
+	if v != nil && v.BatchTaskAttributes != nil {
+		return v.BatchTaskAttributes
+	}
+
+	return
+}
+
+// IsSetBatchTaskAttributes returns true if BatchTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetBatchTaskAttributes() bool {
+	return v != nil && v.BatchTaskAttributes != nil
+}
+
+type ReplicationTaskBatch struct {
+	Tasks []*ReplicationTask `json:"tasks,omitempty"`
+}
+
+type _List_ReplicationTask_ValueList []*ReplicationTask
+
+func (v _List_ReplicationTask_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationTask_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationTask_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationTask_ValueList) Close() {}
+
+// ToWire translates a ReplicationTaskBatch struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationTaskBatch) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Tasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.Tasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTask_Read(w wire.Value) (*ReplicationTask, error) {
+	var v ReplicationTask
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationTask_Read(l wire.ValueList) ([]*ReplicationTask, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationTask, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationTask_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ReplicationTaskBatch struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTaskBatch struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationTaskBatch
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationTaskBatch) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Tasks, err = _List_ReplicationTask_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTaskBatch
+// struct.
+func (v *ReplicationTaskBatch) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Tasks != nil {
+		fields[i] = fmt.Sprintf("Tasks: %v", v.Tasks)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTaskBatch{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationTask_Equals(lhs, rhs []*ReplicationTask) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ReplicationTaskBatch match the
+// provided ReplicationTaskBatch.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTaskBatch) Equals(rhs *ReplicationTaskBatch) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Tasks == nil && rhs.Tasks == nil) || (v.Tasks != nil && rhs.Tasks != nil && _List_ReplicationTask_Equals(v.Tasks, rhs.Tasks))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationTask_Zapper []*ReplicationTask
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationTask_Zapper.
+func (l _List_ReplicationTask_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskBatch.
+func (v *ReplicationTaskBatch) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Tasks != nil {
+		err = multierr.Append(err, enc.AddArray("tasks", (_List_ReplicationTask_Zapper)(v.Tasks)))
+	}
+	return err
+}
+
+// GetTasks returns the value of Tasks if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskBatch) GetTasks() (o []*ReplicationTask) {
+	if v != nil && v.Tasks != nil {
+		return v.Tasks
+	}
+
+	return
+}
+
+// IsSetTasks returns true if Tasks is not nil.
+func (v *ReplicationTaskBatch) IsSetTasks() bool {
+	return v != nil && v.Tasks != nil
+}
+
 type ReplicationTaskType int32
 
 const (
@@ -1865,6 +2394,7 @@ const (
 	ReplicationTaskTypeHistory         ReplicationTaskType = 1
 	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
 	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
+	ReplicationTaskTypeBatch           ReplicationTaskType = 4
 )
 
 // ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
@@ -1874,6 +2404,7 @@ func ReplicationTaskType_Values() []ReplicationTaskType {
 		ReplicationTaskTypeHistory,
 		ReplicationTaskTypeSyncShardStatus,
 		ReplicationTaskTypeSyncActivity,
+		ReplicationTaskTypeBatch,
 	}
 }
 
@@ -1896,6 +2427,9 @@ func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
 	case "SyncActivity":
 		*v = ReplicationTaskTypeSyncActivity
 		return nil
+	case "Batch":
+		*v = ReplicationTaskTypeBatch
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1922,6 +2456,8 @@ func (v ReplicationTaskType) MarshalText() ([]byte, error) {
 		return []byte("SyncShardStatus"), nil
 	case 3:
 		return []byte("SyncActivity"), nil
+	case 4:
+		return []byte("Batch"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -1941,6 +2477,8 @@ func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "SyncShardStatus")
 	case 3:
 		enc.AddString("name", "SyncActivity")
+	case 4:
+		enc.AddString("name", "Batch")
 	}
 	return nil
 }
@@ -1989,6 +2527,8 @@ func (v ReplicationTaskType) String() string {
 		return "SyncShardStatus"
 	case 3:
 		return "SyncActivity"
+	case 4:
+		return "Batch"
 	}
 	return fmt.Sprintf("ReplicationTaskType(%d)", w)
 }
@@ -2015,6 +2555,8 @@ func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"SyncShardStatus\""), nil
 	case 3:
 		return ([]byte)("\"SyncActivity\""), nil
+	case 4:
+		return ([]byte)("\"Batch\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -6286,6 +6286,144 @@ func (v *ClientVersionNotSupportedError) Error() string {
 	return v.String()
 }
 
+type CloseShardRequest struct {
+	ShardID *int32 `json:"shardID,omitempty"`
+}
+
+// ToWire translates a CloseShardRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CloseShardRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CloseShardRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CloseShardRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CloseShardRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CloseShardRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CloseShardRequest
+// struct.
+func (v *CloseShardRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+
+	return fmt.Sprintf("CloseShardRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this CloseShardRequest match the
+// provided CloseShardRequest.
+//
+// This function performs a deep comparison.
+func (v *CloseShardRequest) Equals(rhs *CloseShardRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CloseShardRequest.
+func (v *CloseShardRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *CloseShardRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *CloseShardRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
 type ClusterReplicationConfiguration struct {
 	ClusterName *string `json:"clusterName,omitempty"`
 }
@@ -7370,12 +7508,16 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) IsSetCronSchedule() b
 	return v != nil && v.CronSchedule != nil
 }
 
-type DataBlob struct {
-	EncodingType *EncodingType `json:"EncodingType,omitempty"`
-	Data         []byte        `json:"Data,omitempty"`
+type CountWorkflowExecutionsRequest struct {
+	Domain          *string                       `json:"domain,omitempty"`
+	StartTimeFilter *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
+	Closed          *bool                         `json:"closed,omitempty"`
+	ExecutionFilter *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
+	TypeFilter      *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
+	StatusFilter    *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
 }
 
-// ToWire translates a DataBlob struct into a Thrift-level intermediate
+// ToWire translates a CountWorkflowExecutionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7390,45 +7532,95 @@ type DataBlob struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DataBlob) ToWire() (wire.Value, error) {
+func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.EncodingType != nil {
-		w, err = v.EncodingType.ToWire()
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Data != nil {
-		w, err = wire.NewValueBinary(v.Data), error(nil)
+	if v.StartTimeFilter != nil {
+		w, err = v.StartTimeFilter.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Closed != nil {
+		w, err = wire.NewValueBool(*(v.Closed)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ExecutionFilter != nil {
+		w, err = v.ExecutionFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TypeFilter != nil {
+		w, err = v.TypeFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.StatusFilter != nil {
+		w, err = v.StatusFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _EncodingType_Read(w wire.Value) (EncodingType, error) {
-	var v EncodingType
+func _StartTimeFilter_Read(w wire.Value) (*StartTimeFilter, error) {
+	var v StartTimeFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionFilter_Read(w wire.Value) (*WorkflowExecutionFilter, error) {
+	var v WorkflowExecutionFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowTypeFilter_Read(w wire.Value) (*WorkflowTypeFilter, error) {
+	var v WorkflowTypeFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseStatus, error) {
+	var v WorkflowExecutionCloseStatus
 	err := v.FromWire(w)
 	return v, err
 }
 
-// FromWire deserializes a DataBlob struct from its Thrift-level
+// FromWire deserializes a CountWorkflowExecutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DataBlob struct
+// An error is returned if we were unable to build a CountWorkflowExecutionsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7436,29 +7628,65 @@ func _EncodingType_Read(w wire.Value) (EncodingType, error) {
 //     return nil, err
 //   }
 //
-//   var v DataBlob
+//   var v CountWorkflowExecutionsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DataBlob) FromWire(w wire.Value) error {
+func (v *CountWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x EncodingType
-				x, err = _EncodingType_Read(field.Value)
-				v.EncodingType = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Data, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TStruct {
+				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Closed = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowExecutionCloseStatus
+				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
+				v.StatusFilter = &x
 				if err != nil {
 					return err
 				}
@@ -7470,28 +7698,54 @@ func (v *DataBlob) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DataBlob
+// String returns a readable string representation of a CountWorkflowExecutionsRequest
 // struct.
-func (v *DataBlob) String() string {
+func (v *CountWorkflowExecutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [6]string
 	i := 0
-	if v.EncodingType != nil {
-		fields[i] = fmt.Sprintf("EncodingType: %v", *(v.EncodingType))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.Data != nil {
-		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+	if v.StartTimeFilter != nil {
+		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
+		i++
+	}
+	if v.Closed != nil {
+		fields[i] = fmt.Sprintf("Closed: %v", *(v.Closed))
+		i++
+	}
+	if v.ExecutionFilter != nil {
+		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
+		i++
+	}
+	if v.TypeFilter != nil {
+		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
+		i++
+	}
+	if v.StatusFilter != nil {
+		fields[i] = fmt.Sprintf("StatusFilter: %v", *(v.StatusFilter))
 		i++
 	}
 
-	return fmt.Sprintf("DataBlob{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("CountWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _EncodingType_EqualsPtr(lhs, rhs *EncodingType) bool {
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *WorkflowExecutionCloseStatus) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -7501,20 +7755,32 @@ func _EncodingType_EqualsPtr(lhs, rhs *EncodingType) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this DataBlob match the
-// provided DataBlob.
+// Equals returns true if all the fields of this CountWorkflowExecutionsRequest match the
+// provided CountWorkflowExecutionsRequest.
 //
 // This function performs a deep comparison.
-func (v *DataBlob) Equals(rhs *DataBlob) bool {
+func (v *CountWorkflowExecutionsRequest) Equals(rhs *CountWorkflowExecutionsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_EncodingType_EqualsPtr(v.EncodingType, rhs.EncodingType) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && bytes.Equal(v.Data, rhs.Data))) {
+	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Closed, rhs.Closed) {
+		return false
+	}
+	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
+		return false
+	}
+	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
+		return false
+	}
+	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.StatusFilter, rhs.StatusFilter) {
 		return false
 	}
 
@@ -7522,67 +7788,127 @@ func (v *DataBlob) Equals(rhs *DataBlob) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DataBlob.
-func (v *DataBlob) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of CountWorkflowExecutionsRequest.
+func (v *CountWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.EncodingType != nil {
-		err = multierr.Append(err, enc.AddObject("EncodingType", *v.EncodingType))
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.Data != nil {
-		enc.AddString("Data", base64.StdEncoding.EncodeToString(v.Data))
+	if v.StartTimeFilter != nil {
+		err = multierr.Append(err, enc.AddObject("StartTimeFilter", v.StartTimeFilter))
+	}
+	if v.Closed != nil {
+		enc.AddBool("closed", *v.Closed)
+	}
+	if v.ExecutionFilter != nil {
+		err = multierr.Append(err, enc.AddObject("executionFilter", v.ExecutionFilter))
+	}
+	if v.TypeFilter != nil {
+		err = multierr.Append(err, enc.AddObject("typeFilter", v.TypeFilter))
+	}
+	if v.StatusFilter != nil {
+		err = multierr.Append(err, enc.AddObject("statusFilter", *v.StatusFilter))
 	}
 	return err
 }
 
-// GetEncodingType returns the value of EncodingType if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DataBlob) GetEncodingType() (o EncodingType) {
-	if v != nil && v.EncodingType != nil {
-		return *v.EncodingType
+func (v *CountWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetEncodingType returns true if EncodingType is not nil.
-func (v *DataBlob) IsSetEncodingType() bool {
-	return v != nil && v.EncodingType != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetData returns the value of Data if it is set or its
+// GetStartTimeFilter returns the value of StartTimeFilter if it is set or its
 // zero value if it is unset.
-func (v *DataBlob) GetData() (o []byte) {
-	if v != nil && v.Data != nil {
-		return v.Data
+func (v *CountWorkflowExecutionsRequest) GetStartTimeFilter() (o *StartTimeFilter) {
+	if v != nil && v.StartTimeFilter != nil {
+		return v.StartTimeFilter
 	}
 
 	return
 }
 
-// IsSetData returns true if Data is not nil.
-func (v *DataBlob) IsSetData() bool {
-	return v != nil && v.Data != nil
+// IsSetStartTimeFilter returns true if StartTimeFilter is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
+	return v != nil && v.StartTimeFilter != nil
 }
 
-type Decision struct {
-	DecisionType                                             *DecisionType                                             `json:"decisionType,omitempty"`
-	ScheduleActivityTaskDecisionAttributes                   *ScheduleActivityTaskDecisionAttributes                   `json:"scheduleActivityTaskDecisionAttributes,omitempty"`
-	StartTimerDecisionAttributes                             *StartTimerDecisionAttributes                             `json:"startTimerDecisionAttributes,omitempty"`
-	CompleteWorkflowExecutionDecisionAttributes              *CompleteWorkflowExecutionDecisionAttributes              `json:"completeWorkflowExecutionDecisionAttributes,omitempty"`
-	FailWorkflowExecutionDecisionAttributes                  *FailWorkflowExecutionDecisionAttributes                  `json:"failWorkflowExecutionDecisionAttributes,omitempty"`
-	RequestCancelActivityTaskDecisionAttributes              *RequestCancelActivityTaskDecisionAttributes              `json:"requestCancelActivityTaskDecisionAttributes,omitempty"`
-	CancelTimerDecisionAttributes                            *CancelTimerDecisionAttributes                            `json:"cancelTimerDecisionAttributes,omitempty"`
-	CancelWorkflowExecutionDecisionAttributes                *CancelWorkflowExecutionDecisionAttributes                `json:"cancelWorkflowExecutionDecisionAttributes,omitempty"`
-	RequestCancelExternalWorkflowExecutionDecisionAttributes *RequestCancelExternalWorkflowExecutionDecisionAttributes `json:"requestCancelExternalWorkflowExecutionDecisionAttributes,omitempty"`
-	RecordMarkerDecisionAttributes                           *RecordMarkerDecisionAttributes                           `json:"recordMarkerDecisionAttributes,omitempty"`
-	ContinueAsNewWorkflowExecutionDecisionAttributes         *ContinueAsNewWorkflowExecutionDecisionAttributes         `json:"continueAsNewWorkflowExecutionDecisionAttributes,omitempty"`
-	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
-	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
+// GetClosed returns the value of Closed if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsRequest) GetClosed() (o bool) {
+	if v != nil && v.Closed != nil {
+		return *v.Closed
+	}
+
+	return
 }
 
-// ToWire translates a Decision struct into a Thrift-level intermediate
+// IsSetClosed returns true if Closed is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetClosed() bool {
+	return v != nil && v.Closed != nil
+}
+
+// GetExecutionFilter returns the value of ExecutionFilter if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsRequest) GetExecutionFilter() (o *WorkflowExecutionFilter) {
+	if v != nil && v.ExecutionFilter != nil {
+		return v.ExecutionFilter
+	}
+
+	return
+}
+
+// IsSetExecutionFilter returns true if ExecutionFilter is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
+	return v != nil && v.ExecutionFilter != nil
+}
+
+// GetTypeFilter returns the value of TypeFilter if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsRequest) GetTypeFilter() (o *WorkflowTypeFilter) {
+	if v != nil && v.TypeFilter != nil {
+		return v.TypeFilter
+	}
+
+	return
+}
+
+// IsSetTypeFilter returns true if TypeFilter is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetTypeFilter() bool {
+	return v != nil && v.TypeFilter != nil
+}
+
+// GetStatusFilter returns the value of StatusFilter if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsRequest) GetStatusFilter() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.StatusFilter != nil {
+		return *v.StatusFilter
+	}
+
+	return
+}
+
+// IsSetStatusFilter returns true if StatusFilter is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetStatusFilter() bool {
+	return v != nil && v.StatusFilter != nil
+}
+
+type CountWorkflowExecutionsResponse struct {
+	Count *int64 `json:"count,omitempty"`
+}
+
+// ToWire translates a CountWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7597,87 +7923,433 @@ type Decision struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Decision) ToWire() (wire.Value, error) {
+func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DecisionType != nil {
-		w, err = v.DecisionType.ToWire()
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ScheduleActivityTaskDecisionAttributes != nil {
-		w, err = v.ScheduleActivityTaskDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.StartTimerDecisionAttributes != nil {
-		w, err = v.StartTimerDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CountWorkflowExecutionsResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CountWorkflowExecutionsResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CountWorkflowExecutionsResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		fields[i] = wire.Field{ID: 25, Value: w}
-		i++
 	}
-	if v.CompleteWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.CompleteWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
+
+	return nil
+}
+
+// String returns a readable string representation of a CountWorkflowExecutionsResponse
+// struct.
+func (v *CountWorkflowExecutionsResponse) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if v.FailWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.FailWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 35, Value: w}
+
+	var fields [1]string
+	i := 0
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
 		i++
 	}
-	if v.RequestCancelActivityTaskDecisionAttributes != nil {
-		w, err = v.RequestCancelActivityTaskDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	return fmt.Sprintf("CountWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this CountWorkflowExecutionsResponse match the
+// provided CountWorkflowExecutionsResponse.
+//
+// This function performs a deep comparison.
+func (v *CountWorkflowExecutionsResponse) Equals(rhs *CountWorkflowExecutionsResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	if v.CancelTimerDecisionAttributes != nil {
-		w, err = v.CancelTimerDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
+		return false
 	}
-	if v.CancelWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.CancelWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CountWorkflowExecutionsResponse.
+func (v *CountWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	if v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.RequestCancelExternalWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
+	if v.Count != nil {
+		enc.AddInt64("count", *v.Count)
 	}
-	if v.RecordMarkerDecisionAttributes != nil {
+	return err
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsResponse) GetCount() (o int64) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *CountWorkflowExecutionsResponse) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+type DataBlob struct {
+	EncodingType *EncodingType `json:"EncodingType,omitempty"`
+	Data         []byte        `json:"Data,omitempty"`
+}
+
+// ToWire translates a DataBlob struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DataBlob) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.EncodingType != nil {
+		w, err = v.EncodingType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Data != nil {
+		w, err = wire.NewValueBinary(v.Data), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _EncodingType_Read(w wire.Value) (EncodingType, error) {
+	var v EncodingType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a DataBlob struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DataBlob struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DataBlob
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DataBlob) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x EncodingType
+				x, err = _EncodingType_Read(field.Value)
+				v.EncodingType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Data, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DataBlob
+// struct.
+func (v *DataBlob) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.EncodingType != nil {
+		fields[i] = fmt.Sprintf("EncodingType: %v", *(v.EncodingType))
+		i++
+	}
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+
+	return fmt.Sprintf("DataBlob{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _EncodingType_EqualsPtr(lhs, rhs *EncodingType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DataBlob match the
+// provided DataBlob.
+//
+// This function performs a deep comparison.
+func (v *DataBlob) Equals(rhs *DataBlob) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_EncodingType_EqualsPtr(v.EncodingType, rhs.EncodingType) {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && bytes.Equal(v.Data, rhs.Data))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DataBlob.
+func (v *DataBlob) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.EncodingType != nil {
+		err = multierr.Append(err, enc.AddObject("EncodingType", *v.EncodingType))
+	}
+	if v.Data != nil {
+		enc.AddString("Data", base64.StdEncoding.EncodeToString(v.Data))
+	}
+	return err
+}
+
+// GetEncodingType returns the value of EncodingType if it is set or its
+// zero value if it is unset.
+func (v *DataBlob) GetEncodingType() (o EncodingType) {
+	if v != nil && v.EncodingType != nil {
+		return *v.EncodingType
+	}
+
+	return
+}
+
+// IsSetEncodingType returns true if EncodingType is not nil.
+func (v *DataBlob) IsSetEncodingType() bool {
+	return v != nil && v.EncodingType != nil
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+func (v *DataBlob) GetData() (o []byte) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
+
+	return
+}
+
+// IsSetData returns true if Data is not nil.
+func (v *DataBlob) IsSetData() bool {
+	return v != nil && v.Data != nil
+}
+
+type Decision struct {
+	DecisionType                                             *DecisionType                                             `json:"decisionType,omitempty"`
+	ScheduleActivityTaskDecisionAttributes                   *ScheduleActivityTaskDecisionAttributes                   `json:"scheduleActivityTaskDecisionAttributes,omitempty"`
+	StartTimerDecisionAttributes                             *StartTimerDecisionAttributes                             `json:"startTimerDecisionAttributes,omitempty"`
+	CompleteWorkflowExecutionDecisionAttributes              *CompleteWorkflowExecutionDecisionAttributes              `json:"completeWorkflowExecutionDecisionAttributes,omitempty"`
+	FailWorkflowExecutionDecisionAttributes                  *FailWorkflowExecutionDecisionAttributes                  `json:"failWorkflowExecutionDecisionAttributes,omitempty"`
+	RequestCancelActivityTaskDecisionAttributes              *RequestCancelActivityTaskDecisionAttributes              `json:"requestCancelActivityTaskDecisionAttributes,omitempty"`
+	CancelTimerDecisionAttributes                            *CancelTimerDecisionAttributes                            `json:"cancelTimerDecisionAttributes,omitempty"`
+	CancelWorkflowExecutionDecisionAttributes                *CancelWorkflowExecutionDecisionAttributes                `json:"cancelWorkflowExecutionDecisionAttributes,omitempty"`
+	RequestCancelExternalWorkflowExecutionDecisionAttributes *RequestCancelExternalWorkflowExecutionDecisionAttributes `json:"requestCancelExternalWorkflowExecutionDecisionAttributes,omitempty"`
+	RecordMarkerDecisionAttributes                           *RecordMarkerDecisionAttributes                           `json:"recordMarkerDecisionAttributes,omitempty"`
+	ContinueAsNewWorkflowExecutionDecisionAttributes         *ContinueAsNewWorkflowExecutionDecisionAttributes         `json:"continueAsNewWorkflowExecutionDecisionAttributes,omitempty"`
+	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
+	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
+}
+
+// ToWire translates a Decision struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Decision) ToWire() (wire.Value, error) {
+	var (
+		fields [13]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DecisionType != nil {
+		w, err = v.DecisionType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ScheduleActivityTaskDecisionAttributes != nil {
+		w, err = v.ScheduleActivityTaskDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartTimerDecisionAttributes != nil {
+		w, err = v.StartTimerDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 25, Value: w}
+		i++
+	}
+	if v.CompleteWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.CompleteWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.FailWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.FailWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 35, Value: w}
+		i++
+	}
+	if v.RequestCancelActivityTaskDecisionAttributes != nil {
+		w, err = v.RequestCancelActivityTaskDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.CancelTimerDecisionAttributes != nil {
+		w, err = v.CancelTimerDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.CancelWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.CancelWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.RequestCancelExternalWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.RecordMarkerDecisionAttributes != nil {
 		w, err = v.RecordMarkerDecisionAttributes.ToWire()
 		if err != nil {
 			return w, err
@@ -8631,6 +9303,7 @@ const (
 	DecisionTaskFailedCauseFailoverCloseDecision                               DecisionTaskFailedCause = 17
 	DecisionTaskFailedCauseBadSignalInputSize                                  DecisionTaskFailedCause = 18
 	DecisionTaskFailedCauseResetWorkflow                                       DecisionTaskFailedCause = 19
+	DecisionTaskFailedCauseBadDecisions                                        DecisionTaskFailedCause = 20
 )
 
 // DecisionTaskFailedCause_Values returns all recognized values of DecisionTaskFailedCause.
@@ -8656,6 +9329,7 @@ func DecisionTaskFailedCause_Values() []DecisionTaskFailedCause {
 		DecisionTaskFailedCauseFailoverCloseDecision,
 		DecisionTaskFailedCauseBadSignalInputSize,
 		DecisionTaskFailedCauseResetWorkflow,
+		DecisionTaskFailedCauseBadDecisions,
 	}
 }
 
@@ -8726,6 +9400,9 @@ func (v *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "RESET_WORKFLOW":
 		*v = DecisionTaskFailedCauseResetWorkflow
 		return nil
+	case "BAD_DECISIONS":
+		*v = DecisionTaskFailedCauseBadDecisions
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -8784,6 +9461,8 @@ func (v DecisionTaskFailedCause) MarshalText() ([]byte, error) {
 		return []byte("BAD_SIGNAL_INPUT_SIZE"), nil
 	case 19:
 		return []byte("RESET_WORKFLOW"), nil
+	case 20:
+		return []byte("BAD_DECISIONS"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -8835,6 +9514,8 @@ func (v DecisionTaskFailedCause) MarshalLogObject(enc zapcore.ObjectEncoder) err
 		enc.AddString("name", "BAD_SIGNAL_INPUT_SIZE")
 	case 19:
 		enc.AddString("name", "RESET_WORKFLOW")
+	case 20:
+		enc.AddString("name", "BAD_DECISIONS")
 	}
 	return nil
 }
@@ -8915,6 +9596,8 @@ func (v DecisionTaskFailedCause) String() string {
 		return "BAD_SIGNAL_INPUT_SIZE"
 	case 19:
 		return "RESET_WORKFLOW"
+	case 20:
+		return "BAD_DECISIONS"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -8973,6 +9656,8 @@ func (v DecisionTaskFailedCause) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"BAD_SIGNAL_INPUT_SIZE\""), nil
 	case 19:
 		return ([]byte)("\"RESET_WORKFLOW\""), nil
+	case 20:
+		return ([]byte)("\"BAD_DECISIONS\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -11056,16 +11741,6 @@ func (v *DescribeDomainResponse) String() string {
 	return fmt.Sprintf("DescribeDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this DescribeDomainResponse match the
 // provided DescribeDomainResponse.
 //
@@ -11419,11 +12094,13 @@ func (v *DescribeHistoryHostRequest) IsSetExecutionForHost() bool {
 }
 
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        *int32           `json:"numberOfShards,omitempty"`
-	ShardIDs              []int32          `json:"shardIDs,omitempty"`
-	DomainCache           *DomainCacheInfo `json:"domainCache,omitempty"`
-	ShardControllerStatus *string          `json:"shardControllerStatus,omitempty"`
-	Address               *string          `json:"address,omitempty"`
+	NumberOfShards        *int32                `json:"numberOfShards,omitempty"`
+	ShardIDs              []int32               `json:"shardIDs,omitempty"`
+	DomainCache           *DomainCacheInfo      `json:"domainCache,omitempty"`
+	ShardControllerStatus *string               `json:"shardControllerStatus,omitempty"`
+	Address               *string               `json:"address,omitempty"`
+	Shards                []*HistoryShardStatus `json:"shards,omitempty"`
+	MembershipRing        []string              `json:"membershipRing,omitempty"`
 }
 
 type _List_I32_ValueList []int32
@@ -11452,6 +12129,35 @@ func (_List_I32_ValueList) ValueType() wire.Type {
 
 func (_List_I32_ValueList) Close() {}
 
+type _List_HistoryShardStatus_ValueList []*HistoryShardStatus
+
+func (v _List_HistoryShardStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_HistoryShardStatus_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_HistoryShardStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_HistoryShardStatus_ValueList) Close() {}
+
 // ToWire translates a DescribeHistoryHostResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -11469,7 +12175,7 @@ func (_List_I32_ValueList) Close() {}
 //   }
 func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -11515,6 +12221,22 @@ func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.Shards != nil {
+		w, err = wire.NewValueList(_List_HistoryShardStatus_ValueList(v.Shards)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.MembershipRing != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.MembershipRing)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -11543,6 +12265,30 @@ func _DomainCacheInfo_Read(w wire.Value) (*DomainCacheInfo, error) {
 	return &v, err
 }
 
+func _HistoryShardStatus_Read(w wire.Value) (*HistoryShardStatus, error) {
+	var v HistoryShardStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_HistoryShardStatus_Read(l wire.ValueList) ([]*HistoryShardStatus, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*HistoryShardStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _HistoryShardStatus_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeHistoryHostResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -11610,6 +12356,22 @@ func (v *DescribeHistoryHostResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.Shards, err = _List_HistoryShardStatus_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TList {
+				v.MembershipRing, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -11624,7 +12386,7 @@ func (v *DescribeHistoryHostResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.NumberOfShards != nil {
 		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
@@ -11646,6 +12408,14 @@ func (v *DescribeHistoryHostResponse) String() string {
 		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
 		i++
 	}
+	if v.Shards != nil {
+		fields[i] = fmt.Sprintf("Shards: %v", v.Shards)
+		i++
+	}
+	if v.MembershipRing != nil {
+		fields[i] = fmt.Sprintf("MembershipRing: %v", v.MembershipRing)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -11665,6 +12435,21 @@ func _List_I32_Equals(lhs, rhs []int32) bool {
 	return true
 }
 
+func _List_HistoryShardStatus_Equals(lhs, rhs []*HistoryShardStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeHistoryHostResponse match the
 // provided DescribeHistoryHostResponse.
 //
@@ -11690,6 +12475,12 @@ func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) b
 	if !_String_EqualsPtr(v.Address, rhs.Address) {
 		return false
 	}
+	if !((v.Shards == nil && rhs.Shards == nil) || (v.Shards != nil && rhs.Shards != nil && _List_HistoryShardStatus_Equals(v.Shards, rhs.Shards))) {
+		return false
+	}
+	if !((v.MembershipRing == nil && rhs.MembershipRing == nil) || (v.MembershipRing != nil && rhs.MembershipRing != nil && _List_String_Equals(v.MembershipRing, rhs.MembershipRing))) {
+		return false
+	}
 
 	return true
 }
@@ -11705,6 +12496,17 @@ func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error)
 	return err
 }
 
+type _List_HistoryShardStatus_Zapper []*HistoryShardStatus
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_HistoryShardStatus_Zapper.
+func (l _List_HistoryShardStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeHistoryHostResponse.
 func (v *DescribeHistoryHostResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -11726,6 +12528,12 @@ func (v *DescribeHistoryHostResponse) MarshalLogObject(enc zapcore.ObjectEncoder
 	if v.Address != nil {
 		enc.AddString("address", *v.Address)
 	}
+	if v.Shards != nil {
+		err = multierr.Append(err, enc.AddArray("shards", (_List_HistoryShardStatus_Zapper)(v.Shards)))
+	}
+	if v.MembershipRing != nil {
+		err = multierr.Append(err, enc.AddArray("membershipRing", (_List_String_Zapper)(v.MembershipRing)))
+	}
 	return err
 }
 
@@ -11804,6 +12612,36 @@ func (v *DescribeHistoryHostResponse) IsSetAddress() bool {
 	return v != nil && v.Address != nil
 }
 
+// GetShards returns the value of Shards if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetShards() (o []*HistoryShardStatus) {
+	if v != nil && v.Shards != nil {
+		return v.Shards
+	}
+
+	return
+}
+
+// IsSetShards returns true if Shards is not nil.
+func (v *DescribeHistoryHostResponse) IsSetShards() bool {
+	return v != nil && v.Shards != nil
+}
+
+// GetMembershipRing returns the value of MembershipRing if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetMembershipRing() (o []string) {
+	if v != nil && v.MembershipRing != nil {
+		return v.MembershipRing
+	}
+
+	return
+}
+
+// IsSetMembershipRing returns true if MembershipRing is not nil.
+func (v *DescribeHistoryHostResponse) IsSetMembershipRing() bool {
+	return v != nil && v.MembershipRing != nil
+}
+
 type DescribeTaskListRequest struct {
 	Domain                *string       `json:"domain,omitempty"`
 	TaskList              *TaskList     `json:"taskList,omitempty"`
@@ -12535,6 +13373,10 @@ type DescribeWorkflowExecutionResponse struct {
 	ExecutionConfiguration *WorkflowExecutionConfiguration `json:"executionConfiguration,omitempty"`
 	WorkflowExecutionInfo  *WorkflowExecutionInfo          `json:"workflowExecutionInfo,omitempty"`
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
+	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
+	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	Annotations            []*WorkflowExecutionAnnotation  `json:"annotations,omitempty"`
+	ExecutionContext       []byte                          `json:"executionContext,omitempty"`
 }
 
 type _List_PendingActivityInfo_ValueList []*PendingActivityInfo
@@ -12566,6 +13408,64 @@ func (_List_PendingActivityInfo_ValueList) ValueType() wire.Type {
 
 func (_List_PendingActivityInfo_ValueList) Close() {}
 
+type _List_PendingChildExecutionInfo_ValueList []*PendingChildExecutionInfo
+
+func (v _List_PendingChildExecutionInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_PendingChildExecutionInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_PendingChildExecutionInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PendingChildExecutionInfo_ValueList) Close() {}
+
+type _List_WorkflowExecutionAnnotation_ValueList []*WorkflowExecutionAnnotation
+
+func (v _List_WorkflowExecutionAnnotation_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_WorkflowExecutionAnnotation_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_WorkflowExecutionAnnotation_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkflowExecutionAnnotation_ValueList) Close() {}
+
 // ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -12583,7 +13483,7 @@ func (_List_PendingActivityInfo_ValueList) Close() {}
 //   }
 func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -12613,6 +13513,38 @@ func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.PendingChildren != nil {
+		w, err = wire.NewValueList(_List_PendingChildExecutionInfo_ValueList(v.PendingChildren)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.PendingDecision != nil {
+		w, err = v.PendingDecision.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Annotations != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecutionAnnotation_ValueList(v.Annotations)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ExecutionContext != nil {
+		w, err = wire.NewValueBinary(v.ExecutionContext), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -12653,6 +13585,60 @@ func _List_PendingActivityInfo_Read(l wire.ValueList) ([]*PendingActivityInfo, e
 	return o, err
 }
 
+func _PendingChildExecutionInfo_Read(w wire.Value) (*PendingChildExecutionInfo, error) {
+	var v PendingChildExecutionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PendingChildExecutionInfo_Read(l wire.ValueList) ([]*PendingChildExecutionInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*PendingChildExecutionInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PendingChildExecutionInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _PendingDecisionInfo_Read(w wire.Value) (*PendingDecisionInfo, error) {
+	var v PendingDecisionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionAnnotation_Read(w wire.Value) (*WorkflowExecutionAnnotation, error) {
+	var v WorkflowExecutionAnnotation
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_WorkflowExecutionAnnotation_Read(l wire.ValueList) ([]*WorkflowExecutionAnnotation, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*WorkflowExecutionAnnotation, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkflowExecutionAnnotation_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -12698,6 +13684,38 @@ func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.PendingChildren, err = _List_PendingChildExecutionInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.PendingDecision, err = _PendingDecisionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.Annotations, err = _List_WorkflowExecutionAnnotation_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				v.ExecutionContext, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -12712,7 +13730,7 @@ func (v *DescribeWorkflowExecutionResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [7]string
 	i := 0
 	if v.ExecutionConfiguration != nil {
 		fields[i] = fmt.Sprintf("ExecutionConfiguration: %v", v.ExecutionConfiguration)
@@ -12726,6 +13744,22 @@ func (v *DescribeWorkflowExecutionResponse) String() string {
 		fields[i] = fmt.Sprintf("PendingActivities: %v", v.PendingActivities)
 		i++
 	}
+	if v.PendingChildren != nil {
+		fields[i] = fmt.Sprintf("PendingChildren: %v", v.PendingChildren)
+		i++
+	}
+	if v.PendingDecision != nil {
+		fields[i] = fmt.Sprintf("PendingDecision: %v", v.PendingDecision)
+		i++
+	}
+	if v.Annotations != nil {
+		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
+		i++
+	}
+	if v.ExecutionContext != nil {
+		fields[i] = fmt.Sprintf("ExecutionContext: %v", v.ExecutionContext)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -12745,6 +13779,36 @@ func _List_PendingActivityInfo_Equals(lhs, rhs []*PendingActivityInfo) bool {
 	return true
 }
 
+func _List_PendingChildExecutionInfo_Equals(lhs, rhs []*PendingChildExecutionInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_WorkflowExecutionAnnotation_Equals(lhs, rhs []*WorkflowExecutionAnnotation) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
 // provided DescribeWorkflowExecutionResponse.
 //
@@ -12764,6 +13828,18 @@ func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutio
 	if !((v.PendingActivities == nil && rhs.PendingActivities == nil) || (v.PendingActivities != nil && rhs.PendingActivities != nil && _List_PendingActivityInfo_Equals(v.PendingActivities, rhs.PendingActivities))) {
 		return false
 	}
+	if !((v.PendingChildren == nil && rhs.PendingChildren == nil) || (v.PendingChildren != nil && rhs.PendingChildren != nil && _List_PendingChildExecutionInfo_Equals(v.PendingChildren, rhs.PendingChildren))) {
+		return false
+	}
+	if !((v.PendingDecision == nil && rhs.PendingDecision == nil) || (v.PendingDecision != nil && rhs.PendingDecision != nil && v.PendingDecision.Equals(rhs.PendingDecision))) {
+		return false
+	}
+	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _List_WorkflowExecutionAnnotation_Equals(v.Annotations, rhs.Annotations))) {
+		return false
+	}
+	if !((v.ExecutionContext == nil && rhs.ExecutionContext == nil) || (v.ExecutionContext != nil && rhs.ExecutionContext != nil && bytes.Equal(v.ExecutionContext, rhs.ExecutionContext))) {
+		return false
+	}
 
 	return true
 }
@@ -12779,6 +13855,28 @@ func (l _List_PendingActivityInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncod
 	return err
 }
 
+type _List_PendingChildExecutionInfo_Zapper []*PendingChildExecutionInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_PendingChildExecutionInfo_Zapper.
+func (l _List_PendingChildExecutionInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_WorkflowExecutionAnnotation_Zapper []*WorkflowExecutionAnnotation
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkflowExecutionAnnotation_Zapper.
+func (l _List_WorkflowExecutionAnnotation_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeWorkflowExecutionResponse.
 func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -12794,6 +13892,18 @@ func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectE
 	if v.PendingActivities != nil {
 		err = multierr.Append(err, enc.AddArray("pendingActivities", (_List_PendingActivityInfo_Zapper)(v.PendingActivities)))
 	}
+	if v.PendingChildren != nil {
+		err = multierr.Append(err, enc.AddArray("pendingChildren", (_List_PendingChildExecutionInfo_Zapper)(v.PendingChildren)))
+	}
+	if v.PendingDecision != nil {
+		err = multierr.Append(err, enc.AddObject("pendingDecision", v.PendingDecision))
+	}
+	if v.Annotations != nil {
+		err = multierr.Append(err, enc.AddArray("annotations", (_List_WorkflowExecutionAnnotation_Zapper)(v.Annotations)))
+	}
+	if v.ExecutionContext != nil {
+		enc.AddString("executionContext", base64.StdEncoding.EncodeToString(v.ExecutionContext))
+	}
 	return err
 }
 
@@ -12842,6 +13952,66 @@ func (v *DescribeWorkflowExecutionResponse) IsSetPendingActivities() bool {
 	return v != nil && v.PendingActivities != nil
 }
 
+// GetPendingChildren returns the value of PendingChildren if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetPendingChildren() (o []*PendingChildExecutionInfo) {
+	if v != nil && v.PendingChildren != nil {
+		return v.PendingChildren
+	}
+
+	return
+}
+
+// IsSetPendingChildren returns true if PendingChildren is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetPendingChildren() bool {
+	return v != nil && v.PendingChildren != nil
+}
+
+// GetPendingDecision returns the value of PendingDecision if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetPendingDecision() (o *PendingDecisionInfo) {
+	if v != nil && v.PendingDecision != nil {
+		return v.PendingDecision
+	}
+
+	return
+}
+
+// IsSetPendingDecision returns true if PendingDecision is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetPendingDecision() bool {
+	return v != nil && v.PendingDecision != nil
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetAnnotations() (o []*WorkflowExecutionAnnotation) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
+
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
+
+// GetExecutionContext returns the value of ExecutionContext if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetExecutionContext() (o []byte) {
+	if v != nil && v.ExecutionContext != nil {
+		return v.ExecutionContext
+	}
+
+	return
+}
+
+// IsSetExecutionContext returns true if ExecutionContext is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetExecutionContext() bool {
+	return v != nil && v.ExecutionContext != nil
+}
+
 type DomainAlreadyExistsError struct {
 	Message string `json:"message,required"`
 }
@@ -15016,6 +16186,8 @@ const (
 	EventTypeSignalExternalWorkflowExecutionInitiated        EventType = 38
 	EventTypeSignalExternalWorkflowExecutionFailed           EventType = 39
 	EventTypeExternalWorkflowExecutionSignaled               EventType = 40
+	EventTypeWorkflowExecutionPaused                         EventType = 41
+	EventTypeWorkflowExecutionResumed                        EventType = 42
 )
 
 // EventType_Values returns all recognized values of EventType.
@@ -15062,6 +16234,8 @@ func EventType_Values() []EventType {
 		EventTypeSignalExternalWorkflowExecutionInitiated,
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
+		EventTypeWorkflowExecutionPaused,
+		EventTypeWorkflowExecutionResumed,
 	}
 }
 
@@ -15195,6 +16369,12 @@ func (v *EventType) UnmarshalText(value []byte) error {
 	case "ExternalWorkflowExecutionSignaled":
 		*v = EventTypeExternalWorkflowExecutionSignaled
 		return nil
+	case "WorkflowExecutionPaused":
+		*v = EventTypeWorkflowExecutionPaused
+		return nil
+	case "WorkflowExecutionResumed":
+		*v = EventTypeWorkflowExecutionResumed
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -15295,6 +16475,10 @@ func (v EventType) MarshalText() ([]byte, error) {
 		return []byte("SignalExternalWorkflowExecutionFailed"), nil
 	case 40:
 		return []byte("ExternalWorkflowExecutionSignaled"), nil
+	case 41:
+		return []byte("WorkflowExecutionPaused"), nil
+	case 42:
+		return []byte("WorkflowExecutionResumed"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -15388,6 +16572,10 @@ func (v EventType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "SignalExternalWorkflowExecutionFailed")
 	case 40:
 		enc.AddString("name", "ExternalWorkflowExecutionSignaled")
+	case 41:
+		enc.AddString("name", "WorkflowExecutionPaused")
+	case 42:
+		enc.AddString("name", "WorkflowExecutionResumed")
 	}
 	return nil
 }
@@ -15510,6 +16698,10 @@ func (v EventType) String() string {
 		return "SignalExternalWorkflowExecutionFailed"
 	case 40:
 		return "ExternalWorkflowExecutionSignaled"
+	case 41:
+		return "WorkflowExecutionPaused"
+	case 42:
+		return "WorkflowExecutionResumed"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -15610,6 +16802,10 @@ func (v EventType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"SignalExternalWorkflowExecutionFailed\""), nil
 	case 40:
 		return ([]byte)("\"ExternalWorkflowExecutionSignaled\""), nil
+	case 41:
+		return ([]byte)("\"WorkflowExecutionPaused\""), nil
+	case 42:
+		return ([]byte)("\"WorkflowExecutionResumed\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -17939,6 +19135,8 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionInitiatedEventAttributes        *SignalExternalWorkflowExecutionInitiatedEventAttributes        `json:"signalExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
+	WorkflowExecutionPausedEventAttributes                         *WorkflowExecutionPausedEventAttributes                         `json:"workflowExecutionPausedEventAttributes,omitempty"`
+	WorkflowExecutionResumedEventAttributes                        *WorkflowExecutionResumedEventAttributes                        `json:"workflowExecutionResumedEventAttributes,omitempty"`
 }
 
 // ToWire translates a HistoryEvent struct into a Thrift-level intermediate
@@ -17958,7 +19156,7 @@ type HistoryEvent struct {
 //   }
 func (v *HistoryEvent) ToWire() (wire.Value, error) {
 	var (
-		fields [46]wire.Field
+		fields [48]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -18332,6 +19530,22 @@ func (v *HistoryEvent) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 440, Value: w}
 		i++
 	}
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		w, err = v.WorkflowExecutionPausedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 450, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionResumedEventAttributes != nil {
+		w, err = v.WorkflowExecutionResumedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 460, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -18588,6 +19802,18 @@ func _ExternalWorkflowExecutionSignaledEventAttributes_Read(w wire.Value) (*Exte
 	return &v, err
 }
 
+func _WorkflowExecutionPausedEventAttributes_Read(w wire.Value) (*WorkflowExecutionPausedEventAttributes, error) {
+	var v WorkflowExecutionPausedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionResumedEventAttributes_Read(w wire.Value) (*WorkflowExecutionResumedEventAttributes, error) {
+	var v WorkflowExecutionResumedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a HistoryEvent struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -18987,6 +20213,22 @@ func (v *HistoryEvent) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 450:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionPausedEventAttributes, err = _WorkflowExecutionPausedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 460:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionResumedEventAttributes, err = _WorkflowExecutionResumedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -19001,7 +20243,7 @@ func (v *HistoryEvent) String() string {
 		return "<nil>"
 	}
 
-	var fields [46]string
+	var fields [48]string
 	i := 0
 	if v.EventId != nil {
 		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
@@ -19187,6 +20429,14 @@ func (v *HistoryEvent) String() string {
 		fields[i] = fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes: %v", v.ExternalWorkflowExecutionSignaledEventAttributes)
 		i++
 	}
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionPausedEventAttributes: %v", v.WorkflowExecutionPausedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionResumedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionResumedEventAttributes: %v", v.WorkflowExecutionResumedEventAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
 }
@@ -19349,6 +20599,12 @@ func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
 	if !((v.ExternalWorkflowExecutionSignaledEventAttributes == nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes == nil) || (v.ExternalWorkflowExecutionSignaledEventAttributes != nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes != nil && v.ExternalWorkflowExecutionSignaledEventAttributes.Equals(rhs.ExternalWorkflowExecutionSignaledEventAttributes))) {
 		return false
 	}
+	if !((v.WorkflowExecutionPausedEventAttributes == nil && rhs.WorkflowExecutionPausedEventAttributes == nil) || (v.WorkflowExecutionPausedEventAttributes != nil && rhs.WorkflowExecutionPausedEventAttributes != nil && v.WorkflowExecutionPausedEventAttributes.Equals(rhs.WorkflowExecutionPausedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionResumedEventAttributes == nil && rhs.WorkflowExecutionResumedEventAttributes == nil) || (v.WorkflowExecutionResumedEventAttributes != nil && rhs.WorkflowExecutionResumedEventAttributes != nil && v.WorkflowExecutionResumedEventAttributes.Equals(rhs.WorkflowExecutionResumedEventAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -19497,6 +20753,12 @@ func (v *HistoryEvent) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("externalWorkflowExecutionSignaledEventAttributes", v.ExternalWorkflowExecutionSignaledEventAttributes))
 	}
+	if v.WorkflowExecutionPausedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionPausedEventAttributes", v.WorkflowExecutionPausedEventAttributes))
+	}
+	if v.WorkflowExecutionResumedEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecutionResumedEventAttributes", v.WorkflowExecutionResumedEventAttributes))
+	}
 	return err
 }
 
@@ -20190,6 +21452,36 @@ func (v *HistoryEvent) IsSetExternalWorkflowExecutionSignaledEventAttributes() b
 	return v != nil && v.ExternalWorkflowExecutionSignaledEventAttributes != nil
 }
 
+// GetWorkflowExecutionPausedEventAttributes returns the value of WorkflowExecutionPausedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionPausedEventAttributes() (o *WorkflowExecutionPausedEventAttributes) {
+	if v != nil && v.WorkflowExecutionPausedEventAttributes != nil {
+		return v.WorkflowExecutionPausedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionPausedEventAttributes returns true if WorkflowExecutionPausedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionPausedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionPausedEventAttributes != nil
+}
+
+// GetWorkflowExecutionResumedEventAttributes returns the value of WorkflowExecutionResumedEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetWorkflowExecutionResumedEventAttributes() (o *WorkflowExecutionResumedEventAttributes) {
+	if v != nil && v.WorkflowExecutionResumedEventAttributes != nil {
+		return v.WorkflowExecutionResumedEventAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionResumedEventAttributes returns true if WorkflowExecutionResumedEventAttributes is not nil.
+func (v *HistoryEvent) IsSetWorkflowExecutionResumedEventAttributes() bool {
+	return v != nil && v.WorkflowExecutionResumedEventAttributes != nil
+}
+
 type HistoryEventFilterType int32
 
 const (
@@ -20361,6 +21653,574 @@ func (v *HistoryEventFilterType) UnmarshalJSON(text []byte) error {
 	}
 }
 
+type HistoryShardStatus struct {
+	ShardId                  *int32 `json:"shardId,omitempty"`
+	RangeId                  *int64 `json:"rangeId,omitempty"`
+	TransferAckLevel         *int64 `json:"transferAckLevel,omitempty"`
+	TimerAckLevel            *int64 `json:"timerAckLevel,omitempty"`
+	ReplicationAckLevel      *int64 `json:"replicationAckLevel,omitempty"`
+	NumOfItemsInHistoryCache *int32 `json:"numOfItemsInHistoryCache,omitempty"`
+}
+
+// ToWire translates a HistoryShardStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryShardStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardId != nil {
+		w, err = wire.NewValueI32(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RangeId != nil {
+		w, err = wire.NewValueI64(*(v.RangeId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TransferAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.TransferAckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TimerAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.TimerAckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ReplicationAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.ReplicationAckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NumOfItemsInHistoryCache != nil {
+		w, err = wire.NewValueI32(*(v.NumOfItemsInHistoryCache)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a HistoryShardStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryShardStatus struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryShardStatus
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryShardStatus) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RangeId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TransferAckLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TimerAckLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReplicationAckLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumOfItemsInHistoryCache = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryShardStatus
+// struct.
+func (v *HistoryShardStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
+	if v.RangeId != nil {
+		fields[i] = fmt.Sprintf("RangeId: %v", *(v.RangeId))
+		i++
+	}
+	if v.TransferAckLevel != nil {
+		fields[i] = fmt.Sprintf("TransferAckLevel: %v", *(v.TransferAckLevel))
+		i++
+	}
+	if v.TimerAckLevel != nil {
+		fields[i] = fmt.Sprintf("TimerAckLevel: %v", *(v.TimerAckLevel))
+		i++
+	}
+	if v.ReplicationAckLevel != nil {
+		fields[i] = fmt.Sprintf("ReplicationAckLevel: %v", *(v.ReplicationAckLevel))
+		i++
+	}
+	if v.NumOfItemsInHistoryCache != nil {
+		fields[i] = fmt.Sprintf("NumOfItemsInHistoryCache: %v", *(v.NumOfItemsInHistoryCache))
+		i++
+	}
+
+	return fmt.Sprintf("HistoryShardStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this HistoryShardStatus match the
+// provided HistoryShardStatus.
+//
+// This function performs a deep comparison.
+func (v *HistoryShardStatus) Equals(rhs *HistoryShardStatus) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RangeId, rhs.RangeId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TransferAckLevel, rhs.TransferAckLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TimerAckLevel, rhs.TimerAckLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ReplicationAckLevel, rhs.ReplicationAckLevel) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumOfItemsInHistoryCache, rhs.NumOfItemsInHistoryCache) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryShardStatus.
+func (v *HistoryShardStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardId != nil {
+		enc.AddInt32("shardId", *v.ShardId)
+	}
+	if v.RangeId != nil {
+		enc.AddInt64("rangeId", *v.RangeId)
+	}
+	if v.TransferAckLevel != nil {
+		enc.AddInt64("transferAckLevel", *v.TransferAckLevel)
+	}
+	if v.TimerAckLevel != nil {
+		enc.AddInt64("timerAckLevel", *v.TimerAckLevel)
+	}
+	if v.ReplicationAckLevel != nil {
+		enc.AddInt64("replicationAckLevel", *v.ReplicationAckLevel)
+	}
+	if v.NumOfItemsInHistoryCache != nil {
+		enc.AddInt32("numOfItemsInHistoryCache", *v.NumOfItemsInHistoryCache)
+	}
+	return err
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetShardId() (o int32) {
+	if v != nil && v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// IsSetShardId returns true if ShardId is not nil.
+func (v *HistoryShardStatus) IsSetShardId() bool {
+	return v != nil && v.ShardId != nil
+}
+
+// GetRangeId returns the value of RangeId if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetRangeId() (o int64) {
+	if v != nil && v.RangeId != nil {
+		return *v.RangeId
+	}
+
+	return
+}
+
+// IsSetRangeId returns true if RangeId is not nil.
+func (v *HistoryShardStatus) IsSetRangeId() bool {
+	return v != nil && v.RangeId != nil
+}
+
+// GetTransferAckLevel returns the value of TransferAckLevel if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetTransferAckLevel() (o int64) {
+	if v != nil && v.TransferAckLevel != nil {
+		return *v.TransferAckLevel
+	}
+
+	return
+}
+
+// IsSetTransferAckLevel returns true if TransferAckLevel is not nil.
+func (v *HistoryShardStatus) IsSetTransferAckLevel() bool {
+	return v != nil && v.TransferAckLevel != nil
+}
+
+// GetTimerAckLevel returns the value of TimerAckLevel if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetTimerAckLevel() (o int64) {
+	if v != nil && v.TimerAckLevel != nil {
+		return *v.TimerAckLevel
+	}
+
+	return
+}
+
+// IsSetTimerAckLevel returns true if TimerAckLevel is not nil.
+func (v *HistoryShardStatus) IsSetTimerAckLevel() bool {
+	return v != nil && v.TimerAckLevel != nil
+}
+
+// GetReplicationAckLevel returns the value of ReplicationAckLevel if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetReplicationAckLevel() (o int64) {
+	if v != nil && v.ReplicationAckLevel != nil {
+		return *v.ReplicationAckLevel
+	}
+
+	return
+}
+
+// IsSetReplicationAckLevel returns true if ReplicationAckLevel is not nil.
+func (v *HistoryShardStatus) IsSetReplicationAckLevel() bool {
+	return v != nil && v.ReplicationAckLevel != nil
+}
+
+// GetNumOfItemsInHistoryCache returns the value of NumOfItemsInHistoryCache if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardStatus) GetNumOfItemsInHistoryCache() (o int32) {
+	if v != nil && v.NumOfItemsInHistoryCache != nil {
+		return *v.NumOfItemsInHistoryCache
+	}
+
+	return
+}
+
+// IsSetNumOfItemsInHistoryCache returns true if NumOfItemsInHistoryCache is not nil.
+func (v *HistoryShardStatus) IsSetNumOfItemsInHistoryCache() bool {
+	return v != nil && v.NumOfItemsInHistoryCache != nil
+}
+
+type IndexedValueType int32
+
+const (
+	IndexedValueTypeString   IndexedValueType = 0
+	IndexedValueTypeKeyword  IndexedValueType = 1
+	IndexedValueTypeInt      IndexedValueType = 2
+	IndexedValueTypeBool     IndexedValueType = 3
+	IndexedValueTypeDatetime IndexedValueType = 4
+)
+
+// IndexedValueType_Values returns all recognized values of IndexedValueType.
+func IndexedValueType_Values() []IndexedValueType {
+	return []IndexedValueType{
+		IndexedValueTypeString,
+		IndexedValueTypeKeyword,
+		IndexedValueTypeInt,
+		IndexedValueTypeBool,
+		IndexedValueTypeDatetime,
+	}
+}
+
+// UnmarshalText tries to decode IndexedValueType from a byte slice
+// containing its name.
+//
+//   var v IndexedValueType
+//   err := v.UnmarshalText([]byte("STRING"))
+func (v *IndexedValueType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "STRING":
+		*v = IndexedValueTypeString
+		return nil
+	case "KEYWORD":
+		*v = IndexedValueTypeKeyword
+		return nil
+	case "INT":
+		*v = IndexedValueTypeInt
+		return nil
+	case "BOOL":
+		*v = IndexedValueTypeBool
+		return nil
+	case "DATETIME":
+		*v = IndexedValueTypeDatetime
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "IndexedValueType", err)
+		}
+		*v = IndexedValueType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes IndexedValueType to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v IndexedValueType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("STRING"), nil
+	case 1:
+		return []byte("KEYWORD"), nil
+	case 2:
+		return []byte("INT"), nil
+	case 3:
+		return []byte("BOOL"), nil
+	case 4:
+		return []byte("DATETIME"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of IndexedValueType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v IndexedValueType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "STRING")
+	case 1:
+		enc.AddString("name", "KEYWORD")
+	case 2:
+		enc.AddString("name", "INT")
+	case 3:
+		enc.AddString("name", "BOOL")
+	case 4:
+		enc.AddString("name", "DATETIME")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v IndexedValueType) Ptr() *IndexedValueType {
+	return &v
+}
+
+// ToWire translates IndexedValueType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v IndexedValueType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes IndexedValueType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return IndexedValueType(0), err
+//   }
+//
+//   var v IndexedValueType
+//   if err := v.FromWire(x); err != nil {
+//     return IndexedValueType(0), err
+//   }
+//   return v, nil
+func (v *IndexedValueType) FromWire(w wire.Value) error {
+	*v = (IndexedValueType)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of IndexedValueType.
+func (v IndexedValueType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "STRING"
+	case 1:
+		return "KEYWORD"
+	case 2:
+		return "INT"
+	case 3:
+		return "BOOL"
+	case 4:
+		return "DATETIME"
+	}
+	return fmt.Sprintf("IndexedValueType(%d)", w)
+}
+
+// Equals returns true if this IndexedValueType value matches the provided
+// value.
+func (v IndexedValueType) Equals(rhs IndexedValueType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes IndexedValueType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v IndexedValueType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"STRING\""), nil
+	case 1:
+		return ([]byte)("\"KEYWORD\""), nil
+	case 2:
+		return ([]byte)("\"INT\""), nil
+	case 3:
+		return ([]byte)("\"BOOL\""), nil
+	case 4:
+		return ([]byte)("\"DATETIME\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode IndexedValueType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *IndexedValueType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "IndexedValueType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "IndexedValueType")
+		}
+		*v = (IndexedValueType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "IndexedValueType")
+	}
+}
+
 type InternalServiceError struct {
 	Message string `json:"message,required"`
 }
@@ -20722,30 +22582,6 @@ func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartTimeFilter_Read(w wire.Value) (*StartTimeFilter, error) {
-	var v StartTimeFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionFilter_Read(w wire.Value) (*WorkflowExecutionFilter, error) {
-	var v WorkflowExecutionFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowTypeFilter_Read(w wire.Value) (*WorkflowTypeFilter, error) {
-	var v WorkflowTypeFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseStatus, error) {
-	var v WorkflowExecutionCloseStatus
-	err := v.FromWire(w)
-	return v, err
-}
-
 // FromWire deserializes a ListClosedWorkflowExecutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -20877,16 +22713,6 @@ func (v *ListClosedWorkflowExecutionsRequest) String() string {
 	return fmt.Sprintf("ListClosedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *WorkflowExecutionCloseStatus) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this ListClosedWorkflowExecutionsRequest match the
 // provided ListClosedWorkflowExecutionsRequest.
 //
@@ -22681,20 +24507,15 @@ func (v *Memo) IsSetFields() bool {
 	return v != nil && v.Fields != nil
 }
 
-type PendingActivityInfo struct {
-	ActivityID             *string               `json:"activityID,omitempty"`
-	ActivityType           *ActivityType         `json:"activityType,omitempty"`
-	State                  *PendingActivityState `json:"state,omitempty"`
-	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
-	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
-	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
-	Attempt                *int32                `json:"attempt,omitempty"`
-	MaximumAttempts        *int32                `json:"maximumAttempts,omitempty"`
-	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
-	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
+type MergeReplicationDLQRequest struct {
+	ShardID            *int32  `json:"shardID,omitempty"`
+	SourceCluster      *string `json:"sourceCluster,omitempty"`
+	InclusiveEndTaskID *int64  `json:"inclusiveEndTaskID,omitempty"`
+	MaximumPageSize    *int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken      []byte  `json:"nextPageToken,omitempty"`
 }
 
-// ToWire translates a PendingActivityInfo struct into a Thrift-level intermediate
+// ToWire translates a MergeReplicationDLQRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -22709,109 +24530,63 @@ type PendingActivityInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
+func (v *MergeReplicationDLQRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [10]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ActivityID != nil {
-		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ActivityType != nil {
-		w, err = v.ActivityType.ToWire()
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.State != nil {
-		w, err = v.State.ToWire()
+	if v.InclusiveEndTaskID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndTaskID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.HeartbeatDetails != nil {
-		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.LastHeartbeatTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.LastHeartbeatTimestamp)), error(nil)
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.LastStartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.LastStartedTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.MaximumAttempts != nil {
-		w, err = wire.NewValueI32(*(v.MaximumAttempts)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.ScheduledTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.ExpirationTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PendingActivityState_Read(w wire.Value) (PendingActivityState, error) {
-	var v PendingActivityState
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a PendingActivityInfo struct from its Thrift-level
+// FromWire deserializes a MergeReplicationDLQRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PendingActivityInfo struct
+// An error is returned if we were unable to build a MergeReplicationDLQRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -22819,107 +24594,59 @@ func _PendingActivityState_Read(w wire.Value) (PendingActivityState, error) {
 //     return nil, err
 //   }
 //
-//   var v PendingActivityInfo
+//   var v MergeReplicationDLQRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PendingActivityInfo) FromWire(w wire.Value) error {
+func (v *MergeReplicationDLQRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActivityID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.ActivityType, err = _ActivityType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
 			if field.Value.Type() == wire.TI32 {
-				var x PendingActivityState
-				x, err = _PendingActivityState_Read(field.Value)
-				v.State = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastHeartbeatTimestamp = &x
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
+		case 30:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.LastStartedTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Attempt = &x
+				v.InclusiveEndTaskID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 80:
+		case 40:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumAttempts = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestamp = &x
+				v.MaximumPageSize = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 100:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ExpirationTimestamp = &x
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -22931,107 +24658,62 @@ func (v *PendingActivityInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PendingActivityInfo
+// String returns a readable string representation of a MergeReplicationDLQRequest
 // struct.
-func (v *PendingActivityInfo) String() string {
+func (v *MergeReplicationDLQRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [10]string
+	var fields [5]string
 	i := 0
-	if v.ActivityID != nil {
-		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.ActivityType != nil {
-		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
-		i++
-	}
-	if v.State != nil {
-		fields[i] = fmt.Sprintf("State: %v", *(v.State))
-		i++
-	}
-	if v.HeartbeatDetails != nil {
-		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
-		i++
-	}
-	if v.LastHeartbeatTimestamp != nil {
-		fields[i] = fmt.Sprintf("LastHeartbeatTimestamp: %v", *(v.LastHeartbeatTimestamp))
-		i++
-	}
-	if v.LastStartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("LastStartedTimestamp: %v", *(v.LastStartedTimestamp))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
 		i++
 	}
-	if v.MaximumAttempts != nil {
-		fields[i] = fmt.Sprintf("MaximumAttempts: %v", *(v.MaximumAttempts))
+	if v.InclusiveEndTaskID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndTaskID: %v", *(v.InclusiveEndTaskID))
 		i++
 	}
-	if v.ScheduledTimestamp != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
 		i++
 	}
-	if v.ExpirationTimestamp != nil {
-		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
 
-	return fmt.Sprintf("PendingActivityInfo{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _PendingActivityState_EqualsPtr(lhs, rhs *PendingActivityState) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("MergeReplicationDLQRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PendingActivityInfo match the
-// provided PendingActivityInfo.
+// Equals returns true if all the fields of this MergeReplicationDLQRequest match the
+// provided MergeReplicationDLQRequest.
 //
 // This function performs a deep comparison.
-func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
+func (v *MergeReplicationDLQRequest) Equals(rhs *MergeReplicationDLQRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
-		return false
-	}
-	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
-		return false
-	}
-	if !_PendingActivityState_EqualsPtr(v.State, rhs.State) {
-		return false
-	}
-	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastHeartbeatTimestamp, rhs.LastHeartbeatTimestamp) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastStartedTimestamp, rhs.LastStartedTimestamp) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.MaximumAttempts, rhs.MaximumAttempts) {
+	if !_I64_EqualsPtr(v.InclusiveEndTaskID, rhs.InclusiveEndTaskID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
 
@@ -23039,388 +24721,294 @@ func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PendingActivityInfo.
-func (v *PendingActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MergeReplicationDLQRequest.
+func (v *MergeReplicationDLQRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ActivityID != nil {
-		enc.AddString("activityID", *v.ActivityID)
-	}
-	if v.ActivityType != nil {
-		err = multierr.Append(err, enc.AddObject("activityType", v.ActivityType))
-	}
-	if v.State != nil {
-		err = multierr.Append(err, enc.AddObject("state", *v.State))
-	}
-	if v.HeartbeatDetails != nil {
-		enc.AddString("heartbeatDetails", base64.StdEncoding.EncodeToString(v.HeartbeatDetails))
-	}
-	if v.LastHeartbeatTimestamp != nil {
-		enc.AddInt64("lastHeartbeatTimestamp", *v.LastHeartbeatTimestamp)
-	}
-	if v.LastStartedTimestamp != nil {
-		enc.AddInt64("lastStartedTimestamp", *v.LastStartedTimestamp)
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.Attempt != nil {
-		enc.AddInt32("attempt", *v.Attempt)
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
 	}
-	if v.MaximumAttempts != nil {
-		enc.AddInt32("maximumAttempts", *v.MaximumAttempts)
+	if v.InclusiveEndTaskID != nil {
+		enc.AddInt64("inclusiveEndTaskID", *v.InclusiveEndTaskID)
 	}
-	if v.ScheduledTimestamp != nil {
-		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
 	}
-	if v.ExpirationTimestamp != nil {
-		enc.AddInt64("expirationTimestamp", *v.ExpirationTimestamp)
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
 	return err
 }
 
-// GetActivityID returns the value of ActivityID if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetActivityID() (o string) {
-	if v != nil && v.ActivityID != nil {
-		return *v.ActivityID
+func (v *MergeReplicationDLQRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetActivityID returns true if ActivityID is not nil.
-func (v *PendingActivityInfo) IsSetActivityID() bool {
-	return v != nil && v.ActivityID != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *MergeReplicationDLQRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetActivityType returns the value of ActivityType if it is set or its
+// GetSourceCluster returns the value of SourceCluster if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetActivityType() (o *ActivityType) {
-	if v != nil && v.ActivityType != nil {
-		return v.ActivityType
+func (v *MergeReplicationDLQRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
 	}
 
 	return
 }
 
-// IsSetActivityType returns true if ActivityType is not nil.
-func (v *PendingActivityInfo) IsSetActivityType() bool {
-	return v != nil && v.ActivityType != nil
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *MergeReplicationDLQRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
 }
 
-// GetState returns the value of State if it is set or its
+// GetInclusiveEndTaskID returns the value of InclusiveEndTaskID if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetState() (o PendingActivityState) {
-	if v != nil && v.State != nil {
-		return *v.State
+func (v *MergeReplicationDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
 	}
 
 	return
 }
 
-// IsSetState returns true if State is not nil.
-func (v *PendingActivityInfo) IsSetState() bool {
-	return v != nil && v.State != nil
+// IsSetInclusiveEndTaskID returns true if InclusiveEndTaskID is not nil.
+func (v *MergeReplicationDLQRequest) IsSetInclusiveEndTaskID() bool {
+	return v != nil && v.InclusiveEndTaskID != nil
 }
 
-// GetHeartbeatDetails returns the value of HeartbeatDetails if it is set or its
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetHeartbeatDetails() (o []byte) {
-	if v != nil && v.HeartbeatDetails != nil {
-		return v.HeartbeatDetails
+func (v *MergeReplicationDLQRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
 	}
 
 	return
 }
 
-// IsSetHeartbeatDetails returns true if HeartbeatDetails is not nil.
-func (v *PendingActivityInfo) IsSetHeartbeatDetails() bool {
-	return v != nil && v.HeartbeatDetails != nil
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *MergeReplicationDLQRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
 }
 
-// GetLastHeartbeatTimestamp returns the value of LastHeartbeatTimestamp if it is set or its
+// GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
-	if v != nil && v.LastHeartbeatTimestamp != nil {
-		return *v.LastHeartbeatTimestamp
+func (v *MergeReplicationDLQRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 
 	return
 }
 
-// IsSetLastHeartbeatTimestamp returns true if LastHeartbeatTimestamp is not nil.
-func (v *PendingActivityInfo) IsSetLastHeartbeatTimestamp() bool {
-	return v != nil && v.LastHeartbeatTimestamp != nil
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeReplicationDLQRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
-// GetLastStartedTimestamp returns the value of LastStartedTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetLastStartedTimestamp() (o int64) {
-	if v != nil && v.LastStartedTimestamp != nil {
-		return *v.LastStartedTimestamp
+type MergeReplicationDLQResponse struct {
+	MergedCount   *int32 `json:"mergedCount,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a MergeReplicationDLQResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MergeReplicationDLQResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.MergedCount != nil {
+		w, err = wire.NewValueI32(*(v.MergedCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// IsSetLastStartedTimestamp returns true if LastStartedTimestamp is not nil.
-func (v *PendingActivityInfo) IsSetLastStartedTimestamp() bool {
-	return v != nil && v.LastStartedTimestamp != nil
-}
+// FromWire deserializes a MergeReplicationDLQResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MergeReplicationDLQResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MergeReplicationDLQResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MergeReplicationDLQResponse) FromWire(w wire.Value) error {
+	var err error
 
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetAttempt() (o int32) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MergedCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
-	return
+	return nil
 }
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *PendingActivityInfo) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
+// String returns a readable string representation of a MergeReplicationDLQResponse
+// struct.
+func (v *MergeReplicationDLQResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.MergedCount != nil {
+		fields[i] = fmt.Sprintf("MergedCount: %v", *(v.MergedCount))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("MergeReplicationDLQResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetMaximumAttempts returns the value of MaximumAttempts if it is set or its
-// zero value if it is unset.
-func (v *PendingActivityInfo) GetMaximumAttempts() (o int32) {
-	if v != nil && v.MaximumAttempts != nil {
-		return *v.MaximumAttempts
+// Equals returns true if all the fields of this MergeReplicationDLQResponse match the
+// provided MergeReplicationDLQResponse.
+//
+// This function performs a deep comparison.
+func (v *MergeReplicationDLQResponse) Equals(rhs *MergeReplicationDLQResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MergedCount, rhs.MergedCount) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetMaximumAttempts returns true if MaximumAttempts is not nil.
-func (v *PendingActivityInfo) IsSetMaximumAttempts() bool {
-	return v != nil && v.MaximumAttempts != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MergeReplicationDLQResponse.
+func (v *MergeReplicationDLQResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.MergedCount != nil {
+		enc.AddInt32("mergedCount", *v.MergedCount)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
 }
 
-// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
+// GetMergedCount returns the value of MergedCount if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetScheduledTimestamp() (o int64) {
-	if v != nil && v.ScheduledTimestamp != nil {
-		return *v.ScheduledTimestamp
+func (v *MergeReplicationDLQResponse) GetMergedCount() (o int32) {
+	if v != nil && v.MergedCount != nil {
+		return *v.MergedCount
 	}
 
 	return
 }
 
-// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
-func (v *PendingActivityInfo) IsSetScheduledTimestamp() bool {
-	return v != nil && v.ScheduledTimestamp != nil
+// IsSetMergedCount returns true if MergedCount is not nil.
+func (v *MergeReplicationDLQResponse) IsSetMergedCount() bool {
+	return v != nil && v.MergedCount != nil
 }
 
-// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
+// GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *PendingActivityInfo) GetExpirationTimestamp() (o int64) {
-	if v != nil && v.ExpirationTimestamp != nil {
-		return *v.ExpirationTimestamp
+func (v *MergeReplicationDLQResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 
 	return
 }
 
-// IsSetExpirationTimestamp returns true if ExpirationTimestamp is not nil.
-func (v *PendingActivityInfo) IsSetExpirationTimestamp() bool {
-	return v != nil && v.ExpirationTimestamp != nil
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeReplicationDLQResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
-type PendingActivityState int32
-
-const (
-	PendingActivityStateScheduled       PendingActivityState = 0
-	PendingActivityStateStarted         PendingActivityState = 1
-	PendingActivityStateCancelRequested PendingActivityState = 2
-)
-
-// PendingActivityState_Values returns all recognized values of PendingActivityState.
-func PendingActivityState_Values() []PendingActivityState {
-	return []PendingActivityState{
-		PendingActivityStateScheduled,
-		PendingActivityStateStarted,
-		PendingActivityStateCancelRequested,
-	}
+type MergeTransferDLQRequest struct {
+	ShardID            *int32 `json:"shardID,omitempty"`
+	InclusiveEndTaskID *int64 `json:"inclusiveEndTaskID,omitempty"`
+	MaximumPageSize    *int32 `json:"maximumPageSize,omitempty"`
+	NextPageToken      []byte `json:"nextPageToken,omitempty"`
 }
 
-// UnmarshalText tries to decode PendingActivityState from a byte slice
-// containing its name.
-//
-//   var v PendingActivityState
-//   err := v.UnmarshalText([]byte("SCHEDULED"))
-func (v *PendingActivityState) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "SCHEDULED":
-		*v = PendingActivityStateScheduled
-		return nil
-	case "STARTED":
-		*v = PendingActivityStateStarted
-		return nil
-	case "CANCEL_REQUESTED":
-		*v = PendingActivityStateCancelRequested
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingActivityState", err)
-		}
-		*v = PendingActivityState(val)
-		return nil
-	}
-}
-
-// MarshalText encodes PendingActivityState to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v PendingActivityState) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("SCHEDULED"), nil
-	case 1:
-		return []byte("STARTED"), nil
-	case 2:
-		return []byte("CANCEL_REQUESTED"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PendingActivityState.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v PendingActivityState) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "SCHEDULED")
-	case 1:
-		enc.AddString("name", "STARTED")
-	case 2:
-		enc.AddString("name", "CANCEL_REQUESTED")
-	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v PendingActivityState) Ptr() *PendingActivityState {
-	return &v
-}
-
-// ToWire translates PendingActivityState into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v PendingActivityState) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes PendingActivityState from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return PendingActivityState(0), err
-//   }
-//
-//   var v PendingActivityState
-//   if err := v.FromWire(x); err != nil {
-//     return PendingActivityState(0), err
-//   }
-//   return v, nil
-func (v *PendingActivityState) FromWire(w wire.Value) error {
-	*v = (PendingActivityState)(w.GetI32())
-	return nil
-}
-
-// String returns a readable string representation of PendingActivityState.
-func (v PendingActivityState) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "SCHEDULED"
-	case 1:
-		return "STARTED"
-	case 2:
-		return "CANCEL_REQUESTED"
-	}
-	return fmt.Sprintf("PendingActivityState(%d)", w)
-}
-
-// Equals returns true if this PendingActivityState value matches the provided
-// value.
-func (v PendingActivityState) Equals(rhs PendingActivityState) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes PendingActivityState into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v PendingActivityState) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"SCHEDULED\""), nil
-	case 1:
-		return ([]byte)("\"STARTED\""), nil
-	case 2:
-		return ([]byte)("\"CANCEL_REQUESTED\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// UnmarshalJSON attempts to decode PendingActivityState from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *PendingActivityState) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "PendingActivityState")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "PendingActivityState")
-		}
-		*v = (PendingActivityState)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "PendingActivityState")
-	}
-}
-
-type PollForActivityTaskRequest struct {
-	Domain           *string           `json:"domain,omitempty"`
-	TaskList         *TaskList         `json:"taskList,omitempty"`
-	Identity         *string           `json:"identity,omitempty"`
-	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
-}
-
-// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// ToWire translates a MergeTransferDLQRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
@@ -23433,7 +25021,7 @@ type PollForActivityTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+func (v *MergeTransferDLQRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
@@ -23441,32 +25029,32 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.InclusiveEndTaskID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndTaskID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.TaskListMetadata != nil {
-		w, err = v.TaskListMetadata.ToWire()
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -23477,17 +25065,11 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TaskListMetadata_Read(w wire.Value) (*TaskListMetadata, error) {
-	var v TaskListMetadata
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a PollForActivityTaskRequest struct from its Thrift-level
+// FromWire deserializes a MergeTransferDLQRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForActivityTaskRequest struct
+// An error is returned if we were unable to build a MergeTransferDLQRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -23495,47 +25077,49 @@ func _TaskListMetadata_Read(w wire.Value) (*TaskListMetadata, error) {
 //     return nil, err
 //   }
 //
-//   var v PollForActivityTaskRequest
+//   var v MergeTransferDLQRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
+func (v *MergeTransferDLQRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndTaskID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskListMetadata, err = _TaskListMetadata_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -23547,55 +25131,55 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForActivityTaskRequest
+// String returns a readable string representation of a MergeTransferDLQRequest
 // struct.
-func (v *PollForActivityTaskRequest) String() string {
+func (v *MergeTransferDLQRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [4]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+	if v.InclusiveEndTaskID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndTaskID: %v", *(v.InclusiveEndTaskID))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
 		i++
 	}
-	if v.TaskListMetadata != nil {
-		fields[i] = fmt.Sprintf("TaskListMetadata: %v", v.TaskListMetadata)
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
 
-	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MergeTransferDLQRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForActivityTaskRequest match the
-// provided PollForActivityTaskRequest.
+// Equals returns true if all the fields of this MergeTransferDLQRequest match the
+// provided MergeTransferDLQRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
+func (v *MergeTransferDLQRequest) Equals(rhs *MergeTransferDLQRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+	if !_I64_EqualsPtr(v.InclusiveEndTaskID, rhs.InclusiveEndTaskID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
 		return false
 	}
-	if !((v.TaskListMetadata == nil && rhs.TaskListMetadata == nil) || (v.TaskListMetadata != nil && rhs.TaskListMetadata != nil && v.TaskListMetadata.Equals(rhs.TaskListMetadata))) {
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
 
@@ -23603,105 +25187,92 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForActivityTaskRequest.
-func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MergeTransferDLQRequest.
+func (v *MergeTransferDLQRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	if v.InclusiveEndTaskID != nil {
+		enc.AddInt64("inclusiveEndTaskID", *v.InclusiveEndTaskID)
 	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
 	}
-	if v.TaskListMetadata != nil {
-		err = multierr.Append(err, enc.AddObject("taskListMetadata", v.TaskListMetadata))
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *MergeTransferDLQRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *PollForActivityTaskRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *MergeTransferDLQRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
+// GetInclusiveEndTaskID returns the value of InclusiveEndTaskID if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetTaskList() (o *TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+func (v *MergeTransferDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
 	}
 
 	return
 }
 
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *PollForActivityTaskRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
+// IsSetInclusiveEndTaskID returns true if InclusiveEndTaskID is not nil.
+func (v *MergeTransferDLQRequest) IsSetInclusiveEndTaskID() bool {
+	return v != nil && v.InclusiveEndTaskID != nil
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *MergeTransferDLQRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
 	}
 
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *PollForActivityTaskRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *MergeTransferDLQRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
 }
 
-// GetTaskListMetadata returns the value of TaskListMetadata if it is set or its
+// GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetTaskListMetadata() (o *TaskListMetadata) {
-	if v != nil && v.TaskListMetadata != nil {
-		return v.TaskListMetadata
+func (v *MergeTransferDLQRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 
 	return
 }
 
-// IsSetTaskListMetadata returns true if TaskListMetadata is not nil.
-func (v *PollForActivityTaskRequest) IsSetTaskListMetadata() bool {
-	return v != nil && v.TaskListMetadata != nil
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeTransferDLQRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
-type PollForActivityTaskResponse struct {
-	TaskToken                       []byte             `json:"taskToken,omitempty"`
-	WorkflowExecution               *WorkflowExecution `json:"workflowExecution,omitempty"`
-	ActivityId                      *string            `json:"activityId,omitempty"`
-	ActivityType                    *ActivityType      `json:"activityType,omitempty"`
-	Input                           []byte             `json:"input,omitempty"`
-	ScheduledTimestamp              *int64             `json:"scheduledTimestamp,omitempty"`
-	ScheduleToCloseTimeoutSeconds   *int32             `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	StartedTimestamp                *int64             `json:"startedTimestamp,omitempty"`
-	StartToCloseTimeoutSeconds      *int32             `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds         *int32             `json:"heartbeatTimeoutSeconds,omitempty"`
-	Attempt                         *int32             `json:"attempt,omitempty"`
-	ScheduledTimestampOfThisAttempt *int64             `json:"scheduledTimestampOfThisAttempt,omitempty"`
-	HeartbeatDetails                []byte             `json:"heartbeatDetails,omitempty"`
-	WorkflowType                    *WorkflowType      `json:"workflowType,omitempty"`
-	WorkflowDomain                  *string            `json:"workflowDomain,omitempty"`
+type MergeTransferDLQResponse struct {
+	MergedCount   *int32 `json:"mergedCount,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
 }
 
-// ToWire translates a PollForActivityTaskResponse struct into a Thrift-level intermediate
+// ToWire translates a MergeTransferDLQResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -23716,143 +25287,39 @@ type PollForActivityTaskResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForActivityTaskResponse) ToWire() (wire.Value, error) {
+func (v *MergeTransferDLQResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.MergedCount != nil {
+		w, err = wire.NewValueI32(*(v.MergedCount)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ActivityType != nil {
-		w, err = v.ActivityType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.Input != nil {
-		w, err = wire.NewValueBinary(v.Input), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.ScheduledTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ScheduleToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.StartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.HeartbeatTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
-	}
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestampOfThisAttempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 130, Value: w}
-		i++
-	}
-	if v.HeartbeatDetails != nil {
-		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 140, Value: w}
-		i++
-	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 150, Value: w}
-		i++
-	}
-	if v.WorkflowDomain != nil {
-		w, err = wire.NewValueString(*(v.WorkflowDomain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 160, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PollForActivityTaskResponse struct from its Thrift-level
+// FromWire deserializes a MergeTransferDLQResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForActivityTaskResponse struct
+// An error is returned if we were unable to build a MergeTransferDLQResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -23860,286 +25327,75 @@ func (v *PollForActivityTaskResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v PollForActivityTaskResponse
+//   var v MergeTransferDLQResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForActivityTaskResponse) FromWire(w wire.Value) error {
+func (v *MergeTransferDLQResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MergedCount = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.ActivityType, err = _ActivityType_Read(field.Value)
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 50:
-			if field.Value.Type() == wire.TBinary {
-				v.Input, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 70:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestamp = &x
-				if err != nil {
-					return err
-				}
+// String returns a readable string representation of a MergeTransferDLQResponse
+// struct.
+func (v *MergeTransferDLQResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-			}
-		case 80:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
+	var fields [2]string
+	i := 0
+	if v.MergedCount != nil {
+		fields[i] = fmt.Sprintf("MergedCount: %v", *(v.MergedCount))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
 
-			}
-		case 90:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTimestamp = &x
-				if err != nil {
-					return err
-				}
+	return fmt.Sprintf("MergeTransferDLQResponse{%v}", strings.Join(fields[:i], ", "))
+}
 
-			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.StartToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.HeartbeatTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 120:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 130:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestampOfThisAttempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 140:
-			if field.Value.Type() == wire.TBinary {
-				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 150:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 160:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowDomain = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a PollForActivityTaskResponse
-// struct.
-func (v *PollForActivityTaskResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [15]string
-	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
-		i++
-	}
-	if v.ActivityType != nil {
-		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
-		i++
-	}
-	if v.Input != nil {
-		fields[i] = fmt.Sprintf("Input: %v", v.Input)
-		i++
-	}
-	if v.ScheduledTimestamp != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
-		i++
-	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToCloseTimeoutSeconds: %v", *(v.ScheduleToCloseTimeoutSeconds))
-		i++
-	}
-	if v.StartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
-		i++
-	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
-		i++
-	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("HeartbeatTimeoutSeconds: %v", *(v.HeartbeatTimeoutSeconds))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestampOfThisAttempt: %v", *(v.ScheduledTimestampOfThisAttempt))
-		i++
-	}
-	if v.HeartbeatDetails != nil {
-		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
-		i++
-	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
-	if v.WorkflowDomain != nil {
-		fields[i] = fmt.Sprintf("WorkflowDomain: %v", *(v.WorkflowDomain))
-		i++
-	}
-
-	return fmt.Sprintf("PollForActivityTaskResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this PollForActivityTaskResponse match the
-// provided PollForActivityTaskResponse.
+// Equals returns true if all the fields of this MergeTransferDLQResponse match the
+// provided MergeTransferDLQResponse.
 //
 // This function performs a deep comparison.
-func (v *PollForActivityTaskResponse) Equals(rhs *PollForActivityTaskResponse) bool {
+func (v *MergeTransferDLQResponse) Equals(rhs *MergeTransferDLQResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
-		return false
-	}
-	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
-		return false
-	}
-	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.ScheduleToCloseTimeoutSeconds, rhs.ScheduleToCloseTimeoutSeconds) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.HeartbeatTimeoutSeconds, rhs.HeartbeatTimeoutSeconds) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTimestampOfThisAttempt, rhs.ScheduledTimestampOfThisAttempt) {
-		return false
-	}
-	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
+	if !_I32_EqualsPtr(v.MergedCount, rhs.MergedCount) {
 		return false
 	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowDomain, rhs.WorkflowDomain) {
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
 
@@ -24147,291 +25403,58 @@ func (v *PollForActivityTaskResponse) Equals(rhs *PollForActivityTaskResponse) b
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForActivityTaskResponse.
-func (v *PollForActivityTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MergeTransferDLQResponse.
+func (v *MergeTransferDLQResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskToken != nil {
-		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
-	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
-	}
-	if v.ActivityId != nil {
-		enc.AddString("activityId", *v.ActivityId)
-	}
-	if v.ActivityType != nil {
-		err = multierr.Append(err, enc.AddObject("activityType", v.ActivityType))
-	}
-	if v.Input != nil {
-		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
-	}
-	if v.ScheduledTimestamp != nil {
-		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
-	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		enc.AddInt32("scheduleToCloseTimeoutSeconds", *v.ScheduleToCloseTimeoutSeconds)
-	}
-	if v.StartedTimestamp != nil {
-		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
-	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		enc.AddInt32("startToCloseTimeoutSeconds", *v.StartToCloseTimeoutSeconds)
-	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		enc.AddInt32("heartbeatTimeoutSeconds", *v.HeartbeatTimeoutSeconds)
-	}
-	if v.Attempt != nil {
-		enc.AddInt32("attempt", *v.Attempt)
-	}
-	if v.ScheduledTimestampOfThisAttempt != nil {
-		enc.AddInt64("scheduledTimestampOfThisAttempt", *v.ScheduledTimestampOfThisAttempt)
-	}
-	if v.HeartbeatDetails != nil {
-		enc.AddString("heartbeatDetails", base64.StdEncoding.EncodeToString(v.HeartbeatDetails))
-	}
-	if v.WorkflowType != nil {
-		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	if v.MergedCount != nil {
+		enc.AddInt32("mergedCount", *v.MergedCount)
 	}
-	if v.WorkflowDomain != nil {
-		enc.AddString("workflowDomain", *v.WorkflowDomain)
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
 	return err
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
-	}
-
-	return
-}
-
-// IsSetTaskToken returns true if TaskToken is not nil.
-func (v *PollForActivityTaskResponse) IsSetTaskToken() bool {
-	return v != nil && v.TaskToken != nil
-}
-
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
-	}
-
-	return
-}
-
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *PollForActivityTaskResponse) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
-}
-
-// GetActivityId returns the value of ActivityId if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetActivityId() (o string) {
-	if v != nil && v.ActivityId != nil {
-		return *v.ActivityId
-	}
-
-	return
-}
-
-// IsSetActivityId returns true if ActivityId is not nil.
-func (v *PollForActivityTaskResponse) IsSetActivityId() bool {
-	return v != nil && v.ActivityId != nil
-}
-
-// GetActivityType returns the value of ActivityType if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetActivityType() (o *ActivityType) {
-	if v != nil && v.ActivityType != nil {
-		return v.ActivityType
-	}
-
-	return
-}
-
-// IsSetActivityType returns true if ActivityType is not nil.
-func (v *PollForActivityTaskResponse) IsSetActivityType() bool {
-	return v != nil && v.ActivityType != nil
-}
-
-// GetInput returns the value of Input if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetInput() (o []byte) {
-	if v != nil && v.Input != nil {
-		return v.Input
-	}
-
-	return
-}
-
-// IsSetInput returns true if Input is not nil.
-func (v *PollForActivityTaskResponse) IsSetInput() bool {
-	return v != nil && v.Input != nil
-}
-
-// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetScheduledTimestamp() (o int64) {
-	if v != nil && v.ScheduledTimestamp != nil {
-		return *v.ScheduledTimestamp
-	}
-
-	return
-}
-
-// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
-func (v *PollForActivityTaskResponse) IsSetScheduledTimestamp() bool {
-	return v != nil && v.ScheduledTimestamp != nil
-}
-
-// GetScheduleToCloseTimeoutSeconds returns the value of ScheduleToCloseTimeoutSeconds if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetScheduleToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
-		return *v.ScheduleToCloseTimeoutSeconds
-	}
-
-	return
-}
-
-// IsSetScheduleToCloseTimeoutSeconds returns true if ScheduleToCloseTimeoutSeconds is not nil.
-func (v *PollForActivityTaskResponse) IsSetScheduleToCloseTimeoutSeconds() bool {
-	return v != nil && v.ScheduleToCloseTimeoutSeconds != nil
-}
-
-// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetStartedTimestamp() (o int64) {
-	if v != nil && v.StartedTimestamp != nil {
-		return *v.StartedTimestamp
-	}
-
-	return
-}
-
-// IsSetStartedTimestamp returns true if StartedTimestamp is not nil.
-func (v *PollForActivityTaskResponse) IsSetStartedTimestamp() bool {
-	return v != nil && v.StartedTimestamp != nil
-}
-
-// GetStartToCloseTimeoutSeconds returns the value of StartToCloseTimeoutSeconds if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetStartToCloseTimeoutSeconds() (o int32) {
-	if v != nil && v.StartToCloseTimeoutSeconds != nil {
-		return *v.StartToCloseTimeoutSeconds
-	}
-
-	return
-}
-
-// IsSetStartToCloseTimeoutSeconds returns true if StartToCloseTimeoutSeconds is not nil.
-func (v *PollForActivityTaskResponse) IsSetStartToCloseTimeoutSeconds() bool {
-	return v != nil && v.StartToCloseTimeoutSeconds != nil
-}
-
-// GetHeartbeatTimeoutSeconds returns the value of HeartbeatTimeoutSeconds if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetHeartbeatTimeoutSeconds() (o int32) {
-	if v != nil && v.HeartbeatTimeoutSeconds != nil {
-		return *v.HeartbeatTimeoutSeconds
-	}
-
-	return
-}
-
-// IsSetHeartbeatTimeoutSeconds returns true if HeartbeatTimeoutSeconds is not nil.
-func (v *PollForActivityTaskResponse) IsSetHeartbeatTimeoutSeconds() bool {
-	return v != nil && v.HeartbeatTimeoutSeconds != nil
-}
-
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetAttempt() (o int32) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
-	}
-
-	return
-}
-
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *PollForActivityTaskResponse) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
-}
-
-// GetScheduledTimestampOfThisAttempt returns the value of ScheduledTimestampOfThisAttempt if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetScheduledTimestampOfThisAttempt() (o int64) {
-	if v != nil && v.ScheduledTimestampOfThisAttempt != nil {
-		return *v.ScheduledTimestampOfThisAttempt
-	}
-
-	return
-}
-
-// IsSetScheduledTimestampOfThisAttempt returns true if ScheduledTimestampOfThisAttempt is not nil.
-func (v *PollForActivityTaskResponse) IsSetScheduledTimestampOfThisAttempt() bool {
-	return v != nil && v.ScheduledTimestampOfThisAttempt != nil
-}
-
-// GetHeartbeatDetails returns the value of HeartbeatDetails if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetHeartbeatDetails() (o []byte) {
-	if v != nil && v.HeartbeatDetails != nil {
-		return v.HeartbeatDetails
-	}
-
-	return
-}
-
-// IsSetHeartbeatDetails returns true if HeartbeatDetails is not nil.
-func (v *PollForActivityTaskResponse) IsSetHeartbeatDetails() bool {
-	return v != nil && v.HeartbeatDetails != nil
-}
-
-// GetWorkflowType returns the value of WorkflowType if it is set or its
+// GetMergedCount returns the value of MergedCount if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetWorkflowType() (o *WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
+func (v *MergeTransferDLQResponse) GetMergedCount() (o int32) {
+	if v != nil && v.MergedCount != nil {
+		return *v.MergedCount
 	}
 
 	return
 }
 
-// IsSetWorkflowType returns true if WorkflowType is not nil.
-func (v *PollForActivityTaskResponse) IsSetWorkflowType() bool {
-	return v != nil && v.WorkflowType != nil
+// IsSetMergedCount returns true if MergedCount is not nil.
+func (v *MergeTransferDLQResponse) IsSetMergedCount() bool {
+	return v != nil && v.MergedCount != nil
 }
 
-// GetWorkflowDomain returns the value of WorkflowDomain if it is set or its
+// GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskResponse) GetWorkflowDomain() (o string) {
-	if v != nil && v.WorkflowDomain != nil {
-		return *v.WorkflowDomain
+func (v *MergeTransferDLQResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 
 	return
 }
 
-// IsSetWorkflowDomain returns true if WorkflowDomain is not nil.
-func (v *PollForActivityTaskResponse) IsSetWorkflowDomain() bool {
-	return v != nil && v.WorkflowDomain != nil
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeTransferDLQResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
-type PollForDecisionTaskRequest struct {
-	Domain   *string   `json:"domain,omitempty"`
-	TaskList *TaskList `json:"taskList,omitempty"`
-	Identity *string   `json:"identity,omitempty"`
+type PauseWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            *string            `json:"reason,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
 }
 
-// ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a PauseWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -24446,9 +25469,9 @@ type PollForDecisionTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+func (v *PauseWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -24462,31 +25485,39 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 	if v.Identity != nil {
 		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
+// FromWire deserializes a PauseWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForDecisionTaskRequest struct
+// An error is returned if we were unable to build a PauseWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -24494,12 +25525,12 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v PollForDecisionTaskRequest
+//   var v PauseWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+func (v *PauseWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -24516,13 +25547,23 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -24538,21 +25579,25 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForDecisionTaskRequest
+// String returns a readable string representation of a PauseWorkflowExecutionRequest
 // struct.
-func (v *PollForDecisionTaskRequest) String() string {
+func (v *PauseWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
 		i++
 	}
 	if v.Identity != nil {
@@ -24560,14 +25605,14 @@ func (v *PollForDecisionTaskRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PauseWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForDecisionTaskRequest match the
-// provided PollForDecisionTaskRequest.
+// Equals returns true if all the fields of this PauseWorkflowExecutionRequest match the
+// provided PauseWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+func (v *PauseWorkflowExecutionRequest) Equals(rhs *PauseWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -24576,7 +25621,10 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
@@ -24587,16 +25635,19 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForDecisionTaskRequest.
-func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PauseWorkflowExecutionRequest.
+func (v *PauseWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
 	}
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
@@ -24606,7 +25657,7 @@ func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetDomain() (o string) {
+func (v *PauseWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -24615,28 +25666,43 @@ func (v *PollForDecisionTaskRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *PollForDecisionTaskRequest) IsSetDomain() bool {
+func (v *PauseWorkflowExecutionRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetTaskList() (o *TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+func (v *PauseWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *PollForDecisionTaskRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *PauseWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *PauseWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *PauseWorkflowExecutionRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
 }
 
 // GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetIdentity() (o string) {
+func (v *PauseWorkflowExecutionRequest) GetIdentity() (o string) {
 	if v != nil && v.Identity != nil {
 		return *v.Identity
 	}
@@ -24645,25 +25711,24 @@ func (v *PollForDecisionTaskRequest) GetIdentity() (o string) {
 }
 
 // IsSetIdentity returns true if Identity is not nil.
-func (v *PollForDecisionTaskRequest) IsSetIdentity() bool {
+func (v *PauseWorkflowExecutionRequest) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
-type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte             `json:"taskToken,omitempty"`
-	WorkflowExecution         *WorkflowExecution `json:"workflowExecution,omitempty"`
-	WorkflowType              *WorkflowType      `json:"workflowType,omitempty"`
-	PreviousStartedEventId    *int64             `json:"previousStartedEventId,omitempty"`
-	StartedEventId            *int64             `json:"startedEventId,omitempty"`
-	Attempt                   *int64             `json:"attempt,omitempty"`
-	BacklogCountHint          *int64             `json:"backlogCountHint,omitempty"`
-	History                   *History           `json:"history,omitempty"`
-	NextPageToken             []byte             `json:"nextPageToken,omitempty"`
-	Query                     *WorkflowQuery     `json:"query,omitempty"`
-	WorkflowExecutionTaskList *TaskList          `json:"WorkflowExecutionTaskList,omitempty"`
+type PendingActivityInfo struct {
+	ActivityID             *string               `json:"activityID,omitempty"`
+	ActivityType           *ActivityType         `json:"activityType,omitempty"`
+	State                  *PendingActivityState `json:"state,omitempty"`
+	HeartbeatDetails       []byte                `json:"heartbeatDetails,omitempty"`
+	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
+	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
+	Attempt                *int32                `json:"attempt,omitempty"`
+	MaximumAttempts        *int32                `json:"maximumAttempts,omitempty"`
+	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
+	ExpirationTimestamp    *int64                `json:"expirationTimestamp,omitempty"`
 }
 
-// ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
+// ToWire translates a PendingActivityInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -24678,117 +25743,109 @@ type PollForDecisionTaskResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.ActivityID != nil {
+		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.ActivityType != nil {
+		w, err = v.ActivityType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
+	if v.State != nil {
+		w, err = v.State.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.PreviousStartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+	if v.HeartbeatDetails != nil {
+		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+	if v.LastHeartbeatTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastHeartbeatTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 51, Value: w}
-		i++
-	}
-	if v.BacklogCountHint != nil {
-		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
+	if v.LastStartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastStartedTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 54, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.History != nil {
-		w, err = v.History.ToWire()
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.MaximumAttempts != nil {
+		w, err = wire.NewValueI32(*(v.MaximumAttempts)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
+		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
-	if v.Query != nil {
-		w, err = v.Query.ToWire()
+	if v.ScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
+		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		w, err = v.WorkflowExecutionTaskList.ToWire()
+	if v.ExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 90, Value: w}
+		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowQuery_Read(w wire.Value) (*WorkflowQuery, error) {
-	var v WorkflowQuery
+func _PendingActivityState_Read(w wire.Value) (PendingActivityState, error) {
+	var v PendingActivityState
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-// FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
+// FromWire deserializes a PendingActivityInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForDecisionTaskResponse struct
+// An error is returned if we were unable to build a PendingActivityInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -24796,19 +25853,21 @@ func _WorkflowQuery_Read(w wire.Value) (*WorkflowQuery, error) {
 //     return nil, err
 //   }
 //
-//   var v PollForDecisionTaskResponse
+//   var v PendingActivityInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+func (v *PendingActivityInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityID = &x
 				if err != nil {
 					return err
 				}
@@ -24816,25 +25875,25 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				v.ActivityType, err = _ActivityType_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x PendingActivityState
+				x, err = _PendingActivityState_Read(field.Value)
+				v.State = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.PreviousStartedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -24844,59 +25903,57 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 51:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
+				v.LastHeartbeatTimestamp = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 54:
+		case 60:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.BacklogCountHint = &x
+				v.LastStartedTimestamp = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 70:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumAttempts = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.Query, err = _WorkflowQuery_Read(field.Value)
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestamp = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
+		case 100:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTimestamp = &x
 				if err != nil {
 					return err
 				}
@@ -24908,104 +25965,107 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForDecisionTaskResponse
+// String returns a readable string representation of a PendingActivityInfo
 // struct.
-func (v *PollForDecisionTaskResponse) String() string {
+func (v *PendingActivityInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [10]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.ActivityID != nil {
+		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
 		i++
 	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
 		i++
 	}
-	if v.PreviousStartedEventId != nil {
-		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
 		i++
 	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+	if v.HeartbeatDetails != nil {
+		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
 		i++
 	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+	if v.LastHeartbeatTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastHeartbeatTimestamp: %v", *(v.LastHeartbeatTimestamp))
 		i++
 	}
-	if v.BacklogCountHint != nil {
-		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
+	if v.LastStartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastStartedTimestamp: %v", *(v.LastStartedTimestamp))
 		i++
 	}
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
 		i++
 	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.MaximumAttempts != nil {
+		fields[i] = fmt.Sprintf("MaximumAttempts: %v", *(v.MaximumAttempts))
 		i++
 	}
-	if v.Query != nil {
-		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+	if v.ScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
 		i++
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
+	if v.ExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
 		i++
 	}
 
-	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PendingActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForDecisionTaskResponse match the
-// provided PollForDecisionTaskResponse.
+func _PendingActivityState_EqualsPtr(lhs, rhs *PendingActivityState) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this PendingActivityInfo match the
+// provided PendingActivityInfo.
 //
 // This function performs a deep comparison.
-func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
 		return false
 	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
+	if !_PendingActivityState_EqualsPtr(v.State, rhs.State) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+	if !_I64_EqualsPtr(v.LastHeartbeatTimestamp, rhs.LastHeartbeatTimestamp) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
+	if !_I64_EqualsPtr(v.LastStartedTimestamp, rhs.LastStartedTimestamp) {
 		return false
 	}
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !_I32_EqualsPtr(v.MaximumAttempts, rhs.MaximumAttempts) {
 		return false
 	}
-	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
+	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
 		return false
 	}
-	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
+	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
 		return false
 	}
 
@@ -25013,274 +26073,458 @@ func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) b
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForDecisionTaskResponse.
-func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PendingActivityInfo.
+func (v *PendingActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskToken != nil {
-		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
-	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	if v.ActivityID != nil {
+		enc.AddString("activityID", *v.ActivityID)
 	}
-	if v.WorkflowType != nil {
-		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	if v.ActivityType != nil {
+		err = multierr.Append(err, enc.AddObject("activityType", v.ActivityType))
 	}
-	if v.PreviousStartedEventId != nil {
-		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
+	if v.State != nil {
+		err = multierr.Append(err, enc.AddObject("state", *v.State))
 	}
-	if v.StartedEventId != nil {
-		enc.AddInt64("startedEventId", *v.StartedEventId)
+	if v.HeartbeatDetails != nil {
+		enc.AddString("heartbeatDetails", base64.StdEncoding.EncodeToString(v.HeartbeatDetails))
 	}
-	if v.Attempt != nil {
-		enc.AddInt64("attempt", *v.Attempt)
+	if v.LastHeartbeatTimestamp != nil {
+		enc.AddInt64("lastHeartbeatTimestamp", *v.LastHeartbeatTimestamp)
 	}
-	if v.BacklogCountHint != nil {
-		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
+	if v.LastStartedTimestamp != nil {
+		enc.AddInt64("lastStartedTimestamp", *v.LastStartedTimestamp)
 	}
-	if v.History != nil {
-		err = multierr.Append(err, enc.AddObject("history", v.History))
+	if v.Attempt != nil {
+		enc.AddInt32("attempt", *v.Attempt)
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.MaximumAttempts != nil {
+		enc.AddInt32("maximumAttempts", *v.MaximumAttempts)
 	}
-	if v.Query != nil {
-		err = multierr.Append(err, enc.AddObject("query", v.Query))
+	if v.ScheduledTimestamp != nil {
+		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
+	if v.ExpirationTimestamp != nil {
+		enc.AddInt64("expirationTimestamp", *v.ExpirationTimestamp)
 	}
 	return err
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
+// GetActivityID returns the value of ActivityID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
+func (v *PendingActivityInfo) GetActivityID() (o string) {
+	if v != nil && v.ActivityID != nil {
+		return *v.ActivityID
 	}
 
 	return
 }
 
-// IsSetTaskToken returns true if TaskToken is not nil.
-func (v *PollForDecisionTaskResponse) IsSetTaskToken() bool {
-	return v != nil && v.TaskToken != nil
+// IsSetActivityID returns true if ActivityID is not nil.
+func (v *PendingActivityInfo) IsSetActivityID() bool {
+	return v != nil && v.ActivityID != nil
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// GetActivityType returns the value of ActivityType if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *PendingActivityInfo) GetActivityType() (o *ActivityType) {
+	if v != nil && v.ActivityType != nil {
+		return v.ActivityType
 	}
 
 	return
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+// IsSetActivityType returns true if ActivityType is not nil.
+func (v *PendingActivityInfo) IsSetActivityType() bool {
+	return v != nil && v.ActivityType != nil
 }
 
-// GetWorkflowType returns the value of WorkflowType if it is set or its
+// GetState returns the value of State if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowType() (o *WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
+func (v *PendingActivityInfo) GetState() (o PendingActivityState) {
+	if v != nil && v.State != nil {
+		return *v.State
 	}
 
 	return
 }
 
-// IsSetWorkflowType returns true if WorkflowType is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
-	return v != nil && v.WorkflowType != nil
+// IsSetState returns true if State is not nil.
+func (v *PendingActivityInfo) IsSetState() bool {
+	return v != nil && v.State != nil
 }
 
-// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// GetHeartbeatDetails returns the value of HeartbeatDetails if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetPreviousStartedEventId() (o int64) {
-	if v != nil && v.PreviousStartedEventId != nil {
-		return *v.PreviousStartedEventId
+func (v *PendingActivityInfo) GetHeartbeatDetails() (o []byte) {
+	if v != nil && v.HeartbeatDetails != nil {
+		return v.HeartbeatDetails
 	}
 
 	return
 }
 
-// IsSetPreviousStartedEventId returns true if PreviousStartedEventId is not nil.
-func (v *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
-	return v != nil && v.PreviousStartedEventId != nil
+// IsSetHeartbeatDetails returns true if HeartbeatDetails is not nil.
+func (v *PendingActivityInfo) IsSetHeartbeatDetails() bool {
+	return v != nil && v.HeartbeatDetails != nil
 }
 
-// GetStartedEventId returns the value of StartedEventId if it is set or its
+// GetLastHeartbeatTimestamp returns the value of LastHeartbeatTimestamp if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStartedEventId() (o int64) {
-	if v != nil && v.StartedEventId != nil {
-		return *v.StartedEventId
+func (v *PendingActivityInfo) GetLastHeartbeatTimestamp() (o int64) {
+	if v != nil && v.LastHeartbeatTimestamp != nil {
+		return *v.LastHeartbeatTimestamp
 	}
 
 	return
 }
 
-// IsSetStartedEventId returns true if StartedEventId is not nil.
-func (v *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
-	return v != nil && v.StartedEventId != nil
+// IsSetLastHeartbeatTimestamp returns true if LastHeartbeatTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetLastHeartbeatTimestamp() bool {
+	return v != nil && v.LastHeartbeatTimestamp != nil
 }
 
-// GetAttempt returns the value of Attempt if it is set or its
+// GetLastStartedTimestamp returns the value of LastStartedTimestamp if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
+func (v *PendingActivityInfo) GetLastStartedTimestamp() (o int64) {
+	if v != nil && v.LastStartedTimestamp != nil {
+		return *v.LastStartedTimestamp
 	}
 
 	return
 }
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *PollForDecisionTaskResponse) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
+// IsSetLastStartedTimestamp returns true if LastStartedTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetLastStartedTimestamp() bool {
+	return v != nil && v.LastStartedTimestamp != nil
 }
 
-// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
+// GetAttempt returns the value of Attempt if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
-	if v != nil && v.BacklogCountHint != nil {
-		return *v.BacklogCountHint
+func (v *PendingActivityInfo) GetAttempt() (o int32) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
 	}
 
 	return
 }
 
-// IsSetBacklogCountHint returns true if BacklogCountHint is not nil.
-func (v *PollForDecisionTaskResponse) IsSetBacklogCountHint() bool {
-	return v != nil && v.BacklogCountHint != nil
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *PendingActivityInfo) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
 }
 
-// GetHistory returns the value of History if it is set or its
+// GetMaximumAttempts returns the value of MaximumAttempts if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetHistory() (o *History) {
-	if v != nil && v.History != nil {
-		return v.History
+func (v *PendingActivityInfo) GetMaximumAttempts() (o int32) {
+	if v != nil && v.MaximumAttempts != nil {
+		return *v.MaximumAttempts
 	}
 
 	return
 }
 
-// IsSetHistory returns true if History is not nil.
-func (v *PollForDecisionTaskResponse) IsSetHistory() bool {
-	return v != nil && v.History != nil
+// IsSetMaximumAttempts returns true if MaximumAttempts is not nil.
+func (v *PendingActivityInfo) IsSetMaximumAttempts() bool {
+	return v != nil && v.MaximumAttempts != nil
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *PendingActivityInfo) GetScheduledTimestamp() (o int64) {
+	if v != nil && v.ScheduledTimestamp != nil {
+		return *v.ScheduledTimestamp
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *PollForDecisionTaskResponse) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetScheduledTimestamp() bool {
+	return v != nil && v.ScheduledTimestamp != nil
 }
 
-// GetQuery returns the value of Query if it is set or its
+// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetQuery() (o *WorkflowQuery) {
-	if v != nil && v.Query != nil {
-		return v.Query
+func (v *PendingActivityInfo) GetExpirationTimestamp() (o int64) {
+	if v != nil && v.ExpirationTimestamp != nil {
+		return *v.ExpirationTimestamp
 	}
 
 	return
 }
 
-// IsSetQuery returns true if Query is not nil.
-func (v *PollForDecisionTaskResponse) IsSetQuery() bool {
-	return v != nil && v.Query != nil
+// IsSetExpirationTimestamp returns true if ExpirationTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetExpirationTimestamp() bool {
+	return v != nil && v.ExpirationTimestamp != nil
 }
 
-// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *TaskList) {
-	if v != nil && v.WorkflowExecutionTaskList != nil {
-		return v.WorkflowExecutionTaskList
-	}
-
-	return
-}
+type PendingActivityState int32
 
-// IsSetWorkflowExecutionTaskList returns true if WorkflowExecutionTaskList is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowExecutionTaskList() bool {
-	return v != nil && v.WorkflowExecutionTaskList != nil
-}
+const (
+	PendingActivityStateScheduled       PendingActivityState = 0
+	PendingActivityStateStarted         PendingActivityState = 1
+	PendingActivityStateCancelRequested PendingActivityState = 2
+)
 
-type PollerInfo struct {
-	LastAccessTime *int64   `json:"lastAccessTime,omitempty"`
-	Identity       *string  `json:"identity,omitempty"`
-	RatePerSecond  *float64 `json:"ratePerSecond,omitempty"`
+// PendingActivityState_Values returns all recognized values of PendingActivityState.
+func PendingActivityState_Values() []PendingActivityState {
+	return []PendingActivityState{
+		PendingActivityStateScheduled,
+		PendingActivityStateStarted,
+		PendingActivityStateCancelRequested,
+	}
 }
 
-// ToWire translates a PollerInfo struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+// UnmarshalText tries to decode PendingActivityState from a byte slice
+// containing its name.
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PollerInfo) ToWire() (wire.Value, error) {
+//   var v PendingActivityState
+//   err := v.UnmarshalText([]byte("SCHEDULED"))
+func (v *PendingActivityState) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "SCHEDULED":
+		*v = PendingActivityStateScheduled
+		return nil
+	case "STARTED":
+		*v = PendingActivityStateStarted
+		return nil
+	case "CANCEL_REQUESTED":
+		*v = PendingActivityStateCancelRequested
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingActivityState", err)
+		}
+		*v = PendingActivityState(val)
+		return nil
+	}
+}
+
+// MarshalText encodes PendingActivityState to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v PendingActivityState) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("SCHEDULED"), nil
+	case 1:
+		return []byte("STARTED"), nil
+	case 2:
+		return []byte("CANCEL_REQUESTED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PendingActivityState.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v PendingActivityState) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "SCHEDULED")
+	case 1:
+		enc.AddString("name", "STARTED")
+	case 2:
+		enc.AddString("name", "CANCEL_REQUESTED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v PendingActivityState) Ptr() *PendingActivityState {
+	return &v
+}
+
+// ToWire translates PendingActivityState into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v PendingActivityState) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes PendingActivityState from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return PendingActivityState(0), err
+//   }
+//
+//   var v PendingActivityState
+//   if err := v.FromWire(x); err != nil {
+//     return PendingActivityState(0), err
+//   }
+//   return v, nil
+func (v *PendingActivityState) FromWire(w wire.Value) error {
+	*v = (PendingActivityState)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of PendingActivityState.
+func (v PendingActivityState) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "SCHEDULED"
+	case 1:
+		return "STARTED"
+	case 2:
+		return "CANCEL_REQUESTED"
+	}
+	return fmt.Sprintf("PendingActivityState(%d)", w)
+}
+
+// Equals returns true if this PendingActivityState value matches the provided
+// value.
+func (v PendingActivityState) Equals(rhs PendingActivityState) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes PendingActivityState into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v PendingActivityState) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"SCHEDULED\""), nil
+	case 1:
+		return ([]byte)("\"STARTED\""), nil
+	case 2:
+		return ([]byte)("\"CANCEL_REQUESTED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode PendingActivityState from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *PendingActivityState) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "PendingActivityState")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "PendingActivityState")
+		}
+		*v = (PendingActivityState)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "PendingActivityState")
+	}
+}
+
+type PendingChildExecutionInfo struct {
+	WorkflowID       *string `json:"workflowID,omitempty"`
+	RunID            *string `json:"runID,omitempty"`
+	WorkflowTypeName *string `json:"workflowTypeName,omitempty"`
+	InitiatedID      *int64  `json:"initiatedID,omitempty"`
+	Domain           *string `json:"domain,omitempty"`
+}
+
+// ToWire translates a PendingChildExecutionInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PendingChildExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.LastAccessTime != nil {
-		w, err = wire.NewValueI64(*(v.LastAccessTime)), error(nil)
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RatePerSecond != nil {
-		w, err = wire.NewValueDouble(*(v.RatePerSecond)), error(nil)
+	if v.WorkflowTypeName != nil {
+		w, err = wire.NewValueString(*(v.WorkflowTypeName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.InitiatedID != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a PollerInfo struct from its Thrift-level
+// FromWire deserializes a PendingChildExecutionInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollerInfo struct
+// An error is returned if we were unable to build a PendingChildExecutionInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25288,21 +26532,21 @@ func (v *PollerInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v PollerInfo
+//   var v PendingChildExecutionInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollerInfo) FromWire(w wire.Value) error {
+func (v *PendingChildExecutionInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastAccessTime = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
 				if err != nil {
 					return err
 				}
@@ -25312,17 +26556,37 @@ func (v *PollerInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.RunID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TDouble {
-				var x float64
-				x, err = field.Value.GetDouble(), error(nil)
-				v.RatePerSecond = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowTypeName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
@@ -25334,58 +26598,62 @@ func (v *PollerInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollerInfo
+// String returns a readable string representation of a PendingChildExecutionInfo
 // struct.
-func (v *PollerInfo) String() string {
+func (v *PendingChildExecutionInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
-	if v.LastAccessTime != nil {
-		fields[i] = fmt.Sprintf("LastAccessTime: %v", *(v.LastAccessTime))
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
 		i++
 	}
-	if v.RatePerSecond != nil {
-		fields[i] = fmt.Sprintf("RatePerSecond: %v", *(v.RatePerSecond))
+	if v.WorkflowTypeName != nil {
+		fields[i] = fmt.Sprintf("WorkflowTypeName: %v", *(v.WorkflowTypeName))
 		i++
 	}
-
-	return fmt.Sprintf("PollerInfo{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Double_EqualsPtr(lhs, rhs *float64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	if v.InitiatedID != nil {
+		fields[i] = fmt.Sprintf("InitiatedID: %v", *(v.InitiatedID))
+		i++
 	}
-	return lhs == nil && rhs == nil
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+
+	return fmt.Sprintf("PendingChildExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollerInfo match the
-// provided PollerInfo.
+// Equals returns true if all the fields of this PendingChildExecutionInfo match the
+// provided PendingChildExecutionInfo.
 //
 // This function performs a deep comparison.
-func (v *PollerInfo) Equals(rhs *PollerInfo) bool {
+func (v *PendingChildExecutionInfo) Equals(rhs *PendingChildExecutionInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastAccessTime, rhs.LastAccessTime) {
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
 		return false
 	}
-	if !_Double_EqualsPtr(v.RatePerSecond, rhs.RatePerSecond) {
+	if !_String_EqualsPtr(v.WorkflowTypeName, rhs.WorkflowTypeName) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitiatedID, rhs.InitiatedID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
 
@@ -25393,73 +26661,112 @@ func (v *PollerInfo) Equals(rhs *PollerInfo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollerInfo.
-func (v *PollerInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PendingChildExecutionInfo.
+func (v *PendingChildExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.LastAccessTime != nil {
-		enc.AddInt64("lastAccessTime", *v.LastAccessTime)
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
 	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
 	}
-	if v.RatePerSecond != nil {
-		enc.AddFloat64("ratePerSecond", *v.RatePerSecond)
+	if v.WorkflowTypeName != nil {
+		enc.AddString("workflowTypeName", *v.WorkflowTypeName)
+	}
+	if v.InitiatedID != nil {
+		enc.AddInt64("initiatedID", *v.InitiatedID)
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
 	return err
 }
 
-// GetLastAccessTime returns the value of LastAccessTime if it is set or its
+// GetWorkflowID returns the value of WorkflowID if it is set or its
 // zero value if it is unset.
-func (v *PollerInfo) GetLastAccessTime() (o int64) {
-	if v != nil && v.LastAccessTime != nil {
-		return *v.LastAccessTime
+func (v *PendingChildExecutionInfo) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
 	}
 
 	return
 }
 
-// IsSetLastAccessTime returns true if LastAccessTime is not nil.
-func (v *PollerInfo) IsSetLastAccessTime() bool {
-	return v != nil && v.LastAccessTime != nil
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *PendingChildExecutionInfo) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetRunID returns the value of RunID if it is set or its
 // zero value if it is unset.
-func (v *PollerInfo) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *PendingChildExecutionInfo) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
 	}
 
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *PollerInfo) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+// IsSetRunID returns true if RunID is not nil.
+func (v *PendingChildExecutionInfo) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
 }
 
-// GetRatePerSecond returns the value of RatePerSecond if it is set or its
+// GetWorkflowTypeName returns the value of WorkflowTypeName if it is set or its
 // zero value if it is unset.
-func (v *PollerInfo) GetRatePerSecond() (o float64) {
-	if v != nil && v.RatePerSecond != nil {
-		return *v.RatePerSecond
+func (v *PendingChildExecutionInfo) GetWorkflowTypeName() (o string) {
+	if v != nil && v.WorkflowTypeName != nil {
+		return *v.WorkflowTypeName
 	}
 
 	return
 }
 
-// IsSetRatePerSecond returns true if RatePerSecond is not nil.
-func (v *PollerInfo) IsSetRatePerSecond() bool {
-	return v != nil && v.RatePerSecond != nil
+// IsSetWorkflowTypeName returns true if WorkflowTypeName is not nil.
+func (v *PendingChildExecutionInfo) IsSetWorkflowTypeName() bool {
+	return v != nil && v.WorkflowTypeName != nil
 }
 
-type QueryFailedError struct {
-	Message string `json:"message,required"`
+// GetInitiatedID returns the value of InitiatedID if it is set or its
+// zero value if it is unset.
+func (v *PendingChildExecutionInfo) GetInitiatedID() (o int64) {
+	if v != nil && v.InitiatedID != nil {
+		return *v.InitiatedID
+	}
+
+	return
 }
 
-// ToWire translates a QueryFailedError struct into a Thrift-level intermediate
+// IsSetInitiatedID returns true if InitiatedID is not nil.
+func (v *PendingChildExecutionInfo) IsSetInitiatedID() bool {
+	return v != nil && v.InitiatedID != nil
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *PendingChildExecutionInfo) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *PendingChildExecutionInfo) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+type PendingDecisionInfo struct {
+	State            *PendingDecisionState `json:"state,omitempty"`
+	ScheduledEventId *int64                `json:"scheduledEventId,omitempty"`
+	StartedEventId   *int64                `json:"startedEventId,omitempty"`
+	Attempt          *int64                `json:"attempt,omitempty"`
+}
+
+// ToWire translates a PendingDecisionInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25474,29 +26781,61 @@ type QueryFailedError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *QueryFailedError) ToWire() (wire.Value, error) {
+func (v *PendingDecisionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.State != nil {
+		w, err = v.State.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ScheduledEventId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a QueryFailedError struct from its Thrift-level
+func _PendingDecisionState_Read(w wire.Value) (PendingDecisionState, error) {
+	var v PendingDecisionState
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a PendingDecisionInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a QueryFailedError struct
+// An error is returned if we were unable to build a PendingDecisionInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25504,62 +26843,121 @@ func (v *QueryFailedError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v QueryFailedError
+//   var v PendingDecisionInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *QueryFailedError) FromWire(w wire.Value) error {
+func (v *PendingDecisionInfo) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x PendingDecisionState
+				x, err = _PendingDecisionState_Read(field.Value)
+				v.State = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
-		}
-	}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledEventId = &x
+				if err != nil {
+					return err
+				}
 
-	if !messageIsSet {
-		return errors.New("field Message of QueryFailedError is required")
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a QueryFailedError
+// String returns a readable string representation of a PendingDecisionInfo
 // struct.
-func (v *QueryFailedError) String() string {
+func (v *PendingDecisionInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [4]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+		i++
+	}
+	if v.ScheduledEventId != nil {
+		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
 
-	return fmt.Sprintf("QueryFailedError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("PendingDecisionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this QueryFailedError match the
-// provided QueryFailedError.
+func _PendingDecisionState_EqualsPtr(lhs, rhs *PendingDecisionState) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this PendingDecisionInfo match the
+// provided PendingDecisionInfo.
 //
 // This function performs a deep comparison.
-func (v *QueryFailedError) Equals(rhs *QueryFailedError) bool {
+func (v *PendingDecisionInfo) Equals(rhs *PendingDecisionInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
+	if !_PendingDecisionState_EqualsPtr(v.State, rhs.State) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
 		return false
 	}
 
@@ -25567,171 +26965,229 @@ func (v *QueryFailedError) Equals(rhs *QueryFailedError) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of QueryFailedError.
-func (v *QueryFailedError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PendingDecisionInfo.
+func (v *PendingDecisionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
-	return err
-}
-
-// GetMessage returns the value of Message if it is set or its
-// zero value if it is unset.
-func (v *QueryFailedError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+	if v.State != nil {
+		err = multierr.Append(err, enc.AddObject("state", *v.State))
 	}
-	return
+	if v.ScheduledEventId != nil {
+		enc.AddInt64("scheduledEventId", *v.ScheduledEventId)
+	}
+	if v.StartedEventId != nil {
+		enc.AddInt64("startedEventId", *v.StartedEventId)
+	}
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
+	}
+	return err
 }
 
-func (v *QueryFailedError) Error() string {
-	return v.String()
+// GetState returns the value of State if it is set or its
+// zero value if it is unset.
+func (v *PendingDecisionInfo) GetState() (o PendingDecisionState) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+
+	return
 }
 
-type QueryTaskCompletedType int32
+// IsSetState returns true if State is not nil.
+func (v *PendingDecisionInfo) IsSetState() bool {
+	return v != nil && v.State != nil
+}
+
+// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
+// zero value if it is unset.
+func (v *PendingDecisionInfo) GetScheduledEventId() (o int64) {
+	if v != nil && v.ScheduledEventId != nil {
+		return *v.ScheduledEventId
+	}
+
+	return
+}
+
+// IsSetScheduledEventId returns true if ScheduledEventId is not nil.
+func (v *PendingDecisionInfo) IsSetScheduledEventId() bool {
+	return v != nil && v.ScheduledEventId != nil
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PendingDecisionInfo) GetStartedEventId() (o int64) {
+	if v != nil && v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// IsSetStartedEventId returns true if StartedEventId is not nil.
+func (v *PendingDecisionInfo) IsSetStartedEventId() bool {
+	return v != nil && v.StartedEventId != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *PendingDecisionInfo) GetAttempt() (o int64) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *PendingDecisionInfo) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+type PendingDecisionState int32
 
 const (
-	QueryTaskCompletedTypeCompleted QueryTaskCompletedType = 0
-	QueryTaskCompletedTypeFailed    QueryTaskCompletedType = 1
+	PendingDecisionStateScheduled PendingDecisionState = 0
+	PendingDecisionStateStarted   PendingDecisionState = 1
 )
 
-// QueryTaskCompletedType_Values returns all recognized values of QueryTaskCompletedType.
-func QueryTaskCompletedType_Values() []QueryTaskCompletedType {
-	return []QueryTaskCompletedType{
-		QueryTaskCompletedTypeCompleted,
-		QueryTaskCompletedTypeFailed,
+// PendingDecisionState_Values returns all recognized values of PendingDecisionState.
+func PendingDecisionState_Values() []PendingDecisionState {
+	return []PendingDecisionState{
+		PendingDecisionStateScheduled,
+		PendingDecisionStateStarted,
 	}
 }
 
-// UnmarshalText tries to decode QueryTaskCompletedType from a byte slice
+// UnmarshalText tries to decode PendingDecisionState from a byte slice
 // containing its name.
 //
-//   var v QueryTaskCompletedType
-//   err := v.UnmarshalText([]byte("COMPLETED"))
-func (v *QueryTaskCompletedType) UnmarshalText(value []byte) error {
+//   var v PendingDecisionState
+//   err := v.UnmarshalText([]byte("SCHEDULED"))
+func (v *PendingDecisionState) UnmarshalText(value []byte) error {
 	switch s := string(value); s {
-	case "COMPLETED":
-		*v = QueryTaskCompletedTypeCompleted
+	case "SCHEDULED":
+		*v = PendingDecisionStateScheduled
 		return nil
-	case "FAILED":
-		*v = QueryTaskCompletedTypeFailed
+	case "STARTED":
+		*v = PendingDecisionStateStarted
 		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryTaskCompletedType", err)
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "PendingDecisionState", err)
 		}
-		*v = QueryTaskCompletedType(val)
+		*v = PendingDecisionState(val)
 		return nil
 	}
 }
 
-// MarshalText encodes QueryTaskCompletedType to text.
+// MarshalText encodes PendingDecisionState to text.
 //
 // If the enum value is recognized, its name is returned. Otherwise,
 // its integer value is returned.
 //
 // This implements the TextMarshaler interface.
-func (v QueryTaskCompletedType) MarshalText() ([]byte, error) {
+func (v PendingDecisionState) MarshalText() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return []byte("COMPLETED"), nil
+		return []byte("SCHEDULED"), nil
 	case 1:
-		return []byte("FAILED"), nil
+		return []byte("STARTED"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of QueryTaskCompletedType.
+// fast logging of PendingDecisionState.
 // Enums are logged as objects, where the value is logged with key "value", and
 // if this value's name is known, the name is logged with key "name".
-func (v QueryTaskCompletedType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+func (v PendingDecisionState) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddInt32("value", int32(v))
 	switch int32(v) {
 	case 0:
-		enc.AddString("name", "COMPLETED")
+		enc.AddString("name", "SCHEDULED")
 	case 1:
-		enc.AddString("name", "FAILED")
+		enc.AddString("name", "STARTED")
 	}
 	return nil
 }
 
 // Ptr returns a pointer to this enum value.
-func (v QueryTaskCompletedType) Ptr() *QueryTaskCompletedType {
+func (v PendingDecisionState) Ptr() *PendingDecisionState {
 	return &v
 }
 
-// ToWire translates QueryTaskCompletedType into a Thrift-level intermediate
+// ToWire translates PendingDecisionState into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // Enums are represented as 32-bit integers over the wire.
-func (v QueryTaskCompletedType) ToWire() (wire.Value, error) {
+func (v PendingDecisionState) ToWire() (wire.Value, error) {
 	return wire.NewValueI32(int32(v)), nil
 }
 
-// FromWire deserializes QueryTaskCompletedType from its Thrift-level
+// FromWire deserializes PendingDecisionState from its Thrift-level
 // representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return QueryTaskCompletedType(0), err
+//     return PendingDecisionState(0), err
 //   }
 //
-//   var v QueryTaskCompletedType
+//   var v PendingDecisionState
 //   if err := v.FromWire(x); err != nil {
-//     return QueryTaskCompletedType(0), err
+//     return PendingDecisionState(0), err
 //   }
 //   return v, nil
-func (v *QueryTaskCompletedType) FromWire(w wire.Value) error {
-	*v = (QueryTaskCompletedType)(w.GetI32())
+func (v *PendingDecisionState) FromWire(w wire.Value) error {
+	*v = (PendingDecisionState)(w.GetI32())
 	return nil
 }
 
-// String returns a readable string representation of QueryTaskCompletedType.
-func (v QueryTaskCompletedType) String() string {
+// String returns a readable string representation of PendingDecisionState.
+func (v PendingDecisionState) String() string {
 	w := int32(v)
 	switch w {
 	case 0:
-		return "COMPLETED"
+		return "SCHEDULED"
 	case 1:
-		return "FAILED"
+		return "STARTED"
 	}
-	return fmt.Sprintf("QueryTaskCompletedType(%d)", w)
+	return fmt.Sprintf("PendingDecisionState(%d)", w)
 }
 
-// Equals returns true if this QueryTaskCompletedType value matches the provided
+// Equals returns true if this PendingDecisionState value matches the provided
 // value.
-func (v QueryTaskCompletedType) Equals(rhs QueryTaskCompletedType) bool {
+func (v PendingDecisionState) Equals(rhs PendingDecisionState) bool {
 	return v == rhs
 }
 
-// MarshalJSON serializes QueryTaskCompletedType into JSON.
+// MarshalJSON serializes PendingDecisionState into JSON.
 //
 // If the enum value is recognized, its name is returned. Otherwise,
 // its integer value is returned.
 //
 // This implements json.Marshaler.
-func (v QueryTaskCompletedType) MarshalJSON() ([]byte, error) {
+func (v PendingDecisionState) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return ([]byte)("\"COMPLETED\""), nil
+		return ([]byte)("\"SCHEDULED\""), nil
 	case 1:
-		return ([]byte)("\"FAILED\""), nil
+		return ([]byte)("\"STARTED\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// UnmarshalJSON attempts to decode QueryTaskCompletedType from its JSON
+// UnmarshalJSON attempts to decode PendingDecisionState from its JSON
 // representation.
 //
 // This implementation supports both, numeric and string inputs. If a
 // string is provided, it must be a known enum name.
 //
 // This implements json.Unmarshaler.
-func (v *QueryTaskCompletedType) UnmarshalJSON(text []byte) error {
+func (v *PendingDecisionState) UnmarshalJSON(text []byte) error {
 	d := json.NewDecoder(bytes.NewReader(text))
 	d.UseNumber()
 	t, err := d.Token()
@@ -25746,27 +27202,5086 @@ func (v *QueryTaskCompletedType) UnmarshalJSON(text []byte) error {
 			return err
 		}
 		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "QueryTaskCompletedType")
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "PendingDecisionState")
 		}
 		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "QueryTaskCompletedType")
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "PendingDecisionState")
 		}
-		*v = (QueryTaskCompletedType)(x)
+		*v = (PendingDecisionState)(x)
 		return nil
 	case string:
 		return v.UnmarshalText([]byte(w))
 	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "QueryTaskCompletedType")
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "PendingDecisionState")
+	}
+}
+
+type PendingTimerInfo struct {
+	TimerId         *string `json:"timerId,omitempty"`
+	StartedEventId  *int64  `json:"startedEventId,omitempty"`
+	ExpiryTimestamp *int64  `json:"expiryTimestamp,omitempty"`
+}
+
+// ToWire translates a PendingTimerInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PendingTimerInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TimerId != nil {
+		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ExpiryTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpiryTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PendingTimerInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PendingTimerInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PendingTimerInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PendingTimerInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TimerId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpiryTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PendingTimerInfo
+// struct.
+func (v *PendingTimerInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.TimerId != nil {
+		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.ExpiryTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpiryTimestamp: %v", *(v.ExpiryTimestamp))
+		i++
+	}
+
+	return fmt.Sprintf("PendingTimerInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PendingTimerInfo match the
+// provided PendingTimerInfo.
+//
+// This function performs a deep comparison.
+func (v *PendingTimerInfo) Equals(rhs *PendingTimerInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpiryTimestamp, rhs.ExpiryTimestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PendingTimerInfo.
+func (v *PendingTimerInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TimerId != nil {
+		enc.AddString("timerId", *v.TimerId)
+	}
+	if v.StartedEventId != nil {
+		enc.AddInt64("startedEventId", *v.StartedEventId)
+	}
+	if v.ExpiryTimestamp != nil {
+		enc.AddInt64("expiryTimestamp", *v.ExpiryTimestamp)
+	}
+	return err
+}
+
+// GetTimerId returns the value of TimerId if it is set or its
+// zero value if it is unset.
+func (v *PendingTimerInfo) GetTimerId() (o string) {
+	if v != nil && v.TimerId != nil {
+		return *v.TimerId
+	}
+
+	return
+}
+
+// IsSetTimerId returns true if TimerId is not nil.
+func (v *PendingTimerInfo) IsSetTimerId() bool {
+	return v != nil && v.TimerId != nil
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PendingTimerInfo) GetStartedEventId() (o int64) {
+	if v != nil && v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// IsSetStartedEventId returns true if StartedEventId is not nil.
+func (v *PendingTimerInfo) IsSetStartedEventId() bool {
+	return v != nil && v.StartedEventId != nil
+}
+
+// GetExpiryTimestamp returns the value of ExpiryTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PendingTimerInfo) GetExpiryTimestamp() (o int64) {
+	if v != nil && v.ExpiryTimestamp != nil {
+		return *v.ExpiryTimestamp
+	}
+
+	return
+}
+
+// IsSetExpiryTimestamp returns true if ExpiryTimestamp is not nil.
+func (v *PendingTimerInfo) IsSetExpiryTimestamp() bool {
+	return v != nil && v.ExpiryTimestamp != nil
+}
+
+type PollForActivityTaskRequest struct {
+	Domain           *string           `json:"domain,omitempty"`
+	TaskList         *TaskList         `json:"taskList,omitempty"`
+	Identity         *string           `json:"identity,omitempty"`
+	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+}
+
+// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskListMetadata != nil {
+		w, err = v.TaskListMetadata.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TaskListMetadata_Read(w wire.Value) (*TaskListMetadata, error) {
+	var v TaskListMetadata
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PollForActivityTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForActivityTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForActivityTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskListMetadata, err = _TaskListMetadata_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollForActivityTaskRequest
+// struct.
+func (v *PollForActivityTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.TaskListMetadata != nil {
+		fields[i] = fmt.Sprintf("TaskListMetadata: %v", v.TaskListMetadata)
+		i++
+	}
+
+	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PollForActivityTaskRequest match the
+// provided PollForActivityTaskRequest.
+//
+// This function performs a deep comparison.
+func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !((v.TaskListMetadata == nil && rhs.TaskListMetadata == nil) || (v.TaskListMetadata != nil && rhs.TaskListMetadata != nil && v.TaskListMetadata.Equals(rhs.TaskListMetadata))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForActivityTaskRequest.
+func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.TaskListMetadata != nil {
+		err = multierr.Append(err, enc.AddObject("taskListMetadata", v.TaskListMetadata))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *PollForActivityTaskRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *PollForActivityTaskRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *PollForActivityTaskRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetTaskListMetadata returns the value of TaskListMetadata if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetTaskListMetadata() (o *TaskListMetadata) {
+	if v != nil && v.TaskListMetadata != nil {
+		return v.TaskListMetadata
+	}
+
+	return
+}
+
+// IsSetTaskListMetadata returns true if TaskListMetadata is not nil.
+func (v *PollForActivityTaskRequest) IsSetTaskListMetadata() bool {
+	return v != nil && v.TaskListMetadata != nil
+}
+
+type PollForActivityTaskResponse struct {
+	TaskToken                       []byte             `json:"taskToken,omitempty"`
+	WorkflowExecution               *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityId                      *string            `json:"activityId,omitempty"`
+	ActivityType                    *ActivityType      `json:"activityType,omitempty"`
+	Input                           []byte             `json:"input,omitempty"`
+	ScheduledTimestamp              *int64             `json:"scheduledTimestamp,omitempty"`
+	ScheduleToCloseTimeoutSeconds   *int32             `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	StartedTimestamp                *int64             `json:"startedTimestamp,omitempty"`
+	StartToCloseTimeoutSeconds      *int32             `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds         *int32             `json:"heartbeatTimeoutSeconds,omitempty"`
+	Attempt                         *int32             `json:"attempt,omitempty"`
+	ScheduledTimestampOfThisAttempt *int64             `json:"scheduledTimestampOfThisAttempt,omitempty"`
+	HeartbeatDetails                []byte             `json:"heartbeatDetails,omitempty"`
+	WorkflowType                    *WorkflowType      `json:"workflowType,omitempty"`
+	WorkflowDomain                  *string            `json:"workflowDomain,omitempty"`
+	CurrentTimestamp                *int64             `json:"currentTimestamp,omitempty"`
+	ScheduleToCloseDeadline         *int64             `json:"scheduleToCloseDeadline,omitempty"`
+	StartToCloseDeadline            *int64             `json:"startToCloseDeadline,omitempty"`
+}
+
+// ToWire translates a PollForActivityTaskResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForActivityTaskResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [18]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ActivityType != nil {
+		w, err = v.ActivityType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.HeartbeatTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.HeartbeatTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestampOfThisAttempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.HeartbeatDetails != nil {
+		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.WorkflowDomain != nil {
+		w, err = wire.NewValueString(*(v.WorkflowDomain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+	if v.CurrentTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.CurrentTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
+	if v.ScheduleToCloseDeadline != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleToCloseDeadline)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+	if v.StartToCloseDeadline != nil {
+		w, err = wire.NewValueI64(*(v.StartToCloseDeadline)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PollForActivityTaskResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForActivityTaskResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForActivityTaskResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForActivityTaskResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityType, err = _ActivityType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.HeartbeatTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestampOfThisAttempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TBinary {
+				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 150:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowDomain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 170:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CurrentTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 180:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleToCloseDeadline = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 190:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartToCloseDeadline = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollForActivityTaskResponse
+// struct.
+func (v *PollForActivityTaskResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [18]string
+	i := 0
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+		i++
+	}
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+		i++
+	}
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToCloseTimeoutSeconds: %v", *(v.ScheduleToCloseTimeoutSeconds))
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.HeartbeatTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("HeartbeatTimeoutSeconds: %v", *(v.HeartbeatTimeoutSeconds))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestampOfThisAttempt: %v", *(v.ScheduledTimestampOfThisAttempt))
+		i++
+	}
+	if v.HeartbeatDetails != nil {
+		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
+		i++
+	}
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.WorkflowDomain != nil {
+		fields[i] = fmt.Sprintf("WorkflowDomain: %v", *(v.WorkflowDomain))
+		i++
+	}
+	if v.CurrentTimestamp != nil {
+		fields[i] = fmt.Sprintf("CurrentTimestamp: %v", *(v.CurrentTimestamp))
+		i++
+	}
+	if v.ScheduleToCloseDeadline != nil {
+		fields[i] = fmt.Sprintf("ScheduleToCloseDeadline: %v", *(v.ScheduleToCloseDeadline))
+		i++
+	}
+	if v.StartToCloseDeadline != nil {
+		fields[i] = fmt.Sprintf("StartToCloseDeadline: %v", *(v.StartToCloseDeadline))
+		i++
+	}
+
+	return fmt.Sprintf("PollForActivityTaskResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PollForActivityTaskResponse match the
+// provided PollForActivityTaskResponse.
+//
+// This function performs a deep comparison.
+func (v *PollForActivityTaskResponse) Equals(rhs *PollForActivityTaskResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+		return false
+	}
+	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToCloseTimeoutSeconds, rhs.ScheduleToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.HeartbeatTimeoutSeconds, rhs.HeartbeatTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimestampOfThisAttempt, rhs.ScheduledTimestampOfThisAttempt) {
+		return false
+	}
+	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
+		return false
+	}
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowDomain, rhs.WorkflowDomain) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CurrentTimestamp, rhs.CurrentTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleToCloseDeadline, rhs.ScheduleToCloseDeadline) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartToCloseDeadline, rhs.StartToCloseDeadline) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForActivityTaskResponse.
+func (v *PollForActivityTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskToken != nil {
+		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.ActivityId != nil {
+		enc.AddString("activityId", *v.ActivityId)
+	}
+	if v.ActivityType != nil {
+		err = multierr.Append(err, enc.AddObject("activityType", v.ActivityType))
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
+	}
+	if v.ScheduledTimestamp != nil {
+		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
+	}
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		enc.AddInt32("scheduleToCloseTimeoutSeconds", *v.ScheduleToCloseTimeoutSeconds)
+	}
+	if v.StartedTimestamp != nil {
+		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		enc.AddInt32("startToCloseTimeoutSeconds", *v.StartToCloseTimeoutSeconds)
+	}
+	if v.HeartbeatTimeoutSeconds != nil {
+		enc.AddInt32("heartbeatTimeoutSeconds", *v.HeartbeatTimeoutSeconds)
+	}
+	if v.Attempt != nil {
+		enc.AddInt32("attempt", *v.Attempt)
+	}
+	if v.ScheduledTimestampOfThisAttempt != nil {
+		enc.AddInt64("scheduledTimestampOfThisAttempt", *v.ScheduledTimestampOfThisAttempt)
+	}
+	if v.HeartbeatDetails != nil {
+		enc.AddString("heartbeatDetails", base64.StdEncoding.EncodeToString(v.HeartbeatDetails))
+	}
+	if v.WorkflowType != nil {
+		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	}
+	if v.WorkflowDomain != nil {
+		enc.AddString("workflowDomain", *v.WorkflowDomain)
+	}
+	if v.CurrentTimestamp != nil {
+		enc.AddInt64("currentTimestamp", *v.CurrentTimestamp)
+	}
+	if v.ScheduleToCloseDeadline != nil {
+		enc.AddInt64("scheduleToCloseDeadline", *v.ScheduleToCloseDeadline)
+	}
+	if v.StartToCloseDeadline != nil {
+		enc.AddInt64("startToCloseDeadline", *v.StartToCloseDeadline)
+	}
+	return err
+}
+
+// GetTaskToken returns the value of TaskToken if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
+	}
+
+	return
+}
+
+// IsSetTaskToken returns true if TaskToken is not nil.
+func (v *PollForActivityTaskResponse) IsSetTaskToken() bool {
+	return v != nil && v.TaskToken != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *PollForActivityTaskResponse) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetActivityId returns the value of ActivityId if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetActivityId() (o string) {
+	if v != nil && v.ActivityId != nil {
+		return *v.ActivityId
+	}
+
+	return
+}
+
+// IsSetActivityId returns true if ActivityId is not nil.
+func (v *PollForActivityTaskResponse) IsSetActivityId() bool {
+	return v != nil && v.ActivityId != nil
+}
+
+// GetActivityType returns the value of ActivityType if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetActivityType() (o *ActivityType) {
+	if v != nil && v.ActivityType != nil {
+		return v.ActivityType
+	}
+
+	return
+}
+
+// IsSetActivityType returns true if ActivityType is not nil.
+func (v *PollForActivityTaskResponse) IsSetActivityType() bool {
+	return v != nil && v.ActivityType != nil
+}
+
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
+}
+
+// IsSetInput returns true if Input is not nil.
+func (v *PollForActivityTaskResponse) IsSetInput() bool {
+	return v != nil && v.Input != nil
+}
+
+// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetScheduledTimestamp() (o int64) {
+	if v != nil && v.ScheduledTimestamp != nil {
+		return *v.ScheduledTimestamp
+	}
+
+	return
+}
+
+// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
+func (v *PollForActivityTaskResponse) IsSetScheduledTimestamp() bool {
+	return v != nil && v.ScheduledTimestamp != nil
+}
+
+// GetScheduleToCloseTimeoutSeconds returns the value of ScheduleToCloseTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetScheduleToCloseTimeoutSeconds returns true if ScheduleToCloseTimeoutSeconds is not nil.
+func (v *PollForActivityTaskResponse) IsSetScheduleToCloseTimeoutSeconds() bool {
+	return v != nil && v.ScheduleToCloseTimeoutSeconds != nil
+}
+
+// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetStartedTimestamp() (o int64) {
+	if v != nil && v.StartedTimestamp != nil {
+		return *v.StartedTimestamp
+	}
+
+	return
+}
+
+// IsSetStartedTimestamp returns true if StartedTimestamp is not nil.
+func (v *PollForActivityTaskResponse) IsSetStartedTimestamp() bool {
+	return v != nil && v.StartedTimestamp != nil
+}
+
+// GetStartToCloseTimeoutSeconds returns the value of StartToCloseTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetStartToCloseTimeoutSeconds returns true if StartToCloseTimeoutSeconds is not nil.
+func (v *PollForActivityTaskResponse) IsSetStartToCloseTimeoutSeconds() bool {
+	return v != nil && v.StartToCloseTimeoutSeconds != nil
+}
+
+// GetHeartbeatTimeoutSeconds returns the value of HeartbeatTimeoutSeconds if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
+	}
+
+	return
+}
+
+// IsSetHeartbeatTimeoutSeconds returns true if HeartbeatTimeoutSeconds is not nil.
+func (v *PollForActivityTaskResponse) IsSetHeartbeatTimeoutSeconds() bool {
+	return v != nil && v.HeartbeatTimeoutSeconds != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetAttempt() (o int32) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *PollForActivityTaskResponse) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetScheduledTimestampOfThisAttempt returns the value of ScheduledTimestampOfThisAttempt if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetScheduledTimestampOfThisAttempt() (o int64) {
+	if v != nil && v.ScheduledTimestampOfThisAttempt != nil {
+		return *v.ScheduledTimestampOfThisAttempt
+	}
+
+	return
+}
+
+// IsSetScheduledTimestampOfThisAttempt returns true if ScheduledTimestampOfThisAttempt is not nil.
+func (v *PollForActivityTaskResponse) IsSetScheduledTimestampOfThisAttempt() bool {
+	return v != nil && v.ScheduledTimestampOfThisAttempt != nil
+}
+
+// GetHeartbeatDetails returns the value of HeartbeatDetails if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetHeartbeatDetails() (o []byte) {
+	if v != nil && v.HeartbeatDetails != nil {
+		return v.HeartbeatDetails
+	}
+
+	return
+}
+
+// IsSetHeartbeatDetails returns true if HeartbeatDetails is not nil.
+func (v *PollForActivityTaskResponse) IsSetHeartbeatDetails() bool {
+	return v != nil && v.HeartbeatDetails != nil
+}
+
+// GetWorkflowType returns the value of WorkflowType if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+
+	return
+}
+
+// IsSetWorkflowType returns true if WorkflowType is not nil.
+func (v *PollForActivityTaskResponse) IsSetWorkflowType() bool {
+	return v != nil && v.WorkflowType != nil
+}
+
+// GetWorkflowDomain returns the value of WorkflowDomain if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetWorkflowDomain() (o string) {
+	if v != nil && v.WorkflowDomain != nil {
+		return *v.WorkflowDomain
+	}
+
+	return
+}
+
+// IsSetWorkflowDomain returns true if WorkflowDomain is not nil.
+func (v *PollForActivityTaskResponse) IsSetWorkflowDomain() bool {
+	return v != nil && v.WorkflowDomain != nil
+}
+
+// GetCurrentTimestamp returns the value of CurrentTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetCurrentTimestamp() (o int64) {
+	if v != nil && v.CurrentTimestamp != nil {
+		return *v.CurrentTimestamp
+	}
+
+	return
+}
+
+// IsSetCurrentTimestamp returns true if CurrentTimestamp is not nil.
+func (v *PollForActivityTaskResponse) IsSetCurrentTimestamp() bool {
+	return v != nil && v.CurrentTimestamp != nil
+}
+
+// GetScheduleToCloseDeadline returns the value of ScheduleToCloseDeadline if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetScheduleToCloseDeadline() (o int64) {
+	if v != nil && v.ScheduleToCloseDeadline != nil {
+		return *v.ScheduleToCloseDeadline
+	}
+
+	return
+}
+
+// IsSetScheduleToCloseDeadline returns true if ScheduleToCloseDeadline is not nil.
+func (v *PollForActivityTaskResponse) IsSetScheduleToCloseDeadline() bool {
+	return v != nil && v.ScheduleToCloseDeadline != nil
+}
+
+// GetStartToCloseDeadline returns the value of StartToCloseDeadline if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskResponse) GetStartToCloseDeadline() (o int64) {
+	if v != nil && v.StartToCloseDeadline != nil {
+		return *v.StartToCloseDeadline
+	}
+
+	return
+}
+
+// IsSetStartToCloseDeadline returns true if StartToCloseDeadline is not nil.
+func (v *PollForActivityTaskResponse) IsSetStartToCloseDeadline() bool {
+	return v != nil && v.StartToCloseDeadline != nil
+}
+
+type PollForDecisionTaskRequest struct {
+	Domain                       *string   `json:"domain,omitempty"`
+	TaskList                     *TaskList `json:"taskList,omitempty"`
+	Identity                     *string   `json:"identity,omitempty"`
+	IncludeWorkflowStateSnapshot *bool     `json:"includeWorkflowStateSnapshot,omitempty"`
+}
+
+// ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.IncludeWorkflowStateSnapshot != nil {
+		w, err = wire.NewValueBool(*(v.IncludeWorkflowStateSnapshot)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForDecisionTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForDecisionTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IncludeWorkflowStateSnapshot = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollForDecisionTaskRequest
+// struct.
+func (v *PollForDecisionTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.IncludeWorkflowStateSnapshot != nil {
+		fields[i] = fmt.Sprintf("IncludeWorkflowStateSnapshot: %v", *(v.IncludeWorkflowStateSnapshot))
+		i++
+	}
+
+	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PollForDecisionTaskRequest match the
+// provided PollForDecisionTaskRequest.
+//
+// This function performs a deep comparison.
+func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IncludeWorkflowStateSnapshot, rhs.IncludeWorkflowStateSnapshot) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForDecisionTaskRequest.
+func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.IncludeWorkflowStateSnapshot != nil {
+		enc.AddBool("includeWorkflowStateSnapshot", *v.IncludeWorkflowStateSnapshot)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *PollForDecisionTaskRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *PollForDecisionTaskRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *PollForDecisionTaskRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetIncludeWorkflowStateSnapshot returns the value of IncludeWorkflowStateSnapshot if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetIncludeWorkflowStateSnapshot() (o bool) {
+	if v != nil && v.IncludeWorkflowStateSnapshot != nil {
+		return *v.IncludeWorkflowStateSnapshot
+	}
+
+	return
+}
+
+// IsSetIncludeWorkflowStateSnapshot returns true if IncludeWorkflowStateSnapshot is not nil.
+func (v *PollForDecisionTaskRequest) IsSetIncludeWorkflowStateSnapshot() bool {
+	return v != nil && v.IncludeWorkflowStateSnapshot != nil
+}
+
+type PollForDecisionTaskResponse struct {
+	TaskToken                 []byte                 `json:"taskToken,omitempty"`
+	WorkflowExecution         *WorkflowExecution     `json:"workflowExecution,omitempty"`
+	WorkflowType              *WorkflowType          `json:"workflowType,omitempty"`
+	PreviousStartedEventId    *int64                 `json:"previousStartedEventId,omitempty"`
+	StartedEventId            *int64                 `json:"startedEventId,omitempty"`
+	Attempt                   *int64                 `json:"attempt,omitempty"`
+	BacklogCountHint          *int64                 `json:"backlogCountHint,omitempty"`
+	History                   *History               `json:"history,omitempty"`
+	NextPageToken             []byte                 `json:"nextPageToken,omitempty"`
+	Query                     *WorkflowQuery         `json:"query,omitempty"`
+	WorkflowExecutionTaskList *TaskList              `json:"WorkflowExecutionTaskList,omitempty"`
+	WorkflowStateSnapshot     *WorkflowStateSnapshot `json:"workflowStateSnapshot,omitempty"`
+	ContinueAsNewSuggested    *bool                  `json:"continueAsNewSuggested,omitempty"`
+}
+
+// ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [13]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 51, Value: w}
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 54, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = v.Query.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		w, err = v.WorkflowExecutionTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.WorkflowStateSnapshot != nil {
+		w, err = v.WorkflowStateSnapshot.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.ContinueAsNewSuggested != nil {
+		w, err = wire.NewValueBool(*(v.ContinueAsNewSuggested)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowQuery_Read(w wire.Value) (*WorkflowQuery, error) {
+	var v WorkflowQuery
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowStateSnapshot_Read(w wire.Value) (*WorkflowStateSnapshot, error) {
+	var v WorkflowStateSnapshot
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForDecisionTaskResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForDecisionTaskResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PreviousStartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 51:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 54:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.BacklogCountHint = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.Query, err = _WorkflowQuery_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowStateSnapshot, err = _WorkflowStateSnapshot_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ContinueAsNewSuggested = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollForDecisionTaskResponse
+// struct.
+func (v *PollForDecisionTaskResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [13]string
+	i := 0
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+		i++
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
+		i++
+	}
+	if v.WorkflowStateSnapshot != nil {
+		fields[i] = fmt.Sprintf("WorkflowStateSnapshot: %v", v.WorkflowStateSnapshot)
+		i++
+	}
+	if v.ContinueAsNewSuggested != nil {
+		fields[i] = fmt.Sprintf("ContinueAsNewSuggested: %v", *(v.ContinueAsNewSuggested))
+		i++
+	}
+
+	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PollForDecisionTaskResponse match the
+// provided PollForDecisionTaskResponse.
+//
+// This function performs a deep comparison.
+func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
+		return false
+	}
+	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
+		return false
+	}
+	if !((v.WorkflowStateSnapshot == nil && rhs.WorkflowStateSnapshot == nil) || (v.WorkflowStateSnapshot != nil && rhs.WorkflowStateSnapshot != nil && v.WorkflowStateSnapshot.Equals(rhs.WorkflowStateSnapshot))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ContinueAsNewSuggested, rhs.ContinueAsNewSuggested) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForDecisionTaskResponse.
+func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskToken != nil {
+		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.WorkflowType != nil {
+		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	}
+	if v.PreviousStartedEventId != nil {
+		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
+	}
+	if v.StartedEventId != nil {
+		enc.AddInt64("startedEventId", *v.StartedEventId)
+	}
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
+	}
+	if v.BacklogCountHint != nil {
+		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddObject("history", v.History))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.Query != nil {
+		err = multierr.Append(err, enc.AddObject("query", v.Query))
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
+	}
+	if v.WorkflowStateSnapshot != nil {
+		err = multierr.Append(err, enc.AddObject("workflowStateSnapshot", v.WorkflowStateSnapshot))
+	}
+	if v.ContinueAsNewSuggested != nil {
+		enc.AddBool("continueAsNewSuggested", *v.ContinueAsNewSuggested)
+	}
+	return err
+}
+
+// GetTaskToken returns the value of TaskToken if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
+	}
+
+	return
+}
+
+// IsSetTaskToken returns true if TaskToken is not nil.
+func (v *PollForDecisionTaskResponse) IsSetTaskToken() bool {
+	return v != nil && v.TaskToken != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetWorkflowType returns the value of WorkflowType if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+
+	return
+}
+
+// IsSetWorkflowType returns true if WorkflowType is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
+	return v != nil && v.WorkflowType != nil
+}
+
+// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetPreviousStartedEventId() (o int64) {
+	if v != nil && v.PreviousStartedEventId != nil {
+		return *v.PreviousStartedEventId
+	}
+
+	return
+}
+
+// IsSetPreviousStartedEventId returns true if PreviousStartedEventId is not nil.
+func (v *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
+	return v != nil && v.PreviousStartedEventId != nil
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStartedEventId() (o int64) {
+	if v != nil && v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// IsSetStartedEventId returns true if StartedEventId is not nil.
+func (v *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
+	return v != nil && v.StartedEventId != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *PollForDecisionTaskResponse) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
+	if v != nil && v.BacklogCountHint != nil {
+		return *v.BacklogCountHint
+	}
+
+	return
+}
+
+// IsSetBacklogCountHint returns true if BacklogCountHint is not nil.
+func (v *PollForDecisionTaskResponse) IsSetBacklogCountHint() bool {
+	return v != nil && v.BacklogCountHint != nil
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetHistory() (o *History) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// IsSetHistory returns true if History is not nil.
+func (v *PollForDecisionTaskResponse) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *PollForDecisionTaskResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+// GetQuery returns the value of Query if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetQuery() (o *WorkflowQuery) {
+	if v != nil && v.Query != nil {
+		return v.Query
+	}
+
+	return
+}
+
+// IsSetQuery returns true if Query is not nil.
+func (v *PollForDecisionTaskResponse) IsSetQuery() bool {
+	return v != nil && v.Query != nil
+}
+
+// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *TaskList) {
+	if v != nil && v.WorkflowExecutionTaskList != nil {
+		return v.WorkflowExecutionTaskList
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionTaskList returns true if WorkflowExecutionTaskList is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowExecutionTaskList() bool {
+	return v != nil && v.WorkflowExecutionTaskList != nil
+}
+
+// GetWorkflowStateSnapshot returns the value of WorkflowStateSnapshot if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowStateSnapshot() (o *WorkflowStateSnapshot) {
+	if v != nil && v.WorkflowStateSnapshot != nil {
+		return v.WorkflowStateSnapshot
+	}
+
+	return
+}
+
+// IsSetWorkflowStateSnapshot returns true if WorkflowStateSnapshot is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowStateSnapshot() bool {
+	return v != nil && v.WorkflowStateSnapshot != nil
+}
+
+// GetContinueAsNewSuggested returns the value of ContinueAsNewSuggested if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetContinueAsNewSuggested() (o bool) {
+	if v != nil && v.ContinueAsNewSuggested != nil {
+		return *v.ContinueAsNewSuggested
+	}
+
+	return
+}
+
+// IsSetContinueAsNewSuggested returns true if ContinueAsNewSuggested is not nil.
+func (v *PollForDecisionTaskResponse) IsSetContinueAsNewSuggested() bool {
+	return v != nil && v.ContinueAsNewSuggested != nil
+}
+
+type PollerInfo struct {
+	LastAccessTime *int64   `json:"lastAccessTime,omitempty"`
+	Identity       *string  `json:"identity,omitempty"`
+	RatePerSecond  *float64 `json:"ratePerSecond,omitempty"`
+}
+
+// ToWire translates a PollerInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollerInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.LastAccessTime != nil {
+		w, err = wire.NewValueI64(*(v.LastAccessTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.RatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PollerInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollerInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollerInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollerInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastAccessTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.RatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollerInfo
+// struct.
+func (v *PollerInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.LastAccessTime != nil {
+		fields[i] = fmt.Sprintf("LastAccessTime: %v", *(v.LastAccessTime))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.RatePerSecond != nil {
+		fields[i] = fmt.Sprintf("RatePerSecond: %v", *(v.RatePerSecond))
+		i++
+	}
+
+	return fmt.Sprintf("PollerInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this PollerInfo match the
+// provided PollerInfo.
+//
+// This function performs a deep comparison.
+func (v *PollerInfo) Equals(rhs *PollerInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastAccessTime, rhs.LastAccessTime) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.RatePerSecond, rhs.RatePerSecond) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollerInfo.
+func (v *PollerInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.LastAccessTime != nil {
+		enc.AddInt64("lastAccessTime", *v.LastAccessTime)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.RatePerSecond != nil {
+		enc.AddFloat64("ratePerSecond", *v.RatePerSecond)
+	}
+	return err
+}
+
+// GetLastAccessTime returns the value of LastAccessTime if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetLastAccessTime() (o int64) {
+	if v != nil && v.LastAccessTime != nil {
+		return *v.LastAccessTime
+	}
+
+	return
+}
+
+// IsSetLastAccessTime returns true if LastAccessTime is not nil.
+func (v *PollerInfo) IsSetLastAccessTime() bool {
+	return v != nil && v.LastAccessTime != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *PollerInfo) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetRatePerSecond returns the value of RatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetRatePerSecond() (o float64) {
+	if v != nil && v.RatePerSecond != nil {
+		return *v.RatePerSecond
+	}
+
+	return
+}
+
+// IsSetRatePerSecond returns true if RatePerSecond is not nil.
+func (v *PollerInfo) IsSetRatePerSecond() bool {
+	return v != nil && v.RatePerSecond != nil
+}
+
+type PurgeReplicationDLQRequest struct {
+	ShardID            *int32  `json:"shardID,omitempty"`
+	SourceCluster      *string `json:"sourceCluster,omitempty"`
+	InclusiveEndTaskID *int64  `json:"inclusiveEndTaskID,omitempty"`
+}
+
+// ToWire translates a PurgeReplicationDLQRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PurgeReplicationDLQRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.InclusiveEndTaskID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndTaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PurgeReplicationDLQRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PurgeReplicationDLQRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PurgeReplicationDLQRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PurgeReplicationDLQRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndTaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PurgeReplicationDLQRequest
+// struct.
+func (v *PurgeReplicationDLQRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.InclusiveEndTaskID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndTaskID: %v", *(v.InclusiveEndTaskID))
+		i++
+	}
+
+	return fmt.Sprintf("PurgeReplicationDLQRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PurgeReplicationDLQRequest match the
+// provided PurgeReplicationDLQRequest.
+//
+// This function performs a deep comparison.
+func (v *PurgeReplicationDLQRequest) Equals(rhs *PurgeReplicationDLQRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndTaskID, rhs.InclusiveEndTaskID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PurgeReplicationDLQRequest.
+func (v *PurgeReplicationDLQRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.InclusiveEndTaskID != nil {
+		enc.AddInt64("inclusiveEndTaskID", *v.InclusiveEndTaskID)
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *PurgeReplicationDLQRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *PurgeReplicationDLQRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *PurgeReplicationDLQRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *PurgeReplicationDLQRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetInclusiveEndTaskID returns the value of InclusiveEndTaskID if it is set or its
+// zero value if it is unset.
+func (v *PurgeReplicationDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndTaskID returns true if InclusiveEndTaskID is not nil.
+func (v *PurgeReplicationDLQRequest) IsSetInclusiveEndTaskID() bool {
+	return v != nil && v.InclusiveEndTaskID != nil
+}
+
+type PurgeTransferDLQRequest struct {
+	ShardID            *int32 `json:"shardID,omitempty"`
+	InclusiveEndTaskID *int64 `json:"inclusiveEndTaskID,omitempty"`
+}
+
+// ToWire translates a PurgeTransferDLQRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PurgeTransferDLQRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.InclusiveEndTaskID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndTaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PurgeTransferDLQRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PurgeTransferDLQRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PurgeTransferDLQRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PurgeTransferDLQRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndTaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PurgeTransferDLQRequest
+// struct.
+func (v *PurgeTransferDLQRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.InclusiveEndTaskID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndTaskID: %v", *(v.InclusiveEndTaskID))
+		i++
+	}
+
+	return fmt.Sprintf("PurgeTransferDLQRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PurgeTransferDLQRequest match the
+// provided PurgeTransferDLQRequest.
+//
+// This function performs a deep comparison.
+func (v *PurgeTransferDLQRequest) Equals(rhs *PurgeTransferDLQRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndTaskID, rhs.InclusiveEndTaskID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PurgeTransferDLQRequest.
+func (v *PurgeTransferDLQRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.InclusiveEndTaskID != nil {
+		enc.AddInt64("inclusiveEndTaskID", *v.InclusiveEndTaskID)
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *PurgeTransferDLQRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *PurgeTransferDLQRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetInclusiveEndTaskID returns the value of InclusiveEndTaskID if it is set or its
+// zero value if it is unset.
+func (v *PurgeTransferDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndTaskID returns true if InclusiveEndTaskID is not nil.
+func (v *PurgeTransferDLQRequest) IsSetInclusiveEndTaskID() bool {
+	return v != nil && v.InclusiveEndTaskID != nil
+}
+
+type QueryFailedError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a QueryFailedError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *QueryFailedError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a QueryFailedError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a QueryFailedError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v QueryFailedError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *QueryFailedError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of QueryFailedError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a QueryFailedError
+// struct.
+func (v *QueryFailedError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("QueryFailedError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this QueryFailedError match the
+// provided QueryFailedError.
+//
+// This function performs a deep comparison.
+func (v *QueryFailedError) Equals(rhs *QueryFailedError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of QueryFailedError.
+func (v *QueryFailedError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *QueryFailedError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+func (v *QueryFailedError) Error() string {
+	return v.String()
+}
+
+type QueryTaskCompletedType int32
+
+const (
+	QueryTaskCompletedTypeCompleted QueryTaskCompletedType = 0
+	QueryTaskCompletedTypeFailed    QueryTaskCompletedType = 1
+)
+
+// QueryTaskCompletedType_Values returns all recognized values of QueryTaskCompletedType.
+func QueryTaskCompletedType_Values() []QueryTaskCompletedType {
+	return []QueryTaskCompletedType{
+		QueryTaskCompletedTypeCompleted,
+		QueryTaskCompletedTypeFailed,
+	}
+}
+
+// UnmarshalText tries to decode QueryTaskCompletedType from a byte slice
+// containing its name.
+//
+//   var v QueryTaskCompletedType
+//   err := v.UnmarshalText([]byte("COMPLETED"))
+func (v *QueryTaskCompletedType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "COMPLETED":
+		*v = QueryTaskCompletedTypeCompleted
+		return nil
+	case "FAILED":
+		*v = QueryTaskCompletedTypeFailed
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "QueryTaskCompletedType", err)
+		}
+		*v = QueryTaskCompletedType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes QueryTaskCompletedType to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v QueryTaskCompletedType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("COMPLETED"), nil
+	case 1:
+		return []byte("FAILED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of QueryTaskCompletedType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v QueryTaskCompletedType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "COMPLETED")
+	case 1:
+		enc.AddString("name", "FAILED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v QueryTaskCompletedType) Ptr() *QueryTaskCompletedType {
+	return &v
+}
+
+// ToWire translates QueryTaskCompletedType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v QueryTaskCompletedType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes QueryTaskCompletedType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return QueryTaskCompletedType(0), err
+//   }
+//
+//   var v QueryTaskCompletedType
+//   if err := v.FromWire(x); err != nil {
+//     return QueryTaskCompletedType(0), err
+//   }
+//   return v, nil
+func (v *QueryTaskCompletedType) FromWire(w wire.Value) error {
+	*v = (QueryTaskCompletedType)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of QueryTaskCompletedType.
+func (v QueryTaskCompletedType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "COMPLETED"
+	case 1:
+		return "FAILED"
+	}
+	return fmt.Sprintf("QueryTaskCompletedType(%d)", w)
+}
+
+// Equals returns true if this QueryTaskCompletedType value matches the provided
+// value.
+func (v QueryTaskCompletedType) Equals(rhs QueryTaskCompletedType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes QueryTaskCompletedType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v QueryTaskCompletedType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"COMPLETED\""), nil
+	case 1:
+		return ([]byte)("\"FAILED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode QueryTaskCompletedType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *QueryTaskCompletedType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "QueryTaskCompletedType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "QueryTaskCompletedType")
+		}
+		*v = (QueryTaskCompletedType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "QueryTaskCompletedType")
+	}
+}
+
+type QueryWorkflowRequest struct {
+	Domain    *string            `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+	Query     *WorkflowQuery     `json:"query,omitempty"`
+}
+
+// ToWire translates a QueryWorkflowRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = v.Query.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a QueryWorkflowRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a QueryWorkflowRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v QueryWorkflowRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Query, err = _WorkflowQuery_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a QueryWorkflowRequest
+// struct.
+func (v *QueryWorkflowRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+		i++
+	}
+
+	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this QueryWorkflowRequest match the
+// provided QueryWorkflowRequest.
+//
+// This function performs a deep comparison.
+func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of QueryWorkflowRequest.
+func (v *QueryWorkflowRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.Query != nil {
+		err = multierr.Append(err, enc.AddObject("query", v.Query))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *QueryWorkflowRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *QueryWorkflowRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetQuery returns the value of Query if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetQuery() (o *WorkflowQuery) {
+	if v != nil && v.Query != nil {
+		return v.Query
+	}
+
+	return
+}
+
+// IsSetQuery returns true if Query is not nil.
+func (v *QueryWorkflowRequest) IsSetQuery() bool {
+	return v != nil && v.Query != nil
+}
+
+type QueryWorkflowResponse struct {
+	QueryResult []byte `json:"queryResult,omitempty"`
+}
+
+// ToWire translates a QueryWorkflowResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *QueryWorkflowResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.QueryResult != nil {
+		w, err = wire.NewValueBinary(v.QueryResult), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a QueryWorkflowResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a QueryWorkflowResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v QueryWorkflowResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *QueryWorkflowResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.QueryResult, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a QueryWorkflowResponse
+// struct.
+func (v *QueryWorkflowResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.QueryResult != nil {
+		fields[i] = fmt.Sprintf("QueryResult: %v", v.QueryResult)
+		i++
+	}
+
+	return fmt.Sprintf("QueryWorkflowResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this QueryWorkflowResponse match the
+// provided QueryWorkflowResponse.
+//
+// This function performs a deep comparison.
+func (v *QueryWorkflowResponse) Equals(rhs *QueryWorkflowResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.QueryResult == nil && rhs.QueryResult == nil) || (v.QueryResult != nil && rhs.QueryResult != nil && bytes.Equal(v.QueryResult, rhs.QueryResult))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of QueryWorkflowResponse.
+func (v *QueryWorkflowResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.QueryResult != nil {
+		enc.AddString("queryResult", base64.StdEncoding.EncodeToString(v.QueryResult))
+	}
+	return err
+}
+
+// GetQueryResult returns the value of QueryResult if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowResponse) GetQueryResult() (o []byte) {
+	if v != nil && v.QueryResult != nil {
+		return v.QueryResult
+	}
+
+	return
+}
+
+// IsSetQueryResult returns true if QueryResult is not nil.
+func (v *QueryWorkflowResponse) IsSetQueryResult() bool {
+	return v != nil && v.QueryResult != nil
+}
+
+type ReadReplicationDLQRequest struct {
+	ShardID            *int32  `json:"shardID,omitempty"`
+	SourceCluster      *string `json:"sourceCluster,omitempty"`
+	InclusiveEndTaskID *int64  `json:"inclusiveEndTaskID,omitempty"`
+	MaximumPageSize    *int32  `json:"maximumPageSize,omitempty"`
+	NextPageToken      []byte  `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a ReadReplicationDLQRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReadReplicationDLQRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.InclusiveEndTaskID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndTaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReadReplicationDLQRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReadReplicationDLQRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReadReplicationDLQRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReadReplicationDLQRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndTaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReadReplicationDLQRequest
+// struct.
+func (v *ReadReplicationDLQRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.InclusiveEndTaskID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndTaskID: %v", *(v.InclusiveEndTaskID))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ReadReplicationDLQRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReadReplicationDLQRequest match the
+// provided ReadReplicationDLQRequest.
+//
+// This function performs a deep comparison.
+func (v *ReadReplicationDLQRequest) Equals(rhs *ReadReplicationDLQRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndTaskID, rhs.InclusiveEndTaskID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReadReplicationDLQRequest.
+func (v *ReadReplicationDLQRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.InclusiveEndTaskID != nil {
+		enc.AddInt64("inclusiveEndTaskID", *v.InclusiveEndTaskID)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ReadReplicationDLQRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReadReplicationDLQRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *ReadReplicationDLQRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *ReadReplicationDLQRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetInclusiveEndTaskID returns the value of InclusiveEndTaskID if it is set or its
+// zero value if it is unset.
+func (v *ReadReplicationDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndTaskID returns true if InclusiveEndTaskID is not nil.
+func (v *ReadReplicationDLQRequest) IsSetInclusiveEndTaskID() bool {
+	return v != nil && v.InclusiveEndTaskID != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *ReadReplicationDLQRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *ReadReplicationDLQRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadReplicationDLQRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadReplicationDLQRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ReadReplicationDLQResponse struct {
+	Tasks         []*ReplicationDLQTask `json:"tasks,omitempty"`
+	NextPageToken []byte                `json:"nextPageToken,omitempty"`
+}
+
+type _List_ReplicationDLQTask_ValueList []*ReplicationDLQTask
+
+func (v _List_ReplicationDLQTask_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationDLQTask_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationDLQTask_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationDLQTask_ValueList) Close() {}
+
+// ToWire translates a ReadReplicationDLQResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReadReplicationDLQResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Tasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationDLQTask_ValueList(v.Tasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationDLQTask_Read(w wire.Value) (*ReplicationDLQTask, error) {
+	var v ReplicationDLQTask
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationDLQTask_Read(l wire.ValueList) ([]*ReplicationDLQTask, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationDLQTask, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationDLQTask_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ReadReplicationDLQResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReadReplicationDLQResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReadReplicationDLQResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReadReplicationDLQResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Tasks, err = _List_ReplicationDLQTask_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReadReplicationDLQResponse
+// struct.
+func (v *ReadReplicationDLQResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Tasks != nil {
+		fields[i] = fmt.Sprintf("Tasks: %v", v.Tasks)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ReadReplicationDLQResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationDLQTask_Equals(lhs, rhs []*ReplicationDLQTask) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ReadReplicationDLQResponse match the
+// provided ReadReplicationDLQResponse.
+//
+// This function performs a deep comparison.
+func (v *ReadReplicationDLQResponse) Equals(rhs *ReadReplicationDLQResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Tasks == nil && rhs.Tasks == nil) || (v.Tasks != nil && rhs.Tasks != nil && _List_ReplicationDLQTask_Equals(v.Tasks, rhs.Tasks))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationDLQTask_Zapper []*ReplicationDLQTask
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationDLQTask_Zapper.
+func (l _List_ReplicationDLQTask_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReadReplicationDLQResponse.
+func (v *ReadReplicationDLQResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Tasks != nil {
+		err = multierr.Append(err, enc.AddArray("tasks", (_List_ReplicationDLQTask_Zapper)(v.Tasks)))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetTasks returns the value of Tasks if it is set or its
+// zero value if it is unset.
+func (v *ReadReplicationDLQResponse) GetTasks() (o []*ReplicationDLQTask) {
+	if v != nil && v.Tasks != nil {
+		return v.Tasks
+	}
+
+	return
+}
+
+// IsSetTasks returns true if Tasks is not nil.
+func (v *ReadReplicationDLQResponse) IsSetTasks() bool {
+	return v != nil && v.Tasks != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadReplicationDLQResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadReplicationDLQResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ReadTransferDLQRequest struct {
+	ShardID            *int32 `json:"shardID,omitempty"`
+	InclusiveEndTaskID *int64 `json:"inclusiveEndTaskID,omitempty"`
+	MaximumPageSize    *int32 `json:"maximumPageSize,omitempty"`
+	NextPageToken      []byte `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a ReadTransferDLQRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReadTransferDLQRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.InclusiveEndTaskID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndTaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReadTransferDLQRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReadTransferDLQRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReadTransferDLQRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReadTransferDLQRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndTaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReadTransferDLQRequest
+// struct.
+func (v *ReadTransferDLQRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.InclusiveEndTaskID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndTaskID: %v", *(v.InclusiveEndTaskID))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ReadTransferDLQRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReadTransferDLQRequest match the
+// provided ReadTransferDLQRequest.
+//
+// This function performs a deep comparison.
+func (v *ReadTransferDLQRequest) Equals(rhs *ReadTransferDLQRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndTaskID, rhs.InclusiveEndTaskID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReadTransferDLQRequest.
+func (v *ReadTransferDLQRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.InclusiveEndTaskID != nil {
+		enc.AddInt64("inclusiveEndTaskID", *v.InclusiveEndTaskID)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ReadTransferDLQRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReadTransferDLQRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetInclusiveEndTaskID returns the value of InclusiveEndTaskID if it is set or its
+// zero value if it is unset.
+func (v *ReadTransferDLQRequest) GetInclusiveEndTaskID() (o int64) {
+	if v != nil && v.InclusiveEndTaskID != nil {
+		return *v.InclusiveEndTaskID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndTaskID returns true if InclusiveEndTaskID is not nil.
+func (v *ReadTransferDLQRequest) IsSetInclusiveEndTaskID() bool {
+	return v != nil && v.InclusiveEndTaskID != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *ReadTransferDLQRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *ReadTransferDLQRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadTransferDLQRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadTransferDLQRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ReadTransferDLQResponse struct {
+	Tasks         []*TransferDLQTask `json:"tasks,omitempty"`
+	NextPageToken []byte             `json:"nextPageToken,omitempty"`
+}
+
+type _List_TransferDLQTask_ValueList []*TransferDLQTask
+
+func (v _List_TransferDLQTask_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_TransferDLQTask_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_TransferDLQTask_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_TransferDLQTask_ValueList) Close() {}
+
+// ToWire translates a ReadTransferDLQResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReadTransferDLQResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Tasks != nil {
+		w, err = wire.NewValueList(_List_TransferDLQTask_ValueList(v.Tasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TransferDLQTask_Read(w wire.Value) (*TransferDLQTask, error) {
+	var v TransferDLQTask
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_TransferDLQTask_Read(l wire.ValueList) ([]*TransferDLQTask, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*TransferDLQTask, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _TransferDLQTask_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ReadTransferDLQResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReadTransferDLQResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReadTransferDLQResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReadTransferDLQResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Tasks, err = _List_TransferDLQTask_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReadTransferDLQResponse
+// struct.
+func (v *ReadTransferDLQResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Tasks != nil {
+		fields[i] = fmt.Sprintf("Tasks: %v", v.Tasks)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ReadTransferDLQResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_TransferDLQTask_Equals(lhs, rhs []*TransferDLQTask) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ReadTransferDLQResponse match the
+// provided ReadTransferDLQResponse.
+//
+// This function performs a deep comparison.
+func (v *ReadTransferDLQResponse) Equals(rhs *ReadTransferDLQResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Tasks == nil && rhs.Tasks == nil) || (v.Tasks != nil && rhs.Tasks != nil && _List_TransferDLQTask_Equals(v.Tasks, rhs.Tasks))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_TransferDLQTask_Zapper []*TransferDLQTask
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_TransferDLQTask_Zapper.
+func (l _List_TransferDLQTask_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReadTransferDLQResponse.
+func (v *ReadTransferDLQResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Tasks != nil {
+		err = multierr.Append(err, enc.AddArray("tasks", (_List_TransferDLQTask_Zapper)(v.Tasks)))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetTasks returns the value of Tasks if it is set or its
+// zero value if it is unset.
+func (v *ReadTransferDLQResponse) GetTasks() (o []*TransferDLQTask) {
+	if v != nil && v.Tasks != nil {
+		return v.Tasks
+	}
+
+	return
+}
+
+// IsSetTasks returns true if Tasks is not nil.
+func (v *ReadTransferDLQResponse) IsSetTasks() bool {
+	return v != nil && v.Tasks != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadTransferDLQResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadTransferDLQResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type RecordActivityTaskHeartbeatByIDRequest struct {
+	Domain     *string `json:"domain,omitempty"`
+	WorkflowID *string `json:"workflowID,omitempty"`
+	RunID      *string `json:"runID,omitempty"`
+	ActivityID *string `json:"activityID,omitempty"`
+	Details    []byte  `json:"details,omitempty"`
+	Identity   *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a RecordActivityTaskHeartbeatByIDRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RecordActivityTaskHeartbeatByIDRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ActivityID != nil {
+		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RecordActivityTaskHeartbeatByIDRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordActivityTaskHeartbeatByIDRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordActivityTaskHeartbeatByIDRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordActivityTaskHeartbeatByIDRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordActivityTaskHeartbeatByIDRequest
+// struct.
+func (v *RecordActivityTaskHeartbeatByIDRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+	if v.ActivityID != nil {
+		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("RecordActivityTaskHeartbeatByIDRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RecordActivityTaskHeartbeatByIDRequest match the
+// provided RecordActivityTaskHeartbeatByIDRequest.
+//
+// This function performs a deep comparison.
+func (v *RecordActivityTaskHeartbeatByIDRequest) Equals(rhs *RecordActivityTaskHeartbeatByIDRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RecordActivityTaskHeartbeatByIDRequest.
+func (v *RecordActivityTaskHeartbeatByIDRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	if v.ActivityID != nil {
+		enc.AddString("activityID", *v.ActivityID)
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+// GetActivityID returns the value of ActivityID if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetActivityID() (o string) {
+	if v != nil && v.ActivityID != nil {
+		return *v.ActivityID
+	}
+
+	return
+}
+
+// IsSetActivityID returns true if ActivityID is not nil.
+func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetActivityID() bool {
+	return v != nil && v.ActivityID != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *RecordActivityTaskHeartbeatByIDRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
 	}
+
+	return
 }
 
-type QueryWorkflowRequest struct {
-	Domain    *string            `json:"domain,omitempty"`
-	Execution *WorkflowExecution `json:"execution,omitempty"`
-	Query     *WorkflowQuery     `json:"query,omitempty"`
+// IsSetIdentity returns true if Identity is not nil.
+func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
 }
 
-// ToWire translates a QueryWorkflowRequest struct into a Thrift-level intermediate
+type RecordActivityTaskHeartbeatRequest struct {
+	TaskToken []byte  `json:"taskToken,omitempty"`
+	Details   []byte  `json:"details,omitempty"`
+	Identity  *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a RecordActivityTaskHeartbeatRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25781,7 +32296,7 @@ type QueryWorkflowRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -25789,24 +32304,24 @@ func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Query != nil {
-		w, err = v.Query.ToWire()
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -25817,11 +32332,11 @@ func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a QueryWorkflowRequest struct from its Thrift-level
+// FromWire deserializes a RecordActivityTaskHeartbeatRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a QueryWorkflowRequest struct
+// An error is returned if we were unable to build a RecordActivityTaskHeartbeatRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25829,37 +32344,37 @@ func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v QueryWorkflowRequest
+//   var v RecordActivityTaskHeartbeatRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.Query, err = _WorkflowQuery_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -25871,48 +32386,48 @@ func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a QueryWorkflowRequest
+// String returns a readable string representation of a RecordActivityTaskHeartbeatRequest
 // struct.
-func (v *QueryWorkflowRequest) String() string {
+func (v *RecordActivityTaskHeartbeatRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [3]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
-	if v.Query != nil {
-		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordActivityTaskHeartbeatRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this QueryWorkflowRequest match the
-// provided QueryWorkflowRequest.
+// Equals returns true if all the fields of this RecordActivityTaskHeartbeatRequest match the
+// provided RecordActivityTaskHeartbeatRequest.
 //
 // This function performs a deep comparison.
-func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeartbeatRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
-	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -25920,73 +32435,73 @@ func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of QueryWorkflowRequest.
-func (v *QueryWorkflowRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordActivityTaskHeartbeatRequest.
+func (v *RecordActivityTaskHeartbeatRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
+	if v.TaskToken != nil {
+		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
 	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
 	}
-	if v.Query != nil {
-		err = multierr.Append(err, enc.AddObject("query", v.Query))
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetTaskToken returns the value of TaskToken if it is set or its
 // zero value if it is unset.
-func (v *QueryWorkflowRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *RecordActivityTaskHeartbeatRequest) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *QueryWorkflowRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetTaskToken returns true if TaskToken is not nil.
+func (v *RecordActivityTaskHeartbeatRequest) IsSetTaskToken() bool {
+	return v != nil && v.TaskToken != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
+// GetDetails returns the value of Details if it is set or its
 // zero value if it is unset.
-func (v *QueryWorkflowRequest) GetExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+func (v *RecordActivityTaskHeartbeatRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 
 	return
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *QueryWorkflowRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
+// IsSetDetails returns true if Details is not nil.
+func (v *RecordActivityTaskHeartbeatRequest) IsSetDetails() bool {
+	return v != nil && v.Details != nil
 }
 
-// GetQuery returns the value of Query if it is set or its
+// GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *QueryWorkflowRequest) GetQuery() (o *WorkflowQuery) {
-	if v != nil && v.Query != nil {
-		return v.Query
+func (v *RecordActivityTaskHeartbeatRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-// IsSetQuery returns true if Query is not nil.
-func (v *QueryWorkflowRequest) IsSetQuery() bool {
-	return v != nil && v.Query != nil
+// IsSetIdentity returns true if Identity is not nil.
+func (v *RecordActivityTaskHeartbeatRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
 }
 
-type QueryWorkflowResponse struct {
-	QueryResult []byte `json:"queryResult,omitempty"`
+type RecordActivityTaskHeartbeatResponse struct {
+	CancelRequested *bool `json:"cancelRequested,omitempty"`
 }
 
-// ToWire translates a QueryWorkflowResponse struct into a Thrift-level intermediate
+// ToWire translates a RecordActivityTaskHeartbeatResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26001,7 +32516,7 @@ type QueryWorkflowResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *QueryWorkflowResponse) ToWire() (wire.Value, error) {
+func (v *RecordActivityTaskHeartbeatResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -26009,8 +32524,8 @@ func (v *QueryWorkflowResponse) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.QueryResult != nil {
-		w, err = wire.NewValueBinary(v.QueryResult), error(nil)
+	if v.CancelRequested != nil {
+		w, err = wire.NewValueBool(*(v.CancelRequested)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -26021,11 +32536,11 @@ func (v *QueryWorkflowResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a QueryWorkflowResponse struct from its Thrift-level
+// FromWire deserializes a RecordActivityTaskHeartbeatResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a QueryWorkflowResponse struct
+// An error is returned if we were unable to build a RecordActivityTaskHeartbeatResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26033,19 +32548,21 @@ func (v *QueryWorkflowResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v QueryWorkflowResponse
+//   var v RecordActivityTaskHeartbeatResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *QueryWorkflowResponse) FromWire(w wire.Value) error {
+func (v *RecordActivityTaskHeartbeatResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.QueryResult, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.CancelRequested = &x
 				if err != nil {
 					return err
 				}
@@ -26057,34 +32574,34 @@ func (v *QueryWorkflowResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a QueryWorkflowResponse
+// String returns a readable string representation of a RecordActivityTaskHeartbeatResponse
 // struct.
-func (v *QueryWorkflowResponse) String() string {
+func (v *RecordActivityTaskHeartbeatResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.QueryResult != nil {
-		fields[i] = fmt.Sprintf("QueryResult: %v", v.QueryResult)
+	if v.CancelRequested != nil {
+		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
 		i++
 	}
 
-	return fmt.Sprintf("QueryWorkflowResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordActivityTaskHeartbeatResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this QueryWorkflowResponse match the
-// provided QueryWorkflowResponse.
+// Equals returns true if all the fields of this RecordActivityTaskHeartbeatResponse match the
+// provided RecordActivityTaskHeartbeatResponse.
 //
 // This function performs a deep comparison.
-func (v *QueryWorkflowResponse) Equals(rhs *QueryWorkflowResponse) bool {
+func (v *RecordActivityTaskHeartbeatResponse) Equals(rhs *RecordActivityTaskHeartbeatResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.QueryResult == nil && rhs.QueryResult == nil) || (v.QueryResult != nil && rhs.QueryResult != nil && bytes.Equal(v.QueryResult, rhs.QueryResult))) {
+	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
 		return false
 	}
 
@@ -26092,42 +32609,39 @@ func (v *QueryWorkflowResponse) Equals(rhs *QueryWorkflowResponse) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of QueryWorkflowResponse.
-func (v *QueryWorkflowResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordActivityTaskHeartbeatResponse.
+func (v *RecordActivityTaskHeartbeatResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.QueryResult != nil {
-		enc.AddString("queryResult", base64.StdEncoding.EncodeToString(v.QueryResult))
+	if v.CancelRequested != nil {
+		enc.AddBool("cancelRequested", *v.CancelRequested)
 	}
 	return err
 }
 
-// GetQueryResult returns the value of QueryResult if it is set or its
+// GetCancelRequested returns the value of CancelRequested if it is set or its
 // zero value if it is unset.
-func (v *QueryWorkflowResponse) GetQueryResult() (o []byte) {
-	if v != nil && v.QueryResult != nil {
-		return v.QueryResult
+func (v *RecordActivityTaskHeartbeatResponse) GetCancelRequested() (o bool) {
+	if v != nil && v.CancelRequested != nil {
+		return *v.CancelRequested
 	}
 
 	return
 }
 
-// IsSetQueryResult returns true if QueryResult is not nil.
-func (v *QueryWorkflowResponse) IsSetQueryResult() bool {
-	return v != nil && v.QueryResult != nil
+// IsSetCancelRequested returns true if CancelRequested is not nil.
+func (v *RecordActivityTaskHeartbeatResponse) IsSetCancelRequested() bool {
+	return v != nil && v.CancelRequested != nil
 }
 
-type RecordActivityTaskHeartbeatByIDRequest struct {
-	Domain     *string `json:"domain,omitempty"`
-	WorkflowID *string `json:"workflowID,omitempty"`
-	RunID      *string `json:"runID,omitempty"`
-	ActivityID *string `json:"activityID,omitempty"`
+type RecordMarkerDecisionAttributes struct {
+	MarkerName *string `json:"markerName,omitempty"`
 	Details    []byte  `json:"details,omitempty"`
-	Identity   *string `json:"identity,omitempty"`
+	Header     *Header `json:"header,omitempty"`
 }
 
-// ToWire translates a RecordActivityTaskHeartbeatByIDRequest struct into a Thrift-level intermediate
+// ToWire translates a RecordMarkerDecisionAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26142,71 +32656,47 @@ type RecordActivityTaskHeartbeatByIDRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordActivityTaskHeartbeatByIDRequest) ToWire() (wire.Value, error) {
+func (v *RecordMarkerDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.MarkerName != nil {
+		w, err = wire.NewValueString(*(v.MarkerName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowID != nil {
-		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.RunID != nil {
-		w, err = wire.NewValueString(*(v.RunID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ActivityID != nil {
-		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 	if v.Details != nil {
 		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.Header != nil {
+		w, err = v.Header.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RecordActivityTaskHeartbeatByIDRequest struct from its Thrift-level
+// FromWire deserializes a RecordMarkerDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordActivityTaskHeartbeatByIDRequest struct
+// An error is returned if we were unable to build a RecordMarkerDecisionAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26214,12 +32704,12 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RecordActivityTaskHeartbeatByIDRequest
+//   var v RecordMarkerDecisionAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordActivityTaskHeartbeatByIDRequest) FromWire(w wire.Value) error {
+func (v *RecordMarkerDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -26228,43 +32718,13 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.MarkerName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActivityID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
 			if field.Value.Type() == wire.TBinary {
 				v.Details, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
@@ -26272,11 +32732,9 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 60:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Header, err = _Header_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -26288,69 +32746,48 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordActivityTaskHeartbeatByIDRequest
+// String returns a readable string representation of a RecordMarkerDecisionAttributes
 // struct.
-func (v *RecordActivityTaskHeartbeatByIDRequest) String() string {
+func (v *RecordMarkerDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [3]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.WorkflowID != nil {
-		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
-		i++
-	}
-	if v.RunID != nil {
-		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
-		i++
-	}
-	if v.ActivityID != nil {
-		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
+	if v.MarkerName != nil {
+		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
 		i++
 	}
 	if v.Details != nil {
 		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.Header != nil {
+		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
 
-	return fmt.Sprintf("RecordActivityTaskHeartbeatByIDRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RecordMarkerDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskHeartbeatByIDRequest match the
-// provided RecordActivityTaskHeartbeatByIDRequest.
+// Equals returns true if all the fields of this RecordMarkerDecisionAttributes match the
+// provided RecordMarkerDecisionAttributes.
 //
 // This function performs a deep comparison.
-func (v *RecordActivityTaskHeartbeatByIDRequest) Equals(rhs *RecordActivityTaskHeartbeatByIDRequest) bool {
+func (v *RecordMarkerDecisionAttributes) Equals(rhs *RecordMarkerDecisionAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
+	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
 		return false
 	}
 	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
 
@@ -26358,95 +32795,41 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) Equals(rhs *RecordActivityTaskH
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordActivityTaskHeartbeatByIDRequest.
-func (v *RecordActivityTaskHeartbeatByIDRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RecordMarkerDecisionAttributes.
+func (v *RecordMarkerDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
-	}
-	if v.WorkflowID != nil {
-		enc.AddString("workflowID", *v.WorkflowID)
-	}
-	if v.RunID != nil {
-		enc.AddString("runID", *v.RunID)
-	}
-	if v.ActivityID != nil {
-		enc.AddString("activityID", *v.ActivityID)
+	if v.MarkerName != nil {
+		enc.AddString("markerName", *v.MarkerName)
 	}
 	if v.Details != nil {
 		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
 	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
+	if v.Header != nil {
+		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
-	}
-
-	return
-}
-
-// IsSetDomain returns true if Domain is not nil.
-func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
-}
-
-// GetWorkflowID returns the value of WorkflowID if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetWorkflowID() (o string) {
-	if v != nil && v.WorkflowID != nil {
-		return *v.WorkflowID
-	}
-
-	return
-}
-
-// IsSetWorkflowID returns true if WorkflowID is not nil.
-func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetWorkflowID() bool {
-	return v != nil && v.WorkflowID != nil
-}
-
-// GetRunID returns the value of RunID if it is set or its
-// zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetRunID() (o string) {
-	if v != nil && v.RunID != nil {
-		return *v.RunID
-	}
-
-	return
-}
-
-// IsSetRunID returns true if RunID is not nil.
-func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetRunID() bool {
-	return v != nil && v.RunID != nil
-}
-
-// GetActivityID returns the value of ActivityID if it is set or its
+// GetMarkerName returns the value of MarkerName if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetActivityID() (o string) {
-	if v != nil && v.ActivityID != nil {
-		return *v.ActivityID
+func (v *RecordMarkerDecisionAttributes) GetMarkerName() (o string) {
+	if v != nil && v.MarkerName != nil {
+		return *v.MarkerName
 	}
 
 	return
 }
 
-// IsSetActivityID returns true if ActivityID is not nil.
-func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetActivityID() bool {
-	return v != nil && v.ActivityID != nil
+// IsSetMarkerName returns true if MarkerName is not nil.
+func (v *RecordMarkerDecisionAttributes) IsSetMarkerName() bool {
+	return v != nil && v.MarkerName != nil
 }
 
 // GetDetails returns the value of Details if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetDetails() (o []byte) {
+func (v *RecordMarkerDecisionAttributes) GetDetails() (o []byte) {
 	if v != nil && v.Details != nil {
 		return v.Details
 	}
@@ -26455,32 +32838,40 @@ func (v *RecordActivityTaskHeartbeatByIDRequest) GetDetails() (o []byte) {
 }
 
 // IsSetDetails returns true if Details is not nil.
-func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetDetails() bool {
+func (v *RecordMarkerDecisionAttributes) IsSetDetails() bool {
 	return v != nil && v.Details != nil
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetHeader returns the value of Header if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatByIDRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *RecordMarkerDecisionAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
 	}
 
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *RecordActivityTaskHeartbeatByIDRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+// IsSetHeader returns true if Header is not nil.
+func (v *RecordMarkerDecisionAttributes) IsSetHeader() bool {
+	return v != nil && v.Header != nil
 }
 
-type RecordActivityTaskHeartbeatRequest struct {
-	TaskToken []byte  `json:"taskToken,omitempty"`
-	Details   []byte  `json:"details,omitempty"`
-	Identity  *string `json:"identity,omitempty"`
+type RegisterDomainRequest struct {
+	Name                                   *string                            `json:"name,omitempty"`
+	Description                            *string                            `json:"description,omitempty"`
+	OwnerEmail                             *string                            `json:"ownerEmail,omitempty"`
+	WorkflowExecutionRetentionPeriodInDays *int32                             `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
+	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
+	Data                                   map[string]string                  `json:"data,omitempty"`
+	SecurityToken                          *string                            `json:"securityToken,omitempty"`
+	ArchivalStatus                         *ArchivalStatus                    `json:"archivalStatus,omitempty"`
+	ArchivalBucketName                     *string                            `json:"archivalBucketName,omitempty"`
 }
 
-// ToWire translates a RecordActivityTaskHeartbeatRequest struct into a Thrift-level intermediate
+// ToWire translates a RegisterDomainRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26495,47 +32886,111 @@ type RecordActivityTaskHeartbeatRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
+func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Description != nil {
+		w, err = wire.NewValueString(*(v.Description)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.OwnerEmail != nil {
+		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.EmitMetric != nil {
+		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Clusters != nil {
+		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.ActiveClusterName != nil {
+		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.Data != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Data)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.SecurityToken != nil {
+		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.ArchivalStatus != nil {
+		w, err = v.ArchivalStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.ArchivalBucketName != nil {
+		w, err = wire.NewValueString(*(v.ArchivalBucketName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RecordActivityTaskHeartbeatRequest struct from its Thrift-level
+// FromWire deserializes a RegisterDomainRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordActivityTaskHeartbeatRequest struct
+// An error is returned if we were unable to build a RegisterDomainRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26543,19 +32998,21 @@ func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RecordActivityTaskHeartbeatRequest
+//   var v RegisterDomainRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
+func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
 				if err != nil {
 					return err
 				}
@@ -26563,7 +33020,9 @@ func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Description = &x
 				if err != nil {
 					return err
 				}
@@ -26573,7 +33032,83 @@ func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.OwnerEmail = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionRetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.EmitMetric = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActiveClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TMap {
+				v.Data, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SecurityToken = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x ArchivalStatus
+				x, err = _ArchivalStatus_Read(field.Value)
+				v.ArchivalStatus = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ArchivalBucketName = &x
 				if err != nil {
 					return err
 				}
@@ -26585,48 +33120,104 @@ func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordActivityTaskHeartbeatRequest
+// String returns a readable string representation of a RegisterDomainRequest
 // struct.
-func (v *RecordActivityTaskHeartbeatRequest) String() string {
+func (v *RegisterDomainRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [11]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.Description != nil {
+		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.OwnerEmail != nil {
+		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+		i++
+	}
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
+		i++
+	}
+	if v.EmitMetric != nil {
+		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
+		i++
+	}
+	if v.Clusters != nil {
+		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
+		i++
+	}
+	if v.ActiveClusterName != nil {
+		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
+		i++
+	}
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+	if v.SecurityToken != nil {
+		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
+		i++
+	}
+	if v.ArchivalStatus != nil {
+		fields[i] = fmt.Sprintf("ArchivalStatus: %v", *(v.ArchivalStatus))
+		i++
+	}
+	if v.ArchivalBucketName != nil {
+		fields[i] = fmt.Sprintf("ArchivalBucketName: %v", *(v.ArchivalBucketName))
 		i++
 	}
 
-	return fmt.Sprintf("RecordActivityTaskHeartbeatRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RegisterDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskHeartbeatRequest match the
-// provided RecordActivityTaskHeartbeatRequest.
+// Equals returns true if all the fields of this RegisterDomainRequest match the
+// provided RegisterDomainRequest.
 //
 // This function performs a deep comparison.
-func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeartbeatRequest) bool {
+func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_String_EqualsPtr(v.Description, rhs.Description) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
+		return false
+	}
+	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
+		return false
+	}
+	if !_ArchivalStatus_EqualsPtr(v.ArchivalStatus, rhs.ArchivalStatus) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ArchivalBucketName, rhs.ArchivalBucketName) {
 		return false
 	}
 
@@ -26634,213 +33225,220 @@ func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeart
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordActivityTaskHeartbeatRequest.
-func (v *RecordActivityTaskHeartbeatRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RegisterDomainRequest.
+func (v *RegisterDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskToken != nil {
-		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.Description != nil {
+		enc.AddString("description", *v.Description)
 	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
+	if v.OwnerEmail != nil {
+		enc.AddString("ownerEmail", *v.OwnerEmail)
+	}
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		enc.AddInt32("workflowExecutionRetentionPeriodInDays", *v.WorkflowExecutionRetentionPeriodInDays)
+	}
+	if v.EmitMetric != nil {
+		enc.AddBool("emitMetric", *v.EmitMetric)
+	}
+	if v.Clusters != nil {
+		err = multierr.Append(err, enc.AddArray("clusters", (_List_ClusterReplicationConfiguration_Zapper)(v.Clusters)))
+	}
+	if v.ActiveClusterName != nil {
+		enc.AddString("activeClusterName", *v.ActiveClusterName)
+	}
+	if v.Data != nil {
+		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
+	}
+	if v.SecurityToken != nil {
+		enc.AddString("securityToken", *v.SecurityToken)
+	}
+	if v.ArchivalStatus != nil {
+		err = multierr.Append(err, enc.AddObject("archivalStatus", *v.ArchivalStatus))
+	}
+	if v.ArchivalBucketName != nil {
+		enc.AddString("archivalBucketName", *v.ArchivalBucketName)
 	}
 	return err
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatRequest) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
+func (v *RegisterDomainRequest) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
 	}
 
 	return
 }
 
-// IsSetTaskToken returns true if TaskToken is not nil.
-func (v *RecordActivityTaskHeartbeatRequest) IsSetTaskToken() bool {
-	return v != nil && v.TaskToken != nil
+// IsSetName returns true if Name is not nil.
+func (v *RegisterDomainRequest) IsSetName() bool {
+	return v != nil && v.Name != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetDescription returns the value of Description if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatRequest) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *RegisterDomainRequest) GetDescription() (o string) {
+	if v != nil && v.Description != nil {
+		return *v.Description
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *RecordActivityTaskHeartbeatRequest) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetDescription returns true if Description is not nil.
+func (v *RegisterDomainRequest) IsSetDescription() bool {
+	return v != nil && v.Description != nil
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetOwnerEmail returns the value of OwnerEmail if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *RegisterDomainRequest) GetOwnerEmail() (o string) {
+	if v != nil && v.OwnerEmail != nil {
+		return *v.OwnerEmail
 	}
 
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *RecordActivityTaskHeartbeatRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+// IsSetOwnerEmail returns true if OwnerEmail is not nil.
+func (v *RegisterDomainRequest) IsSetOwnerEmail() bool {
+	return v != nil && v.OwnerEmail != nil
 }
 
-type RecordActivityTaskHeartbeatResponse struct {
-	CancelRequested *bool `json:"cancelRequested,omitempty"`
+// GetWorkflowExecutionRetentionPeriodInDays returns the value of WorkflowExecutionRetentionPeriodInDays if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
+	if v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil {
+		return *v.WorkflowExecutionRetentionPeriodInDays
+	}
+
+	return
 }
 
-// ToWire translates a RecordActivityTaskHeartbeatResponse struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *RecordActivityTaskHeartbeatResponse) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+// IsSetWorkflowExecutionRetentionPeriodInDays returns true if WorkflowExecutionRetentionPeriodInDays is not nil.
+func (v *RegisterDomainRequest) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
+	return v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil
+}
 
-	if v.CancelRequested != nil {
-		w, err = wire.NewValueBool(*(v.CancelRequested)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
+// GetEmitMetric returns the value of EmitMetric if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetEmitMetric() (o bool) {
+	if v != nil && v.EmitMetric != nil {
+		return *v.EmitMetric
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	return
 }
 
-// FromWire deserializes a RecordActivityTaskHeartbeatResponse struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a RecordActivityTaskHeartbeatResponse struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v RecordActivityTaskHeartbeatResponse
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *RecordActivityTaskHeartbeatResponse) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.CancelRequested = &x
-				if err != nil {
-					return err
-				}
+// IsSetEmitMetric returns true if EmitMetric is not nil.
+func (v *RegisterDomainRequest) IsSetEmitMetric() bool {
+	return v != nil && v.EmitMetric != nil
+}
 
-			}
-		}
+// GetClusters returns the value of Clusters if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetClusters() (o []*ClusterReplicationConfiguration) {
+	if v != nil && v.Clusters != nil {
+		return v.Clusters
 	}
 
-	return nil
+	return
 }
 
-// String returns a readable string representation of a RecordActivityTaskHeartbeatResponse
-// struct.
-func (v *RecordActivityTaskHeartbeatResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
+// IsSetClusters returns true if Clusters is not nil.
+func (v *RegisterDomainRequest) IsSetClusters() bool {
+	return v != nil && v.Clusters != nil
+}
 
-	var fields [1]string
-	i := 0
-	if v.CancelRequested != nil {
-		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
-		i++
+// GetActiveClusterName returns the value of ActiveClusterName if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetActiveClusterName() (o string) {
+	if v != nil && v.ActiveClusterName != nil {
+		return *v.ActiveClusterName
 	}
 
-	return fmt.Sprintf("RecordActivityTaskHeartbeatResponse{%v}", strings.Join(fields[:i], ", "))
+	return
 }
 
-// Equals returns true if all the fields of this RecordActivityTaskHeartbeatResponse match the
-// provided RecordActivityTaskHeartbeatResponse.
-//
-// This function performs a deep comparison.
-func (v *RecordActivityTaskHeartbeatResponse) Equals(rhs *RecordActivityTaskHeartbeatResponse) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
-		return false
+// IsSetActiveClusterName returns true if ActiveClusterName is not nil.
+func (v *RegisterDomainRequest) IsSetActiveClusterName() bool {
+	return v != nil && v.ActiveClusterName != nil
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetData() (o map[string]string) {
+	if v != nil && v.Data != nil {
+		return v.Data
 	}
 
-	return true
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordActivityTaskHeartbeatResponse.
-func (v *RecordActivityTaskHeartbeatResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
+// IsSetData returns true if Data is not nil.
+func (v *RegisterDomainRequest) IsSetData() bool {
+	return v != nil && v.Data != nil
+}
+
+// GetSecurityToken returns the value of SecurityToken if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetSecurityToken() (o string) {
+	if v != nil && v.SecurityToken != nil {
+		return *v.SecurityToken
 	}
-	if v.CancelRequested != nil {
-		enc.AddBool("cancelRequested", *v.CancelRequested)
+
+	return
+}
+
+// IsSetSecurityToken returns true if SecurityToken is not nil.
+func (v *RegisterDomainRequest) IsSetSecurityToken() bool {
+	return v != nil && v.SecurityToken != nil
+}
+
+// GetArchivalStatus returns the value of ArchivalStatus if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetArchivalStatus() (o ArchivalStatus) {
+	if v != nil && v.ArchivalStatus != nil {
+		return *v.ArchivalStatus
 	}
-	return err
+
+	return
 }
 
-// GetCancelRequested returns the value of CancelRequested if it is set or its
+// IsSetArchivalStatus returns true if ArchivalStatus is not nil.
+func (v *RegisterDomainRequest) IsSetArchivalStatus() bool {
+	return v != nil && v.ArchivalStatus != nil
+}
+
+// GetArchivalBucketName returns the value of ArchivalBucketName if it is set or its
 // zero value if it is unset.
-func (v *RecordActivityTaskHeartbeatResponse) GetCancelRequested() (o bool) {
-	if v != nil && v.CancelRequested != nil {
-		return *v.CancelRequested
+func (v *RegisterDomainRequest) GetArchivalBucketName() (o string) {
+	if v != nil && v.ArchivalBucketName != nil {
+		return *v.ArchivalBucketName
 	}
 
 	return
 }
 
-// IsSetCancelRequested returns true if CancelRequested is not nil.
-func (v *RecordActivityTaskHeartbeatResponse) IsSetCancelRequested() bool {
-	return v != nil && v.CancelRequested != nil
+// IsSetArchivalBucketName returns true if ArchivalBucketName is not nil.
+func (v *RegisterDomainRequest) IsSetArchivalBucketName() bool {
+	return v != nil && v.ArchivalBucketName != nil
 }
 
-type RecordMarkerDecisionAttributes struct {
-	MarkerName *string `json:"markerName,omitempty"`
-	Details    []byte  `json:"details,omitempty"`
-	Header     *Header `json:"header,omitempty"`
+type RemoveTaskRequest struct {
+	ShardID             *int32 `json:"shardID,omitempty"`
+	Type                *int32 `json:"type,omitempty"`
+	TaskID              *int64 `json:"taskID,omitempty"`
+	VisibilityTimestamp *int64 `json:"visibilityTimestamp,omitempty"`
 }
 
-// ToWire translates a RecordMarkerDecisionAttributes struct into a Thrift-level intermediate
+// ToWire translates a RemoveTaskRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26855,47 +33453,55 @@ type RecordMarkerDecisionAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RecordMarkerDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *RemoveTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-
-	if v.MarkerName != nil {
-		w, err = wire.NewValueString(*(v.MarkerName)), error(nil)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.Type != nil {
+		w, err = wire.NewValueI32(*(v.Type)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Header != nil {
-		w, err = v.Header.ToWire()
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.VisibilityTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.VisibilityTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RecordMarkerDecisionAttributes struct from its Thrift-level
+// FromWire deserializes a RemoveTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RecordMarkerDecisionAttributes struct
+// An error is returned if we were unable to build a RemoveTaskRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26903,37 +33509,51 @@ func (v *RecordMarkerDecisionAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RecordMarkerDecisionAttributes
+//   var v RemoveTaskRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RecordMarkerDecisionAttributes) FromWire(w wire.Value) error {
+func (v *RemoveTaskRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MarkerName = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Type = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.Header, err = _Header_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.VisibilityTimestamp = &x
 				if err != nil {
 					return err
 				}
@@ -26945,48 +33565,55 @@ func (v *RecordMarkerDecisionAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RecordMarkerDecisionAttributes
+// String returns a readable string representation of a RemoveTaskRequest
 // struct.
-func (v *RecordMarkerDecisionAttributes) String() string {
+func (v *RemoveTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
-	if v.MarkerName != nil {
-		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
 		i++
 	}
-	if v.Header != nil {
-		fields[i] = fmt.Sprintf("Header: %v", v.Header)
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.VisibilityTimestamp != nil {
+		fields[i] = fmt.Sprintf("VisibilityTimestamp: %v", *(v.VisibilityTimestamp))
 		i++
 	}
 
-	return fmt.Sprintf("RecordMarkerDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RemoveTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RecordMarkerDecisionAttributes match the
-// provided RecordMarkerDecisionAttributes.
+// Equals returns true if all the fields of this RemoveTaskRequest match the
+// provided RemoveTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *RecordMarkerDecisionAttributes) Equals(rhs *RecordMarkerDecisionAttributes) bool {
+func (v *RemoveTaskRequest) Equals(rhs *RemoveTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_I32_EqualsPtr(v.Type, rhs.Type) {
 		return false
 	}
-	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.VisibilityTimestamp, rhs.VisibilityTimestamp) {
 		return false
 	}
 
@@ -26994,83 +33621,97 @@ func (v *RecordMarkerDecisionAttributes) Equals(rhs *RecordMarkerDecisionAttribu
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RecordMarkerDecisionAttributes.
-func (v *RecordMarkerDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RemoveTaskRequest.
+func (v *RemoveTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.MarkerName != nil {
-		enc.AddString("markerName", *v.MarkerName)
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.Type != nil {
+		enc.AddInt32("type", *v.Type)
 	}
-	if v.Header != nil {
-		err = multierr.Append(err, enc.AddObject("header", v.Header))
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
+	}
+	if v.VisibilityTimestamp != nil {
+		enc.AddInt64("visibilityTimestamp", *v.VisibilityTimestamp)
 	}
 	return err
 }
 
-// GetMarkerName returns the value of MarkerName if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *RecordMarkerDecisionAttributes) GetMarkerName() (o string) {
-	if v != nil && v.MarkerName != nil {
-		return *v.MarkerName
+func (v *RemoveTaskRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetMarkerName returns true if MarkerName is not nil.
-func (v *RecordMarkerDecisionAttributes) IsSetMarkerName() bool {
-	return v != nil && v.MarkerName != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *RemoveTaskRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *RecordMarkerDecisionAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *RemoveTaskRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *RecordMarkerDecisionAttributes) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetType returns true if Type is not nil.
+func (v *RemoveTaskRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
 }
 
-// GetHeader returns the value of Header if it is set or its
+// GetTaskID returns the value of TaskID if it is set or its
 // zero value if it is unset.
-func (v *RecordMarkerDecisionAttributes) GetHeader() (o *Header) {
-	if v != nil && v.Header != nil {
-		return v.Header
+func (v *RemoveTaskRequest) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
 	}
 
 	return
 }
 
-// IsSetHeader returns true if Header is not nil.
-func (v *RecordMarkerDecisionAttributes) IsSetHeader() bool {
-	return v != nil && v.Header != nil
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *RemoveTaskRequest) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
 }
 
-type RegisterDomainRequest struct {
-	Name                                   *string                            `json:"name,omitempty"`
-	Description                            *string                            `json:"description,omitempty"`
-	OwnerEmail                             *string                            `json:"ownerEmail,omitempty"`
-	WorkflowExecutionRetentionPeriodInDays *int32                             `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
-	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
-	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
-	Data                                   map[string]string                  `json:"data,omitempty"`
-	SecurityToken                          *string                            `json:"securityToken,omitempty"`
-	ArchivalStatus                         *ArchivalStatus                    `json:"archivalStatus,omitempty"`
-	ArchivalBucketName                     *string                            `json:"archivalBucketName,omitempty"`
+// GetVisibilityTimestamp returns the value of VisibilityTimestamp if it is set or its
+// zero value if it is unset.
+func (v *RemoveTaskRequest) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+
+	return
 }
 
-// ToWire translates a RegisterDomainRequest struct into a Thrift-level intermediate
+// IsSetVisibilityTimestamp returns true if VisibilityTimestamp is not nil.
+func (v *RemoveTaskRequest) IsSetVisibilityTimestamp() bool {
+	return v != nil && v.VisibilityTimestamp != nil
+}
+
+type ReplicationDLQTask struct {
+	DomainId     *string `json:"domainId,omitempty"`
+	WorkflowId   *string `json:"workflowId,omitempty"`
+	RunId        *string `json:"runId,omitempty"`
+	TaskId       *int64  `json:"taskId,omitempty"`
+	FirstEventId *int64  `json:"firstEventId,omitempty"`
+	NextEventId  *int64  `json:"nextEventId,omitempty"`
+	Version      *int64  `json:"version,omitempty"`
+}
+
+// ToWire translates a ReplicationDLQTask struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -27085,111 +33726,79 @@ type RegisterDomainRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
+func (v *ReplicationDLQTask) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Description != nil {
-		w, err = wire.NewValueString(*(v.Description)), error(nil)
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.OwnerEmail != nil {
-		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.EmitMetric != nil {
-		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Clusters != nil {
-		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.ActiveClusterName != nil {
-		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.Data != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Data)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.SecurityToken != nil {
-		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.ArchivalStatus != nil {
-		w, err = v.ArchivalStatus.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.ArchivalBucketName != nil {
-		w, err = wire.NewValueString(*(v.ArchivalBucketName)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RegisterDomainRequest struct from its Thrift-level
+// FromWire deserializes a ReplicationDLQTask struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RegisterDomainRequest struct
+// An error is returned if we were unable to build a ReplicationDLQTask struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -27197,12 +33806,12 @@ func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RegisterDomainRequest
+//   var v ReplicationDLQTask
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
+func (v *ReplicationDLQTask) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -27211,7 +33820,7 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+				v.DomainId = &x
 				if err != nil {
 					return err
 				}
@@ -27221,7 +33830,7 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Description = &x
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
@@ -27231,83 +33840,47 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.OwnerEmail = &x
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.WorkflowExecutionRetentionPeriodInDays = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.EmitMetric = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 60:
-			if field.Value.Type() == wire.TList {
-				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 70:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActiveClusterName = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TMap {
-				v.Data, err = _Map_String_String_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.SecurityToken = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x ArchivalStatus
-				x, err = _ArchivalStatus_Read(field.Value)
-				v.ArchivalStatus = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ArchivalBucketName = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
 				if err != nil {
 					return err
 				}
@@ -27319,104 +33892,76 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RegisterDomainRequest
+// String returns a readable string representation of a ReplicationDLQTask
 // struct.
-func (v *RegisterDomainRequest) String() string {
+func (v *ReplicationDLQTask) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [7]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
-		i++
-	}
-	if v.Description != nil {
-		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
-		i++
-	}
-	if v.OwnerEmail != nil {
-		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
-		i++
-	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
-		i++
-	}
-	if v.EmitMetric != nil {
-		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
 		i++
 	}
-	if v.Clusters != nil {
-		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
-	if v.ActiveClusterName != nil {
-		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	if v.Data != nil {
-		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
 		i++
 	}
-	if v.SecurityToken != nil {
-		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
 		i++
 	}
-	if v.ArchivalStatus != nil {
-		fields[i] = fmt.Sprintf("ArchivalStatus: %v", *(v.ArchivalStatus))
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
 		i++
 	}
-	if v.ArchivalBucketName != nil {
-		fields[i] = fmt.Sprintf("ArchivalBucketName: %v", *(v.ArchivalBucketName))
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
 		i++
 	}
 
-	return fmt.Sprintf("RegisterDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReplicationDLQTask{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RegisterDomainRequest match the
-// provided RegisterDomainRequest.
+// Equals returns true if all the fields of this ReplicationDLQTask match the
+// provided ReplicationDLQTask.
 //
 // This function performs a deep comparison.
-func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
+func (v *ReplicationDLQTask) Equals(rhs *ReplicationDLQTask) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Description, rhs.Description) {
-		return false
-	}
-	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
 		return false
 	}
-	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
-	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
 		return false
 	}
-	if !_ArchivalStatus_EqualsPtr(v.ArchivalStatus, rhs.ArchivalStatus) {
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ArchivalBucketName, rhs.ArchivalBucketName) {
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
 		return false
 	}
 
@@ -27424,210 +33969,138 @@ func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RegisterDomainRequest.
-func (v *RegisterDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReplicationDLQTask.
+func (v *ReplicationDLQTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
-	}
-	if v.Description != nil {
-		enc.AddString("description", *v.Description)
-	}
-	if v.OwnerEmail != nil {
-		enc.AddString("ownerEmail", *v.OwnerEmail)
-	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		enc.AddInt32("workflowExecutionRetentionPeriodInDays", *v.WorkflowExecutionRetentionPeriodInDays)
-	}
-	if v.EmitMetric != nil {
-		enc.AddBool("emitMetric", *v.EmitMetric)
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
 	}
-	if v.Clusters != nil {
-		err = multierr.Append(err, enc.AddArray("clusters", (_List_ClusterReplicationConfiguration_Zapper)(v.Clusters)))
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
 	}
-	if v.ActiveClusterName != nil {
-		enc.AddString("activeClusterName", *v.ActiveClusterName)
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
 	}
-	if v.Data != nil {
-		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
+	if v.TaskId != nil {
+		enc.AddInt64("taskId", *v.TaskId)
 	}
-	if v.SecurityToken != nil {
-		enc.AddString("securityToken", *v.SecurityToken)
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
 	}
-	if v.ArchivalStatus != nil {
-		err = multierr.Append(err, enc.AddObject("archivalStatus", *v.ArchivalStatus))
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
 	}
-	if v.ArchivalBucketName != nil {
-		enc.AddString("archivalBucketName", *v.ArchivalBucketName)
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
-	}
-
-	return
-}
-
-// IsSetName returns true if Name is not nil.
-func (v *RegisterDomainRequest) IsSetName() bool {
-	return v != nil && v.Name != nil
-}
-
-// GetDescription returns the value of Description if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetDescription() (o string) {
-	if v != nil && v.Description != nil {
-		return *v.Description
-	}
-
-	return
-}
-
-// IsSetDescription returns true if Description is not nil.
-func (v *RegisterDomainRequest) IsSetDescription() bool {
-	return v != nil && v.Description != nil
-}
-
-// GetOwnerEmail returns the value of OwnerEmail if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetOwnerEmail() (o string) {
-	if v != nil && v.OwnerEmail != nil {
-		return *v.OwnerEmail
-	}
-
-	return
-}
-
-// IsSetOwnerEmail returns true if OwnerEmail is not nil.
-func (v *RegisterDomainRequest) IsSetOwnerEmail() bool {
-	return v != nil && v.OwnerEmail != nil
-}
-
-// GetWorkflowExecutionRetentionPeriodInDays returns the value of WorkflowExecutionRetentionPeriodInDays if it is set or its
-// zero value if it is unset.
-func (v *RegisterDomainRequest) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
-	if v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil {
-		return *v.WorkflowExecutionRetentionPeriodInDays
-	}
-
-	return
-}
-
-// IsSetWorkflowExecutionRetentionPeriodInDays returns true if WorkflowExecutionRetentionPeriodInDays is not nil.
-func (v *RegisterDomainRequest) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
-	return v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil
-}
-
-// GetEmitMetric returns the value of EmitMetric if it is set or its
+// GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetEmitMetric() (o bool) {
-	if v != nil && v.EmitMetric != nil {
-		return *v.EmitMetric
+func (v *ReplicationDLQTask) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
 	}
 
 	return
 }
 
-// IsSetEmitMetric returns true if EmitMetric is not nil.
-func (v *RegisterDomainRequest) IsSetEmitMetric() bool {
-	return v != nil && v.EmitMetric != nil
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *ReplicationDLQTask) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
 }
 
-// GetClusters returns the value of Clusters if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetClusters() (o []*ClusterReplicationConfiguration) {
-	if v != nil && v.Clusters != nil {
-		return v.Clusters
+func (v *ReplicationDLQTask) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetClusters returns true if Clusters is not nil.
-func (v *RegisterDomainRequest) IsSetClusters() bool {
-	return v != nil && v.Clusters != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *ReplicationDLQTask) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
-// GetActiveClusterName returns the value of ActiveClusterName if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetActiveClusterName() (o string) {
-	if v != nil && v.ActiveClusterName != nil {
-		return *v.ActiveClusterName
+func (v *ReplicationDLQTask) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetActiveClusterName returns true if ActiveClusterName is not nil.
-func (v *RegisterDomainRequest) IsSetActiveClusterName() bool {
-	return v != nil && v.ActiveClusterName != nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *ReplicationDLQTask) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
 }
 
-// GetData returns the value of Data if it is set or its
+// GetTaskId returns the value of TaskId if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetData() (o map[string]string) {
-	if v != nil && v.Data != nil {
-		return v.Data
+func (v *ReplicationDLQTask) GetTaskId() (o int64) {
+	if v != nil && v.TaskId != nil {
+		return *v.TaskId
 	}
 
 	return
 }
 
-// IsSetData returns true if Data is not nil.
-func (v *RegisterDomainRequest) IsSetData() bool {
-	return v != nil && v.Data != nil
+// IsSetTaskId returns true if TaskId is not nil.
+func (v *ReplicationDLQTask) IsSetTaskId() bool {
+	return v != nil && v.TaskId != nil
 }
 
-// GetSecurityToken returns the value of SecurityToken if it is set or its
+// GetFirstEventId returns the value of FirstEventId if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetSecurityToken() (o string) {
-	if v != nil && v.SecurityToken != nil {
-		return *v.SecurityToken
+func (v *ReplicationDLQTask) GetFirstEventId() (o int64) {
+	if v != nil && v.FirstEventId != nil {
+		return *v.FirstEventId
 	}
 
 	return
 }
 
-// IsSetSecurityToken returns true if SecurityToken is not nil.
-func (v *RegisterDomainRequest) IsSetSecurityToken() bool {
-	return v != nil && v.SecurityToken != nil
+// IsSetFirstEventId returns true if FirstEventId is not nil.
+func (v *ReplicationDLQTask) IsSetFirstEventId() bool {
+	return v != nil && v.FirstEventId != nil
 }
 
-// GetArchivalStatus returns the value of ArchivalStatus if it is set or its
+// GetNextEventId returns the value of NextEventId if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetArchivalStatus() (o ArchivalStatus) {
-	if v != nil && v.ArchivalStatus != nil {
-		return *v.ArchivalStatus
+func (v *ReplicationDLQTask) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
 	}
 
 	return
 }
 
-// IsSetArchivalStatus returns true if ArchivalStatus is not nil.
-func (v *RegisterDomainRequest) IsSetArchivalStatus() bool {
-	return v != nil && v.ArchivalStatus != nil
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *ReplicationDLQTask) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
 }
 
-// GetArchivalBucketName returns the value of ArchivalBucketName if it is set or its
+// GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *RegisterDomainRequest) GetArchivalBucketName() (o string) {
-	if v != nil && v.ArchivalBucketName != nil {
-		return *v.ArchivalBucketName
+func (v *ReplicationDLQTask) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
 	return
 }
 
-// IsSetArchivalBucketName returns true if ArchivalBucketName is not nil.
-func (v *RegisterDomainRequest) IsSetArchivalBucketName() bool {
-	return v != nil && v.ArchivalBucketName != nil
+// IsSetVersion returns true if Version is not nil.
+func (v *ReplicationDLQTask) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
 type ReplicationInfo struct {
@@ -29753,39 +36226,498 @@ func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.DecisionFinishEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionFinishEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResetWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionFinishEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetWorkflowExecutionRequest
+// struct.
+func (v *ResetWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.DecisionFinishEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionFinishEventId: %v", *(v.DecisionFinishEventId))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+
+	return fmt.Sprintf("ResetWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResetWorkflowExecutionRequest match the
+// provided ResetWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionFinishEventId, rhs.DecisionFinishEventId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResetWorkflowExecutionRequest.
+func (v *ResetWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.DecisionFinishEventId != nil {
+		enc.AddInt64("decisionFinishEventId", *v.DecisionFinishEventId)
+	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ResetWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ResetWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *ResetWorkflowExecutionRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetDecisionFinishEventId returns the value of DecisionFinishEventId if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetDecisionFinishEventId() (o int64) {
+	if v != nil && v.DecisionFinishEventId != nil {
+		return *v.DecisionFinishEventId
+	}
+
+	return
+}
+
+// IsSetDecisionFinishEventId returns true if DecisionFinishEventId is not nil.
+func (v *ResetWorkflowExecutionRequest) IsSetDecisionFinishEventId() bool {
+	return v != nil && v.DecisionFinishEventId != nil
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *ResetWorkflowExecutionRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
+type ResetWorkflowExecutionResponse struct {
+	RunId *string `json:"runId,omitempty"`
+}
+
+// ToWire translates a ResetWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResetWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetWorkflowExecutionResponse
+// struct.
+func (v *ResetWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+
+	return fmt.Sprintf("ResetWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResetWorkflowExecutionResponse match the
+// provided ResetWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *ResetWorkflowExecutionResponse) Equals(rhs *ResetWorkflowExecutionResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResetWorkflowExecutionResponse.
+func (v *ResetWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	return err
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionResponse) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *ResetWorkflowExecutionResponse) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+type RespondActivityTaskCanceledByIDRequest struct {
+	Domain     *string `json:"domain,omitempty"`
+	WorkflowID *string `json:"workflowID,omitempty"`
+	RunID      *string `json:"runID,omitempty"`
+	ActivityID *string `json:"activityID,omitempty"`
+	Details    []byte  `json:"details,omitempty"`
+	Identity   *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a RespondActivityTaskCanceledByIDRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RespondActivityTaskCanceledByIDRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.DecisionFinishEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionFinishEventId)), error(nil)
+	if v.ActivityID != nil {
+		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskCanceledByIDRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskCanceledByIDRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -29793,12 +36725,12 @@ func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetWorkflowExecutionRequest
+//   var v RespondActivityTaskCanceledByIDRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCanceledByIDRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -29814,8 +36746,10 @@ func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
 				if err != nil {
 					return err
 				}
@@ -29825,27 +36759,35 @@ func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
+				v.RunID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionFinishEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -29857,44 +36799,48 @@ func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetWorkflowExecutionRequest
+// String returns a readable string representation of a RespondActivityTaskCanceledByIDRequest
 // struct.
-func (v *ResetWorkflowExecutionRequest) String() string {
+func (v *RespondActivityTaskCanceledByIDRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
 		i++
 	}
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
 		i++
 	}
-	if v.DecisionFinishEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionFinishEventId: %v", *(v.DecisionFinishEventId))
+	if v.ActivityID != nil {
+		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
 		i++
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("ResetWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCanceledByIDRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetWorkflowExecutionRequest match the
-// provided ResetWorkflowExecutionRequest.
+// Equals returns true if all the fields of this RespondActivityTaskCanceledByIDRequest match the
+// provided RespondActivityTaskCanceledByIDRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionRequest) bool {
+func (v *RespondActivityTaskCanceledByIDRequest) Equals(rhs *RespondActivityTaskCanceledByIDRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -29903,16 +36849,19 @@ func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionReques
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionFinishEventId, rhs.DecisionFinishEventId) {
+	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -29920,32 +36869,35 @@ func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionReques
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetWorkflowExecutionRequest.
-func (v *ResetWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskCanceledByIDRequest.
+func (v *RespondActivityTaskCanceledByIDRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
 	}
-	if v.Reason != nil {
-		enc.AddString("reason", *v.Reason)
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
 	}
-	if v.DecisionFinishEventId != nil {
-		enc.AddInt64("decisionFinishEventId", *v.DecisionFinishEventId)
+	if v.ActivityID != nil {
+		enc.AddString("activityID", *v.ActivityID)
 	}
-	if v.RequestId != nil {
-		enc.AddString("requestId", *v.RequestId)
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
 	return err
 }
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ResetWorkflowExecutionRequest) GetDomain() (o string) {
+func (v *RespondActivityTaskCanceledByIDRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -29954,75 +36906,92 @@ func (v *ResetWorkflowExecutionRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *ResetWorkflowExecutionRequest) IsSetDomain() bool {
+func (v *RespondActivityTaskCanceledByIDRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// GetWorkflowID returns the value of WorkflowID if it is set or its
 // zero value if it is unset.
-func (v *ResetWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *RespondActivityTaskCanceledByIDRequest) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
 	}
 
 	return
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *ResetWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *RespondActivityTaskCanceledByIDRequest) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
 }
 
-// GetReason returns the value of Reason if it is set or its
+// GetRunID returns the value of RunID if it is set or its
 // zero value if it is unset.
-func (v *ResetWorkflowExecutionRequest) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
+func (v *RespondActivityTaskCanceledByIDRequest) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
 	}
 
 	return
 }
 
-// IsSetReason returns true if Reason is not nil.
-func (v *ResetWorkflowExecutionRequest) IsSetReason() bool {
-	return v != nil && v.Reason != nil
+// IsSetRunID returns true if RunID is not nil.
+func (v *RespondActivityTaskCanceledByIDRequest) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
 }
 
-// GetDecisionFinishEventId returns the value of DecisionFinishEventId if it is set or its
+// GetActivityID returns the value of ActivityID if it is set or its
 // zero value if it is unset.
-func (v *ResetWorkflowExecutionRequest) GetDecisionFinishEventId() (o int64) {
-	if v != nil && v.DecisionFinishEventId != nil {
-		return *v.DecisionFinishEventId
+func (v *RespondActivityTaskCanceledByIDRequest) GetActivityID() (o string) {
+	if v != nil && v.ActivityID != nil {
+		return *v.ActivityID
 	}
 
 	return
 }
 
-// IsSetDecisionFinishEventId returns true if DecisionFinishEventId is not nil.
-func (v *ResetWorkflowExecutionRequest) IsSetDecisionFinishEventId() bool {
-	return v != nil && v.DecisionFinishEventId != nil
+// IsSetActivityID returns true if ActivityID is not nil.
+func (v *RespondActivityTaskCanceledByIDRequest) IsSetActivityID() bool {
+	return v != nil && v.ActivityID != nil
 }
 
-// GetRequestId returns the value of RequestId if it is set or its
+// GetDetails returns the value of Details if it is set or its
 // zero value if it is unset.
-func (v *ResetWorkflowExecutionRequest) GetRequestId() (o string) {
-	if v != nil && v.RequestId != nil {
-		return *v.RequestId
+func (v *RespondActivityTaskCanceledByIDRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
 	}
 
 	return
 }
 
-// IsSetRequestId returns true if RequestId is not nil.
-func (v *ResetWorkflowExecutionRequest) IsSetRequestId() bool {
-	return v != nil && v.RequestId != nil
+// IsSetDetails returns true if Details is not nil.
+func (v *RespondActivityTaskCanceledByIDRequest) IsSetDetails() bool {
+	return v != nil && v.Details != nil
 }
 
-type ResetWorkflowExecutionResponse struct {
-	RunId *string `json:"runId,omitempty"`
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *RespondActivityTaskCanceledByIDRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
 }
 
-// ToWire translates a ResetWorkflowExecutionResponse struct into a Thrift-level intermediate
+// IsSetIdentity returns true if Identity is not nil.
+func (v *RespondActivityTaskCanceledByIDRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type RespondActivityTaskCanceledRequest struct {
+	TaskToken []byte  `json:"taskToken,omitempty"`
+	Details   []byte  `json:"details,omitempty"`
+	Identity  *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a RespondActivityTaskCanceledRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -30037,31 +37006,47 @@ type ResetWorkflowExecutionResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetWorkflowExecutionResponse struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskCanceledRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetWorkflowExecutionResponse struct
+// An error is returned if we were unable to build a RespondActivityTaskCanceledRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -30069,21 +37054,37 @@ func (v *ResetWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetWorkflowExecutionResponse
+//   var v RespondActivityTaskCanceledRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetWorkflowExecutionResponse) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -30095,34 +37096,48 @@ func (v *ResetWorkflowExecutionResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetWorkflowExecutionResponse
+// String returns a readable string representation of a RespondActivityTaskCanceledRequest
 // struct.
-func (v *ResetWorkflowExecutionResponse) String() string {
+func (v *RespondActivityTaskCanceledRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [3]string
 	i := 0
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("ResetWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCanceledRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetWorkflowExecutionResponse match the
-// provided ResetWorkflowExecutionResponse.
+// Equals returns true if all the fields of this RespondActivityTaskCanceledRequest match the
+// provided RespondActivityTaskCanceledRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetWorkflowExecutionResponse) Equals(rhs *ResetWorkflowExecutionResponse) bool {
+func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanceledRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -30130,42 +37145,78 @@ func (v *ResetWorkflowExecutionResponse) Equals(rhs *ResetWorkflowExecutionRespo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetWorkflowExecutionResponse.
-func (v *ResetWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskCanceledRequest.
+func (v *RespondActivityTaskCanceledRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
+	if v.TaskToken != nil {
+		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
 	return err
 }
 
-// GetRunId returns the value of RunId if it is set or its
+// GetTaskToken returns the value of TaskToken if it is set or its
 // zero value if it is unset.
-func (v *ResetWorkflowExecutionResponse) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+func (v *RespondActivityTaskCanceledRequest) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
 	}
 
 	return
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *ResetWorkflowExecutionResponse) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+// IsSetTaskToken returns true if TaskToken is not nil.
+func (v *RespondActivityTaskCanceledRequest) IsSetTaskToken() bool {
+	return v != nil && v.TaskToken != nil
 }
 
-type RespondActivityTaskCanceledByIDRequest struct {
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *RespondActivityTaskCanceledRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *RespondActivityTaskCanceledRequest) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *RespondActivityTaskCanceledRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *RespondActivityTaskCanceledRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type RespondActivityTaskCompletedByIDRequest struct {
 	Domain     *string `json:"domain,omitempty"`
 	WorkflowID *string `json:"workflowID,omitempty"`
 	RunID      *string `json:"runID,omitempty"`
 	ActivityID *string `json:"activityID,omitempty"`
-	Details    []byte  `json:"details,omitempty"`
+	Result     []byte  `json:"result,omitempty"`
 	Identity   *string `json:"identity,omitempty"`
 }
 
-// ToWire translates a RespondActivityTaskCanceledByIDRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskCompletedByIDRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -30180,7 +37231,7 @@ type RespondActivityTaskCanceledByIDRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskCanceledByIDRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCompletedByIDRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [6]wire.Field
 		i      int = 0
@@ -30220,8 +37271,8 @@ func (v *RespondActivityTaskCanceledByIDRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -30240,11 +37291,11 @@ func (v *RespondActivityTaskCanceledByIDRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RespondActivityTaskCanceledByIDRequest struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskCompletedByIDRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskCanceledByIDRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskCompletedByIDRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -30252,12 +37303,12 @@ func (v *RespondActivityTaskCanceledByIDRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskCanceledByIDRequest
+//   var v RespondActivityTaskCompletedByIDRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskCanceledByIDRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCompletedByIDRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -30304,7 +37355,7 @@ func (v *RespondActivityTaskCanceledByIDRequest) FromWire(w wire.Value) error {
 			}
 		case 50:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				v.Result, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -30326,9 +37377,9 @@ func (v *RespondActivityTaskCanceledByIDRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskCanceledByIDRequest
+// String returns a readable string representation of a RespondActivityTaskCompletedByIDRequest
 // struct.
-func (v *RespondActivityTaskCanceledByIDRequest) String() string {
+func (v *RespondActivityTaskCompletedByIDRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -30351,8 +37402,8 @@ func (v *RespondActivityTaskCanceledByIDRequest) String() string {
 		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
 		i++
 	}
 	if v.Identity != nil {
@@ -30360,14 +37411,14 @@ func (v *RespondActivityTaskCanceledByIDRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskCanceledByIDRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCompletedByIDRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskCanceledByIDRequest match the
-// provided RespondActivityTaskCanceledByIDRequest.
+// Equals returns true if all the fields of this RespondActivityTaskCompletedByIDRequest match the
+// provided RespondActivityTaskCompletedByIDRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskCanceledByIDRequest) Equals(rhs *RespondActivityTaskCanceledByIDRequest) bool {
+func (v *RespondActivityTaskCompletedByIDRequest) Equals(rhs *RespondActivityTaskCompletedByIDRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -30385,7 +37436,7 @@ func (v *RespondActivityTaskCanceledByIDRequest) Equals(rhs *RespondActivityTask
 	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
@@ -30396,8 +37447,8 @@ func (v *RespondActivityTaskCanceledByIDRequest) Equals(rhs *RespondActivityTask
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskCanceledByIDRequest.
-func (v *RespondActivityTaskCanceledByIDRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskCompletedByIDRequest.
+func (v *RespondActivityTaskCompletedByIDRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -30413,8 +37464,8 @@ func (v *RespondActivityTaskCanceledByIDRequest) MarshalLogObject(enc zapcore.Ob
 	if v.ActivityID != nil {
 		enc.AddString("activityID", *v.ActivityID)
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.Result != nil {
+		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
 	}
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
@@ -30424,7 +37475,7 @@ func (v *RespondActivityTaskCanceledByIDRequest) MarshalLogObject(enc zapcore.Ob
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledByIDRequest) GetDomain() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -30433,13 +37484,13 @@ func (v *RespondActivityTaskCanceledByIDRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *RespondActivityTaskCanceledByIDRequest) IsSetDomain() bool {
+func (v *RespondActivityTaskCompletedByIDRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
 // GetWorkflowID returns the value of WorkflowID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledByIDRequest) GetWorkflowID() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetWorkflowID() (o string) {
 	if v != nil && v.WorkflowID != nil {
 		return *v.WorkflowID
 	}
@@ -30448,13 +37499,13 @@ func (v *RespondActivityTaskCanceledByIDRequest) GetWorkflowID() (o string) {
 }
 
 // IsSetWorkflowID returns true if WorkflowID is not nil.
-func (v *RespondActivityTaskCanceledByIDRequest) IsSetWorkflowID() bool {
+func (v *RespondActivityTaskCompletedByIDRequest) IsSetWorkflowID() bool {
 	return v != nil && v.WorkflowID != nil
 }
 
 // GetRunID returns the value of RunID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledByIDRequest) GetRunID() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetRunID() (o string) {
 	if v != nil && v.RunID != nil {
 		return *v.RunID
 	}
@@ -30463,13 +37514,13 @@ func (v *RespondActivityTaskCanceledByIDRequest) GetRunID() (o string) {
 }
 
 // IsSetRunID returns true if RunID is not nil.
-func (v *RespondActivityTaskCanceledByIDRequest) IsSetRunID() bool {
+func (v *RespondActivityTaskCompletedByIDRequest) IsSetRunID() bool {
 	return v != nil && v.RunID != nil
 }
 
 // GetActivityID returns the value of ActivityID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledByIDRequest) GetActivityID() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetActivityID() (o string) {
 	if v != nil && v.ActivityID != nil {
 		return *v.ActivityID
 	}
@@ -30478,28 +37529,28 @@ func (v *RespondActivityTaskCanceledByIDRequest) GetActivityID() (o string) {
 }
 
 // IsSetActivityID returns true if ActivityID is not nil.
-func (v *RespondActivityTaskCanceledByIDRequest) IsSetActivityID() bool {
+func (v *RespondActivityTaskCompletedByIDRequest) IsSetActivityID() bool {
 	return v != nil && v.ActivityID != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetResult returns the value of Result if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledByIDRequest) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *RespondActivityTaskCompletedByIDRequest) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *RespondActivityTaskCanceledByIDRequest) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetResult returns true if Result is not nil.
+func (v *RespondActivityTaskCompletedByIDRequest) IsSetResult() bool {
+	return v != nil && v.Result != nil
 }
 
 // GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledByIDRequest) GetIdentity() (o string) {
+func (v *RespondActivityTaskCompletedByIDRequest) GetIdentity() (o string) {
 	if v != nil && v.Identity != nil {
 		return *v.Identity
 	}
@@ -30508,17 +37559,17 @@ func (v *RespondActivityTaskCanceledByIDRequest) GetIdentity() (o string) {
 }
 
 // IsSetIdentity returns true if Identity is not nil.
-func (v *RespondActivityTaskCanceledByIDRequest) IsSetIdentity() bool {
+func (v *RespondActivityTaskCompletedByIDRequest) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
-type RespondActivityTaskCanceledRequest struct {
+type RespondActivityTaskCompletedRequest struct {
 	TaskToken []byte  `json:"taskToken,omitempty"`
-	Details   []byte  `json:"details,omitempty"`
+	Result    []byte  `json:"result,omitempty"`
 	Identity  *string `json:"identity,omitempty"`
 }
 
-// ToWire translates a RespondActivityTaskCanceledRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -30533,7 +37584,7 @@ type RespondActivityTaskCanceledRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -30549,8 +37600,8 @@ func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -30569,11 +37620,11 @@ func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RespondActivityTaskCanceledRequest struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskCanceledRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -30581,12 +37632,12 @@ func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskCanceledRequest
+//   var v RespondActivityTaskCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -30601,7 +37652,7 @@ func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				v.Result, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -30623,9 +37674,9 @@ func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskCanceledRequest
+// String returns a readable string representation of a RespondActivityTaskCompletedRequest
 // struct.
-func (v *RespondActivityTaskCanceledRequest) String() string {
+func (v *RespondActivityTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -30636,8 +37687,8 @@ func (v *RespondActivityTaskCanceledRequest) String() string {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
 		i++
 	}
 	if v.Identity != nil {
@@ -30645,14 +37696,14 @@ func (v *RespondActivityTaskCanceledRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskCanceledRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskCanceledRequest match the
-// provided RespondActivityTaskCanceledRequest.
+// Equals returns true if all the fields of this RespondActivityTaskCompletedRequest match the
+// provided RespondActivityTaskCompletedRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanceledRequest) bool {
+func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCompletedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -30661,7 +37712,7 @@ func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanc
 	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
@@ -30672,16 +37723,16 @@ func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskCanceledRequest.
-func (v *RespondActivityTaskCanceledRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskCompletedRequest.
+func (v *RespondActivityTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.TaskToken != nil {
 		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.Result != nil {
+		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
 	}
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
@@ -30691,7 +37742,7 @@ func (v *RespondActivityTaskCanceledRequest) MarshalLogObject(enc zapcore.Object
 
 // GetTaskToken returns the value of TaskToken if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledRequest) GetTaskToken() (o []byte) {
+func (v *RespondActivityTaskCompletedRequest) GetTaskToken() (o []byte) {
 	if v != nil && v.TaskToken != nil {
 		return v.TaskToken
 	}
@@ -30700,28 +37751,28 @@ func (v *RespondActivityTaskCanceledRequest) GetTaskToken() (o []byte) {
 }
 
 // IsSetTaskToken returns true if TaskToken is not nil.
-func (v *RespondActivityTaskCanceledRequest) IsSetTaskToken() bool {
+func (v *RespondActivityTaskCompletedRequest) IsSetTaskToken() bool {
 	return v != nil && v.TaskToken != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetResult returns the value of Result if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledRequest) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *RespondActivityTaskCompletedRequest) GetResult() (o []byte) {
+	if v != nil && v.Result != nil {
+		return v.Result
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *RespondActivityTaskCanceledRequest) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetResult returns true if Result is not nil.
+func (v *RespondActivityTaskCompletedRequest) IsSetResult() bool {
+	return v != nil && v.Result != nil
 }
 
 // GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCanceledRequest) GetIdentity() (o string) {
+func (v *RespondActivityTaskCompletedRequest) GetIdentity() (o string) {
 	if v != nil && v.Identity != nil {
 		return *v.Identity
 	}
@@ -30730,20 +37781,21 @@ func (v *RespondActivityTaskCanceledRequest) GetIdentity() (o string) {
 }
 
 // IsSetIdentity returns true if Identity is not nil.
-func (v *RespondActivityTaskCanceledRequest) IsSetIdentity() bool {
+func (v *RespondActivityTaskCompletedRequest) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
-type RespondActivityTaskCompletedByIDRequest struct {
+type RespondActivityTaskFailedByIDRequest struct {
 	Domain     *string `json:"domain,omitempty"`
 	WorkflowID *string `json:"workflowID,omitempty"`
 	RunID      *string `json:"runID,omitempty"`
 	ActivityID *string `json:"activityID,omitempty"`
-	Result     []byte  `json:"result,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
+	Details    []byte  `json:"details,omitempty"`
 	Identity   *string `json:"identity,omitempty"`
 }
 
-// ToWire translates a RespondActivityTaskCompletedByIDRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskFailedByIDRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -30758,9 +37810,9 @@ type RespondActivityTaskCompletedByIDRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskCompletedByIDRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskFailedByIDRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -30798,31 +37850,39 @@ func (v *RespondActivityTaskCompletedByIDRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.Result != nil {
-		w, err = wire.NewValueBinary(v.Result), error(nil)
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 	if v.Identity != nil {
 		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RespondActivityTaskCompletedByIDRequest struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskFailedByIDRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskCompletedByIDRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskFailedByIDRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -30830,12 +37890,12 @@ func (v *RespondActivityTaskCompletedByIDRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskCompletedByIDRequest
+//   var v RespondActivityTaskFailedByIDRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskCompletedByIDRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskFailedByIDRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -30882,13 +37942,23 @@ func (v *RespondActivityTaskCompletedByIDRequest) FromWire(w wire.Value) error {
 			}
 		case 50:
 			if field.Value.Type() == wire.TBinary {
-				v.Result, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -30904,14 +37974,14 @@ func (v *RespondActivityTaskCompletedByIDRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskCompletedByIDRequest
+// String returns a readable string representation of a RespondActivityTaskFailedByIDRequest
 // struct.
-func (v *RespondActivityTaskCompletedByIDRequest) String() string {
+func (v *RespondActivityTaskFailedByIDRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -30929,8 +37999,12 @@ func (v *RespondActivityTaskCompletedByIDRequest) String() string {
 		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
 		i++
 	}
-	if v.Result != nil {
-		fields[i] = fmt.Sprintf("Result: %v", v.Result)
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
 	if v.Identity != nil {
@@ -30938,14 +38012,14 @@ func (v *RespondActivityTaskCompletedByIDRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskCompletedByIDRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskFailedByIDRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskCompletedByIDRequest match the
-// provided RespondActivityTaskCompletedByIDRequest.
+// Equals returns true if all the fields of this RespondActivityTaskFailedByIDRequest match the
+// provided RespondActivityTaskFailedByIDRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskCompletedByIDRequest) Equals(rhs *RespondActivityTaskCompletedByIDRequest) bool {
+func (v *RespondActivityTaskFailedByIDRequest) Equals(rhs *RespondActivityTaskFailedByIDRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -30963,7 +38037,10 @@ func (v *RespondActivityTaskCompletedByIDRequest) Equals(rhs *RespondActivityTas
 	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
 		return false
 	}
-	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
@@ -30974,8 +38051,8 @@ func (v *RespondActivityTaskCompletedByIDRequest) Equals(rhs *RespondActivityTas
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskCompletedByIDRequest.
-func (v *RespondActivityTaskCompletedByIDRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskFailedByIDRequest.
+func (v *RespondActivityTaskFailedByIDRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -30991,8 +38068,11 @@ func (v *RespondActivityTaskCompletedByIDRequest) MarshalLogObject(enc zapcore.O
 	if v.ActivityID != nil {
 		enc.AddString("activityID", *v.ActivityID)
 	}
-	if v.Result != nil {
-		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
 	}
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
@@ -31002,7 +38082,7 @@ func (v *RespondActivityTaskCompletedByIDRequest) MarshalLogObject(enc zapcore.O
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedByIDRequest) GetDomain() (o string) {
+func (v *RespondActivityTaskFailedByIDRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -31011,13 +38091,13 @@ func (v *RespondActivityTaskCompletedByIDRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *RespondActivityTaskCompletedByIDRequest) IsSetDomain() bool {
+func (v *RespondActivityTaskFailedByIDRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
 // GetWorkflowID returns the value of WorkflowID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedByIDRequest) GetWorkflowID() (o string) {
+func (v *RespondActivityTaskFailedByIDRequest) GetWorkflowID() (o string) {
 	if v != nil && v.WorkflowID != nil {
 		return *v.WorkflowID
 	}
@@ -31026,13 +38106,13 @@ func (v *RespondActivityTaskCompletedByIDRequest) GetWorkflowID() (o string) {
 }
 
 // IsSetWorkflowID returns true if WorkflowID is not nil.
-func (v *RespondActivityTaskCompletedByIDRequest) IsSetWorkflowID() bool {
+func (v *RespondActivityTaskFailedByIDRequest) IsSetWorkflowID() bool {
 	return v != nil && v.WorkflowID != nil
 }
 
 // GetRunID returns the value of RunID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedByIDRequest) GetRunID() (o string) {
+func (v *RespondActivityTaskFailedByIDRequest) GetRunID() (o string) {
 	if v != nil && v.RunID != nil {
 		return *v.RunID
 	}
@@ -31041,13 +38121,13 @@ func (v *RespondActivityTaskCompletedByIDRequest) GetRunID() (o string) {
 }
 
 // IsSetRunID returns true if RunID is not nil.
-func (v *RespondActivityTaskCompletedByIDRequest) IsSetRunID() bool {
+func (v *RespondActivityTaskFailedByIDRequest) IsSetRunID() bool {
 	return v != nil && v.RunID != nil
 }
 
 // GetActivityID returns the value of ActivityID if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedByIDRequest) GetActivityID() (o string) {
+func (v *RespondActivityTaskFailedByIDRequest) GetActivityID() (o string) {
 	if v != nil && v.ActivityID != nil {
 		return *v.ActivityID
 	}
@@ -31056,28 +38136,43 @@ func (v *RespondActivityTaskCompletedByIDRequest) GetActivityID() (o string) {
 }
 
 // IsSetActivityID returns true if ActivityID is not nil.
-func (v *RespondActivityTaskCompletedByIDRequest) IsSetActivityID() bool {
+func (v *RespondActivityTaskFailedByIDRequest) IsSetActivityID() bool {
 	return v != nil && v.ActivityID != nil
 }
 
-// GetResult returns the value of Result if it is set or its
+// GetReason returns the value of Reason if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedByIDRequest) GetResult() (o []byte) {
-	if v != nil && v.Result != nil {
-		return v.Result
+func (v *RespondActivityTaskFailedByIDRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
 	}
 
 	return
 }
 
-// IsSetResult returns true if Result is not nil.
-func (v *RespondActivityTaskCompletedByIDRequest) IsSetResult() bool {
-	return v != nil && v.Result != nil
+// IsSetReason returns true if Reason is not nil.
+func (v *RespondActivityTaskFailedByIDRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *RespondActivityTaskFailedByIDRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *RespondActivityTaskFailedByIDRequest) IsSetDetails() bool {
+	return v != nil && v.Details != nil
 }
 
 // GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedByIDRequest) GetIdentity() (o string) {
+func (v *RespondActivityTaskFailedByIDRequest) GetIdentity() (o string) {
 	if v != nil && v.Identity != nil {
 		return *v.Identity
 	}
@@ -31086,17 +38181,18 @@ func (v *RespondActivityTaskCompletedByIDRequest) GetIdentity() (o string) {
 }
 
 // IsSetIdentity returns true if Identity is not nil.
-func (v *RespondActivityTaskCompletedByIDRequest) IsSetIdentity() bool {
+func (v *RespondActivityTaskFailedByIDRequest) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
-type RespondActivityTaskCompletedRequest struct {
+type RespondActivityTaskFailedRequest struct {
 	TaskToken []byte  `json:"taskToken,omitempty"`
-	Result    []byte  `json:"result,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+	Details   []byte  `json:"details,omitempty"`
 	Identity  *string `json:"identity,omitempty"`
 }
 
-// ToWire translates a RespondActivityTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondActivityTaskFailedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -31111,9 +38207,9 @@ type RespondActivityTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -31127,31 +38223,39 @@ func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Result != nil {
-		w, err = wire.NewValueBinary(v.Result), error(nil)
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 	if v.Identity != nil {
 		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RespondActivityTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a RespondActivityTaskFailedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskCompletedRequest struct
+// An error is returned if we were unable to build a RespondActivityTaskFailedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -31159,12 +38263,12 @@ func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskCompletedRequest
+//   var v RespondActivityTaskFailedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -31179,13 +38283,23 @@ func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Result, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -31201,21 +38315,25 @@ func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskCompletedRequest
+// String returns a readable string representation of a RespondActivityTaskFailedRequest
 // struct.
-func (v *RespondActivityTaskCompletedRequest) String() string {
+func (v *RespondActivityTaskFailedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.Result != nil {
-		fields[i] = fmt.Sprintf("Result: %v", v.Result)
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
 	if v.Identity != nil {
@@ -31223,14 +38341,14 @@ func (v *RespondActivityTaskCompletedRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskCompletedRequest match the
-// provided RespondActivityTaskCompletedRequest.
+// Equals returns true if all the fields of this RespondActivityTaskFailedRequest match the
+// provided RespondActivityTaskFailedRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCompletedRequest) bool {
+func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -31239,7 +38357,10 @@ func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCom
 	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
@@ -31250,16 +38371,19 @@ func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCom
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskCompletedRequest.
-func (v *RespondActivityTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondActivityTaskFailedRequest.
+func (v *RespondActivityTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.TaskToken != nil {
 		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
 	}
-	if v.Result != nil {
-		enc.AddString("result", base64.StdEncoding.EncodeToString(v.Result))
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
 	}
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
@@ -31269,7 +38393,7 @@ func (v *RespondActivityTaskCompletedRequest) MarshalLogObject(enc zapcore.Objec
 
 // GetTaskToken returns the value of TaskToken if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedRequest) GetTaskToken() (o []byte) {
+func (v *RespondActivityTaskFailedRequest) GetTaskToken() (o []byte) {
 	if v != nil && v.TaskToken != nil {
 		return v.TaskToken
 	}
@@ -31278,51 +38402,97 @@ func (v *RespondActivityTaskCompletedRequest) GetTaskToken() (o []byte) {
 }
 
 // IsSetTaskToken returns true if TaskToken is not nil.
-func (v *RespondActivityTaskCompletedRequest) IsSetTaskToken() bool {
+func (v *RespondActivityTaskFailedRequest) IsSetTaskToken() bool {
 	return v != nil && v.TaskToken != nil
 }
 
-// GetResult returns the value of Result if it is set or its
+// GetReason returns the value of Reason if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskCompletedRequest) GetResult() (o []byte) {
-	if v != nil && v.Result != nil {
-		return v.Result
+func (v *RespondActivityTaskFailedRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *RespondActivityTaskFailedRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *RespondActivityTaskFailedRequest) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *RespondActivityTaskFailedRequest) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *RespondActivityTaskFailedRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-// IsSetResult returns true if Result is not nil.
-func (v *RespondActivityTaskCompletedRequest) IsSetResult() bool {
-	return v != nil && v.Result != nil
-}
+// IsSetIdentity returns true if Identity is not nil.
+func (v *RespondActivityTaskFailedRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type RespondDecisionTaskCompletedRequest struct {
+	TaskToken                  []byte                     `json:"taskToken,omitempty"`
+	Decisions                  []*Decision                `json:"decisions,omitempty"`
+	ExecutionContext           []byte                     `json:"executionContext,omitempty"`
+	Identity                   *string                    `json:"identity,omitempty"`
+	StickyAttributes           *StickyExecutionAttributes `json:"stickyAttributes,omitempty"`
+	ReturnNewDecisionTask      *bool                      `json:"returnNewDecisionTask,omitempty"`
+	ForceCreateNewDecisionTask *bool                      `json:"forceCreateNewDecisionTask,omitempty"`
+	BinaryChecksum             *string                    `json:"binaryChecksum,omitempty"`
+	ClearExecutionContext      *bool                      `json:"clearExecutionContext,omitempty"`
+}
+
+type _List_Decision_ValueList []*Decision
 
-// GetIdentity returns the value of Identity if it is set or its
-// zero value if it is unset.
-func (v *RespondActivityTaskCompletedRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v _List_Decision_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
-
-	return
+	return nil
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *RespondActivityTaskCompletedRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+func (v _List_Decision_ValueList) Size() int {
+	return len(v)
 }
 
-type RespondActivityTaskFailedByIDRequest struct {
-	Domain     *string `json:"domain,omitempty"`
-	WorkflowID *string `json:"workflowID,omitempty"`
-	RunID      *string `json:"runID,omitempty"`
-	ActivityID *string `json:"activityID,omitempty"`
-	Reason     *string `json:"reason,omitempty"`
-	Details    []byte  `json:"details,omitempty"`
-	Identity   *string `json:"identity,omitempty"`
+func (_List_Decision_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-// ToWire translates a RespondActivityTaskFailedByIDRequest struct into a Thrift-level intermediate
+func (_List_Decision_ValueList) Close() {}
+
+// ToWire translates a RespondDecisionTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -31337,79 +38507,125 @@ type RespondActivityTaskFailedByIDRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskFailedByIDRequest) ToWire() (wire.Value, error) {
+func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowID != nil {
-		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+	if v.Decisions != nil {
+		w, err = wire.NewValueList(_List_Decision_ValueList(v.Decisions)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunID != nil {
-		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+	if v.ExecutionContext != nil {
+		w, err = wire.NewValueBinary(v.ExecutionContext), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ActivityID != nil {
-		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+	if v.StickyAttributes != nil {
+		w, err = v.StickyAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.ReturnNewDecisionTask != nil {
+		w, err = wire.NewValueBool(*(v.ReturnNewDecisionTask)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.ForceCreateNewDecisionTask != nil {
+		w, err = wire.NewValueBool(*(v.ForceCreateNewDecisionTask)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.ClearExecutionContext != nil {
+		w, err = wire.NewValueBool(*(v.ClearExecutionContext)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RespondActivityTaskFailedByIDRequest struct from its Thrift-level
+func _Decision_Read(w wire.Value) (*Decision, error) {
+	var v Decision
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Decision_Read(l wire.ValueList) ([]*Decision, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Decision, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Decision_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _StickyExecutionAttributes_Read(w wire.Value) (*StickyExecutionAttributes, error) {
+	var v StickyExecutionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondDecisionTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskFailedByIDRequest struct
+// An error is returned if we were unable to build a RespondDecisionTaskCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -31417,31 +38633,27 @@ func (v *RespondActivityTaskFailedByIDRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskFailedByIDRequest
+//   var v RespondDecisionTaskCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskFailedByIDRequest) FromWire(w wire.Value) error {
+func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowID = &x
+			if field.Value.Type() == wire.TList {
+				v.Decisions, err = _List_Decision_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -31449,9 +38661,7 @@ func (v *RespondActivityTaskFailedByIDRequest) FromWire(w wire.Value) error {
 			}
 		case 30:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunID = &x
+				v.ExecutionContext, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -31461,35 +38671,55 @@ func (v *RespondActivityTaskFailedByIDRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ActivityID = &x
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
+			if field.Value.Type() == wire.TStruct {
+				v.StickyAttributes, err = _StickyExecutionAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 60:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ReturnNewDecisionTask = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ForceCreateNewDecisionTask = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.BinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ClearExecutionContext = &x
 				if err != nil {
 					return err
 				}
@@ -31501,225 +38731,443 @@ func (v *RespondActivityTaskFailedByIDRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskFailedByIDRequest
+// String returns a readable string representation of a RespondDecisionTaskCompletedRequest
 // struct.
-func (v *RespondActivityTaskFailedByIDRequest) String() string {
+func (v *RespondDecisionTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [9]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.WorkflowID != nil {
-		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+	if v.Decisions != nil {
+		fields[i] = fmt.Sprintf("Decisions: %v", v.Decisions)
 		i++
 	}
-	if v.RunID != nil {
-		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+	if v.ExecutionContext != nil {
+		fields[i] = fmt.Sprintf("ExecutionContext: %v", v.ExecutionContext)
 		i++
 	}
-	if v.ActivityID != nil {
-		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+	if v.StickyAttributes != nil {
+		fields[i] = fmt.Sprintf("StickyAttributes: %v", v.StickyAttributes)
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.ReturnNewDecisionTask != nil {
+		fields[i] = fmt.Sprintf("ReturnNewDecisionTask: %v", *(v.ReturnNewDecisionTask))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.ForceCreateNewDecisionTask != nil {
+		fields[i] = fmt.Sprintf("ForceCreateNewDecisionTask: %v", *(v.ForceCreateNewDecisionTask))
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+		i++
+	}
+	if v.ClearExecutionContext != nil {
+		fields[i] = fmt.Sprintf("ClearExecutionContext: %v", *(v.ClearExecutionContext))
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskFailedByIDRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskFailedByIDRequest match the
-// provided RespondActivityTaskFailedByIDRequest.
+func _List_Decision_Equals(lhs, rhs []*Decision) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this RespondDecisionTaskCompletedRequest match the
+// provided RespondDecisionTaskCompletedRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskFailedByIDRequest) Equals(rhs *RespondActivityTaskFailedByIDRequest) bool {
+func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCompletedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+	if !((v.Decisions == nil && rhs.Decisions == nil) || (v.Decisions != nil && rhs.Decisions != nil && _List_Decision_Equals(v.Decisions, rhs.Decisions))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+	if !((v.ExecutionContext == nil && rhs.ExecutionContext == nil) || (v.ExecutionContext != nil && rhs.ExecutionContext != nil && bytes.Equal(v.ExecutionContext, rhs.ExecutionContext))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+	if !((v.StickyAttributes == nil && rhs.StickyAttributes == nil) || (v.StickyAttributes != nil && rhs.StickyAttributes != nil && v.StickyAttributes.Equals(rhs.StickyAttributes))) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_Bool_EqualsPtr(v.ReturnNewDecisionTask, rhs.ReturnNewDecisionTask) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !_Bool_EqualsPtr(v.ForceCreateNewDecisionTask, rhs.ForceCreateNewDecisionTask) {
+		return false
+	}
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ClearExecutionContext, rhs.ClearExecutionContext) {
 		return false
 	}
 
 	return true
 }
 
+type _List_Decision_Zapper []*Decision
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Decision_Zapper.
+func (l _List_Decision_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskFailedByIDRequest.
-func (v *RespondActivityTaskFailedByIDRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondDecisionTaskCompletedRequest.
+func (v *RespondDecisionTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
+	if v.TaskToken != nil {
+		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
 	}
-	if v.WorkflowID != nil {
-		enc.AddString("workflowID", *v.WorkflowID)
+	if v.Decisions != nil {
+		err = multierr.Append(err, enc.AddArray("decisions", (_List_Decision_Zapper)(v.Decisions)))
 	}
-	if v.RunID != nil {
-		enc.AddString("runID", *v.RunID)
+	if v.ExecutionContext != nil {
+		enc.AddString("executionContext", base64.StdEncoding.EncodeToString(v.ExecutionContext))
 	}
-	if v.ActivityID != nil {
-		enc.AddString("activityID", *v.ActivityID)
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
-	if v.Reason != nil {
-		enc.AddString("reason", *v.Reason)
+	if v.StickyAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("stickyAttributes", v.StickyAttributes))
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.ReturnNewDecisionTask != nil {
+		enc.AddBool("returnNewDecisionTask", *v.ReturnNewDecisionTask)
 	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
+	if v.ForceCreateNewDecisionTask != nil {
+		enc.AddBool("forceCreateNewDecisionTask", *v.ForceCreateNewDecisionTask)
+	}
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	}
+	if v.ClearExecutionContext != nil {
+		enc.AddBool("clearExecutionContext", *v.ClearExecutionContext)
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetTaskToken returns the value of TaskToken if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedByIDRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *RespondDecisionTaskCompletedRequest) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *RespondActivityTaskFailedByIDRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetTaskToken returns true if TaskToken is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetTaskToken() bool {
+	return v != nil && v.TaskToken != nil
 }
 
-// GetWorkflowID returns the value of WorkflowID if it is set or its
+// GetDecisions returns the value of Decisions if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedByIDRequest) GetWorkflowID() (o string) {
-	if v != nil && v.WorkflowID != nil {
-		return *v.WorkflowID
+func (v *RespondDecisionTaskCompletedRequest) GetDecisions() (o []*Decision) {
+	if v != nil && v.Decisions != nil {
+		return v.Decisions
 	}
 
 	return
 }
 
-// IsSetWorkflowID returns true if WorkflowID is not nil.
-func (v *RespondActivityTaskFailedByIDRequest) IsSetWorkflowID() bool {
-	return v != nil && v.WorkflowID != nil
+// IsSetDecisions returns true if Decisions is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetDecisions() bool {
+	return v != nil && v.Decisions != nil
 }
 
-// GetRunID returns the value of RunID if it is set or its
+// GetExecutionContext returns the value of ExecutionContext if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedByIDRequest) GetRunID() (o string) {
-	if v != nil && v.RunID != nil {
-		return *v.RunID
+func (v *RespondDecisionTaskCompletedRequest) GetExecutionContext() (o []byte) {
+	if v != nil && v.ExecutionContext != nil {
+		return v.ExecutionContext
 	}
 
 	return
 }
 
-// IsSetRunID returns true if RunID is not nil.
-func (v *RespondActivityTaskFailedByIDRequest) IsSetRunID() bool {
-	return v != nil && v.RunID != nil
+// IsSetExecutionContext returns true if ExecutionContext is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetExecutionContext() bool {
+	return v != nil && v.ExecutionContext != nil
 }
 
-// GetActivityID returns the value of ActivityID if it is set or its
+// GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedByIDRequest) GetActivityID() (o string) {
-	if v != nil && v.ActivityID != nil {
-		return *v.ActivityID
+func (v *RespondDecisionTaskCompletedRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-// IsSetActivityID returns true if ActivityID is not nil.
-func (v *RespondActivityTaskFailedByIDRequest) IsSetActivityID() bool {
-	return v != nil && v.ActivityID != nil
+// IsSetIdentity returns true if Identity is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetStickyAttributes returns the value of StickyAttributes if it is set or its
+// zero value if it is unset.
+func (v *RespondDecisionTaskCompletedRequest) GetStickyAttributes() (o *StickyExecutionAttributes) {
+	if v != nil && v.StickyAttributes != nil {
+		return v.StickyAttributes
+	}
+
+	return
+}
+
+// IsSetStickyAttributes returns true if StickyAttributes is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetStickyAttributes() bool {
+	return v != nil && v.StickyAttributes != nil
+}
+
+// GetReturnNewDecisionTask returns the value of ReturnNewDecisionTask if it is set or its
+// zero value if it is unset.
+func (v *RespondDecisionTaskCompletedRequest) GetReturnNewDecisionTask() (o bool) {
+	if v != nil && v.ReturnNewDecisionTask != nil {
+		return *v.ReturnNewDecisionTask
+	}
+
+	return
+}
+
+// IsSetReturnNewDecisionTask returns true if ReturnNewDecisionTask is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetReturnNewDecisionTask() bool {
+	return v != nil && v.ReturnNewDecisionTask != nil
+}
+
+// GetForceCreateNewDecisionTask returns the value of ForceCreateNewDecisionTask if it is set or its
+// zero value if it is unset.
+func (v *RespondDecisionTaskCompletedRequest) GetForceCreateNewDecisionTask() (o bool) {
+	if v != nil && v.ForceCreateNewDecisionTask != nil {
+		return *v.ForceCreateNewDecisionTask
+	}
+
+	return
+}
+
+// IsSetForceCreateNewDecisionTask returns true if ForceCreateNewDecisionTask is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetForceCreateNewDecisionTask() bool {
+	return v != nil && v.ForceCreateNewDecisionTask != nil
+}
+
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *RespondDecisionTaskCompletedRequest) GetBinaryChecksum() (o string) {
+	if v != nil && v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
+	}
+
+	return
+}
+
+// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetBinaryChecksum() bool {
+	return v != nil && v.BinaryChecksum != nil
+}
+
+// GetClearExecutionContext returns the value of ClearExecutionContext if it is set or its
+// zero value if it is unset.
+func (v *RespondDecisionTaskCompletedRequest) GetClearExecutionContext() (o bool) {
+	if v != nil && v.ClearExecutionContext != nil {
+		return *v.ClearExecutionContext
+	}
+
+	return
+}
+
+// IsSetClearExecutionContext returns true if ClearExecutionContext is not nil.
+func (v *RespondDecisionTaskCompletedRequest) IsSetClearExecutionContext() bool {
+	return v != nil && v.ClearExecutionContext != nil
+}
+
+type RespondDecisionTaskCompletedResponse struct {
+	DecisionTask *PollForDecisionTaskResponse `json:"decisionTask,omitempty"`
+}
+
+// ToWire translates a RespondDecisionTaskCompletedResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RespondDecisionTaskCompletedResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DecisionTask != nil {
+		w, err = v.DecisionTask.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskResponse, error) {
+	var v PollForDecisionTaskResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondDecisionTaskCompletedResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RespondDecisionTaskCompletedResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RespondDecisionTaskCompletedResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RespondDecisionTaskCompletedResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionTask, err = _PollForDecisionTaskResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
 }
 
-// GetReason returns the value of Reason if it is set or its
-// zero value if it is unset.
-func (v *RespondActivityTaskFailedByIDRequest) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
+// String returns a readable string representation of a RespondDecisionTaskCompletedResponse
+// struct.
+func (v *RespondDecisionTaskCompletedResponse) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [1]string
+	i := 0
+	if v.DecisionTask != nil {
+		fields[i] = fmt.Sprintf("DecisionTask: %v", v.DecisionTask)
+		i++
+	}
 
-// IsSetReason returns true if Reason is not nil.
-func (v *RespondActivityTaskFailedByIDRequest) IsSetReason() bool {
-	return v != nil && v.Reason != nil
+	return fmt.Sprintf("RespondDecisionTaskCompletedResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetDetails returns the value of Details if it is set or its
-// zero value if it is unset.
-func (v *RespondActivityTaskFailedByIDRequest) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+// Equals returns true if all the fields of this RespondDecisionTaskCompletedResponse match the
+// provided RespondDecisionTaskCompletedResponse.
+//
+// This function performs a deep comparison.
+func (v *RespondDecisionTaskCompletedResponse) Equals(rhs *RespondDecisionTaskCompletedResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.DecisionTask == nil && rhs.DecisionTask == nil) || (v.DecisionTask != nil && rhs.DecisionTask != nil && v.DecisionTask.Equals(rhs.DecisionTask))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *RespondActivityTaskFailedByIDRequest) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RespondDecisionTaskCompletedResponse.
+func (v *RespondDecisionTaskCompletedResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DecisionTask != nil {
+		err = multierr.Append(err, enc.AddObject("decisionTask", v.DecisionTask))
+	}
+	return err
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetDecisionTask returns the value of DecisionTask if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedByIDRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *RespondDecisionTaskCompletedResponse) GetDecisionTask() (o *PollForDecisionTaskResponse) {
+	if v != nil && v.DecisionTask != nil {
+		return v.DecisionTask
 	}
 
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *RespondActivityTaskFailedByIDRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+// IsSetDecisionTask returns true if DecisionTask is not nil.
+func (v *RespondDecisionTaskCompletedResponse) IsSetDecisionTask() bool {
+	return v != nil && v.DecisionTask != nil
 }
 
-type RespondActivityTaskFailedRequest struct {
-	TaskToken []byte  `json:"taskToken,omitempty"`
-	Reason    *string `json:"reason,omitempty"`
-	Details   []byte  `json:"details,omitempty"`
-	Identity  *string `json:"identity,omitempty"`
+type RespondDecisionTaskFailedRequest struct {
+	TaskToken []byte                   `json:"taskToken,omitempty"`
+	Cause     *DecisionTaskFailedCause `json:"cause,omitempty"`
+	Details   []byte                   `json:"details,omitempty"`
+	Identity  *string                  `json:"identity,omitempty"`
 }
 
-// ToWire translates a RespondActivityTaskFailedRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondDecisionTaskFailedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -31734,7 +39182,7 @@ type RespondActivityTaskFailedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
+func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
@@ -31750,8 +39198,8 @@ func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -31778,11 +39226,11 @@ func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RespondActivityTaskFailedRequest struct from its Thrift-level
+// FromWire deserializes a RespondDecisionTaskFailedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondActivityTaskFailedRequest struct
+// An error is returned if we were unable to build a RespondDecisionTaskFailedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -31790,12 +39238,12 @@ func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RespondActivityTaskFailedRequest
+//   var v RespondDecisionTaskFailedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
+func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -31809,10 +39257,10 @@ func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
+			if field.Value.Type() == wire.TI32 {
+				var x DecisionTaskFailedCause
+				x, err = _DecisionTaskFailedCause_Read(field.Value)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
@@ -31842,9 +39290,9 @@ func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondActivityTaskFailedRequest
+// String returns a readable string representation of a RespondDecisionTaskFailedRequest
 // struct.
-func (v *RespondActivityTaskFailedRequest) String() string {
+func (v *RespondDecisionTaskFailedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -31855,8 +39303,8 @@ func (v *RespondActivityTaskFailedRequest) String() string {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
 		i++
 	}
 	if v.Details != nil {
@@ -31868,14 +39316,14 @@ func (v *RespondActivityTaskFailedRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondDecisionTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondActivityTaskFailedRequest match the
-// provided RespondActivityTaskFailedRequest.
+// Equals returns true if all the fields of this RespondDecisionTaskFailedRequest match the
+// provided RespondDecisionTaskFailedRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailedRequest) bool {
+func (v *RespondDecisionTaskFailedRequest) Equals(rhs *RespondDecisionTaskFailedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -31884,7 +39332,7 @@ func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailed
 	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+	if !_DecisionTaskFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
 		return false
 	}
 	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
@@ -31898,16 +39346,16 @@ func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailed
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondActivityTaskFailedRequest.
-func (v *RespondActivityTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondDecisionTaskFailedRequest.
+func (v *RespondDecisionTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.TaskToken != nil {
 		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
 	}
-	if v.Reason != nil {
-		enc.AddString("reason", *v.Reason)
+	if v.Cause != nil {
+		err = multierr.Append(err, enc.AddObject("cause", *v.Cause))
 	}
 	if v.Details != nil {
 		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
@@ -31920,7 +39368,7 @@ func (v *RespondActivityTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEn
 
 // GetTaskToken returns the value of TaskToken if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedRequest) GetTaskToken() (o []byte) {
+func (v *RespondDecisionTaskFailedRequest) GetTaskToken() (o []byte) {
 	if v != nil && v.TaskToken != nil {
 		return v.TaskToken
 	}
@@ -31929,28 +39377,28 @@ func (v *RespondActivityTaskFailedRequest) GetTaskToken() (o []byte) {
 }
 
 // IsSetTaskToken returns true if TaskToken is not nil.
-func (v *RespondActivityTaskFailedRequest) IsSetTaskToken() bool {
+func (v *RespondDecisionTaskFailedRequest) IsSetTaskToken() bool {
 	return v != nil && v.TaskToken != nil
 }
 
-// GetReason returns the value of Reason if it is set or its
+// GetCause returns the value of Cause if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedRequest) GetReason() (o string) {
-	if v != nil && v.Reason != nil {
-		return *v.Reason
+func (v *RespondDecisionTaskFailedRequest) GetCause() (o DecisionTaskFailedCause) {
+	if v != nil && v.Cause != nil {
+		return *v.Cause
 	}
 
 	return
 }
 
-// IsSetReason returns true if Reason is not nil.
-func (v *RespondActivityTaskFailedRequest) IsSetReason() bool {
-	return v != nil && v.Reason != nil
+// IsSetCause returns true if Cause is not nil.
+func (v *RespondDecisionTaskFailedRequest) IsSetCause() bool {
+	return v != nil && v.Cause != nil
 }
 
 // GetDetails returns the value of Details if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedRequest) GetDetails() (o []byte) {
+func (v *RespondDecisionTaskFailedRequest) GetDetails() (o []byte) {
 	if v != nil && v.Details != nil {
 		return v.Details
 	}
@@ -31959,13 +39407,13 @@ func (v *RespondActivityTaskFailedRequest) GetDetails() (o []byte) {
 }
 
 // IsSetDetails returns true if Details is not nil.
-func (v *RespondActivityTaskFailedRequest) IsSetDetails() bool {
+func (v *RespondDecisionTaskFailedRequest) IsSetDetails() bool {
 	return v != nil && v.Details != nil
 }
 
 // GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *RespondActivityTaskFailedRequest) GetIdentity() (o string) {
+func (v *RespondDecisionTaskFailedRequest) GetIdentity() (o string) {
 	if v != nil && v.Identity != nil {
 		return *v.Identity
 	}
@@ -31974,51 +39422,18 @@ func (v *RespondActivityTaskFailedRequest) GetIdentity() (o string) {
 }
 
 // IsSetIdentity returns true if Identity is not nil.
-func (v *RespondActivityTaskFailedRequest) IsSetIdentity() bool {
+func (v *RespondDecisionTaskFailedRequest) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
-type RespondDecisionTaskCompletedRequest struct {
-	TaskToken                  []byte                     `json:"taskToken,omitempty"`
-	Decisions                  []*Decision                `json:"decisions,omitempty"`
-	ExecutionContext           []byte                     `json:"executionContext,omitempty"`
-	Identity                   *string                    `json:"identity,omitempty"`
-	StickyAttributes           *StickyExecutionAttributes `json:"stickyAttributes,omitempty"`
-	ReturnNewDecisionTask      *bool                      `json:"returnNewDecisionTask,omitempty"`
-	ForceCreateNewDecisionTask *bool                      `json:"forceCreateNewDecisionTask,omitempty"`
-	BinaryChecksum             *string                    `json:"binaryChecksum,omitempty"`
-}
-
-type _List_Decision_ValueList []*Decision
-
-func (v _List_Decision_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_Decision_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_Decision_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+type RespondQueryTaskCompletedRequest struct {
+	TaskToken     []byte                  `json:"taskToken,omitempty"`
+	CompletedType *QueryTaskCompletedType `json:"completedType,omitempty"`
+	QueryResult   []byte                  `json:"queryResult,omitempty"`
+	ErrorMessage  *string                 `json:"errorMessage,omitempty"`
 }
 
-func (_List_Decision_ValueList) Close() {}
-
-// ToWire translates a RespondDecisionTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -32033,9 +39448,9 @@ func (_List_Decision_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -32049,101 +39464,45 @@ func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Decisions != nil {
-		w, err = wire.NewValueList(_List_Decision_ValueList(v.Decisions)), error(nil)
+	if v.CompletedType != nil {
+		w, err = v.CompletedType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ExecutionContext != nil {
-		w, err = wire.NewValueBinary(v.ExecutionContext), error(nil)
+	if v.QueryResult != nil {
+		w, err = wire.NewValueBinary(v.QueryResult), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.ErrorMessage != nil {
+		w, err = wire.NewValueString(*(v.ErrorMessage)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.StickyAttributes != nil {
-		w, err = v.StickyAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.ReturnNewDecisionTask != nil {
-		w, err = wire.NewValueBool(*(v.ReturnNewDecisionTask)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.ForceCreateNewDecisionTask != nil {
-		w, err = wire.NewValueBool(*(v.ForceCreateNewDecisionTask)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.BinaryChecksum != nil {
-		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Decision_Read(w wire.Value) (*Decision, error) {
-	var v Decision
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_Decision_Read(l wire.ValueList) ([]*Decision, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*Decision, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _Decision_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func _StickyExecutionAttributes_Read(w wire.Value) (*StickyExecutionAttributes, error) {
-	var v StickyExecutionAttributes
+func _QueryTaskCompletedType_Read(w wire.Value) (QueryTaskCompletedType, error) {
+	var v QueryTaskCompletedType
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-// FromWire deserializes a RespondDecisionTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondDecisionTaskCompletedRequest struct
+// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -32151,12 +39510,12 @@ func _StickyExecutionAttributes_Read(w wire.Value) (*StickyExecutionAttributes,
 //     return nil, err
 //   }
 //
-//   var v RespondDecisionTaskCompletedRequest
+//   var v RespondQueryTaskCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -32170,8 +39529,10 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Decisions, err = _List_Decision_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TI32 {
+				var x QueryTaskCompletedType
+				x, err = _QueryTaskCompletedType_Read(field.Value)
+				v.CompletedType = &x
 				if err != nil {
 					return err
 				}
@@ -32179,7 +39540,7 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 			}
 		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.ExecutionContext, err = field.Value.GetBinary(), error(nil)
+				v.QueryResult, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -32189,45 +39550,7 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.StickyAttributes, err = _StickyExecutionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ReturnNewDecisionTask = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ForceCreateNewDecisionTask = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.BinaryChecksum = &x
+				v.ErrorMessage = &x
 				if err != nil {
 					return err
 				}
@@ -32239,71 +39562,50 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondDecisionTaskCompletedRequest
+// String returns a readable string representation of a RespondQueryTaskCompletedRequest
 // struct.
-func (v *RespondDecisionTaskCompletedRequest) String() string {
+func (v *RespondQueryTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [4]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.Decisions != nil {
-		fields[i] = fmt.Sprintf("Decisions: %v", v.Decisions)
-		i++
-	}
-	if v.ExecutionContext != nil {
-		fields[i] = fmt.Sprintf("ExecutionContext: %v", v.ExecutionContext)
-		i++
-	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
-		i++
-	}
-	if v.StickyAttributes != nil {
-		fields[i] = fmt.Sprintf("StickyAttributes: %v", v.StickyAttributes)
-		i++
-	}
-	if v.ReturnNewDecisionTask != nil {
-		fields[i] = fmt.Sprintf("ReturnNewDecisionTask: %v", *(v.ReturnNewDecisionTask))
+	if v.CompletedType != nil {
+		fields[i] = fmt.Sprintf("CompletedType: %v", *(v.CompletedType))
 		i++
 	}
-	if v.ForceCreateNewDecisionTask != nil {
-		fields[i] = fmt.Sprintf("ForceCreateNewDecisionTask: %v", *(v.ForceCreateNewDecisionTask))
+	if v.QueryResult != nil {
+		fields[i] = fmt.Sprintf("QueryResult: %v", v.QueryResult)
 		i++
 	}
-	if v.BinaryChecksum != nil {
-		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+	if v.ErrorMessage != nil {
+		fields[i] = fmt.Sprintf("ErrorMessage: %v", *(v.ErrorMessage))
 		i++
 	}
 
-	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_Decision_Equals(lhs, rhs []*Decision) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
+func _QueryTaskCompletedType_EqualsPtr(lhs, rhs *QueryTaskCompletedType) bool {
+	if lhs != nil && rhs != nil {
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
 	}
-
-	return true
+	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this RespondDecisionTaskCompletedRequest match the
-// provided RespondDecisionTaskCompletedRequest.
+// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
+// provided RespondQueryTaskCompletedRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCompletedRequest) bool {
+func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -32312,200 +39614,108 @@ func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCom
 	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !((v.Decisions == nil && rhs.Decisions == nil) || (v.Decisions != nil && rhs.Decisions != nil && _List_Decision_Equals(v.Decisions, rhs.Decisions))) {
-		return false
-	}
-	if !((v.ExecutionContext == nil && rhs.ExecutionContext == nil) || (v.ExecutionContext != nil && rhs.ExecutionContext != nil && bytes.Equal(v.ExecutionContext, rhs.ExecutionContext))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
-		return false
-	}
-	if !((v.StickyAttributes == nil && rhs.StickyAttributes == nil) || (v.StickyAttributes != nil && rhs.StickyAttributes != nil && v.StickyAttributes.Equals(rhs.StickyAttributes))) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.ReturnNewDecisionTask, rhs.ReturnNewDecisionTask) {
+	if !_QueryTaskCompletedType_EqualsPtr(v.CompletedType, rhs.CompletedType) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ForceCreateNewDecisionTask, rhs.ForceCreateNewDecisionTask) {
+	if !((v.QueryResult == nil && rhs.QueryResult == nil) || (v.QueryResult != nil && rhs.QueryResult != nil && bytes.Equal(v.QueryResult, rhs.QueryResult))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+	if !_String_EqualsPtr(v.ErrorMessage, rhs.ErrorMessage) {
 		return false
 	}
 
 	return true
 }
 
-type _List_Decision_Zapper []*Decision
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_Decision_Zapper.
-func (l _List_Decision_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondDecisionTaskCompletedRequest.
-func (v *RespondDecisionTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RespondQueryTaskCompletedRequest.
+func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.TaskToken != nil {
 		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
 	}
-	if v.Decisions != nil {
-		err = multierr.Append(err, enc.AddArray("decisions", (_List_Decision_Zapper)(v.Decisions)))
-	}
-	if v.ExecutionContext != nil {
-		enc.AddString("executionContext", base64.StdEncoding.EncodeToString(v.ExecutionContext))
-	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
-	}
-	if v.StickyAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("stickyAttributes", v.StickyAttributes))
-	}
-	if v.ReturnNewDecisionTask != nil {
-		enc.AddBool("returnNewDecisionTask", *v.ReturnNewDecisionTask)
+	if v.CompletedType != nil {
+		err = multierr.Append(err, enc.AddObject("completedType", *v.CompletedType))
 	}
-	if v.ForceCreateNewDecisionTask != nil {
-		enc.AddBool("forceCreateNewDecisionTask", *v.ForceCreateNewDecisionTask)
+	if v.QueryResult != nil {
+		enc.AddString("queryResult", base64.StdEncoding.EncodeToString(v.QueryResult))
 	}
-	if v.BinaryChecksum != nil {
-		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	if v.ErrorMessage != nil {
+		enc.AddString("errorMessage", *v.ErrorMessage)
 	}
 	return err
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
-// zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
-	}
-
-	return
-}
-
-// IsSetTaskToken returns true if TaskToken is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetTaskToken() bool {
-	return v != nil && v.TaskToken != nil
-}
-
-// GetDecisions returns the value of Decisions if it is set or its
-// zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetDecisions() (o []*Decision) {
-	if v != nil && v.Decisions != nil {
-		return v.Decisions
-	}
-
-	return
-}
-
-// IsSetDecisions returns true if Decisions is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetDecisions() bool {
-	return v != nil && v.Decisions != nil
-}
-
-// GetExecutionContext returns the value of ExecutionContext if it is set or its
-// zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetExecutionContext() (o []byte) {
-	if v != nil && v.ExecutionContext != nil {
-		return v.ExecutionContext
-	}
-
-	return
-}
-
-// IsSetExecutionContext returns true if ExecutionContext is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetExecutionContext() bool {
-	return v != nil && v.ExecutionContext != nil
-}
-
-// GetIdentity returns the value of Identity if it is set or its
-// zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
-	}
-
-	return
-}
-
-// IsSetIdentity returns true if Identity is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
-}
-
-// GetStickyAttributes returns the value of StickyAttributes if it is set or its
+// GetTaskToken returns the value of TaskToken if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetStickyAttributes() (o *StickyExecutionAttributes) {
-	if v != nil && v.StickyAttributes != nil {
-		return v.StickyAttributes
+func (v *RespondQueryTaskCompletedRequest) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
 	}
 
 	return
 }
 
-// IsSetStickyAttributes returns true if StickyAttributes is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetStickyAttributes() bool {
-	return v != nil && v.StickyAttributes != nil
+// IsSetTaskToken returns true if TaskToken is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskToken() bool {
+	return v != nil && v.TaskToken != nil
 }
 
-// GetReturnNewDecisionTask returns the value of ReturnNewDecisionTask if it is set or its
+// GetCompletedType returns the value of CompletedType if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetReturnNewDecisionTask() (o bool) {
-	if v != nil && v.ReturnNewDecisionTask != nil {
-		return *v.ReturnNewDecisionTask
+func (v *RespondQueryTaskCompletedRequest) GetCompletedType() (o QueryTaskCompletedType) {
+	if v != nil && v.CompletedType != nil {
+		return *v.CompletedType
 	}
 
 	return
 }
 
-// IsSetReturnNewDecisionTask returns true if ReturnNewDecisionTask is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetReturnNewDecisionTask() bool {
-	return v != nil && v.ReturnNewDecisionTask != nil
+// IsSetCompletedType returns true if CompletedType is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetCompletedType() bool {
+	return v != nil && v.CompletedType != nil
 }
 
-// GetForceCreateNewDecisionTask returns the value of ForceCreateNewDecisionTask if it is set or its
+// GetQueryResult returns the value of QueryResult if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetForceCreateNewDecisionTask() (o bool) {
-	if v != nil && v.ForceCreateNewDecisionTask != nil {
-		return *v.ForceCreateNewDecisionTask
+func (v *RespondQueryTaskCompletedRequest) GetQueryResult() (o []byte) {
+	if v != nil && v.QueryResult != nil {
+		return v.QueryResult
 	}
 
 	return
 }
 
-// IsSetForceCreateNewDecisionTask returns true if ForceCreateNewDecisionTask is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetForceCreateNewDecisionTask() bool {
-	return v != nil && v.ForceCreateNewDecisionTask != nil
+// IsSetQueryResult returns true if QueryResult is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetQueryResult() bool {
+	return v != nil && v.QueryResult != nil
 }
 
-// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// GetErrorMessage returns the value of ErrorMessage if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedRequest) GetBinaryChecksum() (o string) {
-	if v != nil && v.BinaryChecksum != nil {
-		return *v.BinaryChecksum
+func (v *RespondQueryTaskCompletedRequest) GetErrorMessage() (o string) {
+	if v != nil && v.ErrorMessage != nil {
+		return *v.ErrorMessage
 	}
 
 	return
 }
 
-// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
-func (v *RespondDecisionTaskCompletedRequest) IsSetBinaryChecksum() bool {
-	return v != nil && v.BinaryChecksum != nil
+// IsSetErrorMessage returns true if ErrorMessage is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetErrorMessage() bool {
+	return v != nil && v.ErrorMessage != nil
 }
 
-type RespondDecisionTaskCompletedResponse struct {
-	DecisionTask *PollForDecisionTaskResponse `json:"decisionTask,omitempty"`
+type ResumeWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            *string            `json:"reason,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
 }
 
-// ToWire translates a RespondDecisionTaskCompletedResponse struct into a Thrift-level intermediate
+// ToWire translates a ResumeWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -32520,37 +39730,55 @@ type RespondDecisionTaskCompletedResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondDecisionTaskCompletedResponse) ToWire() (wire.Value, error) {
+func (v *ResumeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DecisionTask != nil {
-		w, err = v.DecisionTask.ToWire()
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskResponse, error) {
-	var v PollForDecisionTaskResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RespondDecisionTaskCompletedResponse struct from its Thrift-level
+// FromWire deserializes a ResumeWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondDecisionTaskCompletedResponse struct
+// An error is returned if we were unable to build a ResumeWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -32558,19 +39786,49 @@ func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskRespon
 //     return nil, err
 //   }
 //
-//   var v RespondDecisionTaskCompletedResponse
+//   var v ResumeWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondDecisionTaskCompletedResponse) FromWire(w wire.Value) error {
+func (v *ResumeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.DecisionTask, err = _PollForDecisionTaskResponse_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -32582,34 +39840,55 @@ func (v *RespondDecisionTaskCompletedResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondDecisionTaskCompletedResponse
+// String returns a readable string representation of a ResumeWorkflowExecutionRequest
 // struct.
-func (v *RespondDecisionTaskCompletedResponse) String() string {
+func (v *ResumeWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [4]string
 	i := 0
-	if v.DecisionTask != nil {
-		fields[i] = fmt.Sprintf("DecisionTask: %v", v.DecisionTask)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("RespondDecisionTaskCompletedResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResumeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondDecisionTaskCompletedResponse match the
-// provided RespondDecisionTaskCompletedResponse.
+// Equals returns true if all the fields of this ResumeWorkflowExecutionRequest match the
+// provided ResumeWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondDecisionTaskCompletedResponse) Equals(rhs *RespondDecisionTaskCompletedResponse) bool {
+func (v *ResumeWorkflowExecutionRequest) Equals(rhs *ResumeWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.DecisionTask == nil && rhs.DecisionTask == nil) || (v.DecisionTask != nil && rhs.DecisionTask != nil && v.DecisionTask.Equals(rhs.DecisionTask))) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -32617,40 +39896,122 @@ func (v *RespondDecisionTaskCompletedResponse) Equals(rhs *RespondDecisionTaskCo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondDecisionTaskCompletedResponse.
-func (v *RespondDecisionTaskCompletedResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResumeWorkflowExecutionRequest.
+func (v *ResumeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DecisionTask != nil {
-		err = multierr.Append(err, enc.AddObject("decisionTask", v.DecisionTask))
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
 	return err
 }
 
-// GetDecisionTask returns the value of DecisionTask if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskCompletedResponse) GetDecisionTask() (o *PollForDecisionTaskResponse) {
-	if v != nil && v.DecisionTask != nil {
-		return v.DecisionTask
+func (v *ResumeWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetDecisionTask returns true if DecisionTask is not nil.
-func (v *RespondDecisionTaskCompletedResponse) IsSetDecisionTask() bool {
-	return v != nil && v.DecisionTask != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-type RespondDecisionTaskFailedRequest struct {
-	TaskToken []byte                   `json:"taskToken,omitempty"`
-	Cause     *DecisionTaskFailedCause `json:"cause,omitempty"`
-	Details   []byte                   `json:"details,omitempty"`
-	Identity  *string                  `json:"identity,omitempty"`
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
 }
 
-// ToWire translates a RespondDecisionTaskFailedRequest struct into a Thrift-level intermediate
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *ResumeWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *ResumeWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type RetryPolicy struct {
+	InitialIntervalInSeconds    *int32   `json:"initialIntervalInSeconds,omitempty"`
+	BackoffCoefficient          *float64 `json:"backoffCoefficient,omitempty"`
+	MaximumIntervalInSeconds    *int32   `json:"maximumIntervalInSeconds,omitempty"`
+	MaximumAttempts             *int32   `json:"maximumAttempts,omitempty"`
+	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
+	ExpirationIntervalInSeconds *int32   `json:"expirationIntervalInSeconds,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a RetryPolicy struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -32665,55 +40026,89 @@ type RespondDecisionTaskFailedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
+func (v *RetryPolicy) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.InitialIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.InitialIntervalInSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Cause != nil {
-		w, err = v.Cause.ToWire()
+	if v.BackoffCoefficient != nil {
+		w, err = wire.NewValueDouble(*(v.BackoffCoefficient)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.MaximumIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.MaximumIntervalInSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.MaximumAttempts != nil {
+		w, err = wire.NewValueI32(*(v.MaximumAttempts)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.NonRetriableErrorReasons != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.NonRetriableErrorReasons)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ExpirationIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ExpirationIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RespondDecisionTaskFailedRequest struct from its Thrift-level
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a RetryPolicy struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondDecisionTaskFailedRequest struct
+// An error is returned if we were unable to build a RetryPolicy struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -32721,47 +40116,69 @@ func (v *RespondDecisionTaskFailedRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RespondDecisionTaskFailedRequest
+//   var v RetryPolicy
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
+func (v *RetryPolicy) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.InitialIntervalInSeconds = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x DecisionTaskFailedCause
-				x, err = _DecisionTaskFailedCause_Read(field.Value)
-				v.Cause = &x
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.BackoffCoefficient = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumIntervalInSeconds = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumAttempts = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TList {
+				v.NonRetriableErrorReasons, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExpirationIntervalInSeconds = &x
 				if err != nil {
 					return err
 				}
@@ -32773,150 +40190,227 @@ func (v *RespondDecisionTaskFailedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondDecisionTaskFailedRequest
+// String returns a readable string representation of a RetryPolicy
 // struct.
-func (v *RespondDecisionTaskFailedRequest) String() string {
+func (v *RetryPolicy) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+	if v.InitialIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("InitialIntervalInSeconds: %v", *(v.InitialIntervalInSeconds))
 		i++
 	}
-	if v.Cause != nil {
-		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
+	if v.BackoffCoefficient != nil {
+		fields[i] = fmt.Sprintf("BackoffCoefficient: %v", *(v.BackoffCoefficient))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.MaximumIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("MaximumIntervalInSeconds: %v", *(v.MaximumIntervalInSeconds))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.MaximumAttempts != nil {
+		fields[i] = fmt.Sprintf("MaximumAttempts: %v", *(v.MaximumAttempts))
+		i++
+	}
+	if v.NonRetriableErrorReasons != nil {
+		fields[i] = fmt.Sprintf("NonRetriableErrorReasons: %v", v.NonRetriableErrorReasons)
+		i++
+	}
+	if v.ExpirationIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("ExpirationIntervalInSeconds: %v", *(v.ExpirationIntervalInSeconds))
 		i++
 	}
 
-	return fmt.Sprintf("RespondDecisionTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RetryPolicy{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondDecisionTaskFailedRequest match the
-// provided RespondDecisionTaskFailedRequest.
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this RetryPolicy match the
+// provided RetryPolicy.
 //
 // This function performs a deep comparison.
-func (v *RespondDecisionTaskFailedRequest) Equals(rhs *RespondDecisionTaskFailedRequest) bool {
+func (v *RetryPolicy) Equals(rhs *RetryPolicy) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+	if !_I32_EqualsPtr(v.InitialIntervalInSeconds, rhs.InitialIntervalInSeconds) {
 		return false
 	}
-	if !_DecisionTaskFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
+	if !_Double_EqualsPtr(v.BackoffCoefficient, rhs.BackoffCoefficient) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_I32_EqualsPtr(v.MaximumIntervalInSeconds, rhs.MaximumIntervalInSeconds) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !_I32_EqualsPtr(v.MaximumAttempts, rhs.MaximumAttempts) {
+		return false
+	}
+	if !((v.NonRetriableErrorReasons == nil && rhs.NonRetriableErrorReasons == nil) || (v.NonRetriableErrorReasons != nil && rhs.NonRetriableErrorReasons != nil && _List_String_Equals(v.NonRetriableErrorReasons, rhs.NonRetriableErrorReasons))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ExpirationIntervalInSeconds, rhs.ExpirationIntervalInSeconds) {
 		return false
 	}
 
 	return true
 }
 
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondDecisionTaskFailedRequest.
-func (v *RespondDecisionTaskFailedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RetryPolicy.
+func (v *RetryPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskToken != nil {
-		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	if v.InitialIntervalInSeconds != nil {
+		enc.AddInt32("initialIntervalInSeconds", *v.InitialIntervalInSeconds)
 	}
-	if v.Cause != nil {
-		err = multierr.Append(err, enc.AddObject("cause", *v.Cause))
+	if v.BackoffCoefficient != nil {
+		enc.AddFloat64("backoffCoefficient", *v.BackoffCoefficient)
 	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	if v.MaximumIntervalInSeconds != nil {
+		enc.AddInt32("maximumIntervalInSeconds", *v.MaximumIntervalInSeconds)
 	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
+	if v.MaximumAttempts != nil {
+		enc.AddInt32("maximumAttempts", *v.MaximumAttempts)
+	}
+	if v.NonRetriableErrorReasons != nil {
+		err = multierr.Append(err, enc.AddArray("nonRetriableErrorReasons", (_List_String_Zapper)(v.NonRetriableErrorReasons)))
+	}
+	if v.ExpirationIntervalInSeconds != nil {
+		enc.AddInt32("expirationIntervalInSeconds", *v.ExpirationIntervalInSeconds)
 	}
 	return err
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
+// GetInitialIntervalInSeconds returns the value of InitialIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *RetryPolicy) GetInitialIntervalInSeconds() (o int32) {
+	if v != nil && v.InitialIntervalInSeconds != nil {
+		return *v.InitialIntervalInSeconds
+	}
+
+	return
+}
+
+// IsSetInitialIntervalInSeconds returns true if InitialIntervalInSeconds is not nil.
+func (v *RetryPolicy) IsSetInitialIntervalInSeconds() bool {
+	return v != nil && v.InitialIntervalInSeconds != nil
+}
+
+// GetBackoffCoefficient returns the value of BackoffCoefficient if it is set or its
+// zero value if it is unset.
+func (v *RetryPolicy) GetBackoffCoefficient() (o float64) {
+	if v != nil && v.BackoffCoefficient != nil {
+		return *v.BackoffCoefficient
+	}
+
+	return
+}
+
+// IsSetBackoffCoefficient returns true if BackoffCoefficient is not nil.
+func (v *RetryPolicy) IsSetBackoffCoefficient() bool {
+	return v != nil && v.BackoffCoefficient != nil
+}
+
+// GetMaximumIntervalInSeconds returns the value of MaximumIntervalInSeconds if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskFailedRequest) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
+func (v *RetryPolicy) GetMaximumIntervalInSeconds() (o int32) {
+	if v != nil && v.MaximumIntervalInSeconds != nil {
+		return *v.MaximumIntervalInSeconds
 	}
 
 	return
 }
 
-// IsSetTaskToken returns true if TaskToken is not nil.
-func (v *RespondDecisionTaskFailedRequest) IsSetTaskToken() bool {
-	return v != nil && v.TaskToken != nil
+// IsSetMaximumIntervalInSeconds returns true if MaximumIntervalInSeconds is not nil.
+func (v *RetryPolicy) IsSetMaximumIntervalInSeconds() bool {
+	return v != nil && v.MaximumIntervalInSeconds != nil
 }
 
-// GetCause returns the value of Cause if it is set or its
+// GetMaximumAttempts returns the value of MaximumAttempts if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskFailedRequest) GetCause() (o DecisionTaskFailedCause) {
-	if v != nil && v.Cause != nil {
-		return *v.Cause
+func (v *RetryPolicy) GetMaximumAttempts() (o int32) {
+	if v != nil && v.MaximumAttempts != nil {
+		return *v.MaximumAttempts
 	}
 
 	return
 }
 
-// IsSetCause returns true if Cause is not nil.
-func (v *RespondDecisionTaskFailedRequest) IsSetCause() bool {
-	return v != nil && v.Cause != nil
+// IsSetMaximumAttempts returns true if MaximumAttempts is not nil.
+func (v *RetryPolicy) IsSetMaximumAttempts() bool {
+	return v != nil && v.MaximumAttempts != nil
 }
 
-// GetDetails returns the value of Details if it is set or its
+// GetNonRetriableErrorReasons returns the value of NonRetriableErrorReasons if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskFailedRequest) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
+func (v *RetryPolicy) GetNonRetriableErrorReasons() (o []string) {
+	if v != nil && v.NonRetriableErrorReasons != nil {
+		return v.NonRetriableErrorReasons
 	}
 
 	return
 }
 
-// IsSetDetails returns true if Details is not nil.
-func (v *RespondDecisionTaskFailedRequest) IsSetDetails() bool {
-	return v != nil && v.Details != nil
+// IsSetNonRetriableErrorReasons returns true if NonRetriableErrorReasons is not nil.
+func (v *RetryPolicy) IsSetNonRetriableErrorReasons() bool {
+	return v != nil && v.NonRetriableErrorReasons != nil
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetExpirationIntervalInSeconds returns the value of ExpirationIntervalInSeconds if it is set or its
 // zero value if it is unset.
-func (v *RespondDecisionTaskFailedRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
+	if v != nil && v.ExpirationIntervalInSeconds != nil {
+		return *v.ExpirationIntervalInSeconds
 	}
 
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *RespondDecisionTaskFailedRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+// IsSetExpirationIntervalInSeconds returns true if ExpirationIntervalInSeconds is not nil.
+func (v *RetryPolicy) IsSetExpirationIntervalInSeconds() bool {
+	return v != nil && v.ExpirationIntervalInSeconds != nil
 }
 
-type RespondQueryTaskCompletedRequest struct {
-	TaskToken     []byte                  `json:"taskToken,omitempty"`
-	CompletedType *QueryTaskCompletedType `json:"completedType,omitempty"`
-	QueryResult   []byte                  `json:"queryResult,omitempty"`
-	ErrorMessage  *string                 `json:"errorMessage,omitempty"`
+type RetryTaskError struct {
+	Message     string  `json:"message,required"`
+	DomainId    *string `json:"domainId,omitempty"`
+	WorkflowId  *string `json:"workflowId,omitempty"`
+	RunId       *string `json:"runId,omitempty"`
+	NextEventId *int64  `json:"nextEventId,omitempty"`
 }
 
-// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a RetryTaskError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -32931,61 +40425,61 @@ type RespondQueryTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *RetryTaskError) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.CompletedType != nil {
-		w, err = v.CompletedType.ToWire()
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.QueryResult != nil {
-		w, err = wire.NewValueBinary(v.QueryResult), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
-	if v.ErrorMessage != nil {
-		w, err = wire.NewValueString(*(v.ErrorMessage)), error(nil)
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryTaskCompletedType_Read(w wire.Value) (QueryTaskCompletedType, error) {
-	var v QueryTaskCompletedType
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a RetryTaskError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// An error is returned if we were unable to build a RetryTaskError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -32993,47 +40487,61 @@ func _QueryTaskCompletedType_Read(w wire.Value) (QueryTaskCompletedType, error)
 //     return nil, err
 //   }
 //
-//   var v RespondQueryTaskCompletedRequest
+//   var v RetryTaskError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *RetryTaskError) FromWire(w wire.Value) error {
 	var err error
 
+	messageIsSet := false
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
+		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				v.Message, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				messageIsSet = true
 			}
-		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x QueryTaskCompletedType
-				x, err = _QueryTaskCompletedType_Read(field.Value)
-				v.CompletedType = &x
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
+		case 3:
 			if field.Value.Type() == wire.TBinary {
-				v.QueryResult, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 4:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ErrorMessage = &x
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
 				if err != nil {
 					return err
 				}
@@ -33042,68 +40550,67 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 		}
 	}
 
+	if !messageIsSet {
+		return errors.New("field Message of RetryTaskError is required")
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// String returns a readable string representation of a RetryTaskError
 // struct.
-func (v *RespondQueryTaskCompletedRequest) String() string {
+func (v *RetryTaskError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
 		i++
 	}
-	if v.CompletedType != nil {
-		fields[i] = fmt.Sprintf("CompletedType: %v", *(v.CompletedType))
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
-	if v.QueryResult != nil {
-		fields[i] = fmt.Sprintf("QueryResult: %v", v.QueryResult)
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	if v.ErrorMessage != nil {
-		fields[i] = fmt.Sprintf("ErrorMessage: %v", *(v.ErrorMessage))
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
 		i++
 	}
 
-	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _QueryTaskCompletedType_EqualsPtr(lhs, rhs *QueryTaskCompletedType) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("RetryTaskError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
-// provided RespondQueryTaskCompletedRequest.
+// Equals returns true if all the fields of this RetryTaskError match the
+// provided RetryTaskError.
 //
 // This function performs a deep comparison.
-func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+func (v *RetryTaskError) Equals(rhs *RetryTaskError) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
-	if !_QueryTaskCompletedType_EqualsPtr(v.CompletedType, rhs.CompletedType) {
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
 		return false
 	}
-	if !((v.QueryResult == nil && rhs.QueryResult == nil) || (v.QueryResult != nil && rhs.QueryResult != nil && bytes.Equal(v.QueryResult, rhs.QueryResult))) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ErrorMessage, rhs.ErrorMessage) {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
 		return false
 	}
 
@@ -33111,122 +40618,108 @@ func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompleted
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondQueryTaskCompletedRequest.
-func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RetryTaskError.
+func (v *RetryTaskError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskToken != nil {
-		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	enc.AddString("message", v.Message)
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
 	}
-	if v.CompletedType != nil {
-		err = multierr.Append(err, enc.AddObject("completedType", *v.CompletedType))
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
 	}
-	if v.QueryResult != nil {
-		enc.AddString("queryResult", base64.StdEncoding.EncodeToString(v.QueryResult))
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
 	}
-	if v.ErrorMessage != nil {
-		enc.AddString("errorMessage", *v.ErrorMessage)
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
 	}
 	return err
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
+// GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
+func (v *RetryTaskError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
 	}
-
 	return
 }
 
-// IsSetTaskToken returns true if TaskToken is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskToken() bool {
-	return v != nil && v.TaskToken != nil
-}
-
-// GetCompletedType returns the value of CompletedType if it is set or its
+// GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetCompletedType() (o QueryTaskCompletedType) {
-	if v != nil && v.CompletedType != nil {
-		return *v.CompletedType
+func (v *RetryTaskError) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
 	}
 
 	return
 }
 
-// IsSetCompletedType returns true if CompletedType is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetCompletedType() bool {
-	return v != nil && v.CompletedType != nil
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *RetryTaskError) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
 }
 
-// GetQueryResult returns the value of QueryResult if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetQueryResult() (o []byte) {
-	if v != nil && v.QueryResult != nil {
-		return v.QueryResult
+func (v *RetryTaskError) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetQueryResult returns true if QueryResult is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetQueryResult() bool {
-	return v != nil && v.QueryResult != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *RetryTaskError) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
-// GetErrorMessage returns the value of ErrorMessage if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetErrorMessage() (o string) {
-	if v != nil && v.ErrorMessage != nil {
-		return *v.ErrorMessage
+func (v *RetryTaskError) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetErrorMessage returns true if ErrorMessage is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetErrorMessage() bool {
-	return v != nil && v.ErrorMessage != nil
-}
-
-type RetryPolicy struct {
-	InitialIntervalInSeconds    *int32   `json:"initialIntervalInSeconds,omitempty"`
-	BackoffCoefficient          *float64 `json:"backoffCoefficient,omitempty"`
-	MaximumIntervalInSeconds    *int32   `json:"maximumIntervalInSeconds,omitempty"`
-	MaximumAttempts             *int32   `json:"maximumAttempts,omitempty"`
-	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
-	ExpirationIntervalInSeconds *int32   `json:"expirationIntervalInSeconds,omitempty"`
+// IsSetRunId returns true if RunId is not nil.
+func (v *RetryTaskError) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
 }
 
-type _List_String_ValueList []string
-
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *RetryTaskError) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
 	}
-	return nil
+
+	return
 }
 
-func (v _List_String_ValueList) Size() int {
-	return len(v)
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *RetryTaskError) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
 }
 
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
+func (v *RetryTaskError) Error() string {
+	return v.String()
 }
 
-func (_List_String_ValueList) Close() {}
+type ScanWorkflowExecutionsRequest struct {
+	Domain          *string          `json:"domain,omitempty"`
+	MaximumPageSize *int32           `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte           `json:"nextPageToken,omitempty"`
+	StartTimeFilter *StartTimeFilter `json:"StartTimeFilter,omitempty"`
+}
 
-// ToWire translates a RetryPolicy struct into a Thrift-level intermediate
+// ToWire translates a ScanWorkflowExecutionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -33241,89 +40734,55 @@ func (_List_String_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RetryPolicy) ToWire() (wire.Value, error) {
+func (v *ScanWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.InitialIntervalInSeconds != nil {
-		w, err = wire.NewValueI32(*(v.InitialIntervalInSeconds)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.BackoffCoefficient != nil {
-		w, err = wire.NewValueDouble(*(v.BackoffCoefficient)), error(nil)
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.MaximumIntervalInSeconds != nil {
-		w, err = wire.NewValueI32(*(v.MaximumIntervalInSeconds)), error(nil)
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.MaximumAttempts != nil {
-		w, err = wire.NewValueI32(*(v.MaximumAttempts)), error(nil)
+	if v.StartTimeFilter != nil {
+		w, err = v.StartTimeFilter.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.NonRetriableErrorReasons != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.NonRetriableErrorReasons)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.ExpirationIntervalInSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ExpirationIntervalInSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
-		return nil, nil
-	}
-
-	o := make([]string, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a RetryPolicy struct from its Thrift-level
+// FromWire deserializes a ScanWorkflowExecutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RetryPolicy struct
+// An error is returned if we were unable to build a ScanWorkflowExecutionsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -33331,69 +40790,47 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 //     return nil, err
 //   }
 //
-//   var v RetryPolicy
+//   var v ScanWorkflowExecutionsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RetryPolicy) FromWire(w wire.Value) error {
+func (v *ScanWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.InitialIntervalInSeconds = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TDouble {
-				var x float64
-				x, err = field.Value.GetDouble(), error(nil)
-				v.BackoffCoefficient = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumIntervalInSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumAttempts = &x
+				v.MaximumPageSize = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
-			if field.Value.Type() == wire.TList {
-				v.NonRetriableErrorReasons, err = _List_String_Read(field.Value.GetList())
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ExpirationIntervalInSeconds = &x
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -33405,227 +40842,148 @@ func (v *RetryPolicy) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RetryPolicy
+// String returns a readable string representation of a ScanWorkflowExecutionsRequest
 // struct.
-func (v *RetryPolicy) String() string {
+func (v *ScanWorkflowExecutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [4]string
 	i := 0
-	if v.InitialIntervalInSeconds != nil {
-		fields[i] = fmt.Sprintf("InitialIntervalInSeconds: %v", *(v.InitialIntervalInSeconds))
-		i++
-	}
-	if v.BackoffCoefficient != nil {
-		fields[i] = fmt.Sprintf("BackoffCoefficient: %v", *(v.BackoffCoefficient))
-		i++
-	}
-	if v.MaximumIntervalInSeconds != nil {
-		fields[i] = fmt.Sprintf("MaximumIntervalInSeconds: %v", *(v.MaximumIntervalInSeconds))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.MaximumAttempts != nil {
-		fields[i] = fmt.Sprintf("MaximumAttempts: %v", *(v.MaximumAttempts))
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
 		i++
 	}
-	if v.NonRetriableErrorReasons != nil {
-		fields[i] = fmt.Sprintf("NonRetriableErrorReasons: %v", v.NonRetriableErrorReasons)
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
-	if v.ExpirationIntervalInSeconds != nil {
-		fields[i] = fmt.Sprintf("ExpirationIntervalInSeconds: %v", *(v.ExpirationIntervalInSeconds))
+	if v.StartTimeFilter != nil {
+		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
 		i++
 	}
 
-	return fmt.Sprintf("RetryPolicy{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
-	}
-
-	return true
+	return fmt.Sprintf("ScanWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RetryPolicy match the
-// provided RetryPolicy.
+// Equals returns true if all the fields of this ScanWorkflowExecutionsRequest match the
+// provided ScanWorkflowExecutionsRequest.
 //
 // This function performs a deep comparison.
-func (v *RetryPolicy) Equals(rhs *RetryPolicy) bool {
+func (v *ScanWorkflowExecutionsRequest) Equals(rhs *ScanWorkflowExecutionsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I32_EqualsPtr(v.InitialIntervalInSeconds, rhs.InitialIntervalInSeconds) {
-		return false
-	}
-	if !_Double_EqualsPtr(v.BackoffCoefficient, rhs.BackoffCoefficient) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumIntervalInSeconds, rhs.MaximumIntervalInSeconds) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumAttempts, rhs.MaximumAttempts) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.NonRetriableErrorReasons == nil && rhs.NonRetriableErrorReasons == nil) || (v.NonRetriableErrorReasons != nil && rhs.NonRetriableErrorReasons != nil && _List_String_Equals(v.NonRetriableErrorReasons, rhs.NonRetriableErrorReasons))) {
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ExpirationIntervalInSeconds, rhs.ExpirationIntervalInSeconds) {
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
-
-	return true
-}
-
-type _List_String_Zapper []string
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendString(v)
-	}
-	return err
+	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
+		return false
+	}
+
+	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RetryPolicy.
-func (v *RetryPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ScanWorkflowExecutionsRequest.
+func (v *ScanWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.InitialIntervalInSeconds != nil {
-		enc.AddInt32("initialIntervalInSeconds", *v.InitialIntervalInSeconds)
-	}
-	if v.BackoffCoefficient != nil {
-		enc.AddFloat64("backoffCoefficient", *v.BackoffCoefficient)
-	}
-	if v.MaximumIntervalInSeconds != nil {
-		enc.AddInt32("maximumIntervalInSeconds", *v.MaximumIntervalInSeconds)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.MaximumAttempts != nil {
-		enc.AddInt32("maximumAttempts", *v.MaximumAttempts)
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
 	}
-	if v.NonRetriableErrorReasons != nil {
-		err = multierr.Append(err, enc.AddArray("nonRetriableErrorReasons", (_List_String_Zapper)(v.NonRetriableErrorReasons)))
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
-	if v.ExpirationIntervalInSeconds != nil {
-		enc.AddInt32("expirationIntervalInSeconds", *v.ExpirationIntervalInSeconds)
+	if v.StartTimeFilter != nil {
+		err = multierr.Append(err, enc.AddObject("StartTimeFilter", v.StartTimeFilter))
 	}
 	return err
 }
 
-// GetInitialIntervalInSeconds returns the value of InitialIntervalInSeconds if it is set or its
-// zero value if it is unset.
-func (v *RetryPolicy) GetInitialIntervalInSeconds() (o int32) {
-	if v != nil && v.InitialIntervalInSeconds != nil {
-		return *v.InitialIntervalInSeconds
-	}
-
-	return
-}
-
-// IsSetInitialIntervalInSeconds returns true if InitialIntervalInSeconds is not nil.
-func (v *RetryPolicy) IsSetInitialIntervalInSeconds() bool {
-	return v != nil && v.InitialIntervalInSeconds != nil
-}
-
-// GetBackoffCoefficient returns the value of BackoffCoefficient if it is set or its
-// zero value if it is unset.
-func (v *RetryPolicy) GetBackoffCoefficient() (o float64) {
-	if v != nil && v.BackoffCoefficient != nil {
-		return *v.BackoffCoefficient
-	}
-
-	return
-}
-
-// IsSetBackoffCoefficient returns true if BackoffCoefficient is not nil.
-func (v *RetryPolicy) IsSetBackoffCoefficient() bool {
-	return v != nil && v.BackoffCoefficient != nil
-}
-
-// GetMaximumIntervalInSeconds returns the value of MaximumIntervalInSeconds if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RetryPolicy) GetMaximumIntervalInSeconds() (o int32) {
-	if v != nil && v.MaximumIntervalInSeconds != nil {
-		return *v.MaximumIntervalInSeconds
+func (v *ScanWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetMaximumIntervalInSeconds returns true if MaximumIntervalInSeconds is not nil.
-func (v *RetryPolicy) IsSetMaximumIntervalInSeconds() bool {
-	return v != nil && v.MaximumIntervalInSeconds != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *ScanWorkflowExecutionsRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetMaximumAttempts returns the value of MaximumAttempts if it is set or its
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
 // zero value if it is unset.
-func (v *RetryPolicy) GetMaximumAttempts() (o int32) {
-	if v != nil && v.MaximumAttempts != nil {
-		return *v.MaximumAttempts
+func (v *ScanWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
 	}
 
 	return
 }
 
-// IsSetMaximumAttempts returns true if MaximumAttempts is not nil.
-func (v *RetryPolicy) IsSetMaximumAttempts() bool {
-	return v != nil && v.MaximumAttempts != nil
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *ScanWorkflowExecutionsRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
 }
 
-// GetNonRetriableErrorReasons returns the value of NonRetriableErrorReasons if it is set or its
+// GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *RetryPolicy) GetNonRetriableErrorReasons() (o []string) {
-	if v != nil && v.NonRetriableErrorReasons != nil {
-		return v.NonRetriableErrorReasons
+func (v *ScanWorkflowExecutionsRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 
 	return
 }
 
-// IsSetNonRetriableErrorReasons returns true if NonRetriableErrorReasons is not nil.
-func (v *RetryPolicy) IsSetNonRetriableErrorReasons() bool {
-	return v != nil && v.NonRetriableErrorReasons != nil
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ScanWorkflowExecutionsRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
-// GetExpirationIntervalInSeconds returns the value of ExpirationIntervalInSeconds if it is set or its
+// GetStartTimeFilter returns the value of StartTimeFilter if it is set or its
 // zero value if it is unset.
-func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
-	if v != nil && v.ExpirationIntervalInSeconds != nil {
-		return *v.ExpirationIntervalInSeconds
+func (v *ScanWorkflowExecutionsRequest) GetStartTimeFilter() (o *StartTimeFilter) {
+	if v != nil && v.StartTimeFilter != nil {
+		return v.StartTimeFilter
 	}
 
 	return
 }
 
-// IsSetExpirationIntervalInSeconds returns true if ExpirationIntervalInSeconds is not nil.
-func (v *RetryPolicy) IsSetExpirationIntervalInSeconds() bool {
-	return v != nil && v.ExpirationIntervalInSeconds != nil
+// IsSetStartTimeFilter returns true if StartTimeFilter is not nil.
+func (v *ScanWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
+	return v != nil && v.StartTimeFilter != nil
 }
 
-type RetryTaskError struct {
-	Message     string  `json:"message,required"`
-	DomainId    *string `json:"domainId,omitempty"`
-	WorkflowId  *string `json:"workflowId,omitempty"`
-	RunId       *string `json:"runId,omitempty"`
-	NextEventId *int64  `json:"nextEventId,omitempty"`
+type ScanWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
 }
 
-// ToWire translates a RetryTaskError struct into a Thrift-level intermediate
+// ToWire translates a ScanWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -33640,61 +40998,39 @@ type RetryTaskError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RetryTaskError) ToWire() (wire.Value, error) {
+func (v *ScanWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 2, Value: w}
-		i++
-	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.Executions != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RetryTaskError struct from its Thrift-level
+// FromWire deserializes a ScanWorkflowExecutionsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RetryTaskError struct
+// An error is returned if we were unable to build a ScanWorkflowExecutionsResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -33702,61 +41038,27 @@ func (v *RetryTaskError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RetryTaskError
+//   var v ScanWorkflowExecutionsResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RetryTaskError) FromWire(w wire.Value) error {
+func (v *ScanWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				messageIsSet = true
-			}
-		case 2:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 3:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
-		case 4:
+		case 20:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -33765,67 +41067,44 @@ func (v *RetryTaskError) FromWire(w wire.Value) error {
 		}
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of RetryTaskError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a RetryTaskError
+// String returns a readable string representation of a ScanWorkflowExecutionsResponse
 // struct.
-func (v *RetryTaskError) String() string {
+func (v *ScanWorkflowExecutionsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-	if v.DomainId != nil {
-		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
-		i++
-	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.Executions != nil {
+		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
 		i++
 	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
 
-	return fmt.Sprintf("RetryTaskError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ScanWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RetryTaskError match the
-// provided RetryTaskError.
+// Equals returns true if all the fields of this ScanWorkflowExecutionsResponse match the
+// provided ScanWorkflowExecutionsResponse.
 //
 // This function performs a deep comparison.
-func (v *RetryTaskError) Equals(rhs *RetryTaskError) bool {
+func (v *ScanWorkflowExecutionsResponse) Equals(rhs *ScanWorkflowExecutionsResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
 
@@ -33833,98 +41112,48 @@ func (v *RetryTaskError) Equals(rhs *RetryTaskError) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RetryTaskError.
-func (v *RetryTaskError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ScanWorkflowExecutionsResponse.
+func (v *ScanWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
-	if v.DomainId != nil {
-		enc.AddString("domainId", *v.DomainId)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
+	if v.Executions != nil {
+		err = multierr.Append(err, enc.AddArray("executions", (_List_WorkflowExecutionInfo_Zapper)(v.Executions)))
 	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
-// zero value if it is unset.
-func (v *RetryTaskError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
-	}
-	return
-}
-
-// GetDomainId returns the value of DomainId if it is set or its
-// zero value if it is unset.
-func (v *RetryTaskError) GetDomainId() (o string) {
-	if v != nil && v.DomainId != nil {
-		return *v.DomainId
-	}
-
-	return
-}
-
-// IsSetDomainId returns true if DomainId is not nil.
-func (v *RetryTaskError) IsSetDomainId() bool {
-	return v != nil && v.DomainId != nil
-}
-
-// GetWorkflowId returns the value of WorkflowId if it is set or its
-// zero value if it is unset.
-func (v *RetryTaskError) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
-	}
-
-	return
-}
-
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *RetryTaskError) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
-}
-
-// GetRunId returns the value of RunId if it is set or its
+// GetExecutions returns the value of Executions if it is set or its
 // zero value if it is unset.
-func (v *RetryTaskError) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+func (v *ScanWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
+	if v != nil && v.Executions != nil {
+		return v.Executions
 	}
 
 	return
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *RetryTaskError) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+// IsSetExecutions returns true if Executions is not nil.
+func (v *ScanWorkflowExecutionsResponse) IsSetExecutions() bool {
+	return v != nil && v.Executions != nil
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
+// GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *RetryTaskError) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
+func (v *ScanWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
 
 	return
 }
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *RetryTaskError) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
-}
-
-func (v *RetryTaskError) Error() string {
-	return v.String()
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ScanWorkflowExecutionsResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
 type ScheduleActivityTaskDecisionAttributes struct {
@@ -34453,6 +41682,142 @@ func (v *ScheduleActivityTaskDecisionAttributes) IsSetRetryPolicy() bool {
 	return v != nil && v.RetryPolicy != nil
 }
 
+type SearchAttributes struct {
+	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
+}
+
+// ToWire translates a SearchAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SearchAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.IndexedFields != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.IndexedFields)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SearchAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SearchAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SearchAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SearchAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.IndexedFields, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SearchAttributes
+// struct.
+func (v *SearchAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.IndexedFields != nil {
+		fields[i] = fmt.Sprintf("IndexedFields: %v", v.IndexedFields)
+		i++
+	}
+
+	return fmt.Sprintf("SearchAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SearchAttributes match the
+// provided SearchAttributes.
+//
+// This function performs a deep comparison.
+func (v *SearchAttributes) Equals(rhs *SearchAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.IndexedFields == nil && rhs.IndexedFields == nil) || (v.IndexedFields != nil && rhs.IndexedFields != nil && _Map_String_Binary_Equals(v.IndexedFields, rhs.IndexedFields))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SearchAttributes.
+func (v *SearchAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.IndexedFields != nil {
+		err = multierr.Append(err, enc.AddObject("indexedFields", (_Map_String_Binary_Zapper)(v.IndexedFields)))
+	}
+	return err
+}
+
+// GetIndexedFields returns the value of IndexedFields if it is set or its
+// zero value if it is unset.
+func (v *SearchAttributes) GetIndexedFields() (o map[string][]byte) {
+	if v != nil && v.IndexedFields != nil {
+		return v.IndexedFields
+	}
+
+	return
+}
+
+// IsSetIndexedFields returns true if IndexedFields is not nil.
+func (v *SearchAttributes) IsSetIndexedFields() bool {
+	return v != nil && v.IndexedFields != nil
+}
+
 type ServiceBusyError struct {
 	Message string `json:"message,required"`
 }
@@ -35880,6 +43245,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
 	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
 	Memo                                *Memo                  `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -35899,7 +43265,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [16]wire.Field
+		fields [17]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -36033,6 +43399,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 160, Value: w}
 		i++
 	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 161, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -36049,6 +43423,12 @@ func _Memo_Read(w wire.Value) (*Memo, error) {
 	return &v, err
 }
 
+func _SearchAttributes_Read(w wire.Value) (*SearchAttributes, error) {
+	var v SearchAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a SignalWithStartWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -36216,6 +43596,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 161:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -36230,7 +43618,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [16]string
+	var fields [17]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -36296,6 +43684,10 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
 		i++
 	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -36368,6 +43760,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && v.Memo.Equals(rhs.Memo))) {
 		return false
 	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -36426,6 +43821,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.O
 	if v.Memo != nil {
 		err = multierr.Append(err, enc.AddObject("memo", v.Memo))
 	}
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
+	}
 	return err
 }
 
@@ -36669,6 +44067,21 @@ func (v *SignalWithStartWorkflowExecutionRequest) IsSetMemo() bool {
 	return v != nil && v.Memo != nil
 }
 
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+
+	return
+}
+
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
+}
+
 type SignalWorkflowExecutionRequest struct {
 	Domain            *string            `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -39142,6 +46555,7 @@ type StartWorkflowExecutionRequest struct {
 	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
 	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
 	Memo                                *Memo                  `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -39161,7 +46575,7 @@ type StartWorkflowExecutionRequest struct {
 //   }
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [14]wire.Field
+		fields [15]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -39279,6 +46693,14 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 140, Value: w}
 		i++
 	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 141, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -39434,6 +46856,14 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 141:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -39448,7 +46878,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [14]string
+	var fields [15]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -39506,6 +46936,10 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
 		i++
 	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -39562,6 +46996,9 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && v.Memo.Equals(rhs.Memo))) {
 		return false
 	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -39614,6 +47051,9 @@ func (v *StartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncod
 	if v.Memo != nil {
 		err = multierr.Append(err, enc.AddObject("memo", v.Memo))
 	}
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
+	}
 	return err
 }
 
@@ -39827,6 +47267,21 @@ func (v *StartWorkflowExecutionRequest) IsSetMemo() bool {
 	return v != nil && v.Memo != nil
 }
 
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+
+	return
+}
+
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId *string `json:"runId,omitempty"`
 }
@@ -40835,11 +48290,12 @@ func (v *TaskListMetadata) IsSetMaxTasksPerSecond() bool {
 }
 
 type TaskListStatus struct {
-	BacklogCountHint *int64       `json:"backlogCountHint,omitempty"`
-	ReadLevel        *int64       `json:"readLevel,omitempty"`
-	AckLevel         *int64       `json:"ackLevel,omitempty"`
-	RatePerSecond    *float64     `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint           *int64       `json:"backlogCountHint,omitempty"`
+	ReadLevel                  *int64       `json:"readLevel,omitempty"`
+	AckLevel                   *int64       `json:"ackLevel,omitempty"`
+	RatePerSecond              *float64     `json:"ratePerSecond,omitempty"`
+	TaskIDBlock                *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	OldestTaskCreatedTimestamp *int64       `json:"oldestTaskCreatedTimestamp,omitempty"`
 }
 
 // ToWire translates a TaskListStatus struct into a Thrift-level intermediate
@@ -40859,7 +48315,7 @@ type TaskListStatus struct {
 //   }
 func (v *TaskListStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -40905,6 +48361,14 @@ func (v *TaskListStatus) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.OldestTaskCreatedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.OldestTaskCreatedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -40984,6 +48448,16 @@ func (v *TaskListStatus) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.OldestTaskCreatedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -40998,7 +48472,7 @@ func (v *TaskListStatus) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.BacklogCountHint != nil {
 		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
@@ -41020,6 +48494,10 @@ func (v *TaskListStatus) String() string {
 		fields[i] = fmt.Sprintf("TaskIDBlock: %v", v.TaskIDBlock)
 		i++
 	}
+	if v.OldestTaskCreatedTimestamp != nil {
+		fields[i] = fmt.Sprintf("OldestTaskCreatedTimestamp: %v", *(v.OldestTaskCreatedTimestamp))
+		i++
+	}
 
 	return fmt.Sprintf("TaskListStatus{%v}", strings.Join(fields[:i], ", "))
 }
@@ -41049,6 +48527,9 @@ func (v *TaskListStatus) Equals(rhs *TaskListStatus) bool {
 	if !((v.TaskIDBlock == nil && rhs.TaskIDBlock == nil) || (v.TaskIDBlock != nil && rhs.TaskIDBlock != nil && v.TaskIDBlock.Equals(rhs.TaskIDBlock))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.OldestTaskCreatedTimestamp, rhs.OldestTaskCreatedTimestamp) {
+		return false
+	}
 
 	return true
 }
@@ -41074,6 +48555,9 @@ func (v *TaskListStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	if v.TaskIDBlock != nil {
 		err = multierr.Append(err, enc.AddObject("taskIDBlock", v.TaskIDBlock))
 	}
+	if v.OldestTaskCreatedTimestamp != nil {
+		enc.AddInt64("oldestTaskCreatedTimestamp", *v.OldestTaskCreatedTimestamp)
+	}
 	return err
 }
 
@@ -41152,6 +48636,21 @@ func (v *TaskListStatus) IsSetTaskIDBlock() bool {
 	return v != nil && v.TaskIDBlock != nil
 }
 
+// GetOldestTaskCreatedTimestamp returns the value of OldestTaskCreatedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetOldestTaskCreatedTimestamp() (o int64) {
+	if v != nil && v.OldestTaskCreatedTimestamp != nil {
+		return *v.OldestTaskCreatedTimestamp
+	}
+
+	return
+}
+
+// IsSetOldestTaskCreatedTimestamp returns true if OldestTaskCreatedTimestamp is not nil.
+func (v *TaskListStatus) IsSetOldestTaskCreatedTimestamp() bool {
+	return v != nil && v.OldestTaskCreatedTimestamp != nil
+}
+
 type TaskListType int32
 
 const (
@@ -41329,6 +48828,7 @@ type TerminateWorkflowExecutionRequest struct {
 	Reason            *string            `json:"reason,omitempty"`
 	Details           []byte             `json:"details,omitempty"`
 	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
 }
 
 // ToWire translates a TerminateWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -41348,7 +48848,7 @@ type TerminateWorkflowExecutionRequest struct {
 //   }
 func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -41394,6 +48894,14 @@ func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -41465,6 +48973,16 @@ func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -41479,7 +48997,7 @@ func (v *TerminateWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -41501,6 +49019,10 @@ func (v *TerminateWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
 
 	return fmt.Sprintf("TerminateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -41530,6 +49052,9 @@ func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecuti
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 
 	return true
 }
@@ -41555,6 +49080,9 @@ func (v *TerminateWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectE
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
 	return err
 }
 
@@ -41633,6 +49161,21 @@ func (v *TerminateWorkflowExecutionRequest) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *TerminateWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *TerminateWorkflowExecutionRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
 type TimeoutType int32
 
 const (
@@ -42254,7 +49797,218 @@ func (v *TimerFiredEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder)
 
 // GetTimerId returns the value of TimerId if it is set or its
 // zero value if it is unset.
-func (v *TimerFiredEventAttributes) GetTimerId() (o string) {
+func (v *TimerFiredEventAttributes) GetTimerId() (o string) {
+	if v != nil && v.TimerId != nil {
+		return *v.TimerId
+	}
+
+	return
+}
+
+// IsSetTimerId returns true if TimerId is not nil.
+func (v *TimerFiredEventAttributes) IsSetTimerId() bool {
+	return v != nil && v.TimerId != nil
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *TimerFiredEventAttributes) GetStartedEventId() (o int64) {
+	if v != nil && v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// IsSetStartedEventId returns true if StartedEventId is not nil.
+func (v *TimerFiredEventAttributes) IsSetStartedEventId() bool {
+	return v != nil && v.StartedEventId != nil
+}
+
+type TimerStartedEventAttributes struct {
+	TimerId                      *string `json:"timerId,omitempty"`
+	StartToFireTimeoutSeconds    *int64  `json:"startToFireTimeoutSeconds,omitempty"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+}
+
+// ToWire translates a TimerStartedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TimerStartedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TimerId != nil {
+		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.StartToFireTimeoutSeconds != nil {
+		w, err = wire.NewValueI64(*(v.StartToFireTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TimerStartedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TimerStartedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TimerStartedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TimerStartedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TimerId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartToFireTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TimerStartedEventAttributes
+// struct.
+func (v *TimerStartedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.TimerId != nil {
+		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
+		i++
+	}
+	if v.StartToFireTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StartToFireTimeoutSeconds: %v", *(v.StartToFireTimeoutSeconds))
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
+	}
+
+	return fmt.Sprintf("TimerStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TimerStartedEventAttributes match the
+// provided TimerStartedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *TimerStartedEventAttributes) Equals(rhs *TimerStartedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartToFireTimeoutSeconds, rhs.StartToFireTimeoutSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TimerStartedEventAttributes.
+func (v *TimerStartedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TimerId != nil {
+		enc.AddString("timerId", *v.TimerId)
+	}
+	if v.StartToFireTimeoutSeconds != nil {
+		enc.AddInt64("startToFireTimeoutSeconds", *v.StartToFireTimeoutSeconds)
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	}
+	return err
+}
+
+// GetTimerId returns the value of TimerId if it is set or its
+// zero value if it is unset.
+func (v *TimerStartedEventAttributes) GetTimerId() (o string) {
 	if v != nil && v.TimerId != nil {
 		return *v.TimerId
 	}
@@ -42263,32 +50017,52 @@ func (v *TimerFiredEventAttributes) GetTimerId() (o string) {
 }
 
 // IsSetTimerId returns true if TimerId is not nil.
-func (v *TimerFiredEventAttributes) IsSetTimerId() bool {
+func (v *TimerStartedEventAttributes) IsSetTimerId() bool {
 	return v != nil && v.TimerId != nil
 }
 
-// GetStartedEventId returns the value of StartedEventId if it is set or its
+// GetStartToFireTimeoutSeconds returns the value of StartToFireTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *TimerFiredEventAttributes) GetStartedEventId() (o int64) {
-	if v != nil && v.StartedEventId != nil {
-		return *v.StartedEventId
+func (v *TimerStartedEventAttributes) GetStartToFireTimeoutSeconds() (o int64) {
+	if v != nil && v.StartToFireTimeoutSeconds != nil {
+		return *v.StartToFireTimeoutSeconds
 	}
 
 	return
 }
 
-// IsSetStartedEventId returns true if StartedEventId is not nil.
-func (v *TimerFiredEventAttributes) IsSetStartedEventId() bool {
-	return v != nil && v.StartedEventId != nil
+// IsSetStartToFireTimeoutSeconds returns true if StartToFireTimeoutSeconds is not nil.
+func (v *TimerStartedEventAttributes) IsSetStartToFireTimeoutSeconds() bool {
+	return v != nil && v.StartToFireTimeoutSeconds != nil
 }
 
-type TimerStartedEventAttributes struct {
-	TimerId                      *string `json:"timerId,omitempty"`
-	StartToFireTimeoutSeconds    *int64  `json:"startToFireTimeoutSeconds,omitempty"`
-	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *TimerStartedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
 }
 
-// ToWire translates a TimerStartedEventAttributes struct into a Thrift-level intermediate
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *TimerStartedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
+}
+
+type TransferDLQTask struct {
+	DomainId            *string `json:"domainId,omitempty"`
+	WorkflowId          *string `json:"workflowId,omitempty"`
+	RunId               *string `json:"runId,omitempty"`
+	TaskId              *int64  `json:"taskId,omitempty"`
+	TaskType            *int32  `json:"taskType,omitempty"`
+	VisibilityTimestamp *int64  `json:"visibilityTimestamp,omitempty"`
+	ScheduleId          *int64  `json:"scheduleId,omitempty"`
+	Version             *int64  `json:"version,omitempty"`
+}
+
+// ToWire translates a TransferDLQTask struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -42303,47 +50077,87 @@ type TimerStartedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TimerStartedEventAttributes) ToWire() (wire.Value, error) {
+func (v *TransferDLQTask) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TimerId != nil {
-		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartToFireTimeoutSeconds != nil {
-		w, err = wire.NewValueI64(*(v.StartToFireTimeoutSeconds)), error(nil)
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TaskType != nil {
+		w, err = wire.NewValueI32(*(v.TaskType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.VisibilityTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.VisibilityTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ScheduleId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TimerStartedEventAttributes struct from its Thrift-level
+// FromWire deserializes a TransferDLQTask struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TimerStartedEventAttributes struct
+// An error is returned if we were unable to build a TransferDLQTask struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -42351,12 +50165,12 @@ func (v *TimerStartedEventAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TimerStartedEventAttributes
+//   var v TransferDLQTask
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TimerStartedEventAttributes) FromWire(w wire.Value) error {
+func (v *TransferDLQTask) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -42365,27 +50179,77 @@ func (v *TimerStartedEventAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.TimerId = &x
+				v.DomainId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.StartToFireTimeoutSeconds = &x
+				v.TaskId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+				v.VisibilityTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
 				if err != nil {
 					return err
 				}
@@ -42397,48 +50261,83 @@ func (v *TimerStartedEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TimerStartedEventAttributes
+// String returns a readable string representation of a TransferDLQTask
 // struct.
-func (v *TimerStartedEventAttributes) String() string {
+func (v *TransferDLQTask) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [8]string
 	i := 0
-	if v.TimerId != nil {
-		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
 		i++
 	}
-	if v.StartToFireTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("StartToFireTimeoutSeconds: %v", *(v.StartToFireTimeoutSeconds))
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+		i++
+	}
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.VisibilityTimestamp != nil {
+		fields[i] = fmt.Sprintf("VisibilityTimestamp: %v", *(v.VisibilityTimestamp))
+		i++
+	}
+	if v.ScheduleId != nil {
+		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
 		i++
 	}
 
-	return fmt.Sprintf("TimerStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TransferDLQTask{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TimerStartedEventAttributes match the
-// provided TimerStartedEventAttributes.
+// Equals returns true if all the fields of this TransferDLQTask match the
+// provided TransferDLQTask.
 //
 // This function performs a deep comparison.
-func (v *TimerStartedEventAttributes) Equals(rhs *TimerStartedEventAttributes) bool {
+func (v *TransferDLQTask) Equals(rhs *TransferDLQTask) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartToFireTimeoutSeconds, rhs.StartToFireTimeoutSeconds) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.VisibilityTimestamp, rhs.VisibilityTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
 		return false
 	}
 
@@ -42446,66 +50345,156 @@ func (v *TimerStartedEventAttributes) Equals(rhs *TimerStartedEventAttributes) b
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TimerStartedEventAttributes.
-func (v *TimerStartedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of TransferDLQTask.
+func (v *TransferDLQTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TimerId != nil {
-		enc.AddString("timerId", *v.TimerId)
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
 	}
-	if v.StartToFireTimeoutSeconds != nil {
-		enc.AddInt64("startToFireTimeoutSeconds", *v.StartToFireTimeoutSeconds)
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.TaskId != nil {
+		enc.AddInt64("taskId", *v.TaskId)
+	}
+	if v.TaskType != nil {
+		enc.AddInt32("taskType", *v.TaskType)
+	}
+	if v.VisibilityTimestamp != nil {
+		enc.AddInt64("visibilityTimestamp", *v.VisibilityTimestamp)
+	}
+	if v.ScheduleId != nil {
+		enc.AddInt64("scheduleId", *v.ScheduleId)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
 	}
 	return err
 }
 
-// GetTimerId returns the value of TimerId if it is set or its
+// GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *TimerStartedEventAttributes) GetTimerId() (o string) {
-	if v != nil && v.TimerId != nil {
-		return *v.TimerId
+func (v *TransferDLQTask) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
 	}
 
 	return
 }
 
-// IsSetTimerId returns true if TimerId is not nil.
-func (v *TimerStartedEventAttributes) IsSetTimerId() bool {
-	return v != nil && v.TimerId != nil
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *TransferDLQTask) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
 }
 
-// GetStartToFireTimeoutSeconds returns the value of StartToFireTimeoutSeconds if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *TimerStartedEventAttributes) GetStartToFireTimeoutSeconds() (o int64) {
-	if v != nil && v.StartToFireTimeoutSeconds != nil {
-		return *v.StartToFireTimeoutSeconds
+func (v *TransferDLQTask) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetStartToFireTimeoutSeconds returns true if StartToFireTimeoutSeconds is not nil.
-func (v *TimerStartedEventAttributes) IsSetStartToFireTimeoutSeconds() bool {
-	return v != nil && v.StartToFireTimeoutSeconds != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *TransferDLQTask) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *TimerStartedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *TransferDLQTask) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *TimerStartedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *TransferDLQTask) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetTaskId returns the value of TaskId if it is set or its
+// zero value if it is unset.
+func (v *TransferDLQTask) GetTaskId() (o int64) {
+	if v != nil && v.TaskId != nil {
+		return *v.TaskId
+	}
+
+	return
+}
+
+// IsSetTaskId returns true if TaskId is not nil.
+func (v *TransferDLQTask) IsSetTaskId() bool {
+	return v != nil && v.TaskId != nil
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *TransferDLQTask) GetTaskType() (o int32) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *TransferDLQTask) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetVisibilityTimestamp returns the value of VisibilityTimestamp if it is set or its
+// zero value if it is unset.
+func (v *TransferDLQTask) GetVisibilityTimestamp() (o int64) {
+	if v != nil && v.VisibilityTimestamp != nil {
+		return *v.VisibilityTimestamp
+	}
+
+	return
+}
+
+// IsSetVisibilityTimestamp returns true if VisibilityTimestamp is not nil.
+func (v *TransferDLQTask) IsSetVisibilityTimestamp() bool {
+	return v != nil && v.VisibilityTimestamp != nil
+}
+
+// GetScheduleId returns the value of ScheduleId if it is set or its
+// zero value if it is unset.
+func (v *TransferDLQTask) GetScheduleId() (o int64) {
+	if v != nil && v.ScheduleId != nil {
+		return *v.ScheduleId
+	}
+
+	return
+}
+
+// IsSetScheduleId returns true if ScheduleId is not nil.
+func (v *TransferDLQTask) IsSetScheduleId() bool {
+	return v != nil && v.ScheduleId != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *TransferDLQTask) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *TransferDLQTask) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
 type TransientDecisionInfo struct {
@@ -43944,6 +51933,276 @@ func (v *WorkflowExecutionAlreadyStartedError) Error() string {
 	return v.String()
 }
 
+type WorkflowExecutionAnnotation struct {
+	Key       *string `json:"key,omitempty"`
+	Text      *string `json:"text,omitempty"`
+	Author    *string `json:"author,omitempty"`
+	Timestamp *int64  `json:"timestamp,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionAnnotation struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionAnnotation) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Key != nil {
+		w, err = wire.NewValueString(*(v.Key)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Text != nil {
+		w, err = wire.NewValueString(*(v.Text)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Author != nil {
+		w, err = wire.NewValueString(*(v.Author)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionAnnotation struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionAnnotation struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionAnnotation
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionAnnotation) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Key = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Text = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Author = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionAnnotation
+// struct.
+func (v *WorkflowExecutionAnnotation) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+	if v.Text != nil {
+		fields[i] = fmt.Sprintf("Text: %v", *(v.Text))
+		i++
+	}
+	if v.Author != nil {
+		fields[i] = fmt.Sprintf("Author: %v", *(v.Author))
+		i++
+	}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionAnnotation{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionAnnotation match the
+// provided WorkflowExecutionAnnotation.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionAnnotation) Equals(rhs *WorkflowExecutionAnnotation) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Text, rhs.Text) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Author, rhs.Author) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionAnnotation.
+func (v *WorkflowExecutionAnnotation) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	if v.Text != nil {
+		enc.AddString("text", *v.Text)
+	}
+	if v.Author != nil {
+		enc.AddString("author", *v.Author)
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionAnnotation) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *WorkflowExecutionAnnotation) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// GetText returns the value of Text if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionAnnotation) GetText() (o string) {
+	if v != nil && v.Text != nil {
+		return *v.Text
+	}
+
+	return
+}
+
+// IsSetText returns true if Text is not nil.
+func (v *WorkflowExecutionAnnotation) IsSetText() bool {
+	return v != nil && v.Text != nil
+}
+
+// GetAuthor returns the value of Author if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionAnnotation) GetAuthor() (o string) {
+	if v != nil && v.Author != nil {
+		return *v.Author
+	}
+
+	return
+}
+
+// IsSetAuthor returns true if Author is not nil.
+func (v *WorkflowExecutionAnnotation) IsSetAuthor() bool {
+	return v != nil && v.Author != nil
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionAnnotation) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *WorkflowExecutionAnnotation) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
 type WorkflowExecutionCancelRequestedEventAttributes struct {
 	Cause                     *string            `json:"cause,omitempty"`
 	ExternalInitiatedEventId  *int64             `json:"externalInitiatedEventId,omitempty"`
@@ -46563,6 +54822,370 @@ func (v *WorkflowExecutionInfo) IsSetMemo() bool {
 	return v != nil && v.Memo != nil
 }
 
+type WorkflowExecutionPausedEventAttributes struct {
+	Reason   *string `json:"reason,omitempty"`
+	Identity *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionPausedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionPausedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionPausedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionPausedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionPausedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionPausedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionPausedEventAttributes
+// struct.
+func (v *WorkflowExecutionPausedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionPausedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionPausedEventAttributes match the
+// provided WorkflowExecutionPausedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionPausedEventAttributes) Equals(rhs *WorkflowExecutionPausedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionPausedEventAttributes.
+func (v *WorkflowExecutionPausedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionPausedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *WorkflowExecutionPausedEventAttributes) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionPausedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionPausedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type WorkflowExecutionResumedEventAttributes struct {
+	Reason   *string `json:"reason,omitempty"`
+	Identity *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a WorkflowExecutionResumedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowExecutionResumedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowExecutionResumedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowExecutionResumedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowExecutionResumedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowExecutionResumedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowExecutionResumedEventAttributes
+// struct.
+func (v *WorkflowExecutionResumedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowExecutionResumedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowExecutionResumedEventAttributes match the
+// provided WorkflowExecutionResumedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowExecutionResumedEventAttributes) Equals(rhs *WorkflowExecutionResumedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowExecutionResumedEventAttributes.
+func (v *WorkflowExecutionResumedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionResumedEventAttributes) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *WorkflowExecutionResumedEventAttributes) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionResumedEventAttributes) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *WorkflowExecutionResumedEventAttributes) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName *string `json:"signalName,omitempty"`
 	Input      []byte  `json:"input,omitempty"`
@@ -46809,6 +55432,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 	CronSchedule                        *string                 `json:"cronSchedule,omitempty"`
 	FirstDecisionTaskBackoffSeconds     *int32                  `json:"firstDecisionTaskBackoffSeconds,omitempty"`
 	Memo                                *Memo                   `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionStartedEventAttributes struct into a Thrift-level intermediate
@@ -46828,7 +55452,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [21]wire.Field
+		fields [22]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -47002,6 +55626,14 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 121, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -47221,6 +55853,14 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 121:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -47235,7 +55875,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [21]string
+	var fields [22]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -47321,6 +55961,10 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
 		i++
 	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -47398,6 +56042,9 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && v.Memo.Equals(rhs.Memo))) {
 		return false
 	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -47471,6 +56118,9 @@ func (v *WorkflowExecutionStartedEventAttributes) MarshalLogObject(enc zapcore.O
 	if v.Memo != nil {
 		err = multierr.Append(err, enc.AddObject("memo", v.Memo))
 	}
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
+	}
 	return err
 }
 
@@ -47789,10 +56439,26 @@ func (v *WorkflowExecutionStartedEventAttributes) IsSetMemo() bool {
 	return v != nil && v.Memo != nil
 }
 
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetSearchAttributes() (o *SearchAttributes) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+
+	return
+}
+
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
+}
+
 type WorkflowExecutionTerminatedEventAttributes struct {
-	Reason   *string `json:"reason,omitempty"`
-	Details  []byte  `json:"details,omitempty"`
-	Identity *string `json:"identity,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+	Details   []byte  `json:"details,omitempty"`
+	Identity  *string `json:"identity,omitempty"`
+	RequestId *string `json:"requestId,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionTerminatedEventAttributes struct into a Thrift-level intermediate
@@ -47812,7 +56478,7 @@ type WorkflowExecutionTerminatedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionTerminatedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -47842,6 +56508,14 @@ func (v *WorkflowExecutionTerminatedEventAttributes) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -47895,6 +56569,16 @@ func (v *WorkflowExecutionTerminatedEventAttributes) FromWire(w wire.Value) erro
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -47909,7 +56593,7 @@ func (v *WorkflowExecutionTerminatedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.Reason != nil {
 		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
@@ -47923,6 +56607,10 @@ func (v *WorkflowExecutionTerminatedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionTerminatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -47946,6 +56634,9 @@ func (v *WorkflowExecutionTerminatedEventAttributes) Equals(rhs *WorkflowExecuti
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 
 	return true
 }
@@ -47965,6 +56656,9 @@ func (v *WorkflowExecutionTerminatedEventAttributes) MarshalLogObject(enc zapcor
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
 	return err
 }
 
@@ -48013,6 +56707,21 @@ func (v *WorkflowExecutionTerminatedEventAttributes) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionTerminatedEventAttributes) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *WorkflowExecutionTerminatedEventAttributes) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
 type WorkflowExecutionTimedOutEventAttributes struct {
 	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
 }
@@ -48515,6 +57224,307 @@ func (v *WorkflowQuery) IsSetQueryArgs() bool {
 	return v != nil && v.QueryArgs != nil
 }
 
+type WorkflowStateSnapshot struct {
+	PendingActivities    []*PendingActivityInfo `json:"pendingActivities,omitempty"`
+	PendingTimers        []*PendingTimerInfo    `json:"pendingTimers,omitempty"`
+	LastProcessedEventId *int64                 `json:"lastProcessedEventId,omitempty"`
+}
+
+type _List_PendingTimerInfo_ValueList []*PendingTimerInfo
+
+func (v _List_PendingTimerInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_PendingTimerInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_PendingTimerInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PendingTimerInfo_ValueList) Close() {}
+
+// ToWire translates a WorkflowStateSnapshot struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkflowStateSnapshot) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.PendingActivities != nil {
+		w, err = wire.NewValueList(_List_PendingActivityInfo_ValueList(v.PendingActivities)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PendingTimers != nil {
+		w, err = wire.NewValueList(_List_PendingTimerInfo_ValueList(v.PendingTimers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LastProcessedEventId != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PendingTimerInfo_Read(w wire.Value) (*PendingTimerInfo, error) {
+	var v PendingTimerInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PendingTimerInfo_Read(l wire.ValueList) ([]*PendingTimerInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*PendingTimerInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PendingTimerInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a WorkflowStateSnapshot struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowStateSnapshot struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkflowStateSnapshot
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkflowStateSnapshot) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.PendingActivities, err = _List_PendingActivityInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.PendingTimers, err = _List_PendingTimerInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastProcessedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowStateSnapshot
+// struct.
+func (v *WorkflowStateSnapshot) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.PendingActivities != nil {
+		fields[i] = fmt.Sprintf("PendingActivities: %v", v.PendingActivities)
+		i++
+	}
+	if v.PendingTimers != nil {
+		fields[i] = fmt.Sprintf("PendingTimers: %v", v.PendingTimers)
+		i++
+	}
+	if v.LastProcessedEventId != nil {
+		fields[i] = fmt.Sprintf("LastProcessedEventId: %v", *(v.LastProcessedEventId))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowStateSnapshot{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_PendingTimerInfo_Equals(lhs, rhs []*PendingTimerInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this WorkflowStateSnapshot match the
+// provided WorkflowStateSnapshot.
+//
+// This function performs a deep comparison.
+func (v *WorkflowStateSnapshot) Equals(rhs *WorkflowStateSnapshot) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.PendingActivities == nil && rhs.PendingActivities == nil) || (v.PendingActivities != nil && rhs.PendingActivities != nil && _List_PendingActivityInfo_Equals(v.PendingActivities, rhs.PendingActivities))) {
+		return false
+	}
+	if !((v.PendingTimers == nil && rhs.PendingTimers == nil) || (v.PendingTimers != nil && rhs.PendingTimers != nil && _List_PendingTimerInfo_Equals(v.PendingTimers, rhs.PendingTimers))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastProcessedEventId, rhs.LastProcessedEventId) {
+		return false
+	}
+
+	return true
+}
+
+type _List_PendingTimerInfo_Zapper []*PendingTimerInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_PendingTimerInfo_Zapper.
+func (l _List_PendingTimerInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowStateSnapshot.
+func (v *WorkflowStateSnapshot) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.PendingActivities != nil {
+		err = multierr.Append(err, enc.AddArray("pendingActivities", (_List_PendingActivityInfo_Zapper)(v.PendingActivities)))
+	}
+	if v.PendingTimers != nil {
+		err = multierr.Append(err, enc.AddArray("pendingTimers", (_List_PendingTimerInfo_Zapper)(v.PendingTimers)))
+	}
+	if v.LastProcessedEventId != nil {
+		enc.AddInt64("lastProcessedEventId", *v.LastProcessedEventId)
+	}
+	return err
+}
+
+// GetPendingActivities returns the value of PendingActivities if it is set or its
+// zero value if it is unset.
+func (v *WorkflowStateSnapshot) GetPendingActivities() (o []*PendingActivityInfo) {
+	if v != nil && v.PendingActivities != nil {
+		return v.PendingActivities
+	}
+
+	return
+}
+
+// IsSetPendingActivities returns true if PendingActivities is not nil.
+func (v *WorkflowStateSnapshot) IsSetPendingActivities() bool {
+	return v != nil && v.PendingActivities != nil
+}
+
+// GetPendingTimers returns the value of PendingTimers if it is set or its
+// zero value if it is unset.
+func (v *WorkflowStateSnapshot) GetPendingTimers() (o []*PendingTimerInfo) {
+	if v != nil && v.PendingTimers != nil {
+		return v.PendingTimers
+	}
+
+	return
+}
+
+// IsSetPendingTimers returns true if PendingTimers is not nil.
+func (v *WorkflowStateSnapshot) IsSetPendingTimers() bool {
+	return v != nil && v.PendingTimers != nil
+}
+
+// GetLastProcessedEventId returns the value of LastProcessedEventId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowStateSnapshot) GetLastProcessedEventId() (o int64) {
+	if v != nil && v.LastProcessedEventId != nil {
+		return *v.LastProcessedEventId
+	}
+
+	return
+}
+
+// IsSetLastProcessedEventId returns true if LastProcessedEventId is not nil.
+func (v *WorkflowStateSnapshot) IsSetLastProcessedEventId() bool {
+	return v != nil && v.LastProcessedEventId != nil
+}
+
 type WorkflowType struct {
 	Name *string `json:"name,omitempty"`
 }
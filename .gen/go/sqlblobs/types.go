@@ -27,12 +27,11 @@ import (
 	bytes "bytes"
 	base64 "encoding/base64"
 	fmt "fmt"
-	strings "strings"
-
 	shared "github.com/uber/cadence/.gen/go/shared"
 	multierr "go.uber.org/multierr"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
 )
 
 type ActivityInfo struct {
@@ -4410,15 +4409,18 @@ func (v *RequestCancelInfo) IsSetCancelRequestID() bool {
 }
 
 type ShardInfo struct {
-	StolenSinceRenew          *int32           `json:"stolenSinceRenew,omitempty"`
-	UpdatedAtNanos            *int64           `json:"updatedAtNanos,omitempty"`
-	ReplicationAckLevel       *int64           `json:"replicationAckLevel,omitempty"`
-	TransferAckLevel          *int64           `json:"transferAckLevel,omitempty"`
-	TimerAckLevelNanos        *int64           `json:"timerAckLevelNanos,omitempty"`
-	DomainNotificationVersion *int64           `json:"domainNotificationVersion,omitempty"`
-	ClusterTransferAckLevel   map[string]int64 `json:"clusterTransferAckLevel,omitempty"`
-	ClusterTimerAckLevel      map[string]int64 `json:"clusterTimerAckLevel,omitempty"`
-	Owner                     *string          `json:"owner,omitempty"`
+	StolenSinceRenew          *int32            `json:"stolenSinceRenew,omitempty"`
+	UpdatedAtNanos            *int64            `json:"updatedAtNanos,omitempty"`
+	ReplicationAckLevel       *int64            `json:"replicationAckLevel,omitempty"`
+	TransferAckLevel          *int64            `json:"transferAckLevel,omitempty"`
+	TimerAckLevelNanos        *int64            `json:"timerAckLevelNanos,omitempty"`
+	DomainNotificationVersion *int64            `json:"domainNotificationVersion,omitempty"`
+	ClusterTransferAckLevel   map[string]int64  `json:"clusterTransferAckLevel,omitempty"`
+	ClusterTimerAckLevel      map[string]int64  `json:"clusterTimerAckLevel,omitempty"`
+	Owner                     *string           `json:"owner,omitempty"`
+	ClusterTransferReadLevel  map[string]int64  `json:"clusterTransferReadLevel,omitempty"`
+	ClusterTransferAckBitmap  map[string][]byte `json:"clusterTransferAckBitmap,omitempty"`
+	VisibilityAckLevel        *int64            `json:"visibilityAckLevel,omitempty"`
 }
 
 type _Map_String_I64_MapItemList map[string]int64
@@ -4456,6 +4458,44 @@ func (_Map_String_I64_MapItemList) ValueType() wire.Type {
 
 func (_Map_String_I64_MapItemList) Close() {}
 
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueBinary(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {}
+
 // ToWire translates a ShardInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -4473,7 +4513,7 @@ func (_Map_String_I64_MapItemList) Close() {}
 //   }
 func (v *ShardInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4551,6 +4591,30 @@ func (v *ShardInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 38, Value: w}
 		i++
 	}
+	if v.ClusterTransferReadLevel != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.ClusterTransferReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ClusterTransferAckBitmap != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.ClusterTransferAckBitmap)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 42, Value: w}
+		i++
+	}
+	if v.VisibilityAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.VisibilityAckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 44, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -4583,6 +4647,34 @@ func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
 	return o, err
 }
 
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a ShardInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -4690,6 +4782,32 @@ func (v *ShardInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TMap {
+				v.ClusterTransferReadLevel, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 42:
+			if field.Value.Type() == wire.TMap {
+				v.ClusterTransferAckBitmap, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 44:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.VisibilityAckLevel = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4704,7 +4822,7 @@ func (v *ShardInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [12]string
 	i := 0
 	if v.StolenSinceRenew != nil {
 		fields[i] = fmt.Sprintf("StolenSinceRenew: %v", *(v.StolenSinceRenew))
@@ -4742,6 +4860,18 @@ func (v *ShardInfo) String() string {
 		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
 		i++
 	}
+	if v.ClusterTransferReadLevel != nil {
+		fields[i] = fmt.Sprintf("ClusterTransferReadLevel: %v", v.ClusterTransferReadLevel)
+		i++
+	}
+	if v.ClusterTransferAckBitmap != nil {
+		fields[i] = fmt.Sprintf("ClusterTransferAckBitmap: %v", v.ClusterTransferAckBitmap)
+		i++
+	}
+	if v.VisibilityAckLevel != nil {
+		fields[i] = fmt.Sprintf("VisibilityAckLevel: %v", *(v.VisibilityAckLevel))
+		i++
+	}
 
 	return fmt.Sprintf("ShardInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -4763,6 +4893,23 @@ func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
 	return true
 }
 
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this ShardInfo match the
 // provided ShardInfo.
 //
@@ -4800,6 +4947,15 @@ func (v *ShardInfo) Equals(rhs *ShardInfo) bool {
 	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
 		return false
 	}
+	if !((v.ClusterTransferReadLevel == nil && rhs.ClusterTransferReadLevel == nil) || (v.ClusterTransferReadLevel != nil && rhs.ClusterTransferReadLevel != nil && _Map_String_I64_Equals(v.ClusterTransferReadLevel, rhs.ClusterTransferReadLevel))) {
+		return false
+	}
+	if !((v.ClusterTransferAckBitmap == nil && rhs.ClusterTransferAckBitmap == nil) || (v.ClusterTransferAckBitmap != nil && rhs.ClusterTransferAckBitmap != nil && _Map_String_Binary_Equals(v.ClusterTransferAckBitmap, rhs.ClusterTransferAckBitmap))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.VisibilityAckLevel, rhs.VisibilityAckLevel) {
+		return false
+	}
 
 	return true
 }
@@ -4815,6 +4971,17 @@ func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	return err
 }
 
+type _Map_String_Binary_Zapper map[string][]byte
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Binary_Zapper.
+func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ShardInfo.
 func (v *ShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -4848,6 +5015,15 @@ func (v *ShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.Owner != nil {
 		enc.AddString("owner", *v.Owner)
 	}
+	if v.ClusterTransferReadLevel != nil {
+		err = multierr.Append(err, enc.AddObject("clusterTransferReadLevel", (_Map_String_I64_Zapper)(v.ClusterTransferReadLevel)))
+	}
+	if v.ClusterTransferAckBitmap != nil {
+		err = multierr.Append(err, enc.AddObject("clusterTransferAckBitmap", (_Map_String_Binary_Zapper)(v.ClusterTransferAckBitmap)))
+	}
+	if v.VisibilityAckLevel != nil {
+		enc.AddInt64("visibilityAckLevel", *v.VisibilityAckLevel)
+	}
 	return err
 }
 
@@ -4986,6 +5162,51 @@ func (v *ShardInfo) IsSetOwner() bool {
 	return v != nil && v.Owner != nil
 }
 
+// GetClusterTransferReadLevel returns the value of ClusterTransferReadLevel if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetClusterTransferReadLevel() (o map[string]int64) {
+	if v != nil && v.ClusterTransferReadLevel != nil {
+		return v.ClusterTransferReadLevel
+	}
+
+	return
+}
+
+// IsSetClusterTransferReadLevel returns true if ClusterTransferReadLevel is not nil.
+func (v *ShardInfo) IsSetClusterTransferReadLevel() bool {
+	return v != nil && v.ClusterTransferReadLevel != nil
+}
+
+// GetClusterTransferAckBitmap returns the value of ClusterTransferAckBitmap if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetClusterTransferAckBitmap() (o map[string][]byte) {
+	if v != nil && v.ClusterTransferAckBitmap != nil {
+		return v.ClusterTransferAckBitmap
+	}
+
+	return
+}
+
+// IsSetClusterTransferAckBitmap returns true if ClusterTransferAckBitmap is not nil.
+func (v *ShardInfo) IsSetClusterTransferAckBitmap() bool {
+	return v != nil && v.ClusterTransferAckBitmap != nil
+}
+
+// GetVisibilityAckLevel returns the value of VisibilityAckLevel if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetVisibilityAckLevel() (o int64) {
+	if v != nil && v.VisibilityAckLevel != nil {
+		return *v.VisibilityAckLevel
+	}
+
+	return
+}
+
+// IsSetVisibilityAckLevel returns true if VisibilityAckLevel is not nil.
+func (v *ShardInfo) IsSetVisibilityAckLevel() bool {
+	return v != nil && v.VisibilityAckLevel != nil
+}
+
 type SignalInfo struct {
 	Version   *int64  `json:"version,omitempty"`
 	RequestID *string `json:"requestID,omitempty"`
@@ -5297,10 +5518,11 @@ func (v *SignalInfo) IsSetControl() bool {
 }
 
 type TaskInfo struct {
-	WorkflowID      *string `json:"workflowID,omitempty"`
-	RunID           []byte  `json:"runID,omitempty"`
-	ScheduleID      *int64  `json:"scheduleID,omitempty"`
-	ExpiryTimeNanos *int64  `json:"expiryTimeNanos,omitempty"`
+	WorkflowID       *string `json:"workflowID,omitempty"`
+	RunID            []byte  `json:"runID,omitempty"`
+	ScheduleID       *int64  `json:"scheduleID,omitempty"`
+	ExpiryTimeNanos  *int64  `json:"expiryTimeNanos,omitempty"`
+	CreatedTimeNanos *int64  `json:"createdTimeNanos,omitempty"`
 }
 
 // ToWire translates a TaskInfo struct into a Thrift-level intermediate
@@ -5320,7 +5542,7 @@ type TaskInfo struct {
 //   }
 func (v *TaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5358,6 +5580,14 @@ func (v *TaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 14, Value: w}
 		i++
 	}
+	if v.CreatedTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.CreatedTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -5421,6 +5651,16 @@ func (v *TaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 15:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CreatedTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -5435,7 +5675,7 @@ func (v *TaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.WorkflowID != nil {
 		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
@@ -5453,6 +5693,10 @@ func (v *TaskInfo) String() string {
 		fields[i] = fmt.Sprintf("ExpiryTimeNanos: %v", *(v.ExpiryTimeNanos))
 		i++
 	}
+	if v.CreatedTimeNanos != nil {
+		fields[i] = fmt.Sprintf("CreatedTimeNanos: %v", *(v.CreatedTimeNanos))
+		i++
+	}
 
 	return fmt.Sprintf("TaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -5479,6 +5723,9 @@ func (v *TaskInfo) Equals(rhs *TaskInfo) bool {
 	if !_I64_EqualsPtr(v.ExpiryTimeNanos, rhs.ExpiryTimeNanos) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.CreatedTimeNanos, rhs.CreatedTimeNanos) {
+		return false
+	}
 
 	return true
 }
@@ -5501,6 +5748,9 @@ func (v *TaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.ExpiryTimeNanos != nil {
 		enc.AddInt64("expiryTimeNanos", *v.ExpiryTimeNanos)
 	}
+	if v.CreatedTimeNanos != nil {
+		enc.AddInt64("createdTimeNanos", *v.CreatedTimeNanos)
+	}
 	return err
 }
 
@@ -5564,6 +5814,21 @@ func (v *TaskInfo) IsSetExpiryTimeNanos() bool {
 	return v != nil && v.ExpiryTimeNanos != nil
 }
 
+// GetCreatedTimeNanos returns the value of CreatedTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetCreatedTimeNanos() (o int64) {
+	if v != nil && v.CreatedTimeNanos != nil {
+		return *v.CreatedTimeNanos
+	}
+
+	return
+}
+
+// IsSetCreatedTimeNanos returns true if CreatedTimeNanos is not nil.
+func (v *TaskInfo) IsSetCreatedTimeNanos() bool {
+	return v != nil && v.CreatedTimeNanos != nil
+}
+
 type TaskListInfo struct {
 	Kind             *int16 `json:"kind,omitempty"`
 	AckLevel         *int64 `json:"ackLevel,omitempty"`
@@ -7213,6 +7478,7 @@ type WorkflowExecutionInfo struct {
 	ClientLibraryVersion         *string                     `json:"clientLibraryVersion,omitempty"`
 	ClientFeatureVersion         *string                     `json:"clientFeatureVersion,omitempty"`
 	ClientImpl                   *string                     `json:"clientImpl,omitempty"`
+	DecisionFailureCount         *int64                      `json:"decisionFailureCount,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
@@ -7232,7 +7498,7 @@ type WorkflowExecutionInfo struct {
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [52]wire.Field
+		fields [53]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7654,6 +7920,14 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 114, Value: w}
 		i++
 	}
+	if v.DecisionFailureCount != nil {
+		w, err = wire.NewValueI64(*(v.DecisionFailureCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 116, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -8185,6 +8459,16 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 116:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionFailureCount = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -8199,7 +8483,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [52]string
+	var fields [53]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -8409,6 +8693,10 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("ClientImpl: %v", *(v.ClientImpl))
 		i++
 	}
+	if v.DecisionFailureCount != nil {
+		fields[i] = fmt.Sprintf("DecisionFailureCount: %v", *(v.DecisionFailureCount))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -8579,6 +8867,9 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.ClientImpl, rhs.ClientImpl) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.DecisionFailureCount, rhs.DecisionFailureCount) {
+		return false
+	}
 
 	return true
 }
@@ -8745,6 +9036,9 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.ClientImpl != nil {
 		enc.AddString("clientImpl", *v.ClientImpl)
 	}
+	if v.DecisionFailureCount != nil {
+		enc.AddInt64("decisionFailureCount", *v.DecisionFailureCount)
+	}
 	return err
 }
 
@@ -9527,3 +9821,18 @@ func (v *WorkflowExecutionInfo) GetClientImpl() (o string) {
 func (v *WorkflowExecutionInfo) IsSetClientImpl() bool {
 	return v != nil && v.ClientImpl != nil
 }
+
+// GetDecisionFailureCount returns the value of DecisionFailureCount if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetDecisionFailureCount() (o int64) {
+	if v != nil && v.DecisionFailureCount != nil {
+		return *v.DecisionFailureCount
+	}
+
+	return
+}
+
+// IsSetDecisionFailureCount returns true if DecisionFailureCount is not nil.
+func (v *WorkflowExecutionInfo) IsSetDecisionFailureCount() bool {
+	return v != nil && v.DecisionFailureCount != nil
+}
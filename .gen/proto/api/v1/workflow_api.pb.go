@@ -0,0 +1,1147 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/api/v1/workflow_api.proto
+
+package apiv1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type WorkflowIdReusePolicy int32
+
+const (
+	// The server default, ALLOW_DUPLICATE_FAILED_ONLY.
+	WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID                     WorkflowIdReusePolicy = 0
+	WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY WorkflowIdReusePolicy = 1
+	WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE             WorkflowIdReusePolicy = 2
+	WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE            WorkflowIdReusePolicy = 3
+)
+
+var WorkflowIdReusePolicy_name = map[int32]string{
+	0: "WORKFLOW_ID_REUSE_POLICY_INVALID",
+	1: "WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY",
+	2: "WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE",
+	3: "WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE",
+}
+
+var WorkflowIdReusePolicy_value = map[string]int32{
+	"WORKFLOW_ID_REUSE_POLICY_INVALID":                     0,
+	"WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY": 1,
+	"WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE":             2,
+	"WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE":            3,
+}
+
+func (x WorkflowIdReusePolicy) String() string {
+	return proto.EnumName(WorkflowIdReusePolicy_name, int32(x))
+}
+
+func (WorkflowIdReusePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{0}
+}
+
+type WorkflowExecution struct {
+	WorkflowId           string   `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId                string   `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowExecution) Reset()         { *m = WorkflowExecution{} }
+func (m *WorkflowExecution) String() string { return proto.CompactTextString(m) }
+func (*WorkflowExecution) ProtoMessage()    {}
+func (*WorkflowExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{0}
+}
+func (m *WorkflowExecution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowExecution.Unmarshal(m, b)
+}
+func (m *WorkflowExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowExecution.Marshal(b, m, deterministic)
+}
+func (m *WorkflowExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowExecution.Merge(m, src)
+}
+func (m *WorkflowExecution) XXX_Size() int {
+	return xxx_messageInfo_WorkflowExecution.Size(m)
+}
+func (m *WorkflowExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowExecution proto.InternalMessageInfo
+
+func (m *WorkflowExecution) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *WorkflowExecution) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+type WorkflowType struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowType) Reset()         { *m = WorkflowType{} }
+func (m *WorkflowType) String() string { return proto.CompactTextString(m) }
+func (*WorkflowType) ProtoMessage()    {}
+func (*WorkflowType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{1}
+}
+func (m *WorkflowType) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowType.Unmarshal(m, b)
+}
+func (m *WorkflowType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowType.Marshal(b, m, deterministic)
+}
+func (m *WorkflowType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowType.Merge(m, src)
+}
+func (m *WorkflowType) XXX_Size() int {
+	return xxx_messageInfo_WorkflowType.Size(m)
+}
+func (m *WorkflowType) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowType proto.InternalMessageInfo
+
+func (m *WorkflowType) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type TaskList struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskList) Reset()         { *m = TaskList{} }
+func (m *TaskList) String() string { return proto.CompactTextString(m) }
+func (*TaskList) ProtoMessage()    {}
+func (*TaskList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{2}
+}
+func (m *TaskList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaskList.Unmarshal(m, b)
+}
+func (m *TaskList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaskList.Marshal(b, m, deterministic)
+}
+func (m *TaskList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskList.Merge(m, src)
+}
+func (m *TaskList) XXX_Size() int {
+	return xxx_messageInfo_TaskList.Size(m)
+}
+func (m *TaskList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskList proto.InternalMessageInfo
+
+func (m *TaskList) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RetryPolicy struct {
+	InitialIntervalInSeconds    int32    `protobuf:"varint,1,opt,name=initial_interval_in_seconds,json=initialIntervalInSeconds,proto3" json:"initial_interval_in_seconds,omitempty"`
+	BackoffCoefficient          float64  `protobuf:"fixed64,2,opt,name=backoff_coefficient,json=backoffCoefficient,proto3" json:"backoff_coefficient,omitempty"`
+	MaximumIntervalInSeconds    int32    `protobuf:"varint,3,opt,name=maximum_interval_in_seconds,json=maximumIntervalInSeconds,proto3" json:"maximum_interval_in_seconds,omitempty"`
+	MaximumAttempts             int32    `protobuf:"varint,4,opt,name=maximum_attempts,json=maximumAttempts,proto3" json:"maximum_attempts,omitempty"`
+	NonRetriableErrorReasons    []string `protobuf:"bytes,5,rep,name=non_retriable_error_reasons,json=nonRetriableErrorReasons,proto3" json:"non_retriable_error_reasons,omitempty"`
+	ExpirationIntervalInSeconds int32    `protobuf:"varint,6,opt,name=expiration_interval_in_seconds,json=expirationIntervalInSeconds,proto3" json:"expiration_interval_in_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{3}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryPolicy.Unmarshal(m, b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return xxx_messageInfo_RetryPolicy.Size(m)
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetInitialIntervalInSeconds() int32 {
+	if m != nil {
+		return m.InitialIntervalInSeconds
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffCoefficient() float64 {
+	if m != nil {
+		return m.BackoffCoefficient
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetMaximumIntervalInSeconds() int32 {
+	if m != nil {
+		return m.MaximumIntervalInSeconds
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetMaximumAttempts() int32 {
+	if m != nil {
+		return m.MaximumAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetNonRetriableErrorReasons() []string {
+	if m != nil {
+		return m.NonRetriableErrorReasons
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetExpirationIntervalInSeconds() int32 {
+	if m != nil {
+		return m.ExpirationIntervalInSeconds
+	}
+	return 0
+}
+
+type Memo struct {
+	Fields               map[string][]byte `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Memo) Reset()         { *m = Memo{} }
+func (m *Memo) String() string { return proto.CompactTextString(m) }
+func (*Memo) ProtoMessage()    {}
+func (*Memo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{4}
+}
+func (m *Memo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Memo.Unmarshal(m, b)
+}
+func (m *Memo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Memo.Marshal(b, m, deterministic)
+}
+func (m *Memo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Memo.Merge(m, src)
+}
+func (m *Memo) XXX_Size() int {
+	return xxx_messageInfo_Memo.Size(m)
+}
+func (m *Memo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Memo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Memo proto.InternalMessageInfo
+
+func (m *Memo) GetFields() map[string][]byte {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type SearchAttributes struct {
+	IndexedFields        map[string][]byte `protobuf:"bytes,1,rep,name=indexed_fields,json=indexedFields,proto3" json:"indexed_fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SearchAttributes) Reset()         { *m = SearchAttributes{} }
+func (m *SearchAttributes) String() string { return proto.CompactTextString(m) }
+func (*SearchAttributes) ProtoMessage()    {}
+func (*SearchAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{5}
+}
+func (m *SearchAttributes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchAttributes.Unmarshal(m, b)
+}
+func (m *SearchAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchAttributes.Marshal(b, m, deterministic)
+}
+func (m *SearchAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchAttributes.Merge(m, src)
+}
+func (m *SearchAttributes) XXX_Size() int {
+	return xxx_messageInfo_SearchAttributes.Size(m)
+}
+func (m *SearchAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchAttributes proto.InternalMessageInfo
+
+func (m *SearchAttributes) GetIndexedFields() map[string][]byte {
+	if m != nil {
+		return m.IndexedFields
+	}
+	return nil
+}
+
+type StartWorkflowExecutionRequest struct {
+	Domain                              string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowId                          string                `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	WorkflowType                        *WorkflowType         `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskList                            *TaskList             `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Input                               []byte                `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds int32                 `protobuf:"varint,6,opt,name=execution_start_to_close_timeout_seconds,json=executionStartToCloseTimeoutSeconds,proto3" json:"execution_start_to_close_timeout_seconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      int32                 `protobuf:"varint,7,opt,name=task_start_to_close_timeout_seconds,json=taskStartToCloseTimeoutSeconds,proto3" json:"task_start_to_close_timeout_seconds,omitempty"`
+	Identity                            string                `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId                           string                `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	WorkflowIdReusePolicy               WorkflowIdReusePolicy `protobuf:"varint,10,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=uber.cadence.api.v1.WorkflowIdReusePolicy" json:"workflow_id_reuse_policy,omitempty"`
+	RetryPolicy                         *RetryPolicy          `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	CronSchedule                        string                `protobuf:"bytes,12,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	Memo                                *Memo                 `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes     `protobuf:"bytes,14,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	XXX_NoUnkeyedLiteral                struct{}              `json:"-"`
+	XXX_unrecognized                    []byte                `json:"-"`
+	XXX_sizecache                       int32                 `json:"-"`
+}
+
+func (m *StartWorkflowExecutionRequest) Reset()         { *m = StartWorkflowExecutionRequest{} }
+func (m *StartWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*StartWorkflowExecutionRequest) ProtoMessage()    {}
+func (*StartWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{6}
+}
+func (m *StartWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartWorkflowExecutionRequest.Unmarshal(m, b)
+}
+func (m *StartWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartWorkflowExecutionRequest.Marshal(b, m, deterministic)
+}
+func (m *StartWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *StartWorkflowExecutionRequest) XXX_Size() int {
+	return xxx_messageInfo_StartWorkflowExecutionRequest.Size(m)
+}
+func (m *StartWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *StartWorkflowExecutionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *StartWorkflowExecutionRequest) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *StartWorkflowExecutionRequest) GetWorkflowType() *WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() int32 {
+	if m != nil {
+		return m.ExecutionStartToCloseTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *StartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TaskStartToCloseTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *StartWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *StartWorkflowExecutionRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *StartWorkflowExecutionRequest) GetWorkflowIdReusePolicy() WorkflowIdReusePolicy {
+	if m != nil {
+		return m.WorkflowIdReusePolicy
+	}
+	return WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
+}
+
+func (m *StartWorkflowExecutionRequest) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetCronSchedule() string {
+	if m != nil {
+		return m.CronSchedule
+	}
+	return ""
+}
+
+func (m *StartWorkflowExecutionRequest) GetMemo() *Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetSearchAttributes() *SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+type StartWorkflowExecutionResponse struct {
+	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartWorkflowExecutionResponse) Reset()         { *m = StartWorkflowExecutionResponse{} }
+func (m *StartWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*StartWorkflowExecutionResponse) ProtoMessage()    {}
+func (*StartWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{7}
+}
+func (m *StartWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartWorkflowExecutionResponse.Unmarshal(m, b)
+}
+func (m *StartWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartWorkflowExecutionResponse.Marshal(b, m, deterministic)
+}
+func (m *StartWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *StartWorkflowExecutionResponse) XXX_Size() int {
+	return xxx_messageInfo_StartWorkflowExecutionResponse.Size(m)
+}
+func (m *StartWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *StartWorkflowExecutionResponse) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+type SignalWorkflowExecutionRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	SignalName           string             `protobuf:"bytes,3,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	Input                []byte             `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	Identity             string             `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string             `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Control              []byte             `protobuf:"bytes,7,opt,name=control,proto3" json:"control,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SignalWorkflowExecutionRequest) Reset()         { *m = SignalWorkflowExecutionRequest{} }
+func (m *SignalWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignalWorkflowExecutionRequest) ProtoMessage()    {}
+func (*SignalWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{8}
+}
+func (m *SignalWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalWorkflowExecutionRequest.Unmarshal(m, b)
+}
+func (m *SignalWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalWorkflowExecutionRequest.Marshal(b, m, deterministic)
+}
+func (m *SignalWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *SignalWorkflowExecutionRequest) XXX_Size() int {
+	return xxx_messageInfo_SignalWorkflowExecutionRequest.Size(m)
+}
+func (m *SignalWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *SignalWorkflowExecutionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *SignalWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *SignalWorkflowExecutionRequest) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *SignalWorkflowExecutionRequest) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *SignalWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *SignalWorkflowExecutionRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *SignalWorkflowExecutionRequest) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+type SignalWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignalWorkflowExecutionResponse) Reset()         { *m = SignalWorkflowExecutionResponse{} }
+func (m *SignalWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignalWorkflowExecutionResponse) ProtoMessage()    {}
+func (*SignalWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{9}
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalWorkflowExecutionResponse.Unmarshal(m, b)
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalWorkflowExecutionResponse.Marshal(b, m, deterministic)
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Size() int {
+	return xxx_messageInfo_SignalWorkflowExecutionResponse.Size(m)
+}
+func (m *SignalWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWorkflowExecutionResponse proto.InternalMessageInfo
+
+type SignalWithStartWorkflowExecutionRequest struct {
+	StartRequest         *StartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=start_request,json=startRequest,proto3" json:"start_request,omitempty"`
+	SignalName           string                         `protobuf:"bytes,2,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	SignalInput          []byte                         `protobuf:"bytes,3,opt,name=signal_input,json=signalInput,proto3" json:"signal_input,omitempty"`
+	Control              []byte                         `protobuf:"bytes,4,opt,name=control,proto3" json:"control,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) Reset() {
+	*m = SignalWithStartWorkflowExecutionRequest{}
+}
+func (m *SignalWithStartWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignalWithStartWorkflowExecutionRequest) ProtoMessage()    {}
+func (*SignalWithStartWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{10}
+}
+func (m *SignalWithStartWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalWithStartWorkflowExecutionRequest.Unmarshal(m, b)
+}
+func (m *SignalWithStartWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalWithStartWorkflowExecutionRequest.Marshal(b, m, deterministic)
+}
+func (m *SignalWithStartWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWithStartWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *SignalWithStartWorkflowExecutionRequest) XXX_Size() int {
+	return xxx_messageInfo_SignalWithStartWorkflowExecutionRequest.Size(m)
+}
+func (m *SignalWithStartWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWithStartWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWithStartWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *SignalWithStartWorkflowExecutionRequest) GetStartRequest() *StartWorkflowExecutionRequest {
+	if m != nil {
+		return m.StartRequest
+	}
+	return nil
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) GetSignalInput() []byte {
+	if m != nil {
+		return m.SignalInput
+	}
+	return nil
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) GetControl() []byte {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+type SignalWithStartWorkflowExecutionResponse struct {
+	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) Reset() {
+	*m = SignalWithStartWorkflowExecutionResponse{}
+}
+func (m *SignalWithStartWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignalWithStartWorkflowExecutionResponse) ProtoMessage()    {}
+func (*SignalWithStartWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{11}
+}
+func (m *SignalWithStartWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignalWithStartWorkflowExecutionResponse.Unmarshal(m, b)
+}
+func (m *SignalWithStartWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignalWithStartWorkflowExecutionResponse.Marshal(b, m, deterministic)
+}
+func (m *SignalWithStartWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWithStartWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *SignalWithStartWorkflowExecutionResponse) XXX_Size() int {
+	return xxx_messageInfo_SignalWithStartWorkflowExecutionResponse.Size(m)
+}
+func (m *SignalWithStartWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWithStartWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWithStartWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *SignalWithStartWorkflowExecutionResponse) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+type RequestCancelWorkflowExecutionRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Identity             string             `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string             `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCancelWorkflowExecutionRequest{} }
+func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
+func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{12}
+}
+func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestCancelWorkflowExecutionRequest.Unmarshal(m, b)
+}
+func (m *RequestCancelWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestCancelWorkflowExecutionRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestCancelWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *RequestCancelWorkflowExecutionRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestCancelWorkflowExecutionRequest.Size(m)
+}
+func (m *RequestCancelWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCancelWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *RequestCancelWorkflowExecutionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type RequestCancelWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Reset() {
+	*m = RequestCancelWorkflowExecutionResponse{}
+}
+func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
+func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{13}
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Unmarshal(m, b)
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Size(m)
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCancelWorkflowExecutionResponse proto.InternalMessageInfo
+
+type TerminateWorkflowExecutionRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Reason               string             `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details              []byte             `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	Identity             string             `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string             `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TerminateWorkflowExecutionRequest) Reset()         { *m = TerminateWorkflowExecutionRequest{} }
+func (m *TerminateWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*TerminateWorkflowExecutionRequest) ProtoMessage()    {}
+func (*TerminateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{14}
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminateWorkflowExecutionRequest.Unmarshal(m, b)
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminateWorkflowExecutionRequest.Marshal(b, m, deterministic)
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_Size() int {
+	return xxx_messageInfo_TerminateWorkflowExecutionRequest.Size(m)
+}
+func (m *TerminateWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *TerminateWorkflowExecutionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *TerminateWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *TerminateWorkflowExecutionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TerminateWorkflowExecutionRequest) GetDetails() []byte {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *TerminateWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *TerminateWorkflowExecutionRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type TerminateWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TerminateWorkflowExecutionResponse) Reset()         { *m = TerminateWorkflowExecutionResponse{} }
+func (m *TerminateWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*TerminateWorkflowExecutionResponse) ProtoMessage()    {}
+func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{15}
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminateWorkflowExecutionResponse.Unmarshal(m, b)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Size() int {
+	return xxx_messageInfo_TerminateWorkflowExecutionResponse.Size(m)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
+
+type GetWorkflowExecutionHistoryStreamRequest struct {
+	Domain            string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	// The maximum number of events of each page, the server default when not set.
+	MaximumPageSize      int32    `protobuf:"varint,3,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWorkflowExecutionHistoryStreamRequest) Reset() {
+	*m = GetWorkflowExecutionHistoryStreamRequest{}
+}
+func (m *GetWorkflowExecutionHistoryStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowExecutionHistoryStreamRequest) ProtoMessage()    {}
+func (*GetWorkflowExecutionHistoryStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{16}
+}
+func (m *GetWorkflowExecutionHistoryStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWorkflowExecutionHistoryStreamRequest.Unmarshal(m, b)
+}
+func (m *GetWorkflowExecutionHistoryStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWorkflowExecutionHistoryStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetWorkflowExecutionHistoryStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowExecutionHistoryStreamRequest.Merge(m, src)
+}
+func (m *GetWorkflowExecutionHistoryStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetWorkflowExecutionHistoryStreamRequest.Size(m)
+}
+func (m *GetWorkflowExecutionHistoryStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowExecutionHistoryStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkflowExecutionHistoryStreamRequest proto.InternalMessageInfo
+
+func (m *GetWorkflowExecutionHistoryStreamRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *GetWorkflowExecutionHistoryStreamRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *GetWorkflowExecutionHistoryStreamRequest) GetMaximumPageSize() int32 {
+	if m != nil {
+		return m.MaximumPageSize
+	}
+	return 0
+}
+
+type GetWorkflowExecutionHistoryStreamResponse struct {
+	// The events of one page, a shared.History encoded by the thriftrw codec of common/codec.
+	History              []byte   `protobuf:"bytes,1,opt,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWorkflowExecutionHistoryStreamResponse) Reset() {
+	*m = GetWorkflowExecutionHistoryStreamResponse{}
+}
+func (m *GetWorkflowExecutionHistoryStreamResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetWorkflowExecutionHistoryStreamResponse) ProtoMessage() {}
+func (*GetWorkflowExecutionHistoryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce37b034f3aa771, []int{17}
+}
+func (m *GetWorkflowExecutionHistoryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWorkflowExecutionHistoryStreamResponse.Unmarshal(m, b)
+}
+func (m *GetWorkflowExecutionHistoryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWorkflowExecutionHistoryStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *GetWorkflowExecutionHistoryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowExecutionHistoryStreamResponse.Merge(m, src)
+}
+func (m *GetWorkflowExecutionHistoryStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_GetWorkflowExecutionHistoryStreamResponse.Size(m)
+}
+func (m *GetWorkflowExecutionHistoryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowExecutionHistoryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkflowExecutionHistoryStreamResponse proto.InternalMessageInfo
+
+func (m *GetWorkflowExecutionHistoryStreamResponse) GetHistory() []byte {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("uber.cadence.api.v1.WorkflowIdReusePolicy", WorkflowIdReusePolicy_name, WorkflowIdReusePolicy_value)
+	proto.RegisterType((*WorkflowExecution)(nil), "uber.cadence.api.v1.WorkflowExecution")
+	proto.RegisterType((*WorkflowType)(nil), "uber.cadence.api.v1.WorkflowType")
+	proto.RegisterType((*TaskList)(nil), "uber.cadence.api.v1.TaskList")
+	proto.RegisterType((*RetryPolicy)(nil), "uber.cadence.api.v1.RetryPolicy")
+	proto.RegisterType((*Memo)(nil), "uber.cadence.api.v1.Memo")
+	proto.RegisterMapType((map[string][]byte)(nil), "uber.cadence.api.v1.Memo.FieldsEntry")
+	proto.RegisterType((*SearchAttributes)(nil), "uber.cadence.api.v1.SearchAttributes")
+	proto.RegisterMapType((map[string][]byte)(nil), "uber.cadence.api.v1.SearchAttributes.IndexedFieldsEntry")
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.StartWorkflowExecutionRequest")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*GetWorkflowExecutionHistoryStreamRequest)(nil), "uber.cadence.api.v1.GetWorkflowExecutionHistoryStreamRequest")
+	proto.RegisterType((*GetWorkflowExecutionHistoryStreamResponse)(nil), "uber.cadence.api.v1.GetWorkflowExecutionHistoryStreamResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/api/v1/workflow_api.proto", fileDescriptor_7ce37b034f3aa771)
+}
+
+var fileDescriptor_7ce37b034f3aa771 = []byte{
+	// 1340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0x46, 0xb1, 0xe3, 0x24, 0xc7, 0x4e, 0x71, 0xb6, 0xb4, 0x15, 0xee, 0x34, 0x75, 0xdc, 0x1f,
+	0xdc, 0x0e, 0xb5, 0xa9, 0xdb, 0xa1, 0xa5, 0xa5, 0x0c, 0xc6, 0x71, 0x40, 0xd4, 0x34, 0x19, 0xd9,
+	0x21, 0x53, 0x6e, 0x34, 0x1b, 0x79, 0x93, 0xec, 0xc4, 0xd2, 0x9a, 0xd5, 0x3a, 0x89, 0x7b, 0x05,
+	0x33, 0xdc, 0xf6, 0x01, 0x18, 0x2e, 0xb8, 0x80, 0x57, 0xe0, 0x11, 0x78, 0x00, 0x66, 0x78, 0x00,
+	0xee, 0x79, 0x08, 0x18, 0xad, 0x56, 0x8e, 0x62, 0xcb, 0x76, 0x12, 0x2e, 0xe8, 0x9d, 0xf6, 0xec,
+	0x77, 0xce, 0x9e, 0xbf, 0xef, 0xec, 0x8e, 0xe0, 0x76, 0x6f, 0x9b, 0xf0, 0xb2, 0x8d, 0xdb, 0xc4,
+	0xb5, 0x49, 0x19, 0x77, 0x69, 0xf9, 0xe0, 0x7e, 0xf9, 0x90, 0xf1, 0xfd, 0x9d, 0x0e, 0x3b, 0xb4,
+	0x70, 0x97, 0x96, 0xba, 0x9c, 0x09, 0x86, 0x2e, 0xfa, 0xb8, 0x92, 0xc2, 0x95, 0x7c, 0xf9, 0xc1,
+	0xfd, 0xc2, 0x73, 0x58, 0xda, 0x52, 0xd0, 0xfa, 0x11, 0xb1, 0x7b, 0x82, 0x32, 0x17, 0x5d, 0x87,
+	0xf4, 0x40, 0x9f, 0xb6, 0x75, 0x2d, 0xaf, 0x15, 0x17, 0x4c, 0x08, 0x45, 0x46, 0x1b, 0x5d, 0x82,
+	0x14, 0xef, 0xb9, 0xfe, 0xde, 0x8c, 0xdc, 0x9b, 0xe5, 0x3d, 0xd7, 0x68, 0x17, 0x0a, 0x90, 0x09,
+	0x8d, 0xb5, 0xfa, 0x5d, 0x82, 0x10, 0x24, 0x5d, 0xec, 0x10, 0x65, 0x40, 0x7e, 0x17, 0x96, 0x61,
+	0xbe, 0x85, 0xbd, 0xfd, 0x06, 0xf5, 0x44, 0xec, 0xfe, 0xdf, 0x33, 0x90, 0x36, 0x89, 0xe0, 0xfd,
+	0x0d, 0xd6, 0xa1, 0x76, 0x1f, 0x3d, 0x83, 0xab, 0xd4, 0xa5, 0x82, 0xe2, 0x8e, 0x45, 0x5d, 0x41,
+	0xf8, 0x81, 0xfc, 0xb0, 0x3c, 0x62, 0x33, 0xb7, 0xed, 0x49, 0xd5, 0x59, 0x53, 0x57, 0x10, 0x43,
+	0x21, 0x0c, 0xb7, 0x19, 0xec, 0xa3, 0x32, 0x5c, 0xdc, 0xc6, 0xf6, 0x3e, 0xdb, 0xd9, 0xb1, 0x6c,
+	0x46, 0x76, 0x76, 0xa8, 0x4d, 0x89, 0x2b, 0xa4, 0xdb, 0x9a, 0x89, 0xd4, 0x56, 0xed, 0x78, 0xc7,
+	0x3f, 0xcf, 0xc1, 0x47, 0xd4, 0xe9, 0x39, 0xb1, 0xe7, 0x25, 0x82, 0xf3, 0x14, 0x64, 0xf4, 0xbc,
+	0x3b, 0x90, 0x0d, 0xd5, 0xb1, 0x10, 0xc4, 0xe9, 0x0a, 0x4f, 0x4f, 0x4a, 0x9d, 0xb7, 0x95, 0xbc,
+	0xaa, 0xc4, 0xfe, 0x49, 0x2e, 0x73, 0x2d, 0x4e, 0x04, 0xa7, 0x78, 0xbb, 0x43, 0x2c, 0xc2, 0x39,
+	0xe3, 0x16, 0x27, 0xd8, 0x63, 0xae, 0xa7, 0xcf, 0xe6, 0x13, 0xc5, 0x05, 0x53, 0x77, 0x99, 0x6b,
+	0x86, 0x88, 0xba, 0x0f, 0x30, 0x83, 0x7d, 0x54, 0x83, 0x65, 0x72, 0xd4, 0xa5, 0x1c, 0xfb, 0x25,
+	0x8b, 0xf5, 0x35, 0x25, 0xcf, 0xbd, 0x7a, 0x8c, 0x1a, 0x71, 0xb7, 0xf0, 0x9d, 0x06, 0xc9, 0xaf,
+	0x88, 0xc3, 0xd0, 0x33, 0x48, 0xed, 0x50, 0xd2, 0x91, 0x19, 0x4d, 0x14, 0xd3, 0x95, 0x5b, 0xa5,
+	0x98, 0x6e, 0x29, 0xf9, 0xd0, 0xd2, 0x9a, 0xc4, 0xd5, 0x5d, 0xc1, 0xfb, 0xa6, 0x52, 0xca, 0x7d,
+	0x04, 0xe9, 0x88, 0x18, 0x65, 0x21, 0xb1, 0x4f, 0xfa, 0xaa, 0xae, 0xfe, 0x27, 0x7a, 0x07, 0x66,
+	0x0f, 0x70, 0xa7, 0x47, 0x64, 0xe6, 0x33, 0x66, 0xb0, 0x78, 0x32, 0xf3, 0x58, 0x2b, 0xfc, 0xa6,
+	0x41, 0xb6, 0x49, 0x30, 0xb7, 0xf7, 0xaa, 0x42, 0x70, 0xba, 0xdd, 0x13, 0xc4, 0x43, 0x16, 0x5c,
+	0xa0, 0x6e, 0x9b, 0x1c, 0x91, 0xb6, 0x75, 0xc2, 0xad, 0xc7, 0xb1, 0x6e, 0x0d, 0xab, 0x97, 0x8c,
+	0x40, 0x37, 0xea, 0xe9, 0x22, 0x8d, 0xca, 0x72, 0x9f, 0x02, 0x1a, 0x05, 0x9d, 0xc9, 0xef, 0x5f,
+	0x52, 0x70, 0xad, 0x29, 0x30, 0x17, 0x23, 0xfc, 0x31, 0xc9, 0xb7, 0x3d, 0xe2, 0x09, 0x74, 0x19,
+	0x52, 0x6d, 0xe6, 0x60, 0xea, 0x2a, 0x83, 0x6a, 0x35, 0x4c, 0xaf, 0x99, 0x11, 0x7a, 0xad, 0xc1,
+	0xe2, 0x00, 0x20, 0xfa, 0x5d, 0x22, 0xbb, 0x2e, 0x5d, 0x59, 0x89, 0x0d, 0x3e, 0xca, 0x38, 0x33,
+	0x73, 0x18, 0x59, 0xa1, 0x27, 0xb0, 0x20, 0xb0, 0xb7, 0x6f, 0x75, 0xa8, 0x27, 0x64, 0x17, 0xa6,
+	0x2b, 0xd7, 0x62, 0x6d, 0x84, 0x8c, 0x34, 0xe7, 0x85, 0xfa, 0xf2, 0x03, 0xa7, 0x6e, 0xb7, 0x27,
+	0xf4, 0xd9, 0x20, 0x70, 0xb9, 0x40, 0x9b, 0x50, 0x24, 0x61, 0x98, 0x96, 0xe7, 0x47, 0x6f, 0x09,
+	0x66, 0xd9, 0x1d, 0xe6, 0x11, 0x4b, 0x50, 0x87, 0xb0, 0x9e, 0x18, 0x6a, 0xbf, 0x1b, 0x03, 0xbc,
+	0x4c, 0x56, 0x8b, 0xd5, 0x7c, 0x70, 0x2b, 0xc0, 0x86, 0xac, 0x79, 0x0e, 0x37, 0xa4, 0xa3, 0x53,
+	0x2c, 0xce, 0x49, 0x8b, 0xcb, 0x3e, 0x74, 0x82, 0xb1, 0x1c, 0xcc, 0xd3, 0x36, 0x71, 0x05, 0x15,
+	0x7d, 0x7d, 0x5e, 0xe6, 0x76, 0xb0, 0x46, 0xd7, 0x00, 0x78, 0x50, 0x1d, 0x3f, 0xf3, 0x0b, 0x72,
+	0x77, 0x41, 0x49, 0x8c, 0x36, 0xb2, 0x41, 0x8f, 0x54, 0xc6, 0xe2, 0xa4, 0xe7, 0x11, 0xab, 0x2b,
+	0x07, 0x91, 0x0e, 0x79, 0xad, 0x78, 0xa1, 0x72, 0x77, 0x62, 0x0d, 0x8c, 0xb6, 0xe9, 0xab, 0x04,
+	0xa3, 0xcb, 0xbc, 0x74, 0x18, 0x27, 0x46, 0x35, 0xc8, 0xf8, 0x9c, 0xef, 0x87, 0x86, 0xd3, 0xb2,
+	0x30, 0xf9, 0x58, 0xc3, 0x91, 0x49, 0x68, 0xa6, 0xf9, 0xf1, 0x02, 0xdd, 0x80, 0x45, 0x9b, 0xfb,
+	0x35, 0xb0, 0xf7, 0x48, 0xbb, 0xd7, 0x21, 0x7a, 0x46, 0xc6, 0x92, 0xf1, 0x85, 0x4d, 0x25, 0x43,
+	0xf7, 0x20, 0xe9, 0x10, 0x87, 0xe9, 0x8b, 0xf2, 0x84, 0x77, 0xc7, 0x52, 0xda, 0x94, 0x30, 0x64,
+	0xc2, 0x92, 0x27, 0x99, 0x64, 0xe1, 0x01, 0x95, 0xf4, 0x0b, 0x79, 0x6d, 0xec, 0x38, 0x18, 0xe6,
+	0x9d, 0x99, 0xf5, 0x86, 0x24, 0x85, 0x47, 0xb0, 0x3c, 0x8e, 0x24, 0x5e, 0x97, 0xb9, 0x1e, 0x89,
+	0xdc, 0x25, 0x5a, 0xf4, 0x2e, 0xf9, 0x69, 0x06, 0x96, 0x9b, 0x74, 0xd7, 0xc5, 0x9d, 0x33, 0xf3,
+	0x6b, 0x13, 0xd0, 0xa0, 0x8a, 0x83, 0xee, 0x93, 0x34, 0x4b, 0x57, 0x6e, 0x4f, 0xac, 0xdf, 0xf1,
+	0x11, 0x4b, 0x87, 0x71, 0xb7, 0xa2, 0x27, 0x1d, 0xb2, 0xe4, 0xa5, 0x95, 0x08, 0x68, 0x1b, 0x88,
+	0x5e, 0x60, 0x87, 0x1c, 0x53, 0x26, 0x19, 0xa5, 0x4c, 0xb4, 0x1d, 0x67, 0x27, 0xb6, 0x63, 0x6a,
+	0xb8, 0x1d, 0x75, 0x98, 0xb3, 0x99, 0x2b, 0x38, 0xeb, 0xc8, 0xd6, 0xcf, 0x98, 0xe1, 0xb2, 0xb0,
+	0x02, 0xd7, 0xc7, 0x26, 0x27, 0xc8, 0x6b, 0xe1, 0x2f, 0x0d, 0xde, 0x53, 0x18, 0x2a, 0xf6, 0x26,
+	0x4f, 0xaa, 0x2d, 0x58, 0x0c, 0xa8, 0xa7, 0xce, 0x96, 0x09, 0x4d, 0x57, 0x2a, 0xf1, 0x55, 0x9f,
+	0x64, 0xca, 0xcc, 0x48, 0x43, 0xa1, 0xe1, 0xa1, 0x9c, 0xcd, 0x8c, 0xe4, 0x6c, 0x05, 0x32, 0x0a,
+	0x10, 0xa4, 0x2e, 0x21, 0xe3, 0x54, 0x4a, 0x86, 0x4c, 0x60, 0x24, 0x0b, 0xc9, 0x93, 0x59, 0xa8,
+	0x42, 0x71, 0x7a, 0x84, 0x93, 0xdb, 0xec, 0x0f, 0x0d, 0x6e, 0x29, 0x67, 0x6b, 0xd8, 0xb5, 0xc9,
+	0x1b, 0xd3, 0x6d, 0xd1, 0xb6, 0x49, 0x4c, 0x6c, 0x9b, 0xe4, 0x50, 0xdb, 0x14, 0x8a, 0x70, 0x7b,
+	0x5a, 0x48, 0xaa, 0x47, 0xfe, 0xd1, 0x60, 0xa5, 0x45, 0xb8, 0x43, 0x5d, 0x2c, 0xc8, 0x9b, 0x12,
+	0xf9, 0x65, 0x48, 0x05, 0x6f, 0x20, 0x15, 0xb7, 0x5a, 0xf9, 0x7d, 0xd0, 0x26, 0x02, 0xd3, 0x8e,
+	0x17, 0xf6, 0x81, 0x5a, 0xfe, 0x07, 0x8a, 0x15, 0x6e, 0x42, 0x61, 0x52, 0x02, 0x54, 0x9e, 0x7e,
+	0xd7, 0xa0, 0xf8, 0x39, 0x19, 0xed, 0xae, 0x2f, 0xa8, 0x27, 0x18, 0xef, 0x37, 0x05, 0x27, 0xd8,
+	0xf9, 0x9f, 0xd2, 0x75, 0x17, 0x96, 0xc2, 0x17, 0x67, 0x17, 0xef, 0x12, 0xcb, 0xa3, 0xaf, 0x88,
+	0x9e, 0x38, 0xf1, 0xe4, 0xdc, 0xc0, 0xbb, 0xa4, 0x49, 0x5f, 0x91, 0x42, 0x1d, 0xee, 0x9c, 0x22,
+	0x0c, 0xc5, 0x18, 0x1d, 0xe6, 0xf6, 0x82, 0x0d, 0x19, 0x48, 0xc6, 0x0c, 0x97, 0x77, 0xff, 0xd4,
+	0xe0, 0x52, 0xec, 0x95, 0x87, 0x6e, 0x42, 0x7e, 0x6b, 0xdd, 0x7c, 0xbe, 0xd6, 0x58, 0xdf, 0xb2,
+	0x8c, 0x55, 0xcb, 0xac, 0x6f, 0x36, 0xeb, 0xd6, 0xc6, 0x7a, 0xc3, 0xa8, 0xbd, 0xb4, 0x8c, 0x17,
+	0x5f, 0x57, 0x1b, 0xc6, 0x6a, 0xf6, 0x2d, 0xf4, 0x18, 0x1e, 0x8e, 0x45, 0x55, 0x1b, 0xbe, 0x74,
+	0x75, 0x73, 0xa3, 0x61, 0xd4, 0xaa, 0xad, 0xba, 0xb5, 0x56, 0x35, 0x1a, 0xf5, 0x55, 0x6b, 0xfd,
+	0x45, 0xe3, 0x65, 0x56, 0x43, 0xef, 0x43, 0xf1, 0xb4, 0x9a, 0xd9, 0x19, 0x74, 0x0f, 0xee, 0x8c,
+	0x45, 0x9b, 0xf5, 0x2f, 0xeb, 0xb5, 0x56, 0x04, 0x9e, 0xa8, 0xbc, 0x9e, 0x83, 0x74, 0x18, 0x56,
+	0x75, 0xc3, 0x40, 0xdf, 0x6b, 0x70, 0x39, 0x7e, 0xaa, 0xa0, 0x73, 0x4c, 0xc6, 0xdc, 0x83, 0x33,
+	0xe9, 0xa8, 0x22, 0xfc, 0xa0, 0xc1, 0x95, 0x31, 0x93, 0x1e, 0x8d, 0x31, 0x38, 0xf1, 0xd2, 0xcc,
+	0x3d, 0x3c, 0x9b, 0x92, 0x72, 0xe3, 0x67, 0x0d, 0xf2, 0xd3, 0x46, 0x2d, 0xfa, 0x78, 0x92, 0xe9,
+	0x69, 0x77, 0x50, 0xee, 0xd9, 0x39, 0xb5, 0x95, 0x87, 0x3f, 0x6a, 0xb0, 0x3c, 0x79, 0xea, 0xa1,
+	0x27, 0x63, 0x9e, 0x58, 0xa7, 0x98, 0xfe, 0xb9, 0xa7, 0xe7, 0xd2, 0x55, 0xbe, 0xbd, 0xd6, 0x20,
+	0x37, 0x7e, 0xca, 0xa0, 0x0f, 0xe3, 0xdf, 0xe4, 0xd3, 0xe6, 0x72, 0xee, 0xd1, 0x99, 0xf5, 0x94,
+	0x3f, 0xbf, 0x6a, 0xb0, 0x32, 0x75, 0x0e, 0xa0, 0xf8, 0x82, 0x9c, 0x76, 0x0c, 0xe6, 0x3e, 0x39,
+	0xaf, 0x7a, 0xe0, 0xe4, 0x07, 0xda, 0x67, 0x0d, 0xb8, 0x62, 0x33, 0x27, 0xce, 0xcc, 0x86, 0xf6,
+	0x4d, 0x79, 0x97, 0x8a, 0xbd, 0xde, 0x76, 0xc9, 0x66, 0x4e, 0xf9, 0xc4, 0x0f, 0x90, 0xd2, 0x2e,
+	0x71, 0xcb, 0xf2, 0x8f, 0x87, 0xfa, 0x17, 0xf2, 0x14, 0x77, 0xe9, 0xc1, 0xfd, 0xed, 0x94, 0x94,
+	0x3d, 0xf8, 0x77, 0x00, 0x6a, 0x03, 0x91, 0x3d, 0x2f, 0x11, 0x00, 0x00,
+}
@@ -55,6 +55,22 @@ func NewClient(
 	}
 }
 
+func (c *clientImpl) AddSearchAttribute(
+	ctx context.Context,
+	request *admin.AddSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.AddSearchAttribute(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -71,6 +87,22 @@ func (c *clientImpl) DescribeHistoryHost(
 	return client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeShardDistributionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeShardDistribution(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -103,6 +135,198 @@ func (c *clientImpl) GetWorkflowExecutionRawHistory(
 	return client.GetWorkflowExecutionRawHistory(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReadTransferDLQ(
+	ctx context.Context,
+	request *shared.ReadTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadTransferDLQResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReadTransferDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResetWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) MergeTransferDLQ(
+	ctx context.Context,
+	request *shared.MergeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.MergeTransferDLQResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MergeTransferDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) PurgeTransferDLQ(
+	ctx context.Context,
+	request *shared.PurgeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PurgeTransferDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReadReplicationDLQ(
+	ctx context.Context,
+	request *shared.ReadReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadReplicationDLQResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReadReplicationDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) MergeReplicationDLQ(
+	ctx context.Context,
+	request *shared.MergeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.MergeReplicationDLQResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MergeReplicationDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) PurgeReplicationDLQ(
+	ctx context.Context,
+	request *shared.PurgeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PurgeReplicationDLQ(ctx, request, opts...)
+}
+
+func (c *clientImpl) RemoveTask(
+	ctx context.Context,
+	request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RemoveTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CloseShard(ctx, request, opts...)
+}
+
+func (c *clientImpl) AddWorkflowExecutionAnnotation(
+	ctx context.Context,
+	request *admin.AddWorkflowExecutionAnnotationRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.AddWorkflowExecutionAnnotation(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateDynamicConfig(
+	ctx context.Context,
+	request *admin.UpdateDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.UpdateDynamicConfigResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) RollbackDynamicConfig(
+	ctx context.Context,
+	request *admin.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.RollbackDynamicConfigResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RollbackDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
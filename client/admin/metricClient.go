@@ -44,6 +44,24 @@ func NewMetricClient(client Client, metricsClient metrics.Client) Client {
 	}
 }
 
+func (c *metricClient) AddSearchAttribute(
+	ctx context.Context,
+	request *admin.AddSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientAddSearchAttributeScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientAddSearchAttributeScope, metrics.CadenceClientLatency)
+	err := c.client.AddSearchAttribute(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientAddSearchAttributeScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -62,6 +80,24 @@ func (c *metricClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *metricClient) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeShardDistributionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeShardDistributionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeShardDistributionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeShardDistribution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeShardDistributionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -97,3 +133,219 @@ func (c *metricClient) GetWorkflowExecutionRawHistory(
 	}
 	return resp, err
 }
+
+func (c *metricClient) ReadTransferDLQ(
+	ctx context.Context,
+	request *shared.ReadTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadTransferDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReadTransferDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReadTransferDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadTransferDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReadTransferDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResetWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResetWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ResetWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResetWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) MergeTransferDLQ(
+	ctx context.Context,
+	request *shared.MergeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.MergeTransferDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientMergeTransferDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMergeTransferDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MergeTransferDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMergeTransferDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PurgeTransferDLQ(
+	ctx context.Context,
+	request *shared.PurgeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPurgeTransferDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPurgeTransferDLQScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeTransferDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPurgeTransferDLQScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ReadReplicationDLQ(
+	ctx context.Context,
+	request *shared.ReadReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadReplicationDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReadReplicationDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReadReplicationDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadReplicationDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReadReplicationDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) MergeReplicationDLQ(
+	ctx context.Context,
+	request *shared.MergeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.MergeReplicationDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientMergeReplicationDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMergeReplicationDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MergeReplicationDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMergeReplicationDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PurgeReplicationDLQ(
+	ctx context.Context,
+	request *shared.PurgeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPurgeReplicationDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPurgeReplicationDLQScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeReplicationDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPurgeReplicationDLQScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) RemoveTask(
+	ctx context.Context,
+	request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRemoveTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRemoveTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RemoveTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRemoveTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCloseShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCloseShardScope, metrics.CadenceClientLatency)
+	err := c.client.CloseShard(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCloseShardScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) AddWorkflowExecutionAnnotation(
+	ctx context.Context,
+	request *admin.AddWorkflowExecutionAnnotationRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientAddWorkflowExecutionAnnotationScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientAddWorkflowExecutionAnnotationScope, metrics.CadenceClientLatency)
+	err := c.client.AddWorkflowExecutionAnnotation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientAddWorkflowExecutionAnnotationScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *admin.UpdateDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.UpdateDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateDynamicConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateDynamicConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateDynamicConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RollbackDynamicConfig(
+	ctx context.Context,
+	request *admin.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.RollbackDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRollbackDynamicConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRollbackDynamicConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RollbackDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRollbackDynamicConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
@@ -46,6 +46,18 @@ func NewRetryableClient(client Client, policy backoff.RetryPolicy, isRetryable b
 	}
 }
 
+func (c *retryableClient) AddSearchAttribute(
+	ctx context.Context,
+	request *admin.AddSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.AddSearchAttribute(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -62,6 +74,22 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeShardDistributionResponse, error) {
+
+	var resp *admin.DescribeShardDistributionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeShardDistribution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -93,3 +121,175 @@ func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ReadTransferDLQ(
+	ctx context.Context,
+	request *shared.ReadTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadTransferDLQResponse, error) {
+
+	var resp *shared.ReadTransferDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadTransferDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	var resp *shared.ResetWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ResetWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MergeTransferDLQ(
+	ctx context.Context,
+	request *shared.MergeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.MergeTransferDLQResponse, error) {
+
+	var resp *shared.MergeTransferDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MergeTransferDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PurgeTransferDLQ(
+	ctx context.Context,
+	request *shared.PurgeTransferDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PurgeTransferDLQ(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReadReplicationDLQ(
+	ctx context.Context,
+	request *shared.ReadReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ReadReplicationDLQResponse, error) {
+
+	var resp *shared.ReadReplicationDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadReplicationDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MergeReplicationDLQ(
+	ctx context.Context,
+	request *shared.MergeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) (*shared.MergeReplicationDLQResponse, error) {
+
+	var resp *shared.MergeReplicationDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MergeReplicationDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PurgeReplicationDLQ(
+	ctx context.Context,
+	request *shared.PurgeReplicationDLQRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PurgeReplicationDLQ(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RemoveTask(
+	ctx context.Context,
+	request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RemoveTask(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.CloseShard(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) AddWorkflowExecutionAnnotation(
+	ctx context.Context,
+	request *admin.AddWorkflowExecutionAnnotationRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.AddWorkflowExecutionAnnotation(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *admin.UpdateDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.UpdateDynamicConfigResponse, error) {
+
+	var resp *admin.UpdateDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RollbackDynamicConfig(
+	ctx context.Context,
+	request *admin.RollbackDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.RollbackDynamicConfigResponse, error) {
+
+	var resp *admin.RollbackDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RollbackDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+var _ Client = (*hedgedClient)(nil)
+
+type (
+	hedgedClient struct {
+		// calls which are not safe to hedge are passed through to the underlying client
+		Client
+		delay func() time.Duration
+	}
+
+	hedgedResult struct {
+		resp interface{}
+		err  error
+	}
+)
+
+// NewHedgedClient creates a new instance of Client which hedges idempotent read calls: if the first attempt has not
+// returned after the given delay, a second attempt is sent, which will be load balanced to a (likely) different
+// frontend host, and whichever attempt succeeds first wins. The delay is read on every call, zero disables hedging.
+func NewHedgedClient(client Client, delay func() time.Duration) Client {
+	return &hedgedClient{
+		Client: client,
+		delay:  delay,
+	}
+}
+
+func (c *hedgedClient) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeDomainResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.DescribeDomain(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.DescribeDomainResponse), nil
+}
+
+func (c *hedgedClient) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeTaskListResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.DescribeTaskList(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.DescribeTaskListResponse), nil
+}
+
+func (c *hedgedClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.DescribeWorkflowExecution(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.DescribeWorkflowExecutionResponse), nil
+}
+
+func (c *hedgedClient) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	// long poll requests are expected to block, hedging them would only double the number of pending polls
+	if request.GetWaitForNewEvent() {
+		return c.Client.GetWorkflowExecutionHistory(ctx, request, opts...)
+	}
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.GetWorkflowExecutionHistory(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.GetWorkflowExecutionHistoryResponse), nil
+}
+
+func (c *hedgedClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListClosedWorkflowExecutionsResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.ListClosedWorkflowExecutions(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.ListClosedWorkflowExecutionsResponse), nil
+}
+
+func (c *hedgedClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.CountWorkflowExecutions(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.CountWorkflowExecutionsResponse), nil
+}
+
+func (c *hedgedClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ScanWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ScanWorkflowExecutionsResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.ScanWorkflowExecutions(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.ScanWorkflowExecutionsResponse), nil
+}
+
+func (c *hedgedClient) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDomainsResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.ListDomains(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.ListDomainsResponse), nil
+}
+
+func (c *hedgedClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.ListOpenWorkflowExecutions(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.ListOpenWorkflowExecutionsResponse), nil
+}
+
+// hedge runs op and, if it has not completed within the hedging delay, runs it a second time concurrently.
+// The first successful response is returned and the other attempt is cancelled. An attempt failing before the
+// hedging delay is returned as is, retries are left to the retryable client.
+func (c *hedgedClient) hedge(
+	ctx context.Context,
+	op func(ctx context.Context) (interface{}, error),
+) (interface{}, error) {
+
+	delay := c.delay()
+	if delay <= 0 {
+		return op(ctx)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so that the attempt which loses the race never blocks
+	results := make(chan hedgedResult, 2)
+	attempt := func() {
+		resp, err := op(ctx)
+		results <- hedgedResult{resp: resp, err: err}
+	}
+
+	go attempt()
+	pending := 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	hedgeC := timer.C
+
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			pending++
+			go attempt()
+		case result := <-results:
+			pending--
+			if result.err == nil || pending == 0 {
+				return result.resp, result.err
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+)
+
+type (
+	hedgedClientSuite struct {
+		suite.Suite
+		mockClient *mocks.FrontendClient
+		delay      time.Duration
+		client     Client
+	}
+)
+
+func TestHedgedClientSuite(t *testing.T) {
+	s := new(hedgedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *hedgedClientSuite) SetupTest() {
+	s.mockClient = &mocks.FrontendClient{}
+	s.delay = 10 * time.Millisecond
+	s.client = NewHedgedClient(s.mockClient, func() time.Duration { return s.delay })
+}
+
+func (s *hedgedClientSuite) TearDownTest() {
+	s.mockClient.AssertExpectations(s.T())
+}
+
+// blockUntilCancelled makes a mocked call hang until the hedged client cancels it
+func blockUntilCancelled(args mock.Arguments) {
+	<-args.Get(0).(context.Context).Done()
+}
+
+func (s *hedgedClientSuite) TestSecondAttemptWins() {
+	request := &shared.DescribeWorkflowExecutionRequest{Domain: common.StringPtr("domain")}
+	response := &shared.DescribeWorkflowExecutionResponse{}
+	s.mockClient.On("DescribeWorkflowExecution", mock.Anything, request).
+		Run(blockUntilCancelled).Return(nil, context.Canceled).Once()
+	s.mockClient.On("DescribeWorkflowExecution", mock.Anything, request).Return(response, nil).Once()
+
+	resp, err := s.client.DescribeWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal(response, resp)
+}
+
+func (s *hedgedClientSuite) TestFastAttemptNotHedged() {
+	request := &shared.CountWorkflowExecutionsRequest{Domain: common.StringPtr("domain")}
+	response := &shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(3)}
+	s.mockClient.On("CountWorkflowExecutions", mock.Anything, request).Return(response, nil).Once()
+
+	resp, err := s.client.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(response, resp)
+	time.Sleep(2 * s.delay)
+	s.mockClient.AssertNumberOfCalls(s.T(), "CountWorkflowExecutions", 1)
+}
+
+func (s *hedgedClientSuite) TestErrorBeforeDelayReturned() {
+	request := &shared.ListOpenWorkflowExecutionsRequest{Domain: common.StringPtr("domain")}
+	callErr := errors.New("some random error")
+	s.mockClient.On("ListOpenWorkflowExecutions", mock.Anything, request).Return(nil, callErr).Once()
+
+	resp, err := s.client.ListOpenWorkflowExecutions(context.Background(), request)
+	s.Equal(callErr, err)
+	s.Nil(resp)
+}
+
+func (s *hedgedClientSuite) TestBothAttemptsFail() {
+	request := &shared.DescribeDomainRequest{Name: common.StringPtr("domain")}
+	callErr := errors.New("some random error")
+	s.mockClient.On("DescribeDomain", mock.Anything, request).
+		Run(func(args mock.Arguments) { time.Sleep(2 * s.delay) }).Return(nil, callErr).Twice()
+
+	resp, err := s.client.DescribeDomain(context.Background(), request)
+	s.Equal(callErr, err)
+	s.Nil(resp)
+}
+
+func (s *hedgedClientSuite) TestLongPollNotHedged() {
+	request := &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:          common.StringPtr("domain"),
+		WaitForNewEvent: common.BoolPtr(true),
+	}
+	response := &shared.GetWorkflowExecutionHistoryResponse{}
+	s.mockClient.On("GetWorkflowExecutionHistory", mock.Anything, request).
+		Run(func(args mock.Arguments) { time.Sleep(2 * s.delay) }).Return(response, nil).Once()
+
+	resp, err := s.client.GetWorkflowExecutionHistory(context.Background(), request)
+	s.NoError(err)
+	s.Equal(response, resp)
+}
+
+func (s *hedgedClientSuite) TestHedgingDisabled() {
+	s.delay = 0
+	request := &shared.DescribeTaskListRequest{Domain: common.StringPtr("domain")}
+	response := &shared.DescribeTaskListResponse{}
+	s.mockClient.On("DescribeTaskList", mock.Anything, request).
+		Run(func(args mock.Arguments) { time.Sleep(10 * time.Millisecond) }).Return(response, nil).Once()
+
+	resp, err := s.client.DescribeTaskList(context.Background(), request)
+	s.NoError(err)
+	s.Equal(response, resp)
+}
+
+func (s *hedgedClientSuite) TestWritesPassedThrough() {
+	request := &shared.SignalWorkflowExecutionRequest{Domain: common.StringPtr("domain")}
+	s.mockClient.On("SignalWorkflowExecution", mock.Anything, request).
+		Run(func(args mock.Arguments) { time.Sleep(2 * s.delay) }).Return(nil).Once()
+
+	s.NoError(s.client.SignalWorkflowExecution(context.Background(), request))
+}
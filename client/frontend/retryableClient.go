@@ -138,6 +138,38 @@ func (c *retryableClient) ListClosedWorkflowExecutions(
 	return resp, err
 }
 
+func (c *retryableClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	var resp *shared.CountWorkflowExecutionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CountWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ScanWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ScanWorkflowExecutionsResponse, error) {
+
+	var resp *shared.ScanWorkflowExecutionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ScanWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListDomains(
 	ctx context.Context,
 	request *shared.ListDomainsRequest,
@@ -170,6 +202,18 @@ func (c *retryableClient) ListOpenWorkflowExecutions(
 	return resp, err
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) PollForActivityTask(
 	ctx context.Context,
 	request *shared.PollForActivityTaskRequest,
@@ -418,6 +462,18 @@ func (c *retryableClient) RespondQueryTaskCompleted(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *shared.SignalWithStartWorkflowExecutionRequest,
@@ -0,0 +1,156 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+var _ Client = (*hedgedClient)(nil)
+
+type (
+	hedgedClient struct {
+		// calls which are not safe to hedge are passed through to the underlying client
+		Client
+		delay func() time.Duration
+	}
+
+	hedgedResult struct {
+		resp interface{}
+		err  error
+	}
+)
+
+// NewHedgedClient creates a new instance of Client which hedges idempotent read calls: if the first attempt has not
+// returned after the given delay, a second attempt is sent, and whichever attempt succeeds first wins. The second
+// attempt resolves the owner of the shard again, so it reaches the new owner if the shard moved in the meantime.
+// The delay is read on every call, zero disables hedging.
+func NewHedgedClient(client Client, delay func() time.Duration) Client {
+	return &hedgedClient{
+		Client: client,
+		delay:  delay,
+	}
+}
+
+func (c *hedgedClient) DescribeMutableState(
+	ctx context.Context,
+	request *h.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*h.DescribeMutableStateResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.DescribeMutableState(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*h.DescribeMutableStateResponse), nil
+}
+
+func (c *hedgedClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *h.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.DescribeWorkflowExecution(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*shared.DescribeWorkflowExecutionResponse), nil
+}
+
+func (c *hedgedClient) GetMutableState(
+	ctx context.Context,
+	request *h.GetMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*h.GetMutableStateResponse, error) {
+
+	// callers expecting a next event ID past the first event may long poll for it,
+	// hedging them would only double the number of pending polls
+	if request.GetExpectedNextEventId() > common.FirstEventID {
+		return c.Client.GetMutableState(ctx, request, opts...)
+	}
+
+	resp, err := c.hedge(ctx, func(ctx context.Context) (interface{}, error) {
+		return c.Client.GetMutableState(ctx, request, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*h.GetMutableStateResponse), nil
+}
+
+// hedge runs op and, if it has not completed within the hedging delay, runs it a second time concurrently.
+// The first successful response is returned and the other attempt is cancelled. An attempt failing before the
+// hedging delay is returned as is, retries are left to the retryable client.
+func (c *hedgedClient) hedge(
+	ctx context.Context,
+	op func(ctx context.Context) (interface{}, error),
+) (interface{}, error) {
+
+	delay := c.delay()
+	if delay <= 0 {
+		return op(ctx)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so that the attempt which loses the race never blocks
+	results := make(chan hedgedResult, 2)
+	attempt := func() {
+		resp, err := op(ctx)
+		results <- hedgedResult{resp: resp, err: err}
+	}
+
+	go attempt()
+	pending := 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	hedgeC := timer.C
+
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			pending++
+			go attempt()
+		case result := <-results:
+			pending--
+			if result.err == nil || pending == 0 {
+				return result.resp, result.err
+			}
+		}
+	}
+}
@@ -351,6 +351,193 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	context context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResumeWorkflowExecution(
+	context context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ReadTransferDLQ(
+	context context.Context,
+	request *shared.ReadTransferDLQRequest,
+	opts ...yarpc.CallOption) (*shared.ReadTransferDLQResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientReadTransferDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReadTransferDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadTransferDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReadTransferDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) MergeTransferDLQ(
+	context context.Context,
+	request *shared.MergeTransferDLQRequest,
+	opts ...yarpc.CallOption) (*shared.MergeTransferDLQResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientMergeTransferDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientMergeTransferDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MergeTransferDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientMergeTransferDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) PurgeTransferDLQ(
+	context context.Context,
+	request *shared.PurgeTransferDLQRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPurgeTransferDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPurgeTransferDLQScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeTransferDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPurgeTransferDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ReadReplicationDLQ(
+	context context.Context,
+	request *shared.ReadReplicationDLQRequest,
+	opts ...yarpc.CallOption) (*shared.ReadReplicationDLQResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientReadReplicationDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReadReplicationDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadReplicationDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReadReplicationDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) MergeReplicationDLQ(
+	context context.Context,
+	request *shared.MergeReplicationDLQRequest,
+	opts ...yarpc.CallOption) (*shared.MergeReplicationDLQResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientMergeReplicationDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientMergeReplicationDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MergeReplicationDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientMergeReplicationDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) PurgeReplicationDLQ(
+	context context.Context,
+	request *shared.PurgeReplicationDLQRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPurgeReplicationDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPurgeReplicationDLQScope, metrics.CadenceClientLatency)
+	err := c.client.PurgeReplicationDLQ(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPurgeReplicationDLQScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) RemoveTask(
+	context context.Context,
+	request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRemoveTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRemoveTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RemoveTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRemoveTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) CloseShard(
+	context context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientCloseShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientCloseShardScope, metrics.CadenceClientLatency)
+	err := c.client.CloseShard(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientCloseShardScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) AddWorkflowExecutionAnnotation(
+	context context.Context,
+	request *h.AddWorkflowExecutionAnnotationRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientAddWorkflowExecutionAnnotationScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientAddWorkflowExecutionAnnotationScope, metrics.CadenceClientLatency)
+	err := c.client.AddWorkflowExecutionAnnotation(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientAddWorkflowExecutionAnnotationScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) ResetWorkflowExecution(
 	context context.Context,
 	request *h.ResetWorkflowExecutionRequest,
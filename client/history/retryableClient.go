@@ -318,6 +318,154 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReadTransferDLQ(
+	ctx context.Context,
+	request *shared.ReadTransferDLQRequest,
+	opts ...yarpc.CallOption) (*shared.ReadTransferDLQResponse, error) {
+
+	var resp *shared.ReadTransferDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadTransferDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MergeTransferDLQ(
+	ctx context.Context,
+	request *shared.MergeTransferDLQRequest,
+	opts ...yarpc.CallOption) (*shared.MergeTransferDLQResponse, error) {
+
+	var resp *shared.MergeTransferDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MergeTransferDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PurgeTransferDLQ(
+	ctx context.Context,
+	request *shared.PurgeTransferDLQRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PurgeTransferDLQ(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReadReplicationDLQ(
+	ctx context.Context,
+	request *shared.ReadReplicationDLQRequest,
+	opts ...yarpc.CallOption) (*shared.ReadReplicationDLQResponse, error) {
+
+	var resp *shared.ReadReplicationDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadReplicationDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MergeReplicationDLQ(
+	ctx context.Context,
+	request *shared.MergeReplicationDLQRequest,
+	opts ...yarpc.CallOption) (*shared.MergeReplicationDLQResponse, error) {
+
+	var resp *shared.MergeReplicationDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MergeReplicationDLQ(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PurgeReplicationDLQ(
+	ctx context.Context,
+	request *shared.PurgeReplicationDLQRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PurgeReplicationDLQ(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RemoveTask(
+	ctx context.Context,
+	request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RemoveTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.CloseShard(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) AddWorkflowExecutionAnnotation(
+	ctx context.Context,
+	request *h.AddWorkflowExecutionAnnotationRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.AddWorkflowExecutionAnnotation(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) ResetWorkflowExecution(
 	ctx context.Context,
 	request *h.ResetWorkflowExecutionRequest,
@@ -23,9 +23,19 @@ package main
 import (
 	"log"
 	"os"
+	"os/signal"
 	"strings"
-
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/tools/cassandra"
 
 	"github.com/urfave/cli"
@@ -34,6 +44,9 @@ import (
 // validServices is the list of all valid cadence services
 var validServices = []string{historyService, matchingService, frontendService, workerService}
 
+// dynamicConfigRefreshInterval is how often the hosts reload the dynamic config changed through the admin API
+const dynamicConfigRefreshInterval = 10 * time.Second
+
 // main entry point for the cadence server
 func main() {
 	app := buildCLI()
@@ -67,16 +80,62 @@ func startHandler(c *cli.Context) {
 		log.Fatal("Incompatible versions", err)
 	}
 
+	tracer, tracerCloser, err := cfg.Tracing.NewTracer()
+	if err != nil {
+		log.Fatalf("unable to create tracer: %v", err)
+	}
+	// the rpc dispatchers pick up the global tracer when they are created
+	opentracing.SetGlobalTracer(tracer)
+	defer tracerCloser.Close()
+
+	// the services of the process share the dynamic config, which is persisted in the cluster
+	// metadata, so that an update through the admin API of any frontend applies to all the hosts
+	logger := loggerimpl.NewLogger(cfg.Log.NewZapLogger())
+	pFactory := persistencefactory.New(&cfg.Persistence, cfg.ClustersInfo.CurrentClusterName, nil, nil, logger)
+	defer pFactory.Close()
+	clusterMetadataManager, err := pFactory.NewClusterMetadataManager()
+	if err != nil {
+		log.Fatalf("error creating cluster metadata manager: %v", err)
+	}
+	defer clusterMetadataManager.Close()
+	dynamicConfig := dynamicconfig.NewVersionedClient(
+		persistence.NewDynamicConfigStore(clusterMetadataManager),
+		dynamicConfigRefreshInterval,
+		logger,
+	)
+	dynamicConfig.Start()
+	defer dynamicConfig.Stop()
+
 	services := getServices(c)
+	servers := make([]common.Daemon, 0, len(services))
 	for _, svc := range services {
 		if _, ok := cfg.Services[svc]; !ok {
 			log.Fatalf("`%v` service missing config", svc)
 		}
-		server := newServer(svc, &cfg)
+		server := newServer(svc, &cfg, dynamicConfig)
 		server.Start()
+		servers = append(servers, server)
 	}
 
-	select {}
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, syscall.SIGTERM, syscall.SIGINT)
+	sig := <-sigC
+	log.Printf("Received signal %v, stopping services\n", sig)
+	stopServers(servers)
+}
+
+// stopServers stops the servers in parallel, so each of them
+// gets the full shutdown deadline to drain its requests
+func stopServers(servers []common.Daemon) {
+	var stopWG sync.WaitGroup
+	for _, server := range servers {
+		stopWG.Add(1)
+		go func(server common.Daemon) {
+			defer stopWG.Done()
+			server.Stop()
+		}(server)
+	}
+	stopWG.Wait()
 }
 
 func getEnvironment(c *cli.Context) string {
@@ -32,6 +32,8 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -45,10 +47,11 @@ import (
 
 type (
 	server struct {
-		name   string
-		cfg    *config.Config
-		doneC  chan struct{}
-		daemon common.Daemon
+		name          string
+		cfg           *config.Config
+		dynamicConfig dynamicconfig.Client
+		doneC         chan struct{}
+		daemon        common.Daemon
 	}
 )
 
@@ -61,11 +64,12 @@ const (
 
 // newServer returns a new instance of a daemon
 // that represents a cadence service
-func newServer(service string, cfg *config.Config) common.Daemon {
+func newServer(service string, cfg *config.Config, dynamicConfig dynamicconfig.Client) common.Daemon {
 	return &server{
-		cfg:   cfg,
-		name:  service,
-		doneC: make(chan struct{}),
+		cfg:           cfg,
+		name:          service,
+		dynamicConfig: dynamicConfig,
+		doneC:         make(chan struct{}),
 	}
 }
 
@@ -111,20 +115,38 @@ func (s *server) startService() common.Daemon {
 		log.Fatalf("error creating ringpop factory: %v", err)
 	}
 
-	params.DynamicConfig = dynamicconfig.NewNopClient()
+	params.DynamicConfig = s.dynamicConfig
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, params.Logger)
 
 	svcCfg := s.cfg.Services[s.name]
 	params.MetricScope = svcCfg.Metrics.NewScope()
-	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
+	rpcFactory := svcCfg.RPC.NewFactory(params.Name, params.Logger)
+	params.RPCFactory = rpcFactory
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
+	if svcCfg.HTTPGateway.Port > 0 {
+		params.HTTPGatewayAddress = rpcFactory.GetListenAddress(svcCfg.HTTPGateway.Port)
+	}
 	enableGlobalDomain := dc.GetBoolProperty(dynamicconfig.EnableGlobalDomain, s.cfg.ClustersInfo.EnableGlobalDomain)
 	archivalStatus := dc.GetStringProperty(dynamicconfig.ArchivalStatus, s.cfg.Archival.Status)
 	enableReadFromArchival := dc.GetBoolProperty(dynamicconfig.EnableReadFromArchival, s.cfg.Archival.EnableReadFromArchival)
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
+	params.Authorizer, err = s.cfg.Authorization.NewAuthorizer()
+	if err != nil {
+		log.Fatalf("error creating authorizer: %v", err)
+	}
 
-	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
+	metricsTagOverflowBuckets := dc.GetIntProperty(dynamicconfig.MetricsTagOverflowBuckets, 10)
+	params.MetricsClient = metrics.NewClientWithTagFilter(
+		params.MetricScope,
+		service.GetMetricsServiceIdx(params.Name, params.Logger),
+		metrics.NewTagCardinalityFilter(
+			map[string]func(domain string) int{
+				metrics.TaskListTagName: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MetricsTaskListTagCardinalityLimit, 100),
+			},
+			func() int { return metricsTagOverflowBuckets() },
+		),
+	)
 	params.ClusterMetadata = cluster.NewMetadata(
 		params.Logger,
 		params.MetricsClient,
@@ -138,6 +160,8 @@ func (s *server) startService() common.Daemon {
 		s.cfg.Archival.DefaultBucket,
 		enableReadFromArchival,
 	)
+	s.verifyImmutableClusterMetadata(&params)
+
 	params.DispatcherProvider = client.NewIPYarpcDispatcherProvider()
 	params.ESConfig = &s.cfg.ElasticSearch
 	params.ESConfig.Enable = dc.GetBoolProperty(dynamicconfig.EnableVisibilityToKafka, params.ESConfig.Enable)() // force override with dynamic config
@@ -169,7 +193,8 @@ func (s *server) startService() common.Daemon {
 	}
 	params.PublicClient = workflowserviceclient.New(dispatcher.ClientConfig(common.FrontendServiceName))
 
-	if params.ClusterMetadata.ArchivalConfig().ConfiguredForArchival() {
+	// the blobstore is shared by archival and the persistence claim check
+	if params.ClusterMetadata.ArchivalConfig().ConfiguredForArchival() || s.cfg.Persistence.ClaimCheck != nil {
 		params.BlobstoreClient, err = filestore.NewClient(&s.cfg.Archival.Filestore)
 		if err != nil {
 			log.Fatalf("error creating blobstore: %v", err)
@@ -196,6 +221,36 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// verifyImmutableClusterMetadata persists the immutable cluster settings on first start and fails fast
+// if the datastore was initialized with different settings than the ones in the config
+func (s *server) verifyImmutableClusterMetadata(params *service.BootstrapParams) {
+	clusterName := params.ClusterMetadata.GetCurrentClusterName()
+	pFactory := persistencefactory.New(&params.PersistenceConfig, clusterName, params.MetricsClient, nil, params.Logger)
+	defer pFactory.Close()
+
+	clusterMetadataManager, err := pFactory.NewClusterMetadataManager()
+	if err != nil {
+		log.Fatalf("error creating cluster metadata manager: %v", err)
+	}
+	defer clusterMetadataManager.Close()
+
+	expected := persistence.ImmutableClusterMetadata{
+		ClusterName:            clusterName,
+		HistoryShardCount:      s.cfg.Persistence.NumHistoryShards,
+		InitialFailoverVersion: s.cfg.ClustersInfo.ClusterInitialFailoverVersions[clusterName],
+	}
+	resp, err := clusterMetadataManager.InitializeImmutableClusterMetadata(
+		&persistence.InitializeImmutableClusterMetadataRequest{ImmutableClusterMetadata: expected},
+	)
+	if err != nil {
+		log.Fatalf("error initializing immutable cluster metadata: %v", err)
+	}
+	if resp.PersistedImmutableData != expected {
+		log.Fatalf("immutable cluster metadata mismatch, persisted: %+v, configured: %+v",
+			resp.PersistedImmutableData, expected)
+	}
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+)
+
+const (
+	// DecisionDeny means the caller is not allowed to perform the operation
+	DecisionDeny Decision = iota + 1
+	// DecisionAllow means the caller is allowed to perform the operation
+	DecisionAllow
+)
+
+type (
+	// Decision is the result of an authorization check
+	Decision int
+
+	// Attributes describes the operation to be authorized
+	Attributes struct {
+		// Token identifies the caller, it is passed in the authorization token header
+		Token string
+		// APIName is the name of the frontend API being called
+		APIName string
+		// DomainName is the domain the operation is performed in, empty for operations without domain
+		DomainName string
+		// TaskList is the task list the operation is performed on, empty for operations without task list
+		TaskList string
+		// StickyTaskList is true if TaskList is the sticky task list of a worker
+		StickyTaskList bool
+	}
+
+	// Result is the result of an authorization check
+	Result struct {
+		Decision Decision
+	}
+
+	// Authorizer decides whether a caller is allowed to perform an operation
+	Authorizer interface {
+		Authorize(ctx context.Context, attributes *Attributes) (Result, error)
+	}
+
+	nopAuthorizer struct{}
+)
+
+// NewNopAuthorizer returns an Authorizer which allows every operation
+func NewNopAuthorizer() Authorizer {
+	return &nopAuthorizer{}
+}
+
+func (a *nopAuthorizer) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	return Result{Decision: DecisionAllow}, nil
+}
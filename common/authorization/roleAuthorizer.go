@@ -0,0 +1,166 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	// RoleRead allows the read only operations of a domain
+	RoleRead Role = iota + 1
+	// RoleWrite allows to start and interact with the workflows of a domain, it includes RoleRead
+	RoleWrite
+	// RoleAdmin allows to terminate and reset the workflows and to manage a domain, it includes RoleWrite
+	RoleAdmin
+)
+
+const (
+	// AllDomains is the domain name of a grant which applies to every domain
+	AllDomains = "*"
+	// AdminAPIPrefix is the prefix of the names of the admin service APIs, they all require RoleAdmin
+	AdminAPIPrefix = "AdminService::"
+)
+
+type (
+	// Role is the access level granted to a token
+	Role int
+
+	// RoleGrant grants the role to the holder of a token in a domain
+	RoleGrant struct {
+		Token string
+		// DomainName is the name of the domain, AllDomains grants the role in every domain
+		DomainName string
+		Role       Role
+	}
+
+	roleAuthorizer struct {
+		// token -> domain name -> role
+		grants map[string]map[string]Role
+	}
+
+	multiAuthorizer struct {
+		authorizers []Authorizer
+	}
+)
+
+// the roles required by the frontend APIs, APIs not listed require RoleWrite
+var apiRoles = map[string]Role{
+	"DescribeDomain":               RoleRead,
+	"DescribeTaskList":             RoleRead,
+	"DescribeWorkflowExecution":    RoleRead,
+	"GetWorkflowExecutionHistory":  RoleRead,
+	"ListOpenWorkflowExecutions":   RoleRead,
+	"ListClosedWorkflowExecutions": RoleRead,
+	"CountWorkflowExecutions":      RoleRead,
+	"ScanWorkflowExecutions":       RoleRead,
+	"QueryWorkflow":                RoleRead,
+	"TerminateWorkflowExecution":   RoleAdmin,
+	"ResetWorkflowExecution":       RoleAdmin,
+	"PauseWorkflowExecution":       RoleAdmin,
+	"ResumeWorkflowExecution":      RoleAdmin,
+	"RegisterDomain":               RoleAdmin,
+	"UpdateDomain":                 RoleAdmin,
+	"DeprecateDomain":              RoleAdmin,
+	"ListDomains":                  RoleRead,
+}
+
+// the APIs creating a domain, a grant in the domain can not exist before it is created, so they are only
+// allowed by grants in AllDomains
+var domainCreationAPIs = map[string]struct{}{
+	"RegisterDomain": {},
+}
+
+// ParseRole parses the name of a role, it returns false for unknown names
+func ParseRole(name string) (Role, bool) {
+	switch strings.ToLower(name) {
+	case "read":
+		return RoleRead, true
+	case "write":
+		return RoleWrite, true
+	case "admin":
+		return RoleAdmin, true
+	default:
+		return 0, false
+	}
+}
+
+// RequiredRole returns the role required to call the frontend or admin API
+func RequiredRole(apiName string) Role {
+	if strings.HasPrefix(apiName, AdminAPIPrefix) {
+		return RoleAdmin
+	}
+	if role, ok := apiRoles[apiName]; ok {
+		return role
+	}
+	return RoleWrite
+}
+
+// NewRoleAuthorizer returns an Authorizer which allows the operations of a domain according to the
+// role granted to the token of the caller. Operations without domain, e.g. listing the domains or the
+// admin operations on shards, and registering a domain are only allowed by grants in AllDomains.
+func NewRoleAuthorizer(grants []RoleGrant) Authorizer {
+	a := &roleAuthorizer{
+		grants: make(map[string]map[string]Role),
+	}
+	for _, grant := range grants {
+		domains, ok := a.grants[grant.Token]
+		if !ok {
+			domains = make(map[string]Role)
+			a.grants[grant.Token] = domains
+		}
+		if grant.Role > domains[grant.DomainName] {
+			domains[grant.DomainName] = grant.Role
+		}
+	}
+	return a
+}
+
+func (a *roleAuthorizer) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	domains := a.grants[attributes.Token]
+	var role Role
+	if _, ok := domainCreationAPIs[attributes.APIName]; !ok && attributes.DomainName != "" {
+		role = domains[attributes.DomainName]
+	}
+	if domains[AllDomains] > role {
+		role = domains[AllDomains]
+	}
+	if role < RequiredRole(attributes.APIName) {
+		return Result{Decision: DecisionDeny}, nil
+	}
+	return Result{Decision: DecisionAllow}, nil
+}
+
+// NewMultiAuthorizer returns an Authorizer which allows an operation only if all the given authorizers allow it
+func NewMultiAuthorizer(authorizers ...Authorizer) Authorizer {
+	return &multiAuthorizer{authorizers: authorizers}
+}
+
+func (a *multiAuthorizer) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	for _, authorizer := range a.authorizers {
+		result, err := authorizer.Authorize(ctx, attributes)
+		if err != nil || result.Decision != DecisionAllow {
+			return result, err
+		}
+	}
+	return Result{Decision: DecisionAllow}, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	roleAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+		authorizer Authorizer
+	}
+)
+
+func TestRoleAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(roleAuthorizerSuite))
+}
+
+func (s *roleAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.authorizer = NewRoleAuthorizer([]RoleGrant{
+		{Token: "viewer-token", DomainName: "shop", Role: RoleRead},
+		{Token: "service-token", DomainName: "shop", Role: RoleWrite},
+		{Token: "service-token", DomainName: "shop", Role: RoleRead},
+		{Token: "shop-admin-token", DomainName: "shop", Role: RoleAdmin},
+		{Token: "oncall-token", DomainName: AllDomains, Role: RoleAdmin},
+	})
+}
+
+func (s *roleAuthorizerSuite) TestAuthorize() {
+	testCases := []struct {
+		attributes *Attributes
+		decision   Decision
+	}{
+		{&Attributes{Token: "viewer-token", DomainName: "shop", APIName: "ListOpenWorkflowExecutions"}, DecisionAllow},
+		{&Attributes{Token: "viewer-token", DomainName: "shop", APIName: "SignalWorkflowExecution"}, DecisionDeny},
+		{&Attributes{Token: "viewer-token", DomainName: "other", APIName: "ListOpenWorkflowExecutions"}, DecisionDeny},
+		{&Attributes{Token: "service-token", DomainName: "shop", APIName: "SignalWorkflowExecution"}, DecisionAllow},
+		{&Attributes{Token: "service-token", DomainName: "shop", APIName: "QueryWorkflow"}, DecisionAllow},
+		{&Attributes{Token: "service-token", DomainName: "shop", APIName: "TerminateWorkflowExecution"}, DecisionDeny},
+		{&Attributes{Token: "oncall-token", DomainName: "shop", APIName: "TerminateWorkflowExecution"}, DecisionAllow},
+		{&Attributes{Token: "oncall-token", DomainName: "other", APIName: "UpdateDomain"}, DecisionAllow},
+		{&Attributes{DomainName: "shop", APIName: "DescribeWorkflowExecution"}, DecisionDeny},
+		{&Attributes{APIName: "RespondActivityTaskCompleted"}, DecisionDeny},
+		{&Attributes{Token: "service-token", APIName: "RespondActivityTaskCompleted"}, DecisionDeny},
+		{&Attributes{Token: "service-token", DomainName: "shop", APIName: "RespondActivityTaskCompleted"}, DecisionAllow},
+		{&Attributes{Token: "shop-admin-token", DomainName: "shop", APIName: "UpdateDomain"}, DecisionAllow},
+		{&Attributes{Token: "shop-admin-token", DomainName: "shop", APIName: "RegisterDomain"}, DecisionDeny},
+		{&Attributes{Token: "oncall-token", DomainName: "new-domain", APIName: "RegisterDomain"}, DecisionAllow},
+		{&Attributes{Token: "viewer-token", APIName: "ListDomains"}, DecisionDeny},
+		{&Attributes{Token: "oncall-token", APIName: "ListDomains"}, DecisionAllow},
+		{&Attributes{Token: "service-token", DomainName: "shop", APIName: AdminAPIPrefix + "DescribeWorkflowExecution"}, DecisionDeny},
+		{&Attributes{Token: "oncall-token", APIName: AdminAPIPrefix + "CloseShard"}, DecisionAllow},
+	}
+
+	for _, tc := range testCases {
+		result, err := s.authorizer.Authorize(context.Background(), tc.attributes)
+		s.NoError(err)
+		s.Equal(tc.decision, result.Decision, "%+v", tc.attributes)
+	}
+}
+
+func (s *roleAuthorizerSuite) TestMultiAuthorizer() {
+	authorizer := NewMultiAuthorizer(
+		s.authorizer,
+		NewTaskListAuthorizer([]TaskListGrant{{Token: "service-token", DomainName: "shop", TaskLists: []string{"orders"}}}),
+	)
+
+	result, err := authorizer.Authorize(context.Background(),
+		&Attributes{Token: "service-token", DomainName: "shop", APIName: "PollForActivityTask", TaskList: "orders"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = authorizer.Authorize(context.Background(),
+		&Attributes{Token: "service-token", DomainName: "shop", APIName: "PollForActivityTask", TaskList: "payments"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	result, err = authorizer.Authorize(context.Background(),
+		&Attributes{Token: "viewer-token", DomainName: "shop", APIName: "PollForActivityTask", TaskList: "orders"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *roleAuthorizerSuite) TestParseRole() {
+	role, ok := ParseRole("Admin")
+	s.True(ok)
+	s.Equal(RoleAdmin, role)
+
+	_, ok = ParseRole("owner")
+	s.False(ok)
+}
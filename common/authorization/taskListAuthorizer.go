@@ -0,0 +1,97 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+)
+
+type (
+	// TaskListGrant allows the holder of a token to poll the given task lists of a domain
+	TaskListGrant struct {
+		Token      string
+		DomainName string
+		// TaskLists are the task lists which may be polled, empty means all task lists of the domain
+		TaskLists []string
+	}
+
+	taskListAuthorizer struct {
+		// token -> domain name -> task list grant
+		grants map[string]map[string]*taskListSet
+	}
+
+	taskListSet struct {
+		all       bool
+		taskLists map[string]struct{}
+	}
+)
+
+// NewTaskListAuthorizer returns an Authorizer which restricts poll operations to the task lists
+// granted to the token of the caller, so the credentials of the workers of one task list cannot be
+// used to take tasks of other task lists. Operations without task list are always allowed.
+func NewTaskListAuthorizer(grants []TaskListGrant) Authorizer {
+	a := &taskListAuthorizer{
+		grants: make(map[string]map[string]*taskListSet),
+	}
+	for _, grant := range grants {
+		domains, ok := a.grants[grant.Token]
+		if !ok {
+			domains = make(map[string]*taskListSet)
+			a.grants[grant.Token] = domains
+		}
+		set, ok := domains[grant.DomainName]
+		if !ok {
+			set = &taskListSet{taskLists: make(map[string]struct{})}
+			domains[grant.DomainName] = set
+		}
+		if len(grant.TaskLists) == 0 {
+			set.all = true
+		}
+		for _, taskList := range grant.TaskLists {
+			set.taskLists[taskList] = struct{}{}
+		}
+	}
+	return a
+}
+
+func (a *taskListAuthorizer) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	if attributes.TaskList == "" {
+		return Result{Decision: DecisionAllow}, nil
+	}
+	if attributes.Token == "" {
+		return Result{Decision: DecisionDeny}, nil
+	}
+	set, ok := a.grants[attributes.Token][attributes.DomainName]
+	if !ok {
+		return Result{Decision: DecisionDeny}, nil
+	}
+	// sticky task lists are named after the worker and only hold decisions of workflows that worker
+	// already processed, so any token which may poll the domain may poll its own sticky task list.
+	// The kind is set by the caller, matching only serves a sticky poll from a task list which history
+	// added sticky decisions to, so a poll marked sticky never receives the tasks of a normal task list.
+	if attributes.StickyTaskList {
+		return Result{Decision: DecisionAllow}, nil
+	}
+	if _, ok := set.taskLists[attributes.TaskList]; !ok && !set.all {
+		return Result{Decision: DecisionDeny}, nil
+	}
+	return Result{Decision: DecisionAllow}, nil
+}
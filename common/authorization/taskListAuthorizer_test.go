@@ -0,0 +1,80 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	taskListAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+		authorizer Authorizer
+	}
+)
+
+func TestTaskListAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(taskListAuthorizerSuite))
+}
+
+func (s *taskListAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.authorizer = NewTaskListAuthorizer([]TaskListGrant{
+		{Token: "orders-token", DomainName: "shop", TaskLists: []string{"orders"}},
+		{Token: "orders-token", DomainName: "shop", TaskLists: []string{"refunds"}},
+		{Token: "admin-token", DomainName: "shop"},
+	})
+}
+
+func (s *taskListAuthorizerSuite) TestAuthorize() {
+	testCases := []struct {
+		attributes *Attributes
+		decision   Decision
+	}{
+		{&Attributes{Token: "orders-token", DomainName: "shop", TaskList: "orders"}, DecisionAllow},
+		{&Attributes{Token: "orders-token", DomainName: "shop", TaskList: "refunds"}, DecisionAllow},
+		{&Attributes{Token: "orders-token", DomainName: "shop", TaskList: "payments"}, DecisionDeny},
+		{&Attributes{Token: "orders-token", DomainName: "other", TaskList: "orders"}, DecisionDeny},
+		{&Attributes{Token: "orders-token", DomainName: "shop", TaskList: "host:1234", StickyTaskList: true}, DecisionAllow},
+		{&Attributes{Token: "orders-token", DomainName: "other", TaskList: "host:1234", StickyTaskList: true}, DecisionDeny},
+		{&Attributes{Token: "admin-token", DomainName: "shop", TaskList: "payments"}, DecisionAllow},
+		{&Attributes{Token: "unknown-token", DomainName: "shop", TaskList: "orders"}, DecisionDeny},
+		{&Attributes{DomainName: "shop", TaskList: "orders"}, DecisionDeny},
+		{&Attributes{DomainName: "shop"}, DecisionAllow},
+	}
+
+	for _, tc := range testCases {
+		result, err := s.authorizer.Authorize(context.Background(), tc.attributes)
+		s.NoError(err)
+		s.Equal(tc.decision, result.Decision, "%+v", tc.attributes)
+	}
+}
+
+func (s *taskListAuthorizerSuite) TestNopAuthorizer() {
+	result, err := NewNopAuthorizer().Authorize(context.Background(), &Attributes{DomainName: "shop", TaskList: "orders"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
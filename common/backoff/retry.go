@@ -21,6 +21,7 @@
 package backoff
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -113,6 +114,38 @@ func Retry(operation Operation, policy RetryPolicy, isRetryable IsRetryable) err
 	}
 }
 
+// RetryContext is like Retry, but stops retrying as soon as the context is canceled or its deadline is exceeded,
+// in which case the error of the last attempt is returned
+func RetryContext(ctx context.Context, operation Operation, policy RetryPolicy, isRetryable IsRetryable) error {
+	var err error
+	var next time.Duration
+
+	r := NewRetrier(policy, SystemClock)
+	for {
+		// operation completed successfully.  No need to retry.
+		if err = operation(); err == nil {
+			return nil
+		}
+
+		if next = r.NextBackOff(); next == done {
+			return err
+		}
+
+		// Check if the error is retryable
+		if isRetryable != nil && !isRetryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(next)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
 // IgnoreErrors can be used as IsRetryable handler for Retry function to exclude certain errors from the retry list
 func IgnoreErrors(errorsToExclude []error) func(error) bool {
 	return func(err error) bool {
@@ -21,6 +21,7 @@
 package backoff
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -137,6 +138,26 @@ func (s *RetrySuite) TestIsRetryableFailure() {
 	s.Equal(1, i)
 }
 
+func (s *RetrySuite) TestRetryContextCanceled() {
+	i := 0
+	ctx, cancel := context.WithCancel(context.Background())
+	op := func() error {
+		i++
+		if i == 2 {
+			cancel()
+		}
+		return &someError{}
+	}
+
+	policy := NewExponentialRetryPolicy(10 * time.Millisecond)
+	policy.SetMaximumAttempts(10)
+
+	err := RetryContext(ctx, op, policy, nil)
+	s.Error(err)
+	s.IsType(&someError{}, err)
+	s.Equal(2, i)
+}
+
 func (s *RetrySuite) TestConcurrentRetrier() {
 	policy := NewExponentialRetryPolicy(1 * time.Millisecond)
 	policy.SetMaximumInterval(10 * time.Millisecond)
@@ -0,0 +1,165 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// The binary token encoding writes the fields in a fixed order, strings as a uvarint length followed by the
+// bytes and integers as zigzag varints. Optional nested structs are preceded by a presence byte. New fields are only
+// ever appended, and readers ignore the bytes after the fields they know, so tokens written by newer hosts can still
+// be read during a deployment.
+
+var (
+	// ErrMalformedBinaryTaskToken is returned when a binary task token can not be decoded
+	ErrMalformedBinaryTaskToken = errors.New("binary task token is malformed")
+)
+
+type (
+	binaryTokenWriter struct {
+		buf     []byte
+		scratch [binary.MaxVarintLen64]byte
+	}
+
+	binaryTokenReader struct {
+		data []byte
+		err  error
+	}
+)
+
+func encodeBinaryTaskToken(token *TaskToken) []byte {
+	w := &binaryTokenWriter{}
+	w.writeString(token.DomainID)
+	w.writeString(token.WorkflowID)
+	w.writeString(token.RunID)
+	w.writeInt(token.ScheduleID)
+	w.writeInt(token.ScheduleAttempt)
+	w.writeString(token.ActivityID)
+	w.writeBool(token.DispatchTrace != nil)
+	if token.DispatchTrace != nil {
+		w.writeString(token.DispatchTrace.Host)
+		w.writeString(token.DispatchTrace.TaskList)
+		w.writeBool(token.DispatchTrace.SyncMatch)
+		w.writeInt(token.DispatchTrace.BufferWait)
+	}
+	return w.buf
+}
+
+func decodeBinaryTaskToken(data []byte, token *TaskToken) error {
+	r := &binaryTokenReader{data: data}
+	token.DomainID = r.readString()
+	token.WorkflowID = r.readString()
+	token.RunID = r.readString()
+	token.ScheduleID = r.readInt()
+	token.ScheduleAttempt = r.readInt()
+	token.ActivityID = r.readString()
+	if r.readBool() {
+		token.DispatchTrace = &DispatchTrace{
+			Host:       r.readString(),
+			TaskList:   r.readString(),
+			SyncMatch:  r.readBool(),
+			BufferWait: r.readInt(),
+		}
+	}
+	return r.done()
+}
+
+func encodeBinaryQueryTaskToken(token *QueryTaskToken) []byte {
+	w := &binaryTokenWriter{}
+	w.writeString(token.DomainID)
+	w.writeString(token.TaskList)
+	w.writeString(token.TaskID)
+	return w.buf
+}
+
+func decodeBinaryQueryTaskToken(data []byte, token *QueryTaskToken) error {
+	r := &binaryTokenReader{data: data}
+	token.DomainID = r.readString()
+	token.TaskList = r.readString()
+	token.TaskID = r.readString()
+	return r.done()
+}
+
+func (w *binaryTokenWriter) writeString(s string) {
+	n := binary.PutUvarint(w.scratch[:], uint64(len(s)))
+	w.buf = append(w.buf, w.scratch[:n]...)
+	w.buf = append(w.buf, s...)
+}
+
+func (w *binaryTokenWriter) writeInt(v int64) {
+	n := binary.PutVarint(w.scratch[:], v)
+	w.buf = append(w.buf, w.scratch[:n]...)
+}
+
+func (w *binaryTokenWriter) writeBool(v bool) {
+	if v {
+		w.buf = append(w.buf, 1)
+	} else {
+		w.buf = append(w.buf, 0)
+	}
+}
+
+func (r *binaryTokenReader) readString() string {
+	if r.err != nil {
+		return ""
+	}
+	length, n := binary.Uvarint(r.data)
+	if n <= 0 || uint64(len(r.data)-n) < length {
+		r.err = ErrMalformedBinaryTaskToken
+		return ""
+	}
+	s := string(r.data[n : n+int(length)])
+	r.data = r.data[n+int(length):]
+	return s
+}
+
+func (r *binaryTokenReader) readInt() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.data)
+	if n <= 0 {
+		r.err = ErrMalformedBinaryTaskToken
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *binaryTokenReader) readBool() bool {
+	if r.err != nil {
+		return false
+	}
+	if len(r.data) == 0 || r.data[0] > 1 {
+		r.err = ErrMalformedBinaryTaskToken
+		return false
+	}
+	v := r.data[0] == 1
+	r.data = r.data[1:]
+	return v
+}
+
+// done returns the first decoding error, the bytes left over are the fields of newer versions of the token
+func (r *binaryTokenReader) done() error {
+	return r.err
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	binaryTaskTokenSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestBinaryTaskTokenSuite(t *testing.T) {
+	s := new(binaryTaskTokenSuite)
+	suite.Run(t, s)
+}
+
+func (s *binaryTaskTokenSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *binaryTaskTokenSuite) TestTaskTokenRoundTrip() {
+	tokens := []*TaskToken{
+		{},
+		{
+			DomainID:        "domain-id",
+			WorkflowID:      "workflow-id",
+			RunID:           "run-id",
+			ScheduleID:      -1,
+			ScheduleAttempt: 1 << 40,
+			ActivityID:      "activity-id",
+		},
+		{
+			DomainID:   "domain-id",
+			WorkflowID: "workflow-id",
+			RunID:      "run-id",
+			ScheduleID: 12,
+			DispatchTrace: &DispatchTrace{
+				Host:       "host:7935",
+				TaskList:   "task-list",
+				SyncMatch:  true,
+				BufferWait: 1500,
+			},
+		},
+	}
+	for _, expected := range tokens {
+		token := &TaskToken{}
+		s.NoError(decodeBinaryTaskToken(encodeBinaryTaskToken(expected), token))
+		s.Equal(expected, token)
+	}
+}
+
+func (s *binaryTaskTokenSuite) TestQueryTaskTokenRoundTrip() {
+	expected := &QueryTaskToken{DomainID: "domain-id", TaskList: "task-list", TaskID: "task-id"}
+	token := &QueryTaskToken{}
+	s.NoError(decodeBinaryQueryTaskToken(encodeBinaryQueryTaskToken(expected), token))
+	s.Equal(expected, token)
+}
+
+func (s *binaryTaskTokenSuite) TestTrailingBytesIgnored() {
+	expected := &TaskToken{DomainID: "domain-id", WorkflowID: "workflow-id", ScheduleID: 3}
+	data := append(encodeBinaryTaskToken(expected), 7, 'n', 'e', 'w', 'e', 'r')
+
+	token := &TaskToken{}
+	s.NoError(decodeBinaryTaskToken(data, token))
+	s.Equal(expected, token)
+}
+
+func (s *binaryTaskTokenSuite) TestMalformed() {
+	data := encodeBinaryTaskToken(&TaskToken{
+		DomainID:      "domain-id",
+		WorkflowID:    "workflow-id",
+		DispatchTrace: &DispatchTrace{Host: "host"},
+	})
+	// every truncation of the token misses a field
+	for i := 0; i < len(data); i++ {
+		s.Equal(ErrMalformedBinaryTaskToken, decodeBinaryTaskToken(data[:i], &TaskToken{}), "length %v", i)
+	}
+
+	// a string length beyond the end of the token
+	s.Equal(ErrMalformedBinaryTaskToken, decodeBinaryQueryTaskToken([]byte{100, 'a'}, &QueryTaskToken{}))
+	// a varint which never ends
+	s.Equal(ErrMalformedBinaryTaskToken, decodeBinaryQueryTaskToken([]byte{0xff, 0xff, 0xff}, &QueryTaskToken{}))
+	// a presence byte which is neither 0 nor 1
+	invalidPresence := encodeBinaryTaskToken(&TaskToken{})
+	invalidPresence[len(invalidPresence)-1] = 2
+	s.Equal(ErrMalformedBinaryTaskToken, decodeBinaryTaskToken(invalidPresence, &TaskToken{}))
+}
+
+func (s *binaryTaskTokenSuite) TestSerializerReadsBothEncodings() {
+	expected := &TaskToken{DomainID: "domain-id", WorkflowID: "workflow-id", RunID: "run-id", ScheduleID: 7}
+	binarySerializer := NewTaskTokenSerializer(
+		dynamicconfig.GetStringPropertyFn(TaskTokenEncodingBinary),
+		dynamicconfig.GetStringPropertyFn("key"),
+		dynamicconfig.GetStringPropertyFn(""),
+		dynamicconfig.GetBoolPropertyFn(false),
+	)
+	jsonSerializer := newTestTaskTokenSerializer("key", "", false)
+
+	data, err := binarySerializer.Serialize(expected)
+	s.NoError(err)
+	s.Equal(taskTokenVersionSignedBinary, data[0])
+	token, err := jsonSerializer.Deserialize(data)
+	s.NoError(err)
+	s.Equal(expected, token)
+
+	data, err = jsonSerializer.Serialize(expected)
+	s.NoError(err)
+	token, err = binarySerializer.Deserialize(data)
+	s.NoError(err)
+	s.Equal(expected, token)
+
+	_, err = binarySerializer.Deserialize([]byte{taskTokenVersionBinary, 0xff})
+	s.Equal(ErrUnsignedTaskToken, err)
+	_, err = NewTaskTokenSerializer(nil, nil, nil, nil).Deserialize([]byte{taskTokenVersionBinary, 0xff})
+	s.Equal(ErrMalformedBinaryTaskToken, err)
+}
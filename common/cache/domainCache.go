@@ -258,7 +258,17 @@ func (c *domainCache) GetDomain(name string) (*DomainCacheEntry, error) {
 	if name == "" {
 		return nil, &workflow.BadRequestError{Message: "Domain is empty."}
 	}
-	return c.getDomain(name)
+	entry, err := c.getDomain(name)
+	if err != nil {
+		return nil, err
+	}
+	if entry.info.Name != name {
+		// resolved through an alias, callers should move to the current name
+		c.metricsClient.IncCounter(metrics.DomainCacheScope, metrics.DomainAliasResolvedCounter)
+		c.logger.Warn("Domain resolved through deprecated alias",
+			tag.WorkflowDomainName(entry.info.Name), tag.WorkflowDomainAlias(name))
+	}
+	return entry, nil
 }
 
 // GetDomainByID retrieves the information from the cache if it exists, otherwise retrieves the information from metadata
@@ -336,10 +346,14 @@ func (c *domainCache) refreshDomains() error {
 	// make a copy of the existing domain cache, so we can calculate diff and do compare and swap
 	newCacheNameToID := newDomainCache()
 	newCacheByID := newDomainCache()
-	for _, domain := range c.GetAllDomain() {
-		newCacheNameToID.Put(domain.info.Name, domain.info.ID)
+	existingDomains := c.GetAllDomain()
+	for _, domain := range existingDomains {
+		c.updateNameToIDCache(newCacheNameToID, domain.info.Name, domain.info.ID)
 		newCacheByID.Put(domain.info.ID, domain)
 	}
+	for _, domain := range existingDomains {
+		c.updateAliasesToIDCache(newCacheNameToID, domain)
+	}
 
 UpdateLoop:
 	for _, domain := range domains {
@@ -351,11 +365,20 @@ UpdateLoop:
 			// will be loaded into cache in the next refresh
 			break UpdateLoop
 		}
+		if entry, ok := newCacheByID.Get(domain.info.ID).(*DomainCacheEntry); ok && entry.info != nil {
+			// drop the aliases of the previous record, those still valid are added back below
+			for _, alias := range entry.GetAliases() {
+				if id, ok := newCacheNameToID.Get(alias).(string); ok && id == domain.info.ID {
+					newCacheNameToID.Delete(alias)
+				}
+			}
+		}
 		prevEntry, nextEntry, err := c.updateIDToDomainCache(newCacheByID, domain.info.ID, domain)
 		if err != nil {
 			return err
 		}
 		c.updateNameToIDCache(newCacheNameToID, nextEntry.info.Name, nextEntry.info.ID)
+		c.updateAliasesToIDCache(newCacheNameToID, nextEntry)
 
 		if prevEntry != nil {
 			prevEntries = append(prevEntries, prevEntry)
@@ -401,6 +424,16 @@ func (c *domainCache) updateNameToIDCache(cacheNameToID Cache, name string, id s
 	cacheNameToID.Put(name, id)
 }
 
+// updateAliasesToIDCache maps the deprecated names of the domain to its ID,
+// an alias never shadows the name of another domain
+func (c *domainCache) updateAliasesToIDCache(cacheNameToID Cache, entry *DomainCacheEntry) {
+	for _, alias := range entry.GetAliases() {
+		if _, err := cacheNameToID.PutIfNotExist(alias, entry.info.ID); err != nil {
+			c.logger.Warn("Failed to add domain alias to cache", tag.WorkflowDomainName(alias), tag.Error(err))
+		}
+	}
+}
+
 func (c *domainCache) updateIDToDomainCache(cacheByID Cache, id string, record *DomainCacheEntry) (*DomainCacheEntry, *DomainCacheEntry, error) {
 	elem, err := cacheByID.PutIfNotExist(id, newDomainCacheEntry(c.clusterMetadata))
 	if err != nil {
@@ -584,6 +617,11 @@ func (entry *DomainCacheEntry) GetInfo() *persistence.DomainInfo {
 	return entry.info
 }
 
+// GetAliases return the deprecated names of the domain
+func (entry *DomainCacheEntry) GetAliases() []string {
+	return common.GetDomainAliases(entry.info.Data)
+}
+
 // GetConfig return the domain config
 func (entry *DomainCacheEntry) GetConfig() *persistence.DomainConfig {
 	return entry.config
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -176,6 +177,72 @@ func (s *domainCacheSuite) TestListDomain() {
 	}, allDomains)
 }
 
+func (s *domainCacheSuite) TestGetDomain_ByAlias() {
+	domainNotificationVersion := int64(0)
+	domainRecord := &persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{
+			ID:   uuid.New(),
+			Name: "renamed domain name",
+			Data: map[string]string{common.DomainDataKeyForAliases: "old domain name, other domain name"},
+		},
+		Config: &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+			},
+		},
+		FailoverNotificationVersion: 0,
+		NotificationVersion:         domainNotificationVersion,
+	}
+	entry := s.buildEntryFromRecord(domainRecord)
+	domainNotificationVersion++
+
+	// an alias never shadows the name of another domain
+	otherDomainRecord := &persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: uuid.New(), Name: "other domain name", Data: make(map[string]string)},
+		Config: &persistence.DomainConfig{Retention: 2},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+			},
+		},
+		FailoverNotificationVersion: 0,
+		NotificationVersion:         domainNotificationVersion,
+	}
+	otherEntry := s.buildEntryFromRecord(otherDomainRecord)
+	domainNotificationVersion++
+
+	s.metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: domainNotificationVersion}, nil)
+	s.clusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	s.metadataMgr.On("ListDomains", &persistence.ListDomainsRequest{
+		PageSize:      domainCacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListDomainsResponse{
+		Domains:       []*persistence.GetDomainResponse{domainRecord, otherDomainRecord},
+		NextPageToken: nil,
+	}, nil).Once()
+
+	// load domains
+	s.domainCache.Start()
+	defer s.domainCache.Stop()
+
+	entryByName, err := s.domainCache.GetDomain(domainRecord.Info.Name)
+	s.Nil(err)
+	s.Equal(entry, s.clearExpiry(entryByName))
+	entryByAlias, err := s.domainCache.GetDomain("old domain name")
+	s.Nil(err)
+	s.Equal(entry, s.clearExpiry(entryByAlias))
+	domainID, err := s.domainCache.GetDomainID("old domain name")
+	s.Nil(err)
+	s.Equal(domainRecord.Info.ID, domainID)
+
+	entryByName, err = s.domainCache.GetDomain("other domain name")
+	s.Nil(err)
+	s.Equal(otherEntry, s.clearExpiry(entryByName))
+}
+
 func (s *domainCacheSuite) TestGetDomain_NonLoaded_GetByName() {
 	s.clusterMetadata.On("IsGlobalDomainEnabled").Return(true)
 	domainRecord := &persistence.GetDomainResponse{
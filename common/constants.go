@@ -60,11 +60,28 @@ const (
 
 // Data encoding types
 const (
-	EncodingTypeJSON     EncodingType = "json"
-	EncodingTypeThriftRW              = "thriftrw"
-	EncodingTypeGob                   = "gob"
-	EncodingTypeUnknown               = "unknow"
-	EncodingTypeEmpty                 = ""
+	EncodingTypeJSON           EncodingType = "json"
+	EncodingTypeThriftRW                    = "thriftrw"
+	EncodingTypeThriftRWGzip                = "thriftrw-gzip"
+	EncodingTypeThriftRWSnappy              = "thriftrw-snappy"
+	EncodingTypeGob                         = "gob"
+	EncodingTypeUnknown                     = "unknow"
+	EncodingTypeEmpty                       = ""
+)
+
+// History task types addressed by the admin APIs
+const (
+	// TaskTypeTransfer is the task type for transfer task
+	// starting from 2 here to be consistent with the row type define for cassandra
+	TaskTypeTransfer = iota + 2
+	// TaskTypeTimer is the task type for timer task
+	TaskTypeTimer
+)
+
+const (
+	// QueryTypeStackTrace is the built-in query type answered by the client library with the current
+	// goroutine stacks of the workflow, no query handler needs to be registered for it
+	QueryTypeStackTrace = "__stack_trace"
 )
 
 // NoRetryBackoff is used to represent backoff when no retry is needed
@@ -93,6 +110,22 @@ const (
 const (
 	// SystemDomainName is domain name for all cadence system workflows
 	SystemDomainName = "cadence-system"
+	// ReservedIDPrefix is the prefix of workflow IDs and task list names reserved for cadence system workflows
+	ReservedIDPrefix = "cadence-sys"
+)
+
+const (
+	// DomainDataKeyForAliases is the domain data key holding a comma separated list of deprecated names,
+	// e.g. the name before a rename, which still resolve to the domain
+	DomainDataKeyForAliases = "cadence.domainAliases"
+	// DomainDataKeyPrefixForActivityTaskList is the domain data key prefix, followed by an activity type name,
+	// of the task list used for activities of that type scheduled without a task list
+	DomainDataKeyPrefixForActivityTaskList = "cadence.activityTaskList."
+	// DomainDataKeyForDefaultTaskList is the domain data key of the task list used for workflows started without one
+	DomainDataKeyForDefaultTaskList = "cadence.defaultTaskList"
+	// DomainDataKeyPrefixForWorkflowTypeAlias is the domain data key prefix, followed by a workflow type name,
+	// of the workflow type name that workflows started with that type are rewritten to
+	DomainDataKeyPrefixForWorkflowTypeAlias = "cadence.workflowTypeAlias."
 )
 
 const (
@@ -30,5 +30,10 @@ type (
 		Enable  bool              `yaml:enable`
 		URL     url.URL           `yaml:url`
 		Indices map[string]string `yaml:indices`
+		// DomainIndices routes the visibility records of the listed domains, by domain id, to dedicated
+		// indices instead of the visibility index, so large tenants can be sized and retained separately.
+		// "{domainID}" in an index name is replaced with the domain id, which allows the same naming
+		// template to be used for every partitioned domain
+		DomainIndices map[string]string `yaml:"domainIndices"`
 	}
 )
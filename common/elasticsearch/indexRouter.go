@@ -0,0 +1,60 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"strings"
+)
+
+// DomainIDPlaceholder is replaced with the domain id in the names of domain indices
+const DomainIDPlaceholder = "{domainID}"
+
+type (
+	// IndexRouter returns the index which holds the visibility records of a domain
+	IndexRouter interface {
+		GetIndexName(domainID string) string
+	}
+
+	indexRouter struct {
+		defaultIndex  string
+		domainIndices map[string]string
+	}
+)
+
+// NewIndexRouter returns an IndexRouter which routes the given domains to their own index
+// and all other domains to the default index
+func NewIndexRouter(defaultIndex string, domainIndices map[string]string) IndexRouter {
+	indices := make(map[string]string, len(domainIndices))
+	for domainID, index := range domainIndices {
+		indices[domainID] = strings.Replace(index, DomainIDPlaceholder, domainID, -1)
+	}
+	return &indexRouter{
+		defaultIndex:  defaultIndex,
+		domainIndices: indices,
+	}
+}
+
+func (r *indexRouter) GetIndexName(domainID string) string {
+	if index, ok := r.domainIndices[domainID]; ok {
+		return index
+	}
+	return r.defaultIndex
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package errors
+
+import (
+	"fmt"
+)
+
+type (
+	// NoTasksError is returned when a long poll on a task list expires without any task being dispatched
+	NoTasksError struct {
+		DomainID string
+		TaskList string
+		TaskType int
+	}
+
+	// MaxAttemptsExceededError is returned when an update of the workflow execution keeps
+	// failing with a conflict and the retry attempts are exhausted
+	MaxAttemptsExceededError struct {
+		ShardID    int
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+)
+
+// NewNoTasksError return no tasks error
+func NewNoTasksError(domainID string, taskList string, taskType int) *NoTasksError {
+	return &NoTasksError{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: taskType,
+	}
+}
+
+func (e *NoTasksError) Error() string {
+	return fmt.Sprintf("No tasks, domainID: %v, taskList: %v, taskType: %v", e.DomainID, e.TaskList, e.TaskType)
+}
+
+// NewMaxAttemptsExceededError return max attempts exceeded error
+func NewMaxAttemptsExceededError(shardID int, domainID string, workflowID string, runID string) *MaxAttemptsExceededError {
+	return &MaxAttemptsExceededError{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+}
+
+func (e *MaxAttemptsExceededError) Error() string {
+	return fmt.Sprintf("Maximum attempts exceeded to update history, shardID: %v, domainID: %v, workflowID: %v, runID: %v",
+		e.ShardID, e.DomainID, e.WorkflowID, e.RunID)
+}
+
+// IsNoTasksError returns true if the error is a NoTasksError
+func IsNoTasksError(err error) bool {
+	_, ok := err.(*NoTasksError)
+	return ok
+}
+
+// IsMaxAttemptsExceededError returns true if the error is a MaxAttemptsExceededError
+func IsMaxAttemptsExceededError(err error) bool {
+	_, ok := err.(*MaxAttemptsExceededError)
+	return ok
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package historyrender converts workflow history into human readable tables and canonical JSON.
+package historyrender
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const attributesSuffix = "EventAttributes"
+
+type (
+	// Event is the flattened form of a history event, the attributes of the event are keyed by their
+	// dotted JSON path, e.g. "workflowType.name"
+	Event struct {
+		EventID    int64                  `json:"eventId"`
+		Timestamp  string                 `json:"timestamp"`
+		EventType  string                 `json:"eventType"`
+		Version    int64                  `json:"version"`
+		TaskID     int64                  `json:"taskId"`
+		Attributes map[string]interface{} `json:"attributes"`
+	}
+)
+
+// DeserializeBatches decodes serialized history batches, as stored by persistence, into history events
+func DeserializeBatches(blobs []*persistence.DataBlob) ([]*workflow.HistoryEvent, error) {
+	serializer := persistence.NewPayloadSerializer()
+	var events []*workflow.HistoryEvent
+	for _, blob := range blobs {
+		batch, err := serializer.DeserializeBatchEvents(blob)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, batch...)
+	}
+	return events, nil
+}
+
+// EventAttributes returns the attributes set on the given event, or nil if there are none
+func EventAttributes(e *workflow.HistoryEvent) interface{} {
+	v := reflect.ValueOf(e).Elem()
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		if !strings.HasSuffix(t.Field(i).Name, attributesSuffix) {
+			continue
+		}
+		if f := v.Field(i); !f.IsNil() {
+			return f.Interface()
+		}
+	}
+	return nil
+}
+
+// FlattenAttributes returns the attributes of the given event keyed by their dotted JSON path, binary
+// payloads are converted to strings and enums to their names
+func FlattenAttributes(e *workflow.HistoryEvent) map[string]interface{} {
+	result := make(map[string]interface{})
+	if attributes := EventAttributes(e); attributes != nil {
+		flatten(result, "", reflect.ValueOf(attributes))
+	}
+	return result
+}
+
+// NewEvent converts a history event into its flattened form
+func NewEvent(e *workflow.HistoryEvent) *Event {
+	return &Event{
+		EventID:    e.GetEventId(),
+		Timestamp:  formatTimestamp(e.GetTimestamp()),
+		EventType:  e.GetEventType().String(),
+		Version:    e.GetVersion(),
+		TaskID:     e.GetTaskId(),
+		Attributes: FlattenAttributes(e),
+	}
+}
+
+// ToJSON converts history events into canonical JSON, the same events always result in the same bytes
+func ToJSON(events []*workflow.HistoryEvent) ([]byte, error) {
+	flattened := make([]*Event, 0, len(events))
+	for _, e := range events {
+		flattened = append(flattened, NewEvent(e))
+	}
+	return json.MarshalIndent(flattened, "", "  ")
+}
+
+// WriteTable writes history events as a table with one row per event, attribute values longer than
+// maxFieldLength are trimmed unless maxFieldLength is 0
+func WriteTable(w io.Writer, events []*workflow.HistoryEvent, maxFieldLength int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTime\tType\tDetails")
+	for _, e := range events {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n",
+			e.GetEventId(),
+			formatTimestamp(e.GetTimestamp()),
+			e.GetEventType(),
+			details(FlattenAttributes(e), maxFieldLength))
+	}
+	return tw.Flush()
+}
+
+// String renders history events as a table without trimming any attribute, it is meant for logs and
+// test failure messages
+func String(events []*workflow.HistoryEvent) string {
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, events, 0); err != nil {
+		return err.Error()
+	}
+	return buf.String()
+}
+
+func details(attributes map[string]interface{}, maxFieldLength int) string {
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fields := make([]string, 0, len(keys))
+	for _, k := range keys {
+		value := fmt.Sprint(attributes[k])
+		// tabs and new lines would break the table layout
+		value = strings.NewReplacer("\t", " ", "\n", " ").Replace(value)
+		fields = append(fields, fmt.Sprintf("%s:%s", k, trim(value, maxFieldLength)))
+	}
+	return strings.Join(fields, ", ")
+}
+
+func trim(input string, maxFieldLength int) string {
+	if maxFieldLength <= 0 || len(input) <= maxFieldLength {
+		return input
+	}
+	return fmt.Sprintf("%s ... %s", input[:maxFieldLength/2], input[len(input)-maxFieldLength/2:])
+}
+
+func formatTimestamp(timestamp int64) string {
+	return time.Unix(0, timestamp).UTC().Format(time.RFC3339Nano)
+}
+
+func flatten(result map[string]interface{}, prefix string, v reflect.Value) {
+	if stringer, ok := v.Interface().(fmt.Stringer); ok && v.Kind() != reflect.Ptr && v.Kind() != reflect.Struct {
+		// enums
+		result[prefix] = stringer.String()
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			flatten(result, prefix, v.Elem())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			name := jsonName(t.Field(i))
+			if name == "" {
+				continue
+			}
+			flatten(result, join(prefix, name), v.Field(i))
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			return
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			result[prefix] = string(v.Bytes())
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			flatten(result, join(prefix, fmt.Sprint(i)), v.Index(i))
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			flatten(result, join(prefix, fmt.Sprint(key.Interface())), v.MapIndex(key))
+		}
+	default:
+		result[prefix] = v.Interface()
+	}
+}
+
+func jsonName(f reflect.StructField) string {
+	if f.PkgPath != "" {
+		// unexported
+		return ""
+	}
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	default:
+		return name
+	}
+}
+
+func join(prefix string, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package historyrender
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	renderSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestRenderSuite(t *testing.T) {
+	suite.Run(t, new(renderSuite))
+}
+
+func (s *renderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *renderSuite) TestFlattenAttributes() {
+	attributes := FlattenAttributes(s.startedEvent())
+	s.Equal("test-workflow-type", attributes["workflowType.name"])
+	s.Equal("test-tasklist", attributes["taskList.name"])
+	s.Equal("STICKY", attributes["taskList.kind"])
+	s.Equal(`{"key":"value"}`, attributes["input"])
+	s.Equal(int32(10), attributes["executionStartToCloseTimeoutSeconds"])
+	s.Equal("memo-value", attributes["memo.fields.memo-key"])
+	s.NotContains(attributes, "identity")
+
+	s.Empty(FlattenAttributes(&workflow.HistoryEvent{EventId: common.Int64Ptr(2)}))
+}
+
+func (s *renderSuite) TestToJSON() {
+	events := []*workflow.HistoryEvent{s.startedEvent(), s.scheduledEvent()}
+	data, err := ToJSON(events)
+	s.NoError(err)
+
+	again, err := ToJSON(events)
+	s.NoError(err)
+	s.Equal(data, again)
+
+	var decoded []*Event
+	s.NoError(json.Unmarshal(data, &decoded))
+	s.Len(decoded, 2)
+	s.Equal(int64(1), decoded[0].EventID)
+	s.Equal("WorkflowExecutionStarted", decoded[0].EventType)
+	s.Equal("1970-01-01T00:00:01Z", decoded[0].Timestamp)
+	s.Equal("test-workflow-type", decoded[0].Attributes["workflowType.name"])
+	s.Equal("DecisionTaskScheduled", decoded[1].EventType)
+	s.Equal(float64(1), decoded[1].Attributes["attempt"])
+}
+
+func (s *renderSuite) TestWriteTable() {
+	events := []*workflow.HistoryEvent{s.startedEvent(), s.scheduledEvent()}
+	var buf strings.Builder
+	s.NoError(WriteTable(&buf, events, 6))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	s.Len(lines, 3)
+	s.True(strings.HasPrefix(lines[0], "ID"))
+	s.Contains(lines[1], "WorkflowExecutionStarted")
+	s.Contains(lines[1], "workflowType.name:tes ... ype")
+	s.Contains(lines[2], "attempt:1, startToCloseTimeoutSeconds:5, taskList.name:tes ... ist")
+
+	s.Contains(String(events), "workflowType.name:test-workflow-type")
+}
+
+func (s *renderSuite) TestDeserializeBatches() {
+	serializer := persistence.NewPayloadSerializer()
+	blob, err := serializer.SerializeBatchEvents([]*workflow.HistoryEvent{s.startedEvent(), s.scheduledEvent()}, common.EncodingTypeThriftRW)
+	s.NoError(err)
+
+	events, err := DeserializeBatches([]*persistence.DataBlob{blob})
+	s.NoError(err)
+	s.Len(events, 2)
+	s.Equal(workflow.EventTypeDecisionTaskScheduled, events[1].GetEventType())
+}
+
+func (s *renderSuite) startedEvent() *workflow.HistoryEvent {
+	return &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(1),
+		Timestamp: common.Int64Ptr(1000000000),
+		EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionStarted),
+		Version:   common.Int64Ptr(common.EmptyVersion),
+		TaskId:    common.Int64Ptr(common.EmptyEventTaskID),
+		WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr("test-tasklist"),
+				Kind: common.TaskListKindPtr(workflow.TaskListKindSticky),
+			},
+			Input:                               []byte(`{"key":"value"}`),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(10),
+			Memo:                                &workflow.Memo{Fields: map[string][]byte{"memo-key": []byte("memo-value")}},
+		},
+	}
+}
+
+func (s *renderSuite) scheduledEvent() *workflow.HistoryEvent {
+	return &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(2),
+		Timestamp: common.Int64Ptr(2000000000),
+		EventType: common.EventTypePtr(workflow.EventTypeDecisionTaskScheduled),
+		DecisionTaskScheduledEventAttributes: &workflow.DecisionTaskScheduledEventAttributes{
+			TaskList:                   &workflow.TaskList{Name: common.StringPtr("test-tasklist")},
+			StartToCloseTimeoutSeconds: common.Int32Ptr(5),
+			Attempt:                    common.Int64Ptr(1),
+		},
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+// The helpers below attach the standard set of tags for a given entity, so that log lines emitted by
+// different components for the same workflow, shard or task list can be correlated.
+
+// WithWorkflow returns a logger tagged with the domain ID, workflow ID and run ID of the execution
+func WithWorkflow(logger Logger, domainID string, execution *shared.WorkflowExecution) Logger {
+	return WithWorkflowIDs(logger, domainID, execution.GetWorkflowId(), execution.GetRunId())
+}
+
+// WithWorkflowIDs returns a logger tagged with the given domain ID, workflow ID and run ID,
+// empty values are not tagged
+func WithWorkflowIDs(logger Logger, domainID string, workflowID string, runID string) Logger {
+	var tags []tag.Tag
+	if domainID != "" {
+		tags = append(tags, tag.WorkflowDomainID(domainID))
+	}
+	if workflowID != "" {
+		tags = append(tags, tag.WorkflowID(workflowID))
+	}
+	if runID != "" {
+		tags = append(tags, tag.WorkflowRunID(runID))
+	}
+	if len(tags) == 0 {
+		return logger
+	}
+	return logger.WithTags(tags...)
+}
+
+// WithShard returns a logger tagged with the shard ID
+func WithShard(logger Logger, shardID int) Logger {
+	return logger.WithTags(tag.ShardID(shardID))
+}
+
+// WithTaskList returns a logger tagged with the domain ID, name and type of the task list
+func WithTaskList(logger Logger, domainID string, taskListName string, taskListType int) Logger {
+	return logger.WithTags(
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowTaskListName(taskListName),
+		tag.WorkflowTaskListType(taskListType))
+}
+
+// WithOperation returns a logger tagged with the name of the API or task being processed
+func WithOperation(logger Logger, operation string) Logger {
+	return logger.WithTags(tag.Operation(operation))
+}
@@ -48,6 +48,11 @@ func Timestamp(timestamp time.Time) Tag {
 	return newTimeTag("timestamp", timestamp)
 }
 
+// Operation returns tag for Operation
+func Operation(operation string) Tag {
+	return newStringTag("operation", operation)
+}
+
 ///////////////////  Workflow tags defined here: ( wf is short for workflow) ///////////////////
 
 // WorkflowAction returns tag for WorkflowAction
@@ -137,6 +142,11 @@ func WorkflowDomainName(domainName string) Tag {
 	return newStringTag("wf-domain-name", domainName)
 }
 
+// WorkflowDomainAlias returns tag for WorkflowDomainAlias
+func WorkflowDomainAlias(alias string) Tag {
+	return newStringTag("wf-domain-alias", alias)
+}
+
 // WorkflowDomainIDs returns tag for WorkflowDomainIDs
 func WorkflowDomainIDs(domainIDs interface{}) Tag {
 	return newObjectTag("wf-domain-ids", domainIDs)
@@ -218,6 +228,11 @@ func WorkflowDecisionFailCause(decisionFailCause int64) Tag {
 	return newInt64("wf-decision-fail-cause", decisionFailCause)
 }
 
+// WorkflowDecisionScheduleLatencyCause returns tag for WorkflowDecisionScheduleLatencyCause
+func WorkflowDecisionScheduleLatencyCause(cause string) Tag {
+	return newStringTag("wf-decision-schedule-latency-cause", cause)
+}
+
 // WorkflowTaskListType returns tag for WorkflowTaskListType
 func WorkflowTaskListType(taskListType int) Tag {
 	return newInt("wf-task-list-type", taskListType)
@@ -228,6 +243,11 @@ func WorkflowTaskListName(taskListName string) Tag {
 	return newStringTag("wf-task-list-name", taskListName)
 }
 
+// WorkflowTaskDispatchTrace returns tag for WorkflowTaskDispatchTrace
+func WorkflowTaskDispatchTrace(trace interface{}) Tag {
+	return newObjectTag("wf-task-dispatch-trace", trace)
+}
+
 // size limit
 
 // WorkflowSize returns tag for WorkflowSize
@@ -330,6 +350,26 @@ func DefaultValue(v interface{}) Tag {
 	return newObjectTag("default-value", v)
 }
 
+// Caller returns tag for Caller
+func Caller(caller string) Tag {
+	return newStringTag("caller", caller)
+}
+
+// Identity returns tag for Identity
+func Identity(identity string) Tag {
+	return newStringTag("identity", identity)
+}
+
+// Latency returns tag for Latency
+func Latency(latency time.Duration) Tag {
+	return newDurationTag("latency", latency)
+}
+
+// Request returns tag for Request
+func Request(request interface{}) Tag {
+	return newObjectTag("request", request)
+}
+
 // Port returns tag for Port
 func Port(p int) Tag {
 	return newInt("port", p)
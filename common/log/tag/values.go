@@ -45,6 +45,8 @@ var (
 	WorkflowActionTimerCanceled                   = workflowAction("add-timer-Canceled-event")
 	WorkflowActionWorkflowTerminated              = workflowAction("add-workflowexecution-terminated-event")
 	WorkflowActionWorkflowSignaled                = workflowAction("add-workflowexecution-signaled-event")
+	WorkflowActionWorkflowPaused                  = workflowAction("add-workflowexecution-paused-event")
+	WorkflowActionWorkflowResumed                 = workflowAction("add-workflowexecution-resumed-event")
 	WorkflowActionContinueAsNew                   = workflowAction("add-continue-as-new-event")
 	WorkflowActionWorkflowCanceled                = workflowAction("add-workflowexecution-canceled-event")
 	WorkflowActionChildExecutionStarted           = workflowAction("add-childexecution-started-event")
@@ -80,6 +82,8 @@ var (
 	ComponentTimerQueue               = component("timer-queue-processor")
 	ComponentTimerBuilder             = component("timer-builder")
 	ComponentReplicatorQueue          = component("replicator-queue-processor")
+	ComponentVisibilityQueue          = component("visibility-queue-processor")
+	ComponentTaskScheduler            = component("task-scheduler")
 	ComponentShardController          = component("shard-controller")
 	ComponentShard                    = component("shard")
 	ComponentShardItem                = component("shard-item")
@@ -135,6 +135,18 @@ func (r *ringpopServiceResolver) Lookup(key string) (*HostInfo, error) {
 	return NewHostInfo(addr, r.getLabelsMap()), nil
 }
 
+// Members returns all the hosts currently in the ring
+func (r *ringpopServiceResolver) Members() []*HostInfo {
+	r.ringLock.RLock()
+	defer r.ringLock.RUnlock()
+	servers := r.ring.Servers()
+	members := make([]*HostInfo, 0, len(servers))
+	for _, addr := range servers {
+		members = append(members, NewHostInfo(addr, r.getLabelsMap()))
+	}
+	return members
+}
+
 func (r *ringpopServiceResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
 	r.listenerLock.Lock()
 	defer r.listenerLock.Unlock()
@@ -22,6 +22,7 @@ package messaging
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/Shopify/sarama"
 	"github.com/uber/cadence/.gen/go/indexer"
@@ -129,6 +130,13 @@ func (p *kafkaProducer) getKeyForReplicationTask(task *replicator.ReplicationTas
 		// the messaging layer perspective
 		attributes := task.SyncActicvityTaskAttributes
 		return sarama.StringEncoder(attributes.GetWorkflowId())
+	case replicator.ReplicationTaskTypeBatch:
+		// A batch holds tasks of many workflows of a shard in the order they were generated, use the shardID as
+		// the partition key so that batches of a shard are not reordered by the messaging layer either. Once
+		// batching is enabled every task of the shard is published in a batch, so the tasks of a workflow are
+		// never split between the two keys
+		attributes := task.BatchTaskAttributes
+		return sarama.StringEncoder(strconv.Itoa(int(attributes.GetShardId())))
 	}
 
 	return nil
@@ -0,0 +1,60 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"github.com/golang/snappy"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+)
+
+// NewReplicationTaskBatch packs replication tasks generated by a shard into a single batch task,
+// the tasks are encoded and compressed so that the batch is cheap to ship across clusters
+func NewReplicationTaskBatch(shardID int, targetClusters []string, tasks []*replicator.ReplicationTask) (*replicator.ReplicationTask, error) {
+	payload, err := codec.NewThriftRWEncoder().Encode(&replicator.ReplicationTaskBatch{Tasks: tasks})
+	if err != nil {
+		return nil, err
+	}
+	return &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeBatch.Ptr(),
+		BatchTaskAttributes: &replicator.BatchTaskAttributes{
+			Tasks:          snappy.Encode(nil, payload),
+			TaskCount:      common.Int32Ptr(int32(len(tasks))),
+			ShardId:        common.Int32Ptr(int32(shardID)),
+			TargetClusters: targetClusters,
+		},
+	}, nil
+}
+
+// UnpackReplicationTaskBatch returns the replication tasks packed into a batch task, in the order
+// they were generated
+func UnpackReplicationTaskBatch(task *replicator.ReplicationTask) ([]*replicator.ReplicationTask, error) {
+	payload, err := snappy.Decode(nil, task.BatchTaskAttributes.GetTasks())
+	if err != nil {
+		return nil, err
+	}
+	var batch replicator.ReplicationTaskBatch
+	if err := codec.NewThriftRWEncoder().Decode(payload, &batch); err != nil {
+		return nil, err
+	}
+	return batch.Tasks, nil
+}
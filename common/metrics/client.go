@@ -33,6 +33,7 @@ type ClientImpl struct {
 	childScopes map[int]tally.Scope
 	metricDefs  map[int]metricDefinition
 	serviceIdx  ServiceIdx
+	tagFilter   TagFilter
 }
 
 // NewClient creates and returns a new instance of
@@ -40,12 +41,19 @@ type ClientImpl struct {
 // reporter holds the common tags for the service
 // serviceIdx indicates the service type in (InputhostIndex, ... StorageIndex)
 func NewClient(scope tally.Scope, serviceIdx ServiceIdx) Client {
+	return NewClientWithTagFilter(scope, serviceIdx, nil)
+}
+
+// NewClientWithTagFilter creates and returns a new instance of Client implementation
+// whose scopes pass tags added through Scope or Tagged through the given filter
+func NewClientWithTagFilter(scope tally.Scope, serviceIdx ServiceIdx, tagFilter TagFilter) Client {
 	totalScopes := len(ScopeDefs[Common]) + len(ScopeDefs[serviceIdx])
 	metricsClient := &ClientImpl{
 		parentScope: scope,
 		childScopes: make(map[int]tally.Scope, totalScopes),
 		metricDefs:  getMetricDefs(serviceIdx),
 		serviceIdx:  serviceIdx,
+		tagFilter:   tagFilter,
 	}
 
 	for idx, def := range ScopeDefs[Common] {
@@ -104,7 +112,7 @@ func (m *ClientImpl) UpdateGauge(scopeIdx int, gaugeIdx int, value float64) {
 // Scope return a new internal metrics scope that can be used to add additional
 // information to the metrics emitted
 func (m *ClientImpl) Scope(scopeIdx int, tags ...Tag) Scope {
-	return newMetricsScope(m.childScopes[scopeIdx], m.metricDefs).Tagged(tags...)
+	return newMetricsScope(m.childScopes[scopeIdx], m.metricDefs, m.tagFilter, "").Tagged(tags...)
 }
 
 func getMetricDefs(serviceIdx ServiceIdx) map[int]metricDefinition {
@@ -156,6 +156,22 @@ const (
 	PersistenceCompleteTransferTaskScope
 	// PersistenceRangeCompleteTransferTaskScope tracks CompleteTransferTasks calls made by service to persistence layer
 	PersistenceRangeCompleteTransferTaskScope
+	// PersistencePutTransferDLQTaskScope tracks PutTransferDLQTask calls made by service to persistence layer
+	PersistencePutTransferDLQTaskScope
+	// PersistenceGetTransferDLQTasksScope tracks GetTransferDLQTasks calls made by service to persistence layer
+	PersistenceGetTransferDLQTasksScope
+	// PersistenceRangeDeleteTransferDLQTasksScope tracks RangeDeleteTransferDLQTasks calls made by service to persistence layer
+	PersistenceRangeDeleteTransferDLQTasksScope
+	// PersistencePutReplicationDLQTaskScope tracks PutReplicationDLQTask calls made by service to persistence layer
+	PersistencePutReplicationDLQTaskScope
+	// PersistenceGetReplicationDLQTasksScope tracks GetReplicationDLQTasks calls made by service to persistence layer
+	PersistenceGetReplicationDLQTasksScope
+	// PersistenceRangeDeleteReplicationDLQTasksScope tracks RangeDeleteReplicationDLQTasks calls made by service to persistence layer
+	PersistenceRangeDeleteReplicationDLQTasksScope
+	// PersistencePutWorkflowExecutionAnnotationScope tracks PutWorkflowExecutionAnnotation calls made by service to persistence layer
+	PersistencePutWorkflowExecutionAnnotationScope
+	// PersistenceGetWorkflowExecutionAnnotationsScope tracks GetWorkflowExecutionAnnotations calls made by service to persistence layer
+	PersistenceGetWorkflowExecutionAnnotationsScope
 	// PersistenceCompleteReplicationTaskScope tracks CompleteReplicationTasks calls made by service to persistence layer
 	PersistenceCompleteReplicationTaskScope
 	// PersistenceGetTimerIndexTasksScope tracks GetTimerIndexTasks calls made by service to persistence layer
@@ -200,6 +216,16 @@ const (
 	PersistenceListDomainScope
 	// PersistenceGetMetadataScope tracks DeleteDomainByName calls made by service to persistence layer
 	PersistenceGetMetadataScope
+	// PersistenceInitializeImmutableClusterMetadataScope tracks InitializeImmutableClusterMetadata calls made by service to persistence layer
+	PersistenceInitializeImmutableClusterMetadataScope
+	// PersistenceGetSearchAttributesScope tracks GetSearchAttributes calls made by service to persistence layer
+	PersistenceGetSearchAttributesScope
+	// PersistenceUpdateSearchAttributesScope tracks UpdateSearchAttributes calls made by service to persistence layer
+	PersistenceUpdateSearchAttributesScope
+	// PersistenceGetDynamicConfigScope tracks GetDynamicConfig calls made by service to persistence layer
+	PersistenceGetDynamicConfigScope
+	// PersistenceUpdateDynamicConfigScope tracks UpdateDynamicConfig calls made by service to persistence layer
+	PersistenceUpdateDynamicConfigScope
 	// PersistenceRecordWorkflowExecutionStartedScope tracks RecordWorkflowExecutionStarted calls made by service to persistence layer
 	PersistenceRecordWorkflowExecutionStartedScope
 	// PersistenceRecordWorkflowExecutionClosedScope tracks RecordWorkflowExecutionClosed calls made by service to persistence layer
@@ -222,6 +248,10 @@ const (
 	PersistenceGetClosedWorkflowExecutionScope
 	// PersistenceVisibilityDeleteWorkflowExecutionScope is the metrics scope for persistence.VisibilityManager.DeleteWorkflowExecution
 	PersistenceVisibilityDeleteWorkflowExecutionScope
+	// PersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to persistence layer
+	PersistenceCountWorkflowExecutionsScope
+	// PersistenceScanWorkflowExecutionsScope tracks ScanWorkflowExecutions calls made by service to persistence layer
+	PersistenceScanWorkflowExecutionsScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientStartWorkflowExecutionScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
@@ -256,6 +286,28 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientResumeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResumeWorkflowExecutionScope
+	// HistoryClientReadTransferDLQScope tracks RPC calls to history service
+	HistoryClientReadTransferDLQScope
+	// HistoryClientMergeTransferDLQScope tracks RPC calls to history service
+	HistoryClientMergeTransferDLQScope
+	// HistoryClientPurgeTransferDLQScope tracks RPC calls to history service
+	HistoryClientPurgeTransferDLQScope
+	// HistoryClientReadReplicationDLQScope tracks RPC calls to history service
+	HistoryClientReadReplicationDLQScope
+	// HistoryClientMergeReplicationDLQScope tracks RPC calls to history service
+	HistoryClientMergeReplicationDLQScope
+	// HistoryClientPurgeReplicationDLQScope tracks RPC calls to history service
+	HistoryClientPurgeReplicationDLQScope
+	// HistoryClientRemoveTaskScope tracks RPC calls to history service
+	HistoryClientRemoveTaskScope
+	// HistoryClientCloseShardScope tracks RPC calls to history service
+	HistoryClientCloseShardScope
+	// HistoryClientAddWorkflowExecutionAnnotationScope tracks RPC calls to history service
+	HistoryClientAddWorkflowExecutionAnnotationScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -298,6 +350,10 @@ const (
 	FrontendClientGetWorkflowExecutionHistoryScope
 	// FrontendClientListClosedWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientListClosedWorkflowExecutionsScope
+	// FrontendClientCountWorkflowExecutionsScope tracks RPC calls to frontend service
+	FrontendClientCountWorkflowExecutionsScope
+	// FrontendClientScanWorkflowExecutionsScope tracks RPC calls to frontend service
+	FrontendClientScanWorkflowExecutionsScope
 	// FrontendClientListDomainsScope tracks RPC calls to frontend service
 	FrontendClientListDomainsScope
 	// FrontendClientListOpenWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -346,14 +402,46 @@ const (
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientResumeWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientResumeWorkflowExecutionScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
+	// AdminClientAddSearchAttributeScope tracks RPC calls to admin service
+	AdminClientAddSearchAttributeScope
 	// AdminClientDescribeHistoryHostScope tracks RPC calls to admin service
 	AdminClientDescribeHistoryHostScope
+	// AdminClientDescribeShardDistributionScope tracks RPC calls to admin service
+	AdminClientDescribeShardDistributionScope
 	// AdminClientDescribeWorkflowExecutionScope tracks RPC calls to admin service
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientReadTransferDLQScope tracks RPC calls to admin service
+	AdminClientReadTransferDLQScope
+	// AdminClientMergeTransferDLQScope tracks RPC calls to admin service
+	AdminClientMergeTransferDLQScope
+	// AdminClientPurgeTransferDLQScope tracks RPC calls to admin service
+	AdminClientPurgeTransferDLQScope
+	// AdminClientReadReplicationDLQScope tracks RPC calls to admin service
+	AdminClientReadReplicationDLQScope
+	// AdminClientMergeReplicationDLQScope tracks RPC calls to admin service
+	AdminClientMergeReplicationDLQScope
+	// AdminClientPurgeReplicationDLQScope tracks RPC calls to admin service
+	AdminClientPurgeReplicationDLQScope
+	// AdminClientRemoveTaskScope tracks RPC calls to admin service
+	AdminClientRemoveTaskScope
+	// AdminClientCloseShardScope tracks RPC calls to admin service
+	AdminClientCloseShardScope
+	// AdminClientAddWorkflowExecutionAnnotationScope tracks RPC calls to admin service
+	AdminClientAddWorkflowExecutionAnnotationScope
+	// AdminClientResetWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientResetWorkflowExecutionScope
+	// AdminClientUpdateDynamicConfigScope tracks RPC calls to admin service
+	AdminClientUpdateDynamicConfigScope
+	// AdminClientRollbackDynamicConfigScope tracks RPC calls to admin service
+	AdminClientRollbackDynamicConfigScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -424,6 +512,10 @@ const (
 	ElasticsearchListClosedWorkflowExecutionsByStatusScope
 	// ElasticsearchGetClosedWorkflowExecutionScope tracks GetClosedWorkflowExecution calls made by service to persistence layer
 	ElasticsearchGetClosedWorkflowExecutionScope
+	// ElasticsearchCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to persistence layer
+	ElasticsearchCountWorkflowExecutionsScope
+	// ElasticsearchScanWorkflowExecutionsScope tracks ScanWorkflowExecutions calls made by service to persistence layer
+	ElasticsearchScanWorkflowExecutionsScope
 
 	NumCommonScopes
 )
@@ -432,10 +524,38 @@ const (
 const (
 	// AdminDescribeHistoryHostScope is the metric scope for admin.AdminDescribeHistoryHostScope
 	AdminDescribeHistoryHostScope = iota + NumCommonScopes
+	// AdminAddSearchAttributeScope is the metric scope for admin.AdminAddSearchAttributeScope
+	AdminAddSearchAttributeScope
 	// AdminDescribeWorkflowExecutionScope is the metric scope for admin.AdminDescribeWorkflowExecutionScope
 	AdminDescribeWorkflowExecutionScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
+	// AdminReadTransferDLQScope is the metric scope for admin.ReadTransferDLQ
+	AdminReadTransferDLQScope
+	// AdminMergeTransferDLQScope is the metric scope for admin.MergeTransferDLQ
+	AdminMergeTransferDLQScope
+	// AdminPurgeTransferDLQScope is the metric scope for admin.PurgeTransferDLQ
+	AdminPurgeTransferDLQScope
+	// AdminReadReplicationDLQScope is the metric scope for admin.ReadReplicationDLQ
+	AdminReadReplicationDLQScope
+	// AdminMergeReplicationDLQScope is the metric scope for admin.MergeReplicationDLQ
+	AdminMergeReplicationDLQScope
+	// AdminPurgeReplicationDLQScope is the metric scope for admin.PurgeReplicationDLQ
+	AdminPurgeReplicationDLQScope
+	// AdminRemoveTaskScope is the metric scope for admin.RemoveTask
+	AdminRemoveTaskScope
+	// AdminCloseShardScope is the metric scope for admin.CloseShard
+	AdminCloseShardScope
+	// AdminAddWorkflowExecutionAnnotationScope is the metric scope for admin.AddWorkflowExecutionAnnotation
+	AdminAddWorkflowExecutionAnnotationScope
+	// AdminDescribeShardDistributionScope is the metric scope for admin.DescribeShardDistribution
+	AdminDescribeShardDistributionScope
+	// AdminResetWorkflowExecutionScope is the metric scope for admin.ResetWorkflowExecution
+	AdminResetWorkflowExecutionScope
+	// AdminUpdateDynamicConfigScope is the metric scope for admin.UpdateDynamicConfig
+	AdminUpdateDynamicConfigScope
+	// AdminRollbackDynamicConfigScope is the metric scope for admin.RollbackDynamicConfig
+	AdminRollbackDynamicConfigScope
 
 	NumAdminScopes
 )
@@ -478,12 +598,20 @@ const (
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendResumeWorkflowExecutionScope is the metric scope for frontend.ResumeWorkflowExecution
+	FrontendResumeWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
 	FrontendListOpenWorkflowExecutionsScope
 	// FrontendListClosedWorkflowExecutionsScope is the metric scope for frontend.ListClosedWorkflowExecutions
 	FrontendListClosedWorkflowExecutionsScope
+	// FrontendCountWorkflowExecutionsScope is the metric scope for frontend.CountWorkflowExecutions
+	FrontendCountWorkflowExecutionsScope
+	// FrontendScanWorkflowExecutionsScope is the metric scope for frontend.ScanWorkflowExecutions
+	FrontendScanWorkflowExecutionsScope
 	// FrontendRegisterDomainScope is the metric scope for frontend.RegisterDomain
 	FrontendRegisterDomainScope
 	// FrontendDescribeDomainScope is the metric scope for frontend.DescribeDomain
@@ -542,6 +670,28 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryResumeWorkflowExecutionScope tracks ResumeWorkflowExecution API calls received by service
+	HistoryResumeWorkflowExecutionScope
+	// HistoryReadTransferDLQScope tracks ReadTransferDLQ API calls received by service
+	HistoryReadTransferDLQScope
+	// HistoryMergeTransferDLQScope tracks MergeTransferDLQ API calls received by service
+	HistoryMergeTransferDLQScope
+	// HistoryPurgeTransferDLQScope tracks PurgeTransferDLQ API calls received by service
+	HistoryPurgeTransferDLQScope
+	// HistoryReadReplicationDLQScope tracks ReadReplicationDLQ API calls received by service
+	HistoryReadReplicationDLQScope
+	// HistoryMergeReplicationDLQScope tracks MergeReplicationDLQ API calls received by service
+	HistoryMergeReplicationDLQScope
+	// HistoryPurgeReplicationDLQScope tracks PurgeReplicationDLQ API calls received by service
+	HistoryPurgeReplicationDLQScope
+	// HistoryRemoveTaskScope tracks RemoveTask API calls received by service
+	HistoryRemoveTaskScope
+	// HistoryCloseShardScope tracks CloseShard API calls received by service
+	HistoryCloseShardScope
+	// HistoryAddWorkflowExecutionAnnotationScope tracks AddWorkflowExecutionAnnotation API calls received by service
+	HistoryAddWorkflowExecutionAnnotationScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -594,6 +744,12 @@ const (
 	TransferStandbyTaskStartChildExecutionScope
 	// TransferStandbyTaskRecordWorkflowStartedScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskRecordWorkflowStartedScope
+	// VisibilityQueueProcessorScope is the scope used by all metric emitted by visibility queue processor
+	VisibilityQueueProcessorScope
+	// VisibilityTaskRecordWorkflowStartedScope is the scope used for record workflow started task processing by visibility queue processor
+	VisibilityTaskRecordWorkflowStartedScope
+	// VisibilityTaskCloseExecutionScope is the scope used for close execution task processing by visibility queue processor
+	VisibilityTaskCloseExecutionScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerActiveQueueProcessorScope is the scope used by all metric emitted by timer queue processor
@@ -636,6 +792,8 @@ const (
 	ReplicatorTaskHistoryScope
 	// ReplicatorTaskSyncActivityScope is the scope used for sync activity by replicator queue processor
 	ReplicatorTaskSyncActivityScope
+	// ReplicatorTaskBatchScope is the scope used for publishing batches of tasks by replicator queue processor
+	ReplicatorTaskBatchScope
 	// ReplicateHistoryEventsScope is the scope used by historyReplicator API for applying events
 	ReplicateHistoryEventsScope
 	// ShardInfoScope is the scope used when updating shard info
@@ -710,6 +868,8 @@ const (
 	SyncShardTaskScope
 	// SyncActivityTaskScope is the scope used by sync activity information processing
 	SyncActivityTaskScope
+	// BatchReplicationTaskScope is the scope used by batch replication task processing
+	BatchReplicationTaskScope
 	// ESProcessorScope is scope used by all metric emitted by esProcessor
 	ESProcessorScope
 	// IndexProcessorScope is scope used by all metric emitted by index processor
@@ -770,6 +930,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
 		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
 		PersistenceRangeCompleteTransferTaskScope:                {operation: "RangeCompleteTransferTask"},
+		PersistencePutTransferDLQTaskScope:                       {operation: "PutTransferDLQTask"},
+		PersistenceGetTransferDLQTasksScope:                      {operation: "GetTransferDLQTasks"},
+		PersistenceRangeDeleteTransferDLQTasksScope:              {operation: "RangeDeleteTransferDLQTasks"},
+		PersistencePutReplicationDLQTaskScope:                    {operation: "PutReplicationDLQTask"},
+		PersistenceGetReplicationDLQTasksScope:                   {operation: "GetReplicationDLQTasks"},
+		PersistenceRangeDeleteReplicationDLQTasksScope:           {operation: "RangeDeleteReplicationDLQTasks"},
+		PersistencePutWorkflowExecutionAnnotationScope:           {operation: "PutWorkflowExecutionAnnotation"},
+		PersistenceGetWorkflowExecutionAnnotationsScope:          {operation: "GetWorkflowExecutionAnnotations"},
 		PersistenceCompleteReplicationTaskScope:                  {operation: "CompleteReplicationTask"},
 		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
@@ -792,6 +960,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteDomainByNameScope:                       {operation: "DeleteDomainByName", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceListDomainScope:                               {operation: "ListDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetMetadataScope:                              {operation: "GetMetadata", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceInitializeImmutableClusterMetadataScope:       {operation: "InitializeImmutableClusterMetadata", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetSearchAttributesScope:                      {operation: "GetSearchAttributes", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateSearchAttributesScope:                   {operation: "UpdateSearchAttributes", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetDynamicConfigScope:                         {operation: "GetDynamicConfig", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateDynamicConfigScope:                      {operation: "UpdateDynamicConfig", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
 		PersistenceRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
 		PersistenceListOpenWorkflowExecutionsScope:               {operation: "ListOpenWorkflowExecutions"},
@@ -803,6 +976,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
 		PersistenceGetClosedWorkflowExecutionScope:               {operation: "GetClosedWorkflowExecution"},
 		PersistenceVisibilityDeleteWorkflowExecutionScope:        {operation: "VisibilityDeleteWorkflowExecution"},
+		PersistenceCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
+		PersistenceScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
 		PersistenceAppendHistoryNodesScope:                       {operation: "AppendHistoryNodes", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceReadHistoryBranchScope:                        {operation: "ReadHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceForkHistoryBranchScope:                        {operation: "ForkHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
@@ -838,6 +1013,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:            {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResumeWorkflowExecutionScope:           {operation: "HistoryClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReadTransferDLQScope:                   {operation: "HistoryClientReadTransferDLQ", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientMergeTransferDLQScope:                  {operation: "HistoryClientMergeTransferDLQ", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPurgeTransferDLQScope:                  {operation: "HistoryClientPurgeTransferDLQ", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReadReplicationDLQScope:                {operation: "HistoryClientReadReplicationDLQ", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientMergeReplicationDLQScope:               {operation: "HistoryClientMergeReplicationDLQ", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPurgeReplicationDLQScope:               {operation: "HistoryClientPurgeReplicationDLQ", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRemoveTaskScope:                        {operation: "HistoryClientRemoveTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientCloseShardScope:                        {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientAddWorkflowExecutionAnnotationScope:    {operation: "HistoryClientAddWorkflowExecutionAnnotation", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
@@ -859,6 +1045,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientDescribeWorkflowExecutionScope:        {operation: "FrontendClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientGetWorkflowExecutionHistoryScope:      {operation: "FrontendClientGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListClosedWorkflowExecutionsScope:     {operation: "FrontendClientListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientCountWorkflowExecutionsScope:          {operation: "FrontendClientCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientScanWorkflowExecutionsScope:           {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListDomainsScope:                      {operation: "FrontendClientListDomains", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListOpenWorkflowExecutionsScope:       {operation: "FrontendClientListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientPollForActivityTaskScope:              {operation: "FrontendClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -883,10 +1071,26 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientSignalWorkflowExecutionScope:          {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:           {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:       {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:           {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientResumeWorkflowExecutionScope:          {operation: "FrontendClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientUpdateDomainScope:                     {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		AdminClientAddSearchAttributeScope:                  {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeShardDistributionScope:           {operation: "AdminClientDescribeShardDistribution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientReadTransferDLQScope:                     {operation: "AdminClientReadTransferDLQ", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientMergeTransferDLQScope:                    {operation: "AdminClientMergeTransferDLQ", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPurgeTransferDLQScope:                    {operation: "AdminClientPurgeTransferDLQ", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientReadReplicationDLQScope:                  {operation: "AdminClientReadReplicationDLQ", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientMergeReplicationDLQScope:                 {operation: "AdminClientMergeReplicationDLQ", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPurgeReplicationDLQScope:                 {operation: "AdminClientPurgeReplicationDLQ", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRemoveTaskScope:                          {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientCloseShardScope:                          {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientAddWorkflowExecutionAnnotationScope:      {operation: "AdminClientAddWorkflowExecutionAnnotation", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientResetWorkflowExecutionScope:              {operation: "AdminClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateDynamicConfigScope:                 {operation: "AdminClientUpdateDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRollbackDynamicConfigScope:               {operation: "AdminClientRollbackDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -907,13 +1111,29 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
 		ElasticsearchListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
 		ElasticsearchGetClosedWorkflowExecutionScope:               {operation: "GetClosedWorkflowExecution"},
+		ElasticsearchCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
+		ElasticsearchScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
 	},
 	// Frontend Scope Names
 	Frontend: {
 		// Admin API scope co-locates with with frontend
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
+		AdminAddSearchAttributeScope:             {operation: "AddSearchAttribute"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminReadTransferDLQScope:                {operation: "ReadTransferDLQ"},
+		AdminMergeTransferDLQScope:               {operation: "MergeTransferDLQ"},
+		AdminPurgeTransferDLQScope:               {operation: "PurgeTransferDLQ"},
+		AdminReadReplicationDLQScope:             {operation: "ReadReplicationDLQ"},
+		AdminMergeReplicationDLQScope:            {operation: "MergeReplicationDLQ"},
+		AdminPurgeReplicationDLQScope:            {operation: "PurgeReplicationDLQ"},
+		AdminRemoveTaskScope:                     {operation: "RemoveTask"},
+		AdminCloseShardScope:                     {operation: "CloseShard"},
+		AdminAddWorkflowExecutionAnnotationScope: {operation: "AddWorkflowExecutionAnnotation"},
+		AdminDescribeShardDistributionScope:      {operation: "DescribeShardDistribution"},
+		AdminResetWorkflowExecutionScope:         {operation: "AdminResetWorkflowExecution"},
+		AdminUpdateDynamicConfigScope:            {operation: "UpdateDynamicConfig"},
+		AdminRollbackDynamicConfigScope:          {operation: "RollbackDynamicConfig"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -933,10 +1153,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		FrontendResumeWorkflowExecutionScope:          {operation: "ResumeWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
 		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
+		FrontendCountWorkflowExecutionsScope:          {operation: "CountWorkflowExecutions"},
+		FrontendScanWorkflowExecutionsScope:           {operation: "ScanWorkflowExecutions"},
 		FrontendRegisterDomainScope:                   {operation: "RegisterDomain"},
 		FrontendDescribeDomainScope:                   {operation: "DescribeDomain"},
 		FrontendListDomainsScope:                      {operation: "ListDomain"},
@@ -965,6 +1189,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope:  {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:          {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:        {operation: "TerminateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:            {operation: "PauseWorkflowExecution"},
+		HistoryResumeWorkflowExecutionScope:           {operation: "ResumeWorkflowExecution"},
+		HistoryReadTransferDLQScope:                   {operation: "ReadTransferDLQ"},
+		HistoryMergeTransferDLQScope:                  {operation: "MergeTransferDLQ"},
+		HistoryPurgeTransferDLQScope:                  {operation: "PurgeTransferDLQ"},
+		HistoryReadReplicationDLQScope:                {operation: "ReadReplicationDLQ"},
+		HistoryMergeReplicationDLQScope:               {operation: "MergeReplicationDLQ"},
+		HistoryPurgeReplicationDLQScope:               {operation: "PurgeReplicationDLQ"},
+		HistoryRemoveTaskScope:                        {operation: "RemoveTask"},
+		HistoryCloseShardScope:                        {operation: "CloseShard"},
+		HistoryAddWorkflowExecutionAnnotationScope:    {operation: "AddWorkflowExecutionAnnotation"},
 		HistoryResetWorkflowExecutionScope:            {operation: "ResetWorkflowExecution"},
 		HistoryProcessDeleteHistoryEventScope:         {operation: "ProcessDeleteHistoryEvent"},
 		HistoryScheduleDecisionTaskScope:              {operation: "ScheduleDecisionTask"},
@@ -993,6 +1228,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferStandbyTaskSignalExecutionScope:       {operation: "TransferStandbyTaskSignalExecution"},
 		TransferStandbyTaskStartChildExecutionScope:   {operation: "TransferStandbyTaskStartChildExecution"},
 		TransferStandbyTaskRecordWorkflowStartedScope: {operation: "TransferStandbyTaskRecordWorkflowStarted"},
+		VisibilityQueueProcessorScope:                 {operation: "VisibilityQueueProcessor"},
+		VisibilityTaskRecordWorkflowStartedScope:      {operation: "VisibilityTaskRecordWorkflowStarted"},
+		VisibilityTaskCloseExecutionScope:             {operation: "VisibilityTaskCloseExecution"},
 		TimerQueueProcessorScope:                      {operation: "TimerQueueProcessor"},
 		TimerActiveQueueProcessorScope:                {operation: "TimerActiveQueueProcessor"},
 		TimerStandbyQueueProcessorScope:               {operation: "TimerStandbyQueueProcessor"},
@@ -1013,6 +1251,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryEventNotificationScope:                 {operation: "HistoryEventNotification"},
 		ReplicatorQueueProcessorScope:                 {operation: "ReplicatorQueueProcessor"},
 		ReplicatorTaskHistoryScope:                    {operation: "ReplicatorTaskHistory"},
+		ReplicatorTaskBatchScope:                      {operation: "ReplicatorTaskBatch"},
 		ReplicatorTaskSyncActivityScope:               {operation: "ReplicatorTaskSyncActivity"},
 		ReplicateHistoryEventsScope:                   {operation: "ReplicateHistoryEvents"},
 		ShardInfoScope:                                {operation: "ShardInfo"},
@@ -1049,6 +1288,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryReplicationTaskScope:        {operation: "HistoryReplicationTask"},
 		SyncShardTaskScope:                 {operation: "SyncShardTask"},
 		SyncActivityTaskScope:              {operation: "SyncActivityTask"},
+		BatchReplicationTaskScope:          {operation: "BatchReplicationTask"},
 		ESProcessorScope:                   {operation: "ESProcessor"},
 		IndexProcessorScope:                {operation: "IndexProcessor"},
 		ArchiverUploadHistoryActivityScope: {operation: "ArchiverUploadHistoryActivity"},
@@ -1089,6 +1329,7 @@ const (
 	CadenceErrContextTimeoutCounter
 	CadenceErrRetryTaskCounter
 	CadenceErrClientVersionNotSupportedCounter
+	CadenceErrUnauthorizedCounter
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -1103,6 +1344,12 @@ const (
 	PersistenceErrDomainAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceShadowWriteFailures
+	PersistenceShadowWritesDropped
+	PersistenceShadowReadFailures
+	PersistenceShadowReadMismatches
+	PersistencePayloadSize
+	PersistencePayloadCount
 
 	CadenceClientRequests
 	CadenceClientFailures
@@ -1110,10 +1357,12 @@ const (
 
 	DomainCachePrepareCallbacksLatency
 	DomainCacheCallbacksLatency
+	DomainAliasResolvedCounter
 
 	HistorySize
 	HistoryCount
 	EventBlobSize
+	EventBlobSizeExceedsLimitCounter
 
 	ArchivalConfigFailures
 
@@ -1136,6 +1385,7 @@ const (
 	TaskStandbyRetryCounter
 	TaskNotActiveCounter
 	TaskLimitExceededCounter
+	TaskMovedToDLQCounter
 	TaskBatchCompleteCounter
 	TaskProcessingLatency
 	TaskQueueLatency
@@ -1156,6 +1406,13 @@ const (
 	DecisionTypeSignalExternalWorkflowCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
+	DecisionFailureAttemptsExceededCounter
+	DecisionRetryCriticalCounter
+	DecisionScheduleToStartLatency
+	DecisionScheduleLatencyBreachCounter
+	DecisionStartToCloseLatency
+	WorkflowStartToFirstDecisionLatency
+	ReplicationTaskBatchSize
 	StaleMutableStateCounter
 	ConcurrencyUpdateFailureCounter
 	CadenceErrEventAlreadyStartedCounter
@@ -1174,6 +1431,7 @@ const (
 	ShardInfoReplicationPendingTasksTimer
 	ShardInfoTransferActivePendingTasksTimer
 	ShardInfoTransferStandbyPendingTasksTimer
+	ShardInfoVisibilityPendingTasksTimer
 	ShardInfoTimerActivePendingTasksTimer
 	ShardInfoTimerStandbyPendingTasksTimer
 	ShardInfoReplicationLagTimer
@@ -1203,13 +1461,17 @@ const (
 	BufferReplicationTaskTimer
 	UnbufferReplicationTaskTimer
 	HistoryConflictsCounter
+	HistoryConflictDiscardedEventsCounter
 	CompleteTaskFailedCounter
 	CacheRequests
 	CacheFailures
 	CacheLatency
 	CacheMissCounter
+	CacheSizeGauge
+	CacheEvictionCounter
 	AcquireLockFailedCounter
 	WorkflowContextCleared
+	BufferedEventsLimitExceededCounter
 	MutableStateSize
 	ExecutionInfoSize
 	ActivityInfoSize
@@ -1232,6 +1494,7 @@ const (
 	DeleteRequestCancelInfoCount
 	WorkflowRetryBackoffTimerCount
 	WorkflowCronBackoffTimerCount
+	DecisionRetryBackoffTimerCount
 	WorkflowCleanupDeleteCount
 	WorkflowCleanupArchiveCount
 	WorkflowCleanupNopCount
@@ -1254,9 +1517,15 @@ const (
 	ConditionFailedErrorCounter
 	RespondQueryTaskFailedCounter
 	SyncThrottleCounter
+	SyncMatchDeadlineCounter
 	BufferThrottleCounter
 	SyncMatchLatency
 	ExpiredTasksCounter
+	TaskBacklogAgeTimer
+	PollLatency
+	LoadedTaskListGauge
+	TaskListEvictedCounter
+	StartedTaskCacheHitCounter
 
 	NumMatchingMetrics
 )
@@ -1331,6 +1600,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrContextTimeoutCounter:                     {metricName: "cadence_errors_context_timeout", metricType: Counter},
 		CadenceErrRetryTaskCounter:                          {metricName: "cadence_errors_retry_task", metricType: Counter},
 		CadenceErrClientVersionNotSupportedCounter:          {metricName: "cadence_errors_client_version_not_supported", metricType: Counter},
+		CadenceErrUnauthorizedCounter:                       {metricName: "cadence_errors_unauthorized", metricType: Counter},
 		PersistenceRequests:                                 {metricName: "persistence_requests", metricType: Counter},
 		PersistenceFailures:                                 {metricName: "persistence_errors", metricType: Counter},
 		PersistenceLatency:                                  {metricName: "persistence_latency", metricType: Timer},
@@ -1345,14 +1615,22 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrDomainAlreadyExistsCounter:            {metricName: "persistence_errors_domain_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceShadowWriteFailures:                      {metricName: "persistence_shadow_write_errors", metricType: Counter},
+		PersistenceShadowWritesDropped:                      {metricName: "persistence_shadow_writes_dropped", metricType: Counter},
+		PersistenceShadowReadFailures:                       {metricName: "persistence_shadow_read_errors", metricType: Counter},
+		PersistenceShadowReadMismatches:                     {metricName: "persistence_shadow_read_mismatches", metricType: Counter},
+		PersistencePayloadSize:                              {metricName: "persistence_payload_size", metricType: Timer},
+		PersistencePayloadCount:                             {metricName: "persistence_payload_count", metricType: Timer},
 		CadenceClientRequests:                               {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                               {metricName: "cadence_client_errors", metricType: Counter},
 		CadenceClientLatency:                                {metricName: "cadence_client_latency", metricType: Timer},
 		DomainCachePrepareCallbacksLatency:                  {metricName: "domain_cache_prepare_callbacks_latency", metricType: Timer},
 		DomainCacheCallbacksLatency:                         {metricName: "domain_cache_callbacks_latency", metricType: Timer},
+		DomainAliasResolvedCounter:                          {metricName: "domain_alias_resolved", metricType: Counter},
 		HistorySize:                                         {metricName: "history_size", metricType: Timer},
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
+		EventBlobSizeExceedsLimitCounter:                    {metricName: "event_blob_size_exceeds_limit", metricType: Counter},
 		ArchivalConfigFailures:                              {metricName: "archivalconfig_failures", metricType: Counter},
 		ElasticsearchRequests:                               {metricName: "elasticsearch_requests", metricType: Counter},
 		ElasticsearchFailures:                               {metricName: "elasticsearch_errors", metricType: Counter},
@@ -1370,6 +1648,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskStandbyRetryCounter:                      {metricName: "task_errors_standby_retry_counter", metricType: Counter},
 		TaskNotActiveCounter:                         {metricName: "task_errors_not_active_counter", metricType: Counter},
 		TaskLimitExceededCounter:                     {metricName: "task_errors_limit_exceeded_counter", metricType: Counter},
+		TaskMovedToDLQCounter:                        {metricName: "task_moved_to_dlq_counter", metricType: Counter},
 		TaskProcessingLatency:                        {metricName: "task_latency_processing", metricType: Timer},
 		TaskQueueLatency:                             {metricName: "task_latency_queue", metricType: Timer},
 		TaskBatchCompleteCounter:                     {metricName: "task_batch_complete_counter", metricType: Counter},
@@ -1389,6 +1668,13 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeChildWorkflowCounter:             {metricName: "child_workflow_decision", metricType: Counter},
 		MultipleCompletionDecisionsCounter:           {metricName: "multiple_completion_decisions", metricType: Counter},
 		FailedDecisionsCounter:                       {metricName: "failed_decisions", metricType: Counter},
+		DecisionFailureAttemptsExceededCounter:       {metricName: "decision_failure_attempts_exceeded", metricType: Counter},
+		DecisionRetryCriticalCounter:                 {metricName: "decision_retry_critical", metricType: Counter},
+		DecisionScheduleToStartLatency:               {metricName: "decision_schedule_to_start_latency", metricType: Timer},
+		DecisionScheduleLatencyBreachCounter:         {metricName: "decision_schedule_latency_breach", metricType: Counter},
+		DecisionStartToCloseLatency:                  {metricName: "decision_start_to_close_latency", metricType: Timer},
+		WorkflowStartToFirstDecisionLatency:          {metricName: "workflow_start_to_first_decision_latency", metricType: Timer},
+		ReplicationTaskBatchSize:                     {metricName: "replication_task_batch_size", metricType: Timer},
 		StaleMutableStateCounter:                     {metricName: "stale_mutable_state", metricType: Counter},
 		ConcurrencyUpdateFailureCounter:              {metricName: "concurrency_update_failure", metricType: Counter},
 		CadenceErrShardOwnershipLostCounter:          {metricName: "cadence_errors_shard_ownership_lost", metricType: Counter},
@@ -1407,6 +1693,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardInfoReplicationPendingTasksTimer:        {metricName: "shardinfo_replication_pending_task", metricType: Timer},
 		ShardInfoTransferActivePendingTasksTimer:     {metricName: "shardinfo_transfer_active_pending_task", metricType: Timer},
 		ShardInfoTransferStandbyPendingTasksTimer:    {metricName: "shardinfo_transfer_standby_pending_task", metricType: Timer},
+		ShardInfoVisibilityPendingTasksTimer:         {metricName: "shardinfo_visibility_pending_task", metricType: Timer},
 		ShardInfoTimerActivePendingTasksTimer:        {metricName: "shardinfo_timer_active_pending_task", metricType: Timer},
 		ShardInfoTimerStandbyPendingTasksTimer:       {metricName: "shardinfo_timer_standby_pending_task", metricType: Timer},
 		ShardInfoReplicationLagTimer:                 {metricName: "shardinfo_replication_lag", metricType: Timer},
@@ -1436,13 +1723,17 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		BufferReplicationTaskTimer:                   {metricName: "buffer_replication_tasks", metricType: Timer},
 		UnbufferReplicationTaskTimer:                 {metricName: "unbuffer_replication_tasks", metricType: Timer},
 		HistoryConflictsCounter:                      {metricName: "history_conflicts", metricType: Counter},
+		HistoryConflictDiscardedEventsCounter:        {metricName: "history_conflict_discarded_events", metricType: Counter},
 		CompleteTaskFailedCounter:                    {metricName: "complete_task_fail_count", metricType: Counter},
 		CacheRequests:                                {metricName: "cache_requests", metricType: Counter},
 		CacheFailures:                                {metricName: "cache_errors", metricType: Counter},
 		CacheLatency:                                 {metricName: "cache_latency", metricType: Timer},
 		CacheMissCounter:                             {metricName: "cache_miss", metricType: Counter},
+		CacheSizeGauge:                               {metricName: "cache_size", metricType: Gauge},
+		CacheEvictionCounter:                         {metricName: "cache_evictions", metricType: Counter},
 		AcquireLockFailedCounter:                     {metricName: "acquire_lock_failed", metricType: Counter},
 		WorkflowContextCleared:                       {metricName: "workflow_context_cleared", metricType: Counter},
+		BufferedEventsLimitExceededCounter:           {metricName: "buffered_events_limit_exceeded", metricType: Counter},
 		MutableStateSize:                             {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                            {metricName: "execution_info_size", metricType: Timer},
 		ActivityInfoSize:                             {metricName: "activity_info_size", metricType: Timer},
@@ -1465,6 +1756,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DeleteRequestCancelInfoCount:                 {metricName: "delete_request_cancel_info", metricType: Timer},
 		WorkflowRetryBackoffTimerCount:               {metricName: "workflow_retry_backoff_timer", metricType: Counter},
 		WorkflowCronBackoffTimerCount:                {metricName: "workflow_cron_backoff_timer", metricType: Counter},
+		DecisionRetryBackoffTimerCount:               {metricName: "decision_retry_backoff_timer", metricType: Counter},
 		WorkflowCleanupDeleteCount:                   {metricName: "workflow_cleanup_delete", metricType: Counter},
 		WorkflowCleanupArchiveCount:                  {metricName: "workflow_cleanup_archive", metricType: Counter},
 		WorkflowCleanupNopCount:                      {metricName: "workflow_cleanup_nop", metricType: Counter},
@@ -1483,9 +1775,15 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ConditionFailedErrorCounter:   {metricName: "condition_failed_errors"},
 		RespondQueryTaskFailedCounter: {metricName: "respond_query_failed"},
 		SyncThrottleCounter:           {metricName: "sync_throttle_count"},
+		SyncMatchDeadlineCounter:      {metricName: "sync_match_deadline_count"},
 		BufferThrottleCounter:         {metricName: "buffer_throttle_count"},
 		ExpiredTasksCounter:           {metricName: "tasks_expired"},
 		SyncMatchLatency:              {metricName: "syncmatch_latency", metricType: Timer},
+		TaskBacklogAgeTimer:           {metricName: "task_backlog_age", metricType: Timer},
+		PollLatency:                   {metricName: "poll_latency", metricType: Timer},
+		LoadedTaskListGauge:           {metricName: "loaded_tasklists", metricType: Gauge},
+		TaskListEvictedCounter:        {metricName: "tasklists_evicted", metricType: Counter},
+		StartedTaskCacheHitCounter:    {metricName: "started_task_cache_hit", metricType: Counter},
 	},
 	Worker: {
 		ReplicatorMessages:                                     {metricName: "replicator_messages"},
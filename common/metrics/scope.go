@@ -27,17 +27,20 @@ import (
 )
 
 type metricsScope struct {
-	scope tally.Scope
-	defs  map[int]metricDefinition
+	scope     tally.Scope
+	defs      map[int]metricDefinition
+	tagFilter TagFilter
+	// domain is the value of the domain tag of this scope, used by the tag filter
+	domain string
 }
 
-func newMetricsScope(scope tally.Scope, defs map[int]metricDefinition) Scope {
-	return &metricsScope{scope, defs}
+func newMetricsScope(scope tally.Scope, defs map[int]metricDefinition, tagFilter TagFilter, domain string) Scope {
+	return &metricsScope{scope, defs, tagFilter, domain}
 }
 
 // NoopScope returns a noop scope of metrics
 func NoopScope(serviceIdx ServiceIdx) Scope {
-	return &metricsScope{tally.NoopScope, getMetricDefs(serviceIdx)}
+	return &metricsScope{scope: tally.NoopScope, defs: getMetricDefs(serviceIdx)}
 }
 
 func (m *metricsScope) IncCounter(id int) {
@@ -66,9 +69,20 @@ func (m *metricsScope) RecordTimer(id int, d time.Duration) {
 }
 
 func (m *metricsScope) Tagged(tags ...Tag) Scope {
+	domainValue := m.domain
+	for _, tag := range tags {
+		if tag.Key() == domain {
+			domainValue = tag.Value()
+		}
+	}
+
 	tagMap := make(map[string]string, len(tags))
 	for _, tag := range tags {
-		tagMap[tag.Key()] = tag.Value()
+		value := tag.Value()
+		if m.tagFilter != nil && tag.Key() != domain {
+			value = m.tagFilter.Filter(domainValue, tag.Key(), value)
+		}
+		tagMap[tag.Key()] = value
 	}
-	return newMetricsScope(m.scope.Tagged(tagMap), m.defs)
+	return newMetricsScope(m.scope.Tagged(tagMap), m.defs, m.tagFilter, domainValue)
 }
@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"fmt"
+	"sync"
+
+	farm "github.com/dgryski/go-farm"
+)
+
+const (
+	// TaskListTagName is the tag key used for task list names
+	TaskListTagName = taskList
+
+	overflowTagValuePrefix = "overflow"
+)
+
+type (
+	// TagFilter rewrites tag values before they are attached to a metrics scope,
+	// it is used to keep the number of distinct time series bounded
+	TagFilter interface {
+		// Filter returns the value to emit for the given tag of a scope tagged with the given domain,
+		// domain is empty if the scope has no domain tag
+		Filter(domain string, key string, value string) string
+	}
+
+	// tagCardinalityFilter admits at most a budgeted number of distinct values per domain for each
+	// filtered tag key, values beyond the budget are hashed into a small fixed set of overflow buckets
+	tagCardinalityFilter struct {
+		budgets         map[string]func(domain string) int
+		overflowBuckets func() int
+
+		sync.RWMutex
+		// tag key -> domain -> admitted values
+		admitted map[string]map[string]map[string]struct{}
+	}
+)
+
+// NewTagCardinalityFilter returns a TagFilter capping the number of distinct values per domain of each
+// tag key in budgets, tag keys without a budget are passed through unchanged
+func NewTagCardinalityFilter(budgets map[string]func(domain string) int, overflowBuckets func() int) TagFilter {
+	admitted := make(map[string]map[string]map[string]struct{}, len(budgets))
+	for key := range budgets {
+		admitted[key] = make(map[string]map[string]struct{})
+	}
+	return &tagCardinalityFilter{
+		budgets:         budgets,
+		overflowBuckets: overflowBuckets,
+		admitted:        admitted,
+	}
+}
+
+func (f *tagCardinalityFilter) Filter(domain string, key string, value string) string {
+	budget, ok := f.budgets[key]
+	if !ok {
+		return value
+	}
+
+	f.RLock()
+	_, ok = f.admitted[key][domain][value]
+	f.RUnlock()
+	if ok {
+		return value
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	values, ok := f.admitted[key][domain]
+	if !ok {
+		values = make(map[string]struct{})
+		f.admitted[key][domain] = values
+	}
+	if _, ok := values[value]; ok {
+		return value
+	}
+	if len(values) < budget(domain) {
+		values[value] = struct{}{}
+		return value
+	}
+	return f.overflowValue(value)
+}
+
+func (f *tagCardinalityFilter) overflowValue(value string) string {
+	buckets := f.overflowBuckets()
+	if buckets <= 1 {
+		return overflowTagValuePrefix
+	}
+	return fmt.Sprintf("%v_%v", overflowTagValuePrefix, farm.Fingerprint32([]byte(value))%uint32(buckets))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestTagCardinalityFilter(t *testing.T) {
+	filter := NewTagCardinalityFilter(
+		map[string]func(domain string) int{
+			TaskListTagName: func(domain string) int {
+				if domain == "big" {
+					return 3
+				}
+				return 1
+			},
+		},
+		func() int { return 4 },
+	)
+
+	require.Equal(t, "tl1", filter.Filter("small", TaskListTagName, "tl1"))
+	require.Equal(t, "tl1", filter.Filter("small", TaskListTagName, "tl1"))
+	overflow := filter.Filter("small", TaskListTagName, "tl2")
+	require.True(t, strings.HasPrefix(overflow, overflowTagValuePrefix+"_"))
+	require.Equal(t, overflow, filter.Filter("small", TaskListTagName, "tl2"))
+
+	// budgets are tracked per domain
+	for _, value := range []string{"tl1", "tl2", "tl3"} {
+		require.Equal(t, value, filter.Filter("big", TaskListTagName, value))
+	}
+	require.NotEqual(t, "tl4", filter.Filter("big", TaskListTagName, "tl4"))
+
+	// tags without a budget are not filtered
+	require.Equal(t, "some-cause", filter.Filter("small", cause, "some-cause"))
+}
+
+func TestScopeTaggedWithTagFilter(t *testing.T) {
+	filter := NewTagCardinalityFilter(
+		map[string]func(domain string) int{
+			TaskListTagName: func(domain string) int { return 1 },
+		},
+		func() int { return 1 },
+	)
+	client := NewClientWithTagFilter(tally.NoopScope, History, filter)
+
+	client.Scope(HistoryStartWorkflowExecutionScope, DomainTag("domain"), TaskListTag("tl1"))
+	scope := client.Scope(HistoryStartWorkflowExecutionScope, DomainTag("domain")).Tagged(TaskListTag("tl2")).(*metricsScope)
+	require.Equal(t, "domain", scope.domain)
+	require.Equal(t, overflowTagValuePrefix, filter.Filter("domain", TaskListTagName, "tl2"))
+}
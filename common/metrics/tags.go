@@ -23,6 +23,8 @@ package metrics
 const (
 	domain         = "domain"
 	domainAllValue = "all"
+	cause          = "cause"
+	taskList       = "tasklist"
 )
 
 // Tag is an interface to define metrics tags
@@ -37,6 +39,14 @@ type domainTag struct {
 
 type domainAllTag struct{}
 
+type causeTag struct {
+	value string
+}
+
+type taskListTag struct {
+	value string
+}
+
 // DomainTag returns a new domain tag
 func DomainTag(value string) Tag {
 	return domainTag{value}
@@ -66,3 +76,33 @@ func (d domainAllTag) Key() string {
 func (d domainAllTag) Value() string {
 	return domainAllValue
 }
+
+// CauseTag returns a new cause tag
+func CauseTag(value string) Tag {
+	return causeTag{value}
+}
+
+// Key returns the key of the cause tag
+func (c causeTag) Key() string {
+	return cause
+}
+
+// Value returns the value of the cause tag
+func (c causeTag) Value() string {
+	return c.value
+}
+
+// TaskListTag returns a new task list tag
+func TaskListTag(value string) Tag {
+	return taskListTag{value}
+}
+
+// Key returns the key of the task list tag
+func (d taskListTag) Key() string {
+	return taskList
+}
+
+// Value returns the value of the task list tag
+func (d taskListTag) Value() string {
+	return d.value
+}
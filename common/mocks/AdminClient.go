@@ -37,6 +37,20 @@ type AdminClient struct {
 
 var _ adminserviceclient.Interface = (*AdminClient)(nil)
 
+// AddSearchAttribute provides a mock function with given fields: ctx, request
+func (_m *AdminClient) AddSearchAttribute(ctx context.Context, request *admin.AddSearchAttributeRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.AddSearchAttributeRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DescribeHistoryHost provides a mock function with given fields: ctx, request
 func (_m *AdminClient) DescribeHistoryHost(ctx context.Context, request *shared.DescribeHistoryHostRequest, opts ...yarpc.CallOption) (*shared.DescribeHistoryHostResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -60,6 +74,29 @@ func (_m *AdminClient) DescribeHistoryHost(ctx context.Context, request *shared.
 	return r0, r1
 }
 
+// DescribeShardDistribution provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DescribeShardDistribution(ctx context.Context, request *admin.DescribeShardDistributionRequest, opts ...yarpc.CallOption) (*admin.DescribeShardDistributionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.DescribeShardDistributionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.DescribeShardDistributionRequest) *admin.DescribeShardDistributionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.DescribeShardDistributionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.DescribeShardDistributionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeWorkflowExecution provides a mock function with given fields: ctx, request
 func (_m *AdminClient) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*admin.DescribeWorkflowExecutionResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -105,3 +142,234 @@ func (_m *AdminClient) GetWorkflowExecutionRawHistory(ctx context.Context, reque
 
 	return r0, r1
 }
+
+// ReadTransferDLQ provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ReadTransferDLQ(ctx context.Context, request *shared.ReadTransferDLQRequest, opts ...yarpc.CallOption) (*shared.ReadTransferDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ReadTransferDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ReadTransferDLQRequest) *shared.ReadTransferDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ReadTransferDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ReadTransferDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ResetWorkflowExecution(ctx context.Context, request *shared.ResetWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ResetWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ResetWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ResetWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeTransferDLQ provides a mock function with given fields: ctx, request
+func (_m *AdminClient) MergeTransferDLQ(ctx context.Context, request *shared.MergeTransferDLQRequest, opts ...yarpc.CallOption) (*shared.MergeTransferDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.MergeTransferDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.MergeTransferDLQRequest) *shared.MergeTransferDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.MergeTransferDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.MergeTransferDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeTransferDLQ provides a mock function with given fields: ctx, request
+func (_m *AdminClient) PurgeTransferDLQ(ctx context.Context, request *shared.PurgeTransferDLQRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PurgeTransferDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReadReplicationDLQ provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ReadReplicationDLQ(ctx context.Context, request *shared.ReadReplicationDLQRequest, opts ...yarpc.CallOption) (*shared.ReadReplicationDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ReadReplicationDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ReadReplicationDLQRequest) *shared.ReadReplicationDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ReadReplicationDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ReadReplicationDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeReplicationDLQ provides a mock function with given fields: ctx, request
+func (_m *AdminClient) MergeReplicationDLQ(ctx context.Context, request *shared.MergeReplicationDLQRequest, opts ...yarpc.CallOption) (*shared.MergeReplicationDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.MergeReplicationDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.MergeReplicationDLQRequest) *shared.MergeReplicationDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.MergeReplicationDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.MergeReplicationDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeReplicationDLQ provides a mock function with given fields: ctx, request
+func (_m *AdminClient) PurgeReplicationDLQ(ctx context.Context, request *shared.PurgeReplicationDLQRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PurgeReplicationDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveTask provides a mock function with given fields: ctx, request
+func (_m *AdminClient) RemoveTask(ctx context.Context, request *shared.RemoveTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RemoveTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseShard provides a mock function with given fields: ctx, request
+func (_m *AdminClient) CloseShard(ctx context.Context, request *shared.CloseShardRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CloseShardRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddWorkflowExecutionAnnotation provides a mock function with given fields: ctx, request
+func (_m *AdminClient) AddWorkflowExecutionAnnotation(ctx context.Context, request *admin.AddWorkflowExecutionAnnotationRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.AddWorkflowExecutionAnnotationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDynamicConfig provides a mock function with given fields: ctx, request
+func (_m *AdminClient) UpdateDynamicConfig(ctx context.Context, request *admin.UpdateDynamicConfigRequest, opts ...yarpc.CallOption) (*admin.UpdateDynamicConfigResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.UpdateDynamicConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.UpdateDynamicConfigRequest) *admin.UpdateDynamicConfigResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.UpdateDynamicConfigResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.UpdateDynamicConfigRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackDynamicConfig provides a mock function with given fields: ctx, request
+func (_m *AdminClient) RollbackDynamicConfig(ctx context.Context, request *admin.RollbackDynamicConfigRequest, opts ...yarpc.CallOption) (*admin.RollbackDynamicConfigResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.RollbackDynamicConfigResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.RollbackDynamicConfigRequest) *admin.RollbackDynamicConfigResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.RollbackDynamicConfigResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.RollbackDynamicConfigRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -20,6 +20,7 @@
 
 package mocks
 
+import "context"
 import "github.com/uber/cadence/common/persistence"
 import "github.com/stretchr/testify/mock"
 
@@ -56,13 +57,13 @@ func (_m *ExecutionManager) GetShardID() int {
 	return r0
 }
 
-// CreateWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
-	ret := _m.Called(request)
+// CreateWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.CreateWorkflowExecutionResponse
-	if rf, ok := ret.Get(0).(func(*persistence.CreateWorkflowExecutionRequest) *persistence.CreateWorkflowExecutionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CreateWorkflowExecutionRequest) *persistence.CreateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.CreateWorkflowExecutionResponse)
@@ -70,8 +71,8 @@ func (_m *ExecutionManager) CreateWorkflowExecution(request *persistence.CreateW
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.CreateWorkflowExecutionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.CreateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,13 +80,13 @@ func (_m *ExecutionManager) CreateWorkflowExecution(request *persistence.CreateW
 	return r0, r1
 }
 
-// GetWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) GetWorkflowExecution(request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
-	ret := _m.Called(request)
+// GetWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetWorkflowExecution(ctx context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetWorkflowExecutionResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetWorkflowExecutionRequest) *persistence.GetWorkflowExecutionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetWorkflowExecutionRequest) *persistence.GetWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetWorkflowExecutionResponse)
@@ -93,8 +94,8 @@ func (_m *ExecutionManager) GetWorkflowExecution(request *persistence.GetWorkflo
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetWorkflowExecutionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -102,13 +103,13 @@ func (_m *ExecutionManager) GetWorkflowExecution(request *persistence.GetWorkflo
 	return r0, r1
 }
 
-// UpdateWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
-	ret := _m.Called(request)
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.UpdateWorkflowExecutionResponse
-	if rf, ok := ret.Get(0).(func(*persistence.UpdateWorkflowExecutionRequest) *persistence.UpdateWorkflowExecutionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.UpdateWorkflowExecutionRequest) *persistence.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.UpdateWorkflowExecutionResponse)
@@ -116,8 +117,8 @@ func (_m *ExecutionManager) UpdateWorkflowExecution(request *persistence.UpdateW
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.UpdateWorkflowExecutionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -125,13 +126,13 @@ func (_m *ExecutionManager) UpdateWorkflowExecution(request *persistence.UpdateW
 	return r0, r1
 }
 
-// ResetMutableState provides a mock function with given fields: request
-func (_m *ExecutionManager) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
-	ret := _m.Called(request)
+// ResetMutableState provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) ResetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.ResetMutableStateRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.ResetMutableStateRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -139,13 +140,13 @@ func (_m *ExecutionManager) ResetMutableState(request *persistence.ResetMutableS
 	return r0
 }
 
-// ResetWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
-	ret := _m.Called(request)
+// ResetWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) ResetWorkflowExecution(ctx context.Context, request *persistence.ResetWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.ResetWorkflowExecutionRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.ResetWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -153,13 +154,13 @@ func (_m *ExecutionManager) ResetWorkflowExecution(request *persistence.ResetWor
 	return r0
 }
 
-// DeleteWorkflowExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) DeleteWorkflowExecution(request *persistence.DeleteWorkflowExecutionRequest) error {
-	ret := _m.Called(request)
+// DeleteWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *persistence.DeleteWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.DeleteWorkflowExecutionRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -167,13 +168,13 @@ func (_m *ExecutionManager) DeleteWorkflowExecution(request *persistence.DeleteW
 	return r0
 }
 
-// GetCurrentExecution provides a mock function with given fields: request
-func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
-	ret := _m.Called(request)
+// GetCurrentExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetCurrentExecution(ctx context.Context, request *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetCurrentExecutionResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetCurrentExecutionRequest) *persistence.GetCurrentExecutionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetCurrentExecutionRequest) *persistence.GetCurrentExecutionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetCurrentExecutionResponse)
@@ -181,8 +182,8 @@ func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentE
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetCurrentExecutionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetCurrentExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -190,13 +191,13 @@ func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentE
 	return r0, r1
 }
 
-// GetTransferTasks provides a mock function with given fields: request
-func (_m *ExecutionManager) GetTransferTasks(request *persistence.GetTransferTasksRequest) (*persistence.GetTransferTasksResponse, error) {
-	ret := _m.Called(request)
+// GetTransferTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetTransferTasks(ctx context.Context, request *persistence.GetTransferTasksRequest) (*persistence.GetTransferTasksResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetTransferTasksResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetTransferTasksRequest) *persistence.GetTransferTasksResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetTransferTasksRequest) *persistence.GetTransferTasksResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetTransferTasksResponse)
@@ -204,8 +205,8 @@ func (_m *ExecutionManager) GetTransferTasks(request *persistence.GetTransferTas
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetTransferTasksRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetTransferTasksRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -213,13 +214,13 @@ func (_m *ExecutionManager) GetTransferTasks(request *persistence.GetTransferTas
 	return r0, r1
 }
 
-// CompleteTransferTask provides a mock function with given fields: request
-func (_m *ExecutionManager) CompleteTransferTask(request *persistence.CompleteTransferTaskRequest) error {
-	ret := _m.Called(request)
+// CompleteTransferTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) CompleteTransferTask(ctx context.Context, request *persistence.CompleteTransferTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteTransferTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteTransferTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -227,13 +228,13 @@ func (_m *ExecutionManager) CompleteTransferTask(request *persistence.CompleteTr
 	return r0
 }
 
-// RangeCompleteTransferTask provides a mock function with given fields: request
-func (_m *ExecutionManager) RangeCompleteTransferTask(request *persistence.RangeCompleteTransferTaskRequest) error {
-	ret := _m.Called(request)
+// RangeCompleteTransferTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) RangeCompleteTransferTask(ctx context.Context, request *persistence.RangeCompleteTransferTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.RangeCompleteTransferTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RangeCompleteTransferTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -241,13 +242,152 @@ func (_m *ExecutionManager) RangeCompleteTransferTask(request *persistence.Range
 	return r0
 }
 
-// GetReplicationTasks provides a mock function with given fields: request
-func (_m *ExecutionManager) GetReplicationTasks(request *persistence.GetReplicationTasksRequest) (*persistence.GetReplicationTasksResponse, error) {
-	ret := _m.Called(request)
+// PutTransferDLQTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) PutTransferDLQTask(ctx context.Context, request *persistence.PutTransferDLQTaskRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.PutTransferDLQTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTransferDLQTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetTransferDLQTasks(ctx context.Context, request *persistence.GetTransferDLQTasksRequest) (*persistence.GetTransferDLQTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *persistence.GetTransferDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetTransferDLQTasksRequest) *persistence.GetTransferDLQTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetTransferDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetTransferDLQTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RangeDeleteTransferDLQTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) RangeDeleteTransferDLQTasks(ctx context.Context, request *persistence.RangeDeleteTransferDLQTasksRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RangeDeleteTransferDLQTasksRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutReplicationDLQTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) PutReplicationDLQTask(ctx context.Context, request *persistence.PutReplicationDLQTaskRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.PutReplicationDLQTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReplicationDLQTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetReplicationDLQTasks(ctx context.Context, request *persistence.GetReplicationDLQTasksRequest) (*persistence.GetReplicationDLQTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *persistence.GetReplicationDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetReplicationDLQTasksRequest) *persistence.GetReplicationDLQTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetReplicationDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetReplicationDLQTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RangeDeleteReplicationDLQTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) RangeDeleteReplicationDLQTasks(ctx context.Context, request *persistence.RangeDeleteReplicationDLQTasksRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RangeDeleteReplicationDLQTasksRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutWorkflowExecutionAnnotation provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) PutWorkflowExecutionAnnotation(ctx context.Context, request *persistence.PutWorkflowExecutionAnnotationRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.PutWorkflowExecutionAnnotationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetWorkflowExecutionAnnotations provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetWorkflowExecutionAnnotations(ctx context.Context, request *persistence.GetWorkflowExecutionAnnotationsRequest) (*persistence.GetWorkflowExecutionAnnotationsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *persistence.GetWorkflowExecutionAnnotationsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetWorkflowExecutionAnnotationsRequest) *persistence.GetWorkflowExecutionAnnotationsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetWorkflowExecutionAnnotationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetWorkflowExecutionAnnotationsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplicationTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetReplicationTasks(ctx context.Context, request *persistence.GetReplicationTasksRequest) (*persistence.GetReplicationTasksResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetReplicationTasksResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetReplicationTasksRequest) *persistence.GetReplicationTasksResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetReplicationTasksRequest) *persistence.GetReplicationTasksResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetReplicationTasksResponse)
@@ -255,8 +395,8 @@ func (_m *ExecutionManager) GetReplicationTasks(request *persistence.GetReplicat
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetReplicationTasksRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetReplicationTasksRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -264,13 +404,13 @@ func (_m *ExecutionManager) GetReplicationTasks(request *persistence.GetReplicat
 	return r0, r1
 }
 
-// CompleteReplicationTask provides a mock function with given fields: request
-func (_m *ExecutionManager) CompleteReplicationTask(request *persistence.CompleteReplicationTaskRequest) error {
-	ret := _m.Called(request)
+// CompleteReplicationTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) CompleteReplicationTask(ctx context.Context, request *persistence.CompleteReplicationTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteReplicationTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteReplicationTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -278,13 +418,13 @@ func (_m *ExecutionManager) CompleteReplicationTask(request *persistence.Complet
 	return r0
 }
 
-// GetTimerIndexTasks provides a mock function with given fields: request
-func (_m *ExecutionManager) GetTimerIndexTasks(request *persistence.GetTimerIndexTasksRequest) (*persistence.GetTimerIndexTasksResponse, error) {
-	ret := _m.Called(request)
+// GetTimerIndexTasks provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) GetTimerIndexTasks(ctx context.Context, request *persistence.GetTimerIndexTasksRequest) (*persistence.GetTimerIndexTasksResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetTimerIndexTasksResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetTimerIndexTasksRequest) *persistence.GetTimerIndexTasksResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetTimerIndexTasksRequest) *persistence.GetTimerIndexTasksResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetTimerIndexTasksResponse)
@@ -292,8 +432,8 @@ func (_m *ExecutionManager) GetTimerIndexTasks(request *persistence.GetTimerInde
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetTimerIndexTasksRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetTimerIndexTasksRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -301,13 +441,13 @@ func (_m *ExecutionManager) GetTimerIndexTasks(request *persistence.GetTimerInde
 	return r0, r1
 }
 
-// CompleteTimerTask provides a mock function with given fields: request
-func (_m *ExecutionManager) CompleteTimerTask(request *persistence.CompleteTimerTaskRequest) error {
-	ret := _m.Called(request)
+// CompleteTimerTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) CompleteTimerTask(ctx context.Context, request *persistence.CompleteTimerTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteTimerTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteTimerTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -315,13 +455,13 @@ func (_m *ExecutionManager) CompleteTimerTask(request *persistence.CompleteTimer
 	return r0
 }
 
-// RangeCompleteTimerTask provides a mock function with given fields: request
-func (_m *ExecutionManager) RangeCompleteTimerTask(request *persistence.RangeCompleteTimerTaskRequest) error {
-	ret := _m.Called(request)
+// RangeCompleteTimerTask provides a mock function with given fields: ctx, request
+func (_m *ExecutionManager) RangeCompleteTimerTask(ctx context.Context, request *persistence.RangeCompleteTimerTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.RangeCompleteTimerTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.RangeCompleteTimerTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -38,6 +38,36 @@ type FrontendClient struct {
 
 var _ workflowserviceclient.Interface = (*FrontendClient)(nil)
 
+// CountWorkflowExecutions provides a mock function with given fields: ctx, CountRequest, opts
+func (_m *FrontendClient) CountWorkflowExecutions(ctx context.Context, CountRequest *shared.CountWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*shared.CountWorkflowExecutionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, CountRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.CountWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CountWorkflowExecutionsRequest, ...yarpc.CallOption) *shared.CountWorkflowExecutionsResponse); ok {
+		r0 = rf(ctx, CountRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.CountWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.CountWorkflowExecutionsRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, CountRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeprecateDomain provides a mock function with given fields: ctx, DeprecateRequest, opts
 func (_m *FrontendClient) DeprecateDomain(ctx context.Context, DeprecateRequest *shared.DeprecateDomainRequest, opts ...yarpc.CallOption) error {
 	_va := make([]interface{}, len(opts))
@@ -269,6 +299,27 @@ func (_m *FrontendClient) ListOpenWorkflowExecutions(ctx context.Context, ListRe
 	return r0, r1
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, PauseRequest, opts
+func (_m *FrontendClient) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, PauseRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PauseWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, PauseRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PollForActivityTask provides a mock function with given fields: ctx, PollRequest, opts
 func (_m *FrontendClient) PollForActivityTask(ctx context.Context, PollRequest *shared.PollForActivityTaskRequest, opts ...yarpc.CallOption) (*shared.PollForActivityTaskResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -719,6 +770,57 @@ func (_m *FrontendClient) RespondQueryTaskCompleted(ctx context.Context, Complet
 	return r0
 }
 
+// ResumeWorkflowExecution provides a mock function with given fields: ctx, ResumeRequest, opts
+func (_m *FrontendClient) ResumeWorkflowExecution(ctx context.Context, ResumeRequest *shared.ResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ResumeRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ResumeWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, ResumeRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScanWorkflowExecutions provides a mock function with given fields: ctx, ScanRequest, opts
+func (_m *FrontendClient) ScanWorkflowExecutions(ctx context.Context, ScanRequest *shared.ScanWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*shared.ScanWorkflowExecutionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ScanRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.ScanWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ScanWorkflowExecutionsRequest, ...yarpc.CallOption) *shared.ScanWorkflowExecutionsResponse); ok {
+		r0 = rf(ctx, ScanRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ScanWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ScanWorkflowExecutionsRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, ScanRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignalWithStartWorkflowExecution provides a mock function with given fields: ctx, SignalWithStartRequest, opts
 func (_m *FrontendClient) SignalWithStartWorkflowExecution(ctx context.Context, SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.StartWorkflowExecutionResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -405,6 +405,48 @@ func (_m *HistoryClient) TerminateWorkflowExecution(ctx context.Context, termina
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, pauseRequest
+func (_m *HistoryClient) PauseWorkflowExecution(ctx context.Context, pauseRequest *history.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, pauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, pauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution provides a mock function with given fields: ctx, resumeRequest
+func (_m *HistoryClient) ResumeWorkflowExecution(ctx context.Context, resumeRequest *history.ResumeWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, resumeRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, resumeRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddWorkflowExecutionAnnotation provides a mock function with given fields: ctx, annotationRequest
+func (_m *HistoryClient) AddWorkflowExecutionAnnotation(ctx context.Context, annotationRequest *history.AddWorkflowExecutionAnnotationRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, annotationRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.AddWorkflowExecutionAnnotationRequest) error); ok {
+		r0 = rf(ctx, annotationRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetWorkflowExecution provides a mock function with given fields: ctx, terminateRequest
 func (_m *HistoryClient) ResetWorkflowExecution(ctx context.Context, resetRequest *history.ResetWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
 	ret := _m.Called(ctx, resetRequest)
@@ -511,3 +553,151 @@ func (_m *HistoryClient) SyncActivity(ctx context.Context, request *history.Sync
 
 	return r0
 }
+
+// ReadTransferDLQ provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ReadTransferDLQ(ctx context.Context, request *shared.ReadTransferDLQRequest, opts ...yarpc.CallOption) (*shared.ReadTransferDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ReadTransferDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ReadTransferDLQRequest) *shared.ReadTransferDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ReadTransferDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ReadTransferDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeTransferDLQ provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) MergeTransferDLQ(ctx context.Context, request *shared.MergeTransferDLQRequest, opts ...yarpc.CallOption) (*shared.MergeTransferDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.MergeTransferDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.MergeTransferDLQRequest) *shared.MergeTransferDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.MergeTransferDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.MergeTransferDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeTransferDLQ provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) PurgeTransferDLQ(ctx context.Context, request *shared.PurgeTransferDLQRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PurgeTransferDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReadReplicationDLQ provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ReadReplicationDLQ(ctx context.Context, request *shared.ReadReplicationDLQRequest, opts ...yarpc.CallOption) (*shared.ReadReplicationDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ReadReplicationDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ReadReplicationDLQRequest) *shared.ReadReplicationDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ReadReplicationDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ReadReplicationDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeReplicationDLQ provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) MergeReplicationDLQ(ctx context.Context, request *shared.MergeReplicationDLQRequest, opts ...yarpc.CallOption) (*shared.MergeReplicationDLQResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.MergeReplicationDLQResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.MergeReplicationDLQRequest) *shared.MergeReplicationDLQResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.MergeReplicationDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.MergeReplicationDLQRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeReplicationDLQ provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) PurgeReplicationDLQ(ctx context.Context, request *shared.PurgeReplicationDLQRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PurgeReplicationDLQRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RemoveTask(ctx context.Context, request *shared.RemoveTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RemoveTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) CloseShard(ctx context.Context, request *shared.CloseShardRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CloseShardRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
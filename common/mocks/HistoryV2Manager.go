@@ -20,6 +20,7 @@
 
 package mocks
 
+import "context"
 import "github.com/uber/cadence/common/persistence"
 import "github.com/stretchr/testify/mock"
 
@@ -42,12 +43,12 @@ func (_m *HistoryV2Manager) GetName() string {
 	return r0
 }
 
-// AppendHistoryNodes provides a mock function with given fields: request
-func (_m *HistoryV2Manager) AppendHistoryNodes(request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
-	ret := _m.Called(request)
+// AppendHistoryNodes provides a mock function with given fields: ctx, request
+func (_m *HistoryV2Manager) AppendHistoryNodes(ctx context.Context, request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+	ret := _m.Called(ctx, request)
 	var r0 *persistence.AppendHistoryNodesResponse
-	if rf, ok := ret.Get(0).(func(*persistence.AppendHistoryNodesRequest) *persistence.AppendHistoryNodesResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.AppendHistoryNodesRequest) *persistence.AppendHistoryNodesResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.AppendHistoryNodesResponse)
@@ -55,112 +56,112 @@ func (_m *HistoryV2Manager) AppendHistoryNodes(request *persistence.AppendHistor
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.AppendHistoryNodesRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.AppendHistoryNodesRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// ReadHistoryBranch provides a mock function with given fields: request
-func (_m *HistoryV2Manager) ReadHistoryBranch(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
-	ret := _m.Called(request)
+// ReadHistoryBranch provides a mock function with given fields: ctx, request
+func (_m *HistoryV2Manager) ReadHistoryBranch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+	ret := _m.Called(ctx, request)
 	var r0 *persistence.ReadHistoryBranchResponse
-	if rf, ok := ret.Get(0).(func(*persistence.ReadHistoryBranchRequest) *persistence.ReadHistoryBranchResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.ReadHistoryBranchRequest) *persistence.ReadHistoryBranchResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.ReadHistoryBranchResponse)
 		}
 	}
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.ReadHistoryBranchRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.ReadHistoryBranchRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// ReadHistoryBranchByBatch provides a mock function with given fields: request
-func (_m *HistoryV2Manager) ReadHistoryBranchByBatch(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchByBatchResponse, error) {
-	ret := _m.Called(request)
+// ReadHistoryBranchByBatch provides a mock function with given fields: ctx, request
+func (_m *HistoryV2Manager) ReadHistoryBranchByBatch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	ret := _m.Called(ctx, request)
 	var r0 *persistence.ReadHistoryBranchByBatchResponse
-	if rf, ok := ret.Get(0).(func(*persistence.ReadHistoryBranchRequest) *persistence.ReadHistoryBranchByBatchResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.ReadHistoryBranchRequest) *persistence.ReadHistoryBranchByBatchResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.ReadHistoryBranchByBatchResponse)
 		}
 	}
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.ReadHistoryBranchRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.ReadHistoryBranchRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// ForkHistoryBranch provides a mock function with given fields: request
-func (_m *HistoryV2Manager) ForkHistoryBranch(request *persistence.ForkHistoryBranchRequest) (*persistence.ForkHistoryBranchResponse, error) {
-	ret := _m.Called(request)
+// ForkHistoryBranch provides a mock function with given fields: ctx, request
+func (_m *HistoryV2Manager) ForkHistoryBranch(ctx context.Context, request *persistence.ForkHistoryBranchRequest) (*persistence.ForkHistoryBranchResponse, error) {
+	ret := _m.Called(ctx, request)
 	var r0 *persistence.ForkHistoryBranchResponse
-	if rf, ok := ret.Get(0).(func(*persistence.ForkHistoryBranchRequest) *persistence.ForkHistoryBranchResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.ForkHistoryBranchRequest) *persistence.ForkHistoryBranchResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.ForkHistoryBranchResponse)
 		}
 	}
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.ForkHistoryBranchRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.ForkHistoryBranchRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// DeleteHistoryBranch provides a mock function with given fields: request
-func (_m *HistoryV2Manager) DeleteHistoryBranch(request *persistence.DeleteHistoryBranchRequest) error {
-	ret := _m.Called(request)
+// DeleteHistoryBranch provides a mock function with given fields: ctx, request
+func (_m *HistoryV2Manager) DeleteHistoryBranch(ctx context.Context, request *persistence.DeleteHistoryBranchRequest) error {
+	ret := _m.Called(ctx, request)
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.DeleteHistoryBranchRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.DeleteHistoryBranchRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// CompleteForkBranch provides a mock function with given fields: request
-func (_m *HistoryV2Manager) CompleteForkBranch(request *persistence.CompleteForkBranchRequest) error {
-	ret := _m.Called(request)
+// CompleteForkBranch provides a mock function with given fields: ctx, request
+func (_m *HistoryV2Manager) CompleteForkBranch(ctx context.Context, request *persistence.CompleteForkBranchRequest) error {
+	ret := _m.Called(ctx, request)
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteForkBranchRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteForkBranchRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// GetHistoryTree provides a mock function with given fields: request
-func (_m *HistoryV2Manager) GetHistoryTree(request *persistence.GetHistoryTreeRequest) (*persistence.GetHistoryTreeResponse, error) {
-	ret := _m.Called(request)
+// GetHistoryTree provides a mock function with given fields: ctx, request
+func (_m *HistoryV2Manager) GetHistoryTree(ctx context.Context, request *persistence.GetHistoryTreeRequest) (*persistence.GetHistoryTreeResponse, error) {
+	ret := _m.Called(ctx, request)
 	var r0 *persistence.GetHistoryTreeResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetHistoryTreeRequest) *persistence.GetHistoryTreeResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetHistoryTreeRequest) *persistence.GetHistoryTreeResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.GetHistoryTreeResponse)
 		}
 	}
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetHistoryTreeRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetHistoryTreeRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
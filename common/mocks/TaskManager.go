@@ -20,6 +20,7 @@
 
 package mocks
 
+import "context"
 import "github.com/uber/cadence/common/persistence"
 import "github.com/stretchr/testify/mock"
 
@@ -47,20 +48,20 @@ func (_m *TaskManager) Close() {
 	_m.Called()
 }
 
-// LeaseTaskList provides a mock function with given fields: request
-func (_m *TaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
-	ret := _m.Called(request)
+// LeaseTaskList provides a mock function with given fields: ctx, request
+func (_m *TaskManager) LeaseTaskList(ctx context.Context, request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.LeaseTaskListResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error)); ok {
-		return rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error)); ok {
+		return rf(ctx, request)
 	} else if ret.Get(0) != nil {
 		r0 = ret.Get(0).(*persistence.LeaseTaskListResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(*persistence.LeaseTaskListRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.LeaseTaskListRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,13 +69,13 @@ func (_m *TaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest)
 	return r0, r1
 }
 
-// UpdateTaskList provides a mock function with given fields: request
-func (_m *TaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
-	ret := _m.Called(request)
+// UpdateTaskList provides a mock function with given fields: ctx, request
+func (_m *TaskManager) UpdateTaskList(ctx context.Context, request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.UpdateTaskListResponse
-	if rf, ok := ret.Get(0).(func(*persistence.UpdateTaskListRequest) *persistence.UpdateTaskListResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.UpdateTaskListRequest) *persistence.UpdateTaskListResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.UpdateTaskListResponse)
@@ -82,8 +83,8 @@ func (_m *TaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.UpdateTaskListRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.UpdateTaskListRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -91,13 +92,13 @@ func (_m *TaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest
 	return r0, r1
 }
 
-// CompleteTask provides a mock function with given fields: request
-func (_m *TaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
-	ret := _m.Called(request)
+// CompleteTask provides a mock function with given fields: ctx, request
+func (_m *TaskManager) CompleteTask(ctx context.Context, request *persistence.CompleteTaskRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteTaskRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteTaskRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -106,12 +107,12 @@ func (_m *TaskManager) CompleteTask(request *persistence.CompleteTaskRequest) er
 }
 
 // CompleteTasksLessThan
-func (_m *TaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
-	ret := _m.Called(request)
+func (_m *TaskManager) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (int, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 int
-	if rf, ok := ret.Get(0).(func(*persistence.CompleteTasksLessThanRequest) int); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CompleteTasksLessThanRequest) int); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(int)
@@ -119,8 +120,8 @@ func (_m *TaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksL
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.CompleteTasksLessThanRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.CompleteTasksLessThanRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -128,12 +129,12 @@ func (_m *TaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksL
 	return r0, r1
 }
 
-func (_m *TaskManager) ListTaskList(request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
-	ret := _m.Called(request)
+func (_m *TaskManager) ListTaskList(ctx context.Context, request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.ListTaskListResponse
-	if rf, ok := ret.Get(0).(func(request *persistence.ListTaskListRequest) *persistence.ListTaskListResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(ctx context.Context, request *persistence.ListTaskListRequest) *persistence.ListTaskListResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.ListTaskListResponse)
@@ -141,8 +142,8 @@ func (_m *TaskManager) ListTaskList(request *persistence.ListTaskListRequest) (*
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.ListTaskListRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.ListTaskListRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,12 +151,12 @@ func (_m *TaskManager) ListTaskList(request *persistence.ListTaskListRequest) (*
 	return r0, r1
 }
 
-func (_m *TaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
-	ret := _m.Called(request)
+func (_m *TaskManager) DeleteTaskList(ctx context.Context, request *persistence.DeleteTaskListRequest) error {
+	ret := _m.Called(ctx, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.DeleteTaskListRequest) error); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.DeleteTaskListRequest) error); ok {
+		r0 = rf(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -163,13 +164,13 @@ func (_m *TaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest
 	return r0
 }
 
-// CreateTasks provides a mock function with given fields: request
-func (_m *TaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
-	ret := _m.Called(request)
+// CreateTasks provides a mock function with given fields: ctx, request
+func (_m *TaskManager) CreateTasks(ctx context.Context, request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.CreateTasksResponse
-	if rf, ok := ret.Get(0).(func(*persistence.CreateTasksRequest) *persistence.CreateTasksResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.CreateTasksRequest) *persistence.CreateTasksResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*persistence.CreateTasksResponse)
@@ -177,8 +178,8 @@ func (_m *TaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*pe
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.CreateTasksRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.CreateTasksRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -186,20 +187,20 @@ func (_m *TaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*pe
 	return r0, r1
 }
 
-// GetTasks provides a mock function with given fields: request
-func (_m *TaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
-	ret := _m.Called(request)
+// GetTasks provides a mock function with given fields: ctx, request
+func (_m *TaskManager) GetTasks(ctx context.Context, request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *persistence.GetTasksResponse
-	if rf, ok := ret.Get(0).(func(*persistence.GetTasksRequest) *persistence.GetTasksResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *persistence.GetTasksRequest) *persistence.GetTasksResponse); ok {
+		r0 = rf(ctx, request)
 	} else if ret.Get(0) != nil {
 		r0 = ret.Get(0).(*persistence.GetTasksResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*persistence.GetTasksRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *persistence.GetTasksRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,225 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const constMetadataPartition = 0
+
+const (
+	templateInitImmutableClusterMetadata = `INSERT INTO cluster_metadata ` +
+		`(metadata_partition, cluster_name, history_shard_count, initial_failover_version) ` +
+		`VALUES(?, ?, ?, ?) IF NOT EXISTS`
+
+	templateGetSearchAttributes = `SELECT search_attributes, search_attributes_version ` +
+		`FROM cluster_metadata ` +
+		`WHERE metadata_partition = ?`
+
+	templateUpdateSearchAttributes = `UPDATE cluster_metadata ` +
+		`SET search_attributes = ?, search_attributes_version = ? ` +
+		`WHERE metadata_partition = ? ` +
+		`IF search_attributes_version = ?`
+
+	templateGetDynamicConfig = `SELECT dynamic_config, dynamic_config_version ` +
+		`FROM cluster_metadata ` +
+		`WHERE metadata_partition = ?`
+
+	templateUpdateDynamicConfig = `UPDATE cluster_metadata ` +
+		`SET dynamic_config = ?, dynamic_config_version = ? ` +
+		`WHERE metadata_partition = ? ` +
+		`IF dynamic_config_version = ?`
+)
+
+type (
+	cassandraClusterMetadata struct {
+		cassandraStore
+	}
+)
+
+// newClusterMetadataInstance is used to create an instance of ClusterMetadataStore implementation
+func newClusterMetadataInstance(cfg config.Cassandra, logger log.Logger) (p.ClusterMetadataStore, error) {
+	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassandraClusterMetadata{
+		cassandraStore: cassandraStore{session: session, logger: logger},
+	}, nil
+}
+
+func (m *cassandraClusterMetadata) InitializeImmutableClusterMetadata(
+	request *p.InitializeImmutableClusterMetadataRequest,
+) (*p.InitializeImmutableClusterMetadataResponse, error) {
+
+	query := m.session.Query(templateInitImmutableClusterMetadata,
+		constMetadataPartition,
+		request.ClusterName,
+		request.HistoryShardCount,
+		request.InitialFailoverVersion,
+	)
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("InitializeImmutableClusterMetadata operation failed. Error: %v", err),
+		}
+	}
+	if applied {
+		return &p.InitializeImmutableClusterMetadataResponse{
+			PersistedImmutableData: request.ImmutableClusterMetadata,
+			RequestApplied:         true,
+		}, nil
+	}
+
+	return &p.InitializeImmutableClusterMetadataResponse{
+		PersistedImmutableData: p.ImmutableClusterMetadata{
+			ClusterName:            previous["cluster_name"].(string),
+			HistoryShardCount:      previous["history_shard_count"].(int),
+			InitialFailoverVersion: previous["initial_failover_version"].(int64),
+		},
+		RequestApplied: false,
+	}, nil
+}
+
+func (m *cassandraClusterMetadata) GetSearchAttributes() (*p.GetSearchAttributesResponse, error) {
+	query := m.session.Query(templateGetSearchAttributes, constMetadataPartition)
+
+	var attributes map[string]int
+	var version int64
+	if err := query.Scan(&attributes, &version); err != nil {
+		if err == gocql.ErrNotFound {
+			return &p.GetSearchAttributesResponse{
+				SearchAttributes: make(map[string]workflow.IndexedValueType),
+			}, nil
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetSearchAttributes operation failed. Error: %v", err),
+		}
+	}
+
+	searchAttributes := make(map[string]workflow.IndexedValueType, len(attributes))
+	for key, valueType := range attributes {
+		searchAttributes[key] = workflow.IndexedValueType(valueType)
+	}
+	return &p.GetSearchAttributesResponse{
+		SearchAttributes: searchAttributes,
+		Version:          version,
+	}, nil
+}
+
+func (m *cassandraClusterMetadata) UpdateSearchAttributes(request *p.UpdateSearchAttributesRequest) error {
+	attributes := make(map[string]int, len(request.SearchAttributes))
+	for key, valueType := range request.SearchAttributes {
+		attributes[key] = int(valueType)
+	}
+
+	// the column is null until the first update
+	var prevVersion interface{}
+	if request.PrevVersion != 0 {
+		prevVersion = request.PrevVersion
+	}
+	query := m.session.Query(templateUpdateSearchAttributes,
+		attributes,
+		request.PrevVersion+1,
+		constMetadataPartition,
+		prevVersion,
+	)
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateSearchAttributes operation failed. Error: %v", err),
+		}
+	}
+	if !applied {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("UpdateSearchAttributes operation failed because of version mismatch. Expected: %v, actual: %v",
+				request.PrevVersion, previous["search_attributes_version"]),
+		}
+	}
+	return nil
+}
+
+func (m *cassandraClusterMetadata) GetDynamicConfig() (*p.GetDynamicConfigResponse, error) {
+	query := m.session.Query(templateGetDynamicConfig, constMetadataPartition)
+
+	var config []byte
+	var version int64
+	if err := query.Scan(&config, &version); err != nil {
+		if err == gocql.ErrNotFound {
+			return &p.GetDynamicConfigResponse{}, nil
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDynamicConfig operation failed. Error: %v", err),
+		}
+	}
+	return &p.GetDynamicConfigResponse{
+		Config:  config,
+		Version: version,
+	}, nil
+}
+
+func (m *cassandraClusterMetadata) UpdateDynamicConfig(request *p.UpdateDynamicConfigRequest) error {
+	// the column is null until the first update
+	var prevVersion interface{}
+	if request.PrevVersion != 0 {
+		prevVersion = request.PrevVersion
+	}
+	query := m.session.Query(templateUpdateDynamicConfig,
+		request.Config,
+		request.PrevVersion+1,
+		constMetadataPartition,
+		prevVersion,
+	)
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDynamicConfig operation failed. Error: %v", err),
+		}
+	}
+	if !applied {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("UpdateDynamicConfig operation failed because of version mismatch. Expected: %v, actual: %v",
+				request.PrevVersion, previous["dynamic_config_version"]),
+		}
+	}
+	return nil
+}
@@ -282,6 +282,12 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 }
 
 func (m *cassandraMetadataPersistence) UpdateDomain(request *p.UpdateDomainRequest) error {
+	if len(request.PreviousName) > 0 && request.PreviousName != request.Info.Name {
+		// the records of the v1 table are partitioned by name, a rename could not be applied atomically
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("UpdateDomain operation failed. Domain %v in the v1 table cannot be renamed.", request.PreviousName),
+		}
+	}
 	var nextVersion int64 = 1
 	var currentVersion *int64
 	if request.NotificationVersion > 0 {
@@ -23,6 +23,8 @@ package cassandra
 import (
 	"errors"
 
+	"github.com/gocql/gocql"
+
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -54,6 +56,16 @@ func newMetadataManagerProxy(cfg config.Cassandra,
 	return &metadataManagerProxy{metadataMgr: metadataMgr, metadataMgrV2: metadataMgrV2, logger: logger}, nil
 }
 
+// NewMetadataManagerProxyFromSession returns MetadataStore merging the v1 and v2 domain tables of the session
+func NewMetadataManagerProxyFromSession(session *gocql.Session, currentClusterName string, logger log.Logger) p.MetadataStore {
+	store := cassandraStore{session: session, logger: logger}
+	return &metadataManagerProxy{
+		metadataMgr:   &cassandraMetadataPersistence{cassandraStore: store, currentClusterName: currentClusterName},
+		metadataMgrV2: &cassandraMetadataPersistenceV2{cassandraStore: store, currentClusterName: currentClusterName},
+		logger:        logger,
+	}
+}
+
 func (m *metadataManagerProxy) GetName() string {
 	return cassandraPersistenceName
 }
@@ -74,6 +74,10 @@ const (
 		`and name = ? ` +
 		`IF notification_version = ? `
 
+	templateUpdateDomainNameQueryV2 = `UPDATE domains ` +
+		`SET domain = {name: ?} ` +
+		`WHERE id = ?`
+
 	templateDeleteDomainByNameQueryV2 = `DELETE FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? ` +
 		`and name = ?`
@@ -208,6 +212,10 @@ func (m *cassandraMetadataPersistenceV2) CreateDomain(request *p.CreateDomainReq
 }
 
 func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.UpdateDomainRequest) error {
+	if len(request.PreviousName) > 0 && request.PreviousName != request.Info.Name {
+		return m.renameDomain(request)
+	}
+
 	batch := m.session.NewBatch(gocql.LoggedBatch)
 	batch.Query(templateUpdateDomainByNameQueryWithinBatchV2,
 		request.Info.ID,
@@ -253,6 +261,84 @@ func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.UpdateDomainReq
 	return nil
 }
 
+// renameDomain moves the domain record to its new name. All records of domains_by_name_v2 live in the same
+// partition, so the insert of the new name, the delete of the previous one and the metadata update are applied
+// by a single conditional batch. The name in the domains table is updated first and restored if the batch fails,
+// as Cassandra does not support conditional updates across multiple tables.
+func (m *cassandraMetadataPersistenceV2) renameDomain(request *p.UpdateDomainRequest) error {
+	current, err := m.GetDomain(&p.GetDomainRequest{Name: request.PreviousName})
+	if err != nil {
+		return err
+	}
+	if current.Info.ID != request.Info.ID {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("RenameDomain operation failed. Domain %v is not the domain %v.", request.PreviousName, request.Info.ID),
+		}
+	}
+
+	if err := m.session.Query(templateUpdateDomainNameQueryV2, request.Info.Name, request.Info.ID).Exec(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RenameDomain operation failed. Updating domains table. Error: %v", err),
+		}
+	}
+
+	batch := m.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateCreateDomainByNameQueryWithinBatchV2,
+		constDomainPartition,
+		request.Info.Name,
+		request.Info.ID,
+		request.Info.Name,
+		request.Info.Status,
+		request.Info.Description,
+		request.Info.OwnerEmail,
+		request.Info.Data,
+		request.Config.Retention,
+		request.Config.EmitMetric,
+		request.Config.ArchivalBucket,
+		request.Config.ArchivalStatus,
+		request.ReplicationConfig.ActiveClusterName,
+		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		current.IsGlobalDomain,
+		request.ConfigVersion,
+		request.FailoverVersion,
+		request.FailoverNotificationVersion,
+		request.NotificationVersion,
+	)
+	batch.Query(templateDeleteDomainByNameQueryV2, constDomainPartition, request.PreviousName)
+	m.updateMetadataBatch(batch, request.NotificationVersion)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := m.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+
+	if err != nil || !applied {
+		if errRestore := m.session.Query(templateUpdateDomainNameQueryV2, request.PreviousName, request.Info.ID).Exec(); errRestore != nil {
+			m.logger.Warn("Unable to restore domain name after failed rename. Error", tag.Error(errRestore))
+		}
+	}
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RenameDomain operation failed. Updating domains_by_name_v2 table. Error: %v", err),
+		}
+	}
+	if !applied {
+		if _, ok := previous["domain"].(map[string]interface{}); ok {
+			return &workflow.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain already exists.  Name: %v", request.Info.Name),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RenameDomain operation failed because of conditional failure."),
+		}
+	}
+
+	return nil
+}
+
 func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest) (*p.GetDomainResponse, error) {
 	var query *gocql.Query
 	var err error
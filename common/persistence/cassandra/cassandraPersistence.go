@@ -38,7 +38,7 @@ import (
 // Where x is any hexadecimal value, E represents the entity type valid values are:
 // E = {DomainID = 1, WorkflowID = 2, RunID = 3}
 // R represents row type in executions table, valid values are:
-// R = {Shard = 1, Execution = 2, Transfer = 3, Timer = 4, Replication = 5}
+// R = {Shard = 1, Execution = 2, Transfer = 3, Timer = 4, Replication = 5, TransferDLQ = 6, ReplicationDLQ = 7}
 const (
 	cassandraProtoVersion = 4
 	defaultSessionTimeout = 10 * time.Second
@@ -63,6 +63,13 @@ const (
 	rowTypeReplicationDomainID   = "10000000-5000-f000-f000-000000000000"
 	rowTypeReplicationWorkflowID = "20000000-5000-f000-f000-000000000000"
 	rowTypeReplicationRunID      = "30000000-5000-f000-f000-000000000000"
+	// Row Constants for Transfer DLQ Task Row
+	rowTypeTransferDLQDomainID   = "10000000-6000-f000-f000-000000000000"
+	rowTypeTransferDLQWorkflowID = "20000000-6000-f000-f000-000000000000"
+	rowTypeTransferDLQRunID      = "30000000-6000-f000-f000-000000000000"
+	// Row Constants for Replication DLQ Task Row, the workflow ID column holds the source cluster name
+	rowTypeReplicationDLQDomainID = "10000000-7000-f000-f000-000000000000"
+	rowTypeReplicationDLQRunID    = "30000000-7000-f000-f000-000000000000"
 	// Special TaskId constants
 	rowTypeExecutionTaskID  = int64(-10)
 	rowTypeShardTaskID      = int64(-11)
@@ -82,6 +89,8 @@ const (
 	rowTypeTransferTask
 	rowTypeTimerTask
 	rowTypeReplicationTask
+	rowTypeTransferDLQTask
+	rowTypeReplicationDLQTask
 )
 
 const (
@@ -107,6 +116,9 @@ const (
 		`timer_ack_level: ?, ` +
 		`cluster_transfer_ack_level: ?, ` +
 		`cluster_timer_ack_level: ?, ` +
+		`cluster_transfer_read_level: ?, ` +
+		`cluster_transfer_ack_bitmap: ?, ` +
+		`visibility_ack_level: ?, ` +
 		`domain_notification_version: ? ` +
 		`}`
 
@@ -144,6 +156,7 @@ const (
 		`decision_timeout: ?, ` +
 		`decision_attempt: ?, ` +
 		`decision_timestamp: ?, ` +
+		`decision_failure_count: ?, ` +
 		`cancel_requested: ?, ` +
 		`cancel_request_id: ?, ` +
 		`sticky_task_list: ?, ` +
@@ -329,7 +342,8 @@ const (
 		`domain_id: ?, ` +
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
-		`schedule_id: ?` +
+		`schedule_id: ?, ` +
+		`created_time: ?` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -778,6 +792,23 @@ workflow_state = ? ` +
 		`and task_id > ? ` +
 		`and task_id <= ?`
 
+	templateCreateWorkflowAnnotationQuery = `INSERT INTO workflow_annotations (` +
+		`shard_id, domain_id, workflow_id, run_id, annotation_key, annotation_text, author, created_time) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateGetWorkflowAnnotationsQuery = `SELECT annotation_key, annotation_text, author, created_time ` +
+		`FROM workflow_annotations ` +
+		`WHERE shard_id = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ?`
+
+	templateDeleteWorkflowAnnotationsQuery = `DELETE FROM workflow_annotations ` +
+		`WHERE shard_id = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ?`
+
 	templateGetTimerTasksQuery = `SELECT timer ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -857,6 +888,8 @@ workflow_state = ? ` +
 		`task_list ` +
 		`) VALUES (?, ?, ?, ?, ?, ?, ` + templateTaskListType + `) IF NOT EXISTS`
 
+	templateInsertTaskListQueryWithTTL = templateInsertTaskListQuery + ` USING TTL ?`
+
 	templateUpdateTaskListQuery = `UPDATE tasks SET ` +
 		`range_id = ?, ` +
 		`task_list = ` + templateTaskListType + " " +
@@ -867,6 +900,16 @@ workflow_state = ? ` +
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
+	templateUpdateStickyTaskListQuery = `UPDATE tasks USING TTL ? SET ` +
+		`range_id = ?, ` +
+		`task_list = ` + templateTaskListType + " " +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id = ? ` +
+		`IF range_id = ?`
+
 	templateUpdateTaskListQueryWithTTL = `INSERT INTO tasks (` +
 		`domain_id, ` +
 		`task_list_name, ` +
@@ -990,6 +1033,9 @@ func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
+		shardInfo.ClusterTransferReadLevel,
+		shardInfo.ClusterTransferAckBitmap,
+		shardInfo.VisibilityAckLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.RangeID)
 
@@ -1065,6 +1111,9 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
+		shardInfo.ClusterTransferReadLevel,
+		shardInfo.ClusterTransferAckBitmap,
+		shardInfo.VisibilityAckLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.RangeID,
 		shardInfo.ShardID,
@@ -1209,7 +1258,16 @@ func (d *cassandraPersistence) CreateWorkflowExecution(request *p.CreateWorkflow
 					// currentRunID on previous run has been changed, return to caller to handle
 					msg := fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, CurrentRunID: %v, columns: (%v)",
 						request.Execution.GetWorkflowId(), request.Execution.GetRunId(), strings.Join(columns, ","))
-					return nil, &p.CurrentWorkflowConditionFailedError{Msg: msg}
+					conditionFailedErr := &p.CurrentWorkflowConditionFailedError{Msg: msg, RunID: prevRunID}
+					// the condition only returns the current run ID, the request ID of the current run is read back
+					// so that the caller can tell whether it was started by a retry of the same request
+					if current, err := d.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+						DomainID:   request.DomainID,
+						WorkflowID: request.Execution.GetWorkflowId(),
+					}); err == nil && current.RunID == prevRunID {
+						conditionFailedErr.RequestID = current.StartRequestID
+					}
+					return nil, conditionFailedErr
 				}
 
 				msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, CurrentRunID: %v, columns: (%v)",
@@ -1381,6 +1439,7 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.DecisionStartToCloseTimeout,
 			0,
 			0,
+			0, // decision_failure_count
 			false,
 			"",
 			"", // sticky_task_list (no sticky tasklist for new workflow execution)
@@ -1448,6 +1507,7 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.DecisionStartToCloseTimeout,
 			0,
 			0,
+			0, // decision_failure_count
 			false,
 			"",
 			"", // sticky_task_list (no sticky tasklist for new workflow execution)
@@ -1636,6 +1696,7 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 			executionInfo.DecisionTimeout,
 			executionInfo.DecisionAttempt,
 			executionInfo.DecisionTimestamp,
+			executionInfo.DecisionFailureCount,
 			executionInfo.CancelRequested,
 			executionInfo.CancelRequestID,
 			executionInfo.StickyTaskList,
@@ -1703,6 +1764,7 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 			executionInfo.DecisionTimeout,
 			executionInfo.DecisionAttempt,
 			executionInfo.DecisionTimestamp,
+			executionInfo.DecisionFailureCount,
 			executionInfo.CancelRequested,
 			executionInfo.CancelRequestID,
 			executionInfo.StickyTaskList,
@@ -2265,6 +2327,13 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *p.DeleteWorkflow
 		rowTypeExecutionTaskID)
 
 	err := query.Exec()
+	if err == nil {
+		err = d.session.Query(templateDeleteWorkflowAnnotationsQuery,
+			d.shardID,
+			request.DomainID,
+			request.WorkflowID,
+			request.RunID).Exec()
+	}
 	if err != nil {
 		if isThrottlingError(err) {
 			return &workflow.ServiceBusyError{
@@ -2485,6 +2554,303 @@ func (d *cassandraPersistence) RangeCompleteTransferTask(request *p.RangeComplet
 	return nil
 }
 
+func (d *cassandraPersistence) PutTransferDLQTask(request *p.PutTransferDLQTaskRequest) error {
+	task := request.TaskInfo
+	targetWorkflowID := task.TargetWorkflowID
+	if targetWorkflowID == "" {
+		targetWorkflowID = p.TransferTaskTransferTargetWorkflowID
+	}
+	targetRunID := task.TargetRunID
+	if targetRunID == "" {
+		targetRunID = p.TransferTaskTransferTargetRunID
+	}
+	targetDomainID := task.TargetDomainID
+	if targetDomainID == "" {
+		targetDomainID = task.DomainID
+	}
+
+	query := d.session.Query(templateCreateTransferTaskQuery,
+		d.shardID,
+		rowTypeTransferDLQTask,
+		rowTypeTransferDLQDomainID,
+		rowTypeTransferDLQWorkflowID,
+		rowTypeTransferDLQRunID,
+		task.DomainID,
+		task.WorkflowID,
+		task.RunID,
+		task.VisibilityTimestamp,
+		task.TaskID,
+		targetDomainID,
+		targetWorkflowID,
+		targetRunID,
+		task.TargetChildWorkflowOnly,
+		task.TaskList,
+		task.TaskType,
+		task.ScheduleID,
+		task.RecordVisibility,
+		task.Version,
+		defaultVisibilityTimestamp,
+		task.TaskID)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutTransferDLQTask operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutTransferDLQTask operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetTransferDLQTasks(request *p.GetTransferDLQTasksRequest) (*p.GetTransferDLQTasksResponse, error) {
+	query := d.session.Query(templateGetTransferTasksQuery,
+		d.shardID,
+		rowTypeTransferDLQTask,
+		rowTypeTransferDLQDomainID,
+		rowTypeTransferDLQWorkflowID,
+		rowTypeTransferDLQRunID,
+		defaultVisibilityTimestamp,
+		request.ReadLevel,
+		request.MaxReadLevel,
+	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetTransferDLQTasks operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.GetTransferDLQTasksResponse{}
+	task := make(map[string]interface{})
+	for iter.MapScan(task) {
+		t := createTransferTaskInfo(task["transfer"].(map[string]interface{}))
+		// Reset task map to get it ready for next scan
+		task = make(map[string]interface{})
+
+		response.Tasks = append(response.Tasks, t)
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetTransferDLQTasks operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) RangeDeleteTransferDLQTasks(request *p.RangeDeleteTransferDLQTasksRequest) error {
+	query := d.session.Query(templateRangeCompleteTransferTaskQuery,
+		d.shardID,
+		rowTypeTransferDLQTask,
+		rowTypeTransferDLQDomainID,
+		rowTypeTransferDLQWorkflowID,
+		rowTypeTransferDLQRunID,
+		defaultVisibilityTimestamp,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("RangeDeleteTransferDLQTasks operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteTransferDLQTasks operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) PutWorkflowExecutionAnnotation(request *p.PutWorkflowExecutionAnnotationRequest) error {
+	annotation := request.Annotation
+	query := d.session.Query(templateCreateWorkflowAnnotationQuery,
+		d.shardID,
+		request.DomainID,
+		request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId(),
+		annotation.Key,
+		annotation.Text,
+		annotation.Author,
+		annotation.CreatedTime)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutWorkflowExecutionAnnotation operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutWorkflowExecutionAnnotation operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetWorkflowExecutionAnnotations(request *p.GetWorkflowExecutionAnnotationsRequest) (*p.GetWorkflowExecutionAnnotationsResponse, error) {
+	query := d.session.Query(templateGetWorkflowAnnotationsQuery,
+		d.shardID,
+		request.DomainID,
+		request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId())
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetWorkflowExecutionAnnotations operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.GetWorkflowExecutionAnnotationsResponse{}
+	annotation := &p.WorkflowExecutionAnnotation{}
+	for iter.Scan(&annotation.Key, &annotation.Text, &annotation.Author, &annotation.CreatedTime) {
+		response.Annotations = append(response.Annotations, annotation)
+		annotation = &p.WorkflowExecutionAnnotation{}
+	}
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetWorkflowExecutionAnnotations operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetWorkflowExecutionAnnotations operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) PutReplicationDLQTask(request *p.PutReplicationDLQTaskRequest) error {
+	task := request.TaskInfo
+	lastReplicationInfo := make(map[string]map[string]interface{})
+	for k, v := range task.LastReplicationInfo {
+		lastReplicationInfo[k] = createReplicationInfoMap(v)
+	}
+
+	query := d.session.Query(templateCreateReplicationTaskQuery,
+		d.shardID,
+		rowTypeReplicationDLQTask,
+		rowTypeReplicationDLQDomainID,
+		request.SourceClusterName,
+		rowTypeReplicationDLQRunID,
+		task.DomainID,
+		task.WorkflowID,
+		task.RunID,
+		task.TaskID,
+		task.TaskType,
+		task.FirstEventID,
+		task.NextEventID,
+		task.Version,
+		lastReplicationInfo,
+		task.ScheduledID,
+		task.EventStoreVersion,
+		task.BranchToken,
+		task.ResetWorkflow,
+		task.NewRunEventStoreVersion,
+		task.NewRunBranchToken,
+		defaultVisibilityTimestamp,
+		task.TaskID)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutReplicationDLQTask operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutReplicationDLQTask operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetReplicationDLQTasks(request *p.GetReplicationDLQTasksRequest) (*p.GetReplicationDLQTasksResponse, error) {
+	query := d.session.Query(templateGetReplicationTasksQuery,
+		d.shardID,
+		rowTypeReplicationDLQTask,
+		rowTypeReplicationDLQDomainID,
+		request.SourceClusterName,
+		rowTypeReplicationDLQRunID,
+		defaultVisibilityTimestamp,
+		request.ReadLevel,
+		request.MaxReadLevel,
+	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetReplicationDLQTasks operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.GetReplicationDLQTasksResponse{}
+	task := make(map[string]interface{})
+	for iter.MapScan(task) {
+		t := createReplicationTaskInfo(task["replication"].(map[string]interface{}))
+		// Reset task map to get it ready for next scan
+		task = make(map[string]interface{})
+
+		response.Tasks = append(response.Tasks, t)
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetReplicationDLQTasks operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) RangeDeleteReplicationDLQTasks(request *p.RangeDeleteReplicationDLQTasksRequest) error {
+	query := d.session.Query(templateRangeCompleteTransferTaskQuery,
+		d.shardID,
+		rowTypeReplicationDLQTask,
+		rowTypeReplicationDLQDomainID,
+		request.SourceClusterName,
+		rowTypeReplicationDLQRunID,
+		defaultVisibilityTimestamp,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("RangeDeleteReplicationDLQTasks operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteReplicationDLQTasks operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
 func (d *cassandraPersistence) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
 	query := d.session.Query(templateCompleteTransferTaskQuery,
 		d.shardID,
@@ -2584,7 +2950,7 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 	err := query.Scan(&rangeID, &tlDB)
 	if err != nil {
 		if err == gocql.ErrNotFound { // First time task list is used
-			query = d.session.Query(templateInsertTaskListQuery,
+			values := []interface{}{
 				request.DomainID,
 				request.TaskList,
 				request.TaskType,
@@ -2597,7 +2963,13 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 				0,
 				request.TaskListKind,
 				now,
-			)
+			}
+			if request.TaskListKind == p.TaskListKindSticky {
+				// sticky task list must expire even if it is never updated after being created
+				query = d.session.Query(templateInsertTaskListQueryWithTTL, append(values, stickyTaskListTTL)...)
+			} else {
+				query = d.session.Query(templateInsertTaskListQuery, values...)
+			}
 		} else if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
 				Message: fmt.Sprintf("LeaseTaskList operation failed. TaskList: %v, TaskType: %v, Error: %v",
@@ -2621,8 +2993,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		}
 		ackLevel = tlDB["ack_level"].(int64)
 		taskListKind := tlDB["kind"].(int)
-		query = d.session.Query(templateUpdateTaskListQuery,
-			rangeID+1,
+		values := []interface{}{
+			rangeID + 1,
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
@@ -2635,7 +3007,13 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 			rowTypeTaskList,
 			taskListTaskID,
 			rangeID,
-		)
+		}
+		if taskListKind == p.TaskListKindSticky {
+			// an update without TTL would make the sticky task list row permanent
+			query = d.session.Query(templateUpdateStickyTaskListQuery, append([]interface{}{stickyTaskListTTL}, values...)...)
+		} else {
+			query = d.session.Query(templateUpdateTaskListQuery, values...)
+		}
 	}
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -2796,7 +3174,8 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				domainID,
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
-				scheduleID)
+				scheduleID,
+				task.Data.CreatedTime)
 		} else {
 			batch.Query(templateCreateTaskWithTTLQuery,
 				domainID,
@@ -2808,12 +3187,13 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
 				scheduleID,
+				task.Data.CreatedTime,
 				task.Data.ScheduleToStartTimeout)
 		}
 	}
 
 	// The following query is used to ensure that range_id didn't change
-	batch.Query(templateUpdateTaskListQuery,
+	values := []interface{}{
 		request.TaskListInfo.RangeID,
 		domainID,
 		taskList,
@@ -2827,7 +3207,12 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 		rowTypeTaskList,
 		taskListTaskID,
 		request.TaskListInfo.RangeID,
-	)
+	}
+	if taskListKind == p.TaskListKindSticky {
+		batch.Query(templateUpdateStickyTaskListQuery, append([]interface{}{stickyTaskListTTL}, values...)...)
+	} else {
+		batch.Query(templateUpdateTaskListQuery, values...)
+	}
 
 	previous := make(map[string]interface{})
 	applied, _, err := d.session.MapExecuteBatchCAS(batch, previous)
@@ -3709,6 +4094,12 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			info.ClusterTransferAckLevel = v.(map[string]int64)
 		case "cluster_timer_ack_level":
 			info.ClusterTimerAckLevel = v.(map[string]time.Time)
+		case "cluster_transfer_read_level":
+			info.ClusterTransferReadLevel = v.(map[string]int64)
+		case "cluster_transfer_ack_bitmap":
+			info.ClusterTransferAckBitmap = v.(map[string][]byte)
+		case "visibility_ack_level":
+			info.VisibilityAckLevel = v.(int64)
 		case "domain_notification_version":
 			info.DomainNotificationVersion = v.(int64)
 		}
@@ -3800,6 +4191,8 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *p.InternalWorkf
 			info.DecisionAttempt = v.(int64)
 		case "decision_timestamp":
 			info.DecisionTimestamp = v.(int64)
+		case "decision_failure_count":
+			info.DecisionFailureCount = v.(int64)
 		case "cancel_requested":
 			info.CancelRequested = v.(bool)
 		case "cancel_request_id":
@@ -4311,6 +4704,8 @@ func createTaskInfo(result map[string]interface{}) *p.TaskInfo {
 			info.RunID = v.(gocql.UUID).String()
 		case "schedule_id":
 			info.ScheduleID = v.(int64)
+		case "created_time":
+			info.CreatedTime = v.(time.Time)
 		}
 	}
 
@@ -56,6 +56,18 @@ const (
 		`AND start_time = ? ` +
 		`AND run_id = ?`
 
+	templateDeleteWorkflowExecutionClosed = `DELETE FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
+	templateDeleteWorkflowExecutionClosedV2 = `DELETE FROM closed_executions_v2 ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND close_time = ? ` +
+		`AND run_id = ?`
+
 	templateCreateWorkflowExecutionClosedWithTTL = `INSERT INTO closed_executions (` +
 		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding) ` +
 		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
@@ -141,6 +153,14 @@ type (
 	}
 )
 
+// NewVisibilityPersistenceFromSession returns VisibilityStore
+func NewVisibilityPersistenceFromSession(session *gocql.Session, logger log.Logger) p.VisibilityStore {
+	return &cassandraVisibilityPersistence{
+		cassandraStore: cassandraStore{session: session, logger: logger},
+		lowConslevel:   gocql.One,
+	}
+}
+
 // newVisibilityPersistence is used to create an instance of VisibilityManager implementation
 func newVisibilityPersistence(cfg config.Cassandra, logger log.Logger) (p.VisibilityStore, error) {
 	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
@@ -649,8 +669,58 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}, nil
 }
 
-// DeleteWorkflowExecution is a no-op since deletes are auto-handled by cassandra TTLs
+// DeleteWorkflowExecution is a no-op since deletes are auto-handled by cassandra TTLs, unless the start time of the
+// run is given, the open and closed records of the run are deleted right away then
 func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	if request.StartTimestamp == 0 {
+		return nil
+	}
+
+	batch := v.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateDeleteWorkflowExecutionStarted,
+		request.DomainID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(request.StartTimestamp),
+		request.RunID,
+	)
+
+	closed, err := v.GetClosedWorkflowExecution(&p.GetClosedWorkflowExecutionRequest{
+		DomainUUID: request.DomainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(request.WorkflowID),
+			RunId:      common.StringPtr(request.RunID),
+		},
+	})
+	switch err.(type) {
+	case nil:
+		batch.Query(templateDeleteWorkflowExecutionClosed,
+			request.DomainID,
+			domainPartition,
+			p.UnixNanoToDBTimestamp(closed.Execution.StartTime.UnixNano()),
+			request.RunID,
+		)
+		batch.Query(templateDeleteWorkflowExecutionClosedV2,
+			request.DomainID,
+			domainPartition,
+			p.UnixNanoToDBTimestamp(closed.Execution.CloseTime.UnixNano()),
+			request.RunID,
+		)
+	case *workflow.EntityNotExistsError:
+		// the run is not closed, only the open record exists
+	default:
+		return err
+	}
+
+	if err := v.session.ExecuteBatch(batch); err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
 	return nil
 }
 
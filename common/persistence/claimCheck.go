@@ -0,0 +1,549 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+)
+
+// claimCheckMagic prefixes every payload which has been replaced by a reference to the external store,
+// the key of the stored payload follows the magic
+var claimCheckMagic = []byte{0xff, 'c', 'c', 'h', 'k', 1}
+
+const claimCheckKeyExtension = "payload"
+
+// PayloadType identifies an event field which can be moved to the external store
+type PayloadType string
+
+const (
+	// PayloadTypeWorkflowInput is the input of started and continued as new workflows
+	PayloadTypeWorkflowInput PayloadType = "workflowInput"
+	// PayloadTypeWorkflowResult is the result of completed workflows
+	PayloadTypeWorkflowResult PayloadType = "workflowResult"
+	// PayloadTypeActivityInput is the input of scheduled activities
+	PayloadTypeActivityInput PayloadType = "activityInput"
+	// PayloadTypeActivityResult is the result of completed activities
+	PayloadTypeActivityResult PayloadType = "activityResult"
+	// PayloadTypeSignalInput is the input of received and sent signals
+	PayloadTypeSignalInput PayloadType = "signalInput"
+	// PayloadTypeMarkerDetails is the details of recorded markers
+	PayloadTypeMarkerDetails PayloadType = "markerDetails"
+	// PayloadTypeChildWorkflowInput is the input of initiated child workflows
+	PayloadTypeChildWorkflowInput PayloadType = "childWorkflowInput"
+	// PayloadTypeChildWorkflowResult is the result of completed child workflows
+	PayloadTypeChildWorkflowResult PayloadType = "childWorkflowResult"
+)
+
+type (
+	// PayloadClaimChecker moves large event payloads to an external store and leaves a reference
+	// in the history event, the references are resolved back when history is read. Payloads are
+	// stored per owner, the history they belong to, and are deleted together with it
+	PayloadClaimChecker interface {
+		// Offload returns the events with the selected payloads replaced by references,
+		// the given events are not modified
+		Offload(domainID string, ownerID string, events []*workflow.HistoryEvent) ([]*workflow.HistoryEvent, error)
+		// Resolve replaces the references in the events with the stored payloads
+		Resolve(events []*workflow.HistoryEvent) error
+		// Delete deletes the payloads of the given owner
+		Delete(ownerID string) error
+	}
+
+	// ClaimCheckPolicy decides which payloads are moved to the external store
+	ClaimCheckPolicy interface {
+		// GetThreshold returns the size in bytes above which payloads of the given type
+		// are moved to the external store, payloads are never moved if it is not positive
+		GetThreshold(domainID string, payloadType PayloadType) int
+	}
+
+	claimCheckField struct {
+		payloadType PayloadType
+		get         func(event *workflow.HistoryEvent) []byte
+		// set replaces the payload on a copy of the event attributes
+		set func(event *workflow.HistoryEvent, payload []byte)
+	}
+
+	payloadClaimChecker struct {
+		store  blobstore.Client
+		bucket string
+		policy ClaimCheckPolicy
+	}
+
+	staticClaimCheckPolicy struct {
+		thresholds       map[PayloadType]int
+		domainThresholds map[string]map[PayloadType]int
+	}
+
+	historyClaimCheckPersistenceClient struct {
+		persistence HistoryManager
+		checker     PayloadClaimChecker
+	}
+
+	historyV2ClaimCheckPersistenceClient struct {
+		persistence HistoryV2Manager
+		checker     PayloadClaimChecker
+	}
+)
+
+var _ HistoryManager = (*historyClaimCheckPersistenceClient)(nil)
+var _ HistoryV2Manager = (*historyV2ClaimCheckPersistenceClient)(nil)
+
+var claimCheckFields = map[workflow.EventType]claimCheckField{
+	workflow.EventTypeWorkflowExecutionStarted: {
+		payloadType: PayloadTypeWorkflowInput,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.WorkflowExecutionStartedEventAttributes.Input
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.WorkflowExecutionStartedEventAttributes
+			attr.Input = payload
+			e.WorkflowExecutionStartedEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeWorkflowExecutionContinuedAsNew: {
+		payloadType: PayloadTypeWorkflowInput,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.WorkflowExecutionContinuedAsNewEventAttributes.Input
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.WorkflowExecutionContinuedAsNewEventAttributes
+			attr.Input = payload
+			e.WorkflowExecutionContinuedAsNewEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeWorkflowExecutionCompleted: {
+		payloadType: PayloadTypeWorkflowResult,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.WorkflowExecutionCompletedEventAttributes.Result
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.WorkflowExecutionCompletedEventAttributes
+			attr.Result = payload
+			e.WorkflowExecutionCompletedEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeActivityTaskScheduled: {
+		payloadType: PayloadTypeActivityInput,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.ActivityTaskScheduledEventAttributes.Input
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.ActivityTaskScheduledEventAttributes
+			attr.Input = payload
+			e.ActivityTaskScheduledEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeActivityTaskCompleted: {
+		payloadType: PayloadTypeActivityResult,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.ActivityTaskCompletedEventAttributes.Result
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.ActivityTaskCompletedEventAttributes
+			attr.Result = payload
+			e.ActivityTaskCompletedEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeWorkflowExecutionSignaled: {
+		payloadType: PayloadTypeSignalInput,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.WorkflowExecutionSignaledEventAttributes.Input
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.WorkflowExecutionSignaledEventAttributes
+			attr.Input = payload
+			e.WorkflowExecutionSignaledEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeSignalExternalWorkflowExecutionInitiated: {
+		payloadType: PayloadTypeSignalInput,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.SignalExternalWorkflowExecutionInitiatedEventAttributes.Input
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.SignalExternalWorkflowExecutionInitiatedEventAttributes
+			attr.Input = payload
+			e.SignalExternalWorkflowExecutionInitiatedEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeMarkerRecorded: {
+		payloadType: PayloadTypeMarkerDetails,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.MarkerRecordedEventAttributes.Details
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.MarkerRecordedEventAttributes
+			attr.Details = payload
+			e.MarkerRecordedEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeStartChildWorkflowExecutionInitiated: {
+		payloadType: PayloadTypeChildWorkflowInput,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.StartChildWorkflowExecutionInitiatedEventAttributes.Input
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.StartChildWorkflowExecutionInitiatedEventAttributes
+			attr.Input = payload
+			e.StartChildWorkflowExecutionInitiatedEventAttributes = &attr
+		},
+	},
+	workflow.EventTypeChildWorkflowExecutionCompleted: {
+		payloadType: PayloadTypeChildWorkflowResult,
+		get: func(e *workflow.HistoryEvent) []byte {
+			return e.ChildWorkflowExecutionCompletedEventAttributes.Result
+		},
+		set: func(e *workflow.HistoryEvent, payload []byte) {
+			attr := *e.ChildWorkflowExecutionCompletedEventAttributes
+			attr.Result = payload
+			e.ChildWorkflowExecutionCompletedEventAttributes = &attr
+		},
+	},
+}
+
+// ParsePayloadType returns the payload type with the given name
+func ParsePayloadType(name string) (PayloadType, error) {
+	for _, field := range claimCheckFields {
+		if string(field.payloadType) == name {
+			return field.payloadType, nil
+		}
+	}
+	return "", fmt.Errorf("unknown payload type: %v", name)
+}
+
+// NewPayloadClaimChecker returns a PayloadClaimChecker which stores payloads in the given bucket of the blobstore
+func NewPayloadClaimChecker(store blobstore.Client, bucket string, policy ClaimCheckPolicy) PayloadClaimChecker {
+	return &payloadClaimChecker{
+		store:  store,
+		bucket: bucket,
+		policy: policy,
+	}
+}
+
+// NewStaticClaimCheckPolicy returns a ClaimCheckPolicy with fixed thresholds by payload type,
+// domainThresholds overrides the thresholds for individual domains by domain id
+func NewStaticClaimCheckPolicy(thresholds map[PayloadType]int, domainThresholds map[string]map[PayloadType]int) ClaimCheckPolicy {
+	return &staticClaimCheckPolicy{
+		thresholds:       thresholds,
+		domainThresholds: domainThresholds,
+	}
+}
+
+// NewHistoryPersistenceClaimCheckClient creates a HistoryManager client which moves large payloads to an external store
+func NewHistoryPersistenceClaimCheckClient(persistence HistoryManager, checker PayloadClaimChecker) HistoryManager {
+	return &historyClaimCheckPersistenceClient{
+		persistence: persistence,
+		checker:     checker,
+	}
+}
+
+// NewHistoryV2PersistenceClaimCheckClient creates a HistoryV2Manager client which moves large payloads to an external store
+func NewHistoryV2PersistenceClaimCheckClient(persistence HistoryV2Manager, checker PayloadClaimChecker) HistoryV2Manager {
+	return &historyV2ClaimCheckPersistenceClient{
+		persistence: persistence,
+		checker:     checker,
+	}
+}
+
+func (p *staticClaimCheckPolicy) GetThreshold(domainID string, payloadType PayloadType) int {
+	if thresholds, ok := p.domainThresholds[domainID]; ok {
+		if threshold, ok := thresholds[payloadType]; ok {
+			return threshold
+		}
+	}
+	return p.thresholds[payloadType]
+}
+
+func (c *payloadClaimChecker) Offload(domainID string, ownerID string, events []*workflow.HistoryEvent) ([]*workflow.HistoryEvent, error) {
+	var result []*workflow.HistoryEvent
+	for i, event := range events {
+		field, ok := claimCheckFields[event.GetEventType()]
+		if !ok {
+			continue
+		}
+		payload := field.get(event)
+		// payloads which look like a reference are always stored, so user data can never be mistaken for one
+		threshold := c.policy.GetThreshold(domainID, field.payloadType)
+		if !isClaimCheckReference(payload) && (threshold <= 0 || len(payload) <= threshold) {
+			continue
+		}
+
+		reference, err := c.put(domainID, ownerID, field.payloadType, payload)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = make([]*workflow.HistoryEvent, len(events))
+			copy(result, events)
+		}
+		copied := *event
+		field.set(&copied, reference)
+		result[i] = &copied
+	}
+	if result == nil {
+		return events, nil
+	}
+	return result, nil
+}
+
+func (c *payloadClaimChecker) Resolve(events []*workflow.HistoryEvent) error {
+	for _, event := range events {
+		field, ok := claimCheckFields[event.GetEventType()]
+		if !ok {
+			continue
+		}
+		reference := field.get(event)
+		if !isClaimCheckReference(reference) {
+			continue
+		}
+		payload, err := c.get(reference)
+		if err != nil {
+			return err
+		}
+		field.set(event, payload)
+	}
+	return nil
+}
+
+func (c *payloadClaimChecker) Delete(ownerID string) error {
+	keys, err := c.store.ListByPrefix(context.Background(), c.bucket, claimCheckKeyPrefix(ownerID))
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("failed to list payloads of %v: %v", ownerID, err),
+		}
+	}
+	for _, key := range keys {
+		if _, err := c.store.Delete(context.Background(), c.bucket, key); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("failed to delete payload %v: %v", key, err),
+			}
+		}
+	}
+	return nil
+}
+
+// put stores the payload under its owner and the hash of its content, so retried writes store it only once
+func (c *payloadClaimChecker) put(domainID string, ownerID string, payloadType PayloadType, payload []byte) ([]byte, error) {
+	hash := sha256.Sum256(payload)
+	key, err := blob.NewKey(claimCheckKeyExtension, claimCheckOwnerPiece(ownerID), hex.EncodeToString(hash[:]))
+	if err != nil {
+		return nil, err
+	}
+	tags := map[string]string{
+		"domainID":    domainID,
+		"ownerID":     ownerID,
+		"payloadType": string(payloadType),
+	}
+	if err := c.store.Upload(context.Background(), c.bucket, key, blob.NewBlob(payload, tags)); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("failed to store payload %v: %v", key, err),
+		}
+	}
+	return append(append([]byte{}, claimCheckMagic...), key.String()...), nil
+}
+
+func (c *payloadClaimChecker) get(reference []byte) ([]byte, error) {
+	keyName := string(reference[len(claimCheckMagic):])
+	key, err := blob.NewKeyFromString(keyName)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("invalid payload reference %v: %v", keyName, err),
+		}
+	}
+	stored, err := c.store.Download(context.Background(), c.bucket, key)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("failed to load payload %v: %v", keyName, err),
+		}
+	}
+	// the key carries the hash of the payload, which guards against corrupted or swapped payloads
+	pieces := key.Pieces()
+	hash := sha256.Sum256(stored.Body)
+	if len(pieces) != 2 || pieces[1] != hex.EncodeToString(hash[:]) {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("payload %v does not match its reference", keyName),
+		}
+	}
+	return stored.Body, nil
+}
+
+func isClaimCheckReference(payload []byte) bool {
+	return bytes.HasPrefix(payload, claimCheckMagic)
+}
+
+func claimCheckOwnerPiece(ownerID string) string {
+	return strings.Replace(ownerID, "-", "", -1)
+}
+
+// claimCheckKeyPrefix is the prefix of the keys of all payloads of the owner, the hash follows the separator
+func claimCheckKeyPrefix(ownerID string) string {
+	return claimCheckOwnerPiece(ownerID) + "_"
+}
+
+// getHistoryTreeID returns the tree of the branch, payloads of history v2 are owned by the tree
+// as its branches share the nodes they were forked from
+func getHistoryTreeID(branchToken []byte) (string, error) {
+	var branch workflow.HistoryBranch
+	if err := internalThriftEncoder.Decode(branchToken, &branch); err != nil {
+		return "", err
+	}
+	return branch.GetTreeID(), nil
+}
+
+func (p *historyClaimCheckPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyClaimCheckPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
+	events, err := p.checker.Offload(request.DomainID, request.Execution.GetRunId(), request.Events)
+	if err != nil {
+		return nil, err
+	}
+	copied := *request
+	copied.Events = events
+	return p.persistence.AppendHistoryEvents(&copied)
+}
+
+func (p *historyClaimCheckPersistenceClient) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	response, err := p.persistence.GetWorkflowExecutionHistory(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.History != nil {
+		if err := p.checker.Resolve(response.History.Events); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (p *historyClaimCheckPersistenceClient) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
+	response, err := p.persistence.GetWorkflowExecutionHistoryByBatch(request)
+	if err != nil {
+		return nil, err
+	}
+	for _, batch := range response.History {
+		if err := p.checker.Resolve(batch.Events); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (p *historyClaimCheckPersistenceClient) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
+	if err := p.persistence.DeleteWorkflowExecutionHistory(request); err != nil {
+		return err
+	}
+	return p.checker.Delete(request.Execution.GetRunId())
+}
+
+func (p *historyClaimCheckPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	treeID, err := getHistoryTreeID(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+	events, err := p.checker.Offload(request.DomainID, treeID, request.Events)
+	if err != nil {
+		return nil, err
+	}
+	copied := *request
+	copied.Events = events
+	return p.persistence.AppendHistoryNodes(ctx, &copied)
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) ReadHistoryBranch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	response, err := p.persistence.ReadHistoryBranch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checker.Resolve(response.HistoryEvents); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) ReadHistoryBranchByBatch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, batch := range response.History {
+		if err := p.checker.Resolve(batch.Events); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	return p.persistence.ForkHistoryBranch(ctx, request)
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) CompleteForkBranch(ctx context.Context, request *CompleteForkBranchRequest) error {
+	return p.persistence.CompleteForkBranch(ctx, request)
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error {
+	if err := p.persistence.DeleteHistoryBranch(ctx, request); err != nil {
+		return err
+	}
+
+	// the payloads are deleted with the last branch of the tree
+	treeID, err := getHistoryTreeID(request.BranchToken)
+	if err != nil {
+		return err
+	}
+	tree, err := p.persistence.GetHistoryTree(ctx, &GetHistoryTreeRequest{
+		TreeID:  treeID,
+		ShardID: request.ShardID,
+	})
+	if err != nil {
+		return err
+	}
+	if len(tree.Branches) > 0 || len(tree.ForkingInProgressBranches) > 0 {
+		return nil
+	}
+	return p.checker.Delete(treeID)
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	return p.persistence.GetHistoryTree(ctx, request)
+}
+
+func (p *historyV2ClaimCheckPersistenceClient) Close() {
+	p.persistence.Close()
+}
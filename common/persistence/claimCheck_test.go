@@ -0,0 +1,189 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore/filestore"
+)
+
+type (
+	payloadClaimCheckerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		storeDirectory string
+		checker        PayloadClaimChecker
+	}
+)
+
+const (
+	testClaimCheckDomainID      = "3a5cf9f2-7a6d-4a6a-9a52-5f8d1e2f0c41"
+	testClaimCheckOtherDomainID = "0c0a7f61-8f3c-4f43-8b3e-8d3f6f1a2b55"
+	testClaimCheckOwnerID       = "9d4c1f0e-2b7a-4c8e-9f31-6a2d5e8b7c10"
+	testClaimCheckOtherOwnerID  = "5e7a9c3b-1d2f-4e6a-8b4c-3f9d0a1e2b7c"
+)
+
+func TestPayloadClaimCheckerSuite(t *testing.T) {
+	s := new(payloadClaimCheckerSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadClaimCheckerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "TestPayloadClaimChecker")
+	s.NoError(err)
+	s.storeDirectory = dir
+
+	store, err := filestore.NewClient(&filestore.Config{
+		StoreDirectory: dir,
+		DefaultBucket: filestore.BucketConfig{
+			Name:          "payloads",
+			Owner:         "cadence",
+			RetentionDays: 30,
+		},
+	})
+	s.NoError(err)
+	policy := NewStaticClaimCheckPolicy(
+		map[PayloadType]int{PayloadTypeActivityInput: 8},
+		map[string]map[PayloadType]int{
+			testClaimCheckOtherDomainID: {PayloadTypeActivityInput: 0, PayloadTypeSignalInput: 8},
+		},
+	)
+	s.checker = NewPayloadClaimChecker(store, "payloads", policy)
+}
+
+func (s *payloadClaimCheckerSuite) TearDownTest() {
+	os.RemoveAll(s.storeDirectory)
+}
+
+func (s *payloadClaimCheckerSuite) TestOffloadResolve() {
+	large := []byte("large activity input")
+	events := []*workflow.HistoryEvent{
+		s.newActivityScheduledEvent(1, large),
+		s.newActivityScheduledEvent(2, []byte("small")),
+		s.newSignaledEvent(3, large),
+	}
+
+	offloaded, err := s.checker.Offload(testClaimCheckDomainID, testClaimCheckOwnerID, events)
+	s.NoError(err)
+	s.Len(offloaded, 3)
+	s.True(isClaimCheckReference(offloaded[0].ActivityTaskScheduledEventAttributes.Input))
+	s.Equal([]byte("small"), offloaded[1].ActivityTaskScheduledEventAttributes.Input)
+	s.Equal(large, offloaded[2].WorkflowExecutionSignaledEventAttributes.Input)
+	// the events passed in are left untouched
+	s.Equal(large, events[0].ActivityTaskScheduledEventAttributes.Input)
+
+	s.NoError(s.checker.Resolve(offloaded))
+	s.Equal(events, offloaded)
+}
+
+func (s *payloadClaimCheckerSuite) TestOffload_DomainOverride() {
+	large := []byte("large activity input")
+	events := []*workflow.HistoryEvent{
+		s.newActivityScheduledEvent(1, large),
+		s.newSignaledEvent(2, large),
+	}
+
+	offloaded, err := s.checker.Offload(testClaimCheckOtherDomainID, testClaimCheckOwnerID, events)
+	s.NoError(err)
+	s.Equal(large, offloaded[0].ActivityTaskScheduledEventAttributes.Input)
+	s.True(isClaimCheckReference(offloaded[1].WorkflowExecutionSignaledEventAttributes.Input))
+}
+
+func (s *payloadClaimCheckerSuite) TestOffload_ReferenceLikePayload() {
+	payload := append(append([]byte{}, claimCheckMagic...), "abc"...)
+	events := []*workflow.HistoryEvent{s.newSignaledEvent(1, payload)}
+
+	offloaded, err := s.checker.Offload(testClaimCheckDomainID, testClaimCheckOwnerID, events)
+	s.NoError(err)
+	s.False(bytes.Equal(payload, offloaded[0].WorkflowExecutionSignaledEventAttributes.Input))
+
+	s.NoError(s.checker.Resolve(offloaded))
+	s.Equal(payload, offloaded[0].WorkflowExecutionSignaledEventAttributes.Input)
+}
+
+func (s *payloadClaimCheckerSuite) TestDelete() {
+	large := []byte("large activity input")
+	events := []*workflow.HistoryEvent{s.newActivityScheduledEvent(1, large)}
+
+	offloaded, err := s.checker.Offload(testClaimCheckDomainID, testClaimCheckOwnerID, events)
+	s.NoError(err)
+	// the same payload is stored separately for every owner
+	otherOffloaded, err := s.checker.Offload(testClaimCheckDomainID, testClaimCheckOtherOwnerID, events)
+	s.NoError(err)
+
+	s.NoError(s.checker.Delete(testClaimCheckOwnerID))
+	s.IsType(&workflow.InternalServiceError{}, s.checker.Resolve(offloaded))
+	s.NoError(s.checker.Resolve(otherOffloaded))
+	s.Equal(large, otherOffloaded[0].ActivityTaskScheduledEventAttributes.Input)
+
+	// deleting an owner without payloads is a no-op
+	s.NoError(s.checker.Delete(testClaimCheckOwnerID))
+}
+
+func (s *payloadClaimCheckerSuite) TestResolve_MissingPayload() {
+	reference := append(append([]byte{}, claimCheckMagic...), "missing_0000.payload"...)
+	events := []*workflow.HistoryEvent{s.newActivityScheduledEvent(1, reference)}
+
+	err := s.checker.Resolve(events)
+	s.IsType(&workflow.InternalServiceError{}, err)
+}
+
+func (s *payloadClaimCheckerSuite) TestParsePayloadType() {
+	payloadType, err := ParsePayloadType("activityResult")
+	s.NoError(err)
+	s.Equal(PayloadTypeActivityResult, payloadType)
+
+	_, err = ParsePayloadType("activityHeartbeat")
+	s.Error(err)
+}
+
+func (s *payloadClaimCheckerSuite) newActivityScheduledEvent(eventID int64, input []byte) *workflow.HistoryEvent {
+	return &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(eventID),
+		EventType: common.EventTypePtr(workflow.EventTypeActivityTaskScheduled),
+		ActivityTaskScheduledEventAttributes: &workflow.ActivityTaskScheduledEventAttributes{
+			ActivityId: common.StringPtr("activity"),
+			Input:      input,
+		},
+	}
+}
+
+func (s *payloadClaimCheckerSuite) newSignaledEvent(eventID int64, input []byte) *workflow.HistoryEvent {
+	return &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(eventID),
+		EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionSignaled),
+		WorkflowExecutionSignaledEventAttributes: &workflow.WorkflowExecutionSignaledEventAttributes{
+			SignalName: common.StringPtr("signal"),
+			Input:      input,
+		},
+	}
+}
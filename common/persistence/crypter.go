@@ -0,0 +1,218 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// encryptedPayloadMagic prefixes every encrypted payload, so payloads written before encryption was
+// enabled can still be read. 0xff is not a valid leading byte of thriftrw nor of json encoded data.
+var encryptedPayloadMagic = []byte{0xff, 'c', 'e', 'n', 'c', 1}
+
+var errNoPayloadCrypter = errors.New("payload is encrypted but no payload crypter is configured")
+
+type (
+	// PayloadCrypter encrypts payloads before they are written to persistence and decrypts them after
+	// they are read, so history blobs, the events kept in mutable state and execution context are
+	// encrypted at rest.
+	PayloadCrypter interface {
+		// Encrypt encrypts the payload with the current key of the domain
+		Encrypt(domainID string, data []byte) ([]byte, error)
+		// Decrypt decrypts the payload with the key it was encrypted with,
+		// payloads which are not encrypted are returned as is
+		Decrypt(data []byte) ([]byte, error)
+	}
+
+	// KeyProvider manages the encryption keys of the domains
+	KeyProvider interface {
+		// GetDomainKey returns the id and the value of the key used to encrypt new payloads of the domain,
+		// an empty key id means payloads of the domain are not encrypted
+		GetDomainKey(domainID string) (keyID string, key []byte, err error)
+		// GetKey returns the value of the key with the given id, used to decrypt existing payloads
+		GetKey(keyID string) ([]byte, error)
+	}
+
+	noopPayloadCrypter struct{}
+
+	aesGCMPayloadCrypter struct {
+		keyProvider KeyProvider
+	}
+
+	staticKeyProvider struct {
+		keys         map[string][]byte
+		domainKeyIDs map[string]string
+		defaultKeyID string
+	}
+)
+
+// NewNoopPayloadCrypter returns a PayloadCrypter which stores payloads as is
+func NewNoopPayloadCrypter() PayloadCrypter {
+	return &noopPayloadCrypter{}
+}
+
+// NewAESGCMPayloadCrypter returns a PayloadCrypter which encrypts payloads with AES-GCM
+// using the keys of the given KeyProvider
+func NewAESGCMPayloadCrypter(keyProvider KeyProvider) PayloadCrypter {
+	return &aesGCMPayloadCrypter{
+		keyProvider: keyProvider,
+	}
+}
+
+// NewStaticKeyProvider returns a KeyProvider backed by a fixed set of keys. Domains without
+// an assigned key use the default key, an empty default key id disables encryption for them.
+func NewStaticKeyProvider(keys map[string][]byte, domainKeyIDs map[string]string, defaultKeyID string) KeyProvider {
+	return &staticKeyProvider{
+		keys:         keys,
+		domainKeyIDs: domainKeyIDs,
+		defaultKeyID: defaultKeyID,
+	}
+}
+
+func (c *noopPayloadCrypter) Encrypt(domainID string, data []byte) ([]byte, error) {
+	return data, nil
+}
+
+func (c *noopPayloadCrypter) Decrypt(data []byte) ([]byte, error) {
+	if isEncryptedPayload(data) {
+		return nil, errNoPayloadCrypter
+	}
+	return data, nil
+}
+
+// Encrypt returns magic | key id length | key id | nonce | sealed payload
+func (c *aesGCMPayloadCrypter) Encrypt(domainID string, data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	keyID, key, err := c.keyProvider.GetDomainKey(domainID)
+	if err != nil {
+		return nil, err
+	}
+	if keyID == "" {
+		return data, nil
+	}
+	if len(keyID) > 255 {
+		return nil, fmt.Errorf("encryption key id too long: %v", keyID)
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, len(encryptedPayloadMagic)+1+len(keyID)+len(nonce))
+	header = append(header, encryptedPayloadMagic...)
+	header = append(header, byte(len(keyID)))
+	header = append(header, keyID...)
+	header = append(header, nonce...)
+	// the header is authenticated as well, so the key id cannot be tampered with
+	return aead.Seal(header, nonce, data, header), nil
+}
+
+func (c *aesGCMPayloadCrypter) Decrypt(data []byte) ([]byte, error) {
+	if !isEncryptedPayload(data) {
+		return data, nil
+	}
+	offset := len(encryptedPayloadMagic)
+	if len(data) < offset+1 {
+		return nil, errors.New("encrypted payload is truncated")
+	}
+	keyIDLen := int(data[offset])
+	offset++
+	if len(data) < offset+keyIDLen {
+		return nil, errors.New("encrypted payload is truncated")
+	}
+	keyID := string(data[offset : offset+keyIDLen])
+	offset += keyIDLen
+
+	key, err := c.keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < offset+aead.NonceSize() {
+		return nil, errors.New("encrypted payload is truncated")
+	}
+	nonce := data[offset : offset+aead.NonceSize()]
+	offset += aead.NonceSize()
+	return aead.Open(nil, nonce, data[offset:], data[:offset])
+}
+
+func (p *staticKeyProvider) GetDomainKey(domainID string) (string, []byte, error) {
+	keyID, ok := p.domainKeyIDs[domainID]
+	if !ok {
+		keyID = p.defaultKeyID
+	}
+	if keyID == "" {
+		return "", nil, nil
+	}
+	key, err := p.GetKey(keyID)
+	if err != nil {
+		return "", nil, err
+	}
+	return keyID, key, nil
+}
+
+func (p *staticKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key: %v", keyID)
+	}
+	return key, nil
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func isEncryptedPayload(data []byte) bool {
+	return bytes.HasPrefix(data, encryptedPayloadMagic)
+}
+
+// encryptBlob returns a copy of the blob with its data encrypted for the domain
+func encryptBlob(crypter PayloadCrypter, domainID string, blob *DataBlob) (*DataBlob, error) {
+	if blob == nil {
+		return nil, nil
+	}
+	data, err := crypter.Encrypt(domainID, blob.Data)
+	if err != nil {
+		return nil, err
+	}
+	return NewDataBlob(data, blob.Encoding), nil
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	payloadCrypterSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		crypter PayloadCrypter
+	}
+)
+
+func TestPayloadCrypterSuite(t *testing.T) {
+	s := new(payloadCrypterSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadCrypterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	keys := map[string][]byte{
+		"key-1": bytes.Repeat([]byte{1}, 32),
+		"key-2": bytes.Repeat([]byte{2}, 16),
+	}
+	domainKeys := map[string]string{
+		"domain-with-own-key": "key-2",
+		"plaintext-domain":    "",
+	}
+	s.crypter = NewAESGCMPayloadCrypter(NewStaticKeyProvider(keys, domainKeys, "key-1"))
+}
+
+func (s *payloadCrypterSuite) TestEncryptDecrypt() {
+	payload := []byte("activity input")
+
+	for _, domainID := range []string{"some-domain", "domain-with-own-key"} {
+		encrypted, err := s.crypter.Encrypt(domainID, payload)
+		s.NoError(err)
+		s.True(isEncryptedPayload(encrypted))
+		s.False(bytes.Contains(encrypted, payload))
+
+		decrypted, err := s.crypter.Decrypt(encrypted)
+		s.NoError(err)
+		s.Equal(payload, decrypted)
+	}
+
+	encrypted, err := s.crypter.Encrypt("plaintext-domain", payload)
+	s.NoError(err)
+	s.Equal(payload, encrypted)
+}
+
+func (s *payloadCrypterSuite) TestDecrypt_NotEncrypted() {
+	payload := []byte("written before encryption was enabled")
+	decrypted, err := s.crypter.Decrypt(payload)
+	s.NoError(err)
+	s.Equal(payload, decrypted)
+}
+
+func (s *payloadCrypterSuite) TestDecrypt_Tampered() {
+	encrypted, err := s.crypter.Encrypt("some-domain", []byte("activity input"))
+	s.NoError(err)
+
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = s.crypter.Decrypt(tampered)
+	s.Error(err)
+
+	_, err = s.crypter.Decrypt(encrypted[:len(encryptedPayloadMagic)+3])
+	s.Error(err)
+
+	// the key id is authenticated
+	swapped := bytes.Replace(encrypted, []byte("key-1"), []byte("key-2"), 1)
+	_, err = s.crypter.Decrypt(swapped)
+	s.Error(err)
+}
+
+func (s *payloadCrypterSuite) TestDecrypt_UnknownKey() {
+	encrypted, err := s.crypter.Encrypt("some-domain", []byte("activity input"))
+	s.NoError(err)
+
+	crypter := NewAESGCMPayloadCrypter(NewStaticKeyProvider(map[string][]byte{}, nil, ""))
+	_, err = crypter.Decrypt(encrypted)
+	s.Error(err)
+
+	_, err = NewNoopPayloadCrypter().Decrypt(encrypted)
+	s.Error(err)
+}
+
+func (s *payloadCrypterSuite) TestSerializerDecryptsBlobs() {
+	serializer := NewPayloadSerializerWithCrypter(s.crypter)
+	events := []*workflow.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(5),
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskScheduled),
+			ActivityTaskScheduledEventAttributes: &workflow.ActivityTaskScheduledEventAttributes{
+				ActivityId: common.StringPtr("activity-id"),
+				Input:      []byte("activity input"),
+			},
+		},
+	}
+
+	for _, encodingType := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeJSON} {
+		blob, err := serializer.SerializeBatchEvents(events, encodingType)
+		s.NoError(err)
+		encrypted, err := encryptBlob(s.crypter, "some-domain", blob)
+		s.NoError(err)
+		s.Equal(blob.Encoding, encrypted.Encoding)
+		s.NotEqual(blob.Data, encrypted.Data)
+
+		decoded, err := serializer.DeserializeBatchEvents(encrypted)
+		s.NoError(err)
+		s.True((&workflow.History{Events: events}).Equals(&workflow.History{Events: decoded}))
+
+		// blobs written before encryption was enabled are still readable
+		decoded, err = serializer.DeserializeBatchEvents(blob)
+		s.NoError(err)
+		s.True((&workflow.History{Events: events}).Equals(&workflow.History{Events: decoded}))
+
+		_, err = NewPayloadSerializer().DeserializeBatchEvents(encrypted)
+		s.Error(err)
+	}
+}
+
+func (s *payloadCrypterSuite) TestExecutionManagerEncryptsChildAndReplicationEvents() {
+	m := NewExecutionManagerImpl(nil, s.crypter, nil).(*executionManagerImpl)
+	input := []byte("child workflow input")
+	initiatedEvent := &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(5),
+		EventType: common.EventTypePtr(workflow.EventTypeStartChildWorkflowExecutionInitiated),
+		StartChildWorkflowExecutionInitiatedEventAttributes: &workflow.StartChildWorkflowExecutionInitiatedEventAttributes{
+			WorkflowId: common.StringPtr("child-workflow-id"),
+			Input:      input,
+		},
+	}
+
+	childInfos, err := m.SerializeUpsertChildExecutionInfos("some-domain", []*ChildExecutionInfo{
+		{InitiatedID: 5, InitiatedEvent: initiatedEvent},
+	}, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	s.Len(childInfos, 1)
+	s.True(isEncryptedPayload(childInfos[0].InitiatedEvent.Data))
+	s.False(bytes.Contains(childInfos[0].InitiatedEvent.Data, input))
+
+	decodedChildInfos, err := m.DeserializeChildExecutionInfos(map[int64]*InternalChildExecutionInfo{5: childInfos[0]})
+	s.NoError(err)
+	s.True(initiatedEvent.Equals(decodedChildInfos[5].InitiatedEvent))
+
+	replicationTask, err := m.SerializeNewBufferedReplicationTask("some-domain", &BufferedReplicationTask{
+		FirstEventID: 5,
+		NextEventID:  6,
+		History:      []*workflow.HistoryEvent{initiatedEvent},
+	}, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	s.True(isEncryptedPayload(replicationTask.History.Data))
+	s.Nil(replicationTask.NewRunHistory)
+
+	decodedTasks, err := m.DeserializeBufferedReplicationTasks(map[int64]*InternalBufferedReplicationTask{5: replicationTask})
+	s.NoError(err)
+	s.Len(decodedTasks[5].History, 1)
+	s.True(initiatedEvent.Equals(decodedTasks[5].History[0]))
+}
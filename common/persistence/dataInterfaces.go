@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -66,6 +67,8 @@ const (
 	WorkflowStateCreated = iota
 	WorkflowStateRunning
 	WorkflowStateCompleted
+	// WorkflowStatePaused is a running workflow whose decisions and user timers are suspended
+	WorkflowStatePaused
 )
 
 // Workflow execution close status
@@ -126,6 +129,8 @@ const UnknownNumRowsAffected = -1
 const (
 	WorkflowBackoffTimeoutTypeRetry = iota
 	WorkflowBackoffTimeoutTypeCron
+	// WorkflowBackoffTimeoutTypeDecisionRetry delays the next attempt of a continuously failing decision
+	WorkflowBackoffTimeoutTypeDecisionRetry
 )
 
 const (
@@ -146,9 +151,12 @@ type (
 		Msg string
 	}
 
-	// CurrentWorkflowConditionFailedError represents a failed conditional update for current workflow record
+	// CurrentWorkflowConditionFailedError represents a failed conditional update for current workflow record,
+	// RequestID and RunID are those of the current run when the condition failed while creating a workflow
 	CurrentWorkflowConditionFailedError struct {
-		Msg string
+		Msg       string
+		RequestID string
+		RunID     string
 	}
 
 	// ConditionFailedError represents a failed conditional update for execution record
@@ -194,11 +202,23 @@ type (
 		TimerAckLevel             time.Time
 		ClusterTransferAckLevel   map[string]int64
 		ClusterTimerAckLevel      map[string]time.Time
+		ClusterTransferReadLevel  map[string]int64
+		ClusterTransferAckBitmap  map[string][]byte
+		VisibilityAckLevel        int64
 		TransferFailoverLevels    map[string]TransferFailoverLevel // uuid -> TransferFailoverLevel
 		TimerFailoverLevels       map[string]TimerFailoverLevel    // uuid -> TimerFailoverLevel
 		DomainNotificationVersion int64
 	}
 
+	// TransferQueueState is the checkpoint of a transfer queue processor. All tasks up to AckLevel
+	// are completed, a task above AckLevel is completed if its bit is set in AckBitmap, where bit i
+	// (least significant bit first) stands for task ID AckLevel+1+i.
+	TransferQueueState struct {
+		AckLevel  int64
+		ReadLevel int64
+		AckBitmap []byte
+	}
+
 	// TransferFailoverLevel contains corresponding start / end level
 	TransferFailoverLevel struct {
 		StartTime    time.Time
@@ -251,6 +271,7 @@ type (
 		DecisionTimeout              int32
 		DecisionAttempt              int64
 		DecisionTimestamp            int64
+		DecisionFailureCount         int64
 		CancelRequested              bool
 		CancelRequestID              string
 		StickyTaskList               string
@@ -356,6 +377,8 @@ type (
 		ScheduleID             int64
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
+		CreatedTime            time.Time
+		ScheduledTime          time.Time // time the task was scheduled by history, not persisted
 	}
 
 	// Task is the generic interface for workflow tasks
@@ -495,7 +518,7 @@ type (
 		TaskID              int64
 		EventID             int64
 		Version             int64
-		TimeoutType         int // 0 for retry, 1 for cron.
+		TimeoutType         int // 0 for retry, 1 for cron, 2 for decision retry.
 	}
 
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
@@ -630,6 +653,55 @@ type (
 		NewRunEventStoreVersion int32
 	}
 
+	// ImmutableClusterMetadata is the cluster level settings which cannot change once a datastore is initialized
+	ImmutableClusterMetadata struct {
+		ClusterName            string
+		HistoryShardCount      int
+		InitialFailoverVersion int64
+	}
+
+	// InitializeImmutableClusterMetadataRequest is used to persist the immutable cluster metadata, if not yet persisted
+	InitializeImmutableClusterMetadataRequest struct {
+		ImmutableClusterMetadata
+	}
+
+	// InitializeImmutableClusterMetadataResponse is the response to InitializeImmutableClusterMetadata
+	InitializeImmutableClusterMetadataResponse struct {
+		// PersistedImmutableData is the metadata as stored in the datastore, which is the request
+		// itself if RequestApplied is true
+		PersistedImmutableData ImmutableClusterMetadata
+		RequestApplied         bool
+	}
+
+	// GetSearchAttributesResponse is the response to GetSearchAttributes
+	GetSearchAttributesResponse struct {
+		SearchAttributes map[string]workflow.IndexedValueType
+		// Version is bumped on every successful UpdateSearchAttributes, 0 if nothing was registered yet
+		Version int64
+	}
+
+	// UpdateSearchAttributesRequest is used to replace the registered search attributes, it only
+	// succeeds if PrevVersion matches the version currently stored
+	UpdateSearchAttributesRequest struct {
+		SearchAttributes map[string]workflow.IndexedValueType
+		PrevVersion      int64
+	}
+
+	// GetDynamicConfigResponse is the response to GetDynamicConfig
+	GetDynamicConfigResponse struct {
+		// Config is the encoded dynamic config, nil if nothing was stored yet
+		Config []byte
+		// Version is bumped on every successful UpdateDynamicConfig, 0 if nothing was stored yet
+		Version int64
+	}
+
+	// UpdateDynamicConfigRequest is used to replace the stored dynamic config, it only
+	// succeeds if PrevVersion matches the version currently stored
+	UpdateDynamicConfigRequest struct {
+		Config      []byte
+		PrevVersion int64
+	}
+
 	// CreateShardRequest is used to create a shard in executions table
 	CreateShardRequest struct {
 		ShardInfo *ShardInfo
@@ -838,6 +910,89 @@ type (
 		NextPageToken []byte
 	}
 
+	// PutTransferDLQTaskRequest is used to move a transfer task into the transfer task DLQ of the shard
+	PutTransferDLQTaskRequest struct {
+		TaskInfo *TransferTaskInfo
+	}
+
+	// GetTransferDLQTasksRequest is used to read tasks from the transfer task DLQ
+	GetTransferDLQTasksRequest struct {
+		ReadLevel     int64
+		MaxReadLevel  int64
+		BatchSize     int
+		NextPageToken []byte
+	}
+
+	// GetTransferDLQTasksResponse is the response to GetTransferDLQTasksRequest
+	GetTransferDLQTasksResponse struct {
+		Tasks         []*TransferTaskInfo
+		NextPageToken []byte
+	}
+
+	// RangeDeleteTransferDLQTasksRequest is used to delete a range of tasks from the transfer task DLQ
+	RangeDeleteTransferDLQTasksRequest struct {
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
+	// PutReplicationDLQTaskRequest is used to park a replication task which failed to apply into the
+	// replication task DLQ of the shard for the cluster the task was replicated from
+	PutReplicationDLQTaskRequest struct {
+		SourceClusterName string
+		TaskInfo          *ReplicationTaskInfo
+	}
+
+	// GetReplicationDLQTasksRequest is used to read tasks from the replication task DLQ of a source cluster
+	GetReplicationDLQTasksRequest struct {
+		SourceClusterName string
+		ReadLevel         int64
+		MaxReadLevel      int64
+		BatchSize         int
+		NextPageToken     []byte
+	}
+
+	// GetReplicationDLQTasksResponse is the response to GetReplicationDLQTasksRequest
+	GetReplicationDLQTasksResponse struct {
+		Tasks         []*ReplicationTaskInfo
+		NextPageToken []byte
+	}
+
+	// RangeDeleteReplicationDLQTasksRequest is used to delete a range of tasks from the replication task DLQ
+	// of a source cluster
+	RangeDeleteReplicationDLQTasksRequest struct {
+		SourceClusterName    string
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
+	// WorkflowExecutionAnnotation is a note attached to a workflow execution by an operator,
+	// it is stored outside of the execution and its history
+	WorkflowExecutionAnnotation struct {
+		Key         string
+		Text        string
+		Author      string
+		CreatedTime time.Time
+	}
+
+	// PutWorkflowExecutionAnnotationRequest is used to attach an annotation to a workflow execution,
+	// an existing annotation with the same key is replaced
+	PutWorkflowExecutionAnnotationRequest struct {
+		DomainID   string
+		Execution  workflow.WorkflowExecution
+		Annotation *WorkflowExecutionAnnotation
+	}
+
+	// GetWorkflowExecutionAnnotationsRequest is used to read the annotations of a workflow execution
+	GetWorkflowExecutionAnnotationsRequest struct {
+		DomainID  string
+		Execution workflow.WorkflowExecution
+	}
+
+	// GetWorkflowExecutionAnnotationsResponse is the response to GetWorkflowExecutionAnnotationsRequest
+	GetWorkflowExecutionAnnotationsResponse struct {
+		Annotations []*WorkflowExecutionAnnotation
+	}
+
 	// GetReplicationTasksRequest is used to read tasks from the replication task queue
 	GetReplicationTasksRequest struct {
 		ReadLevel     int64
@@ -1121,6 +1276,8 @@ type (
 		FailoverNotificationVersion int64
 		NotificationVersion         int64
 		TableVersion                int
+		// PreviousName is set to rename the domain from PreviousName to Info.Name
+		PreviousName string
 	}
 
 	// DeleteDomainRequest is used to delete domain entry from domains table
@@ -1223,6 +1380,8 @@ type (
 		Encoding common.EncodingType
 		// The shard to get history node data
 		ShardID *int
+		// The domain of the workflow, used to select the key the events are encrypted with
+		DomainID string
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
@@ -1364,27 +1523,43 @@ type (
 		GetName() string
 		GetShardID() int
 
-		CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error)
-		GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
-		UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
-		ResetMutableState(request *ResetMutableStateRequest) error
-		ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error
-		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
-		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
+		// The context of the request the persistence call is made for, the call becomes part of its
+		// trace and is rate limited with the caller type the context is marked with, see WithCallerType
+		CreateWorkflowExecution(ctx context.Context, request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error)
+		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
+		UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+		ResetMutableState(ctx context.Context, request *ResetMutableStateRequest) error
+		ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest) error
+		DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) error
+		GetCurrentExecution(ctx context.Context, request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
 		// Transfer task related methods
-		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
-		CompleteTransferTask(request *CompleteTransferTaskRequest) error
-		RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error
+		GetTransferTasks(ctx context.Context, request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
+		CompleteTransferTask(ctx context.Context, request *CompleteTransferTaskRequest) error
+		RangeCompleteTransferTask(ctx context.Context, request *RangeCompleteTransferTaskRequest) error
+
+		// Transfer task DLQ related methods
+		PutTransferDLQTask(ctx context.Context, request *PutTransferDLQTaskRequest) error
+		GetTransferDLQTasks(ctx context.Context, request *GetTransferDLQTasksRequest) (*GetTransferDLQTasksResponse, error)
+		RangeDeleteTransferDLQTasks(ctx context.Context, request *RangeDeleteTransferDLQTasksRequest) error
+
+		// Replication task DLQ related methods
+		PutReplicationDLQTask(ctx context.Context, request *PutReplicationDLQTaskRequest) error
+		GetReplicationDLQTasks(ctx context.Context, request *GetReplicationDLQTasksRequest) (*GetReplicationDLQTasksResponse, error)
+		RangeDeleteReplicationDLQTasks(ctx context.Context, request *RangeDeleteReplicationDLQTasksRequest) error
+
+		// Workflow execution annotation related methods
+		PutWorkflowExecutionAnnotation(ctx context.Context, request *PutWorkflowExecutionAnnotationRequest) error
+		GetWorkflowExecutionAnnotations(ctx context.Context, request *GetWorkflowExecutionAnnotationsRequest) (*GetWorkflowExecutionAnnotationsResponse, error)
 
 		// Replication task related methods
-		GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error)
-		CompleteReplicationTask(request *CompleteReplicationTaskRequest) error
+		GetReplicationTasks(ctx context.Context, request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error)
+		CompleteReplicationTask(ctx context.Context, request *CompleteReplicationTaskRequest) error
 
 		// Timer related methods.
-		GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
-		CompleteTimerTask(request *CompleteTimerTaskRequest) error
-		RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error
+		GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
+		CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error
+		RangeCompleteTimerTask(ctx context.Context, request *RangeCompleteTimerTaskRequest) error
 	}
 
 	// ExecutionManagerFactory creates an instance of ExecutionManager for a given shard
@@ -1397,13 +1572,15 @@ type (
 	TaskManager interface {
 		Closeable
 		GetName() string
-		LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
-		UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
-		ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error)
-		DeleteTaskList(request *DeleteTaskListRequest) error
-		CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error)
-		GetTasks(request *GetTasksRequest) (*GetTasksResponse, error)
-		CompleteTask(request *CompleteTaskRequest) error
+
+		// The context of the request the persistence call is made for, see ExecutionManager
+		LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
+		UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
+		ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error)
+		DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error
+		CreateTasks(ctx context.Context, request *CreateTasksRequest) (*CreateTasksResponse, error)
+		GetTasks(ctx context.Context, request *GetTasksRequest) (*GetTasksResponse, error)
+		CompleteTask(ctx context.Context, request *CompleteTaskRequest) error
 		// CompleteTasksLessThan completes tasks less than or equal to the given task id
 		// This API takes a limit parameter which specifies the count of maxRows that
 		// can be deleted. This parameter may be ignored by the underlying storage, but
@@ -1413,7 +1590,7 @@ type (
 		// On success, this method returns:
 		//  - number of rows actually deleted, if limit is honored
 		//  - UnknownNumRowsDeleted, when all rows below value are deleted
-		CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error)
+		CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error)
 	}
 
 	// HistoryManager is used to manage Workflow Execution HistoryEventBatch
@@ -1434,6 +1611,7 @@ type (
 	}
 
 	// HistoryV2Manager is used to manager workflow history events
+	// Events are stored as appended batches, one node per transaction, the history is never rewritten as a whole
 	HistoryV2Manager interface {
 		Closeable
 		GetName() string
@@ -1443,20 +1621,31 @@ type (
 		// For Cadence, treeID is new runID, except for fork(reset), treeID will be the runID that it forks from.
 
 		// AppendHistoryNodes add(or override) a batch of nodes to a history branch
-		AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error)
+		AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error)
 		// ReadHistoryBranch returns history node data for a branch
-		ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error)
+		ReadHistoryBranch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error)
 		// ReadHistoryBranchByBatch returns history node data for a branch ByBatch
-		ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error)
+		ReadHistoryBranchByBatch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error)
 		// ForkHistoryBranch forks a new branch from a old branch
-		ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error)
+		ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error)
 		// CompleteForkBranch will complete the forking process after update mutableState, this is to help preventing data leakage
-		CompleteForkBranch(request *CompleteForkBranchRequest) error
+		CompleteForkBranch(ctx context.Context, request *CompleteForkBranchRequest) error
 		// DeleteHistoryBranch removes a branch
 		// If this is the last branch to delete, it will also remove the root node
-		DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error
+		DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
-		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+		GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+	}
+
+	// ClusterMetadataManager is used to manage cluster level metadata
+	ClusterMetadataManager interface {
+		Closeable
+		GetName() string
+		InitializeImmutableClusterMetadata(request *InitializeImmutableClusterMetadataRequest) (*InitializeImmutableClusterMetadataResponse, error)
+		GetSearchAttributes() (*GetSearchAttributesResponse, error)
+		UpdateSearchAttributes(request *UpdateSearchAttributesRequest) error
+		GetDynamicConfig() (*GetDynamicConfigResponse, error)
+		UpdateDynamicConfig(request *UpdateDynamicConfigRequest) error
 	}
 
 	// MetadataManager is used to manage metadata CRUD for domain entities
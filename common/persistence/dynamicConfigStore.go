@@ -0,0 +1,57 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import "github.com/uber/cadence/common/service/dynamicconfig"
+
+type (
+	dynamicConfigStore struct {
+		clusterMetadataMgr ClusterMetadataManager
+	}
+)
+
+var _ dynamicconfig.ConfigStore = (*dynamicConfigStore)(nil)
+
+// NewDynamicConfigStore creates a dynamic config store which keeps the config on the cluster metadata row
+func NewDynamicConfigStore(clusterMetadataMgr ClusterMetadataManager) dynamicconfig.ConfigStore {
+	return &dynamicConfigStore{
+		clusterMetadataMgr: clusterMetadataMgr,
+	}
+}
+
+func (s *dynamicConfigStore) Load() ([]byte, int64, error) {
+	resp, err := s.clusterMetadataMgr.GetDynamicConfig()
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.Config, resp.Version, nil
+}
+
+func (s *dynamicConfigStore) Store(config []byte, prevVersion int64) error {
+	err := s.clusterMetadataMgr.UpdateDynamicConfig(&UpdateDynamicConfigRequest{
+		Config:      config,
+		PrevVersion: prevVersion,
+	})
+	if _, ok := err.(*ConditionFailedError); ok {
+		return dynamicconfig.ErrConfigStoreVersionMismatch
+	}
+	return err
+}
@@ -21,30 +21,28 @@
 package elasticsearch
 
 import (
-	"github.com/uber/cadence/common/clock"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
-	"github.com/uber/cadence/common/tokenbucket"
 )
 
 // NewESVisibilityManager create a visibility manager for ElasticSearch
 // In history, it only needs kafka producer for writing data;
 // In frontend, it only needs ES client and related config for reading data
-func NewESVisibilityManager(indexName string, esClient es.Client, config *config.VisibilityConfig,
+func NewESVisibilityManager(indexRouter es.IndexRouter, esClient es.Client, config *config.VisibilityConfig,
 	producer messaging.Producer, metricsClient metrics.Client, log log.Logger) p.VisibilityManager {
 
-	visibilityFromESStore := NewElasticSearchVisibilityStore(esClient, indexName, producer, config, log)
+	visibilityFromESStore := NewElasticSearchVisibilityStore(esClient, indexRouter, producer, config, log)
 	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log)
 
 	if config != nil {
 		// wrap with rate limiter
 		if config.MaxQPS() != 0 {
-			esRateLimiter := tokenbucket.New(config.MaxQPS(), clock.NewRealTimeSource())
-			visibilityFromES = p.NewVisibilityPersistenceRateLimitedClient(visibilityFromES, esRateLimiter, log)
+			esRateLimiter := p.NewPriorityRateLimiter(config.MaxQPS())
+			visibilityFromES = p.NewVisibilityPersistenceRateLimitedClient(visibilityFromES, esRateLimiter, p.CallerTypeAPI, log)
 		}
 		// wrap with advanced rate limit for list
 		visibilityFromES = p.NewVisibilitySamplingClient(visibilityFromES, config, metricsClient, log)
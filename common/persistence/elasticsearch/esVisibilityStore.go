@@ -46,11 +46,11 @@ const (
 
 type (
 	esVisibilityStore struct {
-		esClient es.Client
-		index    string
-		producer messaging.Producer
-		logger   log.Logger
-		config   *config.VisibilityConfig
+		esClient    es.Client
+		indexRouter es.IndexRouter
+		producer    messaging.Producer
+		logger      log.Logger
+		config      *config.VisibilityConfig
 	}
 
 	esVisibilityPageToken struct {
@@ -84,13 +84,13 @@ var (
 )
 
 // NewElasticSearchVisibilityStore create a visibility store connecting to ElasticSearch
-func NewElasticSearchVisibilityStore(esClient es.Client, index string, producer messaging.Producer, config *config.VisibilityConfig, logger log.Logger) p.VisibilityStore {
+func NewElasticSearchVisibilityStore(esClient es.Client, indexRouter es.IndexRouter, producer messaging.Producer, config *config.VisibilityConfig, logger log.Logger) p.VisibilityStore {
 	return &esVisibilityStore{
-		esClient: esClient,
-		index:    index,
-		producer: producer,
-		logger:   logger.WithTags(tag.ComponentESVisibilityManager),
-		config:   config,
+		esClient:    esClient,
+		indexRouter: indexRouter,
+		producer:    producer,
+		logger:      logger.WithTags(tag.ComponentESVisibilityManager),
+		config:      config,
 	}
 }
 
@@ -102,6 +102,8 @@ func (v *esVisibilityStore) GetName() string {
 
 func (v *esVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
 	v.checkProducer()
+	// TODO: publish request.SearchAttributes once the indexer accepts the keys of the search attribute registry,
+	// it drops every field which is not defined in common/elasticsearch today
 	msg := getVisibilityMessageForOpenExecution(
 		request.DomainUUID,
 		request.WorkflowID,
@@ -287,7 +289,7 @@ func (v *esVisibilityStore) GetClosedWorkflowExecution(
 
 	ctx := context.Background()
 	params := &es.SearchParameters{
-		Index: v.index,
+		Index: v.indexRouter.GetIndexName(request.DomainUUID),
 		Query: boolQuery,
 	}
 	searchResult, err := v.esClient.Search(ctx, params)
@@ -367,7 +369,7 @@ func (v *esVisibilityStore) getSearchResult(request *p.ListWorkflowExecutionsReq
 
 	ctx := context.Background()
 	params := &es.SearchParameters{
-		Index:    v.index,
+		Index:    v.indexRouter.GetIndexName(request.DomainUUID),
 		Query:    boolQuery,
 		From:     token.From,
 		PageSize: request.PageSize,
@@ -21,15 +21,19 @@
 package persistence
 
 import (
+	"context"
+
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/tracing"
 )
 
 type (
 	// executionManagerImpl implements ExecutionManager based on ExecutionStore, statsComputer and PayloadSerializer
 	executionManagerImpl struct {
 		serializer    PayloadSerializer
+		crypter       PayloadCrypter
 		persistence   ExecutionStore
 		statsComputer statsComputer
 		logger        log.Logger
@@ -39,9 +43,10 @@ type (
 var _ ExecutionManager = (*executionManagerImpl)(nil)
 
 // NewExecutionManagerImpl returns new ExecutionManager
-func NewExecutionManagerImpl(persistence ExecutionStore, logger log.Logger) ExecutionManager {
+func NewExecutionManagerImpl(persistence ExecutionStore, crypter PayloadCrypter, logger log.Logger) ExecutionManager {
 	return &executionManagerImpl{
-		serializer:    NewPayloadSerializer(),
+		serializer:    NewPayloadSerializerWithCrypter(crypter),
+		crypter:       crypter,
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -57,7 +62,14 @@ func (m *executionManagerImpl) GetShardID() int {
 }
 
 //The below three APIs are related to serialization/deserialization
-func (m *executionManagerImpl) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+func (m *executionManagerImpl) GetWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (retResp *GetWorkflowExecutionResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.GetWorkflowExecution", tracing.WorkflowTags(
+		request.DomainID, request.Execution.GetWorkflowId(), request.Execution.GetRunId())...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	response, err := m.persistence.GetWorkflowExecution(request)
 	if err != nil {
 		return nil, err
@@ -102,6 +114,10 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(info *InternalWorkflowEx
 	if err != nil {
 		return nil, err
 	}
+	executionContext, err := m.crypter.Decrypt(info.ExecutionContext)
+	if err != nil {
+		return nil, err
+	}
 
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
@@ -118,7 +134,7 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(info *InternalWorkflowEx
 		WorkflowTypeName:             info.WorkflowTypeName,
 		WorkflowTimeout:              info.WorkflowTimeout,
 		DecisionTimeoutValue:         info.DecisionTimeoutValue,
-		ExecutionContext:             info.ExecutionContext,
+		ExecutionContext:             executionContext,
 		State:                        info.State,
 		CloseStatus:                  info.CloseStatus,
 		LastFirstEventID:             info.LastFirstEventID,
@@ -137,6 +153,7 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(info *InternalWorkflowEx
 		DecisionTimeout:              info.DecisionTimeout,
 		DecisionAttempt:              info.DecisionAttempt,
 		DecisionTimestamp:            info.DecisionTimestamp,
+		DecisionFailureCount:         info.DecisionFailureCount,
 		CancelRequested:              info.CancelRequested,
 		CancelRequestID:              info.CancelRequestID,
 		StickyTaskList:               info.StickyTaskList,
@@ -290,7 +307,14 @@ func (m *executionManagerImpl) DeserializeActivityInfos(infos map[int64]*Interna
 	return newInfos, nil
 }
 
-func (m *executionManagerImpl) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+func (m *executionManagerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (retResp *UpdateWorkflowExecutionResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.UpdateWorkflowExecution", tracing.WorkflowTags(
+		request.ExecutionInfo.DomainID, request.ExecutionInfo.WorkflowID, request.ExecutionInfo.RunID)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	executionInfo, err := m.SerializeExecutionInfo(request.ExecutionInfo, request.Encoding)
 	if err != nil {
 		return nil, err
@@ -299,7 +323,8 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(request *UpdateWorkflowEx
 	if err != nil {
 		return nil, err
 	}
-	upsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(request.UpsertChildExecutionInfos, request.Encoding)
+	domainID := request.ExecutionInfo.DomainID
+	upsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(domainID, request.UpsertChildExecutionInfos, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -309,8 +334,12 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(request *UpdateWorkflowEx
 		if err != nil {
 			return nil, err
 		}
+		newBufferedEvents, err = encryptBlob(m.crypter, domainID, newBufferedEvents)
+		if err != nil {
+			return nil, err
+		}
 	}
-	newBufferedReplicationTask, err := m.SerializeNewBufferedReplicationTask(request.NewBufferedReplicationTask, request.Encoding)
+	newBufferedReplicationTask, err := m.SerializeNewBufferedReplicationTask(domainID, request.NewBufferedReplicationTask, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +379,7 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(request *UpdateWorkflowEx
 	return &UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: msuss}, err1
 }
 
-func (m *executionManagerImpl) SerializeNewBufferedReplicationTask(task *BufferedReplicationTask, encoding common.EncodingType) (*InternalBufferedReplicationTask, error) {
+func (m *executionManagerImpl) SerializeNewBufferedReplicationTask(domainID string, task *BufferedReplicationTask, encoding common.EncodingType) (*InternalBufferedReplicationTask, error) {
 	if task == nil {
 		return nil, nil
 	}
@@ -361,6 +390,10 @@ func (m *executionManagerImpl) SerializeNewBufferedReplicationTask(task *Buffere
 		if err != nil {
 			return nil, err
 		}
+		history, err = encryptBlob(m.crypter, domainID, history)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if task.NewRunHistory != nil {
@@ -368,6 +401,10 @@ func (m *executionManagerImpl) SerializeNewBufferedReplicationTask(task *Buffere
 		if err != nil {
 			return nil, err
 		}
+		newHistory, err = encryptBlob(m.crypter, domainID, newHistory)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &InternalBufferedReplicationTask{
@@ -382,17 +419,25 @@ func (m *executionManagerImpl) SerializeNewBufferedReplicationTask(task *Buffere
 	}, nil
 }
 
-func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(infos []*ChildExecutionInfo, encoding common.EncodingType) ([]*InternalChildExecutionInfo, error) {
+func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(domainID string, infos []*ChildExecutionInfo, encoding common.EncodingType) ([]*InternalChildExecutionInfo, error) {
 	newInfos := make([]*InternalChildExecutionInfo, 0)
 	for _, v := range infos {
 		initiatedEvent, err := m.serializer.SerializeEvent(v.InitiatedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
+		initiatedEvent, err = encryptBlob(m.crypter, domainID, initiatedEvent)
+		if err != nil {
+			return nil, err
+		}
 		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
+		startedEvent, err = encryptBlob(m.crypter, domainID, startedEvent)
+		if err != nil {
+			return nil, err
+		}
 		i := &InternalChildExecutionInfo{
 			InitiatedEvent: initiatedEvent,
 			StartedEvent:   startedEvent,
@@ -419,10 +464,18 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(infos []*ActivityInf
 		if err != nil {
 			return nil, err
 		}
+		scheduledEvent, err = encryptBlob(m.crypter, v.DomainID, scheduledEvent)
+		if err != nil {
+			return nil, err
+		}
 		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
+		startedEvent, err = encryptBlob(m.crypter, v.DomainID, startedEvent)
+		if err != nil {
+			return nil, err
+		}
 		i := &InternalActivityInfo{
 			Version:                        v.Version,
 			ScheduleID:                     v.ScheduleID,
@@ -469,6 +522,14 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 	if err != nil {
 		return nil, err
 	}
+	completionEvent, err = encryptBlob(m.crypter, info.DomainID, completionEvent)
+	if err != nil {
+		return nil, err
+	}
+	executionContext, err := m.crypter.Encrypt(info.DomainID, info.ExecutionContext)
+	if err != nil {
+		return nil, err
+	}
 
 	return &InternalWorkflowExecutionInfo{
 		DomainID:                     info.DomainID,
@@ -484,7 +545,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 		WorkflowTypeName:             info.WorkflowTypeName,
 		WorkflowTimeout:              info.WorkflowTimeout,
 		DecisionTimeoutValue:         info.DecisionTimeoutValue,
-		ExecutionContext:             info.ExecutionContext,
+		ExecutionContext:             executionContext,
 		State:                        info.State,
 		CloseStatus:                  info.CloseStatus,
 		LastFirstEventID:             info.LastFirstEventID,
@@ -503,6 +564,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 		DecisionTimeout:              info.DecisionTimeout,
 		DecisionAttempt:              info.DecisionAttempt,
 		DecisionTimestamp:            info.DecisionTimestamp,
+		DecisionFailureCount:         info.DecisionFailureCount,
 		CancelRequested:              info.CancelRequested,
 		CancelRequestID:              info.CancelRequestID,
 		StickyTaskList:               info.StickyTaskList,
@@ -525,7 +587,14 @@ func (m *executionManagerImpl) SerializeExecutionInfo(info *WorkflowExecutionInf
 	}, nil
 }
 
-func (m *executionManagerImpl) ResetMutableState(request *ResetMutableStateRequest) error {
+func (m *executionManagerImpl) ResetMutableState(
+	ctx context.Context,
+	request *ResetMutableStateRequest,
+) (retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.ResetMutableState", tracing.WorkflowTags(
+		request.ExecutionInfo.DomainID, request.ExecutionInfo.WorkflowID, request.ExecutionInfo.RunID)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	executionInfo, err := m.SerializeExecutionInfo(request.ExecutionInfo, request.Encoding)
 	if err != nil {
 		return err
@@ -534,7 +603,7 @@ func (m *executionManagerImpl) ResetMutableState(request *ResetMutableStateReque
 	if err != nil {
 		return err
 	}
-	insertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(request.InsertChildExecutionInfos, request.Encoding)
+	insertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(request.ExecutionInfo.DomainID, request.InsertChildExecutionInfos, request.Encoding)
 	if err != nil {
 		return err
 	}
@@ -555,7 +624,13 @@ func (m *executionManagerImpl) ResetMutableState(request *ResetMutableStateReque
 	return m.persistence.ResetMutableState(newRequest)
 }
 
-func (m *executionManagerImpl) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+func (m *executionManagerImpl) ResetWorkflowExecution(
+	ctx context.Context,
+	request *ResetWorkflowExecutionRequest,
+) (retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.ResetWorkflowExecution", tracing.WorkflowTags(
+		request.InsertExecutionInfo.DomainID, request.InsertExecutionInfo.WorkflowID, request.InsertExecutionInfo.RunID)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
 
 	currExecution, err := m.SerializeExecutionInfo(request.CurrExecutionInfo, request.Encoding)
 	if err != nil {
@@ -570,7 +645,7 @@ func (m *executionManagerImpl) ResetWorkflowExecution(request *ResetWorkflowExec
 	if err != nil {
 		return err
 	}
-	insertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(request.InsertChildExecutionInfos, request.Encoding)
+	insertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(request.InsertExecutionInfo.DomainID, request.InsertChildExecutionInfos, request.Encoding)
 	if err != nil {
 		return err
 	}
@@ -607,44 +682,101 @@ func (m *executionManagerImpl) ResetWorkflowExecution(request *ResetWorkflowExec
 	return m.persistence.ResetWorkflowExecution(newRequest)
 }
 
-func (m *executionManagerImpl) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
-	return m.persistence.CreateWorkflowExecution(request)
+func (m *executionManagerImpl) CreateWorkflowExecution(
+	ctx context.Context,
+	request *CreateWorkflowExecutionRequest,
+) (retResp *CreateWorkflowExecutionResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.CreateWorkflowExecution", tracing.WorkflowTags(
+		request.DomainID, request.Execution.GetWorkflowId(), request.Execution.GetRunId())...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
+	executionContext, err := m.crypter.Encrypt(request.DomainID, request.ExecutionContext)
+	if err != nil {
+		return nil, err
+	}
+	newRequest := *request
+	newRequest.ExecutionContext = executionContext
+	return m.persistence.CreateWorkflowExecution(&newRequest)
 }
-func (m *executionManagerImpl) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+func (m *executionManagerImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) (retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.DeleteWorkflowExecution", tracing.WorkflowTags(
+		request.DomainID, request.WorkflowID, request.RunID)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	return m.persistence.DeleteWorkflowExecution(request)
 }
 
-func (m *executionManagerImpl) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+func (m *executionManagerImpl) GetCurrentExecution(
+	ctx context.Context,
+	request *GetCurrentExecutionRequest,
+) (retResp *GetCurrentExecutionResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.GetCurrentExecution", tracing.WorkflowTags(
+		request.DomainID, request.WorkflowID, "")...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	return m.persistence.GetCurrentExecution(request)
 }
 
 // Transfer task related methods
-func (m *executionManagerImpl) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+func (m *executionManagerImpl) GetTransferTasks(ctx context.Context, request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	return m.persistence.GetTransferTasks(request)
 }
-func (m *executionManagerImpl) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+func (m *executionManagerImpl) CompleteTransferTask(ctx context.Context, request *CompleteTransferTaskRequest) error {
 	return m.persistence.CompleteTransferTask(request)
 }
-func (m *executionManagerImpl) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+func (m *executionManagerImpl) RangeCompleteTransferTask(ctx context.Context, request *RangeCompleteTransferTaskRequest) error {
 	return m.persistence.RangeCompleteTransferTask(request)
 }
 
+// Transfer task DLQ related methods
+func (m *executionManagerImpl) PutTransferDLQTask(ctx context.Context, request *PutTransferDLQTaskRequest) error {
+	return m.persistence.PutTransferDLQTask(request)
+}
+func (m *executionManagerImpl) GetTransferDLQTasks(ctx context.Context, request *GetTransferDLQTasksRequest) (*GetTransferDLQTasksResponse, error) {
+	return m.persistence.GetTransferDLQTasks(request)
+}
+func (m *executionManagerImpl) RangeDeleteTransferDLQTasks(ctx context.Context, request *RangeDeleteTransferDLQTasksRequest) error {
+	return m.persistence.RangeDeleteTransferDLQTasks(request)
+}
+
+// Replication task DLQ related methods
+func (m *executionManagerImpl) PutReplicationDLQTask(ctx context.Context, request *PutReplicationDLQTaskRequest) error {
+	return m.persistence.PutReplicationDLQTask(request)
+}
+func (m *executionManagerImpl) GetReplicationDLQTasks(ctx context.Context, request *GetReplicationDLQTasksRequest) (*GetReplicationDLQTasksResponse, error) {
+	return m.persistence.GetReplicationDLQTasks(request)
+}
+func (m *executionManagerImpl) RangeDeleteReplicationDLQTasks(ctx context.Context, request *RangeDeleteReplicationDLQTasksRequest) error {
+	return m.persistence.RangeDeleteReplicationDLQTasks(request)
+}
+
+// Workflow execution annotation related methods
+func (m *executionManagerImpl) PutWorkflowExecutionAnnotation(ctx context.Context, request *PutWorkflowExecutionAnnotationRequest) error {
+	return m.persistence.PutWorkflowExecutionAnnotation(request)
+}
+func (m *executionManagerImpl) GetWorkflowExecutionAnnotations(ctx context.Context, request *GetWorkflowExecutionAnnotationsRequest) (*GetWorkflowExecutionAnnotationsResponse, error) {
+	return m.persistence.GetWorkflowExecutionAnnotations(request)
+}
+
 // Replication task related methods
-func (m *executionManagerImpl) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+func (m *executionManagerImpl) GetReplicationTasks(ctx context.Context, request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
 	return m.persistence.GetReplicationTasks(request)
 }
-func (m *executionManagerImpl) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+func (m *executionManagerImpl) CompleteReplicationTask(ctx context.Context, request *CompleteReplicationTaskRequest) error {
 	return m.persistence.CompleteReplicationTask(request)
 }
 
 // Timer related methods.
-func (m *executionManagerImpl) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+func (m *executionManagerImpl) GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
 	return m.persistence.GetTimerIndexTasks(request)
 }
-func (m *executionManagerImpl) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+func (m *executionManagerImpl) CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error {
 	return m.persistence.CompleteTimerTask(request)
 }
-func (m *executionManagerImpl) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+func (m *executionManagerImpl) RangeCompleteTimerTask(ctx context.Context, request *RangeCompleteTimerTaskRequest) error {
 	return m.persistence.RangeCompleteTimerTask(request)
 }
 
@@ -34,6 +34,7 @@ type (
 	// historyManagerImpl implements HistoryManager based on HistoryStore and PayloadSerializer
 	historyManagerImpl struct {
 		serializer  PayloadSerializer
+		crypter     PayloadCrypter
 		persistence HistoryStore
 		logger      log.Logger
 	}
@@ -49,9 +50,10 @@ type (
 var _ HistoryManager = (*historyManagerImpl)(nil)
 
 //NewHistoryManagerImpl returns new HistoryManager
-func NewHistoryManagerImpl(persistence HistoryStore, logger log.Logger) HistoryManager {
+func NewHistoryManagerImpl(persistence HistoryStore, crypter PayloadCrypter, logger log.Logger) HistoryManager {
 	return &historyManagerImpl{
-		serializer:  NewPayloadSerializer(),
+		serializer:  NewPayloadSerializerWithCrypter(crypter),
+		crypter:     crypter,
 		persistence: persistence,
 		logger:      logger,
 	}
@@ -69,6 +71,10 @@ func (m *historyManagerImpl) AppendHistoryEvents(request *AppendHistoryEventsReq
 	if err != nil {
 		return nil, err
 	}
+	eventsData, err = encryptBlob(m.crypter, request.DomainID, eventsData)
+	if err != nil {
+		return nil, err
+	}
 
 	resp := &AppendHistoryEventsResponse{Size: len(eventsData.Data)}
 	return resp, m.persistence.AppendHistoryEvents(
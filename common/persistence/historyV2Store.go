@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -29,6 +30,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/tracing"
 )
 
 type (
@@ -36,6 +38,7 @@ type (
 	// historyManagerImpl implements HistoryManager based on HistoryStore and PayloadSerializer
 	historyV2ManagerImpl struct {
 		historySerializer     PayloadSerializer
+		crypter               PayloadCrypter
 		persistence           HistoryV2Store
 		logger                log.Logger
 		thrifteEncoder        codec.BinaryEncoder
@@ -46,9 +49,10 @@ type (
 var _ HistoryV2Manager = (*historyV2ManagerImpl)(nil)
 
 //NewHistoryV2ManagerImpl returns new HistoryManager
-func NewHistoryV2ManagerImpl(persistence HistoryV2Store, logger log.Logger) HistoryV2Manager {
+func NewHistoryV2ManagerImpl(persistence HistoryV2Store, crypter PayloadCrypter, logger log.Logger) HistoryV2Manager {
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     NewPayloadSerializerWithCrypter(crypter),
+		crypter:               crypter,
 		persistence:           persistence,
 		logger:                logger,
 		thrifteEncoder:        codec.NewThriftRWEncoder(),
@@ -61,7 +65,7 @@ func (m *historyV2ManagerImpl) GetName() string {
 }
 
 // ForkHistoryBranch forks a new branch from a old branch
-func (m *historyV2ManagerImpl) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+func (m *historyV2ManagerImpl) ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
 	if request.ForkNodeID <= 1 {
 		return nil, &InvalidPersistenceRequestError{
 			Msg: fmt.Sprintf("ForkNodeID must be > 1"),
@@ -103,7 +107,7 @@ func (m *historyV2ManagerImpl) ForkHistoryBranch(request *ForkHistoryBranchReque
 }
 
 // DeleteHistoryBranch removes a branch
-func (m *historyV2ManagerImpl) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+func (m *historyV2ManagerImpl) DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error {
 	var branch workflow.HistoryBranch
 	err := m.thrifteEncoder.Decode(request.BranchToken, &branch)
 	if err != nil {
@@ -126,7 +130,7 @@ func (m *historyV2ManagerImpl) DeleteHistoryBranch(request *DeleteHistoryBranchR
 }
 
 // CompleteForkBranch complete the forking process
-func (m *historyV2ManagerImpl) CompleteForkBranch(request *CompleteForkBranchRequest) error {
+func (m *historyV2ManagerImpl) CompleteForkBranch(ctx context.Context, request *CompleteForkBranchRequest) error {
 	var branch workflow.HistoryBranch
 	err := m.thrifteEncoder.Decode(request.BranchToken, &branch)
 	if err != nil {
@@ -150,7 +154,7 @@ func (m *historyV2ManagerImpl) CompleteForkBranch(request *CompleteForkBranchReq
 }
 
 // GetHistoryTree returns all branch information of a tree
-func (m *historyV2ManagerImpl) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+func (m *historyV2ManagerImpl) GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
 	if len(request.TreeID) == 0 {
 		var branch workflow.HistoryBranch
 		err := m.thrifteEncoder.Decode(request.BranchToken, &branch)
@@ -163,7 +167,13 @@ func (m *historyV2ManagerImpl) GetHistoryTree(request *GetHistoryTreeRequest) (*
 }
 
 // AppendHistoryNodes add(or override) a node to a history branch
-func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+func (m *historyV2ManagerImpl) AppendHistoryNodes(
+	ctx context.Context,
+	request *AppendHistoryNodesRequest,
+) (retResp *AppendHistoryNodesResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.AppendHistoryNodes")
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	var branch workflow.HistoryBranch
 	err := m.thrifteEncoder.Decode(request.BranchToken, &branch)
 	if err != nil {
@@ -199,6 +209,13 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesReq
 
 	// nodeID will be the first eventID
 	blob, err := m.historySerializer.SerializeBatchEvents(request.Events, request.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	blob, err = encryptBlob(m.crypter, request.DomainID, blob)
+	if err != nil {
+		return nil, err
+	}
 	size := len(blob.Data)
 
 	shardID, err := getShardID(request.ShardID)
@@ -227,7 +244,13 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesReq
 
 // ReadHistoryBranchByBatch returns history node data for a branch by batch
 // Pagination is implemented here, the actual minNodeID passing to persistence layer is calculated along with token's LastNodeID
-func (m *historyV2ManagerImpl) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+func (m *historyV2ManagerImpl) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (retResp *ReadHistoryBranchByBatchResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.ReadHistoryBranchByBatch")
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	resp := &ReadHistoryBranchByBatchResponse{}
 	var err error
 	_, resp.History, resp.NextPageToken, resp.Size, resp.LastFirstEventID, err = m.readHistoryBranch(true, request)
@@ -239,7 +262,13 @@ func (m *historyV2ManagerImpl) ReadHistoryBranchByBatch(request *ReadHistoryBran
 
 // ReadHistoryBranch returns history node data for a branch
 // Pagination is implemented here, the actual minNodeID passing to persistence layer is calculated along with token's LastNodeID
-func (m *historyV2ManagerImpl) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+func (m *historyV2ManagerImpl) ReadHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (retResp *ReadHistoryBranchResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.ReadHistoryBranch")
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	resp := &ReadHistoryBranchResponse{}
 	var err error
 	resp.HistoryEvents, _, resp.NextPageToken, resp.Size, resp.LastFirstEventID, err = m.readHistoryBranch(false, request)
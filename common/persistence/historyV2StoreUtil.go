@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -63,8 +64,8 @@ that zombie history segments can remain under some rare failure cases. Consider
 Under this rare case the section of parent history which was assumed to be common to child will be a zombie history section.
 
 */
-func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken []byte, shardID *int, logger log.Logger) error {
-	err := historyV2Mgr.DeleteHistoryBranch(&DeleteHistoryBranchRequest{
+func DeleteWorkflowExecutionHistoryV2(ctx context.Context, historyV2Mgr HistoryV2Manager, branchToken []byte, shardID *int, logger log.Logger) error {
+	err := historyV2Mgr.DeleteHistoryBranch(ctx, &DeleteHistoryBranchRequest{
 		BranchToken: branchToken,
 		ShardID:     shardID,
 	})
@@ -79,7 +80,7 @@ func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken
 
 	// we believe this is very rare case to see: DeleteHistoryBranch returns ConditionFailedError means there are some incomplete branches
 
-	resp, err := historyV2Mgr.GetHistoryTree(&GetHistoryTreeRequest{
+	resp, err := historyV2Mgr.GetHistoryTree(ctx, &GetHistoryTreeRequest{
 		BranchToken: branchToken,
 		ShardID:     shardID,
 	})
@@ -100,7 +101,7 @@ func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken
 				if err != nil {
 					return err
 				}
-				err = historyV2Mgr.CompleteForkBranch(&CompleteForkBranchRequest{
+				err = historyV2Mgr.CompleteForkBranch(ctx, &CompleteForkBranchRequest{
 					// actually we don't know it is success or fail. but use true for safety
 					// the worst case is we may leak some data that will never deleted
 					Success:     true,
@@ -118,7 +119,7 @@ func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken
 			}
 		}
 	}
-	err = historyV2Mgr.DeleteHistoryBranch(&DeleteHistoryBranchRequest{
+	err = historyV2Mgr.DeleteHistoryBranch(ctx, &DeleteHistoryBranchRequest{
 		BranchToken: branchToken,
 		ShardID:     shardID,
 	})
@@ -128,11 +129,11 @@ func DeleteWorkflowExecutionHistoryV2(historyV2Mgr HistoryV2Manager, branchToken
 // ReadFullPageV2Events reads a full page of history events from HistoryV2Manager. Due to storage format of V2 History
 // it is not guaranteed that pageSize amount of data is returned. Function returns the list of history events, the size
 // of data read, the next page token, and an error if present. Next page token will be empty when there are no more events to read.
-func ReadFullPageV2Events(historyV2Mgr HistoryV2Manager, req *ReadHistoryBranchRequest) ([]*shared.HistoryEvent, int, []byte, error) {
+func ReadFullPageV2Events(ctx context.Context, historyV2Mgr HistoryV2Manager, req *ReadHistoryBranchRequest) ([]*shared.HistoryEvent, int, []byte, error) {
 	historyEvents := []*shared.HistoryEvent{}
 	size := int(0)
 	for {
-		response, err := historyV2Mgr.ReadHistoryBranch(req)
+		response, err := historyV2Mgr.ReadHistoryBranch(ctx, req)
 		if err != nil {
 			return nil, 0, nil, err
 		}
@@ -0,0 +1,250 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+// The stores keep their own copy of everything they are given and hand out copies of
+// what they hold, callers are free to mutate both requests and responses
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
+
+func copyStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append([]string{}, s...)
+}
+
+func copyBlob(blob *p.DataBlob) *p.DataBlob {
+	if blob == nil {
+		return nil
+	}
+	return &p.DataBlob{Encoding: blob.Encoding, Data: copyBytes(blob.Data)}
+}
+
+func copyDomainIDs(ids map[string]struct{}) map[string]struct{} {
+	if ids == nil {
+		return nil
+	}
+	result := make(map[string]struct{}, len(ids))
+	for id := range ids {
+		result[id] = struct{}{}
+	}
+	return result
+}
+
+func copyShardInfo(info *p.ShardInfo) *p.ShardInfo {
+	result := *info
+	if info.ClusterTransferAckLevel != nil {
+		result.ClusterTransferAckLevel = make(map[string]int64, len(info.ClusterTransferAckLevel))
+		for k, v := range info.ClusterTransferAckLevel {
+			result.ClusterTransferAckLevel[k] = v
+		}
+	}
+	if info.ClusterTimerAckLevel != nil {
+		result.ClusterTimerAckLevel = make(map[string]time.Time, len(info.ClusterTimerAckLevel))
+		for k, v := range info.ClusterTimerAckLevel {
+			result.ClusterTimerAckLevel[k] = v
+		}
+	}
+	if info.ClusterTransferReadLevel != nil {
+		result.ClusterTransferReadLevel = make(map[string]int64, len(info.ClusterTransferReadLevel))
+		for k, v := range info.ClusterTransferReadLevel {
+			result.ClusterTransferReadLevel[k] = v
+		}
+	}
+	if info.ClusterTransferAckBitmap != nil {
+		result.ClusterTransferAckBitmap = make(map[string][]byte, len(info.ClusterTransferAckBitmap))
+		for k, v := range info.ClusterTransferAckBitmap {
+			result.ClusterTransferAckBitmap[k] = append([]byte(nil), v...)
+		}
+	}
+	if info.TransferFailoverLevels != nil {
+		result.TransferFailoverLevels = make(map[string]p.TransferFailoverLevel, len(info.TransferFailoverLevels))
+		for k, v := range info.TransferFailoverLevels {
+			v.DomainIDs = copyDomainIDs(v.DomainIDs)
+			result.TransferFailoverLevels[k] = v
+		}
+	}
+	if info.TimerFailoverLevels != nil {
+		result.TimerFailoverLevels = make(map[string]p.TimerFailoverLevel, len(info.TimerFailoverLevels))
+		for k, v := range info.TimerFailoverLevels {
+			v.DomainIDs = copyDomainIDs(v.DomainIDs)
+			result.TimerFailoverLevels[k] = v
+		}
+	}
+	return &result
+}
+
+func copyReplicationInfo(info map[string]*p.ReplicationInfo) map[string]*p.ReplicationInfo {
+	if info == nil {
+		return nil
+	}
+	result := make(map[string]*p.ReplicationInfo, len(info))
+	for k, v := range info {
+		if v != nil {
+			c := *v
+			v = &c
+		}
+		result[k] = v
+	}
+	return result
+}
+
+func copyReplicationState(state *p.ReplicationState) *p.ReplicationState {
+	if state == nil {
+		return nil
+	}
+	result := *state
+	result.LastReplicationInfo = copyReplicationInfo(state.LastReplicationInfo)
+	return &result
+}
+
+func copyExecutionInfo(info *p.InternalWorkflowExecutionInfo) *p.InternalWorkflowExecutionInfo {
+	result := *info
+	result.CompletionEvent = copyBlob(info.CompletionEvent)
+	result.ExecutionContext = copyBytes(info.ExecutionContext)
+	result.NonRetriableErrors = copyStrings(info.NonRetriableErrors)
+	result.BranchToken = copyBytes(info.BranchToken)
+	return &result
+}
+
+func copyActivityInfo(info *p.InternalActivityInfo) *p.InternalActivityInfo {
+	result := *info
+	result.ScheduledEvent = copyBlob(info.ScheduledEvent)
+	result.StartedEvent = copyBlob(info.StartedEvent)
+	result.Details = copyBytes(info.Details)
+	result.NonRetriableErrors = copyStrings(info.NonRetriableErrors)
+	return &result
+}
+
+func copyTimerInfo(info *p.TimerInfo) *p.TimerInfo {
+	result := *info
+	return &result
+}
+
+func copyChildExecutionInfo(info *p.InternalChildExecutionInfo) *p.InternalChildExecutionInfo {
+	result := *info
+	result.InitiatedEvent = copyBlob(info.InitiatedEvent)
+	result.StartedEvent = copyBlob(info.StartedEvent)
+	return &result
+}
+
+func copyRequestCancelInfo(info *p.RequestCancelInfo) *p.RequestCancelInfo {
+	result := *info
+	return &result
+}
+
+func copySignalInfo(info *p.SignalInfo) *p.SignalInfo {
+	result := *info
+	result.Input = copyBytes(info.Input)
+	result.Control = copyBytes(info.Control)
+	return &result
+}
+
+func copyBufferedReplicationTask(task *p.InternalBufferedReplicationTask) *p.InternalBufferedReplicationTask {
+	result := *task
+	result.History = copyBlob(task.History)
+	result.NewRunHistory = copyBlob(task.NewRunHistory)
+	return &result
+}
+
+func copyMutableState(state *p.InternalWorkflowMutableState) *p.InternalWorkflowMutableState {
+	result := &p.InternalWorkflowMutableState{
+		ActivitInfos:             make(map[int64]*p.InternalActivityInfo, len(state.ActivitInfos)),
+		TimerInfos:               make(map[string]*p.TimerInfo, len(state.TimerInfos)),
+		ChildExecutionInfos:      make(map[int64]*p.InternalChildExecutionInfo, len(state.ChildExecutionInfos)),
+		RequestCancelInfos:       make(map[int64]*p.RequestCancelInfo, len(state.RequestCancelInfos)),
+		SignalInfos:              make(map[int64]*p.SignalInfo, len(state.SignalInfos)),
+		SignalRequestedIDs:       make(map[string]struct{}, len(state.SignalRequestedIDs)),
+		ExecutionInfo:            copyExecutionInfo(state.ExecutionInfo),
+		ReplicationState:         copyReplicationState(state.ReplicationState),
+		BufferedEvents:           make([]*p.DataBlob, 0, len(state.BufferedEvents)),
+		BufferedReplicationTasks: make(map[int64]*p.InternalBufferedReplicationTask, len(state.BufferedReplicationTasks)),
+	}
+	for k, v := range state.ActivitInfos {
+		result.ActivitInfos[k] = copyActivityInfo(v)
+	}
+	for k, v := range state.TimerInfos {
+		result.TimerInfos[k] = copyTimerInfo(v)
+	}
+	for k, v := range state.ChildExecutionInfos {
+		result.ChildExecutionInfos[k] = copyChildExecutionInfo(v)
+	}
+	for k, v := range state.RequestCancelInfos {
+		result.RequestCancelInfos[k] = copyRequestCancelInfo(v)
+	}
+	for k, v := range state.SignalInfos {
+		result.SignalInfos[k] = copySignalInfo(v)
+	}
+	for k := range state.SignalRequestedIDs {
+		result.SignalRequestedIDs[k] = struct{}{}
+	}
+	for _, v := range state.BufferedEvents {
+		result.BufferedEvents = append(result.BufferedEvents, copyBlob(v))
+	}
+	for k, v := range state.BufferedReplicationTasks {
+		result.BufferedReplicationTasks[k] = copyBufferedReplicationTask(v)
+	}
+	return result
+}
+
+func copyReplicationTaskInfo(info *p.ReplicationTaskInfo) *p.ReplicationTaskInfo {
+	result := *info
+	result.LastReplicationInfo = copyReplicationInfo(info.LastReplicationInfo)
+	result.BranchToken = copyBytes(info.BranchToken)
+	result.NewRunBranchToken = copyBytes(info.NewRunBranchToken)
+	return &result
+}
+
+func copyAncestors(ancestors []*shared.HistoryBranchRange) []*shared.HistoryBranchRange {
+	if ancestors == nil {
+		return nil
+	}
+	result := make([]*shared.HistoryBranchRange, len(ancestors))
+	for i, br := range ancestors {
+		copied := *br
+		result[i] = &copied
+	}
+	return result
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
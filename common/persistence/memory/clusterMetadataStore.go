@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	clusterMetadataStore struct {
+		memoryStore
+	}
+)
+
+// newClusterMetadataStore creates an instance of ClusterMetadataStore
+func newClusterMetadataStore(db *db, logger log.Logger) p.ClusterMetadataStore {
+	return &clusterMetadataStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *clusterMetadataStore) InitializeImmutableClusterMetadata(
+	request *p.InitializeImmutableClusterMetadataRequest,
+) (*p.InitializeImmutableClusterMetadataResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if m.db.clusterMetadata != nil {
+		return &p.InitializeImmutableClusterMetadataResponse{
+			PersistedImmutableData: *m.db.clusterMetadata,
+			RequestApplied:         false,
+		}, nil
+	}
+	metadata := request.ImmutableClusterMetadata
+	m.db.clusterMetadata = &metadata
+	return &p.InitializeImmutableClusterMetadataResponse{
+		PersistedImmutableData: request.ImmutableClusterMetadata,
+		RequestApplied:         true,
+	}, nil
+}
+
+func (m *clusterMetadataStore) GetSearchAttributes() (*p.GetSearchAttributesResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	searchAttributes := make(map[string]workflow.IndexedValueType, len(m.db.searchAttributes))
+	for key, valueType := range m.db.searchAttributes {
+		searchAttributes[key] = valueType
+	}
+	return &p.GetSearchAttributesResponse{
+		SearchAttributes: searchAttributes,
+		Version:          m.db.searchAttrsVersion,
+	}, nil
+}
+
+func (m *clusterMetadataStore) UpdateSearchAttributes(request *p.UpdateSearchAttributesRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if m.db.searchAttrsVersion != request.PrevVersion {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("UpdateSearchAttributes operation failed because of version mismatch. Expected: %v, actual: %v",
+				request.PrevVersion, m.db.searchAttrsVersion),
+		}
+	}
+	searchAttributes := make(map[string]workflow.IndexedValueType, len(request.SearchAttributes))
+	for key, valueType := range request.SearchAttributes {
+		searchAttributes[key] = valueType
+	}
+	m.db.searchAttributes = searchAttributes
+	m.db.searchAttrsVersion++
+	return nil
+}
+
+func (m *clusterMetadataStore) GetDynamicConfig() (*p.GetDynamicConfigResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	return &p.GetDynamicConfigResponse{
+		Config:  append([]byte(nil), m.db.dynamicConfig...),
+		Version: m.db.dynamicConfigVer,
+	}, nil
+}
+
+func (m *clusterMetadataStore) UpdateDynamicConfig(request *p.UpdateDynamicConfigRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if m.db.dynamicConfigVer != request.PrevVersion {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("UpdateDynamicConfig operation failed because of version mismatch. Expected: %v, actual: %v",
+				request.PrevVersion, m.db.dynamicConfigVer),
+		}
+	}
+	m.db.dynamicConfig = append([]byte(nil), request.Config...)
+	m.db.dynamicConfigVer++
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+const storeName = "memory"
+
+type (
+	// db is the data set shared by all the stores of a Factory. Every store operation
+	// holds its lock for the whole operation, checks all of its conditions before
+	// making any change and so is applied atomically
+	db struct {
+		sync.Mutex
+		shards              map[int]*p.ShardInfo
+		executions          map[int]*shardExecutions
+		taskLists           map[taskListKey]*p.TaskListInfo
+		tasks               map[taskListKey]map[int64]*p.TaskInfo
+		events              map[executionKey]map[int64]*eventsRow
+		historyTrees        map[string]map[string]*historyTreeRow
+		historyNodes        map[historyBranchKey]map[int64]map[int64]*p.DataBlob
+		domains             map[string]*domainRow
+		domainIDsByName     map[string]string
+		notificationVersion int64
+		clusterMetadata     *p.ImmutableClusterMetadata
+		searchAttributes    map[string]workflow.IndexedValueType
+		searchAttrsVersion  int64
+		dynamicConfig       []byte
+		dynamicConfigVer    int64
+		visibility          map[visibilityKey]*visibilityRow
+	}
+
+	memoryStore struct {
+		db     *db
+		logger log.Logger
+	}
+
+	executionKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+)
+
+var (
+	dbsLock sync.Mutex
+	dbs     = make(map[string]*db)
+)
+
+func newDB() *db {
+	return &db{
+		shards:          make(map[int]*p.ShardInfo),
+		executions:      make(map[int]*shardExecutions),
+		taskLists:       make(map[taskListKey]*p.TaskListInfo),
+		tasks:           make(map[taskListKey]map[int64]*p.TaskInfo),
+		events:          make(map[executionKey]map[int64]*eventsRow),
+		historyTrees:    make(map[string]map[string]*historyTreeRow),
+		historyNodes:    make(map[historyBranchKey]map[int64]map[int64]*p.DataBlob),
+		domains:         make(map[string]*domainRow),
+		domainIDsByName: make(map[string]string),
+		// matches the initial row of the domain_metadata table of the sql schema
+		notificationVersion: 1,
+		visibility:          make(map[visibilityKey]*visibilityRow),
+	}
+}
+
+// getDB returns the data set registered under the given name, creating it on first use.
+// The services of a single binary each build their own persistence factory, looking the
+// data set up by name is what lets them see each other's writes
+func getDB(name string) *db {
+	dbsLock.Lock()
+	defer dbsLock.Unlock()
+	d, ok := dbs[name]
+	if !ok {
+		d = newDB()
+		dbs[name] = d
+	}
+	return d
+}
+
+// dropDB discards the data set registered under the given name
+func dropDB(name string) {
+	dbsLock.Lock()
+	defer dbsLock.Unlock()
+	delete(dbs, name)
+}
+
+func (m *memoryStore) GetName() string {
+	return storeName
+}
+
+// Close is a no-op, the data set outlives the stores so that it can be shared by all of
+// the factories of a process
+func (m *memoryStore) Close() {
+}
+
+func serializePageToken(offset int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(offset))
+	return b
+}
+
+func deserializePageToken(payload []byte) (int64, error) {
+	if len(payload) != 8 {
+		return 0, fmt.Errorf("Invalid token of %v length", len(payload))
+	}
+	return int64(binary.LittleEndian.Uint64(payload)), nil
+}
@@ -0,0 +1,1037 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	executionStore struct {
+		memoryStore
+		shardID int
+	}
+
+	// shardExecutions holds the executions and the task queues of a single history shard
+	shardExecutions struct {
+		currentExecutions   map[currentExecutionKey]*currentExecutionRow
+		executions          map[executionKey]*p.InternalWorkflowMutableState
+		transferTasks       map[int64]*p.TransferTaskInfo
+		transferDLQTasks    map[int64]*p.TransferTaskInfo
+		replicationTasks    map[int64]*p.ReplicationTaskInfo
+		replicationDLQTasks map[string]map[int64]*p.ReplicationTaskInfo
+		timerTasks          map[timerTaskKey]*p.TimerTaskInfo
+		annotations         map[executionKey]map[string]*p.WorkflowExecutionAnnotation
+	}
+
+	currentExecutionKey struct {
+		domainID   string
+		workflowID string
+	}
+
+	currentExecutionRow struct {
+		createRequestID  string
+		runID            string
+		state            int
+		closeStatus      int
+		startVersion     int64
+		lastWriteVersion int64
+	}
+
+	timerTaskKey struct {
+		visibilityTimestamp int64
+		taskID              int64
+	}
+
+	timerTaskPageToken struct {
+		TaskID    int64
+		Timestamp time.Time
+	}
+)
+
+// newExecutionStore creates an instance of ExecutionStore for the given shard
+func newExecutionStore(db *db, shardID int, logger log.Logger) p.ExecutionStore {
+	return &executionStore{
+		memoryStore: memoryStore{db: db, logger: logger},
+		shardID:     shardID,
+	}
+}
+
+func (m *executionStore) GetShardID() int {
+	return m.shardID
+}
+
+func (m *executionStore) CreateWorkflowExecution(request *p.CreateWorkflowExecutionRequest) (*p.CreateWorkflowExecutionResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkRangeID(m.shardID, request.RangeID); err != nil {
+		return nil, err
+	}
+	if request.CreateWorkflowMode == p.CreateWorkflowModeContinueAsNew {
+		return nil, &workflow.InternalServiceError{
+			Message: "CreateWorkflowExecution operation failed. Invalid CreateWorkflowModeContinueAsNew is used",
+		}
+	}
+
+	shard := m.shard()
+	domainID := request.DomainID
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+	row, ok := shard.currentExecutions[currentExecutionKey{domainID: domainID, workflowID: workflowID}]
+	switch request.CreateWorkflowMode {
+	case p.CreateWorkflowModeBrandNew:
+		if ok {
+			lastWriteVersion := common.EmptyVersion
+			if request.ReplicationState != nil {
+				lastWriteVersion = row.lastWriteVersion
+			}
+			return nil, &p.WorkflowExecutionAlreadyStartedError{
+				Msg:              fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowID),
+				StartRequestID:   row.createRequestID,
+				RunID:            row.runID,
+				State:            row.state,
+				CloseStatus:      row.closeStatus,
+				LastWriteVersion: lastWriteVersion,
+			}
+		}
+	case p.CreateWorkflowModeWorkflowIDReuse:
+		if !ok {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to reuse workflow ID. WorkflowId: %v", workflowID),
+			}
+		}
+		if request.PreviousLastWriteVersion != row.lastWriteVersion {
+			return nil, &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"LastWriteVersion: %v, PreviousLastWriteVersion: %v",
+					workflowID, row.lastWriteVersion, request.PreviousLastWriteVersion),
+				RequestID: row.createRequestID,
+				RunID:     row.runID,
+			}
+		}
+		if row.state != p.WorkflowStateCompleted {
+			return nil, &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"State: %v, Expected: %v",
+					workflowID, row.state, p.WorkflowStateCompleted),
+				RequestID: row.createRequestID,
+				RunID:     row.runID,
+			}
+		}
+		if row.runID != request.PreviousRunID {
+			return nil, &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"RunID: %v, PreviousRunID: %v",
+					workflowID, row.runID, request.PreviousRunID),
+				RequestID: row.createRequestID,
+				RunID:     row.runID,
+			}
+		}
+	default:
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Unknown workflow creation mode: %v", request.CreateWorkflowMode),
+		}
+	}
+	if err := shard.checkExecutionAbsent(domainID, workflowID, runID); err != nil {
+		return nil, err
+	}
+
+	shard.createExecution(request, time.Now())
+	shard.addTransferTasks(request.TransferTasks, domainID, workflowID, runID)
+	shard.addReplicationTasks(request.ReplicationTasks, domainID, workflowID, runID)
+	shard.addTimerTasks(request.TimerTasks, domainID, workflowID, runID)
+	return &p.CreateWorkflowExecutionResponse{}, nil
+}
+
+func (m *executionStore) GetWorkflowExecution(request *p.GetWorkflowExecutionRequest) (*p.InternalGetWorkflowExecutionResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}
+	state, ok := m.shard().executions[key]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				key.workflowID, key.runID),
+		}
+	}
+	return &p.InternalGetWorkflowExecutionResponse{State: copyMutableState(state)}, nil
+}
+
+func (m *executionStore) UpdateWorkflowExecution(request *p.InternalUpdateWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkRangeID(m.shardID, request.RangeID); err != nil {
+		return err
+	}
+
+	shard := m.shard()
+	info := request.ExecutionInfo
+	state, err := shard.checkNextEventID(info.DomainID, info.WorkflowID, info.RunID, request.Condition)
+	if err != nil {
+		return err
+	}
+	currentKey := currentExecutionKey{domainID: info.DomainID, workflowID: info.WorkflowID}
+	current, ok := shard.currentExecutions[currentKey]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Current execution of %v does not exist.", info.WorkflowID),
+		}
+	}
+	if newRun := request.ContinueAsNew; newRun != nil {
+		if err := shard.checkExecutionAbsent(newRun.DomainID, newRun.Execution.GetWorkflowId(), newRun.Execution.GetRunId()); err != nil {
+			return err
+		}
+	} else if current.runID != info.RunID {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("ContinueAsNew failed. Current run ID was %v, expected %v", current.runID, info.RunID),
+		}
+	}
+
+	shard.addTransferTasks(request.TransferTasks, info.DomainID, info.WorkflowID, info.RunID)
+	shard.addReplicationTasks(request.ReplicationTasks, info.DomainID, info.WorkflowID, info.RunID)
+	shard.addTimerTasks(request.TimerTasks, info.DomainID, info.WorkflowID, info.RunID)
+	if request.DeleteTimerTask != nil {
+		delete(shard.timerTasks, timerTaskKey{
+			visibilityTimestamp: request.DeleteTimerTask.GetVisibilityTimestamp().UnixNano(),
+			taskID:              request.DeleteTimerTask.GetTaskID(),
+		})
+	}
+
+	state.ExecutionInfo = copyExecutionInfo(info)
+	state.ReplicationState = copyReplicationState(request.ReplicationState)
+	for _, v := range request.UpsertActivityInfos {
+		state.ActivitInfos[v.ScheduleID] = copyActivityInfo(v)
+	}
+	for _, id := range request.DeleteActivityInfos {
+		delete(state.ActivitInfos, id)
+	}
+	for _, v := range request.UpserTimerInfos {
+		state.TimerInfos[v.TimerID] = copyTimerInfo(v)
+	}
+	for _, id := range request.DeleteTimerInfos {
+		delete(state.TimerInfos, id)
+	}
+	for _, v := range request.UpsertChildExecutionInfos {
+		state.ChildExecutionInfos[v.InitiatedID] = copyChildExecutionInfo(v)
+	}
+	if request.DeleteChildExecutionInfo != nil {
+		delete(state.ChildExecutionInfos, *request.DeleteChildExecutionInfo)
+	}
+	for _, v := range request.UpsertRequestCancelInfos {
+		state.RequestCancelInfos[v.InitiatedID] = copyRequestCancelInfo(v)
+	}
+	if request.DeleteRequestCancelInfo != nil {
+		delete(state.RequestCancelInfos, *request.DeleteRequestCancelInfo)
+	}
+	for _, v := range request.UpsertSignalInfos {
+		state.SignalInfos[v.InitiatedID] = copySignalInfo(v)
+	}
+	if request.DeleteSignalInfo != nil {
+		delete(state.SignalInfos, *request.DeleteSignalInfo)
+	}
+	for _, id := range request.UpsertSignalRequestedIDs {
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+	if request.DeleteSignalRequestedID != "" {
+		delete(state.SignalRequestedIDs, request.DeleteSignalRequestedID)
+	}
+	if request.ClearBufferedEvents {
+		state.BufferedEvents = []*p.DataBlob{}
+	} else if request.NewBufferedEvents != nil {
+		state.BufferedEvents = append(state.BufferedEvents, copyBlob(request.NewBufferedEvents))
+	}
+	if task := request.NewBufferedReplicationTask; task != nil {
+		state.BufferedReplicationTasks[task.FirstEventID] = copyBufferedReplicationTask(task)
+	}
+	if request.DeleteBufferedReplicationTask != nil {
+		delete(state.BufferedReplicationTasks, *request.DeleteBufferedReplicationTask)
+	}
+
+	if newRun := request.ContinueAsNew; newRun != nil {
+		shard.createExecution(newRun, time.Now())
+		newWorkflowID := newRun.Execution.GetWorkflowId()
+		newRunID := newRun.Execution.GetRunId()
+		shard.addTransferTasks(newRun.TransferTasks, newRun.DomainID, newWorkflowID, newRunID)
+		shard.addTimerTasks(newRun.TimerTasks, newRun.DomainID, newWorkflowID, newRunID)
+		return nil
+	}
+	shard.currentExecutions[currentKey] = newCurrentExecutionRow(info, request.ReplicationState)
+	return nil
+}
+
+func (m *executionStore) ResetMutableState(request *p.InternalResetMutableStateRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkRangeID(m.shardID, request.RangeID); err != nil {
+		return err
+	}
+
+	shard := m.shard()
+	info := request.ExecutionInfo
+	currentKey := currentExecutionKey{domainID: info.DomainID, workflowID: info.WorkflowID}
+	if _, ok := shard.currentExecutions[currentKey]; !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Current execution of %v does not exist.", info.WorkflowID),
+		}
+	}
+	if _, err := shard.checkNextEventID(info.DomainID, info.WorkflowID, info.RunID, request.Condition); err != nil {
+		return err
+	}
+
+	shard.currentExecutions[currentKey] = newCurrentExecutionRow(info, request.ReplicationState)
+	state := newMutableState(info, request.ReplicationState)
+	insertStateMaps(state, request.InsertActivityInfos, request.InsertTimerInfos, request.InsertChildExecutionInfos,
+		request.InsertRequestCancelInfos, request.InsertSignalInfos, request.InsertSignalRequestedIDs)
+	shard.executions[executionKey{domainID: info.DomainID, workflowID: info.WorkflowID, runID: info.RunID}] = state
+	return nil
+}
+
+func (m *executionStore) ResetWorkflowExecution(request *p.InternalResetWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := m.db.checkRangeID(m.shardID, request.RangeID); err != nil {
+		return err
+	}
+
+	shard := m.shard()
+	currInfo := request.CurrExecutionInfo
+	newInfo := request.InsertExecutionInfo
+	domainID := currInfo.DomainID
+	workflowID := currInfo.WorkflowID
+	currentKey := currentExecutionKey{domainID: domainID, workflowID: workflowID}
+	current, ok := shard.currentExecutions[currentKey]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Current execution of %v does not exist.", workflowID),
+		}
+	}
+	if current.runID != currInfo.RunID {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Current run ID was %v, expected %v", current.runID, currInfo.RunID),
+		}
+	}
+	if request.CurrReplicationState != nil {
+		// only check when with replication
+		if current.lastWriteVersion != request.PrevRunVersion || current.state != request.PrevRunState {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Current run version was %v, state was %v, "+
+					"expected version %v and state %v", current.lastWriteVersion, current.state, request.PrevRunVersion, request.PrevRunState),
+			}
+		}
+	}
+	if request.BaseRunID != currInfo.RunID {
+		if _, ok := shard.executions[executionKey{domainID: domainID, workflowID: workflowID, runID: request.BaseRunID}]; !ok {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ResetWorkflowExecution operation failed. Base run %v does not exist.", request.BaseRunID),
+			}
+		}
+	}
+	currState, err := shard.checkNextEventID(domainID, workflowID, currInfo.RunID, request.Condition)
+	if err != nil {
+		return err
+	}
+	if err := shard.checkExecutionAbsent(newInfo.DomainID, newInfo.WorkflowID, newInfo.RunID); err != nil {
+		return err
+	}
+
+	shard.currentExecutions[currentKey] = newCurrentExecutionRow(newInfo, request.InsertReplicationState)
+	if request.UpdateCurr {
+		shard.addTransferTasks(request.CurrTransferTasks, domainID, workflowID, currInfo.RunID)
+		shard.addTimerTasks(request.CurrTimerTasks, domainID, workflowID, currInfo.RunID)
+		currState.ExecutionInfo = copyExecutionInfo(currInfo)
+		currState.ReplicationState = copyReplicationState(request.CurrReplicationState)
+	}
+	shard.addReplicationTasks(request.CurrReplicationTasks, domainID, workflowID, currInfo.RunID)
+
+	newState := newMutableState(newInfo, request.InsertReplicationState)
+	insertStateMaps(newState, request.InsertActivityInfos, request.InsertTimerInfos, request.InsertChildExecutionInfos,
+		request.InsertRequestCancelInfos, request.InsertSignalInfos, request.InsertSignalRequestedIDs)
+	shard.executions[executionKey{domainID: newInfo.DomainID, workflowID: newInfo.WorkflowID, runID: newInfo.RunID}] = newState
+	shard.addReplicationTasks(request.InsertReplicationTasks, newInfo.DomainID, newInfo.WorkflowID, newInfo.RunID)
+	shard.addTimerTasks(request.InsertTimerTasks, newInfo.DomainID, newInfo.WorkflowID, newInfo.RunID)
+	shard.addTransferTasks(request.InsertTransferTasks, newInfo.DomainID, newInfo.WorkflowID, newInfo.RunID)
+	return nil
+}
+
+func (m *executionStore) DeleteWorkflowExecution(request *p.DeleteWorkflowExecutionRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shard := m.shard()
+	key := executionKey{domainID: request.DomainID, workflowID: request.WorkflowID, runID: request.RunID}
+	delete(shard.executions, key)
+	delete(shard.annotations, key)
+	// a new run of the same workflow may have started after the run being deleted here
+	// was finished, the current record is only removed if it still points to this run
+	currentKey := currentExecutionKey{domainID: request.DomainID, workflowID: request.WorkflowID}
+	if current, ok := shard.currentExecutions[currentKey]; ok && current.runID == request.RunID {
+		delete(shard.currentExecutions, currentKey)
+	}
+	return nil
+}
+
+func (m *executionStore) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	current, ok := m.shard().currentExecutions[currentExecutionKey{domainID: request.DomainID, workflowID: request.WorkflowID}]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v", request.WorkflowID),
+		}
+	}
+	return &p.GetCurrentExecutionResponse{
+		StartRequestID:   current.createRequestID,
+		RunID:            current.runID,
+		State:            current.state,
+		CloseStatus:      current.closeStatus,
+		LastWriteVersion: current.lastWriteVersion,
+	}, nil
+}
+
+func (m *executionStore) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shard := m.shard()
+	var ids []int64
+	for id := range shard.transferTasks {
+		if id > request.ReadLevel && id <= request.MaxReadLevel {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	resp := &p.GetTransferTasksResponse{Tasks: make([]*p.TransferTaskInfo, len(ids))}
+	for i, id := range ids {
+		task := *shard.transferTasks[id]
+		resp.Tasks[i] = &task
+	}
+	return resp, nil
+}
+
+func (m *executionStore) CompleteTransferTask(request *p.CompleteTransferTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.shard().transferTasks, request.TaskID)
+	return nil
+}
+
+func (m *executionStore) RangeCompleteTransferTask(request *p.RangeCompleteTransferTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shard := m.shard()
+	for id := range shard.transferTasks {
+		if id > request.ExclusiveBeginTaskID && id <= request.InclusiveEndTaskID {
+			delete(shard.transferTasks, id)
+		}
+	}
+	return nil
+}
+
+func (m *executionStore) PutTransferDLQTask(request *p.PutTransferDLQTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	task := *request.TaskInfo
+	m.shard().transferDLQTasks[task.TaskID] = &task
+	return nil
+}
+
+func (m *executionStore) GetTransferDLQTasks(request *p.GetTransferDLQTasksRequest) (*p.GetTransferDLQTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken),
+			}
+		}
+	}
+
+	shard := m.shard()
+	var ids []int64
+	for id := range shard.transferDLQTasks {
+		if id > readLevel && id <= request.MaxReadLevel {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	resp := &p.GetTransferDLQTasksResponse{}
+	if len(ids) > request.BatchSize {
+		ids = ids[:request.BatchSize]
+		resp.NextPageToken = serializePageToken(ids[len(ids)-1])
+	}
+	resp.Tasks = make([]*p.TransferTaskInfo, len(ids))
+	for i, id := range ids {
+		task := *shard.transferDLQTasks[id]
+		resp.Tasks[i] = &task
+	}
+	return resp, nil
+}
+
+func (m *executionStore) RangeDeleteTransferDLQTasks(request *p.RangeDeleteTransferDLQTasksRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shard := m.shard()
+	for id := range shard.transferDLQTasks {
+		if id > request.ExclusiveBeginTaskID && id <= request.InclusiveEndTaskID {
+			delete(shard.transferDLQTasks, id)
+		}
+	}
+	return nil
+}
+
+func (m *executionStore) PutWorkflowExecutionAnnotation(request *p.PutWorkflowExecutionAnnotationRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shard := m.shard()
+	key := executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}
+	annotations, ok := shard.annotations[key]
+	if !ok {
+		annotations = make(map[string]*p.WorkflowExecutionAnnotation)
+		shard.annotations[key] = annotations
+	}
+	annotation := *request.Annotation
+	annotations[annotation.Key] = &annotation
+	return nil
+}
+
+func (m *executionStore) GetWorkflowExecutionAnnotations(request *p.GetWorkflowExecutionAnnotationsRequest) (*p.GetWorkflowExecutionAnnotationsResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	annotations := m.shard().annotations[executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}]
+	resp := &p.GetWorkflowExecutionAnnotationsResponse{
+		Annotations: make([]*p.WorkflowExecutionAnnotation, 0, len(annotations)),
+	}
+	for _, annotation := range annotations {
+		copied := *annotation
+		resp.Annotations = append(resp.Annotations, &copied)
+	}
+	sort.Slice(resp.Annotations, func(i, j int) bool { return resp.Annotations[i].Key < resp.Annotations[j].Key })
+	return resp, nil
+}
+
+func (m *executionStore) PutReplicationDLQTask(request *p.PutReplicationDLQTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shard := m.shard()
+	tasks, ok := shard.replicationDLQTasks[request.SourceClusterName]
+	if !ok {
+		tasks = make(map[int64]*p.ReplicationTaskInfo)
+		shard.replicationDLQTasks[request.SourceClusterName] = tasks
+	}
+	tasks[request.TaskInfo.TaskID] = copyReplicationTaskInfo(request.TaskInfo)
+	return nil
+}
+
+func (m *executionStore) GetReplicationDLQTasks(request *p.GetReplicationDLQTasksRequest) (*p.GetReplicationDLQTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken),
+			}
+		}
+	}
+
+	tasks := m.shard().replicationDLQTasks[request.SourceClusterName]
+	var ids []int64
+	for id := range tasks {
+		if id > readLevel && id <= request.MaxReadLevel {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	resp := &p.GetReplicationDLQTasksResponse{}
+	if len(ids) > request.BatchSize {
+		ids = ids[:request.BatchSize]
+		resp.NextPageToken = serializePageToken(ids[len(ids)-1])
+	}
+	resp.Tasks = make([]*p.ReplicationTaskInfo, len(ids))
+	for i, id := range ids {
+		resp.Tasks[i] = copyReplicationTaskInfo(tasks[id])
+	}
+	return resp, nil
+}
+
+func (m *executionStore) RangeDeleteReplicationDLQTasks(request *p.RangeDeleteReplicationDLQTasksRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tasks := m.shard().replicationDLQTasks[request.SourceClusterName]
+	for id := range tasks {
+		if id > request.ExclusiveBeginTaskID && id <= request.InclusiveEndTaskID {
+			delete(tasks, id)
+		}
+	}
+	return nil
+}
+
+func (m *executionStore) GetReplicationTasks(request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken),
+			}
+		}
+	}
+
+	shard := m.shard()
+	var ids []int64
+	for id := range shard.replicationTasks {
+		if id > readLevel && id <= request.MaxReadLevel {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) == 0 {
+		return &p.GetReplicationTasksResponse{}, nil
+	}
+
+	resp := &p.GetReplicationTasksResponse{}
+	if len(ids) > request.BatchSize {
+		ids = ids[:request.BatchSize]
+		resp.NextPageToken = serializePageToken(ids[len(ids)-1])
+	}
+	resp.Tasks = make([]*p.ReplicationTaskInfo, len(ids))
+	for i, id := range ids {
+		resp.Tasks[i] = copyReplicationTaskInfo(shard.replicationTasks[id])
+	}
+	return resp, nil
+}
+
+func (m *executionStore) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.shard().replicationTasks, request.TaskID)
+	return nil
+}
+
+func (m *executionStore) GetTimerIndexTasks(request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.MinTimestamp}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing timerTaskPageToken: %v", err),
+			}
+		}
+	}
+
+	shard := m.shard()
+	var timers []*p.TimerTaskInfo
+	for _, timer := range shard.timerTasks {
+		ts := timer.VisibilityTimestamp
+		afterToken := ts.After(pageToken.Timestamp) || (ts.Equal(pageToken.Timestamp) && timer.TaskID >= pageToken.TaskID)
+		if afterToken && ts.Before(request.MaxTimestamp) {
+			timers = append(timers, timer)
+		}
+	}
+	sort.Slice(timers, func(i, j int) bool {
+		if !timers[i].VisibilityTimestamp.Equal(timers[j].VisibilityTimestamp) {
+			return timers[i].VisibilityTimestamp.Before(timers[j].VisibilityTimestamp)
+		}
+		return timers[i].TaskID < timers[j].TaskID
+	})
+
+	resp := &p.GetTimerIndexTasksResponse{}
+	if len(timers) > request.BatchSize {
+		next := timers[request.BatchSize]
+		token, err := json.Marshal(&timerTaskPageToken{TaskID: next.TaskID, Timestamp: next.VisibilityTimestamp})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetTimerTasks: error serializing page token: %v", err),
+			}
+		}
+		resp.NextPageToken = token
+		timers = timers[:request.BatchSize]
+	}
+	resp.Timers = make([]*p.TimerTaskInfo, len(timers))
+	for i, timer := range timers {
+		task := *timer
+		resp.Timers[i] = &task
+	}
+	return resp, nil
+}
+
+func (m *executionStore) CompleteTimerTask(request *p.CompleteTimerTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.shard().timerTasks, timerTaskKey{
+		visibilityTimestamp: request.VisibilityTimestamp.UnixNano(),
+		taskID:              request.TaskID,
+	})
+	return nil
+}
+
+func (m *executionStore) RangeCompleteTimerTask(request *p.RangeCompleteTimerTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shard := m.shard()
+	for key, timer := range shard.timerTasks {
+		ts := timer.VisibilityTimestamp
+		if !ts.Before(request.InclusiveBeginTimestamp) && ts.Before(request.ExclusiveEndTimestamp) {
+			delete(shard.timerTasks, key)
+		}
+	}
+	return nil
+}
+
+// shard returns the executions of the shard of this store, must be called with the db lock held
+func (m *executionStore) shard() *shardExecutions {
+	shard, ok := m.db.executions[m.shardID]
+	if !ok {
+		shard = &shardExecutions{
+			currentExecutions:   make(map[currentExecutionKey]*currentExecutionRow),
+			executions:          make(map[executionKey]*p.InternalWorkflowMutableState),
+			transferTasks:       make(map[int64]*p.TransferTaskInfo),
+			transferDLQTasks:    make(map[int64]*p.TransferTaskInfo),
+			replicationTasks:    make(map[int64]*p.ReplicationTaskInfo),
+			replicationDLQTasks: make(map[string]map[int64]*p.ReplicationTaskInfo),
+			timerTasks:          make(map[timerTaskKey]*p.TimerTaskInfo),
+			annotations:         make(map[executionKey]map[string]*p.WorkflowExecutionAnnotation),
+		}
+		m.db.executions[m.shardID] = shard
+	}
+	return shard
+}
+
+// checkNextEventID returns the state of the given execution if its next event ID is the expected one
+func (s *shardExecutions) checkNextEventID(domainID, workflowID, runID string, condition int64) (*p.InternalWorkflowMutableState, error) {
+	state, ok := s.executions[executionKey{domainID: domainID, workflowID: workflowID, runID: runID}]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Failed to lock executions row with (domain, workflow, run) = (%v,%v,%v) which does not exist.",
+				domainID, workflowID, runID),
+		}
+	}
+	if state.ExecutionInfo.NextEventID != condition {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("next_event_id was %v when it should have been %v.", state.ExecutionInfo.NextEventID, condition),
+		}
+	}
+	return state, nil
+}
+
+func (s *shardExecutions) checkExecutionAbsent(domainID, workflowID, runID string) error {
+	if _, ok := s.executions[executionKey{domainID: domainID, workflowID: workflowID, runID: runID}]; ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to insert executions row with (domain, workflow, run) = (%v,%v,%v) which already exists.",
+				domainID, workflowID, runID),
+		}
+	}
+	return nil
+}
+
+// createExecution writes the new execution of the request and points the current execution to it
+func (s *shardExecutions) createExecution(request *p.CreateWorkflowExecutionRequest, now time.Time) {
+	domainID := request.DomainID
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+
+	current := &currentExecutionRow{
+		createRequestID:  request.RequestID,
+		runID:            runID,
+		state:            p.WorkflowStateRunning,
+		closeStatus:      p.WorkflowCloseStatusNone,
+		startVersion:     common.EmptyVersion,
+		lastWriteVersion: common.EmptyVersion,
+	}
+	if request.ReplicationState != nil {
+		current.startVersion = request.ReplicationState.StartVersion
+		current.lastWriteVersion = request.ReplicationState.LastWriteVersion
+	}
+	if request.ParentExecution != nil {
+		current.state = p.WorkflowStateCreated
+	}
+	s.currentExecutions[currentExecutionKey{domainID: domainID, workflowID: workflowID}] = current
+
+	info := &p.InternalWorkflowExecutionInfo{
+		DomainID:             domainID,
+		WorkflowID:           workflowID,
+		RunID:                runID,
+		TaskList:             request.TaskList,
+		WorkflowTypeName:     request.WorkflowTypeName,
+		WorkflowTimeout:      request.WorkflowTimeout,
+		DecisionTimeoutValue: request.DecisionTimeoutValue,
+		ExecutionContext:     request.ExecutionContext,
+		State:                p.WorkflowStateCreated,
+		CloseStatus:          p.WorkflowCloseStatusNone,
+		LastFirstEventID:     common.FirstEventID,
+		LastEventTaskID:      request.LastEventTaskID,
+		NextEventID:          request.NextEventID,
+		LastProcessedEvent:   request.LastProcessedEvent,
+		StartTimestamp:       now,
+		LastUpdatedTimestamp: now,
+		CreateRequestID:      request.RequestID,
+		SignalCount:          request.SignalCount,
+		HistorySize:          request.HistorySize,
+		DecisionVersion:      request.DecisionVersion,
+		DecisionScheduleID:   request.DecisionScheduleID,
+		DecisionStartedID:    request.DecisionStartedID,
+		DecisionTimeout:      request.DecisionStartToCloseTimeout,
+		Attempt:              request.Attempt,
+		HasRetryPolicy:       request.HasRetryPolicy,
+		InitialInterval:      request.InitialInterval,
+		BackoffCoefficient:   request.BackoffCoefficient,
+		MaximumInterval:      request.MaximumInterval,
+		ExpirationTime:       request.ExpirationTime,
+		MaximumAttempts:      request.MaximumAttempts,
+		NonRetriableErrors:   request.NonRetriableErrors,
+		CronSchedule:         request.CronSchedule,
+		ExpirationSeconds:    request.ExpirationSeconds,
+	}
+	if request.ParentExecution != nil {
+		info.ParentDomainID = request.ParentDomainID
+		info.ParentWorkflowID = request.ParentExecution.GetWorkflowId()
+		info.ParentRunID = request.ParentExecution.GetRunId()
+		info.InitiatedID = request.InitiatedID
+	}
+	if request.EventStoreVersion == p.EventStoreVersionV2 {
+		info.EventStoreVersion = p.EventStoreVersionV2
+		info.BranchToken = request.BranchToken
+	}
+	s.executions[executionKey{domainID: domainID, workflowID: workflowID, runID: runID}] = newMutableState(info, request.ReplicationState)
+}
+
+func (s *shardExecutions) addTransferTasks(tasks []p.Task, domainID, workflowID, runID string) {
+	for _, task := range tasks {
+		info := &p.TransferTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+			TaskID:              task.GetTaskID(),
+			TargetDomainID:      domainID,
+			TargetWorkflowID:    p.TransferTaskTransferTargetWorkflowID,
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+		}
+		switch t := task.(type) {
+		case *p.ActivityTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+		case *p.DecisionTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+			info.RecordVisibility = t.RecordVisibility
+		case *p.CancelExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.TargetRunID = t.TargetRunID
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+		case *p.SignalExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.TargetRunID = t.TargetRunID
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+		case *p.StartChildExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.ScheduleID = t.InitiatedID
+		}
+		s.transferTasks[info.TaskID] = info
+	}
+}
+
+func (s *shardExecutions) addReplicationTasks(tasks []p.Task, domainID, workflowID, runID string) {
+	for _, task := range tasks {
+		info := &p.ReplicationTaskInfo{
+			DomainID:     domainID,
+			WorkflowID:   workflowID,
+			RunID:        runID,
+			TaskID:       task.GetTaskID(),
+			TaskType:     task.GetType(),
+			FirstEventID: common.EmptyEventID,
+			NextEventID:  common.EmptyEventID,
+			Version:      task.GetVersion(),
+			ScheduledID:  common.EmptyEventID,
+		}
+		switch t := task.(type) {
+		case *p.HistoryReplicationTask:
+			info.FirstEventID = t.FirstEventID
+			info.NextEventID = t.NextEventID
+			info.LastReplicationInfo = copyReplicationInfo(t.LastReplicationInfo)
+			info.EventStoreVersion = t.EventStoreVersion
+			info.BranchToken = copyBytes(t.BranchToken)
+			info.NewRunEventStoreVersion = t.NewRunEventStoreVersion
+			info.NewRunBranchToken = copyBytes(t.NewRunBranchToken)
+			info.ResetWorkflow = t.ResetWorkflow
+			if info.LastReplicationInfo == nil {
+				info.LastReplicationInfo = map[string]*p.ReplicationInfo{}
+			}
+		case *p.SyncActivityTask:
+			info.ScheduledID = t.ScheduledID
+		}
+		s.replicationTasks[info.TaskID] = info
+	}
+}
+
+func (s *shardExecutions) addTimerTasks(tasks []p.Task, domainID, workflowID, runID string) {
+	for _, task := range tasks {
+		info := &p.TimerTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+			TaskID:              task.GetTaskID(),
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+		}
+		switch t := task.(type) {
+		case *p.DecisionTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.ScheduleAttempt
+		case *p.ActivityTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.Attempt
+		case *p.UserTimerTask:
+			info.EventID = t.EventID
+		case *p.ActivityRetryTimerTask:
+			info.EventID = t.EventID
+			info.ScheduleAttempt = int64(t.Attempt)
+		case *p.WorkflowBackoffTimerTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+		}
+		key := timerTaskKey{visibilityTimestamp: info.VisibilityTimestamp.UnixNano(), taskID: info.TaskID}
+		s.timerTasks[key] = info
+	}
+}
+
+func newCurrentExecutionRow(info *p.InternalWorkflowExecutionInfo, replicationState *p.ReplicationState) *currentExecutionRow {
+	row := &currentExecutionRow{
+		createRequestID:  info.CreateRequestID,
+		runID:            info.RunID,
+		state:            info.State,
+		closeStatus:      info.CloseStatus,
+		startVersion:     common.EmptyVersion,
+		lastWriteVersion: common.EmptyVersion,
+	}
+	if replicationState != nil {
+		row.startVersion = replicationState.StartVersion
+		row.lastWriteVersion = replicationState.LastWriteVersion
+	}
+	return row
+}
+
+// newMutableState returns the state of an execution without any activity, timer, child
+// execution, request cancel, signal or buffered event
+func newMutableState(info *p.InternalWorkflowExecutionInfo, replicationState *p.ReplicationState) *p.InternalWorkflowMutableState {
+	return &p.InternalWorkflowMutableState{
+		ActivitInfos:             make(map[int64]*p.InternalActivityInfo),
+		TimerInfos:               make(map[string]*p.TimerInfo),
+		ChildExecutionInfos:      make(map[int64]*p.InternalChildExecutionInfo),
+		RequestCancelInfos:       make(map[int64]*p.RequestCancelInfo),
+		SignalInfos:              make(map[int64]*p.SignalInfo),
+		SignalRequestedIDs:       make(map[string]struct{}),
+		ExecutionInfo:            copyExecutionInfo(info),
+		ReplicationState:         copyReplicationState(replicationState),
+		BufferedEvents:           []*p.DataBlob{},
+		BufferedReplicationTasks: make(map[int64]*p.InternalBufferedReplicationTask),
+	}
+}
+
+func insertStateMaps(
+	state *p.InternalWorkflowMutableState,
+	activityInfos []*p.InternalActivityInfo,
+	timerInfos []*p.TimerInfo,
+	childExecutionInfos []*p.InternalChildExecutionInfo,
+	requestCancelInfos []*p.RequestCancelInfo,
+	signalInfos []*p.SignalInfo,
+	signalRequestedIDs []string,
+) {
+	for _, v := range activityInfos {
+		state.ActivitInfos[v.ScheduleID] = copyActivityInfo(v)
+	}
+	for _, v := range timerInfos {
+		state.TimerInfos[v.TimerID] = copyTimerInfo(v)
+	}
+	for _, v := range childExecutionInfos {
+		state.ChildExecutionInfos[v.InitiatedID] = copyChildExecutionInfo(v)
+	}
+	for _, v := range requestCancelInfos {
+		state.RequestCancelInfos[v.InitiatedID] = copyRequestCancelInfo(v)
+	}
+	for _, v := range signalInfos {
+		state.SignalInfos[v.InitiatedID] = copySignalInfo(v)
+	}
+	for _, id := range signalRequestedIDs {
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// Factory vends store objects backed by memory, the data does not survive a
+	// restart of the process and is only meant for local development and tests
+	Factory struct {
+		cfg         config.InMemory
+		clusterName string
+		logger      log.Logger
+		db          *db
+	}
+)
+
+// NewFactory returns an instance of a factory object which can be used to create
+// datastores backed by memory. All the factories of a process that are created
+// with the same data set name share their data
+func NewFactory(cfg config.InMemory, clusterName string, logger log.Logger) *Factory {
+	return &Factory{cfg: cfg, clusterName: clusterName, logger: logger, db: getDB(cfg.Name)}
+}
+
+// NewTaskStore returns a new task store
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	return newTaskStore(f.db, f.logger), nil
+}
+
+// NewShardStore returns a new shard store
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	return newShardStore(f.db, f.clusterName, f.logger), nil
+}
+
+// NewHistoryStore returns a new history store
+func (f *Factory) NewHistoryStore() (p.HistoryStore, error) {
+	return newHistoryStore(f.db, f.logger), nil
+}
+
+// NewHistoryV2Store returns a new history store
+func (f *Factory) NewHistoryV2Store() (p.HistoryV2Store, error) {
+	return newHistoryV2Store(f.db, f.logger), nil
+}
+
+// NewMetadataStore returns a new metadata store
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	return newMetadataStore(f.db, f.clusterName, f.logger), nil
+}
+
+// NewMetadataStoreV1 returns the default metadatastore
+func (f *Factory) NewMetadataStoreV1() (p.MetadataStore, error) {
+	return f.NewMetadataStore()
+}
+
+// NewMetadataStoreV2 returns the default metadatastore
+func (f *Factory) NewMetadataStoreV2() (p.MetadataStore, error) {
+	return f.NewMetadataStore()
+}
+
+// NewClusterMetadataStore returns a new cluster metadata store
+func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
+	return newClusterMetadataStore(f.db, f.logger), nil
+}
+
+// NewExecutionStore returns an ExecutionStore for a given shardID
+func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
+	return newExecutionStore(f.db, shardID, f.logger), nil
+}
+
+// NewVisibilityStore returns a visibility store
+func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
+	return newVisibilityStore(f.db, f.logger), nil
+}
+
+// Close closes the factory, the data set is kept for the other factories of the process
+func (f *Factory) Close() {
+}
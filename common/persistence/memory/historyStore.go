@@ -0,0 +1,173 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	historyStore struct {
+		memoryStore
+	}
+
+	// eventsRow is a batch of history events, keyed by its first event ID
+	eventsRow struct {
+		batchVersion int64
+		rangeID      int64
+		txID         int64
+		data         *p.DataBlob
+	}
+)
+
+// newHistoryStore creates an instance of HistoryStore
+func newHistoryStore(db *db, logger log.Logger) p.HistoryStore {
+	return &historyStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *historyStore) AppendHistoryEvents(request *p.InternalAppendHistoryEventsRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}
+	batches, ok := m.db.events[key]
+	if !ok {
+		batches = make(map[int64]*eventsRow)
+		m.db.events[key] = batches
+	}
+
+	existing, ok := batches[request.FirstEventID]
+	if request.Overwrite {
+		if !ok {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("AppendHistoryEvents: no event batch starting at %v to overwrite", request.FirstEventID),
+			}
+		}
+		if existing.rangeID > request.RangeID {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("expected rangedID <=%v, got %v", request.RangeID, existing.rangeID),
+			}
+		}
+		if existing.txID >= request.TransactionID {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("expected txID < %v, got %v", request.TransactionID, existing.txID),
+			}
+		}
+	} else if ok {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("AppendHistoryEvents: event batch starting at %v already exist", request.FirstEventID),
+		}
+	}
+
+	batches[request.FirstEventID] = &eventsRow{
+		batchVersion: request.EventBatchVersion,
+		rangeID:      request.RangeID,
+		txID:         request.TransactionID,
+		data:         copyBlob(request.Events),
+	}
+	return nil
+}
+
+func (m *historyStore) GetWorkflowExecutionHistory(request *p.InternalGetWorkflowExecutionHistoryRequest) (
+	*p.InternalGetWorkflowExecutionHistoryResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	offset := request.FirstEventID - 1
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if offset, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken)}
+		}
+	}
+
+	batches := m.db.events[executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}]
+	var firstEventIDs []int64
+	for id := range batches {
+		if id > offset && id < request.NextEventID {
+			firstEventIDs = append(firstEventIDs, id)
+		}
+	}
+	if len(firstEventIDs) == 0 {
+		return &p.InternalGetWorkflowExecutionHistoryResponse{}, nil
+	}
+	sort.Slice(firstEventIDs, func(i, j int) bool { return firstEventIDs[i] < firstEventIDs[j] })
+	if len(firstEventIDs) > request.PageSize {
+		firstEventIDs = firstEventIDs[:request.PageSize]
+	}
+
+	history := make([]*p.DataBlob, 0, len(firstEventIDs))
+	lastEventBatchVersion := request.LastEventBatchVersion
+	for _, id := range firstEventIDs {
+		row := batches[id]
+		eventBatchVersion := common.EmptyVersion
+		if row.batchVersion > 0 {
+			eventBatchVersion = row.batchVersion
+		}
+		if eventBatchVersion >= lastEventBatchVersion {
+			history = append(history, copyBlob(row.data))
+			lastEventBatchVersion = eventBatchVersion
+		}
+		offset = id
+	}
+
+	var nextPageToken []byte
+	if len(firstEventIDs) >= request.PageSize {
+		nextPageToken = serializePageToken(offset)
+	}
+	return &p.InternalGetWorkflowExecutionHistoryResponse{
+		History:               history,
+		LastEventBatchVersion: lastEventBatchVersion,
+		NextPageToken:         nextPageToken,
+	}, nil
+}
+
+func (m *historyStore) DeleteWorkflowExecutionHistory(request *p.DeleteWorkflowExecutionHistoryRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.events, executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	})
+	return nil
+}
@@ -0,0 +1,347 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	historyV2Store struct {
+		memoryStore
+	}
+
+	historyBranchKey struct {
+		treeID   string
+		branchID string
+	}
+
+	historyTreeRow struct {
+		ancestors   []*shared.HistoryBranchRange
+		info        string
+		inProgress  bool
+		createdTime time.Time
+	}
+)
+
+// newHistoryV2Store creates an instance of HistoryV2Store
+func newHistoryV2Store(db *db, logger log.Logger) p.HistoryV2Store {
+	return &historyV2Store{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (m *historyV2Store) AppendHistoryNodes(request *p.InternalAppendHistoryNodesRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	branchInfo := request.BranchInfo
+	if request.NodeID < p.GetBeginNodeID(branchInfo) {
+		return &p.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("cannot append to ancestors' nodes"),
+		}
+	}
+
+	treeID := branchInfo.GetTreeID()
+	branchID := branchInfo.GetBranchID()
+	nodes := m.db.historyNodes[historyBranchKey{treeID: treeID, branchID: branchID}]
+	if _, ok := nodes[request.NodeID][request.TransactionID]; ok {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("AppendHistoryNodes: node %v of txn %v already exist", request.NodeID, request.TransactionID),
+		}
+	}
+	if request.IsNewBranch {
+		if _, ok := m.db.historyTrees[treeID][branchID]; ok {
+			return &shared.InternalServiceError{
+				Message: fmt.Sprintf("AppendHistoryNodes: branch %v of tree %v already exist", branchID, treeID),
+			}
+		}
+		m.db.putHistoryTreeRow(treeID, branchID, &historyTreeRow{
+			ancestors:   copyAncestors(branchInfo.Ancestors),
+			info:        request.Info,
+			inProgress:  false,
+			createdTime: time.Now(),
+		})
+	}
+	m.db.putHistoryNode(historyBranchKey{treeID: treeID, branchID: branchID}, request.NodeID, request.TransactionID, request.Events)
+	return nil
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (m *historyV2Store) ReadHistoryBranch(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	minNodeID := request.MinNodeID
+	if len(request.NextPageToken) > 0 {
+		lastNodeID, err := deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &shared.InternalServiceError{
+				Message: fmt.Sprintf("invalid next page token %v", request.NextPageToken)}
+		}
+		minNodeID = lastNodeID + 1
+	}
+
+	nodes := m.db.historyNodes[historyBranchKey{treeID: request.TreeID, branchID: request.BranchID}]
+	var nodeIDs []int64
+	for nodeID := range nodes {
+		if nodeID >= minNodeID && nodeID < request.MaxNodeID {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	if len(nodeIDs) == 0 {
+		return &p.InternalReadHistoryBranchResponse{}, nil
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	var pagingToken []byte
+	if len(nodeIDs) > request.PageSize {
+		nodeIDs = nodeIDs[:request.PageSize]
+		pagingToken = serializePageToken(nodeIDs[len(nodeIDs)-1])
+	}
+	history := make([]*p.DataBlob, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		// a node can be overridden by a later transaction, only the latest one is returned
+		lastTxnID := int64(-1)
+		for txnID := range nodes[nodeID] {
+			if txnID > lastTxnID {
+				lastTxnID = txnID
+			}
+		}
+		history = append(history, copyBlob(nodes[nodeID][lastTxnID]))
+	}
+	return &p.InternalReadHistoryBranchResponse{
+		History:       history,
+		NextPageToken: pagingToken,
+	}, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing branch, see the sql implementation for how the
+// ancestors of the new branch are derived from the forking branch
+func (m *historyV2Store) ForkHistoryBranch(request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	forkB := request.ForkBranchInfo
+	treeID := forkB.GetTreeID()
+	newAncestors := make([]*shared.HistoryBranchRange, 0, len(forkB.Ancestors)+1)
+
+	beginNodeID := p.GetBeginNodeID(forkB)
+	if beginNodeID >= request.ForkNodeID {
+		// this is the case that new branch's ancestors doesn't include the forking branch
+		for _, br := range forkB.Ancestors {
+			if br.GetEndNodeID() >= request.ForkNodeID {
+				newAncestors = append(newAncestors, &shared.HistoryBranchRange{
+					BranchID:    br.BranchID,
+					BeginNodeID: br.BeginNodeID,
+					EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+				})
+				break
+			}
+			newAncestors = append(newAncestors, br)
+		}
+	} else {
+		// this is the case the new branch will inherit all ancestors from forking branch
+		newAncestors = append(newAncestors, forkB.Ancestors...)
+		newAncestors = append(newAncestors, &shared.HistoryBranchRange{
+			BranchID:    forkB.BranchID,
+			BeginNodeID: common.Int64Ptr(beginNodeID),
+			EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+		})
+	}
+
+	if _, ok := m.db.historyTrees[treeID][request.NewBranchID]; ok {
+		return nil, &shared.InternalServiceError{
+			Message: fmt.Sprintf("ForkHistoryBranch: branch %v of tree %v already exist", request.NewBranchID, treeID),
+		}
+	}
+	m.db.putHistoryTreeRow(treeID, request.NewBranchID, &historyTreeRow{
+		ancestors:   copyAncestors(newAncestors),
+		info:        request.Info,
+		inProgress:  true,
+		createdTime: time.Now(),
+	})
+	return &p.InternalForkHistoryBranchResponse{
+		NewBranchInfo: shared.HistoryBranch{
+			TreeID:    common.StringPtr(treeID),
+			BranchID:  common.StringPtr(request.NewBranchID),
+			Ancestors: copyAncestors(newAncestors),
+		},
+	}, nil
+}
+
+// DeleteHistoryBranch removes a branch
+func (m *historyV2Store) DeleteHistoryBranch(request *p.InternalDeleteHistoryBranchRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	branch := request.BranchInfo
+	treeID := branch.GetTreeID()
+	brsToDelete := append([]*shared.HistoryBranchRange{}, branch.Ancestors...)
+	brsToDelete = append(brsToDelete, &shared.HistoryBranchRange{
+		BranchID:    branch.BranchID,
+		BeginNodeID: common.Int64Ptr(p.GetBeginNodeID(branch)),
+	})
+
+	// We won't delete the branch if there is any branch forking in progress
+	tree := m.db.historyTrees[treeID]
+	for _, row := range tree {
+		if row.inProgress {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("There are branches in progress of forking"),
+			}
+		}
+	}
+
+	// validBRsMaxEndNode is to for each branch range that is being used, we want to know what is the max nodeID referred by other valid branch
+	validBRsMaxEndNode := map[string]int64{}
+	for _, row := range tree {
+		for _, br := range row.ancestors {
+			curr, ok := validBRsMaxEndNode[br.GetBranchID()]
+			if !ok || curr < br.GetEndNodeID() {
+				validBRsMaxEndNode[br.GetBranchID()] = br.GetEndNodeID()
+			}
+		}
+	}
+
+	delete(tree, branch.GetBranchID())
+	if len(tree) == 0 {
+		delete(m.db.historyTrees, treeID)
+	}
+	// for each branch range to delete, we iterate from bottom to up, and delete up to the point according to validBRsEndNode
+	for i := len(brsToDelete) - 1; i >= 0; i-- {
+		br := brsToDelete[i]
+		maxReferredEndNodeID, ok := validBRsMaxEndNode[br.GetBranchID()]
+		minNodeID := br.GetBeginNodeID()
+		if ok {
+			// we can only delete from the maxEndNode and stop here
+			minNodeID = maxReferredEndNodeID
+		}
+		m.db.deleteHistoryNodes(historyBranchKey{treeID: treeID, branchID: br.GetBranchID()}, minNodeID)
+		if ok {
+			break
+		}
+	}
+	return nil
+}
+
+// CompleteForkBranch update a branch
+func (m *historyV2Store) CompleteForkBranch(request *p.InternalCompleteForkBranchRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	treeID := request.BranchInfo.GetTreeID()
+	branchID := request.BranchInfo.GetBranchID()
+	row, ok := m.db.historyTrees[treeID][branchID]
+	if !ok {
+		return &shared.InternalServiceError{
+			Message: fmt.Sprintf("CompleteForkBranch: branch %v of tree %v does not exist", branchID, treeID),
+		}
+	}
+	if request.Success {
+		row.inProgress = false
+		return nil
+	}
+	m.db.deleteHistoryNodes(historyBranchKey{treeID: treeID, branchID: branchID}, common.FirstEventID)
+	delete(m.db.historyTrees[treeID], branchID)
+	if len(m.db.historyTrees[treeID]) == 0 {
+		delete(m.db.historyTrees, treeID)
+	}
+	return nil
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (m *historyV2Store) GetHistoryTree(request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tree, ok := m.db.historyTrees[request.TreeID]
+	if !ok {
+		return &p.GetHistoryTreeResponse{}, nil
+	}
+	branches := make([]*shared.HistoryBranch, 0, len(tree))
+	forkingBranches := make([]p.ForkingInProgressBranch, 0)
+	for branchID, row := range tree {
+		if row.inProgress {
+			forkingBranches = append(forkingBranches, p.ForkingInProgressBranch{
+				BranchID: branchID,
+				ForkTime: row.createdTime,
+				Info:     row.info,
+			})
+		}
+		branches = append(branches, &shared.HistoryBranch{
+			TreeID:    common.StringPtr(request.TreeID),
+			BranchID:  common.StringPtr(branchID),
+			Ancestors: copyAncestors(row.ancestors),
+		})
+	}
+	return &p.GetHistoryTreeResponse{
+		Branches:                  branches,
+		ForkingInProgressBranches: forkingBranches,
+	}, nil
+}
+
+func (d *db) putHistoryTreeRow(treeID, branchID string, row *historyTreeRow) {
+	tree, ok := d.historyTrees[treeID]
+	if !ok {
+		tree = make(map[string]*historyTreeRow)
+		d.historyTrees[treeID] = tree
+	}
+	tree[branchID] = row
+}
+
+func (d *db) putHistoryNode(key historyBranchKey, nodeID, txnID int64, events *p.DataBlob) {
+	nodes, ok := d.historyNodes[key]
+	if !ok {
+		nodes = make(map[int64]map[int64]*p.DataBlob)
+		d.historyNodes[key] = nodes
+	}
+	txns, ok := nodes[nodeID]
+	if !ok {
+		txns = make(map[int64]*p.DataBlob)
+		nodes[nodeID] = txns
+	}
+	txns[txnID] = copyBlob(events)
+}
+
+// deleteHistoryNodes removes all the nodes of the branch starting from minNodeID
+func (d *db) deleteHistoryNodes(key historyBranchKey, minNodeID int64) {
+	nodes := d.historyNodes[key]
+	for nodeID := range nodes {
+		if nodeID >= minNodeID {
+			delete(nodes, nodeID)
+		}
+	}
+	if len(nodes) == 0 {
+		delete(d.historyNodes, key)
+	}
+}
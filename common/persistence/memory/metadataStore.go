@@ -0,0 +1,255 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	metadataStore struct {
+		memoryStore
+		currentClusterName string
+	}
+
+	domainRow struct {
+		info                        p.DomainInfo
+		config                      p.DomainConfig
+		activeClusterName           string
+		clusters                    []string
+		isGlobalDomain              bool
+		configVersion               int64
+		failoverVersion             int64
+		failoverNotificationVersion int64
+		notificationVersion         int64
+	}
+)
+
+// newMetadataStore creates an instance of MetadataStore, it follows the semantics of the V2 domain tables
+func newMetadataStore(db *db, currentClusterName string, logger log.Logger) p.MetadataStore {
+	return &metadataStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		currentClusterName: currentClusterName,
+	}
+}
+
+func (m *metadataStore) CreateDomain(request *p.CreateDomainRequest) (*p.CreateDomainResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if _, ok := m.db.domainIDsByName[request.Info.Name]; ok {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("name: %v", request.Info.Name),
+		}
+	}
+	if _, ok := m.db.domains[request.Info.ID]; ok {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("name: %v", request.Info.Name),
+		}
+	}
+
+	row := newDomainRow(request.Info, request.Config, request.ReplicationConfig)
+	row.isGlobalDomain = request.IsGlobalDomain
+	row.configVersion = request.ConfigVersion
+	row.failoverVersion = request.FailoverVersion
+	row.notificationVersion = m.db.notificationVersion
+	row.failoverNotificationVersion = p.InitialFailoverNotificationVersion
+	m.db.domains[request.Info.ID] = row
+	m.db.domainIDsByName[request.Info.Name] = request.Info.ID
+	m.db.notificationVersion++
+	return &p.CreateDomainResponse{ID: request.Info.ID}, nil
+}
+
+func (m *metadataStore) GetDomain(request *p.GetDomainRequest) (*p.GetDomainResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	id := request.ID
+	switch {
+	case request.Name != "" && request.ID != "":
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+		}
+	case request.Name != "":
+		id = m.db.domainIDsByName[request.Name]
+	case request.ID == "":
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	row, ok := m.db.domains[id]
+	if !ok {
+		identity := request.Name
+		if len(request.ID) > 0 {
+			identity = request.ID
+		}
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Domain %s does not exist.", identity),
+		}
+	}
+	return m.toGetDomainResponse(row), nil
+}
+
+func (m *metadataStore) UpdateDomain(request *p.UpdateDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	existing, ok := m.db.domains[request.Info.ID]
+	if !ok {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDomain operation failed. Domain %v does not exist.", request.Info.ID),
+		}
+	}
+	if request.NotificationVersion != m.db.notificationVersion {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to update domain metadata. Notification version was %v, expected %v",
+				m.db.notificationVersion, request.NotificationVersion),
+		}
+	}
+
+	row := newDomainRow(request.Info, request.Config, request.ReplicationConfig)
+	row.info.Name = existing.info.Name
+	if len(request.PreviousName) > 0 && request.PreviousName != request.Info.Name {
+		if request.PreviousName != existing.info.Name {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("UpdateDomain operation failed. Domain %v is not the domain %v.", request.PreviousName, request.Info.ID),
+			}
+		}
+		for _, other := range m.db.domains {
+			if other.info.Name == request.Info.Name {
+				return &workflow.DomainAlreadyExistsError{
+					Message: fmt.Sprintf("Domain already exists.  Name: %v", request.Info.Name),
+				}
+			}
+		}
+		row.info.Name = request.Info.Name
+	}
+	row.isGlobalDomain = existing.isGlobalDomain
+	row.configVersion = request.ConfigVersion
+	row.failoverVersion = request.FailoverVersion
+	row.notificationVersion = request.NotificationVersion
+	row.failoverNotificationVersion = request.FailoverNotificationVersion
+	m.db.domains[request.Info.ID] = row
+	m.db.notificationVersion = request.NotificationVersion + 1
+	return nil
+}
+
+func (m *metadataStore) DeleteDomain(request *p.DeleteDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if row, ok := m.db.domains[request.ID]; ok {
+		delete(m.db.domainIDsByName, row.info.Name)
+		delete(m.db.domains, request.ID)
+	}
+	return nil
+}
+
+func (m *metadataStore) DeleteDomainByName(request *p.DeleteDomainByNameRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if id, ok := m.db.domainIDsByName[request.Name]; ok {
+		delete(m.db.domains, id)
+		delete(m.db.domainIDsByName, request.Name)
+	}
+	return nil
+}
+
+func (m *metadataStore) ListDomains(request *p.ListDomainsRequest) (*p.ListDomainsResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	pageToken := string(request.NextPageToken)
+	var ids []string
+	for id := range m.db.domains {
+		if id > pageToken {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if len(ids) > request.PageSize {
+		ids = ids[:request.PageSize]
+	}
+
+	resp := &p.ListDomainsResponse{}
+	for _, id := range ids {
+		resp.Domains = append(resp.Domains, m.toGetDomainResponse(m.db.domains[id]))
+	}
+	if len(ids) >= request.PageSize {
+		resp.NextPageToken = []byte(ids[len(ids)-1])
+	}
+	return resp, nil
+}
+
+func (m *metadataStore) GetMetadata() (*p.GetMetadataResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	return &p.GetMetadataResponse{NotificationVersion: m.db.notificationVersion}, nil
+}
+
+func (m *metadataStore) toGetDomainResponse(row *domainRow) *p.GetDomainResponse {
+	info := row.info
+	info.Data = copyStringMap(row.info.Data)
+	config := row.config
+	clusters := make([]*p.ClusterReplicationConfig, len(row.clusters))
+	for i, name := range row.clusters {
+		clusters[i] = &p.ClusterReplicationConfig{ClusterName: name}
+	}
+	return &p.GetDomainResponse{
+		TableVersion: p.DomainTableVersionV2,
+		Info:         &info,
+		Config:       &config,
+		ReplicationConfig: &p.DomainReplicationConfig{
+			ActiveClusterName: p.GetOrUseDefaultActiveCluster(m.currentClusterName, row.activeClusterName),
+			Clusters:          p.GetOrUseDefaultClusters(m.currentClusterName, clusters),
+		},
+		IsGlobalDomain:              row.isGlobalDomain,
+		ConfigVersion:               row.configVersion,
+		FailoverVersion:             row.failoverVersion,
+		FailoverNotificationVersion: row.failoverNotificationVersion,
+		NotificationVersion:         row.notificationVersion,
+	}
+}
+
+func newDomainRow(info *p.DomainInfo, config *p.DomainConfig, replicationConfig *p.DomainReplicationConfig) *domainRow {
+	row := &domainRow{
+		info:              *info,
+		config:            *config,
+		activeClusterName: replicationConfig.ActiveClusterName,
+		clusters:          make([]string, len(replicationConfig.Clusters)),
+	}
+	row.info.Data = copyStringMap(info.Data)
+	for i, cluster := range replicationConfig.Clusters {
+		row.clusters[i] = cluster.ClusterName
+	}
+	return row
+}
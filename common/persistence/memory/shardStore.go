@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type shardStore struct {
+	memoryStore
+	currentClusterName string
+}
+
+// newShardStore creates an instance of ShardStore
+func newShardStore(db *db, currentClusterName string, logger log.Logger) p.ShardStore {
+	return &shardStore{
+		memoryStore:        memoryStore{db: db, logger: logger},
+		currentClusterName: currentClusterName,
+	}
+}
+
+func (m *shardStore) CreateShard(request *p.CreateShardRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shardID := request.ShardInfo.ShardID
+	if _, ok := m.db.shards[shardID]; ok {
+		return &p.ShardAlreadyExistError{
+			Msg: fmt.Sprintf("CreateShard operation failed. Shard with ID %v already exists.", shardID),
+		}
+	}
+	m.db.shards[shardID] = copyShardInfo(request.ShardInfo)
+	return nil
+}
+
+func (m *shardStore) GetShard(request *p.GetShardRequest) (*p.GetShardResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	info, ok := m.db.shards[request.ShardID]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("GetShard operation failed. Shard with ID %v not found.", request.ShardID),
+		}
+	}
+	result := copyShardInfo(info)
+	if len(result.ClusterTransferAckLevel) == 0 {
+		result.ClusterTransferAckLevel = map[string]int64{m.currentClusterName: result.TransferAckLevel}
+	}
+	if len(result.ClusterTimerAckLevel) == 0 {
+		result.ClusterTimerAckLevel = map[string]time.Time{m.currentClusterName: result.TimerAckLevel}
+	}
+	return &p.GetShardResponse{ShardInfo: result}, nil
+}
+
+func (m *shardStore) UpdateShard(request *p.UpdateShardRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shardID := request.ShardInfo.ShardID
+	info, ok := m.db.shards[shardID]
+	if !ok {
+		return &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("UpdateShard operation failed. Shard with ID %v not found.", shardID),
+		}
+	}
+	if info.RangeID != request.PreviousRangeID {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg: fmt.Sprintf("Failed to update shard. Previous range ID: %v; new range ID: %v",
+				request.PreviousRangeID, info.RangeID),
+		}
+	}
+	m.db.shards[shardID] = copyShardInfo(request.ShardInfo)
+	return nil
+}
+
+// checkRangeID fails with ShardOwnershipLostError unless the shard is still owned with the given range
+func (d *db) checkRangeID(shardID int, rangeID int64) error {
+	info, ok := d.shards[shardID]
+	if !ok {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     fmt.Sprintf("Failed to lock shard with ID %v that does not exist.", shardID),
+		}
+	}
+	if info.RangeID != rangeID {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     fmt.Sprintf("Failed to lock shard with ID: %v. Provided RangeID: %v, Actual RangeID: %v", shardID, rangeID, info.RangeID),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	taskStore struct {
+		memoryStore
+	}
+
+	taskListKey struct {
+		DomainID string
+		Name     string
+		TaskType int
+	}
+)
+
+const stickyTaskListTTL = 24 * time.Hour
+
+// newTaskStore creates an instance of TaskStore
+func newTaskStore(db *db, logger log.Logger) p.TaskStore {
+	return &taskStore{memoryStore: memoryStore{db: db, logger: logger}}
+}
+
+func (m *taskStore) LeaseTaskList(request *p.LeaseTaskListRequest) (*p.LeaseTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	now := time.Now()
+	key := taskListKey{DomainID: request.DomainID, Name: request.TaskList, TaskType: request.TaskType}
+	info := m.db.getTaskList(key, now)
+	if info == nil {
+		info = &p.TaskListInfo{
+			DomainID: request.DomainID,
+			Name:     request.TaskList,
+			TaskType: request.TaskType,
+			Kind:     request.TaskListKind,
+		}
+		m.db.taskLists[key] = info
+	}
+	if request.RangeID > 0 && request.RangeID != info.RangeID {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("leaseTaskList:renew failed:taskList:%v, taskListType:%v, haveRangeID:%v, gotRangeID:%v",
+				request.TaskList, request.TaskType, request.RangeID, info.RangeID),
+		}
+	}
+	info.RangeID++
+	info.Kind = request.TaskListKind
+	info.LastUpdated = now
+	result := *info
+	return &p.LeaseTaskListResponse{TaskListInfo: &result}, nil
+}
+
+func (m *taskStore) UpdateTaskList(request *p.UpdateTaskListRequest) (*p.UpdateTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	now := time.Now()
+	update := *request.TaskListInfo
+	update.Expiry = time.Time{}
+	update.LastUpdated = now
+	key := taskListKey{DomainID: update.DomainID, Name: update.Name, TaskType: update.TaskType}
+	if update.Kind == p.TaskListKindSticky {
+		// sticky task lists are not leased before being written, they are kept only until
+		// they stop being updated, like rows written with a TTL
+		update.Expiry = now.Add(stickyTaskListTTL)
+		m.db.taskLists[key] = &update
+		return &p.UpdateTaskListResponse{}, nil
+	}
+	info := m.db.getTaskList(key, now)
+	if info == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to lock task list. Task list %v of type %v does not exist.", update.Name, update.TaskType),
+		}
+	}
+	if info.RangeID != update.RangeID {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Task list range ID was %v when it was should have been %v", info.RangeID, update.RangeID),
+		}
+	}
+	m.db.taskLists[key] = &update
+	return &p.UpdateTaskListResponse{}, nil
+}
+
+func (m *taskStore) ListTaskList(request *p.ListTaskListRequest) (*p.ListTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var pageToken *taskListKey
+	if len(request.PageToken) > 0 {
+		pageToken = &taskListKey{}
+		if err := json.Unmarshal(request.PageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
+		}
+	}
+
+	now := time.Now()
+	keys := make([]taskListKey, 0, len(m.db.taskLists))
+	for key := range m.db.taskLists {
+		if m.db.getTaskList(key, now) != nil && (pageToken == nil || pageToken.less(key)) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+
+	resp := &p.ListTaskListResponse{}
+	if len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+		token, err := json.Marshal(keys[len(keys)-1])
+		if err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+		resp.NextPageToken = token
+	}
+	resp.Items = make([]p.TaskListInfo, len(keys))
+	for i, key := range keys {
+		resp.Items[i] = *m.db.taskLists[key]
+	}
+	return resp, nil
+}
+
+func (m *taskStore) DeleteTaskList(request *p.DeleteTaskListRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{DomainID: request.DomainID, Name: request.TaskListName, TaskType: request.TaskListType}
+	info := m.db.getTaskList(key, time.Now())
+	if info == nil || info.RangeID != request.RangeID {
+		return &workflow.InternalServiceError{Message: "delete failed: 0 rows affected instead of 1"}
+	}
+	delete(m.db.taskLists, key)
+	return nil
+}
+
+func (m *taskStore) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tlInfo := request.TaskListInfo
+	key := taskListKey{DomainID: tlInfo.DomainID, Name: tlInfo.Name, TaskType: tlInfo.TaskType}
+	info := m.db.getTaskList(key, time.Now())
+	if info == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to lock task list. Task list %v of type %v does not exist.", tlInfo.Name, tlInfo.TaskType),
+		}
+	}
+	if info.RangeID != tlInfo.RangeID {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Task list range ID was %v when it was should have been %v", info.RangeID, tlInfo.RangeID),
+		}
+	}
+
+	tasks, ok := m.db.tasks[key]
+	if !ok {
+		tasks = make(map[int64]*p.TaskInfo)
+		m.db.tasks[key] = tasks
+	}
+	for _, v := range request.Tasks {
+		task := *v.Data
+		task.TaskID = v.TaskID
+		task.Expiry = time.Time{}
+		if task.ScheduleToStartTimeout > 0 {
+			task.Expiry = time.Now().Add(time.Second * time.Duration(task.ScheduleToStartTimeout))
+		}
+		tasks[v.TaskID] = &task
+	}
+	return &p.CreateTasksResponse{}, nil
+}
+
+func (m *taskStore) GetTasks(request *p.GetTasksRequest) (*p.GetTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{DomainID: request.DomainID, Name: request.TaskList, TaskType: request.TaskType}
+	ids := m.db.sortedTaskIDs(key, func(id int64) bool {
+		return id > request.ReadLevel && (request.MaxReadLevel == nil || id <= *request.MaxReadLevel)
+	})
+	if len(ids) > request.BatchSize {
+		ids = ids[:request.BatchSize]
+	}
+	tasks := make([]*p.TaskInfo, len(ids))
+	for i, id := range ids {
+		task := *m.db.tasks[key][id]
+		tasks[i] = &task
+	}
+	return &p.GetTasksResponse{Tasks: tasks}, nil
+}
+
+func (m *taskStore) CompleteTask(request *p.CompleteTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	taskList := request.TaskList
+	key := taskListKey{DomainID: taskList.DomainID, Name: taskList.Name, TaskType: taskList.TaskType}
+	delete(m.db.tasks[key], request.TaskID)
+	return nil
+}
+
+func (m *taskStore) CompleteTasksLessThan(request *p.CompleteTasksLessThanRequest) (int, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{DomainID: request.DomainID, Name: request.TaskListName, TaskType: request.TaskType}
+	ids := m.db.sortedTaskIDs(key, func(id int64) bool {
+		return id <= request.TaskID
+	})
+	if len(ids) > request.Limit {
+		ids = ids[:request.Limit]
+	}
+	for _, id := range ids {
+		delete(m.db.tasks[key], id)
+	}
+	return len(ids), nil
+}
+
+// getTaskList returns the task list with the given key, sticky task lists which
+// have not been updated in stickyTaskListTTL are discarded
+func (d *db) getTaskList(key taskListKey, now time.Time) *p.TaskListInfo {
+	info, ok := d.taskLists[key]
+	if !ok {
+		return nil
+	}
+	if !info.Expiry.IsZero() && info.Expiry.Before(now) {
+		delete(d.taskLists, key)
+		return nil
+	}
+	return info
+}
+
+func (d *db) sortedTaskIDs(key taskListKey, filter func(id int64) bool) []int64 {
+	var ids []int64
+	for id := range d.tasks[key] {
+		if filter(id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (k taskListKey) less(other taskListKey) bool {
+	if k.DomainID != other.DomainID {
+		return k.DomainID < other.DomainID
+	}
+	if k.Name != other.Name {
+		return k.Name < other.Name
+	}
+	return k.TaskType < other.TaskType
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	visibilityStore struct {
+		memoryStore
+	}
+
+	visibilityKey struct {
+		domainID string
+		runID    string
+	}
+
+	visibilityRow struct {
+		workflowID       string
+		runID            string
+		workflowTypeName string
+		startTime        time.Time
+		executionTime    time.Time
+		memo             *p.DataBlob
+		closed           bool
+		closeTime        time.Time
+		closeStatus      workflow.WorkflowExecutionCloseStatus
+		historyLength    int64
+	}
+
+	visibilityPageToken struct {
+		Time  time.Time
+		RunID string
+	}
+)
+
+// newVisibilityStore creates an instance of VisibilityStore
+func newVisibilityStore(db *db, logger log.Logger) p.VisibilityStore {
+	return &visibilityStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (s *visibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	key := visibilityKey{domainID: request.DomainUUID, runID: request.RunID}
+	if _, ok := s.db.visibility[key]; ok {
+		// the close record may have been written first, it must not be overridden
+		return nil
+	}
+	s.db.visibility[key] = &visibilityRow{
+		workflowID:       request.WorkflowID,
+		runID:            request.RunID,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        time.Unix(0, request.StartTimestamp),
+		executionTime:    time.Unix(0, request.ExecutionTimestamp),
+		memo:             copyBlob(request.Memo),
+	}
+	return nil
+}
+
+func (s *visibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	s.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: request.RunID}] = &visibilityRow{
+		workflowID:       request.WorkflowID,
+		runID:            request.RunID,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        time.Unix(0, request.StartTimestamp),
+		executionTime:    time.Unix(0, request.ExecutionTimestamp),
+		memo:             copyBlob(request.Memo),
+		closed:           true,
+		closeTime:        time.Unix(0, request.CloseTimestamp),
+		closeStatus:      request.Status,
+		historyLength:    request.HistoryLength,
+	}
+	return nil
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutions", request, false, func(row *visibilityRow) bool {
+		return true
+	})
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutions", request, true, func(row *visibilityRow) bool {
+		return true
+	})
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest, false, func(row *visibilityRow) bool {
+		return row.workflowTypeName == request.WorkflowTypeName
+	})
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest, true, func(row *visibilityRow) bool {
+		return row.workflowTypeName == request.WorkflowTypeName
+	})
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest, false, func(row *visibilityRow) bool {
+		return row.workflowID == request.WorkflowID
+	})
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest, true, func(row *visibilityRow) bool {
+		return row.workflowID == request.WorkflowID
+	})
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", &request.ListWorkflowExecutionsRequest, true, func(row *visibilityRow) bool {
+		return row.closeStatus == request.Status
+	})
+}
+
+func (s *visibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	execution := request.Execution
+	row, ok := s.db.visibility[visibilityKey{domainID: request.DomainUUID, runID: execution.GetRunId()}]
+	if !ok || !row.closed {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+	return &p.InternalGetClosedWorkflowExecutionResponse{Execution: rowToInfo(row)}, nil
+}
+
+func (s *visibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	delete(s.db.visibility, visibilityKey{domainID: request.DomainID, runID: request.RunID})
+	return nil
+}
+
+// listWorkflowExecutions returns the open or closed executions of the domain that started in the requested
+// range and match the filter, ordered by start time descending and then by run ID
+func (s *visibilityStore) listWorkflowExecutions(
+	opName string,
+	request *p.ListWorkflowExecutionsRequest,
+	closed bool,
+	filter func(row *visibilityRow) bool,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	earliestStartTime := time.Unix(0, request.EarliestStartTime)
+	readLevel := &visibilityPageToken{Time: time.Unix(0, request.LatestStartTime), RunID: ""}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, readLevel); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("%v operation failed. Invalid page token: %v", opName, err),
+			}
+		}
+	}
+
+	var rows []*visibilityRow
+	for key, row := range s.db.visibility {
+		if key.domainID != request.DomainUUID || row.closed != closed || !filter(row) {
+			continue
+		}
+		if row.startTime.Before(earliestStartTime) || row.startTime.After(readLevel.Time) {
+			continue
+		}
+		if row.startTime.Equal(readLevel.Time) && row.runID <= readLevel.RunID {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].startTime.Equal(rows[j].startTime) {
+			return rows[i].startTime.After(rows[j].startTime)
+		}
+		return rows[i].runID < rows[j].runID
+	})
+
+	resp := &p.InternalListWorkflowExecutionsResponse{}
+	if len(rows) > request.PageSize {
+		rows = rows[:request.PageSize]
+		lastRow := rows[len(rows)-1]
+		token, err := json.Marshal(&visibilityPageToken{Time: lastRow.startTime, RunID: lastRow.runID})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("%v operation failed. Failed to serialize page token: %v", opName, err),
+			}
+		}
+		resp.NextPageToken = token
+	}
+	for _, row := range rows {
+		resp.Executions = append(resp.Executions, rowToInfo(row))
+	}
+	return resp, nil
+}
+
+func rowToInfo(row *visibilityRow) *p.VisibilityWorkflowExecutionInfo {
+	executionTime := row.executionTime
+	if executionTime.UnixNano() == 0 {
+		executionTime = row.startTime
+	}
+	info := &p.VisibilityWorkflowExecutionInfo{
+		WorkflowID:    row.workflowID,
+		RunID:         row.runID,
+		TypeName:      row.workflowTypeName,
+		StartTime:     row.startTime,
+		ExecutionTime: executionTime,
+	}
+	if row.memo != nil {
+		info.Memo = p.NewDataBlob(copyBytes(row.memo.Data), row.memo.Encoding)
+	}
+	if row.closed {
+		status := row.closeStatus
+		info.Status = &status
+		info.CloseTime = row.closeTime
+		info.HistoryLength = row.historyLength
+	}
+	return info
+}
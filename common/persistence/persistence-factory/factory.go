@@ -21,16 +21,19 @@
 package persistence
 
 import (
+	"errors"
 	"sync"
 
-	"github.com/uber/cadence/common/clock"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/persistence/memory"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/service/config"
-	"github.com/uber/cadence/common/tokenbucket"
 )
 
 type (
@@ -51,6 +54,8 @@ type (
 		// NewMetadataManager returns a new metadata manager that can speak
 		// the given version or versions
 		NewMetadataManager(version MetadataVersion) (p.MetadataManager, error)
+		// NewClusterMetadataManager returns a new cluster metadata manager
+		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
 		// NewExecutionManager returns a new execution manager for a given shardID
 		NewExecutionManager(shardID int) (p.ExecutionManager, error)
 		// NewVisibilityManager returns a new visibility manager
@@ -75,6 +80,8 @@ type (
 		NewMetadataStoreV1() (p.MetadataManager, error)
 		// NewMetadataStoreV2 returns a metadata store that can talk v2
 		NewMetadataStoreV2() (p.MetadataManager, error)
+		// NewClusterMetadataStore returns a new cluster metadata store
+		NewClusterMetadataStore() (p.ClusterMetadataStore, error)
 		// NewExecutionStore returns an execution store for given shardID
 		NewExecutionStore(shardID int) (p.ExecutionStore, error)
 		// NewVisibilityStore returns a new visibility store
@@ -83,7 +90,7 @@ type (
 	// Datastore represents a datastore
 	Datastore struct {
 		factory   DataStoreFactory
-		ratelimit tokenbucket.TokenBucket
+		ratelimit p.RateLimiter
 	}
 	factoryImpl struct {
 		sync.RWMutex
@@ -91,6 +98,10 @@ type (
 		metricsClient metrics.Client
 		logger        log.Logger
 		datastores    map[storeType]Datastore
+		shadow        *Datastore
+		shadowOptions p.ShadowOptions
+		crypter       p.PayloadCrypter
+		claimChecker  p.PayloadClaimChecker
 	}
 
 	storeType int
@@ -120,6 +131,8 @@ const (
 var storeTypes = []storeType{
 	storeTypeHistory, storeTypeTask, storeTypeShard, storeTypeMetadata, storeTypeExecution, storeTypeVisibility}
 
+var errClaimCheckWithoutBlobstore = errors.New("persistence claim check is configured but the service has no blobstore")
+
 // New returns an implementation of factory that vends persistence objects based on
 // specified configuration. This factory takes as input a config.Persistence object
 // which specifies the datastore to be used for a given type of object. This config
@@ -131,11 +144,14 @@ func New(
 	cfg *config.Persistence,
 	clusterName string,
 	metricsClient metrics.Client,
+	blobstoreClient blobstore.Client,
 	logger log.Logger) Factory {
 	factory := &factoryImpl{
 		config:        cfg,
 		metricsClient: metricsClient,
 		logger:        logger,
+		crypter:       newPayloadCrypter(cfg.Encryption, logger),
+		claimChecker:  newPayloadClaimChecker(cfg.ClaimCheck, blobstoreClient, logger),
 	}
 	defaultCfg := cfg.DataStores[cfg.DefaultStore]
 	visibilityCfg := cfg.DataStores[cfg.VisibilityStore]
@@ -148,18 +164,34 @@ func New(
 		storeTypeHistory:    newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, cfg.HistoryMaxConns, logger),
 		storeTypeVisibility: newStore(visibilityCfg, limiters[cfg.VisibilityStore], clusterName, 0, logger),
 	}
+	if cfg.Shadow != nil {
+		shadow := newStore(cfg.DataStores[cfg.Shadow.Store], nil, clusterName, 0, logger)
+		factory.shadow = &shadow
+		factory.shadowOptions = p.ShadowOptions{
+			ReadSampleRate: cfg.Shadow.ReadSampleRate,
+			QueueSize:      cfg.Shadow.QueueSize,
+		}
+	}
 	return factory
 }
 
 // NewTaskManager returns a new task manager
 func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	ds := f.datastores[storeTypeTask]
-	result, err := ds.factory.NewTaskStore()
+	store, err := ds.factory.NewTaskStore()
 	if err != nil {
 		return nil, err
 	}
+	if f.shadow != nil {
+		shadow, err := f.shadow.factory.NewTaskStore()
+		if err != nil {
+			return nil, err
+		}
+		store = p.NewShadowTaskStore(store, shadow, f.shadowOptions, f.shadowMetricsClient(), f.logger)
+	}
+	result := p.NewTaskManagerImpl(store, f.logger)
 	if ds.ratelimit != nil {
-		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, p.CallerTypeAPI, f.logger)
 	}
 	if f.metricsClient != nil {
 		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger)
@@ -174,8 +206,15 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.shadow != nil {
+		shadow, err := f.shadow.factory.NewShardStore()
+		if err != nil {
+			return nil, err
+		}
+		result = p.NewShadowShardStore(result, shadow, f.shadowOptions, f.shadowMetricsClient(), f.logger)
+	}
 	if ds.ratelimit != nil {
-		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, p.CallerTypeAPI, f.logger)
 	}
 	if f.metricsClient != nil {
 		result = p.NewShardPersistenceMetricsClient(result, f.metricsClient, f.logger)
@@ -191,9 +230,15 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryManagerImpl(store, f.logger)
+	result := p.NewHistoryManagerImpl(store, f.crypter, f.logger)
+	if f.config.ClaimCheck != nil {
+		if f.claimChecker == nil {
+			return nil, errClaimCheckWithoutBlobstore
+		}
+		result = p.NewHistoryPersistenceClaimCheckClient(result, f.claimChecker)
+	}
 	if ds.ratelimit != nil {
-		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, p.CallerTypeAPI, f.logger)
 	}
 	if f.metricsClient != nil {
 		result = p.NewHistoryPersistenceMetricsClient(result, f.metricsClient, f.logger)
@@ -208,9 +253,22 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger)
+	if f.shadow != nil {
+		shadow, err := f.shadow.factory.NewHistoryV2Store()
+		if err != nil {
+			return nil, err
+		}
+		store = p.NewShadowHistoryV2Store(store, shadow, f.shadowOptions, f.shadowMetricsClient(), f.logger)
+	}
+	result := p.NewHistoryV2ManagerImpl(store, f.crypter, f.logger)
+	if f.config.ClaimCheck != nil {
+		if f.claimChecker == nil {
+			return nil, errClaimCheckWithoutBlobstore
+		}
+		result = p.NewHistoryV2PersistenceClaimCheckClient(result, f.claimChecker)
+	}
 	if ds.ratelimit != nil {
-		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, p.CallerTypeAPI, f.logger)
 	}
 	if f.metricsClient != nil {
 		result = p.NewHistoryV2PersistenceMetricsClient(result, f.metricsClient, f.logger)
@@ -220,25 +278,22 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 
 // NewMetadataManager returns a new metadata manager
 func (f *factoryImpl) NewMetadataManager(version MetadataVersion) (p.MetadataManager, error) {
-	var err error
-	var store p.MetadataStore
 	ds := f.datastores[storeTypeMetadata]
-	switch version {
-	case MetadataV1:
-		store, err = ds.factory.NewMetadataStoreV1()
-	case MetadataV2:
-		store, err = ds.factory.NewMetadataStoreV2()
-	default:
-		store, err = ds.factory.NewMetadataStore()
-	}
-
+	store, err := newMetadataStore(ds.factory, version)
 	if err != nil {
 		return nil, err
 	}
+	if f.shadow != nil {
+		shadow, err := newMetadataStore(f.shadow.factory, version)
+		if err != nil {
+			return nil, err
+		}
+		store = p.NewShadowMetadataStore(store, shadow, f.shadowOptions, f.shadowMetricsClient(), f.logger)
+	}
 
 	result := p.MetadataManager(store)
 	if ds.ratelimit != nil {
-		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, p.CallerTypeAPI, f.logger)
 	}
 	if f.metricsClient != nil {
 		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
@@ -246,6 +301,22 @@ func (f *factoryImpl) NewMetadataManager(version MetadataVersion) (p.MetadataMan
 	return result, nil
 }
 
+// NewClusterMetadataManager returns a new cluster metadata manager
+func (f *factoryImpl) NewClusterMetadataManager() (p.ClusterMetadataManager, error) {
+	ds := f.datastores[storeTypeMetadata]
+	result, err := ds.factory.NewClusterMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewClusterMetadataPersistenceRateLimitedClient(result, ds.ratelimit, p.CallerTypeAPI, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+	return result, nil
+}
+
 // NewExecutionManager returns a new execution manager for a given shardID
 func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, error) {
 	ds := f.datastores[storeTypeExecution]
@@ -253,9 +324,16 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger)
-	if ds.ratelimit != nil {
-		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	if f.shadow != nil {
+		shadow, err := f.shadow.factory.NewExecutionStore(shardID)
+		if err != nil {
+			return nil, err
+		}
+		store = p.NewShadowExecutionStore(store, shadow, f.shadowOptions, f.shadowMetricsClient(), f.logger)
+	}
+	result := p.NewExecutionManagerImpl(store, f.crypter, f.logger)
+	if ratelimit := f.executionRateLimiter(ds); ratelimit != nil {
+		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ratelimit, p.CallerTypeAPI, f.logger)
 	}
 	if f.metricsClient != nil {
 		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger)
@@ -277,7 +355,7 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 
 	result := p.NewVisibilityManagerImpl(store, f.logger)
 	if ds.ratelimit != nil {
-		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, p.CallerTypeAPI, f.logger)
 	}
 	if visConfig != nil && visConfig.EnableSampling() {
 		result = p.NewVisibilitySamplingClient(result, visConfig, f.metricsClient, f.logger)
@@ -293,11 +371,33 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
 	ds.factory.Close()
+	if f.shadow != nil {
+		f.shadow.factory.Close()
+	}
+}
+
+// shadowMetricsClient returns the client used to report divergence of the shadow store
+func (f *factoryImpl) shadowMetricsClient() metrics.Client {
+	if f.metricsClient == nil {
+		return metrics.NewClient(tally.NoopScope, metrics.Common)
+	}
+	return f.metricsClient
+}
+
+func newMetadataStore(factory DataStoreFactory, version MetadataVersion) (p.MetadataStore, error) {
+	switch version {
+	case MetadataV1:
+		return factory.NewMetadataStoreV1()
+	case MetadataV2:
+		return factory.NewMetadataStoreV2()
+	default:
+		return factory.NewMetadataStore()
+	}
 }
 
 func (f *factoryImpl) isCassandra() bool {
 	cfg := f.config
-	return cfg.DataStores[cfg.VisibilityStore].SQL == nil
+	return cfg.DataStores[cfg.VisibilityStore].Cassandra != nil
 }
 
 func (f *factoryImpl) getCassandraConfig() *config.Cassandra {
@@ -305,13 +405,30 @@ func (f *factoryImpl) getCassandraConfig() *config.Cassandra {
 	return cfg.DataStores[cfg.VisibilityStore].Cassandra
 }
 
-func newStore(cfg config.DataStore, tb tokenbucket.TokenBucket, clusterName string, maxConnsOverride int, logger log.Logger) Datastore {
+// executionRateLimiter returns the rate limiter of a new execution manager, which enforces the
+// budget of its shard on top of the budget shared by all shards of the datastore
+func (f *factoryImpl) executionRateLimiter(ds Datastore) p.RateLimiter {
+	if f.config.ExecutionMaxQPSPerShard <= 0 {
+		return ds.ratelimit
+	}
+	shardRateLimiter := p.NewPriorityRateLimiter(f.config.ExecutionMaxQPSPerShard)
+	if ds.ratelimit == nil {
+		return shardRateLimiter
+	}
+	return p.NewMultiStageRateLimiter(shardRateLimiter, ds.ratelimit)
+}
+
+func newStore(cfg config.DataStore, tb p.RateLimiter, clusterName string, maxConnsOverride int, logger log.Logger) Datastore {
 	var ds Datastore
 	ds.ratelimit = tb
 	if cfg.SQL != nil {
 		ds.factory = newSQLStore(*cfg.SQL, clusterName, maxConnsOverride, logger)
 		return ds
 	}
+	if cfg.InMemory != nil {
+		ds.factory = memory.NewFactory(*cfg.InMemory, clusterName, logger)
+		return ds
+	}
 	ds.factory = newCassandraStore(*cfg.Cassandra, clusterName, maxConnsOverride, logger)
 	return ds
 }
@@ -330,8 +447,51 @@ func newCassandraStore(cfg config.Cassandra, clusterName string, maxConnsOverrid
 	return cassandra.NewFactory(cfg, clusterName, logger)
 }
 
-func buildRatelimiters(cfg *config.Persistence) map[string]tokenbucket.TokenBucket {
-	result := make(map[string]tokenbucket.TokenBucket, len(cfg.DataStores))
+func newPayloadCrypter(cfg *config.Encryption, logger log.Logger) p.PayloadCrypter {
+	if cfg == nil {
+		return p.NewNoopPayloadCrypter()
+	}
+	keys, err := cfg.DecodeKeys()
+	if err != nil {
+		logger.Fatal("invalid persistence encryption config", tag.Error(err))
+	}
+	return p.NewAESGCMPayloadCrypter(p.NewStaticKeyProvider(keys, cfg.DomainKeys, cfg.DefaultKey))
+}
+
+// newPayloadClaimChecker returns nil when the claim check is not configured or the service has no blobstore,
+// in the latter case history managers can not be created
+func newPayloadClaimChecker(cfg *config.ClaimCheck, blobstoreClient blobstore.Client, logger log.Logger) p.PayloadClaimChecker {
+	if cfg == nil || blobstoreClient == nil {
+		return nil
+	}
+	thresholds, err := parseClaimCheckThresholds(cfg.Thresholds)
+	if err != nil {
+		logger.Fatal("invalid persistence claim check config", tag.Error(err))
+	}
+	domainThresholds := make(map[string]map[p.PayloadType]int, len(cfg.DomainThresholds))
+	for domainID, domainCfg := range cfg.DomainThresholds {
+		if domainThresholds[domainID], err = parseClaimCheckThresholds(domainCfg); err != nil {
+			logger.Fatal("invalid persistence claim check config", tag.Error(err))
+		}
+	}
+	policy := p.NewStaticClaimCheckPolicy(thresholds, domainThresholds)
+	return p.NewPayloadClaimChecker(blobstoreClient, cfg.Bucket, policy)
+}
+
+func parseClaimCheckThresholds(cfg map[string]int) (map[p.PayloadType]int, error) {
+	thresholds := make(map[p.PayloadType]int, len(cfg))
+	for name, threshold := range cfg {
+		payloadType, err := p.ParsePayloadType(name)
+		if err != nil {
+			return nil, err
+		}
+		thresholds[payloadType] = threshold
+	}
+	return thresholds, nil
+}
+
+func buildRatelimiters(cfg *config.Persistence) map[string]p.RateLimiter {
+	result := make(map[string]p.RateLimiter, len(cfg.DataStores))
 	for dsName, ds := range cfg.DataStores {
 		qps := 0
 		if ds.Cassandra != nil {
@@ -341,7 +501,7 @@ func buildRatelimiters(cfg *config.Persistence) map[string]tokenbucket.TokenBuck
 			qps = ds.SQL.MaxQPS
 		}
 		if qps > 0 {
-			result[dsName] = tokenbucket.New(qps, clock.NewRealTimeSource())
+			result[dsName] = p.NewPriorityRateLimiter(qps)
 		}
 	}
 	return result
@@ -61,6 +61,13 @@ func TestCassandraMetadataPersistenceV2(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestCassandraClusterMetadataPersistence(t *testing.T) {
+	s := new(ClusterMetadataPersistenceSuite)
+	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
 func TestCassandraShardPersistence(t *testing.T) {
 	s := new(ShardPersistenceSuite)
 	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{})
@@ -0,0 +1,179 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"os"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// ClusterMetadataPersistenceSuite contains cluster metadata persistence tests
+	ClusterMetadataPersistenceSuite struct {
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+// SetupSuite implementation
+func (s *ClusterMetadataPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+// SetupTest implementation
+func (s *ClusterMetadataPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+// TearDownSuite implementation
+func (s *ClusterMetadataPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+// TestInitializeImmutableClusterMetadata test
+func (s *ClusterMetadataPersistenceSuite) TestInitializeImmutableClusterMetadata() {
+	clusterName := "testCluster"
+	historyShardCount := 16
+	initialFailoverVersion := int64(2)
+
+	resp, err := s.ClusterMetadataMgr.InitializeImmutableClusterMetadata(&p.InitializeImmutableClusterMetadataRequest{
+		ImmutableClusterMetadata: p.ImmutableClusterMetadata{
+			ClusterName:            clusterName,
+			HistoryShardCount:      historyShardCount,
+			InitialFailoverVersion: initialFailoverVersion,
+		},
+	})
+	s.NoError(err)
+	s.True(resp.RequestApplied)
+	s.Equal(clusterName, resp.PersistedImmutableData.ClusterName)
+	s.Equal(historyShardCount, resp.PersistedImmutableData.HistoryShardCount)
+	s.Equal(initialFailoverVersion, resp.PersistedImmutableData.InitialFailoverVersion)
+
+	// a second initialization must not overwrite the persisted record
+	resp, err = s.ClusterMetadataMgr.InitializeImmutableClusterMetadata(&p.InitializeImmutableClusterMetadataRequest{
+		ImmutableClusterMetadata: p.ImmutableClusterMetadata{
+			ClusterName:            "otherCluster",
+			HistoryShardCount:      historyShardCount * 2,
+			InitialFailoverVersion: initialFailoverVersion + 1,
+		},
+	})
+	s.NoError(err)
+	s.False(resp.RequestApplied)
+	s.Equal(clusterName, resp.PersistedImmutableData.ClusterName)
+	s.Equal(historyShardCount, resp.PersistedImmutableData.HistoryShardCount)
+	s.Equal(initialFailoverVersion, resp.PersistedImmutableData.InitialFailoverVersion)
+}
+
+// TestUpdateSearchAttributes test
+func (s *ClusterMetadataPersistenceSuite) TestUpdateSearchAttributes() {
+	// search attributes live on the cluster metadata row, make sure it exists
+	_, err := s.ClusterMetadataMgr.InitializeImmutableClusterMetadata(&p.InitializeImmutableClusterMetadataRequest{
+		ImmutableClusterMetadata: p.ImmutableClusterMetadata{
+			ClusterName:            "testCluster",
+			HistoryShardCount:      16,
+			InitialFailoverVersion: 2,
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.ClusterMetadataMgr.GetSearchAttributes()
+	s.NoError(err)
+	s.Equal(int64(0), resp.Version)
+	s.Empty(resp.SearchAttributes)
+
+	attributes := map[string]gen.IndexedValueType{
+		"CustomStringField": gen.IndexedValueTypeString,
+		"CustomIntField":    gen.IndexedValueTypeInt,
+	}
+	err = s.ClusterMetadataMgr.UpdateSearchAttributes(&p.UpdateSearchAttributesRequest{
+		SearchAttributes: attributes,
+		PrevVersion:      resp.Version,
+	})
+	s.NoError(err)
+
+	resp, err = s.ClusterMetadataMgr.GetSearchAttributes()
+	s.NoError(err)
+	s.Equal(int64(1), resp.Version)
+	s.Equal(attributes, resp.SearchAttributes)
+
+	// an update based on a stale version must be rejected
+	err = s.ClusterMetadataMgr.UpdateSearchAttributes(&p.UpdateSearchAttributesRequest{
+		SearchAttributes: map[string]gen.IndexedValueType{"CustomBoolField": gen.IndexedValueTypeBool},
+		PrevVersion:      0,
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+
+	resp, err = s.ClusterMetadataMgr.GetSearchAttributes()
+	s.NoError(err)
+	s.Equal(int64(1), resp.Version)
+	s.Equal(attributes, resp.SearchAttributes)
+}
+
+// TestUpdateDynamicConfig test
+func (s *ClusterMetadataPersistenceSuite) TestUpdateDynamicConfig() {
+	// dynamic config lives on the cluster metadata row, make sure it exists
+	_, err := s.ClusterMetadataMgr.InitializeImmutableClusterMetadata(&p.InitializeImmutableClusterMetadataRequest{
+		ImmutableClusterMetadata: p.ImmutableClusterMetadata{
+			ClusterName:            "testCluster",
+			HistoryShardCount:      16,
+			InitialFailoverVersion: 2,
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.ClusterMetadataMgr.GetDynamicConfig()
+	s.NoError(err)
+	prevVersion := resp.Version
+
+	config := []byte(`{"LatestVersion":1}`)
+	err = s.ClusterMetadataMgr.UpdateDynamicConfig(&p.UpdateDynamicConfigRequest{
+		Config:      config,
+		PrevVersion: prevVersion,
+	})
+	s.NoError(err)
+
+	resp, err = s.ClusterMetadataMgr.GetDynamicConfig()
+	s.NoError(err)
+	s.Equal(prevVersion+1, resp.Version)
+	s.Equal(config, resp.Config)
+
+	// an update based on a stale version must be rejected
+	err = s.ClusterMetadataMgr.UpdateDynamicConfig(&p.UpdateDynamicConfigRequest{
+		Config:      []byte(`{"LatestVersion":2}`),
+		PrevVersion: prevVersion,
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+
+	resp, err = s.ClusterMetadataMgr.GetDynamicConfig()
+	s.NoError(err)
+	s.Equal(prevVersion+1, resp.Version)
+	s.Equal(config, resp.Config)
+}
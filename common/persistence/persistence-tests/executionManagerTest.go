@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/rand"
@@ -96,9 +97,9 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionBrandNew() {
 		CreateWorkflowMode:   p.CreateWorkflowModeBrandNew,
 	}
 
-	_, err := s.ExecutionManager.CreateWorkflowExecution(req)
+	_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 	s.Nil(err)
-	_, err = s.ExecutionManager.CreateWorkflowExecution(req)
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), req)
 	s.NotNil(err)
 	alreadyStartedErr, ok := err.(*p.WorkflowExecutionAlreadyStartedError)
 	s.True(ok, "err is not WorkflowExecutionAlreadyStartedError")
@@ -142,7 +143,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 	}
 
 	// try to create a workflow while the current workflow is still running
-	_, err := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -175,7 +176,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 		LastWriteVersion: version,
 		LastWriteEventID: updatedInfo.NextEventID - 1,
 	}
-	_, err = s.ExecutionManager.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(context.Background(), &p.UpdateWorkflowExecutionRequest{
 		ExecutionInfo:        updatedInfo,
 		TransferTasks:        nil,
 		TimerTasks:           nil,
@@ -193,7 +194,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 	s.NoError(err)
 
 	// try to create a workflow while the current workflow is complete but run ID is wrong
-	_, err = s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -211,9 +212,18 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 	})
 	s.NotNil(err)
 	s.IsType(&p.CurrentWorkflowConditionFailedError{}, err, err.Error())
+	// the error identifies the current run so that a retried start request can be recognized
+	currentResp, err1 := s.ExecutionManager.GetCurrentExecution(context.Background(), &p.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+	})
+	s.NoError(err1)
+	conditionFailedErr := err.(*p.CurrentWorkflowConditionFailedError)
+	s.Equal(workflowExecution.GetRunId(), conditionFailedErr.RunID)
+	s.Equal(currentResp.StartRequestID, conditionFailedErr.RequestID)
 
 	// try to create a workflow while the current workflow is complete but version is wrong
-	_, err = s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -233,7 +243,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplica
 	s.IsType(&p.CurrentWorkflowConditionFailedError{}, err, err.Error())
 
 	// try to create a workflow while the current workflow is complete with run ID & version correct
-	_, err = s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err = s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -291,7 +301,7 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithoutRepl
 	}
 	// this create should work since we are relying the business logic in history engine
 	// to check whether the existing running workflow has finished
-	_, err3 := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	_, err3 := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:                uuid.New(),
 		DomainID:                 domainID,
 		Execution:                newExecution,
@@ -390,7 +400,7 @@ func (s *ExecutionManagerSuite) TestPersistenceStartWorkflow() {
 	s.Equal(common.EmptyVersion, startedErr.LastWriteVersion, startedErr.Msg)
 	s.Empty(task1, "Expected empty task identifier.")
 
-	response, err2 := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	response, err2 := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:            uuid.New(),
 		DomainID:             domainID,
 		Execution:            workflowExecution,
@@ -422,6 +432,64 @@ func (s *ExecutionManagerSuite) TestPersistenceStartWorkflow() {
 	s.IsType(&p.ShardOwnershipLostError{}, err2)
 }
 
+// TestCreateWorkflowExecutionWithTransferTasks test
+func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionWithTransferTasks() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("create-workflow-transfer-tasks-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	_, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.NoError(err0)
+
+	tasks1, err1 := s.GetTransferTasks(1, false)
+	s.NoError(err1)
+	s.Equal(1, len(tasks1), "Expected the first decision transfer task to be written with the execution.")
+	s.Equal(domainID, tasks1[0].DomainID)
+	s.Equal(workflowExecution.GetWorkflowId(), tasks1[0].WorkflowID)
+	s.Equal(workflowExecution.GetRunId(), tasks1[0].RunID)
+	s.Equal(p.TransferTaskTypeDecisionTask, tasks1[0].TaskType)
+	s.Equal(int64(2), tasks1[0].ScheduleID)
+	err2 := s.CompleteTransferTask(tasks1[0].TaskID)
+	s.NoError(err2)
+
+	// failed creates must not leave any transfer task behind
+	_, err3 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.Error(err3)
+	s.IsType(&p.WorkflowExecutionAlreadyStartedError{}, err3)
+
+	_, err4 := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
+		RequestID:            uuid.New(),
+		DomainID:             domainID,
+		Execution:            gen.WorkflowExecution{WorkflowId: common.StringPtr("create-workflow-transfer-tasks-test-2"), RunId: common.StringPtr(uuid.New())},
+		TaskList:             "queue1",
+		WorkflowTypeName:     "wType",
+		WorkflowTimeout:      20,
+		DecisionTimeoutValue: 13,
+		NextEventID:          int64(3),
+		LastProcessedEvent:   0,
+		RangeID:              s.ShardInfo.RangeID - 1,
+		TransferTasks: []p.Task{
+			&p.DecisionTask{
+				TaskID:     s.GetNextSequenceNumber(),
+				DomainID:   domainID,
+				TaskList:   "queue1",
+				ScheduleID: int64(2),
+			},
+		},
+		DecisionScheduleID:          int64(2),
+		DecisionStartedID:           common.EmptyEventID,
+		DecisionStartToCloseTimeout: 1,
+	})
+	s.Error(err4)
+	s.IsType(&p.ShardOwnershipLostError{}, err4)
+
+	tasks2, err5 := s.GetTransferTasks(1, false)
+	s.NoError(err5)
+	s.Equal(0, len(tasks2), "Expected no transfer task from failed creates.")
+}
+
 // TestPersistenceStartWorkflowWithReplicationState test
 func (s *ExecutionManagerSuite) TestPersistenceStartWorkflowWithReplicationState() {
 	domainID := "2d7994bf-9de8-459d-9c81-e723daedb246"
@@ -451,7 +519,7 @@ func (s *ExecutionManagerSuite) TestPersistenceStartWorkflowWithReplicationState
 	s.Equal(common.EmptyVersion, startedErr.LastWriteVersion, startedErr.Msg)
 	s.Empty(task1, "Expected empty task identifier.")
 
-	response, err2 := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+	response, err2 := s.ExecutionManager.CreateWorkflowExecution(context.Background(), &p.CreateWorkflowExecutionRequest{
 		RequestID:            uuid.New(),
 		DomainID:             domainID,
 		Execution:            workflowExecution,
@@ -533,7 +601,7 @@ func (s *ExecutionManagerSuite) TestGetWorkflow() {
 		ExpirationSeconds:  rand.Int31(),
 	}
 
-	createResp, err := s.ExecutionManager.CreateWorkflowExecution(createReq)
+	createResp, err := s.ExecutionManager.CreateWorkflowExecution(context.Background(), createReq)
 	s.NoError(err)
 	s.NotNil(createResp, "Expected non empty task identifier.")
 
@@ -622,6 +690,7 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(int32(1), info0.DecisionTimeout)
 	s.Equal(int64(0), info0.DecisionAttempt)
 	s.Equal(int64(0), info0.DecisionTimestamp)
+	s.Equal(int64(0), info0.DecisionFailureCount)
 	s.Empty(info0.StickyTaskList)
 	s.Equal(int32(0), info0.StickyScheduleToStartTimeout)
 	s.Empty(info0.ClientLibraryVersion)
@@ -638,6 +707,7 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	updatedInfo.DecisionVersion = int64(666)
 	updatedInfo.DecisionAttempt = int64(123)
 	updatedInfo.DecisionTimestamp = int64(321)
+	updatedInfo.DecisionFailureCount = int64(4)
 	updatedInfo.StickyTaskList = "random sticky tasklist"
 	updatedInfo.StickyScheduleToStartTimeout = 876
 	updatedInfo.ClientLibraryVersion = "random client library version"
@@ -679,6 +749,7 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(int32(1), info1.DecisionTimeout)
 	s.Equal(int64(123), info1.DecisionAttempt)
 	s.Equal(int64(321), info1.DecisionTimestamp)
+	s.Equal(int64(4), info1.DecisionFailureCount)
 	s.Equal(updatedInfo.StickyTaskList, info1.StickyTaskList)
 	s.Equal(updatedInfo.StickyScheduleToStartTimeout, info1.StickyScheduleToStartTimeout)
 	s.Equal(updatedInfo.ClientLibraryVersion, info1.ClientLibraryVersion)
@@ -972,7 +1043,7 @@ func (s *ExecutionManagerSuite) TestGetCurrentWorkflow() {
 	s.NoError(err0)
 	s.NotNil(task0, "Expected non empty task identifier.")
 
-	response, err := s.ExecutionManager.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+	response, err := s.ExecutionManager.GetCurrentExecution(context.Background(), &p.GetCurrentExecutionRequest{
 		DomainID:   domainID,
 		WorkflowID: workflowExecution.GetWorkflowId(),
 	})
@@ -1551,6 +1622,225 @@ func (s *ExecutionManagerSuite) TestTransferTasksRangeComplete() {
 	s.Empty(txTasks, "expected empty task list.")
 }
 
+// TestTransferDLQTasks test
+func (s *ExecutionManagerSuite) TestTransferDLQTasks() {
+	domainID := "8bfb47be-5b57-4d55-9109-5fb35e20b1d8"
+	workflowID := "get-transfer-dlq-tasks-test"
+	runID := "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	now := time.Now()
+
+	for i := int64(1); i <= 3; i++ {
+		err := s.ExecutionManager.PutTransferDLQTask(context.Background(), &p.PutTransferDLQTaskRequest{
+			TaskInfo: &p.TransferTaskInfo{
+				DomainID:            domainID,
+				WorkflowID:          workflowID,
+				RunID:               runID,
+				TaskID:              i * 10,
+				TaskType:            p.TransferTaskTypeDecisionTask,
+				TaskList:            "some random tasklist",
+				ScheduleID:          2,
+				VisibilityTimestamp: now,
+				Version:             i,
+			},
+		})
+		s.NoError(err)
+	}
+
+	response, err := s.ExecutionManager.GetTransferDLQTasks(context.Background(), &p.GetTransferDLQTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    2,
+	})
+	s.NoError(err)
+	s.Equal(2, len(response.Tasks))
+	s.NotEmpty(response.NextPageToken)
+	s.Equal(int64(10), response.Tasks[0].TaskID)
+	s.Equal(int64(20), response.Tasks[1].TaskID)
+	s.Equal(domainID, response.Tasks[0].DomainID)
+	s.Equal(workflowID, response.Tasks[0].WorkflowID)
+	s.Equal(runID, response.Tasks[0].RunID)
+	s.Equal(p.TransferTaskTypeDecisionTask, response.Tasks[0].TaskType)
+	s.Equal(int64(2), response.Tasks[0].ScheduleID)
+	s.Equal(int64(1), response.Tasks[0].Version)
+	s.True(timeComparator(now, response.Tasks[0].VisibilityTimestamp, TimePrecision))
+
+	response, err = s.ExecutionManager.GetTransferDLQTasks(context.Background(), &p.GetTransferDLQTasksRequest{
+		ReadLevel:     0,
+		MaxReadLevel:  math.MaxInt64,
+		BatchSize:     2,
+		NextPageToken: response.NextPageToken,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(int64(30), response.Tasks[0].TaskID)
+
+	err = s.ExecutionManager.RangeDeleteTransferDLQTasks(context.Background(), &p.RangeDeleteTransferDLQTasksRequest{
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   20,
+	})
+	s.NoError(err)
+
+	response, err = s.ExecutionManager.GetTransferDLQTasks(context.Background(), &p.GetTransferDLQTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    10,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(int64(30), response.Tasks[0].TaskID)
+}
+
+// TestReplicationDLQTasks test
+func (s *ExecutionManagerSuite) TestReplicationDLQTasks() {
+	domainID := "8bfb47be-5b57-4d55-9109-5fb35e20b1d7"
+	workflowID := "get-replication-dlq-tasks-test"
+	runID := "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	sourceCluster := "some random source cluster"
+	otherSourceCluster := "some other source cluster"
+
+	for i := int64(1); i <= 3; i++ {
+		err := s.ExecutionManager.PutReplicationDLQTask(context.Background(), &p.PutReplicationDLQTaskRequest{
+			SourceClusterName: sourceCluster,
+			TaskInfo: &p.ReplicationTaskInfo{
+				DomainID:     domainID,
+				WorkflowID:   workflowID,
+				RunID:        runID,
+				TaskID:       i * 10,
+				TaskType:     p.ReplicationTaskTypeHistory,
+				FirstEventID: i * 5,
+				NextEventID:  i*5 + 5,
+				Version:      i,
+				LastReplicationInfo: map[string]*p.ReplicationInfo{
+					otherSourceCluster: {Version: i, LastEventID: i * 5},
+				},
+			},
+		})
+		s.NoError(err)
+	}
+	err := s.ExecutionManager.PutReplicationDLQTask(context.Background(), &p.PutReplicationDLQTaskRequest{
+		SourceClusterName: otherSourceCluster,
+		TaskInfo: &p.ReplicationTaskInfo{
+			DomainID:     domainID,
+			WorkflowID:   workflowID,
+			RunID:        runID,
+			TaskID:       15,
+			TaskType:     p.ReplicationTaskTypeHistory,
+			FirstEventID: 1,
+			NextEventID:  5,
+			Version:      1,
+		},
+	})
+	s.NoError(err)
+
+	response, err := s.ExecutionManager.GetReplicationDLQTasks(context.Background(), &p.GetReplicationDLQTasksRequest{
+		SourceClusterName: sourceCluster,
+		ReadLevel:         0,
+		MaxReadLevel:      math.MaxInt64,
+		BatchSize:         2,
+	})
+	s.NoError(err)
+	s.Equal(2, len(response.Tasks))
+	s.NotEmpty(response.NextPageToken)
+	s.Equal(int64(10), response.Tasks[0].TaskID)
+	s.Equal(int64(20), response.Tasks[1].TaskID)
+	s.Equal(domainID, response.Tasks[0].DomainID)
+	s.Equal(workflowID, response.Tasks[0].WorkflowID)
+	s.Equal(runID, response.Tasks[0].RunID)
+	s.Equal(p.ReplicationTaskTypeHistory, response.Tasks[0].TaskType)
+	s.Equal(int64(5), response.Tasks[0].FirstEventID)
+	s.Equal(int64(10), response.Tasks[0].NextEventID)
+	s.Equal(int64(1), response.Tasks[0].Version)
+	s.Equal(int64(5), response.Tasks[0].LastReplicationInfo[otherSourceCluster].LastEventID)
+
+	response, err = s.ExecutionManager.GetReplicationDLQTasks(context.Background(), &p.GetReplicationDLQTasksRequest{
+		SourceClusterName: sourceCluster,
+		ReadLevel:         0,
+		MaxReadLevel:      math.MaxInt64,
+		BatchSize:         2,
+		NextPageToken:     response.NextPageToken,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(int64(30), response.Tasks[0].TaskID)
+
+	err = s.ExecutionManager.RangeDeleteReplicationDLQTasks(context.Background(), &p.RangeDeleteReplicationDLQTasksRequest{
+		SourceClusterName:    sourceCluster,
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   20,
+	})
+	s.NoError(err)
+
+	response, err = s.ExecutionManager.GetReplicationDLQTasks(context.Background(), &p.GetReplicationDLQTasksRequest{
+		SourceClusterName: sourceCluster,
+		ReadLevel:         0,
+		MaxReadLevel:      math.MaxInt64,
+		BatchSize:         10,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(int64(30), response.Tasks[0].TaskID)
+
+	response, err = s.ExecutionManager.GetReplicationDLQTasks(context.Background(), &p.GetReplicationDLQTasksRequest{
+		SourceClusterName: otherSourceCluster,
+		ReadLevel:         0,
+		MaxReadLevel:      math.MaxInt64,
+		BatchSize:         10,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(int64(15), response.Tasks[0].TaskID)
+}
+
+// TestWorkflowExecutionAnnotations test
+func (s *ExecutionManagerSuite) TestWorkflowExecutionAnnotations() {
+	domainID := "8bfb47be-5b57-4d55-9109-5fb35e20b1d9"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("workflow-execution-annotations-test"),
+		RunId:      common.StringPtr("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
+	}
+	now := time.Now()
+
+	for _, annotation := range []*p.WorkflowExecutionAnnotation{
+		{Key: "incident", Text: "stuck on activity", Author: "oncall", CreatedTime: now},
+		{Key: "owner", Text: "payments team", Author: "oncall", CreatedTime: now},
+		{Key: "incident", Text: "mitigated", Author: "oncall-2", CreatedTime: now.Add(time.Minute)},
+	} {
+		err := s.ExecutionManager.PutWorkflowExecutionAnnotation(context.Background(), &p.PutWorkflowExecutionAnnotationRequest{
+			DomainID:   domainID,
+			Execution:  workflowExecution,
+			Annotation: annotation,
+		})
+		s.NoError(err)
+	}
+
+	response, err := s.ExecutionManager.GetWorkflowExecutionAnnotations(context.Background(), &p.GetWorkflowExecutionAnnotationsRequest{
+		DomainID:  domainID,
+		Execution: workflowExecution,
+	})
+	s.NoError(err)
+	s.Equal(2, len(response.Annotations))
+	s.Equal("incident", response.Annotations[0].Key)
+	s.Equal("mitigated", response.Annotations[0].Text)
+	s.Equal("oncall-2", response.Annotations[0].Author)
+	s.True(timeComparator(now.Add(time.Minute), response.Annotations[0].CreatedTime, TimePrecision))
+	s.Equal("owner", response.Annotations[1].Key)
+	s.Equal("payments team", response.Annotations[1].Text)
+
+	err = s.ExecutionManager.DeleteWorkflowExecution(context.Background(), &p.DeleteWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+		RunID:      workflowExecution.GetRunId(),
+	})
+	s.NoError(err)
+
+	response, err = s.ExecutionManager.GetWorkflowExecutionAnnotations(context.Background(), &p.GetWorkflowExecutionAnnotationsRequest{
+		DomainID:  domainID,
+		Execution: workflowExecution,
+	})
+	s.NoError(err)
+	s.Empty(response.Annotations)
+}
+
 // TestTimerTasksComplete test
 func (s *ExecutionManagerSuite) TestTimerTasksComplete() {
 	domainID := "8bfb47be-5b57-4d66-9109-5fb35e20b1d7"
@@ -3406,6 +3696,12 @@ func (s *ExecutionManagerSuite) TestCreateGetUpdateGetShard() {
 			cluster.TestCurrentClusterName:     currentClusterTimerAck,
 			cluster.TestAlternativeClusterName: alternativeClusterTimerAck,
 		},
+		ClusterTransferReadLevel: map[string]int64{
+			cluster.TestCurrentClusterName: currentClusterTransferAck + 100,
+		},
+		ClusterTransferAckBitmap: map[string][]byte{
+			cluster.TestCurrentClusterName: {0x05, 0x80},
+		},
 		DomainNotificationVersion: domainNotificationVersion,
 	}
 	updateRequest := &p.UpdateShardRequest{
@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"os"
@@ -235,7 +236,7 @@ func (s *HistoryPerfSuite) newHistoryBranch(treeID string) ([]byte, error) {
 
 // persistence helper
 func (s *HistoryPerfSuite) readv2(branch []byte, minID, maxID int64, pageSize int, token []byte) ([]*workflow.HistoryEvent, []byte, error) {
-	resp, err := s.HistoryV2Mgr.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+	resp, err := s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), &p.ReadHistoryBranchRequest{
 		BranchToken:   branch,
 		MinEventID:    minID,
 		MaxEventID:    maxID,
@@ -258,7 +259,7 @@ func (s *HistoryPerfSuite) appendV2(br []byte, events []*workflow.HistoryEvent,
 	var resp *p.AppendHistoryNodesResponse
 	var err error
 
-	resp, err = s.HistoryV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+	resp, err = s.HistoryV2Mgr.AppendHistoryNodes(context.Background(), &p.AppendHistoryNodesRequest{
 		BranchToken:   br,
 		Events:        events,
 		TransactionID: txnID,
@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"math/rand"
 	"os"
 	"sync"
@@ -163,7 +164,7 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 		ShardID:       common.IntPtr(s.ShardInfo.ShardID),
 	}
 	// first page
-	resp, err := s.HistoryV2Mgr.ReadHistoryBranch(req)
+	resp, err := s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 	s.Nil(err)
 	s.Equal(4, len(resp.HistoryEvents))
 	s.Equal(int64(6), resp.HistoryEvents[0].GetEventId())
@@ -225,34 +226,34 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 		ShardID:       common.IntPtr(s.ShardInfo.ShardID),
 	}
 	// first page
-	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 	s.Nil(err)
 	s.Equal(8, len(resp.HistoryEvents))
 	historyR.Events = append(historyR.Events, resp.HistoryEvents...)
 	req.NextPageToken = resp.NextPageToken
 
 	// this page is all stale batches
-	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 	s.Nil(err)
 	s.Equal(0, len(resp.HistoryEvents))
 	historyR.Events = append(historyR.Events, resp.HistoryEvents...)
 	req.NextPageToken = resp.NextPageToken
 	// second page
-	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 	s.Nil(err)
 	s.Equal(3, len(resp.HistoryEvents))
 	historyR.Events = append(historyR.Events, resp.HistoryEvents...)
 	req.NextPageToken = resp.NextPageToken
 
 	// 3rd page, since we fork from nodeID=13, we can only see one batch of 12 here
-	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 	s.Nil(err)
 	s.Equal(1, len(resp.HistoryEvents))
 	historyR.Events = append(historyR.Events, resp.HistoryEvents...)
 	req.NextPageToken = resp.NextPageToken
 
 	// 4th page, 13~17
-	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 	s.Nil(err)
 	s.Equal(5, len(resp.HistoryEvents))
 	historyR.Events = append(historyR.Events, resp.HistoryEvents...)
@@ -264,13 +265,13 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 	// If it does return a token, we need to ensure that if the token returned is used
 	// to get history again, no error and history events should be returned.
 	req.PageSize = 1
-	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 	s.Nil(err)
 	s.Equal(3, len(resp.HistoryEvents))
 	historyR.Events = append(historyR.Events, resp.HistoryEvents...)
 	req.NextPageToken = resp.NextPageToken
 	if len(resp.NextPageToken) != 0 {
-		resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+		resp, err = s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 		s.Nil(err)
 		s.Equal(0, len(resp.HistoryEvents))
 	}
@@ -282,7 +283,7 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 	// is empty), the call should return an error.
 	req.MinEventID = 19
 	req.NextPageToken = nil
-	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(req)
+	resp, err = s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), req)
 	s.IsType(&gen.EntityNotExistsError{}, err)
 }
 
@@ -625,7 +626,7 @@ func (s *HistoryV2PersistenceSuite) deleteHistoryBranch(branch []byte) error {
 
 	op := func() error {
 		var err error
-		err = s.HistoryV2Mgr.DeleteHistoryBranch(&p.DeleteHistoryBranchRequest{
+		err = s.HistoryV2Mgr.DeleteHistoryBranch(context.Background(), &p.DeleteHistoryBranchRequest{
 			BranchToken: branch,
 			ShardID:     common.IntPtr(s.ShardInfo.ShardID),
 		})
@@ -637,7 +638,7 @@ func (s *HistoryV2PersistenceSuite) deleteHistoryBranch(branch []byte) error {
 
 // persistence helper
 func (s *HistoryV2PersistenceSuite) descTreeByToken(br []byte) []*workflow.HistoryBranch {
-	resp, err := s.HistoryV2Mgr.GetHistoryTree(&p.GetHistoryTreeRequest{
+	resp, err := s.HistoryV2Mgr.GetHistoryTree(context.Background(), &p.GetHistoryTreeRequest{
 		BranchToken: br,
 		ShardID:     common.IntPtr(s.ShardInfo.ShardID),
 	})
@@ -646,7 +647,7 @@ func (s *HistoryV2PersistenceSuite) descTreeByToken(br []byte) []*workflow.Histo
 }
 
 func (s *HistoryV2PersistenceSuite) descTree(treeID string) []*workflow.HistoryBranch {
-	resp, err := s.HistoryV2Mgr.GetHistoryTree(&p.GetHistoryTreeRequest{
+	resp, err := s.HistoryV2Mgr.GetHistoryTree(context.Background(), &p.GetHistoryTreeRequest{
 		TreeID:  treeID,
 		ShardID: common.IntPtr(s.ShardInfo.ShardID),
 	})
@@ -657,7 +658,7 @@ func (s *HistoryV2PersistenceSuite) descTree(treeID string) []*workflow.HistoryB
 
 // persistence helper
 func (s *HistoryV2PersistenceSuite) descInProgress(treeID string) {
-	resp, err := s.HistoryV2Mgr.GetHistoryTree(&p.GetHistoryTreeRequest{
+	resp, err := s.HistoryV2Mgr.GetHistoryTree(context.Background(), &p.GetHistoryTreeRequest{
 		TreeID:  treeID,
 		ShardID: common.IntPtr(s.ShardInfo.ShardID),
 	})
@@ -682,7 +683,7 @@ func (s *HistoryV2PersistenceSuite) readWithError(branch []byte, minID, maxID in
 	res := make([]*workflow.HistoryEvent, 0)
 	token := []byte{}
 	for {
-		resp, err := s.HistoryV2Mgr.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+		resp, err := s.HistoryV2Mgr.ReadHistoryBranch(context.Background(), &p.ReadHistoryBranchRequest{
 			BranchToken:   branch,
 			MinEventID:    minID,
 			MaxEventID:    maxID,
@@ -731,7 +732,7 @@ func (s *HistoryV2PersistenceSuite) append(branch []byte, events []*workflow.His
 
 	op := func() error {
 		var err error
-		resp, err = s.HistoryV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+		resp, err = s.HistoryV2Mgr.AppendHistoryNodes(context.Background(), &p.AppendHistoryNodesRequest{
 			IsNewBranch:   isNewBranch,
 			Info:          branchInfo,
 			BranchToken:   branch,
@@ -759,7 +760,7 @@ func (s *HistoryV2PersistenceSuite) fork(forkBranch []byte, forkNodeID int64) ([
 
 	op := func() error {
 		var err error
-		resp, err := s.HistoryV2Mgr.ForkHistoryBranch(&p.ForkHistoryBranchRequest{
+		resp, err := s.HistoryV2Mgr.ForkHistoryBranch(context.Background(), &p.ForkHistoryBranchRequest{
 			ForkBranchToken: forkBranch,
 			ForkNodeID:      forkNodeID,
 			Info:            testForkRunID,
@@ -777,7 +778,7 @@ func (s *HistoryV2PersistenceSuite) fork(forkBranch []byte, forkNodeID int64) ([
 
 // persistence helper
 func (s *HistoryV2PersistenceSuite) completeFork(forkBranch []byte, succ bool) {
-	err := s.HistoryV2Mgr.CompleteForkBranch(&p.CompleteForkBranchRequest{
+	err := s.HistoryV2Mgr.CompleteForkBranch(context.Background(), &p.CompleteForkBranchRequest{
 		BranchToken: forkBranch,
 		Success:     succ,
 		ShardID:     common.IntPtr(s.ShardInfo.ShardID),
@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -126,6 +127,7 @@ func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	s.NotNil(tasks1Response.Tasks, "expected valid list of tasks.")
 	s.Equal(1, len(tasks1Response.Tasks), "Expected 1 decision task.")
 	s.Equal(int64(5), tasks1Response.Tasks[0].ScheduleID)
+	s.WithinDuration(time.Now(), tasks1Response.Tasks[0].CreatedTime, time.Minute)
 }
 
 // TestGetTasksWithNoMaxReadLevel test
@@ -161,7 +163,7 @@ func (s *MatchingPersistenceSuite) TestGetTasksWithNoMaxReadLevel() {
 
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("tc_%v_%v", tc.batchSz, tc.readLevel), func() {
-			response, err := s.TaskMgr.GetTasks(&p.GetTasksRequest{
+			response, err := s.TaskMgr.GetTasks(context.Background(), &p.GetTasksRequest{
 				DomainID:  domainID,
 				TaskList:  taskList,
 				TaskType:  p.TaskListTypeActivity,
@@ -268,7 +270,7 @@ func (s *MatchingPersistenceSuite) TestCompleteTasksLessThan() {
 	for _, tc := range testCases {
 		req.TaskID = tc.taskID
 		req.Limit = tc.limit
-		nRows, err := s.TaskMgr.CompleteTasksLessThan(req)
+		nRows, err := s.TaskMgr.CompleteTasksLessThan(context.Background(), req)
 		s.NoError(err)
 		resp, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 10)
 		s.NoError(err)
@@ -290,7 +292,7 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	domainID := "00136543-72ad-4615-b7e9-44bca9775b45"
 	taskList := "aaaaaaa"
 	leaseTime := time.Now()
-	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+	response, err := s.TaskMgr.LeaseTaskList(context.Background(), &p.LeaseTaskListRequest{
 		DomainID: domainID,
 		TaskList: taskList,
 		TaskType: p.TaskListTypeActivity,
@@ -302,7 +304,7 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.True(tli.LastUpdated.After(leaseTime) || tli.LastUpdated.Equal(leaseTime))
 
 	leaseTime = time.Now()
-	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+	response, err = s.TaskMgr.LeaseTaskList(context.Background(), &p.LeaseTaskListRequest{
 		DomainID: domainID,
 		TaskList: taskList,
 		TaskType: p.TaskListTypeActivity,
@@ -313,7 +315,7 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.EqualValues(0, tli.AckLevel)
 	s.True(tli.LastUpdated.After(leaseTime) || tli.LastUpdated.Equal(leaseTime))
 
-	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+	response, err = s.TaskMgr.LeaseTaskList(context.Background(), &p.LeaseTaskListRequest{
 		DomainID: domainID,
 		TaskList: taskList,
 		TaskType: p.TaskListTypeActivity,
@@ -331,13 +333,13 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 		AckLevel: 0,
 		Kind:     p.TaskListKindNormal,
 	}
-	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+	_, err = s.TaskMgr.UpdateTaskList(context.Background(), &p.UpdateTaskListRequest{
 		TaskListInfo: taskListInfo,
 	})
 	s.NoError(err)
 
 	taskListInfo.RangeID = 3
-	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+	_, err = s.TaskMgr.UpdateTaskList(context.Background(), &p.UpdateTaskListRequest{
 		TaskListInfo: taskListInfo,
 	})
 	s.Error(err)
@@ -347,7 +349,7 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
 	taskList := "aaaaaaa"
-	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+	response, err := s.TaskMgr.LeaseTaskList(context.Background(), &p.LeaseTaskListRequest{
 		DomainID:     domainID,
 		TaskList:     taskList,
 		TaskType:     p.TaskListTypeDecision,
@@ -367,7 +369,7 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 		AckLevel: 0,
 		Kind:     p.TaskListKindSticky,
 	}
-	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+	_, err = s.TaskMgr.UpdateTaskList(context.Background(), &p.UpdateTaskListRequest{
 		TaskListInfo: taskListInfo,
 	})
 	s.NoError(err) // because update with ttl doesn't check rangeID
@@ -376,10 +378,10 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 func (s *MatchingPersistenceSuite) deleteAllTaskList() {
 	var nextPageToken []byte
 	for {
-		resp, err := s.TaskMgr.ListTaskList(&p.ListTaskListRequest{PageSize: 10, PageToken: nextPageToken})
+		resp, err := s.TaskMgr.ListTaskList(context.Background(), &p.ListTaskListRequest{PageSize: 10, PageToken: nextPageToken})
 		s.NoError(err)
 		for _, it := range resp.Items {
-			err = s.TaskMgr.DeleteTaskList(&p.DeleteTaskListRequest{
+			err = s.TaskMgr.DeleteTaskList(context.Background(), &p.DeleteTaskListRequest{
 				DomainID:     it.DomainID,
 				TaskListName: it.Name,
 				TaskListType: it.TaskType,
@@ -400,7 +402,7 @@ func (s *MatchingPersistenceSuite) TestListWithOneTaskList() {
 		s.T().Skip("ListTaskList API is currently not supported in cassandra")
 	}
 	s.deleteAllTaskList()
-	resp, err := s.TaskMgr.ListTaskList(&p.ListTaskListRequest{PageSize: 10})
+	resp, err := s.TaskMgr.ListTaskList(context.Background(), &p.ListTaskListRequest{PageSize: 10})
 	s.NoError(err)
 	s.Nil(resp.NextPageToken)
 	s.Equal(0, len(resp.Items))
@@ -411,7 +413,7 @@ func (s *MatchingPersistenceSuite) TestListWithOneTaskList() {
 	for i := 0; i < 10; i++ {
 		rangeID++
 		updatedTime := time.Now()
-		_, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		_, err := s.TaskMgr.LeaseTaskList(context.Background(), &p.LeaseTaskListRequest{
 			DomainID:     domainID,
 			TaskList:     "list-task-list-test-tl0",
 			TaskType:     p.TaskListTypeActivity,
@@ -419,7 +421,7 @@ func (s *MatchingPersistenceSuite) TestListWithOneTaskList() {
 		})
 		s.NoError(err)
 
-		resp, err := s.TaskMgr.ListTaskList(&p.ListTaskListRequest{PageSize: 10})
+		resp, err := s.TaskMgr.ListTaskList(context.Background(), &p.ListTaskListRequest{PageSize: 10})
 		s.NoError(err)
 
 		s.Equal(1, len(resp.Items))
@@ -433,7 +435,7 @@ func (s *MatchingPersistenceSuite) TestListWithOneTaskList() {
 
 		ackLevel++
 		updatedTime = time.Now()
-		_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		_, err = s.TaskMgr.UpdateTaskList(context.Background(), &p.UpdateTaskListRequest{
 			TaskListInfo: &p.TaskListInfo{
 				DomainID: domainID,
 				Name:     "list-task-list-test-tl0",
@@ -445,7 +447,7 @@ func (s *MatchingPersistenceSuite) TestListWithOneTaskList() {
 		})
 		s.NoError(err)
 
-		resp, err = s.TaskMgr.ListTaskList(&p.ListTaskListRequest{PageSize: 10})
+		resp, err = s.TaskMgr.ListTaskList(context.Background(), &p.ListTaskListRequest{PageSize: 10})
 		s.NoError(err)
 		s.Equal(1, len(resp.Items))
 		s.True(resp.Items[0].LastUpdated.After(updatedTime) || resp.Items[0].LastUpdated.Equal(updatedTime))
@@ -463,7 +465,7 @@ func (s *MatchingPersistenceSuite) TestListWithMultipleTaskList() {
 	tlNames := make(map[string]struct{})
 	for i := 0; i < 10; i++ {
 		name := fmt.Sprintf("test-list-with-multiple-%v", i)
-		_, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		_, err := s.TaskMgr.LeaseTaskList(context.Background(), &p.LeaseTaskListRequest{
 			DomainID:     domainID,
 			TaskList:     name,
 			TaskType:     p.TaskListTypeActivity,
@@ -474,7 +476,7 @@ func (s *MatchingPersistenceSuite) TestListWithMultipleTaskList() {
 		listedNames := make(map[string]struct{})
 		var nextPageToken []byte
 		for {
-			resp, err := s.TaskMgr.ListTaskList(&p.ListTaskListRequest{PageSize: 10, PageToken: nextPageToken})
+			resp, err := s.TaskMgr.ListTaskList(context.Background(), &p.ListTaskListRequest{PageSize: 10, PageToken: nextPageToken})
 			s.NoError(err)
 			for _, it := range resp.Items {
 				s.Equal(domainID, it.DomainID)
@@ -492,7 +494,7 @@ func (s *MatchingPersistenceSuite) TestListWithMultipleTaskList() {
 		s.Equal(tlNames, listedNames, "list API returned wrong set of task list names")
 	}
 	s.deleteAllTaskList()
-	resp, err := s.TaskMgr.ListTaskList(&p.ListTaskListRequest{PageSize: 10})
+	resp, err := s.TaskMgr.ListTaskList(context.Background(), &p.ListTaskListRequest{PageSize: 10})
 	s.NoError(err)
 	s.Nil(resp.NextPageToken)
 	s.Equal(0, len(resp.Items))
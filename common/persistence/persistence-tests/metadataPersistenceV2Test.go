@@ -670,6 +670,71 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(notificationVersion, resp5.NotificationVersion)
 }
 
+// TestRenameDomain test
+func (m *MetadataPersistenceSuiteV2) TestRenameDomain() {
+	id := uuid.New()
+	name := "rename-domain-test-name"
+	newName := "rename-domain-test-new-name"
+	takenName := "rename-domain-test-taken-name"
+	clusterActive := "some random active cluster name"
+	config := &p.DomainConfig{Retention: 10, EmitMetric: true}
+	replicationConfig := &p.DomainReplicationConfig{
+		ActiveClusterName: clusterActive,
+		Clusters:          []*p.ClusterReplicationConfig{{ClusterName: clusterActive}},
+	}
+
+	_, err := m.CreateDomain(
+		&p.DomainInfo{ID: id, Name: name, Status: p.DomainStatusRegistered, Data: map[string]string{"k1": "v1"}},
+		config, replicationConfig, true, 1, 2,
+	)
+	m.NoError(err)
+	_, err = m.CreateDomain(
+		&p.DomainInfo{ID: uuid.New(), Name: takenName, Status: p.DomainStatusRegistered, Data: map[string]string{}},
+		config, replicationConfig, false, 1, 2,
+	)
+	m.NoError(err)
+
+	rename := func(newName string) error {
+		resp, err := m.GetDomain(id, "")
+		m.NoError(err)
+		metadata, err := m.MetadataManagerV2.GetMetadata()
+		m.NoError(err)
+		info := *resp.Info
+		info.Name = newName
+		return m.MetadataManagerV2.UpdateDomain(&p.UpdateDomainRequest{
+			Info:                        &info,
+			Config:                      resp.Config,
+			ReplicationConfig:           resp.ReplicationConfig,
+			ConfigVersion:               resp.ConfigVersion,
+			FailoverVersion:             resp.FailoverVersion,
+			FailoverNotificationVersion: resp.FailoverNotificationVersion,
+			NotificationVersion:         metadata.NotificationVersion,
+			PreviousName:                name,
+		})
+	}
+
+	// the name of another domain cannot be taken
+	m.Error(rename(takenName))
+	resp, err := m.GetDomain(id, "")
+	m.NoError(err)
+	m.Equal(name, resp.Info.Name)
+	resp, err = m.GetDomain("", takenName)
+	m.NoError(err)
+	m.NotEqual(id, resp.Info.ID)
+
+	m.NoError(rename(newName))
+	resp, err = m.GetDomain(id, "")
+	m.NoError(err)
+	m.Equal(newName, resp.Info.Name)
+	m.Equal(map[string]string{"k1": "v1"}, resp.Info.Data)
+	m.True(resp.IsGlobalDomain)
+	resp, err = m.GetDomain("", newName)
+	m.NoError(err)
+	m.Equal(id, resp.Info.ID)
+	_, err = m.GetDomain("", name)
+	m.IsType(&gen.EntityNotExistsError{}, err)
+}
+
 // TestDeleteDomain test
 func (m *MetadataPersistenceSuiteV2) TestDeleteDomain() {
 	id := uuid.New()
@@ -112,12 +112,14 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	updatedRangeID := int64(142)
 	updatedTransferAckLevel := int64(1000)
 	updatedReplicationAckLevel := int64(2000)
+	updatedVisibilityAckLevel := int64(900)
 	updatedStolenSinceRenew := 10
 	updatedInfo := copyShardInfo(shardInfo)
 	updatedInfo.Owner = updatedOwner
 	updatedInfo.RangeID = updatedRangeID
 	updatedInfo.TransferAckLevel = updatedTransferAckLevel
 	updatedInfo.ReplicationAckLevel = updatedReplicationAckLevel
+	updatedInfo.VisibilityAckLevel = updatedVisibilityAckLevel
 	updatedInfo.StolenSinceRenew = updatedStolenSinceRenew
 	updatedTimerAckLevel := time.Now()
 	updatedInfo.TimerAckLevel = updatedTimerAckLevel
@@ -131,6 +133,7 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	s.Equal(updatedRangeID, info1.RangeID)
 	s.Equal(updatedTransferAckLevel, info1.TransferAckLevel)
 	s.Equal(updatedReplicationAckLevel, info1.ReplicationAckLevel)
+	s.Equal(updatedVisibilityAckLevel, info1.VisibilityAckLevel)
 	s.Equal(updatedStolenSinceRenew, info1.StolenSinceRenew)
 	s.EqualTimes(updatedTimerAckLevel, info1.TimerAckLevel)
 
@@ -150,6 +153,7 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	s.Equal(updatedRangeID, info2.RangeID)
 	s.Equal(updatedTransferAckLevel, info2.TransferAckLevel)
 	s.Equal(updatedReplicationAckLevel, info2.ReplicationAckLevel)
+	s.Equal(updatedVisibilityAckLevel, info2.VisibilityAckLevel)
 	s.Equal(updatedStolenSinceRenew, info2.StolenSinceRenew)
 	s.EqualTimes(updatedTimerAckLevel, info1.TimerAckLevel)
 }
@@ -161,6 +165,7 @@ func copyShardInfo(sourceInfo *p.ShardInfo) *p.ShardInfo {
 		RangeID:             sourceInfo.RangeID,
 		TransferAckLevel:    sourceInfo.TransferAckLevel,
 		ReplicationAckLevel: sourceInfo.ReplicationAckLevel,
+		VisibilityAckLevel:  sourceInfo.VisibilityAckLevel,
 		StolenSinceRenew:    sourceInfo.StolenSinceRenew,
 		TimerAckLevel:       sourceInfo.TimerAckLevel,
 	}
@@ -54,6 +54,13 @@ func TestSQLMetadataPersistenceSuiteV2(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestSQLClusterMetadataPersistenceSuite(t *testing.T) {
+	s := new(ClusterMetadataPersistenceSuite)
+	s.TestBase = NewTestBaseWithSQL(&TestBaseOptions{})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
 func TestSQLShardPersistenceSuite(t *testing.T) {
 	s := new(ShardPersistenceSuite)
 	s.TestBase = NewTestBaseWithSQL(&TestBaseOptions{})
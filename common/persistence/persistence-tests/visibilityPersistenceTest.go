@@ -609,11 +609,80 @@ func (s *VisibilityPersistenceSuite) TestMultipleUpserts() {
 
 }
 
+// TestCountAndScan test
+func (s *VisibilityPersistenceSuite) TestCountAndScan() {
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+
+	nOpen, nClosed := 3, 4
+	for i := 0; i < nOpen+nClosed; i++ {
+		workflowExecution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(uuid.New()),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        workflowExecution,
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime,
+		})
+		s.Nil(err0)
+		if i < nClosed {
+			err1 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
+				DomainUUID:       testDomainUUID,
+				Execution:        workflowExecution,
+				WorkflowTypeName: "visibility-workflow",
+				StartTimestamp:   startTime,
+				Status:           gen.WorkflowExecutionCloseStatusCompleted,
+				CloseTimestamp:   time.Now().UnixNano(),
+				HistoryLength:    3,
+			})
+			s.Nil(err1)
+		}
+	}
+
+	countResp, err2 := s.VisibilityMgr.CountWorkflowExecutions(&p.CountWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+	})
+	s.Nil(err2)
+	s.Equal(int64(nOpen), countResp.Count)
+
+	countResp, err3 := s.VisibilityMgr.CountWorkflowExecutions(&p.CountWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+		Closed:            true,
+		Status:            gen.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	})
+	s.Nil(err3)
+	s.Equal(int64(nClosed), countResp.Count)
+
+	runIDs := make(map[string]struct{})
+	var nextPageToken []byte
+	for {
+		resp, err4 := s.VisibilityMgr.ScanWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+			DomainUUID:        testDomainUUID,
+			EarliestStartTime: startTime,
+			LatestStartTime:   startTime,
+			PageSize:          2,
+			NextPageToken:     nextPageToken,
+		})
+		s.Nil(err4)
+		for _, execution := range resp.Executions {
+			runIDs[execution.GetExecution().GetRunId()] = struct{}{}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+	s.Equal(nOpen+nClosed, len(runIDs))
+}
+
 // TestDelete test
 func (s *VisibilityPersistenceSuite) TestDelete() {
-	if s.VisibilityMgr.GetName() == "cassandra" {
-		s.T().Skip("this test is not applicable for cassandra")
-	}
 	nRows := 5
 	testDomainUUID := uuid.New()
 	startTime := time.Now().Add(time.Second * -5).UnixNano()
@@ -654,8 +723,10 @@ func (s *VisibilityPersistenceSuite) TestDelete() {
 	remaining := nRows
 	for _, row := range resp.Executions {
 		err4 := s.VisibilityMgr.DeleteWorkflowExecution(&p.VisibilityDeleteWorkflowExecutionRequest{
-			DomainID: testDomainUUID,
-			RunID:    row.GetExecution().GetRunId(),
+			DomainID:       testDomainUUID,
+			WorkflowID:     row.GetExecution().GetWorkflowId(),
+			RunID:          row.GetExecution().GetRunId(),
+			StartTimestamp: startTime,
 		})
 		s.Nil(err4)
 		remaining--
@@ -670,6 +741,40 @@ func (s *VisibilityPersistenceSuite) TestDelete() {
 	}
 }
 
+// TestDeleteOpen test
+func (s *VisibilityPersistenceSuite) TestDeleteOpen() {
+	testDomainUUID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(uuid.New()),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+	})
+	s.Nil(err0)
+
+	err1 := s.VisibilityMgr.DeleteWorkflowExecution(&p.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:       testDomainUUID,
+		WorkflowID:     workflowExecution.GetWorkflowId(),
+		RunID:          workflowExecution.GetRunId(),
+		StartTimestamp: startTime,
+	})
+	s.Nil(err1)
+
+	resp, err2 := s.VisibilityMgr.ListOpenWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   time.Now().UnixNano(),
+		PageSize:          10,
+	})
+	s.Nil(err2)
+	s.Equal(0, len(resp.Executions))
+}
+
 func (s *VisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *gen.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunId, resp.Execution.RunId)
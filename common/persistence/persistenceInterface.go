@@ -33,15 +33,38 @@ type (
 	// Persistence interface is a lower layer of dataInterface.
 	// The intention is to let different persistence implementation(SQL,Cassandra/etc) share some common logic
 	// Right now the only common part is serialization/deserialization, and only ExecutionManager/HistoryManager need it.
-	// ShardManager/TaskManager/MetadataManager are the same.
+	// ShardManager/MetadataManager are the same, TaskManager only adds the request context.
 	//////////////////////////////////////////////////////////////////////
 
 	// ShardStore is a lower level of ShardManager
 	ShardStore = ShardManager
-	// TaskStore is a lower level of TaskManager
-	TaskStore = TaskManager
 	// MetadataStore is a lower level of MetadataManager
 	MetadataStore = MetadataManager
+	// ClusterMetadataStore is a lower level of ClusterMetadataManager
+	ClusterMetadataStore = ClusterMetadataManager
+
+	// TaskStore is a lower level of TaskManager
+	TaskStore interface {
+		Closeable
+		GetName() string
+		LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
+		UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
+		ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error)
+		DeleteTaskList(request *DeleteTaskListRequest) error
+		CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error)
+		GetTasks(request *GetTasksRequest) (*GetTasksResponse, error)
+		CompleteTask(request *CompleteTaskRequest) error
+		// CompleteTasksLessThan completes tasks less than or equal to the given task id
+		// This API takes a limit parameter which specifies the count of maxRows that
+		// can be deleted. This parameter may be ignored by the underlying storage, but
+		// its mandatory to specify it. On success this method returns the number of rows
+		// actually deleted. If the underlying storage doesn't support "limit", all rows
+		// less than or equal to taskID will be deleted.
+		// On success, this method returns:
+		//  - number of rows actually deleted, if limit is honored
+		//  - UnknownNumRowsDeleted, when all rows below value are deleted
+		CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error)
+	}
 
 	// ExecutionStore is used to manage workflow executions for Persistence layer
 	ExecutionStore interface {
@@ -63,6 +86,20 @@ type (
 		CompleteTransferTask(request *CompleteTransferTaskRequest) error
 		RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error
 
+		// Transfer task DLQ related methods
+		PutTransferDLQTask(request *PutTransferDLQTaskRequest) error
+		GetTransferDLQTasks(request *GetTransferDLQTasksRequest) (*GetTransferDLQTasksResponse, error)
+		RangeDeleteTransferDLQTasks(request *RangeDeleteTransferDLQTasksRequest) error
+
+		// Replication task DLQ related methods
+		PutReplicationDLQTask(request *PutReplicationDLQTaskRequest) error
+		GetReplicationDLQTasks(request *GetReplicationDLQTasksRequest) (*GetReplicationDLQTasksResponse, error)
+		RangeDeleteReplicationDLQTasks(request *RangeDeleteReplicationDLQTasksRequest) error
+
+		// Workflow execution annotation related methods
+		PutWorkflowExecutionAnnotation(request *PutWorkflowExecutionAnnotationRequest) error
+		GetWorkflowExecutionAnnotations(request *GetWorkflowExecutionAnnotationsRequest) (*GetWorkflowExecutionAnnotationsResponse, error)
+
 		// Replication task related methods
 		GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error)
 		CompleteReplicationTask(request *CompleteReplicationTaskRequest) error
@@ -169,6 +206,7 @@ type (
 		DecisionTimeout              int32
 		DecisionAttempt              int64
 		DecisionTimestamp            int64
+		DecisionFailureCount         int64
 		CancelRequested              bool
 		CancelRequestID              string
 		StickyTaskList               string
@@ -516,6 +554,7 @@ type (
 		WorkflowTimeout    int64
 		TaskID             int64
 		Memo               *DataBlob
+		SearchAttributes   map[string][]byte
 	}
 
 	// InternalRecordWorkflowExecutionClosedRequest is request to RecordWorkflowExecutionClosed
@@ -568,6 +607,10 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeThriftRWGzip:
+		return common.EncodingTypeThriftRWGzip
+	case common.EncodingTypeThriftRWSnappy:
+		return common.EncodingTypeThriftRWSnappy
 	case common.EncodingTypeEmpty:
 		return common.EncodingTypeEmpty
 	default:
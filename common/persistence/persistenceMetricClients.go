@@ -21,6 +21,9 @@
 package persistence
 
 import (
+	"context"
+	"time"
+
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -64,6 +67,12 @@ type (
 		logger       log.Logger
 	}
 
+	clusterMetadataPersistenceClient struct {
+		metricClient metrics.Client
+		persistence  ClusterMetadataManager
+		logger       log.Logger
+	}
+
 	visibilityPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  VisibilityManager
@@ -78,6 +87,7 @@ var _ HistoryManager = (*historyPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2PersistenceClient)(nil)
 var _ MetadataManager = (*metadataPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityPersistenceClient)(nil)
+var _ ClusterMetadataManager = (*clusterMetadataPersistenceClient)(nil)
 
 // NewShardPersistenceMetricsClient creates a client to manage shards
 func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient metrics.Client, logger log.Logger) ShardManager {
@@ -133,6 +143,15 @@ func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricClie
 	}
 }
 
+// NewClusterMetadataPersistenceMetricsClient creates a ClusterMetadataManager client to manage cluster metadata
+func NewClusterMetadataPersistenceMetricsClient(persistence ClusterMetadataManager, metricClient metrics.Client, logger log.Logger) ClusterMetadataManager {
+	return &clusterMetadataPersistenceClient{
+		persistence:  persistence,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
 // NewVisibilityPersistenceMetricsClient creates a client to manage visibility
 func NewVisibilityPersistenceMetricsClient(persistence VisibilityManager, metricClient metrics.Client, logger log.Logger) VisibilityManager {
 	return &visibilityPersistenceClient{
@@ -197,6 +216,11 @@ func (p *shardPersistenceClient) updateErrorMetric(scope int, err error) {
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrShardOwnershipLostCounter)
 	case *workflow.EntityNotExistsError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
+	case *ConditionFailedError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
+	case *TimeoutError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	case *workflow.ServiceBusyError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
@@ -218,11 +242,11 @@ func (p *workflowExecutionPersistenceClient) GetShardID() int {
 	return p.persistence.GetShardID()
 }
 
-func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(ctx context.Context, request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceLatency)
-	response, err := p.persistence.CreateWorkflowExecution(request)
+	response, err := p.persistence.CreateWorkflowExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -232,39 +256,43 @@ func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *Cr
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetWorkflowExecution(request)
+	response, err := p.persistence.GetWorkflowExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionScope, err)
+	} else if response.MutableStateStats != nil {
+		p.recordPayloadSize(metrics.PersistenceGetWorkflowExecutionScope, response.MutableStateStats.MutableStateSize)
 	}
 
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceLatency)
-	resp, err := p.persistence.UpdateWorkflowExecution(request)
+	resp, err := p.persistence.UpdateWorkflowExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateWorkflowExecutionScope, err)
+	} else if resp.MutableStateUpdateSessionStats != nil {
+		p.recordPayloadSize(metrics.PersistenceUpdateWorkflowExecutionScope, resp.MutableStateUpdateSessionStats.MutableStateSize)
 	}
 
 	return resp, err
 }
 
-func (p *workflowExecutionPersistenceClient) ResetMutableState(request *ResetMutableStateRequest) error {
+func (p *workflowExecutionPersistenceClient) ResetMutableState(ctx context.Context, request *ResetMutableStateRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceResetMutableStateScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceResetMutableStateScope, metrics.PersistenceLatency)
-	err := p.persistence.ResetMutableState(request)
+	err := p.persistence.ResetMutableState(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -274,11 +302,11 @@ func (p *workflowExecutionPersistenceClient) ResetMutableState(request *ResetMut
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+func (p *workflowExecutionPersistenceClient) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceResetWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceResetWorkflowExecutionScope, metrics.PersistenceLatency)
-	err := p.persistence.ResetWorkflowExecution(request)
+	err := p.persistence.ResetWorkflowExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -288,11 +316,11 @@ func (p *workflowExecutionPersistenceClient) ResetWorkflowExecution(request *Res
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteWorkflowExecution(request)
+	err := p.persistence.DeleteWorkflowExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -302,11 +330,11 @@ func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *De
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetCurrentExecution(ctx context.Context, request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetCurrentExecution(request)
+	response, err := p.persistence.GetCurrentExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -316,39 +344,43 @@ func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCur
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetTransferTasks(ctx context.Context, request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetTransferTasks(request)
+	response, err := p.persistence.GetTransferTasks(ctx, request)
 	sw.Stop()
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTransferTasksScope, err)
+	} else {
+		p.recordPayloadCount(metrics.PersistenceGetTransferTasksScope, len(response.Tasks))
 	}
 
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetReplicationTasks(ctx context.Context, request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetReplicationTasks(request)
+	response, err := p.persistence.GetReplicationTasks(ctx, request)
 	sw.Stop()
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetReplicationTasksScope, err)
+	} else {
+		p.recordPayloadCount(metrics.PersistenceGetReplicationTasksScope, len(response.Tasks))
 	}
 
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) CompleteTransferTask(ctx context.Context, request *CompleteTransferTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteTransferTask(request)
+	err := p.persistence.CompleteTransferTask(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -358,11 +390,11 @@ func (p *workflowExecutionPersistenceClient) CompleteTransferTask(request *Compl
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) RangeCompleteTransferTask(ctx context.Context, request *RangeCompleteTransferTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.RangeCompleteTransferTask(request)
+	err := p.persistence.RangeCompleteTransferTask(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -372,11 +404,127 @@ func (p *workflowExecutionPersistenceClient) RangeCompleteTransferTask(request *
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) PutTransferDLQTask(ctx context.Context, request *PutTransferDLQTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePutTransferDLQTaskScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePutTransferDLQTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.PutTransferDLQTask(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutTransferDLQTaskScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) GetTransferDLQTasks(ctx context.Context, request *GetTransferDLQTasksRequest) (*GetTransferDLQTasksResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetTransferDLQTasksScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetTransferDLQTasksScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetTransferDLQTasks(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetTransferDLQTasksScope, err)
+	} else {
+		p.recordPayloadCount(metrics.PersistenceGetTransferDLQTasksScope, len(response.Tasks))
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) RangeDeleteTransferDLQTasks(ctx context.Context, request *RangeDeleteTransferDLQTasksRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteTransferDLQTasksScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteTransferDLQTasksScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeDeleteTransferDLQTasks(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeDeleteTransferDLQTasksScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) PutReplicationDLQTask(ctx context.Context, request *PutReplicationDLQTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePutReplicationDLQTaskScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePutReplicationDLQTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.PutReplicationDLQTask(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutReplicationDLQTaskScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) GetReplicationDLQTasks(ctx context.Context, request *GetReplicationDLQTasksRequest) (*GetReplicationDLQTasksResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetReplicationDLQTasksScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationDLQTasksScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetReplicationDLQTasks(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetReplicationDLQTasksScope, err)
+	} else {
+		p.recordPayloadCount(metrics.PersistenceGetReplicationDLQTasksScope, len(response.Tasks))
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) RangeDeleteReplicationDLQTasks(ctx context.Context, request *RangeDeleteReplicationDLQTasksRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeDeleteReplicationDLQTasksScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeDeleteReplicationDLQTasksScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeDeleteReplicationDLQTasks(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeDeleteReplicationDLQTasksScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) PutWorkflowExecutionAnnotation(ctx context.Context, request *PutWorkflowExecutionAnnotationRequest) error {
+	p.metricClient.IncCounter(metrics.PersistencePutWorkflowExecutionAnnotationScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistencePutWorkflowExecutionAnnotationScope, metrics.PersistenceLatency)
+	err := p.persistence.PutWorkflowExecutionAnnotation(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistencePutWorkflowExecutionAnnotationScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) GetWorkflowExecutionAnnotations(ctx context.Context, request *GetWorkflowExecutionAnnotationsRequest) (*GetWorkflowExecutionAnnotationsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionAnnotationsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionAnnotationsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetWorkflowExecutionAnnotations(ctx, request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionAnnotationsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(ctx context.Context, request *CompleteReplicationTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteReplicationTask(request)
+	err := p.persistence.CompleteReplicationTask(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -386,25 +534,27 @@ func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(request *Co
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceLatency)
-	resonse, err := p.persistence.GetTimerIndexTasks(request)
+	resonse, err := p.persistence.GetTimerIndexTasks(ctx, request)
 	sw.Stop()
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTimerIndexTasksScope, err)
+	} else {
+		p.recordPayloadCount(metrics.PersistenceGetTimerIndexTasksScope, len(resonse.Timers))
 	}
 
 	return resonse, err
 }
 
-func (p *workflowExecutionPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteTimerTask(request)
+	err := p.persistence.CompleteTimerTask(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -414,11 +564,11 @@ func (p *workflowExecutionPersistenceClient) CompleteTimerTask(request *Complete
 	return err
 }
 
-func (p *workflowExecutionPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+func (p *workflowExecutionPersistenceClient) RangeCompleteTimerTask(ctx context.Context, request *RangeCompleteTimerTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.RangeCompleteTimerTask(request)
+	err := p.persistence.RangeCompleteTimerTask(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -453,6 +603,14 @@ func (p *workflowExecutionPersistenceClient) updateErrorMetric(scope int, err er
 	}
 }
 
+func (p *workflowExecutionPersistenceClient) recordPayloadSize(scope int, size int) {
+	p.metricClient.RecordTimer(scope, metrics.PersistencePayloadSize, time.Duration(size))
+}
+
+func (p *workflowExecutionPersistenceClient) recordPayloadCount(scope int, count int) {
+	p.metricClient.RecordTimer(scope, metrics.PersistencePayloadCount, time.Duration(count))
+}
+
 func (p *workflowExecutionPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -461,11 +619,13 @@ func (p *taskPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
 
-func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+func (p *taskPersistenceClient) CreateTasks(ctx context.Context, request *CreateTasksRequest) (*CreateTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateTaskScope, metrics.PersistenceRequests)
 
+	p.recordPayloadCount(metrics.PersistenceCreateTaskScope, len(request.Tasks))
+
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateTaskScope, metrics.PersistenceLatency)
-	response, err := p.persistence.CreateTasks(request)
+	response, err := p.persistence.CreateTasks(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -475,25 +635,27 @@ func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*Creat
 	return response, err
 }
 
-func (p *taskPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+func (p *taskPersistenceClient) GetTasks(ctx context.Context, request *GetTasksRequest) (*GetTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTasksScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTasksScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetTasks(request)
+	response, err := p.persistence.GetTasks(ctx, request)
 	sw.Stop()
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTasksScope, err)
+	} else {
+		p.recordPayloadCount(metrics.PersistenceGetTasksScope, len(response.Tasks))
 	}
 
 	return response, err
 }
 
-func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+func (p *taskPersistenceClient) CompleteTask(ctx context.Context, request *CompleteTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTaskScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTaskScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteTask(request)
+	err := p.persistence.CompleteTask(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -503,22 +665,24 @@ func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error
 	return err
 }
 
-func (p *taskPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+func (p *taskPersistenceClient) CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceLatency)
-	result, err := p.persistence.CompleteTasksLessThan(request)
+	result, err := p.persistence.CompleteTasksLessThan(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteTasksLessThanScope, err)
+	} else {
+		p.recordPayloadCount(metrics.PersistenceCompleteTasksLessThanScope, result)
 	}
 	return result, err
 }
 
-func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+func (p *taskPersistenceClient) LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceLatency)
-	response, err := p.persistence.LeaseTaskList(request)
+	response, err := p.persistence.LeaseTaskList(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -528,21 +692,23 @@ func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*L
 	return response, err
 }
 
-func (p *taskPersistenceClient) ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error) {
+func (p *taskPersistenceClient) ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListTaskListScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceListTaskListScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListTaskList(request)
+	response, err := p.persistence.ListTaskList(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListTaskListScope, err)
+	} else {
+		p.recordPayloadCount(metrics.PersistenceListTaskListScope, len(response.Items))
 	}
 	return response, err
 }
 
-func (p *taskPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
+func (p *taskPersistenceClient) DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteTaskList(request)
+	err := p.persistence.DeleteTaskList(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteTaskListScope, err)
@@ -550,11 +716,11 @@ func (p *taskPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) e
 	return err
 }
 
-func (p *taskPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+func (p *taskPersistenceClient) UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateTaskListScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateTaskListScope, metrics.PersistenceLatency)
-	response, err := p.persistence.UpdateTaskList(request)
+	response, err := p.persistence.UpdateTaskList(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -568,6 +734,8 @@ func (p *taskPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *ConditionFailedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
+	case *workflow.EntityNotExistsError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
 	case *TimeoutError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
@@ -581,6 +749,10 @@ func (p *taskPersistenceClient) updateErrorMetric(scope int, err error) {
 	}
 }
 
+func (p *taskPersistenceClient) recordPayloadCount(scope int, count int) {
+	p.metricClient.RecordTimer(scope, metrics.PersistencePayloadCount, time.Duration(count))
+}
+
 func (p *taskPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -795,6 +967,106 @@ func (p *metadataPersistenceClient) updateErrorMetric(scope int, err error) {
 	}
 }
 
+func (p *clusterMetadataPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *clusterMetadataPersistenceClient) InitializeImmutableClusterMetadata(
+	request *InitializeImmutableClusterMetadataRequest,
+) (*InitializeImmutableClusterMetadataResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceInitializeImmutableClusterMetadataScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceInitializeImmutableClusterMetadataScope, metrics.PersistenceLatency)
+	response, err := p.persistence.InitializeImmutableClusterMetadata(request)
+	sw.Stop()
+
+	if err != nil {
+		p.logger.Error("Operation failed with internal error.",
+			tag.Error(err), tag.MetricScope(metrics.PersistenceInitializeImmutableClusterMetadataScope))
+		p.metricClient.IncCounter(metrics.PersistenceInitializeImmutableClusterMetadataScope, metrics.PersistenceFailures)
+	}
+
+	return response, err
+}
+
+func (p *clusterMetadataPersistenceClient) GetSearchAttributes() (*GetSearchAttributesResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetSearchAttributesScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetSearchAttributesScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetSearchAttributes()
+	sw.Stop()
+
+	if err != nil {
+		p.logger.Error("Operation failed with internal error.",
+			tag.Error(err), tag.MetricScope(metrics.PersistenceGetSearchAttributesScope))
+		p.metricClient.IncCounter(metrics.PersistenceGetSearchAttributesScope, metrics.PersistenceFailures)
+	}
+
+	return response, err
+}
+
+func (p *clusterMetadataPersistenceClient) UpdateSearchAttributes(request *UpdateSearchAttributesRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateSearchAttributesScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateSearchAttributesScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateSearchAttributes(request)
+	sw.Stop()
+
+	if err != nil {
+		switch err.(type) {
+		case *ConditionFailedError:
+			p.metricClient.IncCounter(metrics.PersistenceUpdateSearchAttributesScope, metrics.PersistenceErrConditionFailedCounter)
+		default:
+			p.logger.Error("Operation failed with internal error.",
+				tag.Error(err), tag.MetricScope(metrics.PersistenceUpdateSearchAttributesScope))
+			p.metricClient.IncCounter(metrics.PersistenceUpdateSearchAttributesScope, metrics.PersistenceFailures)
+		}
+	}
+
+	return err
+}
+
+func (p *clusterMetadataPersistenceClient) GetDynamicConfig() (*GetDynamicConfigResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetDynamicConfig()
+	sw.Stop()
+
+	if err != nil {
+		p.logger.Error("Operation failed with internal error.",
+			tag.Error(err), tag.MetricScope(metrics.PersistenceGetDynamicConfigScope))
+		p.metricClient.IncCounter(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceFailures)
+	}
+
+	return response, err
+}
+
+func (p *clusterMetadataPersistenceClient) UpdateDynamicConfig(request *UpdateDynamicConfigRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateDynamicConfigScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateDynamicConfigScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateDynamicConfig(request)
+	sw.Stop()
+
+	if err != nil {
+		switch err.(type) {
+		case *ConditionFailedError:
+			p.metricClient.IncCounter(metrics.PersistenceUpdateDynamicConfigScope, metrics.PersistenceErrConditionFailedCounter)
+		default:
+			p.logger.Error("Operation failed with internal error.",
+				tag.Error(err), tag.MetricScope(metrics.PersistenceUpdateDynamicConfigScope))
+			p.metricClient.IncCounter(metrics.PersistenceUpdateDynamicConfigScope, metrics.PersistenceFailures)
+		}
+	}
+
+	return err
+}
+
+func (p *clusterMetadataPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
 func (p *visibilityPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
@@ -953,6 +1225,34 @@ func (p *visibilityPersistenceClient) DeleteWorkflowExecution(request *Visibilit
 	return err
 }
 
+func (p *visibilityPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.CountWorkflowExecutions(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCountWorkflowExecutionsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *visibilityPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceScanWorkflowExecutionsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceScanWorkflowExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ScanWorkflowExecutions(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceScanWorkflowExecutionsScope, err)
+	}
+
+	return response, err
+}
+
 func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *ConditionFailedError:
@@ -985,10 +1285,10 @@ func (p *historyV2PersistenceClient) Close() {
 }
 
 // AppendHistoryNodes add(or override) a node to a history branch
-func (p *historyV2PersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+func (p *historyV2PersistenceClient) AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceLatency)
-	resp, err := p.persistence.AppendHistoryNodes(request)
+	resp, err := p.persistence.AppendHistoryNodes(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceAppendHistoryNodesScope, err)
@@ -997,10 +1297,10 @@ func (p *historyV2PersistenceClient) AppendHistoryNodes(request *AppendHistoryNo
 }
 
 // ReadHistoryBranch returns history node data for a branch
-func (p *historyV2PersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+func (p *historyV2PersistenceClient) ReadHistoryBranch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ReadHistoryBranch(request)
+	response, err := p.persistence.ReadHistoryBranch(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
@@ -1009,10 +1309,10 @@ func (p *historyV2PersistenceClient) ReadHistoryBranch(request *ReadHistoryBranc
 }
 
 // ReadHistoryBranchByBatch returns history node data for a branch ByBatch
-func (p *historyV2PersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+func (p *historyV2PersistenceClient) ReadHistoryBranchByBatch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
@@ -1021,10 +1321,10 @@ func (p *historyV2PersistenceClient) ReadHistoryBranchByBatch(request *ReadHisto
 }
 
 // ForkHistoryBranch forks a new branch from a old branch
-func (p *historyV2PersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+func (p *historyV2PersistenceClient) ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ForkHistoryBranch(request)
+	response, err := p.persistence.ForkHistoryBranch(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceForkHistoryBranchScope, err)
@@ -1033,10 +1333,10 @@ func (p *historyV2PersistenceClient) ForkHistoryBranch(request *ForkHistoryBranc
 }
 
 // DeleteHistoryBranch removes a branch
-func (p *historyV2PersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+func (p *historyV2PersistenceClient) DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceLatency)
-	err := p.persistence.DeleteHistoryBranch(request)
+	err := p.persistence.DeleteHistoryBranch(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteHistoryBranchScope, err)
@@ -1045,10 +1345,10 @@ func (p *historyV2PersistenceClient) DeleteHistoryBranch(request *DeleteHistoryB
 }
 
 // CompleteForkBranch complete forking process
-func (p *historyV2PersistenceClient) CompleteForkBranch(request *CompleteForkBranchRequest) error {
+func (p *historyV2PersistenceClient) CompleteForkBranch(ctx context.Context, request *CompleteForkBranchRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteForkBranchScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteForkBranchScope, metrics.PersistenceLatency)
-	err := p.persistence.CompleteForkBranch(request)
+	err := p.persistence.CompleteForkBranch(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteForkBranchScope, err)
@@ -1057,10 +1357,10 @@ func (p *historyV2PersistenceClient) CompleteForkBranch(request *CompleteForkBra
 }
 
 // GetHistoryTree returns all branch information of a tree
-func (p *historyV2PersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+func (p *historyV2PersistenceClient) GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceRequests)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetHistoryTree(request)
+	response, err := p.persistence.GetHistoryTree(ctx, request)
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetHistoryTreeScope, err)
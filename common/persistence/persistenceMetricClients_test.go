@@ -0,0 +1,258 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	metricClientsSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		scope        tally.TestScope
+		metricClient metrics.Client
+	}
+
+	// testExecutionManager, testTaskManager and testShardManager return the
+	// configured response and error, the remaining methods are not used
+	testExecutionManager struct {
+		ExecutionManager
+		err error
+	}
+
+	testTaskManager struct {
+		TaskManager
+		tasks int
+		err   error
+	}
+
+	testShardManager struct {
+		ShardManager
+		err error
+	}
+)
+
+func TestMetricClientsSuite(t *testing.T) {
+	s := new(metricClientsSuite)
+	suite.Run(t, s)
+}
+
+func (s *metricClientsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.scope = tally.NewTestScope("test", nil)
+	s.metricClient = metrics.NewClient(s.scope, metrics.History)
+}
+
+func (s *metricClientsSuite) TestExecutionPayloadSize() {
+	client := NewWorkflowExecutionPersistenceMetricsClient(&testExecutionManager{}, s.metricClient, loggerimpl.NewNopLogger())
+
+	_, err := client.GetWorkflowExecution(context.Background(), &GetWorkflowExecutionRequest{})
+	s.NoError(err)
+	_, err = client.UpdateWorkflowExecution(context.Background(), &UpdateWorkflowExecutionRequest{})
+	s.NoError(err)
+
+	s.Equal(1, s.counter("persistence_requests", "GetWorkflowExecution"))
+	s.Equal([]time.Duration{123}, s.timer("persistence_payload_size", "GetWorkflowExecution"))
+	s.Len(s.timer("persistence_latency", "GetWorkflowExecution"), 1)
+	s.Equal(1, s.counter("persistence_requests", "UpdateWorkflowExecution"))
+	s.Equal([]time.Duration{456}, s.timer("persistence_payload_size", "UpdateWorkflowExecution"))
+}
+
+func (s *metricClientsSuite) TestExecutionPayloadCount() {
+	client := NewWorkflowExecutionPersistenceMetricsClient(&testExecutionManager{}, s.metricClient, loggerimpl.NewNopLogger())
+
+	_, err := client.GetTransferTasks(context.Background(), &GetTransferTasksRequest{})
+	s.NoError(err)
+	_, err = client.GetTimerIndexTasks(context.Background(), &GetTimerIndexTasksRequest{})
+	s.NoError(err)
+	_, err = client.GetReplicationTasks(context.Background(), &GetReplicationTasksRequest{})
+	s.NoError(err)
+
+	s.Equal([]time.Duration{2}, s.timer("persistence_payload_count", "GetTransferTasks"))
+	s.Equal([]time.Duration{3}, s.timer("persistence_payload_count", "GetTimerIndexTasks"))
+	s.Equal([]time.Duration{1}, s.timer("persistence_payload_count", "GetReplicationTasks"))
+}
+
+func (s *metricClientsSuite) TestExecutionErrors() {
+	manager := &testExecutionManager{err: &TimeoutError{Msg: "timeout"}}
+	client := NewWorkflowExecutionPersistenceMetricsClient(manager, s.metricClient, loggerimpl.NewNopLogger())
+
+	_, err := client.GetWorkflowExecution(context.Background(), &GetWorkflowExecutionRequest{})
+	s.Equal(manager.err, err)
+	manager.err = &ShardOwnershipLostError{ShardID: 1}
+	_, err = client.UpdateWorkflowExecution(context.Background(), &UpdateWorkflowExecutionRequest{})
+	s.Equal(manager.err, err)
+
+	s.Equal(1, s.counter("persistence_errors_timeout", "GetWorkflowExecution"))
+	s.Equal(1, s.counter("persistence_errors", "GetWorkflowExecution"))
+	s.Empty(s.timer("persistence_payload_size", "GetWorkflowExecution"))
+	s.Equal(1, s.counter("persistence_errors_shard_ownership_lost", "UpdateWorkflowExecution"))
+	s.Equal(0, s.counter("persistence_errors", "UpdateWorkflowExecution"))
+}
+
+func (s *metricClientsSuite) TestTaskPayloadCount() {
+	client := NewTaskPersistenceMetricsClient(&testTaskManager{tasks: 4}, s.metricClient, loggerimpl.NewNopLogger())
+
+	_, err := client.CreateTasks(context.Background(), &CreateTasksRequest{Tasks: make([]*CreateTaskInfo, 2)})
+	s.NoError(err)
+	_, err = client.GetTasks(context.Background(), &GetTasksRequest{})
+	s.NoError(err)
+	_, err = client.CompleteTasksLessThan(context.Background(), &CompleteTasksLessThanRequest{})
+	s.NoError(err)
+	_, err = client.ListTaskList(context.Background(), &ListTaskListRequest{})
+	s.NoError(err)
+
+	s.Equal(1, s.counter("persistence_requests", "CreateTask"))
+	s.Equal([]time.Duration{2}, s.timer("persistence_payload_count", "CreateTask"))
+	s.Equal([]time.Duration{4}, s.timer("persistence_payload_count", "GetTasks"))
+	s.Equal([]time.Duration{4}, s.timer("persistence_payload_count", "CompleteTasksLessThan"))
+	s.Equal([]time.Duration{4}, s.timer("persistence_payload_count", "ListTaskList"))
+}
+
+func (s *metricClientsSuite) TestTaskErrors() {
+	manager := &testTaskManager{err: &ConditionFailedError{Msg: "range changed"}}
+	client := NewTaskPersistenceMetricsClient(manager, s.metricClient, loggerimpl.NewNopLogger())
+
+	_, err := client.GetTasks(context.Background(), &GetTasksRequest{})
+	s.Equal(manager.err, err)
+	manager.err = &workflow.EntityNotExistsError{}
+	_, err = client.ListTaskList(context.Background(), &ListTaskListRequest{})
+	s.Equal(manager.err, err)
+	manager.err = errors.New("internal")
+	_, err = client.CompleteTasksLessThan(context.Background(), &CompleteTasksLessThanRequest{})
+	s.Equal(manager.err, err)
+
+	s.Equal(1, s.counter("persistence_errors_condition_failed", "GetTasks"))
+	s.Empty(s.timer("persistence_payload_count", "GetTasks"))
+	s.Equal(1, s.counter("persistence_errors_entity_not_exists", "ListTaskList"))
+	s.Equal(1, s.counter("persistence_errors", "CompleteTasksLessThan"))
+}
+
+func (s *metricClientsSuite) TestShardErrors() {
+	manager := &testShardManager{err: &ConditionFailedError{Msg: "range changed"}}
+	client := NewShardPersistenceMetricsClient(manager, s.metricClient, loggerimpl.NewNopLogger())
+
+	s.Equal(manager.err, client.UpdateShard(&UpdateShardRequest{}))
+	manager.err = &TimeoutError{Msg: "timeout"}
+	_, err := client.GetShard(&GetShardRequest{})
+	s.Equal(manager.err, err)
+
+	s.Equal(1, s.counter("persistence_requests", "UpdateShard"))
+	s.Equal(1, s.counter("persistence_errors_condition_failed", "UpdateShard"))
+	s.Equal(1, s.counter("persistence_errors_timeout", "GetShard"))
+	s.Equal(1, s.counter("persistence_errors", "GetShard"))
+}
+
+func (s *metricClientsSuite) counter(name string, operation string) int {
+	total := 0
+	for _, counter := range s.scope.Snapshot().Counters() {
+		if counter.Name() == "test."+name && counter.Tags()["operation"] == operation {
+			total += int(counter.Value())
+		}
+	}
+	return total
+}
+
+func (s *metricClientsSuite) timer(name string, operation string) []time.Duration {
+	var values []time.Duration
+	for _, timer := range s.scope.Snapshot().Timers() {
+		if timer.Name() == "test."+name && timer.Tags()["operation"] == operation {
+			values = append(values, timer.Values()...)
+		}
+	}
+	return values
+}
+
+func (t *testExecutionManager) GetShardID() int {
+	return 1
+}
+
+func (t *testExecutionManager) GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	return &GetWorkflowExecutionResponse{MutableStateStats: &MutableStateStats{MutableStateSize: 123}}, nil
+}
+
+func (t *testExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	return &UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &MutableStateUpdateSessionStats{MutableStateSize: 456}}, nil
+}
+
+func (t *testExecutionManager) GetTransferTasks(ctx context.Context, request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	return &GetTransferTasksResponse{Tasks: make([]*TransferTaskInfo, 2)}, t.err
+}
+
+func (t *testExecutionManager) GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	return &GetTimerIndexTasksResponse{Timers: make([]*TimerTaskInfo, 3)}, t.err
+}
+
+func (t *testExecutionManager) GetReplicationTasks(ctx context.Context, request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	return &GetReplicationTasksResponse{Tasks: make([]*ReplicationTaskInfo, 1)}, t.err
+}
+
+func (t *testTaskManager) CreateTasks(ctx context.Context, request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	return &CreateTasksResponse{}, t.err
+}
+
+func (t *testTaskManager) GetTasks(ctx context.Context, request *GetTasksRequest) (*GetTasksResponse, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	return &GetTasksResponse{Tasks: make([]*TaskInfo, t.tasks)}, nil
+}
+
+func (t *testTaskManager) CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error) {
+	if t.err != nil {
+		return 0, t.err
+	}
+	return t.tasks, nil
+}
+
+func (t *testTaskManager) ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	return &ListTaskListResponse{Items: make([]TaskListInfo, t.tasks)}, nil
+}
+
+func (t *testShardManager) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	return nil, t.err
+}
+
+func (t *testShardManager) UpdateShard(request *UpdateShardRequest) error {
+	return t.err
+}
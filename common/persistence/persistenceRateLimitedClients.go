@@ -21,9 +21,10 @@
 package persistence
 
 import (
+	"context"
+
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
-	"github.com/uber/cadence/common/tokenbucket"
 )
 
 var (
@@ -35,43 +36,57 @@ var (
 
 type (
 	shardRateLimitedPersistenceClient struct {
-		rateLimiter tokenbucket.TokenBucket
+		rateLimiter RateLimiter
+		callerType  CallerType
 		persistence ShardManager
 		logger      log.Logger
 	}
 
 	workflowExecutionRateLimitedPersistenceClient struct {
-		rateLimiter tokenbucket.TokenBucket
+		rateLimiter RateLimiter
+		callerType  CallerType
 		persistence ExecutionManager
 		logger      log.Logger
 	}
 
 	taskRateLimitedPersistenceClient struct {
-		rateLimiter tokenbucket.TokenBucket
+		rateLimiter RateLimiter
+		callerType  CallerType
 		persistence TaskManager
 		logger      log.Logger
 	}
 
 	historyRateLimitedPersistenceClient struct {
-		rateLimiter tokenbucket.TokenBucket
+		rateLimiter RateLimiter
+		callerType  CallerType
 		persistence HistoryManager
 		logger      log.Logger
 	}
 
 	historyV2RateLimitedPersistenceClient struct {
-		rateLimiter tokenbucket.TokenBucket
+		rateLimiter RateLimiter
+		callerType  CallerType
 		persistence HistoryV2Manager
 		logger      log.Logger
 	}
 
 	metadataRateLimitedPersistenceClient struct {
-		rateLimiter tokenbucket.TokenBucket
+		rateLimiter RateLimiter
+		callerType  CallerType
 		persistence MetadataManager
 		logger      log.Logger
 	}
 
+	clusterMetadataRateLimitedPersistenceClient struct {
+		rateLimiter RateLimiter
+		callerType  CallerType
+		persistence ClusterMetadataManager
+		logger      log.Logger
+	}
+
 	visibilityRateLimitedPersistenceClient struct {
-		rateLimiter tokenbucket.TokenBucket
+		rateLimiter RateLimiter
+		callerType  CallerType
 		persistence VisibilityManager
 		logger      log.Logger
 	}
@@ -84,66 +99,84 @@ var _ HistoryManager = (*historyRateLimitedPersistenceClient)(nil)
 var _ HistoryV2Manager = (*historyV2RateLimitedPersistenceClient)(nil)
 var _ MetadataManager = (*metadataRateLimitedPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityRateLimitedPersistenceClient)(nil)
+var _ ClusterMetadataManager = (*clusterMetadataRateLimitedPersistenceClient)(nil)
 
 // NewShardPersistenceRateLimitedClient creates a client to manage shards
-func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) ShardManager {
+func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter RateLimiter, callerType CallerType, logger log.Logger) ShardManager {
 	return &shardRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
+		callerType:  callerType,
 		logger:      logger,
 	}
 }
 
 // NewWorkflowExecutionPersistenceRateLimitedClient creates a client to manage executions
-func NewWorkflowExecutionPersistenceRateLimitedClient(persistence ExecutionManager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) ExecutionManager {
+func NewWorkflowExecutionPersistenceRateLimitedClient(persistence ExecutionManager, rateLimiter RateLimiter, callerType CallerType, logger log.Logger) ExecutionManager {
 	return &workflowExecutionRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
+		callerType:  callerType,
 		logger:      logger,
 	}
 }
 
 // NewTaskPersistenceRateLimitedClient creates a client to manage tasks
-func NewTaskPersistenceRateLimitedClient(persistence TaskManager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) TaskManager {
+func NewTaskPersistenceRateLimitedClient(persistence TaskManager, rateLimiter RateLimiter, callerType CallerType, logger log.Logger) TaskManager {
 	return &taskRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
+		callerType:  callerType,
 		logger:      logger,
 	}
 }
 
 // NewHistoryPersistenceRateLimitedClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryPersistenceRateLimitedClient(persistence HistoryManager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) HistoryManager {
+func NewHistoryPersistenceRateLimitedClient(persistence HistoryManager, rateLimiter RateLimiter, callerType CallerType, logger log.Logger) HistoryManager {
 	return &historyRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
+		callerType:  callerType,
 		logger:      logger,
 	}
 }
 
 // NewHistoryV2PersistenceRateLimitedClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryV2PersistenceRateLimitedClient(persistence HistoryV2Manager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) HistoryV2Manager {
+func NewHistoryV2PersistenceRateLimitedClient(persistence HistoryV2Manager, rateLimiter RateLimiter, callerType CallerType, logger log.Logger) HistoryV2Manager {
 	return &historyV2RateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
+		callerType:  callerType,
 		logger:      logger,
 	}
 }
 
 // NewMetadataPersistenceRateLimitedClient creates a MetadataManager client to manage metadata
-func NewMetadataPersistenceRateLimitedClient(persistence MetadataManager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) MetadataManager {
+func NewMetadataPersistenceRateLimitedClient(persistence MetadataManager, rateLimiter RateLimiter, callerType CallerType, logger log.Logger) MetadataManager {
 	return &metadataRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
+		callerType:  callerType,
+		logger:      logger,
+	}
+}
+
+// NewClusterMetadataPersistenceRateLimitedClient creates a ClusterMetadataManager client to manage cluster metadata
+func NewClusterMetadataPersistenceRateLimitedClient(persistence ClusterMetadataManager, rateLimiter RateLimiter, callerType CallerType, logger log.Logger) ClusterMetadataManager {
+	return &clusterMetadataRateLimitedPersistenceClient{
+		persistence: persistence,
+		rateLimiter: rateLimiter,
+		callerType:  callerType,
 		logger:      logger,
 	}
 }
 
 // NewVisibilityPersistenceRateLimitedClient creates a client to manage visibility
-func NewVisibilityPersistenceRateLimitedClient(persistence VisibilityManager, rateLimiter tokenbucket.TokenBucket, logger log.Logger) VisibilityManager {
+func NewVisibilityPersistenceRateLimitedClient(persistence VisibilityManager, rateLimiter RateLimiter, callerType CallerType, logger log.Logger) VisibilityManager {
 	return &visibilityRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
+		callerType:  callerType,
 		logger:      logger,
 	}
 }
@@ -153,7 +186,7 @@ func (p *shardRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *shardRateLimitedPersistenceClient) CreateShard(request *CreateShardRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -162,7 +195,7 @@ func (p *shardRateLimitedPersistenceClient) CreateShard(request *CreateShardRequ
 }
 
 func (p *shardRateLimitedPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -171,7 +204,7 @@ func (p *shardRateLimitedPersistenceClient) GetShard(request *GetShardRequest) (
 }
 
 func (p *shardRateLimitedPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -191,147 +224,219 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetShardID() int {
 	return p.persistence.GetShardID()
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) CreateWorkflowExecution(ctx context.Context, request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.CreateWorkflowExecution(request)
+	response, err := p.persistence.CreateWorkflowExecution(ctx, request)
 	return response, err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.GetWorkflowExecution(request)
+	response, err := p.persistence.GetWorkflowExecution(ctx, request)
 	return response, err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	resp, err := p.persistence.UpdateWorkflowExecution(request)
+	resp, err := p.persistence.UpdateWorkflowExecution(ctx, request)
 	return resp, err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) ResetMutableState(request *ResetMutableStateRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) ResetMutableState(ctx context.Context, request *ResetMutableStateRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.ResetMutableState(request)
+	err := p.persistence.ResetMutableState(ctx, request)
 	return err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.ResetWorkflowExecution(request)
+	err := p.persistence.ResetWorkflowExecution(ctx, request)
 	return err
 }
 
 // CompleteForkBranch complete forking process
-func (p *historyV2RateLimitedPersistenceClient) CompleteForkBranch(request *CompleteForkBranchRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *historyV2RateLimitedPersistenceClient) CompleteForkBranch(ctx context.Context, request *CompleteForkBranchRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
-	err := p.persistence.CompleteForkBranch(request)
+	err := p.persistence.CompleteForkBranch(ctx, request)
 	return err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.DeleteWorkflowExecution(request)
+	err := p.persistence.DeleteWorkflowExecution(ctx, request)
 	return err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(ctx context.Context, request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.GetCurrentExecution(request)
+	response, err := p.persistence.GetCurrentExecution(ctx, request)
 	return response, err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferTasks(ctx context.Context, request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.GetTransferTasks(request)
+	response, err := p.persistence.GetTransferTasks(ctx, request)
 	return response, err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) GetReplicationTasks(ctx context.Context, request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.GetReplicationTasks(request)
+	response, err := p.persistence.GetReplicationTasks(ctx, request)
 	return response, err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTransferTask(ctx context.Context, request *CompleteTransferTaskRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.CompleteTransferTask(request)
+	err := p.persistence.CompleteTransferTask(ctx, request)
 	return err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTransferTask(ctx context.Context, request *RangeCompleteTransferTaskRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.RangeCompleteTransferTask(request)
+	err := p.persistence.RangeCompleteTransferTask(ctx, request)
 	return err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) PutTransferDLQTask(ctx context.Context, request *PutTransferDLQTaskRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.PutTransferDLQTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferDLQTasks(ctx context.Context, request *GetTransferDLQTasksRequest) (*GetTransferDLQTasksResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetTransferDLQTasks(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) RangeDeleteTransferDLQTasks(ctx context.Context, request *RangeDeleteTransferDLQTasksRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RangeDeleteTransferDLQTasks(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) PutReplicationDLQTask(ctx context.Context, request *PutReplicationDLQTaskRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.PutReplicationDLQTask(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) GetReplicationDLQTasks(ctx context.Context, request *GetReplicationDLQTasksRequest) (*GetReplicationDLQTasksResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetReplicationDLQTasks(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) RangeDeleteReplicationDLQTasks(ctx context.Context, request *RangeDeleteReplicationDLQTasksRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RangeDeleteReplicationDLQTasks(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) PutWorkflowExecutionAnnotation(ctx context.Context, request *PutWorkflowExecutionAnnotationRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.PutWorkflowExecutionAnnotation(ctx, request)
+	return err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) GetWorkflowExecutionAnnotations(ctx context.Context, request *GetWorkflowExecutionAnnotationsRequest) (*GetWorkflowExecutionAnnotationsResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetWorkflowExecutionAnnotations(ctx, request)
+	return response, err
+}
+
+func (p *workflowExecutionRateLimitedPersistenceClient) CompleteReplicationTask(ctx context.Context, request *CompleteReplicationTaskRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.CompleteReplicationTask(request)
+	err := p.persistence.CompleteReplicationTask(ctx, request)
 	return err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) GetTimerIndexTasks(ctx context.Context, request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	resonse, err := p.persistence.GetTimerIndexTasks(request)
+	resonse, err := p.persistence.GetTimerIndexTasks(ctx, request)
 	return resonse, err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTimerTask(ctx context.Context, request *CompleteTimerTaskRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.CompleteTimerTask(request)
+	err := p.persistence.CompleteTimerTask(ctx, request)
 	return err
 }
 
-func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTimerTask(ctx context.Context, request *RangeCompleteTimerTaskRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.RangeCompleteTimerTask(request)
+	err := p.persistence.RangeCompleteTimerTask(ctx, request)
 	return err
 }
 
@@ -343,70 +448,70 @@ func (p *taskRateLimitedPersistenceClient) GetName() string {
 	return p.persistence.GetName()
 }
 
-func (p *taskRateLimitedPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *taskRateLimitedPersistenceClient) CreateTasks(ctx context.Context, request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.CreateTasks(request)
+	response, err := p.persistence.CreateTasks(ctx, request)
 	return response, err
 }
 
-func (p *taskRateLimitedPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *taskRateLimitedPersistenceClient) GetTasks(ctx context.Context, request *GetTasksRequest) (*GetTasksResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.GetTasks(request)
+	response, err := p.persistence.GetTasks(ctx, request)
 	return response, err
 }
 
-func (p *taskRateLimitedPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *taskRateLimitedPersistenceClient) CompleteTask(ctx context.Context, request *CompleteTaskRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
 
-	err := p.persistence.CompleteTask(request)
+	err := p.persistence.CompleteTask(ctx, request)
 	return err
 }
 
-func (p *taskRateLimitedPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *taskRateLimitedPersistenceClient) CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return 0, ErrPersistenceLimitExceeded
 	}
-	return p.persistence.CompleteTasksLessThan(request)
+	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
 
-func (p *taskRateLimitedPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *taskRateLimitedPersistenceClient) LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.LeaseTaskList(request)
+	response, err := p.persistence.LeaseTaskList(ctx, request)
 	return response, err
 }
 
-func (p *taskRateLimitedPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *taskRateLimitedPersistenceClient) UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
-	response, err := p.persistence.UpdateTaskList(request)
+	response, err := p.persistence.UpdateTaskList(ctx, request)
 	return response, err
 }
 
-func (p *taskRateLimitedPersistenceClient) ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *taskRateLimitedPersistenceClient) ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
-	return p.persistence.ListTaskList(request)
+	return p.persistence.ListTaskList(ctx, request)
 }
 
-func (p *taskRateLimitedPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *taskRateLimitedPersistenceClient) DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
-	return p.persistence.DeleteTaskList(request)
+	return p.persistence.DeleteTaskList(ctx, request)
 }
 
 func (p *taskRateLimitedPersistenceClient) Close() {
@@ -418,7 +523,7 @@ func (p *historyRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *historyRateLimitedPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -427,7 +532,7 @@ func (p *historyRateLimitedPersistenceClient) AppendHistoryEvents(request *Appen
 }
 
 func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -436,7 +541,7 @@ func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistory(reques
 }
 
 func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -445,7 +550,7 @@ func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistoryByBatch
 }
 
 func (p *historyRateLimitedPersistenceClient) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -462,7 +567,7 @@ func (p *metadataRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *metadataRateLimitedPersistenceClient) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -471,7 +576,7 @@ func (p *metadataRateLimitedPersistenceClient) CreateDomain(request *CreateDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -480,7 +585,7 @@ func (p *metadataRateLimitedPersistenceClient) GetDomain(request *GetDomainReque
 }
 
 func (p *metadataRateLimitedPersistenceClient) UpdateDomain(request *UpdateDomainRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -489,7 +594,7 @@ func (p *metadataRateLimitedPersistenceClient) UpdateDomain(request *UpdateDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) DeleteDomain(request *DeleteDomainRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -498,7 +603,7 @@ func (p *metadataRateLimitedPersistenceClient) DeleteDomain(request *DeleteDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -507,7 +612,7 @@ func (p *metadataRateLimitedPersistenceClient) DeleteDomainByName(request *Delet
 }
 
 func (p *metadataRateLimitedPersistenceClient) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -516,7 +621,7 @@ func (p *metadataRateLimitedPersistenceClient) ListDomains(request *ListDomainsR
 }
 
 func (p *metadataRateLimitedPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -533,7 +638,7 @@ func (p *visibilityRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -542,7 +647,7 @@ func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionStarted(
 }
 
 func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -551,7 +656,7 @@ func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionClosed(r
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -560,7 +665,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutions(requ
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -569,7 +674,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutions(re
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -578,7 +683,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByTyp
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -587,7 +692,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByT
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -596,7 +701,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByWor
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -605,7 +710,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByW
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -614,7 +719,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByS
 }
 
 func (p *visibilityRateLimitedPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -623,12 +728,30 @@ func (p *visibilityRateLimitedPersistenceClient) GetClosedWorkflowExecution(requ
 }
 
 func (p *visibilityRateLimitedPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+	if !p.rateLimiter.Allow(p.callerType) {
 		return ErrPersistenceLimitExceeded
 	}
 	return p.persistence.DeleteWorkflowExecution(request)
 }
 
+func (p *visibilityRateLimitedPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	if !p.rateLimiter.Allow(p.callerType) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.CountWorkflowExecutions(request)
+	return response, err
+}
+
+func (p *visibilityRateLimitedPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if !p.rateLimiter.Allow(p.callerType) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ScanWorkflowExecutions(request)
+	return response, err
+}
+
 func (p *visibilityRateLimitedPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -642,54 +765,111 @@ func (p *historyV2RateLimitedPersistenceClient) Close() {
 }
 
 // AppendHistoryNodes add(or override) a node to a history branch
-func (p *historyV2RateLimitedPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *historyV2RateLimitedPersistenceClient) AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
-	return p.persistence.AppendHistoryNodes(request)
+	return p.persistence.AppendHistoryNodes(ctx, request)
 }
 
 // ReadHistoryBranch returns history node data for a branch
-func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
-	response, err := p.persistence.ReadHistoryBranch(request)
+	response, err := p.persistence.ReadHistoryBranch(ctx, request)
 	return response, err
 }
 
 // ReadHistoryBranchByBatch returns history node data for a branch
-func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranchByBatch(ctx context.Context, request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
-	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
 	return response, err
 }
 
 // ForkHistoryBranch forks a new branch from a old branch
-func (p *historyV2RateLimitedPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *historyV2RateLimitedPersistenceClient) ForkHistoryBranch(ctx context.Context, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
-	response, err := p.persistence.ForkHistoryBranch(request)
+	response, err := p.persistence.ForkHistoryBranch(ctx, request)
 	return response, err
 }
 
 // DeleteHistoryBranch removes a branch
-func (p *historyV2RateLimitedPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *historyV2RateLimitedPersistenceClient) DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return ErrPersistenceLimitExceeded
 	}
-	err := p.persistence.DeleteHistoryBranch(request)
+	err := p.persistence.DeleteHistoryBranch(ctx, request)
 	return err
 }
 
 // GetHistoryTree returns all branch information of a tree
-func (p *historyV2RateLimitedPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
-	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+func (p *historyV2RateLimitedPersistenceClient) GetHistoryTree(ctx context.Context, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	if !p.rateLimiter.Allow(GetCallerType(ctx)) {
 		return nil, ErrPersistenceLimitExceeded
 	}
-	response, err := p.persistence.GetHistoryTree(request)
+	response, err := p.persistence.GetHistoryTree(ctx, request)
 	return response, err
 }
+
+func (p *clusterMetadataRateLimitedPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *clusterMetadataRateLimitedPersistenceClient) InitializeImmutableClusterMetadata(
+	request *InitializeImmutableClusterMetadataRequest,
+) (*InitializeImmutableClusterMetadataResponse, error) {
+	if !p.rateLimiter.Allow(p.callerType) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.InitializeImmutableClusterMetadata(request)
+	return response, err
+}
+
+func (p *clusterMetadataRateLimitedPersistenceClient) GetSearchAttributes() (*GetSearchAttributesResponse, error) {
+	// search attributes are refreshed periodically in the background
+	if !p.rateLimiter.Allow(p.callerType) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetSearchAttributes()
+	return response, err
+}
+
+func (p *clusterMetadataRateLimitedPersistenceClient) UpdateSearchAttributes(request *UpdateSearchAttributesRequest) error {
+	if !p.rateLimiter.Allow(p.callerType) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.UpdateSearchAttributes(request)
+	return err
+}
+
+func (p *clusterMetadataRateLimitedPersistenceClient) GetDynamicConfig() (*GetDynamicConfigResponse, error) {
+	// dynamic config is refreshed periodically in the background
+	if !p.rateLimiter.Allow(p.callerType) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetDynamicConfig()
+	return response, err
+}
+
+func (p *clusterMetadataRateLimitedPersistenceClient) UpdateDynamicConfig(request *UpdateDynamicConfigRequest) error {
+	if !p.rateLimiter.Allow(p.callerType) {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.UpdateDynamicConfig(request)
+	return err
+}
+
+func (p *clusterMetadataRateLimitedPersistenceClient) Close() {
+	p.persistence.Close()
+}
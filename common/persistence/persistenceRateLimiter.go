@@ -0,0 +1,114 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/tokenbucket"
+)
+
+type (
+	// CallerType identifies the kind of caller issuing a persistence request, requests from
+	// user facing API calls are given priority over requests from background processors
+	// when the QPS budget of a datastore is exhausted. Requests made with a context take the
+	// caller type the context is marked with, the others the one the rate limited client
+	// was created with
+	CallerType int
+
+	// RateLimiter decides whether a persistence request fits in the QPS budget
+	RateLimiter interface {
+		// Allow takes a token for a request of the given caller type, returns false
+		// if the request must be shed
+		Allow(callerType CallerType) bool
+	}
+
+	priorityRateLimiter struct {
+		tokenBucket tokenbucket.PriorityTokenBucket
+	}
+
+	multiStageRateLimiter struct {
+		rateLimiters []RateLimiter
+	}
+
+	callerTypeContextKey struct{}
+)
+
+const (
+	// CallerTypeAPI is the caller type of requests serving user facing API calls
+	CallerTypeAPI CallerType = iota
+	// CallerTypeBackground is the caller type of requests from background processors,
+	// such as the transfer and timer queue processors
+	CallerTypeBackground
+
+	numCallerTypes = iota
+)
+
+var _ RateLimiter = (*priorityRateLimiter)(nil)
+var _ RateLimiter = (*multiStageRateLimiter)(nil)
+
+// WithCallerType returns a copy of the context which marks the persistence requests made
+// with it as issued by the given caller type
+func WithCallerType(ctx context.Context, callerType CallerType) context.Context {
+	return context.WithValue(ctx, callerTypeContextKey{}, callerType)
+}
+
+// GetCallerType returns the caller type the context was marked with, requests made with
+// an unmarked context are treated as API calls
+func GetCallerType(ctx context.Context) CallerType {
+	if callerType, ok := ctx.Value(callerTypeContextKey{}).(CallerType); ok {
+		return callerType
+	}
+	return CallerTypeAPI
+}
+
+// NewPriorityRateLimiter creates a rate limiter allowing up to qps requests per second, tokens
+// left unused by API callers are handed down to background callers
+func NewPriorityRateLimiter(qps int) RateLimiter {
+	return &priorityRateLimiter{
+		tokenBucket: tokenbucket.NewPriorityTokenBucket(numCallerTypes, qps, clock.NewRealTimeSource()),
+	}
+}
+
+// NewMultiStageRateLimiter creates a rate limiter which allows a request only if every one of
+// the given rate limiters allows it, e.g. to enforce a per shard budget within a global one
+func NewMultiStageRateLimiter(rateLimiters ...RateLimiter) RateLimiter {
+	return &multiStageRateLimiter{
+		rateLimiters: rateLimiters,
+	}
+}
+
+func (r *priorityRateLimiter) Allow(callerType CallerType) bool {
+	ok, _ := r.tokenBucket.GetToken(int(callerType), 1)
+	return ok
+}
+
+func (r *multiStageRateLimiter) Allow(callerType CallerType) bool {
+	// stages are checked in order, a token taken by an earlier stage is not given back
+	// if a later one sheds the request, so the most restrictive stage should go first
+	for _, rateLimiter := range r.rateLimiters {
+		if !rateLimiter.Allow(callerType) {
+			return false
+		}
+	}
+	return true
+}
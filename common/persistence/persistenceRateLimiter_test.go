@@ -0,0 +1,104 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	rateLimiterSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+
+	// callerTypeRateLimiter allows only the requests of the given caller types
+	callerTypeRateLimiter struct {
+		allowed map[CallerType]bool
+		calls   int
+	}
+)
+
+func TestRateLimiterSuite(t *testing.T) {
+	s := new(rateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *rateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (r *callerTypeRateLimiter) Allow(callerType CallerType) bool {
+	r.calls++
+	return r.allowed[callerType]
+}
+
+func (s *rateLimiterSuite) TestPriorityRateLimiter_APIFirst() {
+	// 10 QPS refills a single token every 100ms, which goes to API callers first
+	rateLimiter := NewPriorityRateLimiter(10)
+	s.False(rateLimiter.Allow(CallerTypeBackground))
+	s.True(rateLimiter.Allow(CallerTypeAPI))
+	s.False(rateLimiter.Allow(CallerTypeAPI))
+}
+
+func (s *rateLimiterSuite) TestMultiStageRateLimiter() {
+	shardRateLimiter := &callerTypeRateLimiter{allowed: map[CallerType]bool{CallerTypeAPI: true}}
+	globalRateLimiter := &callerTypeRateLimiter{allowed: map[CallerType]bool{CallerTypeAPI: true, CallerTypeBackground: true}}
+	rateLimiter := NewMultiStageRateLimiter(shardRateLimiter, globalRateLimiter)
+
+	s.True(rateLimiter.Allow(CallerTypeAPI))
+	s.Equal(1, globalRateLimiter.calls)
+	// shed by the shard stage, the global stage is not consulted
+	s.False(rateLimiter.Allow(CallerTypeBackground))
+	s.Equal(1, globalRateLimiter.calls)
+}
+
+func (s *rateLimiterSuite) TestCallerTypeFromContext() {
+	s.Equal(CallerTypeAPI, GetCallerType(context.Background()))
+	s.Equal(CallerTypeBackground, GetCallerType(WithCallerType(context.Background(), CallerTypeBackground)))
+}
+
+func (s *rateLimiterSuite) TestRateLimitedClient_ShedsByContextCallerType() {
+	rateLimiter := &callerTypeRateLimiter{allowed: map[CallerType]bool{CallerTypeAPI: true}}
+	client := NewWorkflowExecutionPersistenceRateLimitedClient(nil, rateLimiter, CallerTypeAPI, loggerimpl.NewNopLogger())
+
+	ctx := WithCallerType(context.Background(), CallerTypeBackground)
+	_, err := client.GetTransferTasks(ctx, &GetTransferTasksRequest{})
+	s.Equal(ErrPersistenceLimitExceeded, err)
+	s.IsType(&workflow.ServiceBusyError{}, err)
+}
+
+func (s *rateLimiterSuite) TestRateLimitedClient_ShedsByClientCallerType() {
+	rateLimiter := &callerTypeRateLimiter{allowed: map[CallerType]bool{CallerTypeAPI: true}}
+	client := NewShardPersistenceRateLimitedClient(nil, rateLimiter, CallerTypeBackground, loggerimpl.NewNopLogger())
+
+	// the request carries no context, the caller type of the client is used
+	_, err := client.GetShard(&GetShardRequest{})
+	s.Equal(ErrPersistenceLimitExceeded, err)
+}
@@ -0,0 +1,869 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// ShadowOptions configures how requests served by a primary store are mirrored to a shadow store
+	ShadowOptions struct {
+		// ReadSampleRate is the ratio of reads which are replayed against the shadow store
+		// and compared with the result of the primary store
+		ReadSampleRate float64
+		// QueueSize is the number of mirrored requests buffered for a store, requests which
+		// do not fit in the buffer are dropped
+		QueueSize int
+	}
+
+	// shadowMirror replays requests against the shadow store in the order they were
+	// served by the primary store, so that the shadow store goes through the same states
+	shadowMirror struct {
+		options      ShadowOptions
+		metricClient metrics.Client
+		logger       log.Logger
+		requestCh    chan func()
+		shutdownCh   chan struct{}
+		shutdownOnce sync.Once
+	}
+
+	shadowShardStore struct {
+		primary ShardStore
+		shadow  ShardStore
+		mirror  *shadowMirror
+	}
+
+	shadowTaskStore struct {
+		primary TaskStore
+		shadow  TaskStore
+		mirror  *shadowMirror
+	}
+
+	shadowMetadataStore struct {
+		primary MetadataStore
+		shadow  MetadataStore
+		mirror  *shadowMirror
+	}
+
+	shadowExecutionStore struct {
+		primary ExecutionStore
+		shadow  ExecutionStore
+		mirror  *shadowMirror
+	}
+
+	shadowHistoryV2Store struct {
+		primary HistoryV2Store
+		shadow  HistoryV2Store
+		mirror  *shadowMirror
+	}
+)
+
+const defaultShadowQueueSize = 1000
+
+var timeType = reflect.TypeOf(time.Time{})
+
+var _ ShardStore = (*shadowShardStore)(nil)
+var _ TaskStore = (*shadowTaskStore)(nil)
+var _ MetadataStore = (*shadowMetadataStore)(nil)
+var _ ExecutionStore = (*shadowExecutionStore)(nil)
+var _ HistoryV2Store = (*shadowHistoryV2Store)(nil)
+
+// NewShadowShardStore creates a shard store which serves requests from the primary store
+// and mirrors them to the shadow store
+func NewShadowShardStore(primary, shadow ShardStore, options ShadowOptions, metricClient metrics.Client, logger log.Logger) ShardStore {
+	return &shadowShardStore{
+		primary: primary,
+		shadow:  shadow,
+		mirror:  newShadowMirror(options, metricClient, logger),
+	}
+}
+
+// NewShadowTaskStore creates a task store which serves requests from the primary store
+// and mirrors them to the shadow store
+func NewShadowTaskStore(primary, shadow TaskStore, options ShadowOptions, metricClient metrics.Client, logger log.Logger) TaskStore {
+	return &shadowTaskStore{
+		primary: primary,
+		shadow:  shadow,
+		mirror:  newShadowMirror(options, metricClient, logger),
+	}
+}
+
+// NewShadowMetadataStore creates a metadata store which serves requests from the primary store
+// and mirrors them to the shadow store
+func NewShadowMetadataStore(primary, shadow MetadataStore, options ShadowOptions, metricClient metrics.Client, logger log.Logger) MetadataStore {
+	return &shadowMetadataStore{
+		primary: primary,
+		shadow:  shadow,
+		mirror:  newShadowMirror(options, metricClient, logger),
+	}
+}
+
+// NewShadowExecutionStore creates an execution store which serves requests from the primary store
+// and mirrors them to the shadow store
+func NewShadowExecutionStore(primary, shadow ExecutionStore, options ShadowOptions, metricClient metrics.Client, logger log.Logger) ExecutionStore {
+	return &shadowExecutionStore{
+		primary: primary,
+		shadow:  shadow,
+		mirror:  newShadowMirror(options, metricClient, logger.WithTags(tag.ShardID(primary.GetShardID()))),
+	}
+}
+
+// NewShadowHistoryV2Store creates a history store which serves requests from the primary store
+// and mirrors them to the shadow store
+func NewShadowHistoryV2Store(primary, shadow HistoryV2Store, options ShadowOptions, metricClient metrics.Client, logger log.Logger) HistoryV2Store {
+	return &shadowHistoryV2Store{
+		primary: primary,
+		shadow:  shadow,
+		mirror:  newShadowMirror(options, metricClient, logger),
+	}
+}
+
+func newShadowMirror(options ShadowOptions, metricClient metrics.Client, logger log.Logger) *shadowMirror {
+	if options.QueueSize <= 0 {
+		options.QueueSize = defaultShadowQueueSize
+	}
+	m := &shadowMirror{
+		options:      options,
+		metricClient: metricClient,
+		logger:       logger,
+		requestCh:    make(chan func(), options.QueueSize),
+		shutdownCh:   make(chan struct{}),
+	}
+	go m.processLoop()
+	return m
+}
+
+func (m *shadowMirror) processLoop() {
+	for {
+		select {
+		case request := <-m.requestCh:
+			request()
+		case <-m.shutdownCh:
+			return
+		}
+	}
+}
+
+func (m *shadowMirror) enqueue(scope int, request func()) bool {
+	select {
+	case m.requestCh <- request:
+		return true
+	default:
+		// the shadow store cannot keep up, it is now missing a write and will diverge
+		m.metricClient.IncCounter(scope, metrics.PersistenceShadowWritesDropped)
+		return false
+	}
+}
+
+// write mirrors a write which succeeded on the primary store
+func (m *shadowMirror) write(scope int, op func() error) {
+	m.enqueue(scope, func() {
+		m.handleWriteError(scope, op())
+	})
+}
+
+func (m *shadowMirror) handleWriteError(scope int, err error) {
+	if err != nil {
+		m.metricClient.IncCounter(scope, metrics.PersistenceShadowWriteFailures)
+		m.logger.Warn("Shadow persistence write failed.", tag.MetricScope(scope), tag.Error(err))
+	}
+}
+
+// compare replays a sampled read against the shadow store once the writes mirrored before
+// it are applied, and reports whether the shadow store returned the same result
+func (m *shadowMirror) compare(scope int, primaryResult interface{}, op func() (interface{}, error)) {
+	if rand.Float64() >= m.options.ReadSampleRate {
+		return
+	}
+	m.enqueue(scope, func() {
+		shadowResult, err := op()
+		if err != nil {
+			m.metricClient.IncCounter(scope, metrics.PersistenceShadowReadFailures)
+			m.logger.Warn("Shadow persistence read failed.", tag.MetricScope(scope), tag.Error(err))
+			return
+		}
+		if !shadowResultsEqual(primaryResult, shadowResult) {
+			m.metricClient.IncCounter(scope, metrics.PersistenceShadowReadMismatches)
+			m.logger.Warn("Shadow persistence read does not match primary.", tag.MetricScope(scope))
+		}
+	})
+}
+
+func (m *shadowMirror) close() {
+	m.shutdownOnce.Do(func() {
+		close(m.shutdownCh)
+	})
+}
+
+func (s *shadowShardStore) GetName() string {
+	return s.primary.GetName()
+}
+
+func (s *shadowShardStore) CreateShard(request *CreateShardRequest) error {
+	err := s.primary.CreateShard(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCreateShardScope, func() error {
+			return s.shadow.CreateShard(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowShardStore) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	response, err := s.primary.GetShard(request)
+	if err == nil {
+		s.mirror.compare(metrics.PersistenceGetShardScope, response, func() (interface{}, error) {
+			return s.shadow.GetShard(request)
+		})
+	}
+	return response, err
+}
+
+func (s *shadowShardStore) UpdateShard(request *UpdateShardRequest) error {
+	err := s.primary.UpdateShard(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceUpdateShardScope, func() error {
+			return s.shadow.UpdateShard(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowShardStore) Close() {
+	s.mirror.close()
+	s.primary.Close()
+	s.shadow.Close()
+}
+
+func (s *shadowTaskStore) GetName() string {
+	return s.primary.GetName()
+}
+
+func (s *shadowTaskStore) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	response, err := s.primary.LeaseTaskList(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceLeaseTaskListScope, func() error {
+			_, err := s.shadow.LeaseTaskList(request)
+			return err
+		})
+	}
+	return response, err
+}
+
+func (s *shadowTaskStore) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	response, err := s.primary.UpdateTaskList(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceUpdateTaskListScope, func() error {
+			_, err := s.shadow.UpdateTaskList(request)
+			return err
+		})
+	}
+	return response, err
+}
+
+func (s *shadowTaskStore) ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error) {
+	return s.primary.ListTaskList(request)
+}
+
+func (s *shadowTaskStore) DeleteTaskList(request *DeleteTaskListRequest) error {
+	err := s.primary.DeleteTaskList(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceDeleteTaskListScope, func() error {
+			return s.shadow.DeleteTaskList(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowTaskStore) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	response, err := s.primary.CreateTasks(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCreateTaskScope, func() error {
+			_, err := s.shadow.CreateTasks(request)
+			return err
+		})
+	}
+	return response, err
+}
+
+func (s *shadowTaskStore) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	response, err := s.primary.GetTasks(request)
+	if err == nil {
+		s.mirror.compare(metrics.PersistenceGetTasksScope, response, func() (interface{}, error) {
+			return s.shadow.GetTasks(request)
+		})
+	}
+	return response, err
+}
+
+func (s *shadowTaskStore) CompleteTask(request *CompleteTaskRequest) error {
+	err := s.primary.CompleteTask(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCompleteTaskScope, func() error {
+			return s.shadow.CompleteTask(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowTaskStore) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	count, err := s.primary.CompleteTasksLessThan(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCompleteTasksLessThanScope, func() error {
+			_, err := s.shadow.CompleteTasksLessThan(request)
+			return err
+		})
+	}
+	return count, err
+}
+
+func (s *shadowTaskStore) Close() {
+	s.mirror.close()
+	s.primary.Close()
+	s.shadow.Close()
+}
+
+func (s *shadowMetadataStore) GetName() string {
+	return s.primary.GetName()
+}
+
+func (s *shadowMetadataStore) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	response, err := s.primary.CreateDomain(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCreateDomainScope, func() error {
+			_, err := s.shadow.CreateDomain(request)
+			return err
+		})
+	}
+	return response, err
+}
+
+func (s *shadowMetadataStore) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	response, err := s.primary.GetDomain(request)
+	if err == nil {
+		s.mirror.compare(metrics.PersistenceGetDomainScope, response, func() (interface{}, error) {
+			return s.shadow.GetDomain(request)
+		})
+	}
+	return response, err
+}
+
+func (s *shadowMetadataStore) UpdateDomain(request *UpdateDomainRequest) error {
+	err := s.primary.UpdateDomain(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceUpdateDomainScope, func() error {
+			return s.shadow.UpdateDomain(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowMetadataStore) DeleteDomain(request *DeleteDomainRequest) error {
+	err := s.primary.DeleteDomain(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceDeleteDomainScope, func() error {
+			return s.shadow.DeleteDomain(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowMetadataStore) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	err := s.primary.DeleteDomainByName(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceDeleteDomainByNameScope, func() error {
+			return s.shadow.DeleteDomainByName(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowMetadataStore) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	return s.primary.ListDomains(request)
+}
+
+func (s *shadowMetadataStore) GetMetadata() (*GetMetadataResponse, error) {
+	return s.primary.GetMetadata()
+}
+
+func (s *shadowMetadataStore) Close() {
+	s.mirror.close()
+	s.primary.Close()
+	s.shadow.Close()
+}
+
+func (s *shadowExecutionStore) GetName() string {
+	return s.primary.GetName()
+}
+
+func (s *shadowExecutionStore) GetShardID() int {
+	return s.primary.GetShardID()
+}
+
+func (s *shadowExecutionStore) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	response, err := s.primary.CreateWorkflowExecution(request)
+	if err == nil {
+		shadowRequest := copyCreateWorkflowExecutionRequest(request)
+		s.mirror.write(metrics.PersistenceCreateWorkflowExecutionScope, func() error {
+			_, err := s.shadow.CreateWorkflowExecution(shadowRequest)
+			return err
+		})
+	}
+	return response, err
+}
+
+func (s *shadowExecutionStore) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*InternalGetWorkflowExecutionResponse, error) {
+	response, err := s.primary.GetWorkflowExecution(request)
+	if err == nil {
+		s.mirror.compare(metrics.PersistenceGetWorkflowExecutionScope, response, func() (interface{}, error) {
+			return s.shadow.GetWorkflowExecution(request)
+		})
+	}
+	return response, err
+}
+
+func (s *shadowExecutionStore) UpdateWorkflowExecution(request *InternalUpdateWorkflowExecutionRequest) error {
+	err := s.primary.UpdateWorkflowExecution(request)
+	if err == nil {
+		shadowRequest := copyInternalUpdateWorkflowExecutionRequest(request)
+		s.mirror.write(metrics.PersistenceUpdateWorkflowExecutionScope, func() error {
+			return s.shadow.UpdateWorkflowExecution(shadowRequest)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) ResetMutableState(request *InternalResetMutableStateRequest) error {
+	err := s.primary.ResetMutableState(request)
+	if err == nil {
+		shadowRequest := copyInternalResetMutableStateRequest(request)
+		s.mirror.write(metrics.PersistenceResetMutableStateScope, func() error {
+			return s.shadow.ResetMutableState(shadowRequest)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) ResetWorkflowExecution(request *InternalResetWorkflowExecutionRequest) error {
+	err := s.primary.ResetWorkflowExecution(request)
+	if err == nil {
+		shadowRequest := copyInternalResetWorkflowExecutionRequest(request)
+		s.mirror.write(metrics.PersistenceResetWorkflowExecutionScope, func() error {
+			return s.shadow.ResetWorkflowExecution(shadowRequest)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	err := s.primary.DeleteWorkflowExecution(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceDeleteWorkflowExecutionScope, func() error {
+			return s.shadow.DeleteWorkflowExecution(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	response, err := s.primary.GetCurrentExecution(request)
+	if err == nil {
+		s.mirror.compare(metrics.PersistenceGetCurrentExecutionScope, response, func() (interface{}, error) {
+			return s.shadow.GetCurrentExecution(request)
+		})
+	}
+	return response, err
+}
+
+func (s *shadowExecutionStore) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	return s.primary.GetTransferTasks(request)
+}
+
+func (s *shadowExecutionStore) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	err := s.primary.CompleteTransferTask(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCompleteTransferTaskScope, func() error {
+			return s.shadow.CompleteTransferTask(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	err := s.primary.RangeCompleteTransferTask(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceRangeCompleteTransferTaskScope, func() error {
+			return s.shadow.RangeCompleteTransferTask(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) PutTransferDLQTask(request *PutTransferDLQTaskRequest) error {
+	err := s.primary.PutTransferDLQTask(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistencePutTransferDLQTaskScope, func() error {
+			return s.shadow.PutTransferDLQTask(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) GetTransferDLQTasks(request *GetTransferDLQTasksRequest) (*GetTransferDLQTasksResponse, error) {
+	return s.primary.GetTransferDLQTasks(request)
+}
+
+func (s *shadowExecutionStore) RangeDeleteTransferDLQTasks(request *RangeDeleteTransferDLQTasksRequest) error {
+	err := s.primary.RangeDeleteTransferDLQTasks(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceRangeDeleteTransferDLQTasksScope, func() error {
+			return s.shadow.RangeDeleteTransferDLQTasks(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) PutReplicationDLQTask(request *PutReplicationDLQTaskRequest) error {
+	err := s.primary.PutReplicationDLQTask(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistencePutReplicationDLQTaskScope, func() error {
+			return s.shadow.PutReplicationDLQTask(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) GetReplicationDLQTasks(request *GetReplicationDLQTasksRequest) (*GetReplicationDLQTasksResponse, error) {
+	return s.primary.GetReplicationDLQTasks(request)
+}
+
+func (s *shadowExecutionStore) RangeDeleteReplicationDLQTasks(request *RangeDeleteReplicationDLQTasksRequest) error {
+	err := s.primary.RangeDeleteReplicationDLQTasks(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceRangeDeleteReplicationDLQTasksScope, func() error {
+			return s.shadow.RangeDeleteReplicationDLQTasks(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) PutWorkflowExecutionAnnotation(request *PutWorkflowExecutionAnnotationRequest) error {
+	err := s.primary.PutWorkflowExecutionAnnotation(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistencePutWorkflowExecutionAnnotationScope, func() error {
+			return s.shadow.PutWorkflowExecutionAnnotation(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) GetWorkflowExecutionAnnotations(request *GetWorkflowExecutionAnnotationsRequest) (*GetWorkflowExecutionAnnotationsResponse, error) {
+	return s.primary.GetWorkflowExecutionAnnotations(request)
+}
+
+func (s *shadowExecutionStore) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	return s.primary.GetReplicationTasks(request)
+}
+
+func (s *shadowExecutionStore) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	err := s.primary.CompleteReplicationTask(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCompleteReplicationTaskScope, func() error {
+			return s.shadow.CompleteReplicationTask(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	return s.primary.GetTimerIndexTasks(request)
+}
+
+func (s *shadowExecutionStore) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	err := s.primary.CompleteTimerTask(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCompleteTimerTaskScope, func() error {
+			return s.shadow.CompleteTimerTask(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	err := s.primary.RangeCompleteTimerTask(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceRangeCompleteTimerTaskScope, func() error {
+			return s.shadow.RangeCompleteTimerTask(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowExecutionStore) Close() {
+	s.mirror.close()
+	s.primary.Close()
+	s.shadow.Close()
+}
+
+func (s *shadowHistoryV2Store) GetName() string {
+	return s.primary.GetName()
+}
+
+func (s *shadowHistoryV2Store) AppendHistoryNodes(request *InternalAppendHistoryNodesRequest) error {
+	err := s.primary.AppendHistoryNodes(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceAppendHistoryNodesScope, func() error {
+			return s.shadow.AppendHistoryNodes(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowHistoryV2Store) ReadHistoryBranch(request *InternalReadHistoryBranchRequest) (*InternalReadHistoryBranchResponse, error) {
+	response, err := s.primary.ReadHistoryBranch(request)
+	// page tokens are specific to each store, so only first pages can be compared
+	if err == nil && len(request.NextPageToken) == 0 {
+		s.mirror.compare(metrics.PersistenceReadHistoryBranchScope, response.History, func() (interface{}, error) {
+			shadowResponse, err := s.shadow.ReadHistoryBranch(request)
+			if err != nil {
+				return nil, err
+			}
+			return shadowResponse.History, nil
+		})
+	}
+	return response, err
+}
+
+func (s *shadowHistoryV2Store) ForkHistoryBranch(request *InternalForkHistoryBranchRequest) (*InternalForkHistoryBranchResponse, error) {
+	response, err := s.primary.ForkHistoryBranch(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceForkHistoryBranchScope, func() error {
+			_, err := s.shadow.ForkHistoryBranch(request)
+			return err
+		})
+	}
+	return response, err
+}
+
+func (s *shadowHistoryV2Store) DeleteHistoryBranch(request *InternalDeleteHistoryBranchRequest) error {
+	err := s.primary.DeleteHistoryBranch(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceDeleteHistoryBranchScope, func() error {
+			return s.shadow.DeleteHistoryBranch(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowHistoryV2Store) CompleteForkBranch(request *InternalCompleteForkBranchRequest) error {
+	err := s.primary.CompleteForkBranch(request)
+	if err == nil {
+		s.mirror.write(metrics.PersistenceCompleteForkBranchScope, func() error {
+			return s.shadow.CompleteForkBranch(request)
+		})
+	}
+	return err
+}
+
+func (s *shadowHistoryV2Store) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	response, err := s.primary.GetHistoryTree(request)
+	if err == nil {
+		s.mirror.compare(metrics.PersistenceGetHistoryTreeScope, response, func() (interface{}, error) {
+			return s.shadow.GetHistoryTree(request)
+		})
+	}
+	return response, err
+}
+
+func (s *shadowHistoryV2Store) Close() {
+	s.mirror.close()
+	s.primary.Close()
+	s.shadow.Close()
+}
+
+// copyCreateWorkflowExecutionRequest copies the parts of the request which still belong to the
+// mutable state of the caller, so that it can be mirrored after the call returns
+func copyCreateWorkflowExecutionRequest(request *CreateWorkflowExecutionRequest) *CreateWorkflowExecutionRequest {
+	if request == nil {
+		return nil
+	}
+	copied := *request
+	copied.ReplicationState = copyReplicationState(request.ReplicationState)
+	return &copied
+}
+
+// copyInternalUpdateWorkflowExecutionRequest copies the parts of the request which still belong to
+// the mutable state of the caller, so that it can be mirrored after the call returns
+func copyInternalUpdateWorkflowExecutionRequest(request *InternalUpdateWorkflowExecutionRequest) *InternalUpdateWorkflowExecutionRequest {
+	copied := *request
+	copied.ReplicationState = copyReplicationState(request.ReplicationState)
+	copied.ContinueAsNew = copyCreateWorkflowExecutionRequest(request.ContinueAsNew)
+	copied.UpserTimerInfos = copyTimerInfos(request.UpserTimerInfos)
+	copied.UpsertRequestCancelInfos = copyRequestCancelInfos(request.UpsertRequestCancelInfos)
+	copied.UpsertSignalInfos = copySignalInfos(request.UpsertSignalInfos)
+	return &copied
+}
+
+// copyInternalResetMutableStateRequest copies the parts of the request which still belong to
+// the mutable state of the caller, so that it can be mirrored after the call returns
+func copyInternalResetMutableStateRequest(request *InternalResetMutableStateRequest) *InternalResetMutableStateRequest {
+	copied := *request
+	copied.ReplicationState = copyReplicationState(request.ReplicationState)
+	copied.InsertTimerInfos = copyTimerInfos(request.InsertTimerInfos)
+	copied.InsertRequestCancelInfos = copyRequestCancelInfos(request.InsertRequestCancelInfos)
+	copied.InsertSignalInfos = copySignalInfos(request.InsertSignalInfos)
+	return &copied
+}
+
+// copyInternalResetWorkflowExecutionRequest copies the parts of the request which still belong to
+// the mutable states of the caller, so that it can be mirrored after the call returns
+func copyInternalResetWorkflowExecutionRequest(request *InternalResetWorkflowExecutionRequest) *InternalResetWorkflowExecutionRequest {
+	copied := *request
+	copied.CurrReplicationState = copyReplicationState(request.CurrReplicationState)
+	copied.InsertReplicationState = copyReplicationState(request.InsertReplicationState)
+	copied.InsertTimerInfos = copyTimerInfos(request.InsertTimerInfos)
+	copied.InsertRequestCancelInfos = copyRequestCancelInfos(request.InsertRequestCancelInfos)
+	copied.InsertSignalInfos = copySignalInfos(request.InsertSignalInfos)
+	return &copied
+}
+
+func copyTimerInfos(infos []*TimerInfo) []*TimerInfo {
+	copied := make([]*TimerInfo, 0, len(infos))
+	for _, info := range infos {
+		infoCopy := *info
+		copied = append(copied, &infoCopy)
+	}
+	return copied
+}
+
+func copyRequestCancelInfos(infos []*RequestCancelInfo) []*RequestCancelInfo {
+	copied := make([]*RequestCancelInfo, 0, len(infos))
+	for _, info := range infos {
+		infoCopy := *info
+		copied = append(copied, &infoCopy)
+	}
+	return copied
+}
+
+func copySignalInfos(infos []*SignalInfo) []*SignalInfo {
+	copied := make([]*SignalInfo, 0, len(infos))
+	for _, info := range infos {
+		infoCopy := *info
+		copied = append(copied, &infoCopy)
+	}
+	return copied
+}
+
+func copyReplicationState(state *ReplicationState) *ReplicationState {
+	if state == nil {
+		return nil
+	}
+	copied := *state
+	if state.LastReplicationInfo != nil {
+		copied.LastReplicationInfo = make(map[string]*ReplicationInfo, len(state.LastReplicationInfo))
+		for cluster, info := range state.LastReplicationInfo {
+			infoCopy := *info
+			copied.LastReplicationInfo[cluster] = &infoCopy
+		}
+	}
+	return &copied
+}
+
+// shadowResultsEqual compares the results of the primary and the shadow store field by field.
+// Stores keep timestamps at different precisions and in different locations, SQL returns local
+// times with nanoseconds while Cassandra returns UTC times with milliseconds, so timestamps are
+// compared at millisecond precision regardless of their location. Nil and empty slices and maps
+// are equal, as stores do not agree on those either.
+func shadowResultsEqual(primary, shadow interface{}) bool {
+	return shadowValuesEqual(reflect.ValueOf(primary), reflect.ValueOf(shadow))
+}
+
+func shadowValuesEqual(primary, shadow reflect.Value) bool {
+	if !primary.IsValid() || !shadow.IsValid() {
+		return primary.IsValid() == shadow.IsValid()
+	}
+	if primary.Type() != shadow.Type() {
+		return false
+	}
+	if primary.Type() == timeType && primary.CanInterface() && shadow.CanInterface() {
+		primaryTime := primary.Interface().(time.Time).Truncate(time.Millisecond)
+		shadowTime := shadow.Interface().(time.Time).Truncate(time.Millisecond)
+		return primaryTime.Equal(shadowTime)
+	}
+
+	switch primary.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if primary.IsNil() || shadow.IsNil() {
+			return primary.IsNil() == shadow.IsNil()
+		}
+		return shadowValuesEqual(primary.Elem(), shadow.Elem())
+	case reflect.Struct:
+		for i := 0; i < primary.NumField(); i++ {
+			if !shadowValuesEqual(primary.Field(i), shadow.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice, reflect.Array:
+		if primary.Len() != shadow.Len() {
+			return false
+		}
+		for i := 0; i < primary.Len(); i++ {
+			if !shadowValuesEqual(primary.Index(i), shadow.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if primary.Len() != shadow.Len() {
+			return false
+		}
+		for _, key := range primary.MapKeys() {
+			if !shadowValuesEqual(primary.MapIndex(key), shadow.MapIndex(key)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return primary.Bool() == shadow.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return primary.Int() == shadow.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return primary.Uint() == shadow.Uint()
+	case reflect.Float32, reflect.Float64:
+		return primary.Float() == shadow.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return primary.Complex() == shadow.Complex()
+	case reflect.String:
+		return primary.String() == shadow.String()
+	default:
+		// functions, channels and unsafe pointers are only equal to themselves
+		return primary.Pointer() == shadow.Pointer()
+	}
+}
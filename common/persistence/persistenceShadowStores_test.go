@@ -0,0 +1,203 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	shadowStoresSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		scope   tally.TestScope
+		primary *testShardStore
+		shadow  *testShardStore
+		store   ShardStore
+	}
+
+	// testShardStore keeps the shards in memory
+	testShardStore struct {
+		sync.Mutex
+		shards    map[int]ShardInfo
+		readCount int
+	}
+)
+
+func TestShadowStoresSuite(t *testing.T) {
+	s := new(shadowStoresSuite)
+	suite.Run(t, s)
+}
+
+func (s *shadowStoresSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.scope = tally.NewTestScope("test", nil)
+	s.primary = &testShardStore{shards: make(map[int]ShardInfo)}
+	s.shadow = &testShardStore{shards: make(map[int]ShardInfo)}
+	options := ShadowOptions{ReadSampleRate: 1}
+	s.store = NewShadowShardStore(s.primary, s.shadow, options, metrics.NewClient(s.scope, metrics.Common), loggerimpl.NewNopLogger())
+}
+
+func (s *shadowStoresSuite) TearDownTest() {
+	s.store.Close()
+}
+
+func (s *shadowStoresSuite) TestWritesMirrored() {
+	s.NoError(s.store.CreateShard(&CreateShardRequest{ShardInfo: &ShardInfo{ShardID: 1, RangeID: 1}}))
+	s.NoError(s.store.UpdateShard(&UpdateShardRequest{ShardInfo: &ShardInfo{ShardID: 1, RangeID: 2}, PreviousRangeID: 1}))
+
+	s.waitFor(func() bool {
+		shard, ok := s.shadow.get(1)
+		return ok && shard.RangeID == 2
+	})
+	s.Equal(0, s.counter("persistence_shadow_write_errors"))
+}
+
+func (s *shadowStoresSuite) TestWriteFailedOnPrimaryNotMirrored() {
+	s.primary.shards[1] = ShardInfo{ShardID: 1, RangeID: 5}
+	s.Error(s.store.UpdateShard(&UpdateShardRequest{ShardInfo: &ShardInfo{ShardID: 1, RangeID: 6}, PreviousRangeID: 1}))
+	s.NoError(s.store.CreateShard(&CreateShardRequest{ShardInfo: &ShardInfo{ShardID: 2}}))
+
+	s.waitFor(func() bool {
+		_, ok := s.shadow.get(2)
+		return ok
+	})
+	_, ok := s.shadow.get(1)
+	s.False(ok)
+}
+
+func (s *shadowStoresSuite) TestReadMismatch() {
+	s.NoError(s.store.CreateShard(&CreateShardRequest{ShardInfo: &ShardInfo{ShardID: 1, RangeID: 1}}))
+	_, err := s.store.GetShard(&GetShardRequest{ShardID: 1})
+	s.NoError(err)
+	s.waitFor(func() bool {
+		return s.counter("persistence_shadow_read_mismatches") == 0 && s.shadow.reads() == 1
+	})
+
+	// diverge the shadow store behind the back of the mirror
+	s.shadow.put(ShardInfo{ShardID: 1, RangeID: 7})
+	_, err = s.store.GetShard(&GetShardRequest{ShardID: 1})
+	s.NoError(err)
+	s.waitFor(func() bool {
+		return s.counter("persistence_shadow_read_mismatches") == 1
+	})
+}
+
+func (s *shadowStoresSuite) TestReadTimestampsComparedAtMillisecondPrecision() {
+	ackLevel := time.Date(2019, 6, 1, 12, 30, 15, 123456789, time.Local)
+	s.NoError(s.store.CreateShard(&CreateShardRequest{ShardInfo: &ShardInfo{ShardID: 1, TimerAckLevel: ackLevel}}))
+	s.waitFor(func() bool {
+		_, ok := s.shadow.get(1)
+		return ok
+	})
+
+	// store the timestamp the way Cassandra returns it
+	s.shadow.put(ShardInfo{ShardID: 1, TimerAckLevel: ackLevel.UTC().Truncate(time.Millisecond)})
+	_, err := s.store.GetShard(&GetShardRequest{ShardID: 1})
+	s.NoError(err)
+	// requests are mirrored in order, once the next write is applied the read has been compared
+	s.NoError(s.store.CreateShard(&CreateShardRequest{ShardInfo: &ShardInfo{ShardID: 2}}))
+	s.waitFor(func() bool {
+		_, ok := s.shadow.get(2)
+		return ok
+	})
+	s.Equal(1, s.shadow.reads())
+	s.Equal(0, s.counter("persistence_shadow_read_mismatches"))
+}
+
+// waitFor waits for the mirror to process the requests it was given
+func (s *shadowStoresSuite) waitFor(condition func() bool) {
+	deadline := time.Now().Add(time.Second)
+	for !condition() {
+		s.True(time.Now().Before(deadline), "timed out waiting for the shadow store")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (s *shadowStoresSuite) counter(name string) int {
+	total := 0
+	for _, counter := range s.scope.Snapshot().Counters() {
+		if counter.Name() == "test."+name {
+			total += int(counter.Value())
+		}
+	}
+	return total
+}
+
+func (t *testShardStore) GetName() string {
+	return "test"
+}
+
+func (t *testShardStore) CreateShard(request *CreateShardRequest) error {
+	t.put(*request.ShardInfo)
+	return nil
+}
+
+func (t *testShardStore) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	shard, ok := t.get(request.ShardID)
+	if !ok {
+		return nil, &ShardOwnershipLostError{ShardID: request.ShardID}
+	}
+	t.Lock()
+	defer t.Unlock()
+	t.readCount++
+	return &GetShardResponse{ShardInfo: &shard}, nil
+}
+
+func (t *testShardStore) UpdateShard(request *UpdateShardRequest) error {
+	shard, ok := t.get(request.ShardInfo.ShardID)
+	if !ok || shard.RangeID != request.PreviousRangeID {
+		return &ShardOwnershipLostError{ShardID: request.ShardInfo.ShardID}
+	}
+	t.put(*request.ShardInfo)
+	return nil
+}
+
+func (t *testShardStore) Close() {}
+
+func (t *testShardStore) get(shardID int) (ShardInfo, bool) {
+	t.Lock()
+	defer t.Unlock()
+	shard, ok := t.shards[shardID]
+	return shard, ok
+}
+
+func (t *testShardStore) put(shard ShardInfo) {
+	t.Lock()
+	defer t.Unlock()
+	t.shards[shard.ShardID] = shard
+}
+
+func (t *testShardStore) reads() int {
+	t.Lock()
+	defer t.Unlock()
+	return t.readCount
+}
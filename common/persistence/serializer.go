@@ -63,13 +63,28 @@ type (
 
 	serializerImpl struct {
 		thriftrwEncoder codec.BinaryEncoder
+		// compressors holds the encoding types which store the thriftrw encoded payload compressed
+		compressors map[common.EncodingType]payloadCompressor
+		// crypter decrypts the payloads which were encrypted at rest
+		crypter PayloadCrypter
 	}
 )
 
 // NewPayloadSerializer returns a PayloadSerializer
 func NewPayloadSerializer() PayloadSerializer {
+	return NewPayloadSerializerWithCrypter(NewNoopPayloadCrypter())
+}
+
+// NewPayloadSerializerWithCrypter returns a PayloadSerializer which can deserialize payloads
+// encrypted by the given PayloadCrypter
+func NewPayloadSerializerWithCrypter(crypter PayloadCrypter) PayloadSerializer {
 	return &serializerImpl{
 		thriftrwEncoder: codec.NewThriftRWEncoder(),
+		compressors: map[common.EncodingType]payloadCompressor{
+			common.EncodingTypeThriftRWGzip:   &gzipCompressor{},
+			common.EncodingTypeThriftRWSnappy: &snappyCompressor{},
+		},
+		crypter: crypter,
 	}
 }
 
@@ -131,6 +146,11 @@ func (t *serializerImpl) serialize(input interface{}, encodingType common.Encodi
 	switch encodingType {
 	case common.EncodingTypeThriftRW:
 		data, err = t.thriftrwEncode(input)
+	case common.EncodingTypeThriftRWGzip, common.EncodingTypeThriftRWSnappy:
+		data, err = t.thriftrwEncode(input)
+		if err == nil {
+			data, err = t.compressors[encodingType].compress(data)
+		}
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
 		encodingType = common.EncodingTypeJSON
 		data, err = json.Marshal(input)
@@ -164,13 +184,22 @@ func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 	if len(data.Data) == 0 {
 		return NewCadenceDeserializationError("DeserializeEvent empty data")
 	}
-	var err error
+	payload, err := t.crypter.Decrypt(data.Data)
+	if err != nil {
+		return NewCadenceDeserializationError(fmt.Sprintf("failed to decrypt payload, error: %v", err.Error()))
+	}
 
 	switch data.GetEncoding() {
 	case common.EncodingTypeThriftRW:
-		err = t.thriftrwDecode(data.Data, target)
+		err = t.thriftrwDecode(payload, target)
+	case common.EncodingTypeThriftRWGzip, common.EncodingTypeThriftRWSnappy:
+		var decompressed []byte
+		decompressed, err = t.compressors[data.GetEncoding()].decompress(payload)
+		if err == nil {
+			err = t.thriftrwDecode(decompressed, target)
+		}
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
-		err = json.Unmarshal(data.Data, target)
+		err = json.Unmarshal(payload, target)
 	default:
 		return NewUnknownEncodingTypeError(data.GetEncoding())
 	}
@@ -225,3 +225,49 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *cadenceSerializerSuite) TestSerializerCompression() {
+	serializer := NewPayloadSerializer()
+
+	var events []*workflow.HistoryEvent
+	for i := int64(1); i <= 100; i++ {
+		events = append(events, &workflow.HistoryEvent{
+			EventId:   common.Int64Ptr(i),
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+			ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+				Result:           []byte("result-of-the-activity-task"),
+				ScheduledEventId: common.Int64Ptr(4),
+				StartedEventId:   common.Int64Ptr(5),
+				Identity:         common.StringPtr("worker-identity"),
+			},
+		})
+	}
+	history0 := &workflow.History{Events: events}
+
+	dsJSON, err := serializer.SerializeBatchEvents(events, common.EncodingTypeJSON)
+	s.Nil(err)
+	dsThrift, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.Nil(err)
+
+	for _, encodingType := range []common.EncodingType{common.EncodingTypeThriftRWGzip, common.EncodingTypeThriftRWSnappy} {
+		dsCompressed, err := serializer.SerializeBatchEvents(events, encodingType)
+		s.Nil(err)
+		s.Equal(encodingType, dsCompressed.GetEncoding())
+		s.True(len(dsCompressed.Data) < len(dsThrift.Data))
+
+		decoded, err := serializer.DeserializeBatchEvents(dsCompressed)
+		s.Nil(err)
+		s.True(history0.Equals(&workflow.History{Events: decoded}))
+
+		_, err = serializer.DeserializeBatchEvents(NewDataBlob([]byte("not compressed"), encodingType))
+		s.NotNil(err)
+		_, ok := err.(*CadenceDeserializationError)
+		s.True(ok)
+	}
+
+	// histories written before compression was enabled are still readable
+	decoded, err := serializer.DeserializeBatchEvents(dsJSON)
+	s.Nil(err)
+	s.True(history0.Equals(&workflow.History{Events: decoded}))
+}
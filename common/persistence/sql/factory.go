@@ -86,6 +86,11 @@ func (f *Factory) NewMetadataStoreV2() (p.MetadataStore, error) {
 	return f.NewMetadataStore()
 }
 
+// NewClusterMetadataStore returns a new cluster metadata store
+func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
+	return newClusterMetadataPersistence(f.cfg, f.logger)
+}
+
 // NewExecutionStore returns an ExecutionStore for a given shardID
 func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
 	factory, err := f.newExecutionStoreFactory()
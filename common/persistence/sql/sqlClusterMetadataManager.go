@@ -0,0 +1,192 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/storage"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const constMetadataPartition = 0
+
+type sqlClusterMetadataManager struct {
+	sqlStore
+}
+
+// newClusterMetadataPersistence creates an instance of ClusterMetadataManager
+func newClusterMetadataPersistence(cfg config.SQL, log log.Logger) (persistence.ClusterMetadataManager, error) {
+	var db, err = storage.NewSQLDB(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlClusterMetadataManager{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: log,
+		},
+	}, nil
+}
+
+func (m *sqlClusterMetadataManager) InitializeImmutableClusterMetadata(
+	request *persistence.InitializeImmutableClusterMetadataRequest,
+) (*persistence.InitializeImmutableClusterMetadataResponse, error) {
+
+	result, err := m.db.InsertIfNotExistsIntoClusterMetadata(&sqldb.ClusterMetadataRow{
+		MetadataPartition:      constMetadataPartition,
+		ClusterName:            request.ClusterName,
+		HistoryShardCount:      request.HistoryShardCount,
+		InitialFailoverVersion: request.InitialFailoverVersion,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("InitializeImmutableClusterMetadata operation failed. Failed to insert into cluster_metadata table. Error: %v", err),
+		}
+	}
+	if rowsAffected, err := result.RowsAffected(); err == nil && rowsAffected == 1 {
+		return &persistence.InitializeImmutableClusterMetadataResponse{
+			PersistedImmutableData: request.ImmutableClusterMetadata,
+			RequestApplied:         true,
+		}, nil
+	}
+
+	row, err := m.db.SelectFromClusterMetadata(constMetadataPartition)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("InitializeImmutableClusterMetadata operation failed. Failed to read cluster_metadata table. Error: %v", err),
+		}
+	}
+	return &persistence.InitializeImmutableClusterMetadataResponse{
+		PersistedImmutableData: persistence.ImmutableClusterMetadata{
+			ClusterName:            row.ClusterName,
+			HistoryShardCount:      row.HistoryShardCount,
+			InitialFailoverVersion: row.InitialFailoverVersion,
+		},
+		RequestApplied: false,
+	}, nil
+}
+
+func (m *sqlClusterMetadataManager) GetSearchAttributes() (*persistence.GetSearchAttributesResponse, error) {
+	row, err := m.db.SelectFromClusterMetadata(constMetadataPartition)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &persistence.GetSearchAttributesResponse{
+				SearchAttributes: make(map[string]workflow.IndexedValueType),
+			}, nil
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetSearchAttributes operation failed. Failed to read cluster_metadata table. Error: %v", err),
+		}
+	}
+
+	searchAttributes := make(map[string]workflow.IndexedValueType)
+	if len(row.SearchAttributes) > 0 {
+		if err := json.Unmarshal(row.SearchAttributes, &searchAttributes); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetSearchAttributes operation failed. Failed to decode search attributes. Error: %v", err),
+			}
+		}
+	}
+	return &persistence.GetSearchAttributesResponse{
+		SearchAttributes: searchAttributes,
+		Version:          row.SearchAttributesVersion,
+	}, nil
+}
+
+func (m *sqlClusterMetadataManager) UpdateSearchAttributes(request *persistence.UpdateSearchAttributesRequest) error {
+	data, err := json.Marshal(request.SearchAttributes)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateSearchAttributes operation failed. Failed to encode search attributes. Error: %v", err),
+		}
+	}
+
+	result, err := m.db.UpdateClusterMetadataSearchAttributes(&sqldb.ClusterMetadataRow{
+		MetadataPartition:       constMetadataPartition,
+		SearchAttributes:        data,
+		SearchAttributesVersion: request.PrevVersion + 1,
+	}, request.PrevVersion)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateSearchAttributes operation failed. Failed to update cluster_metadata table. Error: %v", err),
+		}
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateSearchAttributes operation failed. Failed to check number of rows updated. Error: %v", err),
+		}
+	}
+	if rowsAffected != 1 {
+		return &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("UpdateSearchAttributes operation failed because of version mismatch. Expected: %v", request.PrevVersion),
+		}
+	}
+	return nil
+}
+
+func (m *sqlClusterMetadataManager) GetDynamicConfig() (*persistence.GetDynamicConfigResponse, error) {
+	row, err := m.db.SelectFromClusterMetadata(constMetadataPartition)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &persistence.GetDynamicConfigResponse{}, nil
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDynamicConfig operation failed. Failed to read cluster_metadata table. Error: %v", err),
+		}
+	}
+	return &persistence.GetDynamicConfigResponse{
+		Config:  row.DynamicConfig,
+		Version: row.DynamicConfigVersion,
+	}, nil
+}
+
+func (m *sqlClusterMetadataManager) UpdateDynamicConfig(request *persistence.UpdateDynamicConfigRequest) error {
+	result, err := m.db.UpdateClusterMetadataDynamicConfig(&sqldb.ClusterMetadataRow{
+		MetadataPartition:    constMetadataPartition,
+		DynamicConfig:        request.Config,
+		DynamicConfigVersion: request.PrevVersion + 1,
+	}, request.PrevVersion)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDynamicConfig operation failed. Failed to update cluster_metadata table. Error: %v", err),
+		}
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDynamicConfig operation failed. Failed to check number of rows updated. Error: %v", err),
+		}
+	}
+	if rowsAffected != 1 {
+		return &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("UpdateDynamicConfig operation failed because of version mismatch. Expected: %v", request.PrevVersion),
+		}
+	}
+	return nil
+}
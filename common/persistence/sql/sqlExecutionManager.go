@@ -104,6 +104,8 @@ func (m *sqlExecutionManager) createWorkflowExecutionTx(tx sqldb.Tx, request *p.
 					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
 						"LastWriteVersion: %v, PreviousLastWriteVersion: %v",
 						workflowID, row.LastWriteVersion, request.PreviousLastWriteVersion),
+					RequestID: row.CreateRequestID,
+					RunID:     row.RunID.String(),
 				}
 			}
 			if row.State != p.WorkflowStateCompleted {
@@ -111,6 +113,8 @@ func (m *sqlExecutionManager) createWorkflowExecutionTx(tx sqldb.Tx, request *p.
 					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
 						"State: %v, Expected: %v",
 						workflowID, row.State, p.WorkflowStateCompleted),
+					RequestID: row.CreateRequestID,
+					RunID:     row.RunID.String(),
 				}
 			}
 			runIDStr := row.RunID.String()
@@ -119,6 +123,8 @@ func (m *sqlExecutionManager) createWorkflowExecutionTx(tx sqldb.Tx, request *p.
 					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
 						"RunID: %v, PreviousRunID: %v",
 						workflowID, runIDStr, request.PreviousRunID),
+					RequestID: row.CreateRequestID,
+					RunID:     row.RunID.String(),
 				}
 			}
 		}
@@ -213,6 +219,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 		DecisionTimeout:              info.GetDecisionTimeout(),
 		DecisionAttempt:              info.GetDecisionAttempt(),
 		DecisionTimestamp:            info.GetDecisionTimestampNanos(),
+		DecisionFailureCount:         info.GetDecisionFailureCount(),
 		StickyTaskList:               info.GetStickyTaskList(),
 		StickyScheduleToStartTimeout: int32(info.GetStickyScheduleToStartTimeout()),
 		ClientLibraryVersion:         info.GetClientLibraryVersion(),
@@ -1072,6 +1079,14 @@ func (m *sqlExecutionManager) DeleteWorkflowExecution(request *p.DeleteWorkflowE
 		}); err != nil {
 			return err
 		}
+		if _, err := tx.DeleteFromWorkflowAnnotations(&sqldb.WorkflowAnnotationsFilter{
+			ShardID:    int64(m.shardID),
+			DomainID:   domainID,
+			WorkflowID: request.WorkflowID,
+			RunID:      runID,
+		}); err != nil {
+			return err
+		}
 		// its possible for a new run of the same workflow to have started after the run we are deleting
 		// here was finished. In that case, current_executions table will have the same workflowID but different
 		// runID. The following code will delete the row from current_executions if and only if the runID is
@@ -1121,25 +1136,9 @@ func (m *sqlExecutionManager) GetTransferTasks(request *p.GetTransferTasksReques
 	}
 	resp := &p.GetTransferTasksResponse{Tasks: make([]*p.TransferTaskInfo, len(rows))}
 	for i, row := range rows {
-		info, err := transferTaskInfoFromBlob(row.Data, row.DataEncoding)
-		if err != nil {
+		if resp.Tasks[i], err = transferTaskInfoFromRow(&row); err != nil {
 			return nil, err
 		}
-		resp.Tasks[i] = &p.TransferTaskInfo{
-			TaskID:                  row.TaskID,
-			DomainID:                sqldb.UUID(info.DomainID).String(),
-			WorkflowID:              info.GetWorkflowID(),
-			RunID:                   sqldb.UUID(info.RunID).String(),
-			VisibilityTimestamp:     time.Unix(0, info.GetVisibilityTimestampNanos()),
-			TargetDomainID:          sqldb.UUID(info.TargetDomainID).String(),
-			TargetWorkflowID:        info.GetTargetWorkflowID(),
-			TargetRunID:             sqldb.UUID(info.TargetRunID).String(),
-			TargetChildWorkflowOnly: info.GetTargetChildWorkflowOnly(),
-			TaskList:                info.GetTaskList(),
-			TaskType:                int(info.GetTaskType()),
-			ScheduleID:              info.GetScheduleID(),
-			Version:                 info.GetVersion(),
-		}
 	}
 	return resp, nil
 }
@@ -1168,6 +1167,138 @@ func (m *sqlExecutionManager) RangeCompleteTransferTask(request *p.RangeComplete
 	return nil
 }
 
+func (m *sqlExecutionManager) PutTransferDLQTask(request *p.PutTransferDLQTaskRequest) error {
+	task := request.TaskInfo
+	info := &sqlblobs.TransferTaskInfo{
+		DomainID:                 sqldb.MustParseUUID(task.DomainID),
+		WorkflowID:               &task.WorkflowID,
+		RunID:                    sqldb.MustParseUUID(task.RunID),
+		TaskType:                 common.Int16Ptr(int16(task.TaskType)),
+		TargetDomainID:           sqldb.MustParseUUID(task.TargetDomainID),
+		TargetWorkflowID:         &task.TargetWorkflowID,
+		TaskList:                 &task.TaskList,
+		TargetChildWorkflowOnly:  &task.TargetChildWorkflowOnly,
+		ScheduleID:               &task.ScheduleID,
+		Version:                  &task.Version,
+		VisibilityTimestampNanos: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+	}
+	if task.TargetRunID != "" {
+		info.TargetRunID = sqldb.MustParseUUID(task.TargetRunID)
+	}
+
+	blob, err := transferTaskInfoToBlob(info)
+	if err != nil {
+		return err
+	}
+	if _, err := m.db.InsertIntoTransferDLQTasks(&sqldb.TransferTasksRow{
+		ShardID:      m.shardID,
+		TaskID:       task.TaskID,
+		Data:         blob.Data,
+		DataEncoding: string(blob.Encoding),
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutTransferDLQTask operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetTransferDLQTasks(request *p.GetTransferDLQTasksRequest) (*p.GetTransferDLQTasksResponse, error) {
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := m.db.SelectFromTransferDLQTasks(&sqldb.TransferTasksFilter{
+		ShardID:   m.shardID,
+		MinTaskID: &readLevel,
+		MaxTaskID: &request.MaxReadLevel,
+		PageSize:  &request.BatchSize,
+	})
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetTransferDLQTasks operation failed. Select failed: %v", err),
+			}
+		}
+	}
+	if len(rows) == 0 {
+		return &p.GetTransferDLQTasksResponse{}, nil
+	}
+
+	resp := &p.GetTransferDLQTasksResponse{Tasks: make([]*p.TransferTaskInfo, len(rows))}
+	for i, row := range rows {
+		if resp.Tasks[i], err = transferTaskInfoFromRow(&row); err != nil {
+			return nil, err
+		}
+	}
+	if len(rows) == request.BatchSize {
+		resp.NextPageToken = serializePageToken(rows[len(rows)-1].TaskID)
+	}
+	return resp, nil
+}
+
+func (m *sqlExecutionManager) RangeDeleteTransferDLQTasks(request *p.RangeDeleteTransferDLQTasksRequest) error {
+	if _, err := m.db.DeleteFromTransferDLQTasks(&sqldb.TransferTasksFilter{
+		ShardID:   m.shardID,
+		MinTaskID: &request.ExclusiveBeginTaskID,
+		MaxTaskID: &request.InclusiveEndTaskID}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteTransferDLQTasks operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) PutWorkflowExecutionAnnotation(request *p.PutWorkflowExecutionAnnotationRequest) error {
+	annotation := request.Annotation
+	if _, err := m.db.ReplaceIntoWorkflowAnnotations(&sqldb.WorkflowAnnotationsRow{
+		ShardID:        int64(m.shardID),
+		DomainID:       sqldb.MustParseUUID(request.DomainID),
+		WorkflowID:     request.Execution.GetWorkflowId(),
+		RunID:          sqldb.MustParseUUID(request.Execution.GetRunId()),
+		AnnotationKey:  annotation.Key,
+		AnnotationText: annotation.Text,
+		Author:         annotation.Author,
+		CreatedTime:    annotation.CreatedTime,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutWorkflowExecutionAnnotation operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetWorkflowExecutionAnnotations(request *p.GetWorkflowExecutionAnnotationsRequest) (*p.GetWorkflowExecutionAnnotationsResponse, error) {
+	rows, err := m.db.SelectFromWorkflowAnnotations(&sqldb.WorkflowAnnotationsFilter{
+		ShardID:    int64(m.shardID),
+		DomainID:   sqldb.MustParseUUID(request.DomainID),
+		WorkflowID: request.Execution.GetWorkflowId(),
+		RunID:      sqldb.MustParseUUID(request.Execution.GetRunId()),
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetWorkflowExecutionAnnotations operation failed. Error: %v", err),
+		}
+	}
+
+	resp := &p.GetWorkflowExecutionAnnotationsResponse{
+		Annotations: make([]*p.WorkflowExecutionAnnotation, len(rows)),
+	}
+	for i, row := range rows {
+		resp.Annotations[i] = &p.WorkflowExecutionAnnotation{
+			Key:         row.AnnotationKey,
+			Text:        row.AnnotationText,
+			Author:      row.Author,
+			CreatedTime: row.CreatedTime,
+		}
+	}
+	return resp, nil
+}
+
 func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse, error) {
 	var readLevel int64
 	var maxReadLevelInclusive int64
@@ -1202,36 +1333,9 @@ func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasks
 
 	var tasks = make([]*p.ReplicationTaskInfo, len(rows))
 	for i, row := range rows {
-		info, err := replicationTaskInfoFromBlob(row.Data, row.DataEncoding)
-		if err != nil {
+		if tasks[i], err = replicationTaskInfoFromRow(&row); err != nil {
 			return nil, err
 		}
-
-		var lastReplicationInfo map[string]*p.ReplicationInfo
-		if info.GetTaskType() == p.ReplicationTaskTypeHistory {
-			lastReplicationInfo = make(map[string]*p.ReplicationInfo, len(info.LastReplicationInfo))
-			for k, v := range info.LastReplicationInfo {
-				lastReplicationInfo[k] = &p.ReplicationInfo{Version: v.GetVersion(), LastEventID: v.GetLastEventID()}
-			}
-		}
-
-		tasks[i] = &p.ReplicationTaskInfo{
-			TaskID:                  row.TaskID,
-			DomainID:                sqldb.UUID(info.DomainID).String(),
-			WorkflowID:              info.GetWorkflowID(),
-			RunID:                   sqldb.UUID(info.RunID).String(),
-			TaskType:                int(info.GetTaskType()),
-			FirstEventID:            info.GetFirstEventID(),
-			NextEventID:             info.GetNextEventID(),
-			Version:                 info.GetVersion(),
-			LastReplicationInfo:     lastReplicationInfo,
-			ScheduledID:             info.GetScheduledID(),
-			EventStoreVersion:       info.GetEventStoreVersion(),
-			NewRunEventStoreVersion: info.GetNewRunEventStoreVersion(),
-			BranchToken:             info.GetBranchToken(),
-			NewRunBranchToken:       info.GetNewRunBranchToken(),
-			ResetWorkflow:           info.GetResetWorkflow(),
-		}
 	}
 	var nextPageToken []byte
 	lastTaskID := rows[len(rows)-1].TaskID
@@ -1244,6 +1348,103 @@ func (m *sqlExecutionManager) GetReplicationTasks(request *p.GetReplicationTasks
 	}, nil
 }
 
+func (m *sqlExecutionManager) PutReplicationDLQTask(request *p.PutReplicationDLQTaskRequest) error {
+	task := request.TaskInfo
+	lastReplicationInfo := make(map[string]*sqlblobs.ReplicationInfo, len(task.LastReplicationInfo))
+	for k, v := range task.LastReplicationInfo {
+		lastReplicationInfo[k] = &sqlblobs.ReplicationInfo{Version: common.Int64Ptr(v.Version), LastEventID: common.Int64Ptr(v.LastEventID)}
+	}
+
+	blob, err := replicationTaskInfoToBlob(&sqlblobs.ReplicationTaskInfo{
+		DomainID:                sqldb.MustParseUUID(task.DomainID),
+		WorkflowID:              &task.WorkflowID,
+		RunID:                   sqldb.MustParseUUID(task.RunID),
+		TaskType:                common.Int16Ptr(int16(task.TaskType)),
+		FirstEventID:            &task.FirstEventID,
+		NextEventID:             &task.NextEventID,
+		Version:                 &task.Version,
+		LastReplicationInfo:     lastReplicationInfo,
+		ScheduledID:             &task.ScheduledID,
+		EventStoreVersion:       &task.EventStoreVersion,
+		NewRunEventStoreVersion: &task.NewRunEventStoreVersion,
+		BranchToken:             task.BranchToken,
+		NewRunBranchToken:       task.NewRunBranchToken,
+		ResetWorkflow:           &task.ResetWorkflow,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := m.db.InsertIntoReplicationDLQTasks(&sqldb.ReplicationDLQTasksRow{
+		SourceClusterName: request.SourceClusterName,
+		ShardID:           m.shardID,
+		TaskID:            task.TaskID,
+		Data:              blob.Data,
+		DataEncoding:      string(blob.Encoding),
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutReplicationDLQTask operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetReplicationDLQTasks(request *p.GetReplicationDLQTasksRequest) (*p.GetReplicationDLQTasksResponse, error) {
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := m.db.SelectFromReplicationDLQTasks(&sqldb.ReplicationDLQTasksFilter{
+		ReplicationTasksFilter: sqldb.ReplicationTasksFilter{
+			ShardID:   m.shardID,
+			MinTaskID: &readLevel,
+			MaxTaskID: &request.MaxReadLevel,
+			PageSize:  &request.BatchSize,
+		},
+		SourceClusterName: request.SourceClusterName,
+	})
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetReplicationDLQTasks operation failed. Select failed: %v", err),
+			}
+		}
+	}
+	if len(rows) == 0 {
+		return &p.GetReplicationDLQTasksResponse{}, nil
+	}
+
+	resp := &p.GetReplicationDLQTasksResponse{Tasks: make([]*p.ReplicationTaskInfo, len(rows))}
+	for i, row := range rows {
+		if resp.Tasks[i], err = replicationTaskInfoFromRow(&row); err != nil {
+			return nil, err
+		}
+	}
+	if len(rows) == request.BatchSize {
+		resp.NextPageToken = serializePageToken(rows[len(rows)-1].TaskID)
+	}
+	return resp, nil
+}
+
+func (m *sqlExecutionManager) RangeDeleteReplicationDLQTasks(request *p.RangeDeleteReplicationDLQTasksRequest) error {
+	if _, err := m.db.DeleteFromReplicationDLQTasks(&sqldb.ReplicationDLQTasksFilter{
+		ReplicationTasksFilter: sqldb.ReplicationTasksFilter{
+			ShardID:   m.shardID,
+			MinTaskID: &request.ExclusiveBeginTaskID,
+			MaxTaskID: &request.InclusiveEndTaskID,
+		},
+		SourceClusterName: request.SourceClusterName,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteReplicationDLQTasks operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func (m *sqlExecutionManager) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
 	if _, err := m.db.DeleteFromReplicationTasks(&sqldb.ReplicationTasksFilter{
 		ShardID: m.shardID,
@@ -1420,6 +1621,7 @@ func createExecutionFromRequest(
 		DecisionTimeout:              &request.DecisionStartToCloseTimeout,
 		DecisionAttempt:              zeroPtr,
 		DecisionTimestampNanos:       zeroPtr,
+		DecisionFailureCount:         zeroPtr,
 		StickyTaskList:               &emptyStr,
 		StickyScheduleToStartTimeout: zeroPtr,
 		ClientLibraryVersion:         &emptyStr,
@@ -1677,6 +1879,61 @@ func createTransferTasks(tx sqldb.Tx, transferTasks []p.Task, shardID int, domai
 	return nil
 }
 
+func transferTaskInfoFromRow(row *sqldb.TransferTasksRow) (*p.TransferTaskInfo, error) {
+	info, err := transferTaskInfoFromBlob(row.Data, row.DataEncoding)
+	if err != nil {
+		return nil, err
+	}
+	return &p.TransferTaskInfo{
+		TaskID:                  row.TaskID,
+		DomainID:                sqldb.UUID(info.DomainID).String(),
+		WorkflowID:              info.GetWorkflowID(),
+		RunID:                   sqldb.UUID(info.RunID).String(),
+		VisibilityTimestamp:     time.Unix(0, info.GetVisibilityTimestampNanos()),
+		TargetDomainID:          sqldb.UUID(info.TargetDomainID).String(),
+		TargetWorkflowID:        info.GetTargetWorkflowID(),
+		TargetRunID:             sqldb.UUID(info.TargetRunID).String(),
+		TargetChildWorkflowOnly: info.GetTargetChildWorkflowOnly(),
+		TaskList:                info.GetTaskList(),
+		TaskType:                int(info.GetTaskType()),
+		ScheduleID:              info.GetScheduleID(),
+		Version:                 info.GetVersion(),
+	}, nil
+}
+
+func replicationTaskInfoFromRow(row *sqldb.ReplicationTasksRow) (*p.ReplicationTaskInfo, error) {
+	info, err := replicationTaskInfoFromBlob(row.Data, row.DataEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastReplicationInfo map[string]*p.ReplicationInfo
+	if info.GetTaskType() == p.ReplicationTaskTypeHistory {
+		lastReplicationInfo = make(map[string]*p.ReplicationInfo, len(info.LastReplicationInfo))
+		for k, v := range info.LastReplicationInfo {
+			lastReplicationInfo[k] = &p.ReplicationInfo{Version: v.GetVersion(), LastEventID: v.GetLastEventID()}
+		}
+	}
+
+	return &p.ReplicationTaskInfo{
+		TaskID:                  row.TaskID,
+		DomainID:                sqldb.UUID(info.DomainID).String(),
+		WorkflowID:              info.GetWorkflowID(),
+		RunID:                   sqldb.UUID(info.RunID).String(),
+		TaskType:                int(info.GetTaskType()),
+		FirstEventID:            info.GetFirstEventID(),
+		NextEventID:             info.GetNextEventID(),
+		Version:                 info.GetVersion(),
+		LastReplicationInfo:     lastReplicationInfo,
+		ScheduledID:             info.GetScheduledID(),
+		EventStoreVersion:       info.GetEventStoreVersion(),
+		NewRunEventStoreVersion: info.GetNewRunEventStoreVersion(),
+		BranchToken:             info.GetBranchToken(),
+		NewRunBranchToken:       info.GetNewRunBranchToken(),
+		ResetWorkflow:           info.GetResetWorkflow(),
+	}, nil
+}
+
 func createReplicationTasks(
 	tx sqldb.Tx, replicationTasks []p.Task, shardID int, domainID sqldb.UUID, workflowID string, runID sqldb.UUID) error {
 	if len(replicationTasks) == 0 {
@@ -1925,6 +2182,7 @@ func buildExecutionRow(executionInfo *p.InternalWorkflowExecutionInfo,
 		DecisionTimeout:              &executionInfo.DecisionTimeout,
 		DecisionAttempt:              &executionInfo.DecisionAttempt,
 		DecisionTimestampNanos:       &executionInfo.DecisionTimestamp,
+		DecisionFailureCount:         &executionInfo.DecisionFailureCount,
 		StickyTaskList:               &executionInfo.StickyTaskList,
 		StickyScheduleToStartTimeout: common.Int64Ptr(int64(executionInfo.StickyScheduleToStartTimeout)),
 		ClientLibraryVersion:         &executionInfo.ClientLibraryVersion,
@@ -27,17 +27,19 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
+	"github.com/uber/cadence/common/persistence/sql/storage/postgres"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/environment"
 )
 
 const (
-	testUser      = "uber"
-	testPassword  = "uber"
-	testSchemaDir = "schema/mysql/v57"
+	testUser              = "uber"
+	testPassword          = "uber"
+	testSchemaDir         = "schema/mysql/v57"
+	testPostgresSchemaDir = "schema/postgres"
 )
 
-// TestCluster allows executing cassandra operations in testing.
+// TestCluster allows executing SQL operations in testing.
 type TestCluster struct {
 	dbName    string
 	schemaDir string
@@ -45,25 +47,39 @@ type TestCluster struct {
 	cfg       config.SQL
 }
 
-// NewTestCluster returns a new SQL test cluster
-func NewTestCluster(dbName string, port int, schemaDir string) *TestCluster {
+// NewTestCluster returns a new SQL test cluster, driverName defaults to mysql
+func NewTestCluster(dbName string, driverName string, port int, schemaDir string) *TestCluster {
 	var result TestCluster
 	result.dbName = dbName
+	if driverName == "" {
+		driverName = defaultDriverName
+	}
+	addr := environment.GetMySQLAddress()
+	defaultPort := environment.GetMySQLPort()
+	defaultSchemaDir := testSchemaDir
+	var connectAttributes map[string]string
+	if driverName == postgres.DriverName {
+		addr = environment.GetPostgresAddress()
+		defaultPort = environment.GetPostgresPort()
+		defaultSchemaDir = testPostgresSchemaDir
+		connectAttributes = map[string]string{"sslmode": "disable"}
+	}
 	if port == 0 {
-		port = environment.GetMySQLPort()
+		port = defaultPort
 	}
 	if schemaDir == "" {
-		schemaDir = testSchemaDir
+		schemaDir = defaultSchemaDir
 	}
 	result.schemaDir = schemaDir
 	result.cfg = config.SQL{
-		User:            testUser,
-		Password:        testPassword,
-		ConnectAddr:     fmt.Sprintf("%v:%v", environment.GetMySQLAddress(), port),
-		ConnectProtocol: "tcp",
-		DriverName:      defaultDriverName,
-		DatabaseName:    dbName,
-		NumShards:       4,
+		User:              testUser,
+		Password:          testPassword,
+		ConnectAddr:       fmt.Sprintf("%v:%v", addr, port),
+		ConnectProtocol:   "tcp",
+		ConnectAttributes: connectAttributes,
+		DriverName:        driverName,
+		DatabaseName:      dbName,
+		NumShards:         4,
 	}
 	return &result
 }
@@ -104,8 +120,9 @@ func (s *TestCluster) Config() config.Persistence {
 
 // TearDownTestDatabase from PersistenceTestCluster interface
 func (s *TestCluster) TearDownTestDatabase() {
-	s.DropDatabase()
+	// postgres refuses to drop a database which still has open connections
 	s.db.Close()
+	s.DropDatabase()
 }
 
 // CreateSession from PersistenceTestCluster interface
@@ -119,7 +136,7 @@ func (s *TestCluster) CreateSession() {
 
 // CreateDatabase from PersistenceTestCluster interface
 func (s *TestCluster) CreateDatabase() {
-	err := createDatabase(s.cfg.DriverName, s.cfg.ConnectAddr, testUser, testPassword, s.dbName, true)
+	err := createDatabase(s.cfg, true)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -127,7 +144,12 @@ func (s *TestCluster) CreateDatabase() {
 
 // DropDatabase from PersistenceTestCluster interface
 func (s *TestCluster) DropDatabase() {
-	err := dropDatabase(s.db, s.dbName)
+	db, err := newAdminConnection(s.cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+	err = dropDatabase(db, s.dbName)
 	if err != nil {
 		log.Fatal(err)
 	}
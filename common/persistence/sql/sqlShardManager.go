@@ -126,6 +126,9 @@ func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persi
 		TimerAckLevel:             time.Unix(0, shardInfo.GetTimerAckLevelNanos()),
 		ClusterTransferAckLevel:   shardInfo.ClusterTransferAckLevel,
 		ClusterTimerAckLevel:      timerAckLevel,
+		ClusterTransferReadLevel:  shardInfo.ClusterTransferReadLevel,
+		ClusterTransferAckBitmap:  shardInfo.ClusterTransferAckBitmap,
+		VisibilityAckLevel:        shardInfo.GetVisibilityAckLevel(),
 		DomainNotificationVersion: shardInfo.GetDomainNotificationVersion(),
 	}}
 
@@ -219,6 +222,9 @@ func shardInfoToShardsRow(s persistence.ShardInfo) (*sqldb.ShardsRow, error) {
 		TimerAckLevelNanos:        common.Int64Ptr(s.TimerAckLevel.UnixNano()),
 		ClusterTransferAckLevel:   s.ClusterTransferAckLevel,
 		ClusterTimerAckLevel:      timerAckLevels,
+		ClusterTransferReadLevel:  s.ClusterTransferReadLevel,
+		ClusterTransferAckBitmap:  s.ClusterTransferAckBitmap,
+		VisibilityAckLevel:        common.Int64Ptr(s.VisibilityAckLevel),
 		DomainNotificationVersion: common.Int64Ptr(s.DomainNotificationVersion),
 		Owner:                     &s.Owner,
 	}
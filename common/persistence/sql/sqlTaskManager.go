@@ -47,7 +47,7 @@ var (
 )
 
 // newTaskPersistence creates a new instance of TaskManager
-func newTaskPersistence(cfg config.SQL, log log.Logger) (persistence.TaskManager, error) {
+func newTaskPersistence(cfg config.SQL, log log.Logger) (persistence.TaskStore, error) {
 	var db, err = storage.NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
@@ -337,10 +337,11 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 			expiryTime = time.Now().Add(time.Second * time.Duration(v.Data.ScheduleToStartTimeout))
 		}
 		blob, err := taskInfoToBlob(&sqlblobs.TaskInfo{
-			WorkflowID:      &v.Data.WorkflowID,
-			RunID:           sqldb.MustParseUUID(v.Data.RunID),
-			ScheduleID:      &v.Data.ScheduleID,
-			ExpiryTimeNanos: common.Int64Ptr(expiryTime.UnixNano()),
+			WorkflowID:       &v.Data.WorkflowID,
+			RunID:            sqldb.MustParseUUID(v.Data.RunID),
+			ScheduleID:       &v.Data.ScheduleID,
+			ExpiryTimeNanos:  common.Int64Ptr(expiryTime.UnixNano()),
+			CreatedTimeNanos: common.Int64Ptr(v.Data.CreatedTime.UnixNano()),
 		})
 		if err != nil {
 			return nil, err
@@ -396,12 +397,13 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 			return nil, err
 		}
 		tasks[i] = &persistence.TaskInfo{
-			DomainID:   request.DomainID,
-			WorkflowID: info.GetWorkflowID(),
-			RunID:      sqldb.UUID(info.RunID).String(),
-			TaskID:     v.TaskID,
-			ScheduleID: info.GetScheduleID(),
-			Expiry:     time.Unix(0, info.GetExpiryTimeNanos()),
+			DomainID:    request.DomainID,
+			WorkflowID:  info.GetWorkflowID(),
+			RunID:       sqldb.UUID(info.RunID).String(),
+			TaskID:      v.TaskID,
+			ScheduleID:  info.GetScheduleID(),
+			Expiry:      time.Unix(0, info.GetExpiryTimeNanos()),
+			CreatedTime: time.Unix(0, info.GetCreatedTimeNanos()),
 		}
 	}
 
@@ -92,6 +92,31 @@ workflow_id = :workflow_id
 	deleteTransferTaskQry      = `DELETE FROM transfer_tasks WHERE shard_id = ? AND task_id = ?`
 	rangeDeleteTransferTaskQry = `DELETE FROM transfer_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ?`
 
+	getTransferDLQTasksQry = `SELECT task_id, data, data_encoding 
+ FROM transfer_dlq_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY task_id LIMIT ?`
+
+	createTransferDLQTaskQry = `INSERT INTO transfer_dlq_tasks(shard_id, task_id, data, data_encoding) 
+ VALUES(:shard_id, :task_id, :data, :data_encoding)`
+
+	rangeDeleteTransferDLQTaskQry = `DELETE FROM transfer_dlq_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ?`
+
+	replaceWorkflowAnnotationQry = `REPLACE INTO workflow_annotations
+(shard_id, domain_id, workflow_id, run_id, annotation_key, annotation_text, author, created_time) VALUES
+(:shard_id, :domain_id, :workflow_id, :run_id, :annotation_key, :annotation_text, :author, :created_time)`
+
+	getWorkflowAnnotationsQry = `SELECT annotation_key, annotation_text, author, created_time FROM workflow_annotations WHERE
+shard_id = ? AND
+domain_id = ? AND
+workflow_id = ? AND
+run_id = ?
+ORDER BY annotation_key`
+
+	deleteWorkflowAnnotationsQry = `DELETE FROM workflow_annotations WHERE
+shard_id = ? AND
+domain_id = ? AND
+workflow_id = ? AND
+run_id = ?`
+
 	createTimerTasksQry = `INSERT INTO timer_tasks (shard_id, visibility_timestamp, task_id, data, data_encoding)
   VALUES (:shard_id, :visibility_timestamp, :task_id, :data, :data_encoding)`
 
@@ -115,6 +140,18 @@ ORDER BY task_id LIMIT ?`
 
 	deleteReplicationTaskQry = `DELETE FROM replication_tasks WHERE shard_id = ? AND task_id = ?`
 
+	getReplicationDLQTasksQry = `SELECT task_id, data, data_encoding FROM replication_dlq_tasks WHERE 
+source_cluster_name = ? AND
+shard_id = ? AND
+task_id > ? AND
+task_id <= ?
+ORDER BY task_id LIMIT ?`
+
+	createReplicationDLQTaskQry = `INSERT INTO replication_dlq_tasks (source_cluster_name, shard_id, task_id, data, data_encoding) 
+  VALUES(:source_cluster_name, :shard_id, :task_id, :data, :data_encoding)`
+
+	rangeDeleteReplicationDLQTaskQry = `DELETE FROM replication_dlq_tasks WHERE source_cluster_name = ? AND shard_id = ? AND task_id > ? AND task_id <= ?`
+
 	bufferedEventsColumns    = `shard_id, domain_id, workflow_id, run_id, data, data_encoding`
 	createBufferedEventsQury = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
 VALUES (:shard_id, :domain_id, :workflow_id, :run_id, :data, :data_encoding)`
@@ -223,6 +260,51 @@ func (mdb *DB) DeleteFromTransferTasks(filter *sqldb.TransferTasksFilter) (sql.R
 	return mdb.conn.Exec(deleteTransferTaskQry, filter.ShardID, *filter.TaskID)
 }
 
+// InsertIntoTransferDLQTasks inserts a row into transfer_dlq_tasks table
+func (mdb *DB) InsertIntoTransferDLQTasks(row *sqldb.TransferTasksRow) (sql.Result, error) {
+	return mdb.conn.NamedExec(createTransferDLQTaskQry, row)
+}
+
+// SelectFromTransferDLQTasks reads one or more rows from transfer_dlq_tasks table
+func (mdb *DB) SelectFromTransferDLQTasks(filter *sqldb.TransferTasksFilter) ([]sqldb.TransferTasksRow, error) {
+	var rows []sqldb.TransferTasksRow
+	err := mdb.conn.Select(&rows, getTransferDLQTasksQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID, *filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromTransferDLQTasks deletes a range of rows from transfer_dlq_tasks table
+func (mdb *DB) DeleteFromTransferDLQTasks(filter *sqldb.TransferTasksFilter) (sql.Result, error) {
+	return mdb.conn.Exec(rangeDeleteTransferDLQTaskQry, filter.ShardID, *filter.MinTaskID, *filter.MaxTaskID)
+}
+
+// ReplaceIntoWorkflowAnnotations inserts or replaces a row in workflow_annotations table
+func (mdb *DB) ReplaceIntoWorkflowAnnotations(row *sqldb.WorkflowAnnotationsRow) (sql.Result, error) {
+	row.CreatedTime = mdb.converter.ToMySQLDateTime(row.CreatedTime)
+	return mdb.conn.NamedExec(replaceWorkflowAnnotationQry, row)
+}
+
+// SelectFromWorkflowAnnotations reads the rows of one execution from workflow_annotations table
+func (mdb *DB) SelectFromWorkflowAnnotations(filter *sqldb.WorkflowAnnotationsFilter) ([]sqldb.WorkflowAnnotationsRow, error) {
+	var rows []sqldb.WorkflowAnnotationsRow
+	err := mdb.conn.Select(&rows, getWorkflowAnnotationsQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].ShardID = filter.ShardID
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+		rows[i].CreatedTime = mdb.converter.FromMySQLDateTime(rows[i].CreatedTime)
+	}
+	return rows, nil
+}
+
+// DeleteFromWorkflowAnnotations deletes the rows of one execution from workflow_annotations table
+func (mdb *DB) DeleteFromWorkflowAnnotations(filter *sqldb.WorkflowAnnotationsFilter) (sql.Result, error) {
+	return mdb.conn.Exec(deleteWorkflowAnnotationsQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
 // InsertIntoTimerTasks inserts one or more rows into timer_tasks table
 func (mdb *DB) InsertIntoTimerTasks(rows []sqldb.TimerTasksRow) (sql.Result, error) {
 	for i := range rows {
@@ -297,3 +379,22 @@ func (mdb *DB) SelectFromReplicationTasks(filter *sqldb.ReplicationTasksFilter)
 func (mdb *DB) DeleteFromReplicationTasks(filter *sqldb.ReplicationTasksFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteReplicationTaskQry, filter.ShardID, *filter.TaskID)
 }
+
+// InsertIntoReplicationDLQTasks inserts a row into replication_dlq_tasks table
+func (mdb *DB) InsertIntoReplicationDLQTasks(row *sqldb.ReplicationDLQTasksRow) (sql.Result, error) {
+	return mdb.conn.NamedExec(createReplicationDLQTaskQry, row)
+}
+
+// SelectFromReplicationDLQTasks reads one or more rows from replication_dlq_tasks table
+func (mdb *DB) SelectFromReplicationDLQTasks(filter *sqldb.ReplicationDLQTasksFilter) ([]sqldb.ReplicationTasksRow, error) {
+	var rows []sqldb.ReplicationTasksRow
+	err := mdb.conn.Select(&rows, getReplicationDLQTasksQry, filter.SourceClusterName, filter.ShardID,
+		*filter.MinTaskID, *filter.MaxTaskID, *filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromReplicationDLQTasks deletes a range of rows from replication_dlq_tasks table
+func (mdb *DB) DeleteFromReplicationDLQTasks(filter *sqldb.ReplicationDLQTasksFilter) (sql.Result, error) {
+	return mdb.conn.Exec(rangeDeleteReplicationDLQTaskQry, filter.SourceClusterName, filter.ShardID,
+		*filter.MinTaskID, *filter.MaxTaskID)
+}
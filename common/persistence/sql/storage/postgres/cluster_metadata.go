@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	insertIgnoreClusterMetadataQry = `INSERT INTO
+ cluster_metadata (metadata_partition, cluster_name, history_shard_count, initial_failover_version)
+ VALUES (?, ?, ?, ?)
+ ON CONFLICT (metadata_partition) DO NOTHING`
+
+	getClusterMetadataQry = `SELECT
+ metadata_partition, cluster_name, history_shard_count, initial_failover_version, search_attributes, search_attributes_version,
+ dynamic_config, dynamic_config_version
+ FROM cluster_metadata WHERE metadata_partition = ?`
+
+	updateClusterMetadataSearchAttributesQry = `UPDATE cluster_metadata
+ SET search_attributes = ?, search_attributes_version = ?
+ WHERE metadata_partition = ? AND search_attributes_version = ?`
+
+	updateClusterMetadataDynamicConfigQry = `UPDATE cluster_metadata
+ SET dynamic_config = ?, dynamic_config_version = ?
+ WHERE metadata_partition = ? AND dynamic_config_version = ?`
+)
+
+// InsertIfNotExistsIntoClusterMetadata inserts a single row into cluster_metadata table, if not yet present
+func (pdb *DB) InsertIfNotExistsIntoClusterMetadata(row *sqldb.ClusterMetadataRow) (sql.Result, error) {
+	return pdb.conn.Exec(insertIgnoreClusterMetadataQry,
+		row.MetadataPartition, row.ClusterName, row.HistoryShardCount, row.InitialFailoverVersion)
+}
+
+// SelectFromClusterMetadata reads a single row from cluster_metadata table
+func (pdb *DB) SelectFromClusterMetadata(metadataPartition int) (*sqldb.ClusterMetadataRow, error) {
+	var row sqldb.ClusterMetadataRow
+	err := pdb.conn.Get(&row, getClusterMetadataQry, metadataPartition)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+// UpdateClusterMetadataSearchAttributes updates the search attributes of cluster_metadata table, if the version matches
+func (pdb *DB) UpdateClusterMetadataSearchAttributes(row *sqldb.ClusterMetadataRow, prevVersion int64) (sql.Result, error) {
+	return pdb.conn.Exec(updateClusterMetadataSearchAttributesQry,
+		row.SearchAttributes, row.SearchAttributesVersion, row.MetadataPartition, prevVersion)
+}
+
+// UpdateClusterMetadataDynamicConfig updates the dynamic config of cluster_metadata table, if the version matches
+func (pdb *DB) UpdateClusterMetadataDynamicConfig(row *sqldb.ClusterMetadataRow, prevVersion int64) (sql.Result, error) {
+	return pdb.conn.Exec(updateClusterMetadataDynamicConfigQry,
+		row.DynamicConfig, row.DynamicConfigVersion, row.MetadataPartition, prevVersion)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+// DriverName is the name of the postgres database/sql driver
+const DriverName = "postgres"
+
+// errCodeDupEntry is the postgres error code for unique_violation
+const errCodeDupEntry = "23505"
+
+type (
+	// DB represents a logical connection to postgres database
+	DB struct {
+		db        *sqlx.DB
+		tx        *sqlx.Tx
+		conn      sqldb.Conn
+		converter DataConverter
+	}
+
+	// conn rewrites the '?' bind vars used by the queries in
+	// this package into the positional '$N' form postgres expects
+	conn struct {
+		sqldb.Conn
+	}
+)
+
+var _ sqldb.Tx = (*DB)(nil)
+var _ sqldb.Interface = (*DB)(nil)
+
+// NewDB returns an instance of DB, which is a logical
+// connection to the underlying postgres database
+func NewDB(xdb *sqlx.DB, tx *sqlx.Tx) *DB {
+	pdb := &DB{db: xdb, tx: tx}
+	pdb.conn = &conn{Conn: xdb}
+	if tx != nil {
+		pdb.conn = &conn{Conn: tx}
+	}
+	pdb.converter = &converter{}
+	return pdb
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (pdb *DB) BeginTx() (sqldb.Tx, error) {
+	xtx, err := pdb.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	return NewDB(pdb.db, xtx), nil
+}
+
+// Commit commits a previously started transaction
+func (pdb *DB) Commit() error {
+	return pdb.tx.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (pdb *DB) Rollback() error {
+	return pdb.tx.Rollback()
+}
+
+// Close closes the connection to the postgres db
+func (pdb *DB) Close() error {
+	return pdb.db.Close()
+}
+
+// DriverName returns the name of the postgres driver
+func (pdb *DB) DriverName() string {
+	return pdb.db.DriverName()
+}
+
+// IsDupEntryError returns true if the error is a unique key violation
+func (pdb *DB) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(*pq.Error)
+	return ok && sqlErr.Code == errCodeDupEntry
+}
+
+func (c *conn) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.Conn.Exec(sqlx.Rebind(sqlx.DOLLAR, query), args...)
+}
+
+func (c *conn) Get(dest interface{}, query string, args ...interface{}) error {
+	return c.Conn.Get(dest, sqlx.Rebind(sqlx.DOLLAR, query), args...)
+}
+
+func (c *conn) Select(dest interface{}, query string, args ...interface{}) error {
+	return c.Conn.Select(dest, sqlx.Rebind(sqlx.DOLLAR, query), args...)
+}
@@ -52,6 +52,18 @@ type (
 		NotificationVersion int64
 	}
 
+	// ClusterMetadataRow represents a row in cluster_metadata table
+	ClusterMetadataRow struct {
+		MetadataPartition       int
+		ClusterName             string
+		HistoryShardCount       int
+		InitialFailoverVersion  int64
+		SearchAttributes        []byte
+		SearchAttributesVersion int64
+		DynamicConfig           []byte
+		DynamicConfigVersion    int64
+	}
+
 	// ShardsRow represents a row in shards table
 	ShardsRow struct {
 		ShardID      int64
@@ -81,6 +93,7 @@ type (
 		TaskID    *int64
 		MinTaskID *int64
 		MaxTaskID *int64
+		PageSize  *int
 	}
 
 	// ExecutionsRow represents a row in executions table
@@ -212,6 +225,22 @@ type (
 		PageSize  *int
 	}
 
+	// ReplicationDLQTasksRow represents a row in replication_dlq_tasks table
+	ReplicationDLQTasksRow struct {
+		SourceClusterName string
+		ShardID           int
+		TaskID            int64
+		Data              []byte
+		DataEncoding      string
+	}
+
+	// ReplicationDLQTasksFilter contains the column names within replication_dlq_tasks table that
+	// can be used to filter results through a WHERE clause
+	ReplicationDLQTasksFilter struct {
+		ReplicationTasksFilter
+		SourceClusterName string
+	}
+
 	// TimerTasksRow represents a row in timer_tasks table
 	TimerTasksRow struct {
 		ShardID             int
@@ -452,6 +481,27 @@ type (
 		SignalID   *string
 	}
 
+	// WorkflowAnnotationsRow represents a row in workflow_annotations table
+	WorkflowAnnotationsRow struct {
+		ShardID        int64
+		DomainID       UUID
+		WorkflowID     string
+		RunID          UUID
+		AnnotationKey  string
+		AnnotationText string
+		Author         string
+		CreatedTime    time.Time
+	}
+
+	// WorkflowAnnotationsFilter contains the column names within workflow_annotations table that
+	// can be used to filter results through a WHERE clause
+	WorkflowAnnotationsFilter struct {
+		ShardID    int64
+		DomainID   UUID
+		WorkflowID string
+		RunID      UUID
+	}
+
 	// VisibilityRow represents a row in executions_visibility table
 	VisibilityRow struct {
 		DomainID         string
@@ -496,6 +546,14 @@ type (
 		UpdateDomainMetadata(row *DomainMetadataRow) (sql.Result, error)
 		SelectFromDomainMetadata() (*DomainMetadataRow, error)
 
+		// InsertIfNotExistsIntoClusterMetadata inserts the row unless a row already exists for the partition
+		InsertIfNotExistsIntoClusterMetadata(row *ClusterMetadataRow) (sql.Result, error)
+		SelectFromClusterMetadata(metadataPartition int) (*ClusterMetadataRow, error)
+		// UpdateClusterMetadataSearchAttributes replaces the search attributes only if the stored version is prevVersion
+		UpdateClusterMetadataSearchAttributes(row *ClusterMetadataRow, prevVersion int64) (sql.Result, error)
+		// UpdateClusterMetadataDynamicConfig replaces the dynamic config only if the stored version is prevVersion
+		UpdateClusterMetadataDynamicConfig(row *ClusterMetadataRow, prevVersion int64) (sql.Result, error)
+
 		InsertIntoShards(rows *ShardsRow) (sql.Result, error)
 		UpdateShards(row *ShardsRow) (sql.Result, error)
 		SelectFromShards(filter *ShardsFilter) (*ShardsRow, error)
@@ -573,6 +631,24 @@ type (
 		// When MinTaskID and MaxTaskID are not-nil, a range of rows are deleted.
 		DeleteFromTransferTasks(filter *TransferTasksFilter) (sql.Result, error)
 
+		InsertIntoTransferDLQTasks(row *TransferTasksRow) (sql.Result, error)
+		// SelectFromTransferDLQTasks returns rows that match filter criteria from transfer_dlq_tasks table.
+		// Required filter params - {shardID, minTaskID, maxTaskID, pageSize}
+		SelectFromTransferDLQTasks(filter *TransferTasksFilter) ([]TransferTasksRow, error)
+		// DeleteFromTransferDLQTasks deletes a range of rows from transfer_dlq_tasks table.
+		// Required filter params - {shardID, minTaskID, maxTaskID}
+		DeleteFromTransferDLQTasks(filter *TransferTasksFilter) (sql.Result, error)
+
+		// ReplaceIntoWorkflowAnnotations inserts a row into workflow_annotations table,
+		// replacing the existing row with the same annotation key
+		ReplaceIntoWorkflowAnnotations(row *WorkflowAnnotationsRow) (sql.Result, error)
+		// SelectFromWorkflowAnnotations returns the rows of one execution from workflow_annotations table.
+		// Required filter params - {shardID, domainID, workflowID, runID}
+		SelectFromWorkflowAnnotations(filter *WorkflowAnnotationsFilter) ([]WorkflowAnnotationsRow, error)
+		// DeleteFromWorkflowAnnotations deletes the rows of one execution from workflow_annotations table.
+		// Required filter params - {shardID, domainID, workflowID, runID}
+		DeleteFromWorkflowAnnotations(filter *WorkflowAnnotationsFilter) (sql.Result, error)
+
 		InsertIntoTimerTasks(rows []TimerTasksRow) (sql.Result, error)
 		// SelectFromTimerTasks returns one or more rows from timer_tasks table
 		// Required filter Params - {shardID, taskID, minVisibilityTimestamp, maxVisibilityTimestamp, pageSize}
@@ -595,6 +671,14 @@ type (
 		// Required filter params - {shardID, taskID}
 		DeleteFromReplicationTasks(filter *ReplicationTasksFilter) (sql.Result, error)
 
+		InsertIntoReplicationDLQTasks(row *ReplicationDLQTasksRow) (sql.Result, error)
+		// SelectFromReplicationDLQTasks returns rows that match filter criteria from replication_dlq_tasks table.
+		// Required filter params - {sourceClusterName, shardID, minTaskID, maxTaskID, pageSize}
+		SelectFromReplicationDLQTasks(filter *ReplicationDLQTasksFilter) ([]ReplicationTasksRow, error)
+		// DeleteFromReplicationDLQTasks deletes a range of rows from replication_dlq_tasks table.
+		// Required filter params - {sourceClusterName, shardID, minTaskID, maxTaskID}
+		DeleteFromReplicationDLQTasks(filter *ReplicationDLQTasksFilter) (sql.Result, error)
+
 		ReplaceIntoActivityInfoMaps(rows []ActivityInfoMapsRow) (sql.Result, error)
 		// SelectFromActivityInfoMaps returns one or more rows from activity_info_maps
 		// Required filter params - {shardID, domainID, workflowID, runID}
@@ -694,6 +778,7 @@ type (
 		tableCRUD
 		BeginTx() (Tx, error)
 		DriverName() string
+		IsDupEntryError(err error) bool
 		Close() error
 	}
 
@@ -23,21 +23,24 @@ package sql
 import (
 	"fmt"
 	"io/ioutil"
+	"net/url"
 
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
+	"github.com/uber/cadence/common/persistence/sql/storage/postgres"
 	"github.com/uber/cadence/common/service/config"
 )
 
 const (
 	defaultDriverName = "mysql"
 	dataSourceName    = "%s:%s@%v(%v)/%s?multiStatements=true&transaction_isolation=%%27READ-COMMITTED%%27&parseTime=true&clientFoundRows=true"
+	// postgresAdminDatabase is the database postgres connections are opened on to create and drop other databases
+	postgresAdminDatabase = "postgres"
 )
 
 func newConnection(cfg config.SQL) (*sqlx.DB, error) {
-	var db, err = sqlx.Connect(cfg.DriverName,
-		fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName))
+	var db, err = sqlx.Connect(cfg.DriverName, buildDataSourceName(cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -46,12 +49,41 @@ func newConnection(cfg config.SQL) (*sqlx.DB, error) {
 	return db, nil
 }
 
-func createDatabase(driver string, addr string, username, password, dbName string, overwrite bool) error {
-	var db, err = sqlx.Connect(driver, fmt.Sprintf(dataSourceName, username, password, "tcp", addr, ""))
+// newAdminConnection returns a connection which is not bound to the test database, so it can create and drop it
+func newAdminConnection(cfg config.SQL) (*sqlx.DB, error) {
+	cfg.DatabaseName = ""
+	if cfg.DriverName == postgres.DriverName {
+		cfg.DatabaseName = postgresAdminDatabase
+	}
+	return sqlx.Connect(cfg.DriverName, buildDataSourceName(cfg))
+}
+
+func buildDataSourceName(cfg config.SQL) string {
+	if cfg.DriverName == postgres.DriverName {
+		attrs := url.Values{}
+		for k, v := range cfg.ConnectAttributes {
+			attrs.Set(k, v)
+		}
+		dsn := url.URL{
+			Scheme:   postgres.DriverName,
+			User:     url.UserPassword(cfg.User, cfg.Password),
+			Host:     cfg.ConnectAddr,
+			Path:     "/" + cfg.DatabaseName,
+			RawQuery: attrs.Encode(),
+		}
+		return dsn.String()
+	}
+	return fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName)
+}
+
+func createDatabase(cfg config.SQL, overwrite bool) error {
+	db, err := newAdminConnection(cfg)
 	if err != nil {
-		return fmt.Errorf("failure connecting to mysql database: %v", err)
+		return fmt.Errorf("failure connecting to %v database: %v", cfg.DriverName, err)
 	}
+	defer db.Close()
 
+	dbName := cfg.DatabaseName
 	if overwrite {
 		dropDatabase(db, dbName)
 	}
@@ -63,9 +95,9 @@ func createDatabase(driver string, addr string, username, password, dbName strin
 	return nil
 }
 
-// DropCassandraKeyspace drops the given keyspace, if it exists
+// dropDatabase drops the given database, if it exists
 func dropDatabase(db *sqlx.DB, dbName string) (err error) {
-	_, err = db.Exec("DROP DATABASE " + dbName)
+	_, err = db.Exec("DROP DATABASE IF EXISTS " + dbName)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/tracing"
+)
+
+type (
+	// taskManagerImpl implements TaskManager based on TaskStore
+	taskManagerImpl struct {
+		persistence TaskStore
+		logger      log.Logger
+	}
+)
+
+var _ TaskManager = (*taskManagerImpl)(nil)
+
+// NewTaskManagerImpl returns new TaskManager
+func NewTaskManagerImpl(persistence TaskStore, logger log.Logger) TaskManager {
+	return &taskManagerImpl{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+func (m *taskManagerImpl) GetName() string {
+	return m.persistence.GetName()
+}
+
+func (m *taskManagerImpl) LeaseTaskList(
+	ctx context.Context,
+	request *LeaseTaskListRequest,
+) (retResp *LeaseTaskListResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.LeaseTaskList", tracing.TaskListTags(request.DomainID, request.TaskList)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
+	// the task list is not stolen on behalf of a caller which has gone away
+	if err := common.IsValidContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return m.persistence.LeaseTaskList(request)
+}
+
+func (m *taskManagerImpl) UpdateTaskList(
+	ctx context.Context,
+	request *UpdateTaskListRequest,
+) (retResp *UpdateTaskListResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.UpdateTaskList", tracing.TaskListTags(
+		request.TaskListInfo.DomainID, request.TaskListInfo.Name)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
+	return m.persistence.UpdateTaskList(request)
+}
+
+func (m *taskManagerImpl) ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error) {
+	return m.persistence.ListTaskList(request)
+}
+
+func (m *taskManagerImpl) DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error {
+	return m.persistence.DeleteTaskList(request)
+}
+
+func (m *taskManagerImpl) CreateTasks(
+	ctx context.Context,
+	request *CreateTasksRequest,
+) (retResp *CreateTasksResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.CreateTasks", tracing.TaskListTags(
+		request.TaskListInfo.DomainID, request.TaskListInfo.Name)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
+	return m.persistence.CreateTasks(request)
+}
+
+func (m *taskManagerImpl) GetTasks(
+	ctx context.Context,
+	request *GetTasksRequest,
+) (retResp *GetTasksResponse, retError error) {
+	span, _ := tracing.StartSpan(ctx, "persistence.GetTasks", tracing.TaskListTags(request.DomainID, request.TaskList)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
+	if err := common.IsValidContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return m.persistence.GetTasks(request)
+}
+
+func (m *taskManagerImpl) CompleteTask(ctx context.Context, request *CompleteTaskRequest) error {
+	return m.persistence.CompleteTask(request)
+}
+
+func (m *taskManagerImpl) CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error) {
+	return m.persistence.CompleteTasksLessThan(request)
+}
+
+func (m *taskManagerImpl) Close() {
+	m.persistence.Close()
+}
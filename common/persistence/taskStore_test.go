@@ -0,0 +1,61 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	taskManagerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestTaskManagerSuite(t *testing.T) {
+	s := new(taskManagerSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *taskManagerSuite) TestCanceledContext_AbortsLeaseAndRead() {
+	// the store is never reached once the context is done
+	manager := NewTaskManagerImpl(nil, loggerimpl.NewNopLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := manager.LeaseTaskList(ctx, &LeaseTaskListRequest{DomainID: "domain", TaskList: "tasklist"})
+	s.Equal(context.Canceled, err)
+
+	_, err = manager.GetTasks(ctx, &GetTasksRequest{DomainID: "domain", TaskList: "tasklist"})
+	s.Equal(context.Canceled, err)
+}
@@ -43,6 +43,7 @@ type (
 		WorkflowTimeout    int64 // not persisted, used for cassandra ttl
 		TaskID             int64 // not persisted, used as condition update version for ES
 		Memo               *s.Memo
+		SearchAttributes   map[string][]byte
 	}
 
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a newly
@@ -116,12 +117,35 @@ type (
 		Execution *s.WorkflowExecutionInfo
 	}
 
+	// CountWorkflowExecutionsRequest is used to count open or closed executions in a domain,
+	// at most one of WorkflowTypeName, WorkflowID and Status can be set
+	CountWorkflowExecutionsRequest struct {
+		DomainUUID        string
+		Domain            string // domain name is not persisted, but used as config filter key
+		EarliestStartTime int64
+		LatestStartTime   int64
+		Closed            bool
+		WorkflowTypeName  string
+		WorkflowID        string
+		Status            *s.WorkflowExecutionCloseStatus // only valid for closed executions
+		// MaxCount bounds the number of executions read to count them, 0 means no bound
+		MaxCount int64
+	}
+
+	// CountWorkflowExecutionsResponse is the response to CountWorkflowExecutionsRequest
+	CountWorkflowExecutionsResponse struct {
+		Count int64
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		DomainID   string
 		RunID      string
 		WorkflowID string
 		TaskID     int64
+		// StartTimestamp is the start time of the run, stores which otherwise leave the records to expire,
+		// e.g. cassandra, delete them right away when it is set
+		StartTimestamp int64
 	}
 
 	// VisibilityManager is used to manage the visibility store
@@ -139,5 +163,10 @@ type (
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error
+		CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		// ScanWorkflowExecutions pages through the open and then the closed executions of a domain for exports,
+		// executions are not ordered across pages. An execution closing while the scan runs can be returned twice,
+		// once as open and once as closed, or not at all if it started after the open executions were scanned
+		ScanWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 	}
 )
@@ -217,6 +217,28 @@ func (p *visibilitySamplingClient) DeleteWorkflowExecution(request *VisibilityDe
 	return p.persistence.DeleteWorkflowExecution(request)
 }
 
+func (p *visibilitySamplingClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	domain := request.Domain
+
+	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
+	if ok, _ := rateLimiter.GetToken(0, 1); !ok {
+		return nil, ErrPersistenceLimitExceededForList
+	}
+
+	return p.persistence.CountWorkflowExecutions(request)
+}
+
+func (p *visibilitySamplingClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	domain := request.Domain
+
+	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
+	if ok, _ := rateLimiter.GetToken(0, 1); !ok {
+		return nil, ErrPersistenceLimitExceededForList
+	}
+
+	return p.persistence.ScanWorkflowExecutions(request)
+}
+
 func (p *visibilitySamplingClient) Close() {
 	p.persistence.Close()
 }
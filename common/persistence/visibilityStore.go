@@ -21,6 +21,9 @@
 package persistence
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
@@ -33,11 +36,20 @@ type (
 		persistence VisibilityStore
 		logger      log.Logger
 	}
+
+	// visibilityScanPageToken is the page token of ScanWorkflowExecutions,
+	// open executions are scanned before closed ones
+	visibilityScanPageToken struct {
+		Closed        bool
+		NextPageToken []byte
+	}
 )
 
 // VisibilityEncoding is default encoding for visibility data
 const VisibilityEncoding = common.EncodingTypeThriftRW
 
+const countWorkflowExecutionsPageSize = 1000
+
 var _ VisibilityManager = (*visibilityManagerImpl)(nil)
 
 // NewVisibilityManagerImpl returns new VisibilityManager
@@ -68,6 +80,7 @@ func (v *visibilityManagerImpl) RecordWorkflowExecutionStarted(request *RecordWo
 		WorkflowTimeout:    request.WorkflowTimeout,
 		TaskID:             request.TaskID,
 		Memo:               v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
+		SearchAttributes:   request.SearchAttributes,
 	}
 	return v.persistence.RecordWorkflowExecutionStarted(req)
 }
@@ -158,6 +171,112 @@ func (v *visibilityManagerImpl) DeleteWorkflowExecution(request *VisibilityDelet
 	return v.persistence.DeleteWorkflowExecution(request)
 }
 
+func (v *visibilityManagerImpl) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	listRequest := ListWorkflowExecutionsRequest{
+		DomainUUID:        request.DomainUUID,
+		Domain:            request.Domain,
+		EarliestStartTime: request.EarliestStartTime,
+		LatestStartTime:   request.LatestStartTime,
+		PageSize:          countWorkflowExecutionsPageSize,
+	}
+
+	var count int64
+	for {
+		internalResp, err := v.listWorkflowExecutionsForCount(request, listRequest)
+		if err != nil {
+			return nil, err
+		}
+		count += int64(len(internalResp.Executions))
+		if len(internalResp.NextPageToken) == 0 {
+			return &CountWorkflowExecutionsResponse{Count: count}, nil
+		}
+		if request.MaxCount > 0 && count >= request.MaxCount {
+			// every page is read within a single call, stop before a broad filter scans the whole domain
+			return nil, &shared.BadRequestError{
+				Message: fmt.Sprintf("CountWorkflowExecutions matched more than %v executions, narrow the filter.", request.MaxCount),
+			}
+		}
+		listRequest.NextPageToken = internalResp.NextPageToken
+	}
+}
+
+func (v *visibilityManagerImpl) listWorkflowExecutionsForCount(request *CountWorkflowExecutionsRequest,
+	listRequest ListWorkflowExecutionsRequest) (*InternalListWorkflowExecutionsResponse, error) {
+	switch {
+	case request.WorkflowTypeName != "":
+		typeRequest := &ListWorkflowExecutionsByTypeRequest{
+			ListWorkflowExecutionsRequest: listRequest,
+			WorkflowTypeName:              request.WorkflowTypeName,
+		}
+		if request.Closed {
+			return v.persistence.ListClosedWorkflowExecutionsByType(typeRequest)
+		}
+		return v.persistence.ListOpenWorkflowExecutionsByType(typeRequest)
+	case request.WorkflowID != "":
+		idRequest := &ListWorkflowExecutionsByWorkflowIDRequest{
+			ListWorkflowExecutionsRequest: listRequest,
+			WorkflowID:                    request.WorkflowID,
+		}
+		if request.Closed {
+			return v.persistence.ListClosedWorkflowExecutionsByWorkflowID(idRequest)
+		}
+		return v.persistence.ListOpenWorkflowExecutionsByWorkflowID(idRequest)
+	case request.Closed && request.Status != nil:
+		return v.persistence.ListClosedWorkflowExecutionsByStatus(&ListClosedWorkflowExecutionsByStatusRequest{
+			ListWorkflowExecutionsRequest: listRequest,
+			Status:                        *request.Status,
+		})
+	case request.Closed:
+		return v.persistence.ListClosedWorkflowExecutions(&listRequest)
+	default:
+		return v.persistence.ListOpenWorkflowExecutions(&listRequest)
+	}
+}
+
+func (v *visibilityManagerImpl) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	token := &visibilityScanPageToken{}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &shared.BadRequestError{
+				Message: fmt.Sprintf("ScanWorkflowExecutions failed. Invalid next page token: %v", err),
+			}
+		}
+	}
+
+	listRequest := *request
+	listRequest.NextPageToken = token.NextPageToken
+	var internalResp *InternalListWorkflowExecutionsResponse
+	var err error
+	if token.Closed {
+		internalResp, err = v.persistence.ListClosedWorkflowExecutions(&listRequest)
+	} else {
+		internalResp, err = v.persistence.ListOpenWorkflowExecutions(&listRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := v.convertInternalListResponse(internalResp)
+	resp.NextPageToken = nil
+	switch {
+	case len(internalResp.NextPageToken) > 0:
+		token = &visibilityScanPageToken{Closed: token.Closed, NextPageToken: internalResp.NextPageToken}
+	case !token.Closed:
+		// open executions are exhausted, continue with closed ones
+		token = &visibilityScanPageToken{Closed: true}
+	default:
+		return resp, nil
+	}
+
+	resp.NextPageToken, err = json.Marshal(token)
+	if err != nil {
+		return nil, &shared.InternalServiceError{
+			Message: fmt.Sprintf("ScanWorkflowExecutions failed. Unable to serialize next page token: %v", err),
+		}
+	}
+	return resp, nil
+}
+
 func (v *visibilityManagerImpl) convertInternalGetResponse(internalResp *InternalGetClosedWorkflowExecutionResponse) *GetClosedWorkflowExecutionResponse {
 	if internalResp == nil {
 		return nil
@@ -122,6 +122,16 @@ func (v *visibilityManagerWrapper) DeleteWorkflowExecution(request *VisibilityDe
 	return v.visibilityManager.DeleteWorkflowExecution(request)
 }
 
+func (v *visibilityManagerWrapper) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.CountWorkflowExecutions(request)
+}
+
+func (v *visibilityManagerWrapper) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ScanWorkflowExecutions(request)
+}
+
 func (v *visibilityManagerWrapper) chooseVisibilityManagerForDomain(domain string) VisibilityManager {
 	var visibilityMgr VisibilityManager
 	if v.enableReadVisibilityFromES(domain) && v.esVisibilityManager != nil {
@@ -43,6 +43,11 @@ const (
 	// ClientImplHeaderName refers to the name of the
 	// header that contains the client implementation
 	ClientImplHeaderName = "cadence-client-name"
+
+	// AuthorizationTokenHeaderName refers to the name of the
+	// header that contains the token identifying the caller
+	// to the authorizer
+	AuthorizationTokenHeaderName = "cadence-authorization-token"
 )
 
 type (
@@ -0,0 +1,208 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattribute
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/clock"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// number of times Add re-reads and re-applies the change when it races with another update
+	addSearchAttributesMaxAttempts = 3
+)
+
+var keyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+type (
+	// Registry keeps track of the search attribute keys registered for the cluster and
+	// the type of their values. Registered keys are persisted in cluster metadata.
+	Registry interface {
+		// GetSearchAttributes returns the registered keys and their types, the returned map must not be modified
+		GetSearchAttributes() (map[string]gen.IndexedValueType, error)
+		// AddSearchAttributes registers new keys, registering an existing key again with the same type is a no-op
+		AddSearchAttributes(attributes map[string]gen.IndexedValueType) error
+	}
+
+	registryImpl struct {
+		clusterMetadataMgr persistence.ClusterMetadataManager
+		refreshInterval    dynamicconfig.DurationPropertyFn
+		timeSource         clock.TimeSource
+		logger             log.Logger
+
+		// addLock serializes AddSearchAttributes calls of this registry, it is held across persistence calls
+		addLock sync.Mutex
+
+		// cacheLock only guards the cached fields below and is never held across persistence calls
+		cacheLock   sync.Mutex
+		attributes  map[string]gen.IndexedValueType
+		version     int64
+		lastRefresh time.Time
+	}
+)
+
+// NewRegistry creates a Registry which caches the persisted search attributes for refreshInterval
+func NewRegistry(
+	clusterMetadataMgr persistence.ClusterMetadataManager,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+) Registry {
+	return &registryImpl{
+		clusterMetadataMgr: clusterMetadataMgr,
+		refreshInterval:    refreshInterval,
+		timeSource:         clock.NewRealTimeSource(),
+		logger:             logger,
+	}
+}
+
+func (r *registryImpl) GetSearchAttributes() (map[string]gen.IndexedValueType, error) {
+	r.cacheLock.Lock()
+	if r.attributes != nil && r.timeSource.Now().Sub(r.lastRefresh) < r.refreshInterval() {
+		attributes := r.attributes
+		r.cacheLock.Unlock()
+		return attributes, nil
+	}
+	r.cacheLock.Unlock()
+
+	resp, err := r.clusterMetadataMgr.GetSearchAttributes()
+	if err != nil {
+		return nil, err
+	}
+	return r.updateCache(resp.SearchAttributes, resp.Version), nil
+}
+
+func (r *registryImpl) AddSearchAttributes(attributes map[string]gen.IndexedValueType) error {
+	if len(attributes) == 0 {
+		return &gen.BadRequestError{Message: "No search attribute to add."}
+	}
+	for _, key := range sortedKeys(attributes) {
+		if err := validateNewAttribute(key, attributes[key]); err != nil {
+			return err
+		}
+	}
+
+	r.addLock.Lock()
+	defer r.addLock.Unlock()
+
+	for attempt := 0; attempt < addSearchAttributesMaxAttempts; attempt++ {
+		// always merge into the persisted state, the cached one may be stale
+		resp, err := r.clusterMetadataMgr.GetSearchAttributes()
+		if err != nil {
+			return err
+		}
+		r.updateCache(resp.SearchAttributes, resp.Version)
+
+		merged := make(map[string]gen.IndexedValueType, len(resp.SearchAttributes)+len(attributes))
+		for key, valueType := range resp.SearchAttributes {
+			merged[key] = valueType
+		}
+		changed := false
+		for _, key := range sortedKeys(attributes) {
+			valueType := attributes[key]
+			existing, ok := merged[key]
+			if !ok {
+				merged[key] = valueType
+				changed = true
+				continue
+			}
+			if existing != valueType {
+				return &gen.BadRequestError{
+					Message: fmt.Sprintf("Search attribute %v is already registered with type %v, cannot change it to %v.", key, existing, valueType),
+				}
+			}
+		}
+		if !changed {
+			return nil
+		}
+
+		err = r.clusterMetadataMgr.UpdateSearchAttributes(&persistence.UpdateSearchAttributesRequest{
+			SearchAttributes: merged,
+			PrevVersion:      resp.Version,
+		})
+		if err == nil {
+			r.updateCache(merged, resp.Version+1)
+			return nil
+		}
+		if _, ok := err.(*persistence.ConditionFailedError); !ok {
+			return err
+		}
+		r.logger.Info("Search attributes were updated concurrently, retrying.", tag.Attempt(int32(attempt)))
+	}
+
+	return &gen.ServiceBusyError{Message: "Search attributes are being updated concurrently, please retry."}
+}
+
+// updateCache caches the given persisted state unless a newer version was cached by a concurrent
+// call in the meantime, and returns the cached attributes
+func (r *registryImpl) updateCache(attributes map[string]gen.IndexedValueType, version int64) map[string]gen.IndexedValueType {
+	if attributes == nil {
+		attributes = make(map[string]gen.IndexedValueType)
+	}
+
+	r.cacheLock.Lock()
+	defer r.cacheLock.Unlock()
+
+	if r.attributes == nil || version >= r.version {
+		r.attributes = attributes
+		r.version = version
+		r.lastRefresh = r.timeSource.Now()
+	}
+	return r.attributes
+}
+
+func validateNewAttribute(key string, valueType gen.IndexedValueType) error {
+	if !keyPattern.MatchString(key) {
+		return &gen.BadRequestError{
+			Message: fmt.Sprintf("Invalid search attribute key %q, it must start with a letter and contain only letters, digits and underscores.", key),
+		}
+	}
+	if es.IsFieldNameValid(key) {
+		return &gen.BadRequestError{
+			Message: fmt.Sprintf("Search attribute key %v is reserved by the system.", key),
+		}
+	}
+	if _, ok := valueTypes[valueType]; !ok {
+		return &gen.BadRequestError{
+			Message: fmt.Sprintf("Unknown type %v for search attribute %v.", int32(valueType), key),
+		}
+	}
+	return nil
+}
+
+func sortedKeys(attributes map[string]gen.IndexedValueType) []string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
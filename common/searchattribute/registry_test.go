@@ -0,0 +1,193 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattribute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/memory"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	registrySuite struct {
+		suite.Suite
+		*require.Assertions
+
+		clusterMetadataMgr persistence.ClusterMetadataManager
+		timeSource         *clock.EventTimeSource
+		registry           *registryImpl
+	}
+)
+
+func TestRegistrySuite(t *testing.T) {
+	suite.Run(t, new(registrySuite))
+}
+
+func (s *registrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	logger := loggerimpl.NewNopLogger()
+	factory := memory.NewFactory(config.InMemory{Name: uuid.New()}, "active", logger)
+	var err error
+	s.clusterMetadataMgr, err = factory.NewClusterMetadataStore()
+	s.NoError(err)
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.registry = NewRegistry(s.clusterMetadataMgr, dynamicconfig.GetDurationPropertyFn(time.Minute), logger).(*registryImpl)
+	s.registry.timeSource = s.timeSource
+}
+
+func (s *registrySuite) TestAddSearchAttributes() {
+	attributes, err := s.registry.GetSearchAttributes()
+	s.NoError(err)
+	s.Empty(attributes)
+
+	err = s.registry.AddSearchAttributes(map[string]gen.IndexedValueType{
+		"CustomKeywordField": gen.IndexedValueTypeKeyword,
+		"CustomIntField":     gen.IndexedValueTypeInt,
+	})
+	s.NoError(err)
+	err = s.registry.AddSearchAttributes(map[string]gen.IndexedValueType{
+		"CustomIntField":  gen.IndexedValueTypeInt,
+		"CustomBoolField": gen.IndexedValueTypeBool,
+	})
+	s.NoError(err)
+
+	expected := map[string]gen.IndexedValueType{
+		"CustomKeywordField": gen.IndexedValueTypeKeyword,
+		"CustomIntField":     gen.IndexedValueTypeInt,
+		"CustomBoolField":    gen.IndexedValueTypeBool,
+	}
+	attributes, err = s.registry.GetSearchAttributes()
+	s.NoError(err)
+	s.Equal(expected, attributes)
+
+	resp, err := s.clusterMetadataMgr.GetSearchAttributes()
+	s.NoError(err)
+	s.Equal(expected, resp.SearchAttributes)
+	s.Equal(int64(2), resp.Version)
+}
+
+func (s *registrySuite) TestAddSearchAttributes_TypeMismatch() {
+	err := s.registry.AddSearchAttributes(map[string]gen.IndexedValueType{
+		"CustomIntField": gen.IndexedValueTypeInt,
+	})
+	s.NoError(err)
+
+	err = s.registry.AddSearchAttributes(map[string]gen.IndexedValueType{
+		"CustomIntField":  gen.IndexedValueTypeString,
+		"CustomBoolField": gen.IndexedValueTypeBool,
+	})
+	s.IsType(&gen.BadRequestError{}, err)
+
+	// a rejected request must not register any of its keys
+	attributes, err := s.registry.GetSearchAttributes()
+	s.NoError(err)
+	s.Equal(map[string]gen.IndexedValueType{"CustomIntField": gen.IndexedValueTypeInt}, attributes)
+}
+
+func (s *registrySuite) TestAddSearchAttributes_InvalidKey() {
+	for _, key := range []string{"", "1Field", "Custom-Field", "Custom Field", "WorkflowID", "CloseTime"} {
+		err := s.registry.AddSearchAttributes(map[string]gen.IndexedValueType{key: gen.IndexedValueTypeString})
+		s.IsType(&gen.BadRequestError{}, err, key)
+	}
+
+	err := s.registry.AddSearchAttributes(map[string]gen.IndexedValueType{"CustomField": gen.IndexedValueType(100)})
+	s.IsType(&gen.BadRequestError{}, err)
+
+	err = s.registry.AddSearchAttributes(nil)
+	s.IsType(&gen.BadRequestError{}, err)
+}
+
+func (s *registrySuite) TestAddSearchAttributes_ConcurrentUpdate() {
+	_, err := s.registry.GetSearchAttributes()
+	s.NoError(err)
+
+	// another frontend registers a key, this registry still has the old version cached
+	err = s.clusterMetadataMgr.UpdateSearchAttributes(&persistence.UpdateSearchAttributesRequest{
+		SearchAttributes: map[string]gen.IndexedValueType{"CustomStringField": gen.IndexedValueTypeString},
+		PrevVersion:      0,
+	})
+	s.NoError(err)
+
+	err = s.registry.AddSearchAttributes(map[string]gen.IndexedValueType{"CustomDatetimeField": gen.IndexedValueTypeDatetime})
+	s.NoError(err)
+
+	resp, err := s.clusterMetadataMgr.GetSearchAttributes()
+	s.NoError(err)
+	s.Equal(map[string]gen.IndexedValueType{
+		"CustomStringField":   gen.IndexedValueTypeString,
+		"CustomDatetimeField": gen.IndexedValueTypeDatetime,
+	}, resp.SearchAttributes)
+}
+
+func (s *registrySuite) TestGetSearchAttributes_Refresh() {
+	attributes, err := s.registry.GetSearchAttributes()
+	s.NoError(err)
+	s.Empty(attributes)
+
+	err = s.clusterMetadataMgr.UpdateSearchAttributes(&persistence.UpdateSearchAttributesRequest{
+		SearchAttributes: map[string]gen.IndexedValueType{"CustomStringField": gen.IndexedValueTypeString},
+		PrevVersion:      0,
+	})
+	s.NoError(err)
+
+	// served from cache until the refresh interval elapses
+	attributes, err = s.registry.GetSearchAttributes()
+	s.NoError(err)
+	s.Empty(attributes)
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	attributes, err = s.registry.GetSearchAttributes()
+	s.NoError(err)
+	s.Equal(map[string]gen.IndexedValueType{"CustomStringField": gen.IndexedValueTypeString}, attributes)
+}
+
+func (s *registrySuite) TestGetSearchAttributes_StaleReadDoesNotOverwriteCache() {
+	err := s.registry.AddSearchAttributes(map[string]gen.IndexedValueType{"CustomIntField": gen.IndexedValueTypeInt})
+	s.NoError(err)
+
+	// a read which started before the add completed must not roll the cache back
+	attributes := s.registry.updateCache(nil, 0)
+	s.Equal(map[string]gen.IndexedValueType{"CustomIntField": gen.IndexedValueTypeInt}, attributes)
+}
+
+func (s *registrySuite) TestGetSearchAttributes_NotBlockedByAdd() {
+	_, err := s.registry.GetSearchAttributes()
+	s.NoError(err)
+
+	// an add in progress holds its lock across persistence calls, cached reads must not wait for it
+	s.registry.addLock.Lock()
+	defer s.registry.addLock.Unlock()
+	attributes, err := s.registry.GetSearchAttributes()
+	s.NoError(err)
+	s.Empty(attributes)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattribute
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+)
+
+// max number of bytes of an invalid value quoted back in the error message
+const maxQuotedValueLength = 64
+
+// valueTypes maps every supported type to the check run against JSON encoded values of that type
+var valueTypes = map[gen.IndexedValueType]func(value []byte) bool{
+	gen.IndexedValueTypeString:   isString,
+	gen.IndexedValueTypeKeyword:  isString,
+	gen.IndexedValueTypeInt:      isInt,
+	gen.IndexedValueTypeBool:     isBool,
+	gen.IndexedValueTypeDatetime: isDatetime,
+}
+
+// Validator checks the search attributes sent with workflow requests against a Registry
+type Validator struct {
+	registry Registry
+}
+
+// NewValidator creates a Validator
+func NewValidator(registry Registry) *Validator {
+	return &Validator{
+		registry: registry,
+	}
+}
+
+// ValidateSearchAttributes returns a BadRequestError if a key is not registered or if its
+// value is not the JSON encoding of a value of the registered type
+func (v *Validator) ValidateSearchAttributes(input *gen.SearchAttributes) error {
+	fields := input.GetIndexedFields()
+	if len(fields) == 0 {
+		return nil
+	}
+
+	registered, err := v.registry.GetSearchAttributes()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		valueType, ok := registered[key]
+		if !ok {
+			return &gen.BadRequestError{
+				Message: fmt.Sprintf("Search attribute %v is not registered.", key),
+			}
+		}
+		if isValid, ok := valueTypes[valueType]; !ok || !isValid(fields[key]) {
+			return &gen.BadRequestError{
+				Message: fmt.Sprintf("Invalid value for search attribute %v of type %v: %s.", key, valueType, quote(fields[key])),
+			}
+		}
+	}
+	return nil
+}
+
+func isString(value []byte) bool {
+	// null is accepted by Unmarshal but is not a value of any type
+	var s *string
+	return json.Unmarshal(value, &s) == nil && s != nil
+}
+
+func isInt(value []byte) bool {
+	var i *int64
+	return json.Unmarshal(value, &i) == nil && i != nil
+}
+
+func isBool(value []byte) bool {
+	var b *bool
+	return json.Unmarshal(value, &b) == nil && b != nil
+}
+
+func isDatetime(value []byte) bool {
+	var s *string
+	if err := json.Unmarshal(value, &s); err != nil || s == nil {
+		return false
+	}
+	_, err := time.Parse(time.RFC3339, *s)
+	return err == nil
+}
+
+func quote(value []byte) string {
+	if len(value) > maxQuotedValueLength {
+		return string(value[:maxQuotedValueLength]) + "..."
+	}
+	return string(value)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattribute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence/memory"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	validatorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		validator *Validator
+	}
+)
+
+func TestValidatorSuite(t *testing.T) {
+	suite.Run(t, new(validatorSuite))
+}
+
+func (s *validatorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	logger := loggerimpl.NewNopLogger()
+	factory := memory.NewFactory(config.InMemory{Name: uuid.New()}, "active", logger)
+	clusterMetadataMgr, err := factory.NewClusterMetadataStore()
+	s.NoError(err)
+
+	registry := NewRegistry(clusterMetadataMgr, dynamicconfig.GetDurationPropertyFn(time.Minute), logger)
+	err = registry.AddSearchAttributes(map[string]gen.IndexedValueType{
+		"CustomStringField":   gen.IndexedValueTypeString,
+		"CustomKeywordField":  gen.IndexedValueTypeKeyword,
+		"CustomIntField":      gen.IndexedValueTypeInt,
+		"CustomBoolField":     gen.IndexedValueTypeBool,
+		"CustomDatetimeField": gen.IndexedValueTypeDatetime,
+	})
+	s.NoError(err)
+	s.validator = NewValidator(registry)
+}
+
+func (s *validatorSuite) TestValidateSearchAttributes() {
+	s.NoError(s.validator.ValidateSearchAttributes(nil))
+	s.NoError(s.validator.ValidateSearchAttributes(&gen.SearchAttributes{}))
+
+	err := s.validator.ValidateSearchAttributes(&gen.SearchAttributes{
+		IndexedFields: map[string][]byte{
+			"CustomStringField":   []byte(`"some text"`),
+			"CustomKeywordField":  []byte(`"keyword"`),
+			"CustomIntField":      []byte(`-42`),
+			"CustomBoolField":     []byte(`true`),
+			"CustomDatetimeField": []byte(`"2019-03-01T10:00:00Z"`),
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *validatorSuite) TestValidateSearchAttributes_NotRegistered() {
+	err := s.validator.ValidateSearchAttributes(&gen.SearchAttributes{
+		IndexedFields: map[string][]byte{
+			"UnknownField": []byte(`"value"`),
+		},
+	})
+	s.Equal(&gen.BadRequestError{Message: "Search attribute UnknownField is not registered."}, err)
+}
+
+func (s *validatorSuite) TestValidateSearchAttributes_TypeMismatch() {
+	invalid := map[string][]string{
+		"CustomStringField":   {`1`, `true`, `null`, `not json`},
+		"CustomKeywordField":  {`["a"]`, `null`},
+		"CustomIntField":      {`"1"`, `1.5`, `true`, `null`},
+		"CustomBoolField":     {`"true"`, `1`, `null`},
+		"CustomDatetimeField": {`1551434400`, `"2019-03-01"`, `"yesterday"`, `null`},
+	}
+	for key, values := range invalid {
+		for _, value := range values {
+			err := s.validator.ValidateSearchAttributes(&gen.SearchAttributes{
+				IndexedFields: map[string][]byte{key: []byte(value)},
+			})
+			s.IsType(&gen.BadRequestError{}, err, "%v: %v", key, value)
+		}
+	}
+
+	err := s.validator.ValidateSearchAttributes(&gen.SearchAttributes{
+		IndexedFields: map[string][]byte{"CustomIntField": []byte(`"1"`)},
+	})
+	s.Equal(&gen.BadRequestError{Message: `Invalid value for search attribute CustomIntField of type INT: "1".`}, err)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/authorization"
+)
+
+// NewAuthorizer returns the authorizer described by the config
+func (cfg *Authorization) NewAuthorizer() (authorization.Authorizer, error) {
+	var authorizers []authorization.Authorizer
+	if len(cfg.TaskListGrants) > 0 {
+		grants := make([]authorization.TaskListGrant, 0, len(cfg.TaskListGrants))
+		for _, grant := range cfg.TaskListGrants {
+			grants = append(grants, authorization.TaskListGrant{
+				Token:      grant.Token,
+				DomainName: grant.Domain,
+				TaskLists:  grant.TaskLists,
+			})
+		}
+		authorizers = append(authorizers, authorization.NewTaskListAuthorizer(grants))
+	}
+	if len(cfg.RoleGrants) > 0 {
+		grants := make([]authorization.RoleGrant, 0, len(cfg.RoleGrants))
+		for _, grant := range cfg.RoleGrants {
+			role, ok := authorization.ParseRole(grant.Role)
+			if !ok {
+				return nil, fmt.Errorf("unknown role %q granted in domain %v", grant.Role, grant.Domain)
+			}
+			grants = append(grants, authorization.RoleGrant{
+				Token:      grant.Token,
+				DomainName: grant.Domain,
+				Role:       role,
+			})
+		}
+		authorizers = append(authorizers, authorization.NewRoleAuthorizer(grants))
+	}
+
+	switch len(authorizers) {
+	case 0:
+		return authorization.NewNopAuthorizer(), nil
+	case 1:
+		return authorizers[0], nil
+	default:
+		return authorization.NewMultiAuthorizer(authorizers...), nil
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
 	"github.com/uber/ringpop-go/discovery"
 )
 
@@ -56,6 +57,10 @@ type (
 		ElasticSearch elasticsearch.Config `yaml:"elasticsearch"`
 		// PublicClient is config for connecting to cadence frontend
 		PublicClient PublicClient `yaml:"publicClient"`
+		// Authorization is the config for authorizing frontend requests
+		Authorization Authorization `yaml:"authorization"`
+		// Tracing is the config for the tracer shared by the services, tracing is disabled when not set
+		Tracing Tracing `yaml:"tracing"`
 	}
 
 	// Service contains the service specific config items
@@ -66,6 +71,14 @@ type (
 		Metrics Metrics `yaml:"metrics"`
 		// PProf is the PProf configuration
 		PProf PProf `yaml:"pprof"`
+		// HTTPGateway is the HTTP/JSON gateway configuration, only used by the frontend
+		HTTPGateway HTTPGateway `yaml:"httpGateway"`
+	}
+
+	// Tracing contains the tracing config items
+	Tracing struct {
+		// Jaeger is the jaeger client config, the spans are reported to the configured jaeger agent
+		Jaeger *jaegercfg.Configuration `yaml:"jaeger"`
 	}
 
 	// PProf contains the rpc config items
@@ -74,10 +87,18 @@ type (
 		Port int `yaml:"port"`
 	}
 
+	// HTTPGateway contains the HTTP/JSON gateway config items
+	HTTPGateway struct {
+		// Port is the port on which the gateway will bind to, the gateway is disabled when not set
+		Port int `yaml:"port"`
+	}
+
 	// RPC contains the rpc config items
 	RPC struct {
 		// Port is the port  on which the channel will bind to
 		Port int `yaml:"port"`
+		// GRPCPort is the port on which the gRPC inbound will bind to, gRPC is disabled when not set
+		GRPCPort int `yaml:"grpcPort"`
 		// BindOnLocalHost is true if localhost is the bind address
 		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
 		// BindOnIP can be used to bind service on specific ip (eg. `0.0.0.0`) -
@@ -115,6 +136,9 @@ type (
 		// HistoryMaxConns is the desired number of conns to history store. Value specified
 		// here overrides the MaxConns config specified as part of datastore
 		HistoryMaxConns int `yaml:"historyMaxConns"`
+		// ExecutionMaxQPSPerShard is the max QPS of the execution store requests of a single
+		// history shard, enforced in addition to the MaxQPS of the datastore, optional
+		ExecutionMaxQPSPerShard int `yaml:"executionMaxQPSPerShard"`
 		// NumHistoryShards is the desired number of history shards. This config doesn't
 		// belong here, needs refactoring
 		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
@@ -122,6 +146,49 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// VisibilityConfig is config for visibility sampling
 		VisibilityConfig *VisibilityConfig
+		// Encryption is the config for encrypting payloads at rest, optional
+		Encryption *Encryption `yaml:"encryption"`
+		// ClaimCheck is the config for storing large history event payloads externally, optional
+		ClaimCheck *ClaimCheck `yaml:"claimCheck"`
+		// Shadow is the config for mirroring the requests served by the default store to a
+		// second datastore, optional
+		Shadow *PersistenceShadow `yaml:"shadow"`
+	}
+
+	// PersistenceShadow is the configuration for mirroring shard, task list, domain, execution and
+	// history requests to a second datastore, which verifies it before migrating to it. Writes are
+	// mirrored asynchronously and sampled reads are compared, divergence is reported as metrics
+	PersistenceShadow struct {
+		// Store is the name of the datastore requests are mirrored to
+		Store string `yaml:"store"`
+		// ReadSampleRate is the ratio of reads compared between the two datastores
+		ReadSampleRate float64 `yaml:"readSampleRate"`
+		// QueueSize is the number of mirrored requests buffered per store, defaults to 1000
+		QueueSize int `yaml:"queueSize"`
+	}
+
+	// Encryption is the configuration for encrypting history and execution payloads at rest
+	Encryption struct {
+		// Keys are the base64 encoded AES keys by key id, keys which were used
+		// to encrypt existing payloads must be kept to be able to read them
+		Keys map[string]string `yaml:"keys"`
+		// DomainKeys is the id of the key used to encrypt new payloads by domain id
+		DomainKeys map[string]string `yaml:"domainKeys"`
+		// DefaultKey is the id of the key used for domains without an assigned key,
+		// payloads of such domains are not encrypted if empty
+		DefaultKey string `yaml:"defaultKey"`
+	}
+
+	// ClaimCheck is the configuration for moving large history event payloads to the blobstore of the cluster,
+	// the events keep a reference to the payload which is resolved when history is read
+	ClaimCheck struct {
+		// Bucket is the blobstore bucket the payloads are written to, they are deleted with the history
+		// they belong to so the bucket must not expire them before
+		Bucket string `yaml:"bucket"`
+		// Thresholds is the size in bytes above which payloads are stored externally by payload type
+		Thresholds map[string]int `yaml:"thresholds"`
+		// DomainThresholds overrides Thresholds by domain id
+		DomainThresholds map[string]map[string]int `yaml:"domainThresholds"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -130,6 +197,8 @@ type (
 		Cassandra *Cassandra `yaml:"cassandra"`
 		// SQL contains the config for a SQL based datastore
 		SQL *SQL `yaml:"sql"`
+		// InMemory contains the config for a datastore kept in the memory of the process
+		InMemory *InMemory `yaml:"inMemory"`
 	}
 
 	// VisibilityConfig is config for visibility sampling
@@ -197,6 +266,14 @@ type (
 		NumShards int `yaml:"nShards"`
 	}
 
+	// InMemory is the configuration for a datastore kept in the memory of the process,
+	// it does not survive a restart and is only meant for local development and tests
+	InMemory struct {
+		// Name identifies the data set, all the datastores of a process with the
+		// same name share their data
+		Name string `yaml:"name"`
+	}
+
 	// Replicator describes the configuration of replicator
 	Replicator struct{}
 
@@ -236,6 +313,37 @@ type (
 		RPCAddress string `yaml:"rpcAddress"`
 	}
 
+	// Authorization contains the config for authorizing frontend requests
+	Authorization struct {
+		// TaskListGrants restricts the task lists which may be polled with each token,
+		// polls are not restricted if empty
+		TaskListGrants []TaskListGrant `yaml:"taskListGrants"`
+		// RoleGrants restricts the operations which may be performed in a domain with each token,
+		// operations are not restricted if empty
+		RoleGrants []RoleGrant `yaml:"roleGrants"`
+	}
+
+	// RoleGrant grants a role in a domain to the callers presenting the token
+	RoleGrant struct {
+		// Token is the value of the authorization token header sent by the callers
+		Token string `yaml:"token"`
+		// Domain is the name of the domain, "*" grants the role in every domain.
+		// Registering a domain needs the admin role in "*"
+		Domain string `yaml:"domain"`
+		// Role is one of read, write or admin
+		Role string `yaml:"role"`
+	}
+
+	// TaskListGrant allows the callers presenting the token to poll task lists of a domain
+	TaskListGrant struct {
+		// Token is the value of the authorization token header sent by the workers
+		Token string `yaml:"token"`
+		// Domain is the name of the domain
+		Domain string `yaml:"domain"`
+		// TaskLists are the task lists which may be polled, empty means all task lists of the domain
+		TaskLists []string `yaml:"taskLists"`
+	}
+
 	// DCRedirectionPolicy contains the frontend datacenter redirection policy
 	DCRedirectionPolicy struct {
 		Policy string `yaml:"policy"`
@@ -20,13 +20,19 @@
 
 package config
 
-import "fmt"
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
 
 const (
 	// StoreTypeSQL refers to sql based storage as persistence store
 	StoreTypeSQL = "sql"
 	// StoreTypeCassandra refers to cassandra as persistence store
 	StoreTypeCassandra = "cassandra"
+	// StoreTypeInMemory refers to the memory of the process as persistence store
+	StoreTypeInMemory = "memory"
 )
 
 // SetMaxQPS sets the MaxQPS value for the given datastore
@@ -39,7 +45,9 @@ func (c *Persistence) SetMaxQPS(key string, qps int) {
 		ds.Cassandra.MaxQPS = qps
 		return
 	}
-	ds.SQL.MaxQPS = qps
+	if ds.SQL != nil {
+		ds.SQL.MaxQPS = qps
+	}
 }
 
 // DefaultStoreType returns the storeType for the default persistence store
@@ -47,6 +55,9 @@ func (c *Persistence) DefaultStoreType() string {
 	if c.DataStores[c.DefaultStore].SQL != nil {
 		return StoreTypeSQL
 	}
+	if c.DataStores[c.DefaultStore].InMemory != nil {
+		return StoreTypeInMemory
+	}
 	return StoreTypeCassandra
 }
 
@@ -58,15 +69,117 @@ func (c *Persistence) Validate() error {
 		if !ok {
 			return fmt.Errorf("persistence config: missing config for datastore %v", st)
 		}
-		if ds.SQL == nil && ds.Cassandra == nil {
-			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra or sql stores", st)
-		}
-		if ds.SQL != nil && ds.Cassandra != nil {
-			return fmt.Errorf("persistence config: datastore %v: only one of SQL or cassandra can be specified", st)
+		switch ds.numStores() {
+		case 0:
+			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra, sql or inMemory stores", st)
+		case 1:
+		default:
+			return fmt.Errorf("persistence config: datastore %v: only one of SQL, cassandra or inMemory can be specified", st)
 		}
 		if ds.SQL != nil && ds.SQL.NumShards == 0 {
 			ds.SQL.NumShards = 1
 		}
 	}
+	if c.Encryption != nil {
+		if err := c.Encryption.validate(); err != nil {
+			return fmt.Errorf("persistence config: encryption: %v", err)
+		}
+	}
+	if c.ClaimCheck != nil {
+		if err := c.ClaimCheck.validate(); err != nil {
+			return fmt.Errorf("persistence config: claimCheck: %v", err)
+		}
+	}
+	if c.Shadow != nil {
+		if err := c.Shadow.validate(c); err != nil {
+			return fmt.Errorf("persistence config: shadow: %v", err)
+		}
+	}
+	return nil
+}
+
+func (s *PersistenceShadow) validate(c *Persistence) error {
+	ds, ok := c.DataStores[s.Store]
+	if !ok {
+		return fmt.Errorf("missing config for datastore %v", s.Store)
+	}
+	if s.Store == c.DefaultStore {
+		return fmt.Errorf("datastore %v is the default store", s.Store)
+	}
+	if ds.numStores() != 1 {
+		return fmt.Errorf("datastore %v: exactly one of cassandra, sql or inMemory must be specified", s.Store)
+	}
+	if ds.SQL != nil && ds.SQL.NumShards == 0 {
+		ds.SQL.NumShards = 1
+	}
+	if s.ReadSampleRate < 0 || s.ReadSampleRate > 1 {
+		return fmt.Errorf("readSampleRate must be between 0 and 1")
+	}
+	return nil
+}
+
+func (ds DataStore) numStores() int {
+	n := 0
+	if ds.Cassandra != nil {
+		n++
+	}
+	if ds.SQL != nil {
+		n++
+	}
+	if ds.InMemory != nil {
+		n++
+	}
+	return n
+}
+
+// DecodeKeys returns the encryption keys by key id
+func (e *Encryption) DecodeKeys() (map[string][]byte, error) {
+	keys := make(map[string][]byte, len(e.Keys))
+	for keyID, encoded := range e.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %v is not base64 encoded: %v", keyID, err)
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("key %v must be 16, 24 or 32 bytes long", keyID)
+		}
+		keys[keyID] = key
+	}
+	return keys, nil
+}
+
+func (e *Encryption) validate() error {
+	if _, err := e.DecodeKeys(); err != nil {
+		return err
+	}
+	if _, ok := e.Keys[e.DefaultKey]; e.DefaultKey != "" && !ok {
+		return fmt.Errorf("missing default key %v", e.DefaultKey)
+	}
+	for domainID, keyID := range e.DomainKeys {
+		if _, ok := e.Keys[keyID]; keyID != "" && !ok {
+			return fmt.Errorf("missing key %v of domain %v", keyID, domainID)
+		}
+	}
+	return nil
+}
+
+func (c *ClaimCheck) validate() error {
+	if c.Bucket == "" {
+		return errors.New("bucket is required")
+	}
+	for payloadType, threshold := range c.Thresholds {
+		if threshold < 0 {
+			return fmt.Errorf("negative threshold for %v", payloadType)
+		}
+	}
+	for domainID, thresholds := range c.DomainThresholds {
+		for payloadType, threshold := range thresholds {
+			if threshold < 0 {
+				return fmt.Errorf("negative threshold for %v of domain %v", payloadType, domainID)
+			}
+		}
+	}
 	return nil
 }
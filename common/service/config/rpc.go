@@ -27,6 +27,8 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
@@ -61,12 +63,33 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 		d.logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
 	d.logger.Info("Created RPC dispatcher and listening", tag.Service(d.serviceName), tag.Address(hostAddress))
+	inbounds := yarpc.Inbounds{d.ch.NewInbound()}
+	if d.config.GRPCPort > 0 {
+		inbounds = append(inbounds, d.createGRPCInbound())
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     d.serviceName,
-		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
+		Inbounds: inbounds,
 	})
 }
 
+// createGRPCInbound creates an inbound serving the same procedures over gRPC,
+// so clients without TChannel support can talk to the service directly
+func (d *RPCFactory) createGRPCInbound() transport.Inbound {
+	grpcAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.GRPCPort)
+	listener, err := net.Listen("tcp", grpcAddress)
+	if err != nil {
+		d.logger.Fatal("Failed to listen on gRPC port", tag.Error(err))
+	}
+	d.logger.Info("Created gRPC inbound and listening", tag.Service(d.serviceName), tag.Address(grpcAddress))
+	return grpc.NewTransport().NewInbound(listener)
+}
+
+// GetListenAddress returns the address to listen on the given port, on the IP the RPC channel binds to
+func (d *RPCFactory) GetListenAddress(port int) string {
+	return fmt.Sprintf("%v:%v", d.getListenIP(), port)
+}
+
 // CreateDispatcherForOutbound creates a dispatcher for outbound connection
 func (d *RPCFactory) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
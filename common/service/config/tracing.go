@@ -0,0 +1,46 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"io"
+	"io/ioutil"
+
+	"github.com/opentracing/opentracing-go"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
+)
+
+// defaultTracingServiceName is the service name reported to the tracing backend when the
+// config does not set one, all services started by the process share the global tracer
+const defaultTracingServiceName = "cadence"
+
+// NewTracer builds the tracer described by the config, a noop tracer is returned when
+// tracing is not configured
+func (t *Tracing) NewTracer() (opentracing.Tracer, io.Closer, error) {
+	if t.Jaeger == nil {
+		return opentracing.NoopTracer{}, ioutil.NopCloser(nil), nil
+	}
+	cfg := *t.Jaeger
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = defaultTracingServiceName
+	}
+	return cfg.NewTracer()
+}
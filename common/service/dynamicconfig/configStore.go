@@ -0,0 +1,196 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrConfigStoreVersionMismatch is returned by ConfigStore.Store when the config was changed since it was loaded
+	ErrConfigStoreVersionMismatch = errors.New("dynamic config was changed concurrently")
+)
+
+type (
+	// ConfigStore persists the versioned dynamic config, so that all the hosts of the cluster share it
+	ConfigStore interface {
+		// Load returns the encoded config and the version of the store, nil and 0 if nothing was stored yet
+		Load() ([]byte, int64, error)
+		// Store replaces the encoded config and bumps the version of the store,
+		// it returns ErrConfigStoreVersionMismatch if the version is no longer prevVersion
+		Store(config []byte, prevVersion int64) error
+	}
+
+	// storedConfig is the encoding of the config versions and their audit records in the ConfigStore,
+	// keys and filters are stored by name so that the encoding does not depend on the order of the constants
+	storedConfig struct {
+		LatestVersion int64
+		Snapshots     []*storedSnapshot
+		Changes       []*storedChangeRecord
+	}
+
+	storedSnapshot struct {
+		Version int64
+		Values  map[string][]*storedValue
+	}
+
+	storedValue struct {
+		Filters map[string]string `json:",omitempty"`
+		Value   interface{}
+	}
+
+	storedChangeRecord struct {
+		Version     int64
+		PrevVersion int64
+		RollbackTo  int64             `json:",omitempty"`
+		Key         string            `json:",omitempty"`
+		Filters     map[string]string `json:",omitempty"`
+		OldValue    interface{}       `json:",omitempty"`
+		NewValue    interface{}       `json:",omitempty"`
+		ChangedBy   string
+		Reason      string
+		Timestamp   time.Time
+	}
+)
+
+func encodeConfigState(state *configState) ([]byte, error) {
+	stored := &storedConfig{
+		LatestVersion: state.latestVersion,
+		Snapshots:     make([]*storedSnapshot, 0, len(state.snapshots)),
+		Changes:       make([]*storedChangeRecord, 0, len(state.changes)),
+	}
+	for _, snapshot := range state.snapshots {
+		values := make(map[string][]*storedValue, len(snapshot.Values))
+		for key, cvs := range snapshot.Values {
+			for _, cv := range cvs {
+				values[key.String()] = append(values[key.String()], &storedValue{
+					Filters: encodeFilters(cv.Filters),
+					Value:   cv.Value,
+				})
+			}
+		}
+		stored.Snapshots = append(stored.Snapshots, &storedSnapshot{Version: snapshot.Version, Values: values})
+	}
+	for _, record := range state.changes {
+		storedRecord := &storedChangeRecord{
+			Version:     record.Version,
+			PrevVersion: record.PrevVersion,
+			RollbackTo:  record.RollbackTo,
+			Filters:     encodeFilters(record.Filters),
+			OldValue:    record.OldValue,
+			NewValue:    record.NewValue,
+			ChangedBy:   record.ChangedBy,
+			Reason:      record.Reason,
+			Timestamp:   record.Timestamp,
+		}
+		// rollbacks are not about a single key
+		if record.Key != unknownKey {
+			storedRecord.Key = record.Key.String()
+		}
+		stored.Changes = append(stored.Changes, storedRecord)
+	}
+	return json.Marshal(stored)
+}
+
+// decodeConfigState fails on keys or filters this host does not know rather than dropping them,
+// so that a host running an older version cannot lose them by writing the config back
+func decodeConfigState(data []byte) (*configState, error) {
+	var stored storedConfig
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to decode dynamic config: %v", err)
+	}
+	if len(stored.Snapshots) == 0 {
+		return nil, errors.New("failed to decode dynamic config: no snapshot")
+	}
+
+	state := &configState{
+		latestVersion: stored.LatestVersion,
+		snapshots:     make([]*Snapshot, 0, len(stored.Snapshots)),
+		changes:       make([]*ChangeRecord, 0, len(stored.Changes)),
+	}
+	for _, storedSnapshot := range stored.Snapshots {
+		snapshot := &Snapshot{Version: storedSnapshot.Version, Values: make(map[Key][]*ConstrainedValue)}
+		for name, storedValues := range storedSnapshot.Values {
+			key, err := ParseKey(name)
+			if err != nil {
+				return nil, err
+			}
+			for _, storedValue := range storedValues {
+				filters, err := decodeFilters(storedValue.Filters)
+				if err != nil {
+					return nil, err
+				}
+				snapshot.Values[key] = append(snapshot.Values[key], &ConstrainedValue{Filters: filters, Value: storedValue.Value})
+			}
+		}
+		state.snapshots = append(state.snapshots, snapshot)
+	}
+	for _, storedRecord := range stored.Changes {
+		filters, err := decodeFilters(storedRecord.Filters)
+		if err != nil {
+			return nil, err
+		}
+		record := &ChangeRecord{
+			Version:     storedRecord.Version,
+			PrevVersion: storedRecord.PrevVersion,
+			RollbackTo:  storedRecord.RollbackTo,
+			Filters:     filters,
+			OldValue:    storedRecord.OldValue,
+			NewValue:    storedRecord.NewValue,
+			ChangedBy:   storedRecord.ChangedBy,
+			Reason:      storedRecord.Reason,
+			Timestamp:   storedRecord.Timestamp,
+		}
+		if storedRecord.Key != "" {
+			if record.Key, err = ParseKey(storedRecord.Key); err != nil {
+				return nil, err
+			}
+		}
+		state.changes = append(state.changes, record)
+	}
+	return state, nil
+}
+
+func encodeFilters(filters map[Filter]interface{}) map[string]string {
+	if len(filters) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(filters))
+	for filter, value := range filters {
+		result[filter.String()] = fmt.Sprintf("%v", value)
+	}
+	return result
+}
+
+func decodeFilters(filters map[string]string) (map[Filter]interface{}, error) {
+	result := make(map[Filter]interface{}, len(filters))
+	for name, value := range filters {
+		filter, filterValue, err := ParseFilter(name, value)
+		if err != nil {
+			return nil, err
+		}
+		result[filter] = filterValue
+	}
+	return result, nil
+}
@@ -57,6 +57,12 @@ var keys = map[Key]string{
 	ArchivalStatus:                      "system.archivalStatus",
 	EnableReadFromArchival:              "system.enableReadFromArchival",
 	EnableDomainNotActiveAutoForwarding: "system.enableDomainNotActiveAutoForwarding",
+	MetricsTaskListTagCardinalityLimit:  "system.metricsTaskListTagCardinalityLimit",
+	MetricsTagOverflowBuckets:           "system.metricsTagOverflowBuckets",
+	TaskTokenSigningKey:                 "system.taskTokenSigningKey",
+	TaskTokenPreviousSigningKeys:        "system.taskTokenPreviousSigningKeys",
+	TaskTokenAcceptUnsigned:             "system.taskTokenAcceptUnsigned",
+	TaskTokenEncoding:                   "system.taskTokenEncoding",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -67,34 +73,50 @@ var keys = map[Key]string{
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 	MaxIDLengthLimit:       "limit.maxIDLength",
 
+	ExecutionContextSizeLimitError: "limit.executionContextSize.error",
+	DecisionCountLimitError:        "limit.decisionCount.error",
+	DecisionFailureAttemptsLimit:   "limit.decisionFailureAttempts",
+
 	// frontend settings
-	FrontendPersistenceMaxQPS:      "frontend.persistenceMaxQPS",
-	FrontendVisibilityMaxPageSize:  "frontend.visibilityMaxPageSize",
-	FrontendVisibilityListMaxQPS:   "frontend.visibilityListMaxQPS",
-	FrontendESVisibilityListMaxQPS: "frontend.esVisibilityListMaxQPS",
-	FrontendESIndexMaxResultWindow: "frontend.esIndexMaxResultWindow",
-	FrontendHistoryMaxPageSize:     "frontend.historyMaxPageSize",
-	FrontendRPS:                    "frontend.rps",
-	FrontendHistoryMgrNumConns:     "frontend.historyMgrNumConns",
-	MaxDecisionStartToCloseTimeout: "frontend.maxDecisionStartToCloseTimeout",
-	DisableListVisibilityByFilter:  "frontend.disableListVisibilityByFilter",
-	FrontendThrottledLogRPS:        "frontend.throttledLogRPS",
-	EnableClientVersionCheck:       "frontend.enableClientVersionCheck",
+	FrontendPersistenceMaxQPS:       "frontend.persistenceMaxQPS",
+	FrontendVisibilityMaxPageSize:   "frontend.visibilityMaxPageSize",
+	FrontendVisibilityMaxCountScan:  "frontend.visibilityMaxCountScan",
+	FrontendVisibilityListMaxQPS:    "frontend.visibilityListMaxQPS",
+	FrontendESVisibilityListMaxQPS:  "frontend.esVisibilityListMaxQPS",
+	FrontendESIndexMaxResultWindow:  "frontend.esIndexMaxResultWindow",
+	FrontendHistoryMaxPageSize:      "frontend.historyMaxPageSize",
+	FrontendRPS:                     "frontend.rps",
+	FrontendHistoryMgrNumConns:      "frontend.historyMgrNumConns",
+	MaxDecisionStartToCloseTimeout:  "frontend.maxDecisionStartToCloseTimeout",
+	DisableListVisibilityByFilter:   "frontend.disableListVisibilityByFilter",
+	FrontendThrottledLogRPS:         "frontend.throttledLogRPS",
+	EnableClientVersionCheck:        "frontend.enableClientVersionCheck",
+	FrontendAccessLogSampleRate:     "frontend.accessLogSampleRate",
+	FrontendShutdownDrainDuration:   "frontend.shutdownDrainDuration",
+	SearchAttributesRefreshInterval: "frontend.searchAttributesRefreshInterval",
+	FrontendHistoryHedgingDelay:     "frontend.historyHedgingDelay",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
 	MatchingPersistenceMaxQPS:               "matching.persistenceMaxQPS",
 	MatchingMinTaskThrottlingBurstSize:      "matching.minTaskThrottlingBurstSize",
 	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
+	MatchingGetTasksBatchSizePerPoller:      "matching.getTasksBatchSizePerPoller",
 	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
 	MatchingEnableSyncMatch:                 "matching.enableSyncMatch",
 	MatchingUpdateAckInterval:               "matching.updateAckInterval",
 	MatchingIdleTasklistCheckInterval:       "matching.idleTasklistCheckInterval",
 	MaxTasklistIdleTime:                     "matching.maxTasklistIdleTime",
+	MatchingMaxTaskListsPerHost:             "matching.maxTaskListsPerHost",
 	MatchingOutstandingTaskAppendsThreshold: "matching.outstandingTaskAppendsThreshold",
 	MatchingMaxTaskBatchSize:                "matching.maxTaskBatchSize",
 	MatchingMaxTaskDeleteBatchSize:          "matching.maxTaskDeleteBatchSize",
 	MatchingThrottledLogRPS:                 "matching.throttledLogRPS",
+	MatchingEnableTaskDispatchTrace:         "matching.enableTaskDispatchTrace",
+	MatchingEnableTaskListMetrics:           "matching.enableTaskListMetrics",
+	MatchingEnableFairPollerDispatch:        "matching.enableFairPollerDispatch",
+	MatchingStartedTaskCacheSize:            "matching.startedTaskCacheSize",
+	MatchingStartedTaskCacheTTL:             "matching.startedTaskCacheTTL",
 
 	// history settings
 	HistoryRPS:                                            "history.rps",
@@ -105,10 +127,12 @@ var keys = map[Key]string{
 	HistoryCacheInitialSize:                               "history.cacheInitialSize",
 	HistoryCacheMaxSize:                                   "history.cacheMaxSize",
 	HistoryCacheTTL:                                       "history.cacheTTL",
+	HistoryCacheHostLevelMaxSize:                          "history.hostLevelCacheMaxSize",
 	EventsCacheInitialSize:                                "history.eventsCacheInitialSize",
 	EventsCacheMaxSize:                                    "history.eventsCacheMaxSize",
 	EventsCacheTTL:                                        "history.eventsCacheTTL",
 	AcquireShardInterval:                                  "history.acquireShardInterval",
+	ShardDrainTimeout:                                     "history.shardDrainTimeout",
 	StandbyClusterDelay:                                   "history.standbyClusterDelay",
 	TimerTaskBatchSize:                                    "history.timerTaskBatchSize",
 	TimerTaskWorkerCount:                                  "history.timerTaskWorkerCount",
@@ -131,6 +155,7 @@ var keys = map[Key]string{
 	TransferProcessorMaxPollRPS:                           "history.transferProcessorMaxPollRPS",
 	TransferTaskWorkerCount:                               "history.transferTaskWorkerCount",
 	TransferTaskMaxRetryCount:                             "history.transferTaskMaxRetryCount",
+	TransferTaskDLQEnabled:                                "history.transferTaskDLQEnabled",
 	TransferProcessorStartDelay:                           "history.transferProcessorStartDelay",
 	TransferProcessorFailoverStartDelay:                   "history.transferProcessorFailoverStartDelay",
 	TransferProcessorCompleteTransferFailureRetryCount:    "history.transferProcessorCompleteTransferFailureRetryCount",
@@ -140,6 +165,22 @@ var keys = map[Key]string{
 	TransferProcessorUpdateAckInterval:                    "history.transferProcessorUpdateAckInterval",
 	TransferProcessorUpdateAckIntervalJitterCoefficient:   "history.transferProcessorUpdateAckIntervalJitterCoefficient",
 	TransferProcessorCompleteTransferInterval:             "history.transferProcessorCompleteTransferInterval",
+	TaskSchedulerEnabled:                                  "history.taskSchedulerEnabled",
+	TaskSchedulerWorkerCount:                              "history.taskSchedulerWorkerCount",
+	TaskSchedulerQueueSize:                                "history.taskSchedulerQueueSize",
+	TaskSchedulerHighPriorityWeight:                       "history.taskSchedulerHighPriorityWeight",
+	TaskSchedulerDefaultPriorityWeight:                    "history.taskSchedulerDefaultPriorityWeight",
+	TaskSchedulerLowPriorityWeight:                        "history.taskSchedulerLowPriorityWeight",
+	TaskSchedulerDomainWeight:                             "history.taskSchedulerDomainWeight",
+	VisibilityTaskBatchSize:                               "history.visibilityTaskBatchSize",
+	VisibilityTaskWorkerCount:                             "history.visibilityTaskWorkerCount",
+	VisibilityTaskMaxRetryCount:                           "history.visibilityTaskMaxRetryCount",
+	VisibilityProcessorStartDelay:                         "history.visibilityProcessorStartDelay",
+	VisibilityProcessorMaxPollRPS:                         "history.visibilityProcessorMaxPollRPS",
+	VisibilityProcessorMaxPollInterval:                    "history.visibilityProcessorMaxPollInterval",
+	VisibilityProcessorMaxPollIntervalJitterCoefficient:   "history.visibilityProcessorMaxPollIntervalJitterCoefficient",
+	VisibilityProcessorUpdateAckInterval:                  "history.visibilityProcessorUpdateAckInterval",
+	VisibilityProcessorUpdateAckIntervalJitterCoefficient: "history.visibilityProcessorUpdateAckIntervalJitterCoefficient",
 	ReplicatorTaskBatchSize:                               "history.replicatorTaskBatchSize",
 	ReplicatorTaskWorkerCount:                             "history.replicatorTaskWorkerCount",
 	ReplicatorTaskMaxRetryCount:                           "history.replicatorTaskMaxRetryCount",
@@ -150,6 +191,10 @@ var keys = map[Key]string{
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient:   "history.replicatorProcessorMaxPollIntervalJitterCoefficient",
 	ReplicatorProcessorUpdateAckInterval:                  "history.replicatorProcessorUpdateAckInterval",
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: "history.replicatorProcessorUpdateAckIntervalJitterCoefficient",
+	EnableReplicationTaskBatching:                         "history.enableReplicationTaskBatching",
+	ReplicationTaskBatchMaxTasks:                          "history.replicationTaskBatchMaxTasks",
+	ReplicationTaskBatchFlushInterval:                     "history.replicationTaskBatchFlushInterval",
+	ReplicationDLQEnabled:                                 "history.replicationDLQEnabled",
 	ExecutionMgrNumConns:                                  "history.executionMgrNumConns",
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
@@ -163,6 +208,11 @@ var keys = map[Key]string{
 	NumArchiveSystemWorkflows:                             "history.numArchiveSystemWorkflows",
 	EmitShardDiffLog:                                      "history.emitShardDiffLog",
 	HistoryThrottledLogRPS:                                "history.throttledLogRPS",
+	DecisionRetryCriticalAttempts:                         "history.decisionRetryCriticalAttempts",
+	DecisionRetryInitialBackoff:                           "history.decisionRetryInitialBackoff",
+	DecisionRetryMaxBackoff:                               "history.decisionRetryMaxBackoff",
+	DecisionScheduleLatencyBreachThreshold:                "history.decisionScheduleLatencyBreachThreshold",
+	EnableDecisionScheduleLatencyBreachLog:                "history.enableDecisionScheduleLatencyBreachLog",
 
 	WorkerPersistenceMaxQPS:                         "worker.persistenceMaxQPS",
 	WorkerReplicatorMetaTaskConcurrency:             "worker.replicatorMetaTaskConcurrency",
@@ -182,7 +232,9 @@ var keys = map[Key]string{
 	WorkerArchivalsPerIteration:                     "worker.ArchivalsPerIteration",
 	WorkerDeterministicConstructionCheckProbability: "worker.DeterministicConstructionCheckProbability",
 	WorkerThrottledLogRPS:                           "worker.throttledLogRPS",
+	WorkerFrontendHedgingDelay:                      "worker.frontendHedgingDelay",
 	ScannerPersistenceMaxQPS:                        "worker.scannerPersistenceMaxQPS",
+	EnableBatcher:                                   "worker.enableBatcher",
 }
 
 const (
@@ -223,6 +275,20 @@ const (
 	// EnableDomainNotActiveAutoForwarding whether enabling DC auto forwarding to active cluster
 	// for signal / start / signal with start API if domain is not active
 	EnableDomainNotActiveAutoForwarding
+	// MetricsTaskListTagCardinalityLimit is the max number of distinct task list tag values emitted per domain,
+	// task lists beyond the limit are reported under hashed overflow tag values
+	MetricsTaskListTagCardinalityLimit
+	// MetricsTagOverflowBuckets is the number of hashed overflow tag values used once a tag is over its limit
+	MetricsTagOverflowBuckets
+	// TaskTokenSigningKey is the HMAC key task tokens are signed with, tokens are not signed while it is empty
+	TaskTokenSigningKey
+	// TaskTokenPreviousSigningKeys is a comma separated list of the keys task tokens were signed with before
+	// TaskTokenSigningKey, tokens signed with them are still accepted while the key is rotated
+	TaskTokenPreviousSigningKeys
+	// TaskTokenAcceptUnsigned is whether unsigned task tokens are still accepted once a signing key is set
+	TaskTokenAcceptUnsigned
+	// TaskTokenEncoding is the encoding of new task tokens, json or binary, tokens of both encodings are always read
+	TaskTokenEncoding
 
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
@@ -236,6 +302,12 @@ const (
 	HistoryCountLimitError
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn
+	// ExecutionContextSizeLimitError is the per workflow execution context size limit
+	ExecutionContextSizeLimitError
+	// DecisionCountLimitError is the max number of decisions a decision task can be completed with, 0 means no limit
+	DecisionCountLimitError
+	// DecisionFailureAttemptsLimit is the number of consecutive decision failures after which a workflow is failed, 0 means no limit
+	DecisionFailureAttemptsLimit
 
 	// MaxIDLengthLimit is the length limit for various IDs, including: Domain, TaskList, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
@@ -247,6 +319,9 @@ const (
 	FrontendPersistenceMaxQPS
 	// FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page
 	FrontendVisibilityMaxPageSize
+	// FrontendVisibilityMaxCountScan is the max number of executions read by a CountWorkflowExecutions call
+	// which has to scan the executions to count them
+	FrontendVisibilityMaxCountScan
 	// FrontendVisibilityListMaxQPS is max qps frontend can list open/close workflows
 	FrontendVisibilityListMaxQPS
 	// FrontendESVisibilityListMaxQPS is max qps frontend can list open/close workflows from ElasticSearch
@@ -265,6 +340,15 @@ const (
 	MaxDecisionStartToCloseTimeout
 	// EnableClientVersionCheck enables client version check for frontend
 	EnableClientVersionCheck
+	// FrontendAccessLogSampleRate is the fraction of frontend requests written to the access log, 0 disables it
+	FrontendAccessLogSampleRate
+	// FrontendShutdownDrainDuration is how long frontend keeps serving requests other than polls after it is asked to stop
+	FrontendShutdownDrainDuration
+	// SearchAttributesRefreshInterval is how often frontend reloads the registered search attributes
+	SearchAttributesRefreshInterval
+	// FrontendHistoryHedgingDelay is how long the idempotent reads of frontend to history wait before they are sent
+	// a second time, 0 disables hedging
+	FrontendHistoryHedgingDelay
 
 	// key for matching
 
@@ -276,6 +360,8 @@ const (
 	MatchingMinTaskThrottlingBurstSize
 	// MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer
 	MatchingGetTasksBatchSize
+	// MatchingGetTasksBatchSizePerPoller is the batch size to fetch per recent poller, bounded by MatchingGetTasksBatchSize, 0 disables it
+	MatchingGetTasksBatchSizePerPoller
 	// MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service
 	MatchingLongPollExpirationInterval
 	// MatchingEnableSyncMatch is to enable sync match
@@ -286,6 +372,8 @@ const (
 	MatchingIdleTasklistCheckInterval
 	// MaxTasklistIdleTime is the max time tasklist being idle
 	MaxTasklistIdleTime
+	// MatchingMaxTaskListsPerHost is the max number of task lists loaded on a matching host, 0 means no limit
+	MatchingMaxTaskListsPerHost
 	// MatchingOutstandingTaskAppendsThreshold is the threshold for outstanding task appends
 	MatchingOutstandingTaskAppendsThreshold
 	// MatchingMaxTaskBatchSize is max batch size for task writer
@@ -294,6 +382,16 @@ const (
 	MatchingMaxTaskDeleteBatchSize
 	// MatchingThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	MatchingThrottledLogRPS
+	// MatchingEnableTaskDispatchTrace is to embed and log how a task was dispatched in its task token
+	MatchingEnableTaskDispatchTrace
+	// MatchingEnableTaskListMetrics is to tag the task list manager metrics with the task list name
+	MatchingEnableTaskListMetrics
+	// MatchingEnableFairPollerDispatch is to hand out tasks to the pollers of a task list in the order they arrived
+	MatchingEnableFairPollerDispatch
+	// MatchingStartedTaskCacheSize is the max number of started tasks a task list remembers to drop their redeliveries, 0 disables it
+	MatchingStartedTaskCacheSize
+	// MatchingStartedTaskCacheTTL is how long a started task is remembered
+	MatchingStartedTaskCacheTTL
 
 	// key for history
 
@@ -313,6 +411,8 @@ const (
 	HistoryCacheMaxSize
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL
+	// HistoryCacheHostLevelMaxSize is max size of the history cache shared by all shards of a host, 0 means per shard caches
+	HistoryCacheHostLevelMaxSize
 	// EventsCacheInitialSize is initial size of events cache
 	EventsCacheInitialSize
 	// EventsCacheMaxSize is max size of events cache
@@ -321,6 +421,8 @@ const (
 	EventsCacheTTL
 	// AcquireShardInterval is interval that timer used to acquire shard
 	AcquireShardInterval
+	// ShardDrainTimeout is the max time the engine of an unloaded shard waits for its in flight operations to finish
+	ShardDrainTimeout
 	// StandbyClusterDelay is the atrificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
@@ -365,6 +467,8 @@ const (
 	TransferTaskWorkerCount
 	// TransferTaskMaxRetryCount is max times of retry for transferQueueProcessor
 	TransferTaskMaxRetryCount
+	// TransferTaskDLQEnabled is whether active transfer tasks exceeding the max retry count are moved to the shard DLQ
+	TransferTaskDLQEnabled
 	// TransferProcessorStartDelay is the start delay
 	TransferProcessorStartDelay
 	// TransferProcessorFailoverStartDelay is the failover start delay
@@ -383,6 +487,38 @@ const (
 	TransferProcessorUpdateAckIntervalJitterCoefficient
 	// TransferProcessorCompleteTransferInterval is complete timer interval for transferQueueProcessor
 	TransferProcessorCompleteTransferInterval
+	// TaskSchedulerEnabled is whether the active transfer and timer processors share the per shard task scheduler
+	TaskSchedulerEnabled
+	// TaskSchedulerWorkerCount is number of workers for the shared task scheduler
+	TaskSchedulerWorkerCount
+	// TaskSchedulerQueueSize is max number of tasks pending in the shared task scheduler
+	TaskSchedulerQueueSize
+	// TaskSchedulerHighPriorityWeight is the round robin weight of high priority tasks, e.g. decision and activity tasks
+	TaskSchedulerHighPriorityWeight
+	// TaskSchedulerDefaultPriorityWeight is the round robin weight of default priority tasks
+	TaskSchedulerDefaultPriorityWeight
+	// TaskSchedulerLowPriorityWeight is the round robin weight of low priority tasks, e.g. visibility and history deletion tasks
+	TaskSchedulerLowPriorityWeight
+	// TaskSchedulerDomainWeight is the round robin weight of a domain within a task priority
+	TaskSchedulerDomainWeight
+	// VisibilityTaskBatchSize is batch size for visibilityQueueProcessor
+	VisibilityTaskBatchSize
+	// VisibilityTaskWorkerCount is number of worker for visibilityQueueProcessor
+	VisibilityTaskWorkerCount
+	// VisibilityTaskMaxRetryCount is max times of retry for visibilityQueueProcessor
+	VisibilityTaskMaxRetryCount
+	// VisibilityProcessorStartDelay is the start delay
+	VisibilityProcessorStartDelay
+	// VisibilityProcessorMaxPollRPS is max poll rate per second for visibilityQueueProcessor
+	VisibilityProcessorMaxPollRPS
+	// VisibilityProcessorMaxPollInterval max poll interval for visibilityQueueProcessor
+	VisibilityProcessorMaxPollInterval
+	// VisibilityProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient
+	VisibilityProcessorMaxPollIntervalJitterCoefficient
+	// VisibilityProcessorUpdateAckInterval is update interval for visibilityQueueProcessor
+	VisibilityProcessorUpdateAckInterval
+	// VisibilityProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
+	VisibilityProcessorUpdateAckIntervalJitterCoefficient
 	// ReplicatorTaskBatchSize is batch size for ReplicatorProcessor
 	ReplicatorTaskBatchSize
 	// ReplicatorTaskWorkerCount is number of worker for ReplicatorProcessor
@@ -403,6 +539,17 @@ const (
 	ReplicatorProcessorUpdateAckInterval
 	// ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient
+	// EnableReplicationTaskBatching is whether replication tasks of a shard are published in compressed batches,
+	// the replicators of all remote clusters must support batches before it is enabled
+	EnableReplicationTaskBatching
+	// ReplicationTaskBatchMaxTasks is the number of replication tasks after which a batch is published, a batch
+	// holds at most as many tasks as there are replicator task workers
+	ReplicationTaskBatchMaxTasks
+	// ReplicationTaskBatchFlushInterval is the max time a replication task waits for its batch to be published
+	ReplicationTaskBatchFlushInterval
+	// ReplicationDLQEnabled is whether replicated events failing to apply with a bad request are moved to the shard DLQ
+	// of their source cluster instead of being retried
+	ReplicationDLQEnabled
 	// ExecutionMgrNumConns is persistence connections number for ExecutionManager
 	ExecutionMgrNumConns
 	// HistoryMgrNumConns is persistence connections number for HistoryManager
@@ -411,11 +558,23 @@ const (
 	MaximumBufferedEventsBatch
 	// MaximumSignalsPerExecution is max number of signals supported by single execution
 	MaximumSignalsPerExecution
+	// DecisionRetryCriticalAttempts is the decision attempt after which a continuously failing decision is reported
+	DecisionRetryCriticalAttempts
+	// DecisionRetryInitialBackoff is the delay before the second retry of a continuously failing decision
+	DecisionRetryInitialBackoff
+	// DecisionRetryMaxBackoff is the max delay before a retry of a continuously failing decision
+	DecisionRetryMaxBackoff
+	// DecisionScheduleLatencyBreachThreshold is the delay between scheduling and dispatching a decision task above
+	// which a breach is reported for the domain, 0 disables the check
+	DecisionScheduleLatencyBreachThreshold
+	// EnableDecisionScheduleLatencyBreachLog logs every decision schedule latency breach of the domain
+	EnableDecisionScheduleLatencyBreachLog
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for history events, one of thriftrw, thriftrw-gzip,
+	// thriftrw-snappy or json. Existing history is read back with the encoding it was written with
 	DefaultEventEncoding
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows
@@ -468,8 +627,13 @@ const (
 	WorkerDeterministicConstructionCheckProbability
 	// WorkerThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	WorkerThrottledLogRPS
+	// WorkerFrontendHedgingDelay is how long the idempotent reads of the worker to frontend wait before they are sent
+	// to a second frontend host, 0 disables hedging
+	WorkerFrontendHedgingDelay
 	// ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner
 	ScannerPersistenceMaxQPS
+	// EnableBatcher decides whether to start the batcher in the worker service
+	EnableBatcher
 
 	// lastKeyForTest must be the last one in this const group for testing purpose
 	lastKeyForTest
@@ -479,7 +643,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskListName {
+	if f <= unknownFilter || f >= lastFilterTypeForTest {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -490,6 +654,7 @@ var filters = []string{
 	"domainName",
 	"taskListName",
 	"taskType",
+	"apiName",
 }
 
 const (
@@ -500,6 +665,8 @@ const (
 	TaskListName
 	// TaskType is the task type (0:Decision, 1:Activity)
 	TaskType
+	// APIName is the name of the service API being called
+	APIName
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -528,3 +695,10 @@ func TaskTypeFilter(taskType int) FilterOption {
 		filterMap[TaskType] = taskType
 	}
 }
+
+// APIFilter filters by API name
+func APIFilter(name string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[APIName] = name
+	}
+}
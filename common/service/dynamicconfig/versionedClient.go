@@ -0,0 +1,542 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	// maxConfigSnapshots is the number of config versions kept for rollback
+	maxConfigSnapshots = 100
+	// maxConfigChangeRecords is the number of audit records kept
+	maxConfigChangeRecords = 1000
+	// maxConfigUpdateAttempts is the number of times a change is re-applied when it races with another host
+	maxConfigUpdateAttempts = 3
+)
+
+const (
+	clientStatusInitialized int32 = iota
+	clientStatusStarted
+	clientStatusStopped
+)
+
+var (
+	errKeyNotFound = errors.New("unable to find key")
+	// ErrNothingToRollback is returned by Rollback when there is no change to roll back
+	ErrNothingToRollback = errors.New("no previous dynamic config version to rollback to")
+	// ErrConcurrentConfigUpdate is returned when a change keeps racing with the changes made through other hosts
+	ErrConcurrentConfigUpdate = errors.New("dynamic config is being updated concurrently, please retry")
+)
+
+type (
+	// VersionedClient is a dynamic config client whose values can be updated at runtime.
+	// Every change creates a new version of the config, is recorded for audit and can be rolled back.
+	// The config is persisted in a ConfigStore, which the client polls for the changes made through other hosts.
+	VersionedClient interface {
+		Client
+		// Start loads the config from the store and starts polling it
+		Start()
+		// Stop stops polling the store
+		Stop()
+		// UpdateValue sets the value of the key for the given filters, a nil value removes it.
+		// Returns the new config version.
+		UpdateValue(name Key, filters map[Filter]interface{}, value interface{}, changedBy string, reason string) (int64, error)
+		// Rollback restores the config version which preceded the latest change.
+		// Returns the new config version.
+		Rollback(changedBy string, reason string) (int64, error)
+		// GetSnapshot returns the current version of the config
+		GetSnapshot() *Snapshot
+		// GetChangeHistory returns the audit records of the config changes, oldest first
+		GetChangeHistory() []*ChangeRecord
+	}
+
+	// Snapshot is an immutable version of the dynamic config values
+	Snapshot struct {
+		Version int64
+		Values  map[Key][]*ConstrainedValue
+	}
+
+	// ConstrainedValue is a value of a key which applies when all its filters match the filters of the lookup
+	ConstrainedValue struct {
+		Filters map[Filter]interface{}
+		Value   interface{}
+	}
+
+	// ChangeRecord is the audit record of a dynamic config change
+	ChangeRecord struct {
+		Version int64
+		// PrevVersion is the version the change was applied to
+		PrevVersion int64
+		// RollbackTo is the version restored by a rollback, zero for updates
+		RollbackTo int64
+		Key        Key
+		Filters    map[Filter]interface{}
+		OldValue   interface{}
+		NewValue   interface{}
+		ChangedBy  string
+		Reason     string
+		Timestamp  time.Time
+	}
+
+	versionedClient struct {
+		// updateLock serializes the changes made through this host,
+		// the version check of the store catches the races with other hosts
+		updateLock sync.Mutex
+		sync.RWMutex
+		state *configState
+
+		store           ConfigStore
+		refreshInterval time.Duration
+		logger          log.Logger
+		status          int32
+		shutdownC       chan struct{}
+	}
+
+	// configState is an immutable version of the config together with its history
+	configState struct {
+		// snapshots holds the previous versions of the config, the current one is the last
+		snapshots     []*Snapshot
+		changes       []*ChangeRecord
+		latestVersion int64
+		// storeVersion is the version of the store the state was loaded from or written to
+		storeVersion int64
+	}
+)
+
+var _ VersionedClient = (*versionedClient)(nil)
+
+// NewVersionedClient creates a dynamic config client with runtime updates, audit and rollback,
+// which is persisted in the store and reloaded from it every refreshInterval
+func NewVersionedClient(store ConfigStore, refreshInterval time.Duration, logger log.Logger) VersionedClient {
+	return &versionedClient{
+		state: &configState{
+			snapshots: []*Snapshot{{Values: make(map[Key][]*ConstrainedValue)}},
+		},
+		store:           store,
+		refreshInterval: refreshInterval,
+		logger:          logger,
+		status:          clientStatusInitialized,
+		shutdownC:       make(chan struct{}),
+	}
+}
+
+func (c *versionedClient) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, clientStatusInitialized, clientStatusStarted) {
+		return
+	}
+	if _, err := c.refresh(); err != nil {
+		c.logger.Error("Failed to load dynamic config.", tag.Error(err))
+	}
+	go c.refreshLoop()
+}
+
+func (c *versionedClient) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, clientStatusStarted, clientStatusStopped) {
+		return
+	}
+	close(c.shutdownC)
+}
+
+func (c *versionedClient) refreshLoop() {
+	ticker := time.NewTicker(c.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.shutdownC:
+			return
+		case <-ticker.C:
+			if _, err := c.refresh(); err != nil {
+				c.logger.Warn("Failed to refresh dynamic config.", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (c *versionedClient) GetValue(name Key, defaultValue interface{}) (interface{}, error) {
+	return c.GetValueWithFilters(name, nil, defaultValue)
+}
+
+func (c *versionedClient) GetValueWithFilters(
+	name Key, filters map[Filter]interface{}, defaultValue interface{},
+) (interface{}, error) {
+	state := c.getState()
+	snapshot := state.snapshots[len(state.snapshots)-1]
+
+	// the value with the most filters matching the lookup wins
+	var found *ConstrainedValue
+	for _, cv := range snapshot.Values[name] {
+		if !filtersMatch(cv.Filters, filters) {
+			continue
+		}
+		if found == nil || len(cv.Filters) > len(found.Filters) {
+			found = cv
+		}
+	}
+	if found == nil {
+		return defaultValue, errKeyNotFound
+	}
+	return found.Value, nil
+}
+
+func (c *versionedClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
+	val, err := c.GetValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	switch v := val.(type) {
+	case int:
+		return v, nil
+	case int32:
+		return int(v), nil
+	case int64:
+		return int(v), nil
+	case float64:
+		return int(v), nil
+	default:
+		return defaultValue, fmt.Errorf("value type is not int: %T", val)
+	}
+}
+
+func (c *versionedClient) GetFloatValue(name Key, filters map[Filter]interface{}, defaultValue float64) (float64, error) {
+	val, err := c.GetValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	default:
+		return defaultValue, fmt.Errorf("value type is not float64: %T", val)
+	}
+}
+
+func (c *versionedClient) GetBoolValue(name Key, filters map[Filter]interface{}, defaultValue bool) (bool, error) {
+	val, err := c.GetValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	if v, ok := val.(bool); ok {
+		return v, nil
+	}
+	return defaultValue, fmt.Errorf("value type is not bool: %T", val)
+}
+
+func (c *versionedClient) GetStringValue(name Key, filters map[Filter]interface{}, defaultValue string) (string, error) {
+	val, err := c.GetValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	if v, ok := val.(string); ok {
+		return v, nil
+	}
+	return defaultValue, fmt.Errorf("value type is not string: %T", val)
+}
+
+func (c *versionedClient) GetMapValue(
+	name Key, filters map[Filter]interface{}, defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	val, err := c.GetValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	if v, ok := val.(map[string]interface{}); ok {
+		return v, nil
+	}
+	return defaultValue, fmt.Errorf("value type is not map: %T", val)
+}
+
+func (c *versionedClient) GetDurationValue(
+	name Key, filters map[Filter]interface{}, defaultValue time.Duration,
+) (time.Duration, error) {
+	val, err := c.GetValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	switch v := val.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return defaultValue, fmt.Errorf("failed to parse duration: %v", err)
+		}
+		return d, nil
+	default:
+		return defaultValue, fmt.Errorf("value type is not duration: %T", val)
+	}
+}
+
+func (c *versionedClient) UpdateValue(
+	name Key, filters map[Filter]interface{}, value interface{}, changedBy string, reason string,
+) (int64, error) {
+	if _, ok := keys[name]; !ok {
+		return 0, fmt.Errorf("unknown dynamic config key: %v", name)
+	}
+
+	c.updateLock.Lock()
+	defer c.updateLock.Unlock()
+
+	for attempt := 0; attempt < maxConfigUpdateAttempts; attempt++ {
+		// always apply the change to the persisted config, the cached one may be stale
+		current, err := c.refresh()
+		if err != nil {
+			return 0, err
+		}
+
+		latest := current.snapshots[len(current.snapshots)-1]
+		next := latest.copy()
+
+		var oldValue interface{}
+		var values []*ConstrainedValue
+		for _, cv := range latest.Values[name] {
+			if filtersEqual(cv.Filters, filters) {
+				oldValue = cv.Value
+				continue
+			}
+			values = append(values, cv)
+		}
+		if value != nil {
+			values = append(values, &ConstrainedValue{Filters: copyFilters(filters), Value: value})
+		}
+		if len(values) == 0 {
+			delete(next.Values, name)
+		} else {
+			next.Values[name] = values
+		}
+
+		record := &ChangeRecord{
+			Key:      name,
+			Filters:  copyFilters(filters),
+			OldValue: oldValue,
+			NewValue: value,
+		}
+		err = c.commit(current, current.snapshots, next, record, changedBy, reason)
+		if err == nil {
+			c.logger.Info("Dynamic config updated",
+				tag.Key(name.String()),
+				tag.Value(value),
+				tag.CurrentVersion(next.Version),
+				tag.Identity(changedBy),
+				tag.DetailInfo(reason))
+			return next.Version, nil
+		}
+		if err != ErrConfigStoreVersionMismatch {
+			return 0, err
+		}
+		c.logger.Info("Dynamic config was updated concurrently, retrying.", tag.Attempt(int32(attempt)))
+	}
+	return 0, ErrConcurrentConfigUpdate
+}
+
+func (c *versionedClient) Rollback(changedBy string, reason string) (int64, error) {
+	c.updateLock.Lock()
+	defer c.updateLock.Unlock()
+
+	for attempt := 0; attempt < maxConfigUpdateAttempts; attempt++ {
+		current, err := c.refresh()
+		if err != nil {
+			return 0, err
+		}
+		if len(current.snapshots) < 2 {
+			return 0, ErrNothingToRollback
+		}
+
+		previous := current.snapshots[len(current.snapshots)-2]
+		next := previous.copy()
+		record := &ChangeRecord{
+			RollbackTo: previous.Version,
+		}
+		// drop both, the restored copy of the previous version becomes the current one,
+		// so that rolling back again restores the version before it
+		err = c.commit(current, current.snapshots[:len(current.snapshots)-2], next, record, changedBy, reason)
+		if err == nil {
+			c.logger.Info("Dynamic config rolled back",
+				tag.CurrentVersion(next.Version),
+				tag.Identity(changedBy),
+				tag.DetailInfo(reason))
+			return next.Version, nil
+		}
+		if err != ErrConfigStoreVersionMismatch {
+			return 0, err
+		}
+		c.logger.Info("Dynamic config was updated concurrently, retrying.", tag.Attempt(int32(attempt)))
+	}
+	return 0, ErrConcurrentConfigUpdate
+}
+
+func (c *versionedClient) GetSnapshot() *Snapshot {
+	state := c.getState()
+	return state.snapshots[len(state.snapshots)-1].copy()
+}
+
+func (c *versionedClient) GetChangeHistory() []*ChangeRecord {
+	state := c.getState()
+	changes := make([]*ChangeRecord, len(state.changes))
+	copy(changes, state.changes)
+	return changes
+}
+
+func (c *versionedClient) getState() *configState {
+	c.RLock()
+	defer c.RUnlock()
+	return c.state
+}
+
+// setState replaces the cached state unless a newer one was loaded in the meantime
+func (c *versionedClient) setState(state *configState) {
+	c.Lock()
+	defer c.Unlock()
+	if state.storeVersion > c.state.storeVersion {
+		c.state = state
+	}
+}
+
+// refresh loads the config from the store if it was changed and returns the state of the config
+func (c *versionedClient) refresh() (*configState, error) {
+	data, version, err := c.store.Load()
+	if err != nil {
+		return nil, err
+	}
+	current := c.getState()
+	if version <= current.storeVersion {
+		return current, nil
+	}
+
+	state, err := decodeConfigState(data)
+	if err != nil {
+		return nil, err
+	}
+	state.storeVersion = version
+	c.setState(state)
+	return state, nil
+}
+
+// commit persists the snapshot as the new version of the config on top of the given previous versions,
+// the cached state only changes once the store accepted it
+func (c *versionedClient) commit(
+	current *configState, previous []*Snapshot, next *Snapshot, record *ChangeRecord, changedBy string, reason string,
+) error {
+	state := &configState{
+		latestVersion: current.latestVersion + 1,
+		storeVersion:  current.storeVersion + 1,
+	}
+	next.Version = state.latestVersion
+
+	state.snapshots = make([]*Snapshot, 0, len(previous)+1)
+	state.snapshots = append(state.snapshots, previous...)
+	state.snapshots = append(state.snapshots, next)
+	if len(state.snapshots) > maxConfigSnapshots {
+		state.snapshots = state.snapshots[len(state.snapshots)-maxConfigSnapshots:]
+	}
+
+	record.Version = next.Version
+	record.PrevVersion = current.latestVersion
+	record.ChangedBy = changedBy
+	record.Reason = reason
+	record.Timestamp = time.Now()
+	state.changes = make([]*ChangeRecord, 0, len(current.changes)+1)
+	state.changes = append(state.changes, current.changes...)
+	state.changes = append(state.changes, record)
+	if len(state.changes) > maxConfigChangeRecords {
+		state.changes = state.changes[len(state.changes)-maxConfigChangeRecords:]
+	}
+
+	data, err := encodeConfigState(state)
+	if err != nil {
+		return err
+	}
+	if err := c.store.Store(data, current.storeVersion); err != nil {
+		return err
+	}
+	c.setState(state)
+	return nil
+}
+
+func (s *Snapshot) copy() *Snapshot {
+	values := make(map[Key][]*ConstrainedValue, len(s.Values))
+	for key, cvs := range s.Values {
+		values[key] = append([]*ConstrainedValue(nil), cvs...)
+	}
+	return &Snapshot{Version: s.Version, Values: values}
+}
+
+// ParseKey returns the key with the given name, as used in the dynamic config sources
+func ParseKey(name string) (Key, error) {
+	for key, keyName := range keys {
+		if keyName == name && key != unknownKey {
+			return key, nil
+		}
+	}
+	return unknownKey, fmt.Errorf("unknown dynamic config key: %v", name)
+}
+
+// ParseFilter returns the filter with the given name and its value converted to the type
+// the services use when looking up keys, e.g. an int for the task type
+func ParseFilter(name string, value string) (Filter, interface{}, error) {
+	for f := unknownFilter + 1; f < lastFilterTypeForTest; f++ {
+		if filters[f] != name {
+			continue
+		}
+		if f != TaskType {
+			return f, value, nil
+		}
+		taskType, err := strconv.Atoi(value)
+		if err != nil {
+			return unknownFilter, nil, fmt.Errorf("invalid value of dynamic config filter %v: %v", name, value)
+		}
+		return f, taskType, nil
+	}
+	return unknownFilter, nil, fmt.Errorf("unknown dynamic config filter: %v", name)
+}
+
+// filtersMatch returns true if all the constraints are satisfied by the filters of the lookup
+func filtersMatch(constraints map[Filter]interface{}, filters map[Filter]interface{}) bool {
+	for filter, value := range constraints {
+		if v, ok := filters[filter]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func filtersEqual(a map[Filter]interface{}, b map[Filter]interface{}) bool {
+	return len(a) == len(b) && filtersMatch(a, b)
+}
+
+func copyFilters(filters map[Filter]interface{}) map[Filter]interface{} {
+	result := make(map[Filter]interface{}, len(filters))
+	for filter, value := range filters {
+		result[filter] = value
+	}
+	return result
+}
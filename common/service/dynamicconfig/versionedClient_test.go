@@ -0,0 +1,270 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log"
+)
+
+type (
+	versionedClientSuite struct {
+		suite.Suite
+		store  *testConfigStore
+		client VersionedClient
+	}
+
+	testConfigStore struct {
+		sync.Mutex
+		config  []byte
+		version int64
+		err     error
+	}
+)
+
+func TestVersionedClientSuite(t *testing.T) {
+	s := new(versionedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *versionedClientSuite) SetupTest() {
+	s.store = &testConfigStore{}
+	s.client = s.newClient()
+}
+
+func (s *versionedClientSuite) newClient() VersionedClient {
+	// the tests refresh explicitly through the updates
+	return NewVersionedClient(s.store, time.Hour, log.NewNoop())
+}
+
+func (s *versionedClientSuite) TestGetValue_NotFound() {
+	value, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 10)
+	s.Error(err)
+	s.Equal(10, value)
+}
+
+func (s *versionedClientSuite) TestUpdateValue_UnknownKey() {
+	_, err := s.client.UpdateValue(Key(-1), nil, 1, "tester", "unknown key")
+	s.Error(err)
+	s.Empty(s.client.GetChangeHistory())
+}
+
+func (s *versionedClientSuite) TestUpdateValue_Filters() {
+	domainFilters := map[Filter]interface{}{DomainName: "samples-domain"}
+	taskListFilters := map[Filter]interface{}{DomainName: "samples-domain", TaskListName: "samples-tasklist"}
+
+	version, err := s.client.UpdateValue(testGetIntPropertyKey, nil, 100, "tester", "global")
+	s.NoError(err)
+	s.Equal(int64(1), version)
+	version, err = s.client.UpdateValue(testGetIntPropertyKey, domainFilters, 50, "tester", "domain")
+	s.NoError(err)
+	s.Equal(int64(2), version)
+	version, err = s.client.UpdateValue(testGetIntPropertyKey, taskListFilters, 10, "tester", "tasklist")
+	s.NoError(err)
+	s.Equal(int64(3), version)
+
+	value, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	s.NoError(err)
+	s.Equal(100, value)
+	value, err = s.client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{DomainName: "other-domain"}, 0)
+	s.NoError(err)
+	s.Equal(100, value)
+	value, err = s.client.GetIntValue(testGetIntPropertyKey, domainFilters, 0)
+	s.NoError(err)
+	s.Equal(50, value)
+	value, err = s.client.GetIntValue(testGetIntPropertyKey, taskListFilters, 0)
+	s.NoError(err)
+	s.Equal(10, value)
+
+	// a nil value removes the override
+	_, err = s.client.UpdateValue(testGetIntPropertyKey, domainFilters, nil, "tester", "remove domain")
+	s.NoError(err)
+	value, err = s.client.GetIntValue(testGetIntPropertyKey, domainFilters, 0)
+	s.NoError(err)
+	s.Equal(100, value)
+}
+
+func (s *versionedClientSuite) TestGetDurationValue() {
+	_, err := s.client.UpdateValue(testGetDurationPropertyKey, nil, "2m", "tester", "duration string")
+	s.NoError(err)
+	value, err := s.client.GetDurationValue(testGetDurationPropertyKey, nil, time.Second)
+	s.NoError(err)
+	s.Equal(2*time.Minute, value)
+
+	_, err = s.client.UpdateValue(testGetDurationPropertyKey, nil, "invalid", "tester", "invalid duration")
+	s.NoError(err)
+	value, err = s.client.GetDurationValue(testGetDurationPropertyKey, nil, time.Second)
+	s.Error(err)
+	s.Equal(time.Second, value)
+}
+
+func (s *versionedClientSuite) TestRollback() {
+	_, err := s.client.Rollback("tester", "nothing to rollback")
+	s.Equal(ErrNothingToRollback, err)
+
+	_, err = s.client.UpdateValue(testGetIntPropertyKey, nil, 1, "tester", "first")
+	s.NoError(err)
+	_, err = s.client.UpdateValue(testGetIntPropertyKey, nil, 2, "tester", "second")
+	s.NoError(err)
+
+	version, err := s.client.Rollback("oncall", "bad value")
+	s.NoError(err)
+	s.Equal(int64(3), version)
+	value, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	s.NoError(err)
+	s.Equal(1, value)
+
+	version, err = s.client.Rollback("oncall", "still bad")
+	s.NoError(err)
+	s.Equal(int64(4), version)
+	_, err = s.client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	s.Error(err)
+	s.Equal(int64(4), s.client.GetSnapshot().Version)
+
+	_, err = s.client.Rollback("oncall", "nothing left")
+	s.Error(err)
+
+	history := s.client.GetChangeHistory()
+	s.Len(history, 4)
+	s.Equal(1, history[1].OldValue)
+	s.Equal(2, history[1].NewValue)
+	s.Equal(int64(1), history[2].RollbackTo)
+	s.Equal(int64(2), history[2].PrevVersion)
+	s.Equal("oncall", history[2].ChangedBy)
+	s.Equal("bad value", history[2].Reason)
+	s.Equal(int64(0), history[3].RollbackTo)
+}
+
+func (s *versionedClientSuite) TestGetSnapshot_Isolated() {
+	_, err := s.client.UpdateValue(testGetBoolPropertyKey, nil, true, "tester", "enable")
+	s.NoError(err)
+	snapshot := s.client.GetSnapshot()
+	_, err = s.client.UpdateValue(testGetBoolPropertyKey, nil, false, "tester", "disable")
+	s.NoError(err)
+
+	s.Equal(int64(1), snapshot.Version)
+	s.Len(snapshot.Values[testGetBoolPropertyKey], 1)
+	s.Equal(true, snapshot.Values[testGetBoolPropertyKey][0].Value)
+}
+
+func (s *versionedClientSuite) TestParseKey() {
+	key, err := ParseKey("frontend.rps")
+	s.NoError(err)
+	s.Equal(FrontendRPS, key)
+
+	_, err = ParseKey("unknownKey")
+	s.Error(err)
+	_, err = ParseKey("frontend.unknown")
+	s.Error(err)
+}
+
+func (s *versionedClientSuite) TestParseFilter() {
+	filter, value, err := ParseFilter("domainName", "samples-domain")
+	s.NoError(err)
+	s.Equal(DomainName, filter)
+	s.Equal("samples-domain", value)
+
+	filter, value, err = ParseFilter("taskType", "1")
+	s.NoError(err)
+	s.Equal(TaskType, filter)
+	s.Equal(1, value)
+
+	_, _, err = ParseFilter("taskType", "activity")
+	s.Error(err)
+	_, _, err = ParseFilter("unknownFilter", "value")
+	s.Error(err)
+}
+
+func (s *versionedClientSuite) TestUpdateValue_SharedThroughStore() {
+	other := s.newClient()
+	other.Start()
+	defer other.Stop()
+
+	_, err := s.client.UpdateValue(testGetIntPropertyKey, map[Filter]interface{}{TaskType: 1}, 100, "tester", "first host")
+	s.NoError(err)
+
+	// a host which is started after the update loads it from the store
+	started := s.newClient()
+	started.Start()
+	defer started.Stop()
+	value, err := started.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{TaskType: 1}, 0)
+	s.NoError(err)
+	s.Equal(100, value)
+
+	// a change made through a host with a stale cache is applied on top of the stored config
+	_, err = other.UpdateValue(testGetBoolPropertyKey, nil, true, "tester", "second host")
+	s.NoError(err)
+	snapshot := other.GetSnapshot()
+	s.Equal(int64(2), snapshot.Version)
+	s.Len(snapshot.Values[testGetIntPropertyKey], 1)
+	s.Len(snapshot.Values[testGetBoolPropertyKey], 1)
+
+	history := other.GetChangeHistory()
+	s.Len(history, 2)
+	s.Equal(testGetIntPropertyKey, history[0].Key)
+	s.Equal("first host", history[0].Reason)
+	s.Equal(int64(1), history[1].PrevVersion)
+
+	// rolling back through the first host removes the change of the second one
+	version, err := s.client.Rollback("oncall", "undo second host")
+	s.NoError(err)
+	s.Equal(int64(3), version)
+	_, err = s.client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	s.Error(err)
+}
+
+func (s *versionedClientSuite) TestUpdateValue_StoreError() {
+	s.store.err = errors.New("store unavailable")
+
+	_, err := s.client.UpdateValue(testGetIntPropertyKey, nil, 1, "tester", "store down")
+	s.Error(err)
+	_, err = s.client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	s.Error(err)
+	s.Empty(s.client.GetChangeHistory())
+}
+
+func (t *testConfigStore) Load() ([]byte, int64, error) {
+	t.Lock()
+	defer t.Unlock()
+	if t.err != nil {
+		return nil, 0, t.err
+	}
+	return t.config, t.version, nil
+}
+
+func (t *testConfigStore) Store(config []byte, prevVersion int64) error {
+	t.Lock()
+	defer t.Unlock()
+	if t.err != nil {
+		return t.err
+	}
+	if t.version != prevVersion {
+		return ErrConfigStoreVersionMismatch
+	}
+	t.config = config
+	t.version++
+	return nil
+}
@@ -21,6 +21,7 @@
 package service
 
 import (
+	"io"
 	"math/rand"
 	"os"
 	"sync/atomic"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
 	es "github.com/uber/cadence/common/elasticsearch"
@@ -73,8 +75,10 @@ type (
 		DynamicConfig       dynamicconfig.Client
 		DispatcherProvider  client.DispatcherProvider
 		BlobstoreClient     blobstore.Client
+		Authorizer          authorization.Authorizer
 		DCRedirectionPolicy config.DCRedirectionPolicy
 		PublicClient        workflowserviceclient.Interface
+		HTTPGatewayAddress  string
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -223,6 +227,13 @@ func (h *serviceImpl) Stop() {
 	}
 
 	h.runtimeMetricsReporter.Stop()
+
+	// closing the root scope reports the metrics collected since the last reporting interval
+	if closer, ok := h.metricsScope.(io.Closer); ok && h.metricsScope != tally.NoopScope {
+		if err := closer.Close(); err != nil {
+			h.logger.Warn("Failed to flush metrics.", tag.Error(err))
+		}
+	}
 }
 
 func (h *serviceImpl) GetLogger() log.Logger {
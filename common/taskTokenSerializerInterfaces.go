@@ -37,6 +37,17 @@ type (
 		ScheduleID      int64  `json:"scheduleId"`
 		ScheduleAttempt int64  `json:"scheduleAttempt"`
 		ActivityID      string `json:"activityId"`
+		// DispatchTrace is filled in by matching and is opaque to other services
+		DispatchTrace *DispatchTrace `json:"dispatchTrace,omitempty"`
+	}
+
+	// DispatchTrace records how matching handed a task out to a poller, so that the end to end
+	// latency of a task can be broken down after the fact
+	DispatchTrace struct {
+		Host       string `json:"host,omitempty"`
+		TaskList   string `json:"taskList"`
+		SyncMatch  bool   `json:"syncMatch"`
+		BufferWait int64  `json:"bufferWait"` // nanoseconds between task creation and dispatch
 	}
 
 	// QueryTaskToken identifies a query task
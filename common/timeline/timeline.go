@@ -0,0 +1,247 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package timeline converts the history of a workflow run into spans of the work it did,
+// so clients do not need to pair the scheduled, started and closed events themselves.
+package timeline
+
+import (
+	"sort"
+	"strconv"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+// SpanType is the kind of work a span represents
+type SpanType string
+
+// SpanStatus is the state of the work of a span at the end of the history
+type SpanStatus string
+
+const (
+	// SpanTypeWorkflow is the span of the workflow run itself
+	SpanTypeWorkflow SpanType = "workflow"
+	// SpanTypeDecision is the span of a decision task
+	SpanTypeDecision SpanType = "decision"
+	// SpanTypeActivity is the span of an activity task
+	SpanTypeActivity SpanType = "activity"
+	// SpanTypeTimer is the span of a user timer
+	SpanTypeTimer SpanType = "timer"
+	// SpanTypeChildWorkflow is the span of a child workflow execution
+	SpanTypeChildWorkflow SpanType = "child_workflow"
+)
+
+const (
+	// SpanStatusScheduled means the work is scheduled but not started yet
+	SpanStatusScheduled SpanStatus = "scheduled"
+	// SpanStatusRunning means the work is started but not closed yet
+	SpanStatusRunning SpanStatus = "running"
+	// SpanStatusCompleted means the work completed successfully
+	SpanStatusCompleted SpanStatus = "completed"
+	// SpanStatusFailed means the work failed
+	SpanStatusFailed SpanStatus = "failed"
+	// SpanStatusTimedOut means the work timed out
+	SpanStatusTimedOut SpanStatus = "timed_out"
+	// SpanStatusCanceled means the work was canceled
+	SpanStatusCanceled SpanStatus = "canceled"
+	// SpanStatusTerminated means the work was terminated
+	SpanStatusTerminated SpanStatus = "terminated"
+	// SpanStatusContinuedAsNew means the workflow run continued as a new run
+	SpanStatusContinuedAsNew SpanStatus = "continued_as_new"
+	// SpanStatusFired means the timer fired
+	SpanStatusFired SpanStatus = "fired"
+)
+
+type (
+	// Span is a unit of work of a workflow run, with the events and times it was scheduled, started and closed at.
+	// Event IDs and timestamps of the phases which did not happen are zero.
+	Span struct {
+		Type SpanType `json:"type"`
+		// ID is the activity, timer or child workflow ID, or the scheduled event ID for decisions
+		ID string `json:"id"`
+		// Name is the activity or workflow type name
+		Name   string     `json:"name,omitempty"`
+		Status SpanStatus `json:"status"`
+		// Attempt is the attempt of the activity or decision which started
+		Attempt int64 `json:"attempt"`
+
+		ScheduledEventID   int64 `json:"scheduledEventId"`
+		ScheduledTimestamp int64 `json:"scheduledTimestamp"`
+		StartedEventID     int64 `json:"startedEventId,omitempty"`
+		StartedTimestamp   int64 `json:"startedTimestamp,omitempty"`
+		ClosedEventID      int64 `json:"closedEventId,omitempty"`
+		ClosedTimestamp    int64 `json:"closedTimestamp,omitempty"`
+	}
+
+	// Timeline is the spans of a workflow run ordered by their scheduled event ID
+	Timeline struct {
+		Spans []*Span `json:"spans"`
+	}
+
+	builder struct {
+		spans map[int64]*Span
+	}
+)
+
+// Build computes the timeline of a workflow run from its history events, which have to be in event ID order.
+// The history does not need to be complete, the spans of the work not closed yet are scheduled or running.
+func Build(events []*workflow.HistoryEvent) *Timeline {
+	b := &builder{spans: make(map[int64]*Span)}
+	for _, event := range events {
+		b.apply(event)
+	}
+
+	timeline := &Timeline{Spans: make([]*Span, 0, len(b.spans))}
+	for _, span := range b.spans {
+		timeline.Spans = append(timeline.Spans, span)
+	}
+	sort.Slice(timeline.Spans, func(i, j int) bool {
+		return timeline.Spans[i].ScheduledEventID < timeline.Spans[j].ScheduledEventID
+	})
+	return timeline
+}
+
+// Duration returns the time from scheduling to closing of the span in nanoseconds,
+// or zero if the span is not closed
+func (s *Span) Duration() int64 {
+	if s.ClosedTimestamp == 0 {
+		return 0
+	}
+	return s.ClosedTimestamp - s.ScheduledTimestamp
+}
+
+func (b *builder) apply(event *workflow.HistoryEvent) {
+	eventID := event.GetEventId()
+	timestamp := event.GetTimestamp()
+
+	switch event.GetEventType() {
+	case workflow.EventTypeWorkflowExecutionStarted:
+		attr := event.WorkflowExecutionStartedEventAttributes
+		b.schedule(eventID, timestamp, SpanTypeWorkflow, "", attr.GetWorkflowType().GetName())
+		b.start(eventID, eventID, timestamp, int64(attr.GetAttempt()))
+	case workflow.EventTypeWorkflowExecutionCompleted:
+		b.closeWorkflow(eventID, timestamp, SpanStatusCompleted)
+	case workflow.EventTypeWorkflowExecutionFailed:
+		b.closeWorkflow(eventID, timestamp, SpanStatusFailed)
+	case workflow.EventTypeWorkflowExecutionTimedOut:
+		b.closeWorkflow(eventID, timestamp, SpanStatusTimedOut)
+	case workflow.EventTypeWorkflowExecutionCanceled:
+		b.closeWorkflow(eventID, timestamp, SpanStatusCanceled)
+	case workflow.EventTypeWorkflowExecutionTerminated:
+		b.closeWorkflow(eventID, timestamp, SpanStatusTerminated)
+	case workflow.EventTypeWorkflowExecutionContinuedAsNew:
+		b.closeWorkflow(eventID, timestamp, SpanStatusContinuedAsNew)
+
+	case workflow.EventTypeDecisionTaskScheduled:
+		attr := event.DecisionTaskScheduledEventAttributes
+		b.schedule(eventID, timestamp, SpanTypeDecision, strconv.FormatInt(eventID, 10), "")
+		b.spans[eventID].Attempt = attr.GetAttempt()
+	case workflow.EventTypeDecisionTaskStarted:
+		b.start(event.DecisionTaskStartedEventAttributes.GetScheduledEventId(), eventID, timestamp, -1)
+	case workflow.EventTypeDecisionTaskCompleted:
+		b.close(event.DecisionTaskCompletedEventAttributes.GetScheduledEventId(), eventID, timestamp, SpanStatusCompleted)
+	case workflow.EventTypeDecisionTaskFailed:
+		b.close(event.DecisionTaskFailedEventAttributes.GetScheduledEventId(), eventID, timestamp, SpanStatusFailed)
+	case workflow.EventTypeDecisionTaskTimedOut:
+		b.close(event.DecisionTaskTimedOutEventAttributes.GetScheduledEventId(), eventID, timestamp, SpanStatusTimedOut)
+
+	case workflow.EventTypeActivityTaskScheduled:
+		attr := event.ActivityTaskScheduledEventAttributes
+		b.schedule(eventID, timestamp, SpanTypeActivity, attr.GetActivityId(), attr.GetActivityType().GetName())
+	case workflow.EventTypeActivityTaskStarted:
+		attr := event.ActivityTaskStartedEventAttributes
+		b.start(attr.GetScheduledEventId(), eventID, timestamp, int64(attr.GetAttempt()))
+	case workflow.EventTypeActivityTaskCompleted:
+		b.close(event.ActivityTaskCompletedEventAttributes.GetScheduledEventId(), eventID, timestamp, SpanStatusCompleted)
+	case workflow.EventTypeActivityTaskFailed:
+		b.close(event.ActivityTaskFailedEventAttributes.GetScheduledEventId(), eventID, timestamp, SpanStatusFailed)
+	case workflow.EventTypeActivityTaskTimedOut:
+		b.close(event.ActivityTaskTimedOutEventAttributes.GetScheduledEventId(), eventID, timestamp, SpanStatusTimedOut)
+	case workflow.EventTypeActivityTaskCanceled:
+		b.close(event.ActivityTaskCanceledEventAttributes.GetScheduledEventId(), eventID, timestamp, SpanStatusCanceled)
+
+	case workflow.EventTypeTimerStarted:
+		b.schedule(eventID, timestamp, SpanTypeTimer, event.TimerStartedEventAttributes.GetTimerId(), "")
+		b.start(eventID, eventID, timestamp, -1)
+	case workflow.EventTypeTimerFired:
+		b.close(event.TimerFiredEventAttributes.GetStartedEventId(), eventID, timestamp, SpanStatusFired)
+	case workflow.EventTypeTimerCanceled:
+		b.close(event.TimerCanceledEventAttributes.GetStartedEventId(), eventID, timestamp, SpanStatusCanceled)
+
+	case workflow.EventTypeStartChildWorkflowExecutionInitiated:
+		attr := event.StartChildWorkflowExecutionInitiatedEventAttributes
+		b.schedule(eventID, timestamp, SpanTypeChildWorkflow, attr.GetWorkflowId(), attr.GetWorkflowType().GetName())
+	case workflow.EventTypeStartChildWorkflowExecutionFailed:
+		b.close(event.StartChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventId(), eventID, timestamp, SpanStatusFailed)
+	case workflow.EventTypeChildWorkflowExecutionStarted:
+		b.start(event.ChildWorkflowExecutionStartedEventAttributes.GetInitiatedEventId(), eventID, timestamp, -1)
+	case workflow.EventTypeChildWorkflowExecutionCompleted:
+		b.close(event.ChildWorkflowExecutionCompletedEventAttributes.GetInitiatedEventId(), eventID, timestamp, SpanStatusCompleted)
+	case workflow.EventTypeChildWorkflowExecutionFailed:
+		b.close(event.ChildWorkflowExecutionFailedEventAttributes.GetInitiatedEventId(), eventID, timestamp, SpanStatusFailed)
+	case workflow.EventTypeChildWorkflowExecutionTimedOut:
+		b.close(event.ChildWorkflowExecutionTimedOutEventAttributes.GetInitiatedEventId(), eventID, timestamp, SpanStatusTimedOut)
+	case workflow.EventTypeChildWorkflowExecutionCanceled:
+		b.close(event.ChildWorkflowExecutionCanceledEventAttributes.GetInitiatedEventId(), eventID, timestamp, SpanStatusCanceled)
+	case workflow.EventTypeChildWorkflowExecutionTerminated:
+		b.close(event.ChildWorkflowExecutionTerminatedEventAttributes.GetInitiatedEventId(), eventID, timestamp, SpanStatusTerminated)
+	}
+}
+
+func (b *builder) schedule(eventID int64, timestamp int64, spanType SpanType, id string, name string) {
+	b.spans[eventID] = &Span{
+		Type:               spanType,
+		ID:                 id,
+		Name:               name,
+		Status:             SpanStatusScheduled,
+		ScheduledEventID:   eventID,
+		ScheduledTimestamp: timestamp,
+	}
+}
+
+// start marks the span as running, a negative attempt keeps the attempt of the span
+func (b *builder) start(scheduledEventID int64, eventID int64, timestamp int64, attempt int64) {
+	span, ok := b.spans[scheduledEventID]
+	if !ok {
+		return
+	}
+	span.Status = SpanStatusRunning
+	span.StartedEventID = eventID
+	span.StartedTimestamp = timestamp
+	if attempt >= 0 {
+		span.Attempt = attempt
+	}
+}
+
+func (b *builder) close(scheduledEventID int64, eventID int64, timestamp int64, status SpanStatus) {
+	span, ok := b.spans[scheduledEventID]
+	if !ok {
+		return
+	}
+	span.Status = status
+	span.ClosedEventID = eventID
+	span.ClosedTimestamp = timestamp
+}
+
+func (b *builder) closeWorkflow(eventID int64, timestamp int64, status SpanStatus) {
+	// the workflow span is always scheduled by the first event of the history
+	b.close(common.FirstEventID, eventID, timestamp, status)
+}
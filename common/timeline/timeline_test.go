@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package timeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+func newEvent(eventID int64, eventType workflow.EventType) *workflow.HistoryEvent {
+	return &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(eventID),
+		Timestamp: common.Int64Ptr(eventID * 1000),
+		EventType: common.EventTypePtr(eventType),
+	}
+}
+
+func TestBuild(t *testing.T) {
+	var events []*workflow.HistoryEvent
+	add := func(eventType workflow.EventType) *workflow.HistoryEvent {
+		e := newEvent(int64(len(events)+1), eventType)
+		events = append(events, e)
+		return e
+	}
+
+	add(workflow.EventTypeWorkflowExecutionStarted).WorkflowExecutionStartedEventAttributes = &workflow.WorkflowExecutionStartedEventAttributes{
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("parent-type")},
+	}
+	add(workflow.EventTypeDecisionTaskScheduled).DecisionTaskScheduledEventAttributes = &workflow.DecisionTaskScheduledEventAttributes{}
+	add(workflow.EventTypeDecisionTaskStarted).DecisionTaskStartedEventAttributes = &workflow.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: common.Int64Ptr(2),
+	}
+	add(workflow.EventTypeDecisionTaskCompleted).DecisionTaskCompletedEventAttributes = &workflow.DecisionTaskCompletedEventAttributes{
+		ScheduledEventId: common.Int64Ptr(2),
+	}
+	add(workflow.EventTypeActivityTaskScheduled).ActivityTaskScheduledEventAttributes = &workflow.ActivityTaskScheduledEventAttributes{
+		ActivityId:   common.StringPtr("activity-1"),
+		ActivityType: &workflow.ActivityType{Name: common.StringPtr("activity-type")},
+	}
+	add(workflow.EventTypeTimerStarted).TimerStartedEventAttributes = &workflow.TimerStartedEventAttributes{
+		TimerId: common.StringPtr("timer-1"),
+	}
+	add(workflow.EventTypeStartChildWorkflowExecutionInitiated).StartChildWorkflowExecutionInitiatedEventAttributes = &workflow.StartChildWorkflowExecutionInitiatedEventAttributes{
+		WorkflowId:   common.StringPtr("child-1"),
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("child-type")},
+	}
+	add(workflow.EventTypeActivityTaskStarted).ActivityTaskStartedEventAttributes = &workflow.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: common.Int64Ptr(5),
+		Attempt:          common.Int32Ptr(2),
+	}
+	add(workflow.EventTypeChildWorkflowExecutionStarted).ChildWorkflowExecutionStartedEventAttributes = &workflow.ChildWorkflowExecutionStartedEventAttributes{
+		InitiatedEventId: common.Int64Ptr(7),
+	}
+	add(workflow.EventTypeActivityTaskFailed).ActivityTaskFailedEventAttributes = &workflow.ActivityTaskFailedEventAttributes{
+		ScheduledEventId: common.Int64Ptr(5),
+	}
+	add(workflow.EventTypeTimerFired).TimerFiredEventAttributes = &workflow.TimerFiredEventAttributes{
+		StartedEventId: common.Int64Ptr(6),
+	}
+
+	timeline := Build(events)
+	require.Len(t, timeline.Spans, 5)
+
+	wf := timeline.Spans[0]
+	require.Equal(t, SpanTypeWorkflow, wf.Type)
+	require.Equal(t, "parent-type", wf.Name)
+	require.Equal(t, SpanStatusRunning, wf.Status)
+	require.Equal(t, int64(0), wf.Duration())
+
+	decision := timeline.Spans[1]
+	require.Equal(t, SpanTypeDecision, decision.Type)
+	require.Equal(t, "2", decision.ID)
+	require.Equal(t, SpanStatusCompleted, decision.Status)
+	require.Equal(t, int64(3), decision.StartedEventID)
+	require.Equal(t, int64(4), decision.ClosedEventID)
+	require.Equal(t, int64(2000), decision.Duration())
+
+	activity := timeline.Spans[2]
+	require.Equal(t, SpanTypeActivity, activity.Type)
+	require.Equal(t, "activity-1", activity.ID)
+	require.Equal(t, "activity-type", activity.Name)
+	require.Equal(t, SpanStatusFailed, activity.Status)
+	require.Equal(t, int64(2), activity.Attempt)
+	require.Equal(t, int64(5000), activity.ScheduledTimestamp)
+	require.Equal(t, int64(8000), activity.StartedTimestamp)
+	require.Equal(t, int64(10000), activity.ClosedTimestamp)
+
+	timer := timeline.Spans[3]
+	require.Equal(t, SpanTypeTimer, timer.Type)
+	require.Equal(t, "timer-1", timer.ID)
+	require.Equal(t, SpanStatusFired, timer.Status)
+	require.Equal(t, int64(11), timer.ClosedEventID)
+
+	child := timeline.Spans[4]
+	require.Equal(t, SpanTypeChildWorkflow, child.Type)
+	require.Equal(t, "child-1", child.ID)
+	require.Equal(t, "child-type", child.Name)
+	require.Equal(t, SpanStatusRunning, child.Status)
+	require.Equal(t, int64(9), child.StartedEventID)
+	require.Equal(t, int64(0), child.ClosedEventID)
+
+	closed := newEvent(12, workflow.EventTypeWorkflowExecutionCompleted)
+	closed.WorkflowExecutionCompletedEventAttributes = &workflow.WorkflowExecutionCompletedEventAttributes{}
+	timeline = Build(append(events, closed))
+	require.Equal(t, SpanStatusCompleted, timeline.Spans[0].Status)
+	require.Equal(t, int64(12), timeline.Spans[0].ClosedEventID)
+	require.Equal(t, int64(11000), timeline.Spans[0].Duration())
+}
+
+func TestBuild_PartialHistory(t *testing.T) {
+	// close events of spans scheduled before the first event are ignored
+	fired := newEvent(10, workflow.EventTypeTimerFired)
+	fired.TimerFiredEventAttributes = &workflow.TimerFiredEventAttributes{StartedEventId: common.Int64Ptr(5)}
+	timeline := Build([]*workflow.HistoryEvent{fired})
+	require.Empty(t, timeline.Spans)
+}
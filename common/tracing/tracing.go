@@ -0,0 +1,85 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+// Span tags used by the services, in addition to the standard ones in opentracing-go/ext
+const (
+	DomainIDTag   = "cadence.domain_id"
+	WorkflowIDTag = "cadence.workflow_id"
+	RunIDTag      = "cadence.run_id"
+	TaskListTag   = "cadence.task_list"
+)
+
+const (
+	attemptLogKey   = "cadence.attempt"
+	conflictLogName = "conditional_update_conflict"
+)
+
+// StartSpan starts a span for the operation as a child of the span carried by ctx, if any, using the global
+// tracer. The yarpc transports extract the span of the caller into the context of inbound requests, so spans
+// started from the handler context are part of the trace of the original request.
+func StartSpan(ctx context.Context, operation string, tags ...opentracing.Tag) (opentracing.Span, context.Context) {
+	opts := make([]opentracing.StartSpanOption, 0, len(tags))
+	for _, t := range tags {
+		opts = append(opts, t)
+	}
+	return opentracing.StartSpanFromContext(ctx, operation, opts...)
+}
+
+// WorkflowTags returns the span tags identifying a workflow execution
+func WorkflowTags(domainID string, workflowID string, runID string) []opentracing.Tag {
+	return []opentracing.Tag{
+		{Key: DomainIDTag, Value: domainID},
+		{Key: WorkflowIDTag, Value: workflowID},
+		{Key: RunIDTag, Value: runID},
+	}
+}
+
+// TaskListTags returns the span tags identifying a task list
+func TaskListTags(domainID string, taskListName string) []opentracing.Tag {
+	return []opentracing.Tag{
+		{Key: DomainIDTag, Value: domainID},
+		{Key: TaskListTag, Value: taskListName},
+	}
+}
+
+// FinishSpan finishes the span, marking it as failed if err is not nil
+func FinishSpan(span opentracing.Span, err error) {
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.SetTag("error.message", err.Error())
+	}
+	span.Finish()
+}
+
+// LogConflict records on the span of ctx that a conditional update failed and the operation is retried
+func LogConflict(ctx context.Context, attempt int) {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span.LogKV("event", conflictLogName, attemptLogKey, attempt)
+	}
+}
@@ -22,9 +22,13 @@ package common
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	farm "github.com/dgryski/go-farm"
 	h "github.com/uber/cadence/.gen/go/history"
@@ -76,12 +80,16 @@ const (
 	FailureReasonHeartbeatExceedsLimit = "HEARTBEAT_EXCEEDS_LIMIT"
 	// FailureReasonDecisionBlobSizeExceedsLimit is the failureReason for decision blob exceeds size limit
 	FailureReasonDecisionBlobSizeExceedsLimit = "DECISION_BLOB_SIZE_EXCEEDS_LIMIT"
+	// FailureReasonDecisionFailureAttemptsExceeded is the failureReason for decision tasks failing too many times in a row
+	FailureReasonDecisionFailureAttemptsExceeded = "DECISION_FAILURE_ATTEMPTS_EXCEEDED"
 	// TerminateReasonSizeExceedsLimit is reason to terminate workflow when history size or count exceed limit
 	TerminateReasonSizeExceedsLimit = "HISTORY_EXCEEDS_LIMIT"
 )
 
 var (
 	// ErrBlobSizeExceedsLimit is error for event blob size exceeds limit
+	//
+	// Deprecated: CheckEventBlobSizeLimit returns a *BlobSizeExceedsLimitError carrying the sizes instead
 	ErrBlobSizeExceedsLimit = &workflow.BadRequestError{Message: "Blob data size exceeds limit."}
 	// ErrContextTimeoutTooShort is error for setting a very short context timeout when calling a long poll API
 	ErrContextTimeoutTooShort = &workflow.BadRequestError{Message: "Context timeout is too short."}
@@ -89,6 +97,14 @@ var (
 	ErrContextTimeoutNotSet = &workflow.BadRequestError{Message: "Context timeout is not set."}
 )
 
+type (
+	// BlobSizeExceedsLimitError is the error for a blob data whose size exceeds the limit
+	BlobSizeExceedsLimitError struct {
+		Size  int
+		Limit int
+	}
+)
+
 // AwaitWaitGroup calls Wait on the given wait
 // Returns true if the Wait() call succeeded before the timeout
 // Returns false if the Wait() did not return before the timeout
@@ -300,6 +316,8 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *h.RecordDecision
 		WorkflowExecutionTaskList: historyResponse.WorkflowExecutionTaskList,
 		EventStoreVersion:         historyResponse.EventStoreVersion,
 		BranchToken:               historyResponse.BranchToken,
+		WorkflowStateSnapshot:     historyResponse.WorkflowStateSnapshot,
+		ContinueAsNewSuggested:    historyResponse.ContinueAsNewSuggested,
 	}
 	if historyResponse.GetPreviousStartedEventId() != EmptyEventID {
 		matchingResp.PreviousStartedEventId = historyResponse.PreviousStartedEventId
@@ -323,6 +341,24 @@ func MinInt(a, b int) int {
 	return b
 }
 
+// IsValidIDString returns true if the ID is valid UTF-8 and has no control characters, IDs end up in persistence keys
+func IsValidIDString(id string) bool {
+	if !utf8.ValidString(id) {
+		return false
+	}
+	for _, r := range id {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasReservedIDPrefix returns true if the ID outside of the system domain uses the prefix reserved for system workflows
+func HasReservedIDPrefix(domain string, id string) bool {
+	return domain != SystemDomainName && strings.HasPrefix(id, ReservedIDPrefix)
+}
+
 // ValidateRetryPolicy validates a retry policy
 func ValidateRetryPolicy(policy *workflow.RetryPolicy) error {
 	if policy == nil {
@@ -369,7 +405,7 @@ func CreateHistoryStartWorkflowRequest(domainID string, startRequest *workflow.S
 }
 
 // CheckEventBlobSizeLimit checks if a blob data exceeds limits. It logs a warning if it exceeds warnLimit,
-// and return ErrBlobSizeExceedsLimit if it exceeds errorLimit.
+// and returns a BlobSizeExceedsLimitError if it exceeds errorLimit.
 func CheckEventBlobSizeLimit(actualSize, warnLimit, errorLimit int, domainID, workflowID, runID string, scope metrics.Scope, logger log.Logger) error {
 	scope.RecordTimer(metrics.EventBlobSize, time.Duration(actualSize))
 
@@ -380,12 +416,29 @@ func CheckEventBlobSizeLimit(actualSize, warnLimit, errorLimit int, domainID, wo
 		}
 
 		if actualSize > errorLimit {
-			return ErrBlobSizeExceedsLimit
+			scope.IncCounter(metrics.EventBlobSizeExceedsLimitCounter)
+			return NewBlobSizeExceedsLimitError(actualSize, errorLimit)
 		}
 	}
 	return nil
 }
 
+// NewBlobSizeExceedsLimitError returns the error for a blob data whose size exceeds the limit
+func NewBlobSizeExceedsLimitError(actualSize, errorLimit int) *BlobSizeExceedsLimitError {
+	return &BlobSizeExceedsLimitError{Size: actualSize, Limit: errorLimit}
+}
+
+// Error returns the message of the error, it carries both sizes so the caller knows by how much
+// the payload has to shrink
+func (e *BlobSizeExceedsLimitError) Error() string {
+	return fmt.Sprintf("Blob data size exceeds limit, size: %v bytes, limit: %v bytes.", e.Size, e.Limit)
+}
+
+// BadRequestError converts the error to the BadRequestError returned to the caller
+func (e *BlobSizeExceedsLimitError) BadRequestError() *workflow.BadRequestError {
+	return &workflow.BadRequestError{Message: e.Error()}
+}
+
 // ValidateLongPollContextTimeout check if the context timeout for a long poll handler is too short or below a normal value.
 // If the timeout is not set or too short, it logs an error, and return ErrContextTimeoutNotSet or ErrContextTimeoutTooShort
 // accordingly. If the timeout is only below a normal value, it just logs an info and return nil.
@@ -424,3 +477,33 @@ func GetSizeOfMapStringToByteArray(input map[string][]byte) int {
 	}
 	return res + golandMapReserverNumberOfBytes
 }
+
+// GetDomainAliases returns the deprecated names recorded in the domain data
+func GetDomainAliases(data map[string]string) []string {
+	var aliases []string
+	for _, alias := range strings.Split(data[DomainDataKeyForAliases], ",") {
+		alias = strings.TrimSpace(alias)
+		if alias != "" {
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
+
+// GetActivityTaskListOverride returns the task list the domain data routes the activity type to, if any
+func GetActivityTaskListOverride(data map[string]string, activityType string) (string, bool) {
+	taskList := strings.TrimSpace(data[DomainDataKeyPrefixForActivityTaskList+activityType])
+	return taskList, taskList != ""
+}
+
+// GetDefaultTaskList returns the task list the domain data configures for workflows started without one, if any
+func GetDefaultTaskList(data map[string]string) (string, bool) {
+	taskList := strings.TrimSpace(data[DomainDataKeyForDefaultTaskList])
+	return taskList, taskList != ""
+}
+
+// GetWorkflowTypeAlias returns the workflow type name the domain data rewrites the workflow type to, if any
+func GetWorkflowTypeAlias(data map[string]string, workflowType string) (string, bool) {
+	alias := strings.TrimSpace(data[DomainDataKeyPrefixForWorkflowTypeAlias+workflowType])
+	return alias, alias != ""
+}
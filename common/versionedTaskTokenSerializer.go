@@ -0,0 +1,224 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// TaskTokenEncodingJSON writes task tokens as JSON
+	TaskTokenEncodingJSON = "json"
+	// TaskTokenEncodingBinary writes task tokens in the compact binary encoding
+	TaskTokenEncodingBinary = "binary"
+)
+
+// Unsigned JSON tokens are plain JSON objects and so always start with '{'. All other tokens start with a version
+// byte telling their encoding, signed tokens follow it with the HMAC-SHA256 of the version byte and the payload, then
+// the payload.
+const (
+	taskTokenVersionUnsignedJSON byte = '{'
+	taskTokenVersionSignedJSON   byte = 1
+	taskTokenVersionBinary       byte = 2
+	taskTokenVersionSignedBinary byte = 3
+
+	taskTokenSignatureSize = sha256.Size
+)
+
+var (
+	// ErrInvalidTaskTokenSignature is returned when the signature of a task token does not match its content
+	ErrInvalidTaskTokenSignature = errors.New("task token signature is invalid")
+	// ErrUnsignedTaskToken is returned when an unsigned task token is received while they are no longer accepted
+	ErrUnsignedTaskToken = errors.New("task token is not signed")
+	// ErrUnknownTaskTokenVersion is returned when the version of a task token is not known
+	ErrUnknownTaskTokenVersion = errors.New("task token version is unknown")
+)
+
+type (
+	versionedTaskTokenSerializer struct {
+		encoding            dynamicconfig.StringPropertyFn
+		signingKey          dynamicconfig.StringPropertyFn
+		previousSigningKeys dynamicconfig.StringPropertyFn
+		acceptUnsigned      dynamicconfig.BoolPropertyFn
+	}
+)
+
+// NewTaskTokenSerializer creates a TaskTokenSerializer which writes tokens in the given encoding and signs them
+// with an HMAC keyed by signingKey. While the key is empty tokens are written unsigned. Signed tokens are verified
+// with signingKey or any key of the comma separated previousSigningKeys, so tokens handed out before a key rotation
+// remain valid. Tokens of every version are read regardless of the encoding written. Unsigned tokens are accepted
+// as long as acceptUnsigned returns true, which allows a migration window while the key is rolled out. Nil functions
+// only parse tokens, for components which route requests by token and leave the verification to the service
+// handling the request.
+func NewTaskTokenSerializer(
+	encoding dynamicconfig.StringPropertyFn,
+	signingKey dynamicconfig.StringPropertyFn,
+	previousSigningKeys dynamicconfig.StringPropertyFn,
+	acceptUnsigned dynamicconfig.BoolPropertyFn,
+) TaskTokenSerializer {
+	return &versionedTaskTokenSerializer{
+		encoding:            encoding,
+		signingKey:          signingKey,
+		previousSigningKeys: previousSigningKeys,
+		acceptUnsigned:      acceptUnsigned,
+	}
+}
+
+func (s *versionedTaskTokenSerializer) Serialize(token *TaskToken) ([]byte, error) {
+	if s.isBinary() {
+		return s.seal(taskTokenVersionBinary, taskTokenVersionSignedBinary, encodeBinaryTaskToken(token)), nil
+	}
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+	return s.seal(taskTokenVersionUnsignedJSON, taskTokenVersionSignedJSON, payload), nil
+}
+
+func (s *versionedTaskTokenSerializer) Deserialize(data []byte) (*TaskToken, error) {
+	var token TaskToken
+	binaryPayload, payload, err := s.open(data)
+	if err != nil {
+		return &token, err
+	}
+	if binaryPayload {
+		err = decodeBinaryTaskToken(payload, &token)
+	} else {
+		err = json.Unmarshal(payload, &token)
+	}
+
+	return &token, err
+}
+
+func (s *versionedTaskTokenSerializer) SerializeQueryTaskToken(token *QueryTaskToken) ([]byte, error) {
+	if s.isBinary() {
+		return s.seal(taskTokenVersionBinary, taskTokenVersionSignedBinary, encodeBinaryQueryTaskToken(token)), nil
+	}
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+	return s.seal(taskTokenVersionUnsignedJSON, taskTokenVersionSignedJSON, payload), nil
+}
+
+func (s *versionedTaskTokenSerializer) DeserializeQueryTaskToken(data []byte) (*QueryTaskToken, error) {
+	var token QueryTaskToken
+	binaryPayload, payload, err := s.open(data)
+	if err != nil {
+		return &token, err
+	}
+	if binaryPayload {
+		err = decodeBinaryQueryTaskToken(payload, &token)
+	} else {
+		err = json.Unmarshal(payload, &token)
+	}
+
+	return &token, err
+}
+
+func (s *versionedTaskTokenSerializer) isBinary() bool {
+	return s.encoding != nil && s.encoding() == TaskTokenEncodingBinary
+}
+
+func (s *versionedTaskTokenSerializer) key() []byte {
+	if s.signingKey == nil {
+		return nil
+	}
+	return []byte(s.signingKey())
+}
+
+// verify checks the signature of the version and the payload with the current and the previous signing keys
+func (s *versionedTaskTokenSerializer) verify(key []byte, version byte, payload []byte, signature []byte) bool {
+	if hmac.Equal(signature, signTaskToken(key, version, payload)) {
+		return true
+	}
+	if s.previousSigningKeys == nil {
+		return false
+	}
+	for _, previousKey := range strings.Split(s.previousSigningKeys(), ",") {
+		if previousKey != "" && hmac.Equal(signature, signTaskToken([]byte(previousKey), version, payload)) {
+			return true
+		}
+	}
+	return false
+}
+
+// seal adds the version and the signature to the payload, unsigned JSON tokens are the bare payload
+func (s *versionedTaskTokenSerializer) seal(unsignedVersion byte, signedVersion byte, payload []byte) []byte {
+	key := s.key()
+	if len(key) == 0 {
+		if unsignedVersion == taskTokenVersionUnsignedJSON {
+			return payload
+		}
+		return append([]byte{unsignedVersion}, payload...)
+	}
+
+	data := make([]byte, 0, 1+taskTokenSignatureSize+len(payload))
+	data = append(data, signedVersion)
+	data = append(data, signTaskToken(key, signedVersion, payload)...)
+	return append(data, payload...)
+}
+
+// open verifies the version and the signature of a token and returns its payload and whether it is binary encoded
+func (s *versionedTaskTokenSerializer) open(data []byte) (bool, []byte, error) {
+	if len(data) == 0 {
+		return false, nil, ErrUnknownTaskTokenVersion
+	}
+
+	key := s.key()
+	switch data[0] {
+	case taskTokenVersionUnsignedJSON, taskTokenVersionBinary:
+		if len(key) != 0 && (s.acceptUnsigned == nil || !s.acceptUnsigned()) {
+			return false, nil, ErrUnsignedTaskToken
+		}
+		if data[0] == taskTokenVersionBinary {
+			return true, data[1:], nil
+		}
+		return false, data, nil
+	case taskTokenVersionSignedJSON, taskTokenVersionSignedBinary:
+		if len(data) < 1+taskTokenSignatureSize {
+			return false, nil, ErrInvalidTaskTokenSignature
+		}
+		signature := data[1 : 1+taskTokenSignatureSize]
+		payload := data[1+taskTokenSignatureSize:]
+		// without a key the token can only be parsed, the service handling the request verifies it
+		if len(key) != 0 && !s.verify(key, data[0], payload, signature) {
+			return false, nil, ErrInvalidTaskTokenSignature
+		}
+		return data[0] == taskTokenVersionSignedBinary, payload, nil
+	default:
+		return false, nil, ErrUnknownTaskTokenVersion
+	}
+}
+
+// signTaskToken signs the version byte along with the payload, so the encoding of a token can not be swapped
+func signTaskToken(key []byte, version byte, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte{version})
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	taskTokenSerializerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		token *TaskToken
+	}
+)
+
+func TestTaskTokenSerializerSuite(t *testing.T) {
+	s := new(taskTokenSerializerSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskTokenSerializerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.token = &TaskToken{
+		DomainID:        "domain-id",
+		WorkflowID:      "workflow-id",
+		RunID:           "run-id",
+		ScheduleID:      5,
+		ScheduleAttempt: 2,
+		ActivityID:      "activity-id",
+	}
+}
+
+func (s *taskTokenSerializerSuite) TestSignedRoundTrip() {
+	serializer := newTestTaskTokenSerializer("key", "", false)
+	data, err := serializer.Serialize(s.token)
+	s.NoError(err)
+	s.Equal(taskTokenVersionSignedJSON, data[0])
+
+	token, err := serializer.Deserialize(data)
+	s.NoError(err)
+	s.Equal(s.token, token)
+
+	queryData, err := serializer.SerializeQueryTaskToken(&QueryTaskToken{DomainID: "domain-id", TaskID: "task-id"})
+	s.NoError(err)
+	queryToken, err := serializer.DeserializeQueryTaskToken(queryData)
+	s.NoError(err)
+	s.Equal("task-id", queryToken.TaskID)
+}
+
+func (s *taskTokenSerializerSuite) TestTamperedPayload() {
+	serializer := newTestTaskTokenSerializer("key", "", false)
+	data, err := serializer.Serialize(s.token)
+	s.NoError(err)
+
+	data[len(data)-2] ^= 1
+	_, err = serializer.Deserialize(data)
+	s.Equal(ErrInvalidTaskTokenSignature, err)
+}
+
+func (s *taskTokenSerializerSuite) TestTamperedVersion() {
+	serializer := newTestTaskTokenSerializer("key", "", false)
+	data, err := serializer.Serialize(s.token)
+	s.NoError(err)
+
+	data[0] = taskTokenVersionSignedBinary
+	_, err = serializer.Deserialize(data)
+	s.Equal(ErrInvalidTaskTokenSignature, err)
+}
+
+func (s *taskTokenSerializerSuite) TestTruncatedSignature() {
+	serializer := newTestTaskTokenSerializer("key", "", false)
+	_, err := serializer.Deserialize([]byte{taskTokenVersionSignedJSON, 1, 2, 3})
+	s.Equal(ErrInvalidTaskTokenSignature, err)
+	_, err = serializer.Deserialize(nil)
+	s.Equal(ErrUnknownTaskTokenVersion, err)
+	_, err = serializer.Deserialize([]byte{42})
+	s.Equal(ErrUnknownTaskTokenVersion, err)
+}
+
+func (s *taskTokenSerializerSuite) TestWrongKey() {
+	data, err := newTestTaskTokenSerializer("old-key", "", false).Serialize(s.token)
+	s.NoError(err)
+
+	_, err = newTestTaskTokenSerializer("key", "", false).Deserialize(data)
+	s.Equal(ErrInvalidTaskTokenSignature, err)
+}
+
+func (s *taskTokenSerializerSuite) TestPreviousKeys() {
+	data, err := newTestTaskTokenSerializer("old-key", "", false).Serialize(s.token)
+	s.NoError(err)
+
+	token, err := newTestTaskTokenSerializer("key", "older-key,old-key", false).Deserialize(data)
+	s.NoError(err)
+	s.Equal(s.token, token)
+
+	_, err = newTestTaskTokenSerializer("key", "older-key", false).Deserialize(data)
+	s.Equal(ErrInvalidTaskTokenSignature, err)
+}
+
+func (s *taskTokenSerializerSuite) TestUnsignedToken() {
+	data, err := newTestTaskTokenSerializer("", "", false).Serialize(s.token)
+	s.NoError(err)
+	s.Equal(taskTokenVersionUnsignedJSON, data[0])
+
+	token, err := newTestTaskTokenSerializer("key", "", true).Deserialize(data)
+	s.NoError(err)
+	s.Equal(s.token, token)
+
+	_, err = newTestTaskTokenSerializer("key", "", false).Deserialize(data)
+	s.Equal(ErrUnsignedTaskToken, err)
+
+	// without a key every token is accepted, as no token is signed yet
+	token, err = newTestTaskTokenSerializer("", "", false).Deserialize(data)
+	s.NoError(err)
+	s.Equal(s.token, token)
+}
+
+func (s *taskTokenSerializerSuite) TestParseOnly() {
+	serializer := NewTaskTokenSerializer(nil, nil, nil, nil)
+	data, err := newTestTaskTokenSerializer("key", "", false).Serialize(s.token)
+	s.NoError(err)
+
+	token, err := serializer.Deserialize(data)
+	s.NoError(err)
+	s.Equal(s.token, token)
+
+	unsigned, err := serializer.Serialize(s.token)
+	s.NoError(err)
+	token, err = serializer.Deserialize(unsigned)
+	s.NoError(err)
+	s.Equal(s.token, token)
+}
+
+func newTestTaskTokenSerializer(key string, previousKeys string, acceptUnsigned bool) TaskTokenSerializer {
+	return NewTaskTokenSerializer(
+		dynamicconfig.GetStringPropertyFn(TaskTokenEncodingJSON),
+		dynamicconfig.GetStringPropertyFn(key),
+		dynamicconfig.GetStringPropertyFn(previousKeys),
+		dynamicconfig.GetBoolPropertyFn(acceptUnsigned),
+	)
+}
@@ -38,6 +38,9 @@ export DBNAME="${DBNAME:-cadence}"
 export VISIBILITY_DBNAME="${VISIBILITY_DBNAME:-cadence_visibility}"
 export DB_PORT=${DB_PORT:-3306}
 
+#postgres env
+export POSTGRES_PORT=${POSTGRES_PORT:-5432}
+
 
 setup_cassandra_schema() {
     SCHEMA_DIR=$CADENCE_HOME/schema/cassandra/cadence/versioned
@@ -57,9 +60,20 @@ setup_mysql_schema() {
     mysql -h $MYSQL_SEEDS -u$MYSQL_USER -p$MYSQL_PWD $VISIBILITY_DBNAME < $CADENCE_HOME/schema/mysql/v57/visibility/schema.sql
 }
 
+setup_postgres_schema() {
+    export PGPASSWORD=$POSTGRES_PWD
+    PSQL="psql -v ON_ERROR_STOP=1 -h $POSTGRES_SEEDS -p $POSTGRES_PORT -U $POSTGRES_USER"
+    $PSQL -d postgres -f $CADENCE_HOME/schema/postgres/cadence/database.sql
+    $PSQL -d $DBNAME -f $CADENCE_HOME/schema/postgres/cadence/schema.sql
+    $PSQL -d postgres -f $CADENCE_HOME/schema/postgres/visibility/database.sql
+    $PSQL -d $VISIBILITY_DBNAME -f $CADENCE_HOME/schema/postgres/visibility/schema.sql
+}
+
 setup_schema() {
     if [ "$DB" == "mysql" ]; then
         setup_mysql_schema
+    elif [ "$DB" == "postgres" ]; then
+        setup_postgres_schema
     else
         setup_cassandra_schema
     fi
@@ -85,9 +99,22 @@ wait_for_mysql() {
     echo 'mysql started'
 }
 
+wait_for_postgres() {
+    server=`echo $POSTGRES_SEEDS | awk -F ',' '{print $1}'`
+    nc -z $server $POSTGRES_PORT < /dev/null
+    until [ $? -eq 0 ]; do
+        echo 'waiting for postgres to start up'
+        sleep 1
+        nc -z $server $POSTGRES_PORT < /dev/null
+    done
+    echo 'postgres started'
+}
+
 wait_for_db() {
     if [ "$DB" == "mysql" ]; then
         wait_for_mysql
+    elif [ "$DB" == "postgres" ]; then
+        wait_for_postgres
     else
         wait_for_cassandra
     fi
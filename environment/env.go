@@ -44,6 +44,13 @@ const (
 	// MySQLDefaultPort MySQL default port
 	MySQLDefaultPort = "3306"
 
+	// PostgresSeeds env
+	PostgresSeeds = "POSTGRES_SEEDS"
+	// PostgresPort env
+	PostgresPort = "POSTGRES_PORT"
+	// PostgresDefaultPort Postgres default port
+	PostgresDefaultPort = "5432"
+
 	// KafkaSeeds env
 	KafkaSeeds = "KAFKA_SEEDS"
 	// KafkaPort env
@@ -89,6 +96,20 @@ func SetupEnv() {
 		}
 	}
 
+	if os.Getenv(PostgresSeeds) == "" {
+		err := os.Setenv(PostgresSeeds, Localhost)
+		if err != nil {
+			panic(fmt.Sprintf("error setting env %v", PostgresSeeds))
+		}
+	}
+
+	if os.Getenv(PostgresPort) == "" {
+		err := os.Setenv(PostgresPort, PostgresDefaultPort)
+		if err != nil {
+			panic(fmt.Sprintf("error setting env %v", PostgresPort))
+		}
+	}
+
 	if os.Getenv(KafkaSeeds) == "" {
 		err := os.Setenv(KafkaSeeds, Localhost)
 		if err != nil {
@@ -162,6 +183,28 @@ func GetMySQLPort() int {
 	return p
 }
 
+// GetPostgresAddress return the Postgres address
+func GetPostgresAddress() string {
+	addr := os.Getenv(PostgresSeeds)
+	if addr == "" {
+		addr = Localhost
+	}
+	return addr
+}
+
+// GetPostgresPort return the Postgres port
+func GetPostgresPort() int {
+	port := os.Getenv(PostgresPort)
+	if port == "" {
+		port = PostgresDefaultPort
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		panic(fmt.Sprintf("error getting env %v", PostgresPort))
+	}
+	return p
+}
+
 // GetKafkaAddress return the kafka address
 func GetKafkaAddress() string {
 	addr := os.Getenv(KafkaSeeds)
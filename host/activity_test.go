@@ -32,8 +32,8 @@ import (
 	"github.com/pborman/uuid"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log/tag"
-	"github.com/uber/cadence/service/matching"
 )
 
 func (s *integrationSuite) TestActivityHeartBeatWorkflow_Success() {
@@ -131,10 +131,10 @@ func (s *integrationSuite) TestActivityHeartBeatWorkflow_Success() {
 	}
 
 	_, err := poller.PollAndProcessDecisionTask(false, false)
-	s.True(err == nil || err == matching.ErrNoTasks)
+	s.True(err == nil || ce.IsNoTasksError(err))
 
 	err = poller.PollAndProcessActivityTask(false)
-	s.True(err == nil || err == matching.ErrNoTasks)
+	s.True(err == nil || ce.IsNoTasksError(err))
 
 	s.Logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(*we.RunId))
 
@@ -453,10 +453,10 @@ func (s *integrationSuite) TestActivityRetry() {
 	s.True(err == nil, err)
 
 	err = poller.PollAndProcessActivityTask(false)
-	s.True(err == nil || err == matching.ErrNoTasks, err)
+	s.True(err == nil || ce.IsNoTasksError(err), err)
 
 	err = poller.PollAndProcessActivityTask(false)
-	s.True(err == nil || err == matching.ErrNoTasks, err)
+	s.True(err == nil || ce.IsNoTasksError(err), err)
 
 	s.Logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(*we.RunId))
 	for i := 0; i < 3; i++ {
@@ -576,7 +576,7 @@ func (s *integrationSuite) TestActivityHeartBeatWorkflow_Timeout() {
 	}
 
 	_, err := poller.PollAndProcessDecisionTask(false, false)
-	s.True(err == nil || err == matching.ErrNoTasks)
+	s.True(err == nil || ce.IsNoTasksError(err))
 
 	err = poller.PollAndProcessActivityTask(false)
 
@@ -799,7 +799,7 @@ func (s *integrationSuite) TestActivityTimeouts() {
 	}
 
 	_, err := poller.PollAndProcessDecisionTask(false, false)
-	s.True(err == nil || err == matching.ErrNoTasks)
+	s.True(err == nil || ce.IsNoTasksError(err))
 
 	for i := 0; i < 3; i++ {
 		go func() {
@@ -987,7 +987,7 @@ func (s *integrationSuite) TestActivityHeartbeatTimeouts() {
 	}
 
 	_, err := poller.PollAndProcessDecisionTask(false, false)
-	s.True(err == nil || err == matching.ErrNoTasks)
+	s.True(err == nil || ce.IsNoTasksError(err))
 
 	for i := 0; i < activityCount; i++ {
 		go func() {
@@ -1128,7 +1128,7 @@ func (s *integrationSuite) TestActivityCancellation() {
 	}
 
 	_, err := poller.PollAndProcessDecisionTask(false, false)
-	s.True(err == nil || err == matching.ErrNoTasks, err)
+	s.True(err == nil || ce.IsNoTasksError(err), err)
 
 	cancelCh := make(chan struct{})
 
@@ -1137,12 +1137,12 @@ func (s *integrationSuite) TestActivityCancellation() {
 		scheduleActivity = false
 		requestCancellation = true
 		_, err := poller.PollAndProcessDecisionTask(false, false)
-		s.True(err == nil || err == matching.ErrNoTasks, err)
+		s.True(err == nil || ce.IsNoTasksError(err), err)
 		cancelCh <- struct{}{}
 	}()
 
 	err = poller.PollAndProcessActivityTask(false)
-	s.True(err == nil || err == matching.ErrNoTasks, err)
+	s.True(err == nil || ce.IsNoTasksError(err), err)
 
 	<-cancelCh
 	s.Logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(*we.RunId))
@@ -1242,7 +1242,7 @@ func (s *integrationSuite) TestActivityCancellationNotStarted() {
 	}
 
 	_, err := poller.PollAndProcessDecisionTask(false, false)
-	s.True(err == nil || err == matching.ErrNoTasks)
+	s.True(err == nil || ce.IsNoTasksError(err))
 
 	// Send signal so that worker can send an activity cancel
 	signalName := "my signal"
@@ -1268,7 +1268,7 @@ func (s *integrationSuite) TestActivityCancellationNotStarted() {
 	scheduleActivity = false
 	requestCancellation = false
 	_, err = poller.PollAndProcessDecisionTask(false, false)
-	s.True(err == nil || err == matching.ErrNoTasks)
+	s.True(err == nil || ce.IsNoTasksError(err))
 
 	s.printWorkflowHistory(s.domainName, &workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(id),
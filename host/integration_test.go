@@ -37,8 +37,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log/tag"
-	"github.com/uber/cadence/service/matching"
 )
 
 type (
@@ -569,11 +569,11 @@ func (s *integrationSuite) TestDecisionAndActivityTimeoutsWorkflow() {
 			history := historyResponse.History
 			common.PrettyPrintHistory(history, s.Logger)
 		}
-		s.True(err == nil || err == matching.ErrNoTasks, "Error", tag.Error(err))
+		s.True(err == nil || ce.IsNoTasksError(err), "Error", tag.Error(err))
 		if !dropDecisionTask {
 			s.Logger.Info("Calling Activity Task: %d", tag.Counter(i))
 			err = poller.PollAndProcessActivityTask(i%4 == 0)
-			s.True(err == nil || err == matching.ErrNoTasks)
+			s.True(err == nil || ce.IsNoTasksError(err))
 		}
 	}
 
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
@@ -45,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattribute"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -87,6 +89,7 @@ type (
 		messagingClient     messaging.Client
 		metadataMgr         persistence.MetadataManager
 		metadataMgrV2       persistence.MetadataManager
+		clusterMetadataMgr  persistence.ClusterMetadataManager
 		shardMgr            persistence.ShardManager
 		historyMgr          persistence.HistoryManager
 		historyV2Mgr        persistence.HistoryV2Manager
@@ -124,6 +127,7 @@ type (
 		MessagingClient               messaging.Client
 		MetadataMgr                   persistence.MetadataManager
 		MetadataMgrV2                 persistence.MetadataManager
+		ClusterMetadataMgr            persistence.ClusterMetadataManager
 		ShardMgr                      persistence.ShardManager
 		HistoryMgr                    persistence.HistoryManager
 		HistoryV2Mgr                  persistence.HistoryV2Manager
@@ -157,6 +161,7 @@ func NewCadence(params *CadenceParams) Cadence {
 		messagingClient:     params.MessagingClient,
 		metadataMgr:         params.MetadataMgr,
 		metadataMgrV2:       params.MetadataMgrV2,
+		clusterMetadataMgr:  params.ClusterMetadataMgr,
 		visibilityMgr:       params.VisibilityMgr,
 		shardMgr:            params.ShardMgr,
 		historyMgr:          params.HistoryMgr,
@@ -352,13 +357,15 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 
 	c.initLock.Lock()
 	c.frontEndService = service.New(params)
-	c.adminHandler = frontend.NewAdminHandler(
-		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr)
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, c.logger)
 	frontendConfig := frontend.NewConfig(dc, c.historyConfig.NumHistoryShards, c.workerConfig.EnableIndexer, true)
+	searchAttrs := searchattribute.NewRegistry(c.clusterMetadataMgr, frontendConfig.SearchAttributesRefreshInterval, c.logger)
+	c.adminHandler = frontend.NewAdminHandler(
+		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr, searchAttrs,
+		authorization.NewNopAuthorizer(), params.DynamicConfig)
 	c.frontendHandler = frontend.NewWorkflowHandler(
 		c.frontEndService, frontendConfig, c.metadataMgr, c.historyMgr, c.historyV2Mgr,
-		c.visibilityMgr, kafkaProducer, params.BlobstoreClient)
+		c.visibilityMgr, kafkaProducer, params.BlobstoreClient, authorization.NewNopAuthorizer(), searchAttrs)
 	err = c.frontendHandler.Start()
 	if err != nil {
 		c.logger.Fatal("Failed to start frontend", tag.Error(err))
@@ -407,6 +414,8 @@ func (c *cadenceImpl) startHistory(hosts map[string][]string, startWG *sync.Wait
 		historyConfig.HistoryMgrNumConns = dynamicconfig.GetIntPropertyFn(hConfig.NumHistoryShards)
 		historyConfig.ExecutionMgrNumConns = dynamicconfig.GetIntPropertyFn(hConfig.NumHistoryShards)
 		historyConfig.EnableEventsV2 = dynamicconfig.GetBoolPropertyFnFilteredByDomain(enableEventsV2)
+		// the tests fail decisions repeatedly and poll for their next attempt right away
+		historyConfig.DecisionRetryInitialBackoff = dynamicconfig.GetDurationPropertyFn(0)
 		if hConfig.HistoryCountLimitWarn != 0 {
 			historyConfig.HistoryCountLimitWarn = dynamicconfig.GetIntPropertyFilteredByDomain(hConfig.HistoryCountLimitWarn)
 		}
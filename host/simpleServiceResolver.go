@@ -46,6 +46,10 @@ func (s *simpleResolver) Lookup(key string) (*membership.HostInfo, error) {
 	return s.hosts[idx], nil
 }
 
+func (s *simpleResolver) Members() []*membership.HostInfo {
+	return s.hosts
+}
+
 func (s *simpleResolver) AddListener(name string, notifyChannel chan<- *membership.ChangedEvent) error {
 	return nil
 }
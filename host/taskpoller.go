@@ -29,10 +29,10 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
-	"github.com/uber/cadence/service/history"
-	"github.com/uber/cadence/service/matching"
+	"github.com/uber/cadence/common/persistence"
 	"go.uber.org/yarpc"
 )
 
@@ -112,11 +112,6 @@ Loop:
 			Identity: common.StringPtr(p.Identity),
 		})
 
-		if err1 == history.ErrDuplicate {
-			p.Logger.Info("Duplicate Decision task: Polling again.")
-			continue Loop
-		}
-
 		if err1 != nil {
 			return false, nil, err1
 		}
@@ -252,7 +247,7 @@ Loop:
 		return false, newTask, err
 	}
 
-	return false, nil, matching.ErrNoTasks
+	return false, nil, ce.NewNoTasksError(p.Domain, p.TaskList.GetName(), persistence.TaskListTypeDecision)
 }
 
 // HandlePartialDecision for decision task
@@ -329,7 +324,6 @@ func (p *TaskPoller) HandlePartialDecision(response *workflow.PollForDecisionTas
 
 // PollAndProcessActivityTask for activity tasks
 func (p *TaskPoller) PollAndProcessActivityTask(dropTask bool) error {
-retry:
 	for attempt := 0; attempt < 5; attempt++ {
 		response, err1 := p.Engine.PollForActivityTask(createContext(), &workflow.PollForActivityTaskRequest{
 			Domain:   common.StringPtr(p.Domain),
@@ -337,11 +331,6 @@ retry:
 			Identity: common.StringPtr(p.Identity),
 		})
 
-		if err1 == history.ErrDuplicate {
-			p.Logger.Info("Duplicate Activity task: Polling again.")
-			continue retry
-		}
-
 		if err1 != nil {
 			return err1
 		}
@@ -383,12 +372,11 @@ retry:
 		})
 	}
 
-	return matching.ErrNoTasks
+	return ce.NewNoTasksError(p.Domain, p.TaskList.GetName(), persistence.TaskListTypeActivity)
 }
 
 // PollAndProcessActivityTaskWithID is similar to PollAndProcessActivityTask but using RespondActivityTask...ByID
 func (p *TaskPoller) PollAndProcessActivityTaskWithID(dropTask bool) error {
-retry:
 	for attempt := 0; attempt < 5; attempt++ {
 		response, err1 := p.Engine.PollForActivityTask(createContext(), &workflow.PollForActivityTaskRequest{
 			Domain:   common.StringPtr(p.Domain),
@@ -396,11 +384,6 @@ retry:
 			Identity: common.StringPtr(p.Identity),
 		})
 
-		if err1 == history.ErrDuplicate {
-			p.Logger.Info("Duplicate Activity task: Polling again.")
-			continue retry
-		}
-
 		if err1 != nil {
 			return err1
 		}
@@ -456,7 +439,7 @@ retry:
 		})
 	}
 
-	return matching.ErrNoTasks
+	return ce.NewNoTasksError(p.Domain, p.TaskList.GetName(), persistence.TaskListTypeActivity)
 }
 
 func createContext() context.Context {
@@ -125,7 +125,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 			VisibilityListMaxQPS:   dynamicconfig.GetIntPropertyFilteredByDomain(2000),
 			ESIndexMaxResultWindow: dynamicconfig.GetIntPropertyFn(100),
 		}
-		esVisibilityStore := pes.NewElasticSearchVisibilityStore(esClient, indexName, visProducer, visConfig, logger)
+		esVisibilityStore := pes.NewElasticSearchVisibilityStore(esClient, elasticsearch.NewIndexRouter(indexName, nil), visProducer, visConfig, logger)
 		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, logger)
 	}
 	visibilityMgr := persistence.NewVisibilityManagerWrapper(testBase.VisibilityMgr, esVisibilityMgr,
@@ -140,6 +140,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		MessagingClient:     messagingClient,
 		MetadataMgr:         testBase.MetadataProxy,
 		MetadataMgrV2:       testBase.MetadataManagerV2,
+		ClusterMetadataMgr:  testBase.ClusterMetadataMgr,
 		ShardMgr:            testBase.ShardMgr,
 		HistoryMgr:          testBase.HistoryMgr,
 		HistoryV2Mgr:        testBase.HistoryV2Mgr,
@@ -47,6 +47,16 @@ service AdminService {
       3: shared.AccessDeniedError     accessDeniedError,
     )
 
+  /**
+  * DescribeShardDistribution returns the history hosts which own each shard according to the membership ring
+  **/
+  DescribeShardDistributionResponse DescribeShardDistribution(1: DescribeShardDistributionRequest request)
+    throws (
+      1: shared.BadRequestError       badRequestError,
+      2: shared.InternalServiceError  internalServiceError,
+      3: shared.AccessDeniedError     accessDeniedError,
+    )
+
   /**
   * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow
   * execution in unknown to the service.
@@ -58,6 +68,145 @@ service AdminService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * AddSearchAttribute registers the keys and value types of custom search attributes. It fails with
+  * 'BadRequestError' if a key is already registered with a different type, registered keys cannot be removed.
+  **/
+  void AddSearchAttribute(1: AddSearchAttributeRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReadTransferDLQ returns the transfer tasks which were moved to the DLQ of the given shard after exceeding the retry limit.
+  **/
+  shared.ReadTransferDLQResponse ReadTransferDLQ(1: shared.ReadTransferDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MergeTransferDLQ processes the transfer tasks in the DLQ of the given shard again, tasks which succeed are removed from the DLQ.
+  **/
+  shared.MergeTransferDLQResponse MergeTransferDLQ(1: shared.MergeTransferDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * PurgeTransferDLQ deletes the transfer tasks in the DLQ of the given shard up to the given task ID.
+  **/
+  void PurgeTransferDLQ(1: shared.PurgeTransferDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReadReplicationDLQ returns the replication tasks from the given source cluster which were moved to the DLQ of the given shard after failing to apply.
+  **/
+  shared.ReadReplicationDLQResponse ReadReplicationDLQ(1: shared.ReadReplicationDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MergeReplicationDLQ replicates the workflows of the replication tasks in the DLQ of the given shard again from the source cluster, tasks which succeed are removed from the DLQ.
+  **/
+  shared.MergeReplicationDLQResponse MergeReplicationDLQ(1: shared.MergeReplicationDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * PurgeReplicationDLQ deletes the replication tasks from the given source cluster in the DLQ of the given shard up to the given task ID.
+  **/
+  void PurgeReplicationDLQ(1: shared.PurgeReplicationDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RemoveTask deletes a single transfer or timer task of the given shard, e.g. a task which keeps failing and blocks
+  * the queue processor.  The shard should be closed afterwards so that its processors reload their queues.
+  **/
+  void RemoveTask(1: shared.RemoveTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * CloseShard closes the given shard on the history host currently owning it, the shard is then acquired again by
+  * the owner according to the membership ring on the next request routed to it.
+  **/
+  void CloseShard(1: shared.CloseShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * AddWorkflowExecutionAnnotation attaches an operator annotation to a workflow execution, an existing annotation
+  * with the same key is replaced.  Annotations are returned by DescribeWorkflowExecution.
+  **/
+  void AddWorkflowExecutionAnnotation(1: AddWorkflowExecutionAnnotationRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ResetWorkflowExecution resets a workflow execution to the given DecisionTaskCompleted event.  The history is forked
+  * at that event into a new run, signals received after the event are carried over, and the current run is terminated.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: shared.ResetWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UpdateDynamicConfig sets the value of a dynamic config key for the given filters on all the hosts of the cluster,
+  * an unset value removes it.  Every update creates a new config version which can be rolled back.
+  **/
+  UpdateDynamicConfigResponse UpdateDynamicConfig(1: UpdateDynamicConfigRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * RollbackDynamicConfig restores the dynamic config version which preceded the latest change on all the hosts of
+  * the cluster.
+  **/
+  RollbackDynamicConfigResponse RollbackDynamicConfig(1: RollbackDynamicConfigRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
@@ -65,6 +214,14 @@ struct DescribeWorkflowExecutionRequest {
   20: optional shared.WorkflowExecution     execution
 }
 
+struct AddWorkflowExecutionAnnotationRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional string key
+  40: optional string text
+  50: optional string author
+}
+
 struct DescribeWorkflowExecutionResponse{
   10: optional string shardId
   20: optional string historyAddr
@@ -72,6 +229,19 @@ struct DescribeWorkflowExecutionResponse{
   50: optional string mutableStateInDatabase
 }
 
+struct DescribeShardDistributionRequest {
+}
+
+struct DescribeShardDistributionResponse {
+  10: optional i32 numberOfShards
+  20: optional list<HostShardDistribution> hosts
+}
+
+struct HostShardDistribution {
+  10: optional string address
+  20: optional list<i32> shardIDs
+}
+
 struct GetWorkflowExecutionRawHistoryRequest {
   10: optional string domain
   20: optional shared.WorkflowExecution execution
@@ -86,4 +256,35 @@ struct GetWorkflowExecutionRawHistoryResponse {
   20: optional list<shared.DataBlob> historyBatches
   30: optional map<string, shared.ReplicationInfo> replicationInfo
   40: optional i32 eventStoreVersion
+}
+
+struct AddSearchAttributeRequest {
+  10: optional map<string, shared.IndexedValueType> searchAttribute
+}
+
+struct DynamicConfigFilter {
+  10: optional string name
+  20: optional string value
+}
+
+struct UpdateDynamicConfigRequest {
+  10: optional string key
+  20: optional list<DynamicConfigFilter> filters
+  // JSON encoded value
+  30: optional binary value
+  40: optional string changedBy
+  50: optional string reason
+}
+
+struct UpdateDynamicConfigResponse {
+  10: optional i64 (js.type = "Long") version
+}
+
+struct RollbackDynamicConfigRequest {
+  10: optional string changedBy
+  20: optional string reason
+}
+
+struct RollbackDynamicConfigResponse {
+  10: optional i64 (js.type = "Long") version
 }
\ No newline at end of file
@@ -155,6 +155,12 @@ struct RecordDecisionTaskStartedRequest {
   40: optional i64 (js.type = "Long") taskId
   45: optional string requestId // Unique id of each poll request. Used to ensure at most once delivery of tasks.
   50: optional shared.PollForDecisionTaskRequest pollRequest
+  // time the task was added to matching, used to measure the delay to dispatch it
+  60: optional i64 (js.type = "Long") taskCreatedTimestamp
+  // time the poll which got the task was received by matching
+  70: optional i64 (js.type = "Long") pollStartedTimestamp
+  // number of tasks backlogged in the task list when the task was dispatched, counting the task if it was backlogged
+  80: optional i64 (js.type = "Long") backlogCountHint
 }
 
 struct RecordDecisionTaskStartedResponse {
@@ -169,6 +175,8 @@ struct RecordDecisionTaskStartedResponse {
   90: optional shared.TaskList WorkflowExecutionTaskList
   100: optional i32 eventStoreVersion
   110: optional binary branchToken
+  120: optional shared.WorkflowStateSnapshot workflowStateSnapshot
+  130: optional bool continueAsNewSuggested
 }
 
 struct SignalWorkflowExecutionRequest {
@@ -194,6 +202,22 @@ struct TerminateWorkflowExecutionRequest {
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.PauseWorkflowExecutionRequest pauseRequest
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.ResumeWorkflowExecutionRequest resumeRequest
+}
+
+struct AddWorkflowExecutionAnnotationRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional shared.WorkflowExecutionAnnotation annotation
+}
+
 struct ResetWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.ResetWorkflowExecutionRequest resetRequest
@@ -538,6 +562,36 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event in the
+  * history. While paused no new decision tasks are scheduled and user timers do not fire.
+  **/
+  void PauseWorkflowExecution(1: PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event in the
+  * history.
+  **/
+  void ResumeWorkflowExecution(1: ResumeWorkflowExecutionRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * ResetWorkflowExecution reset an existing workflow execution by a firstEventID of a existing event batch
   * in the history and immediately terminating the current execution instance.
@@ -686,4 +740,107 @@ service HistoryService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.AccessDeniedError accessDeniedError,
     )
+
+  /**
+  * ReadTransferDLQ returns the transfer tasks which were moved to the DLQ of the given shard after exceeding the retry limit.
+  **/
+  shared.ReadTransferDLQResponse ReadTransferDLQ(1: shared.ReadTransferDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MergeTransferDLQ processes the transfer tasks in the DLQ of the given shard again, tasks which succeed are removed from the DLQ.
+  **/
+  shared.MergeTransferDLQResponse MergeTransferDLQ(1: shared.MergeTransferDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * PurgeTransferDLQ deletes the transfer tasks in the DLQ of the given shard up to the given task ID.
+  **/
+  void PurgeTransferDLQ(1: shared.PurgeTransferDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReadReplicationDLQ returns the replication tasks from the given source cluster which were moved to the DLQ of the given shard after failing to apply.
+  **/
+  shared.ReadReplicationDLQResponse ReadReplicationDLQ(1: shared.ReadReplicationDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MergeReplicationDLQ replicates the workflows of the replication tasks in the DLQ of the given shard again from the source cluster, tasks which succeed are removed from the DLQ.
+  **/
+  shared.MergeReplicationDLQResponse MergeReplicationDLQ(1: shared.MergeReplicationDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * PurgeReplicationDLQ deletes the replication tasks from the given source cluster in the DLQ of the given shard up to the given task ID.
+  **/
+  void PurgeReplicationDLQ(1: shared.PurgeReplicationDLQRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RemoveTask deletes a single transfer or timer task of the given shard.  The shard should be closed afterwards so
+  * that its processors reload their queues without the removed task.
+  **/
+  void RemoveTask(1: shared.RemoveTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * CloseShard stops the engine of the given shard on this host, the shard is acquired again on the next request
+  * routed to it.
+  **/
+  void CloseShard(1: shared.CloseShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * AddWorkflowExecutionAnnotation attaches an operator annotation to a workflow execution, an existing annotation
+  * with the same key is replaced.  Annotations are stored outside of the mutable state and history.
+  **/
+  void AddWorkflowExecutionAnnotation(1: AddWorkflowExecutionAnnotationRequest annotationRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
 }
@@ -43,6 +43,8 @@ struct PollForDecisionTaskResponse {
   100: optional shared.TaskList WorkflowExecutionTaskList
   110: optional i32 eventStoreVersion
   120: optional binary branchToken
+  130: optional shared.WorkflowStateSnapshot workflowStateSnapshot
+  140: optional bool continueAsNewSuggested
 }
 
 struct PollForActivityTaskRequest {
@@ -57,6 +59,7 @@ struct AddDecisionTaskRequest {
   30: optional shared.TaskList taskList
   40: optional i64 (js.type = "Long") scheduleId
   50: optional i32 scheduleToStartTimeoutSeconds
+  60: optional i64 (js.type = "Long") scheduledTimestamp
 }
 
 struct AddActivityTaskRequest {
@@ -66,6 +69,7 @@ struct AddActivityTaskRequest {
   40: optional shared.TaskList taskList
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
+  70: optional i64 (js.type = "Long") scheduledTimestamp
 }
 
 struct QueryWorkflowRequest {
@@ -28,6 +28,7 @@ enum ReplicationTaskType {
   History
   SyncShardStatus
   SyncActivity
+  Batch
 }
 
 enum DomainOperation {
@@ -81,11 +82,24 @@ struct SyncActicvityTaskAttributes {
   110: optional i32 attempt
 }
 
+struct BatchTaskAttributes {
+  // ReplicationTaskBatch encoded with thriftrw and compressed with snappy
+  10: optional binary tasks
+  20: optional i32 taskCount
+  30: optional i32 shardId
+  40: optional list<string> targetClusters
+}
+
 struct ReplicationTask {
   10: optional ReplicationTaskType taskType
   20: optional DomainTaskAttributes domainTaskAttributes
   30: optional HistoryTaskAttributes historyTaskAttributes
   40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes
   50: optional SyncActicvityTaskAttributes syncActicvityTaskAttributes
+  60: optional BatchTaskAttributes batchTaskAttributes
+}
+
+struct ReplicationTaskBatch {
+  10: optional list<ReplicationTask> tasks
 }
 
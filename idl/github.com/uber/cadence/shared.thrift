@@ -175,6 +175,8 @@ enum EventType {
   SignalExternalWorkflowExecutionInitiated,
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
+  WorkflowExecutionPaused,
+  WorkflowExecutionResumed,
 }
 
 enum DecisionTaskFailedCause {
@@ -198,6 +200,7 @@ enum DecisionTaskFailedCause {
   FAILOVER_CLOSE_DECISION,
   BAD_SIGNAL_INPUT_SIZE,
   RESET_WORKFLOW,
+  BAD_DECISIONS,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
@@ -238,6 +241,11 @@ enum PendingActivityState {
   CANCEL_REQUESTED,
 }
 
+enum PendingDecisionState {
+  SCHEDULED,
+  STARTED,
+}
+
 enum HistoryEventFilterType {
   ALL_EVENT,
   CLOSE_EVENT,
@@ -253,6 +261,14 @@ enum ArchivalStatus {
   ENABLED,
 }
 
+enum IndexedValueType {
+  STRING,
+  KEYWORD,
+  INT,
+  BOOL,
+  DATETIME,
+}
+
 struct Header {
     10: optional map<string, binary> fields
 }
@@ -297,6 +313,12 @@ struct Memo {
   10: optional map<string,binary> fields
 }
 
+// SearchAttributes are the JSON encoded values of the custom search attributes of a workflow,
+// their keys and types must be registered with AdminService.AddSearchAttribute
+struct SearchAttributes {
+  10: optional map<string,binary> indexedFields
+}
+
 struct WorkflowExecutionInfo {
   10: optional WorkflowExecution execution
   20: optional WorkflowType type
@@ -452,6 +474,7 @@ struct WorkflowExecutionStartedEventAttributes {
   100: optional string cronSchedule
   110: optional i32 firstDecisionTaskBackoffSeconds
   120: optional Memo memo
+  121: optional SearchAttributes searchAttributes
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -645,6 +668,17 @@ struct WorkflowExecutionTerminatedEventAttributes {
   10: optional string reason
   20: optional binary details
   30: optional string identity
+  40: optional string requestId
+}
+
+struct WorkflowExecutionPausedEventAttributes {
+  10: optional string reason
+  20: optional string identity
+}
+
+struct WorkflowExecutionResumedEventAttributes {
+  10: optional string reason
+  20: optional string identity
 }
 
 struct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
@@ -821,6 +855,8 @@ struct HistoryEvent {
   420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
+  450: optional WorkflowExecutionPausedEventAttributes workflowExecutionPausedEventAttributes
+  460: optional WorkflowExecutionResumedEventAttributes workflowExecutionResumedEventAttributes
 }
 
 struct History {
@@ -949,6 +985,7 @@ struct StartWorkflowExecutionRequest {
   120: optional RetryPolicy retryPolicy
   130: optional string cronSchedule
   140: optional Memo memo
+  141: optional SearchAttributes searchAttributes
 }
 
 struct StartWorkflowExecutionResponse {
@@ -959,6 +996,19 @@ struct PollForDecisionTaskRequest {
   10: optional string domain
   20: optional TaskList taskList
   30: optional string identity
+  40: optional bool includeWorkflowStateSnapshot
+}
+
+struct PendingTimerInfo {
+  10: optional string timerId
+  20: optional i64 (js.type = "Long") startedEventId
+  30: optional i64 (js.type = "Long") expiryTimestamp
+}
+
+struct WorkflowStateSnapshot {
+  10: optional list<PendingActivityInfo> pendingActivities
+  20: optional list<PendingTimerInfo> pendingTimers
+  30: optional i64 (js.type = "Long") lastProcessedEventId
 }
 
 struct PollForDecisionTaskResponse {
@@ -973,6 +1023,9 @@ struct PollForDecisionTaskResponse {
   70: optional binary nextPageToken
   80: optional WorkflowQuery query
   90: optional TaskList WorkflowExecutionTaskList
+  100: optional WorkflowStateSnapshot workflowStateSnapshot
+  // set when the history is approaching its size or count limits and the workflow should continue as new
+  110: optional bool continueAsNewSuggested
 }
 
 struct StickyExecutionAttributes {
@@ -989,6 +1042,8 @@ struct RespondDecisionTaskCompletedRequest {
   60: optional bool returnNewDecisionTask
   70: optional bool forceCreateNewDecisionTask
   80: optional string binaryChecksum
+  // executionContext replaces the stored execution context when set, clearExecutionContext removes it
+  90: optional bool clearExecutionContext
 }
 
 struct RespondDecisionTaskCompletedResponse {
@@ -1025,6 +1080,9 @@ struct PollForActivityTaskResponse {
   140: optional binary heartbeatDetails
   150: optional WorkflowType workflowType
   160: optional string workflowDomain
+  170: optional i64 (js.type = "Long") currentTimestamp
+  180: optional i64 (js.type = "Long") scheduleToCloseDeadline
+  190: optional i64 (js.type = "Long") startToCloseDeadline
 }
 
 struct RecordActivityTaskHeartbeatRequest {
@@ -1142,6 +1200,7 @@ struct SignalWithStartWorkflowExecutionRequest {
   140: optional RetryPolicy retryPolicy
   150: optional string cronSchedule
   160: optional Memo memo
+  161: optional SearchAttributes searchAttributes
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1150,6 +1209,21 @@ struct TerminateWorkflowExecutionRequest {
   30: optional string reason
   40: optional binary details
   50: optional string identity
+  60: optional string requestId
+}
+
+struct PauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
 }
 
 struct ResetWorkflowExecutionRequest {
@@ -1193,6 +1267,32 @@ struct ListClosedWorkflowExecutionsResponse {
   20: optional binary nextPageToken
 }
 
+struct CountWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional StartTimeFilter StartTimeFilter
+  // count closed executions instead of open ones
+  30: optional bool closed
+  40: optional WorkflowExecutionFilter executionFilter
+  50: optional WorkflowTypeFilter typeFilter
+  60: optional WorkflowExecutionCloseStatus statusFilter
+}
+
+struct CountWorkflowExecutionsResponse {
+  10: optional i64 count
+}
+
+struct ScanWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional i32 maximumPageSize
+  30: optional binary nextPageToken
+  40: optional StartTimeFilter StartTimeFilter
+}
+
+struct ScanWorkflowExecutionsResponse {
+  10: optional list<WorkflowExecutionInfo> executions
+  20: optional binary nextPageToken
+}
+
 struct QueryWorkflowRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
@@ -1243,10 +1343,36 @@ struct PendingActivityInfo {
   100: optional i64 (js.type = "Long") expirationTimestamp
 }
 
+struct PendingChildExecutionInfo {
+  10: optional string workflowID
+  20: optional string runID
+  30: optional string workflowTypeName
+  40: optional i64 (js.type = "Long") initiatedID
+  50: optional string domain
+}
+
+struct PendingDecisionInfo {
+  10: optional PendingDecisionState state
+  20: optional i64 (js.type = "Long") scheduledEventId
+  30: optional i64 (js.type = "Long") startedEventId
+  40: optional i64 (js.type = "Long") attempt
+}
+
 struct DescribeWorkflowExecutionResponse {
   10: optional WorkflowExecutionConfiguration executionConfiguration
   20: optional WorkflowExecutionInfo workflowExecutionInfo
   30: optional list<PendingActivityInfo> pendingActivities
+  40: optional list<PendingChildExecutionInfo> pendingChildren
+  50: optional PendingDecisionInfo pendingDecision
+  60: optional list<WorkflowExecutionAnnotation> annotations
+  70: optional binary executionContext
+}
+
+struct WorkflowExecutionAnnotation {
+  10: optional string key
+  20: optional string text
+  30: optional string author
+  40: optional i64 (js.type = "Long") timestamp
 }
 
 struct DescribeTaskListRequest {
@@ -1267,6 +1393,7 @@ struct TaskListStatus {
   30: optional i64 (js.type = "Long") ackLevel
   35: optional double ratePerSecond
   40: optional TaskIDBlock taskIDBlock
+  50: optional i64 (js.type = "Long") oldestTaskCreatedTimestamp
 }
 
 struct TaskIDBlock {
@@ -1287,6 +1414,17 @@ struct DescribeHistoryHostResponse{
   30: optional DomainCacheInfo      domainCache
   40: optional string               shardControllerStatus
   50: optional string               address
+  60: optional list<HistoryShardStatus> shards
+  70: optional list<string>         membershipRing
+}
+
+struct HistoryShardStatus {
+  10: optional i32 shardId
+  20: optional i64 (js.type = "Long") rangeId
+  30: optional i64 (js.type = "Long") transferAckLevel
+  40: optional i64 (js.type = "Long") timerAckLevel
+  50: optional i64 (js.type = "Long") replicationAckLevel
+  60: optional i32 numOfItemsInHistoryCache
 }
 
 struct DomainCacheInfo{
@@ -1294,6 +1432,102 @@ struct DomainCacheInfo{
   20: optional i64 numOfItemsInCacheByName
 }
 
+struct TransferDLQTask {
+  10: optional string domainId
+  20: optional string workflowId
+  30: optional string runId
+  40: optional i64 (js.type = "Long") taskId
+  50: optional i32 taskType
+  60: optional i64 (js.type = "Long") visibilityTimestamp
+  70: optional i64 (js.type = "Long") scheduleId
+  80: optional i64 (js.type = "Long") version
+}
+
+struct ReadTransferDLQRequest {
+  10: optional i32 shardID
+  20: optional i64 (js.type = "Long") inclusiveEndTaskID
+  30: optional i32 maximumPageSize
+  40: optional binary nextPageToken
+}
+
+struct ReadTransferDLQResponse {
+  10: optional list<TransferDLQTask> tasks
+  20: optional binary nextPageToken
+}
+
+struct MergeTransferDLQRequest {
+  10: optional i32 shardID
+  20: optional i64 (js.type = "Long") inclusiveEndTaskID
+  30: optional i32 maximumPageSize
+  40: optional binary nextPageToken
+}
+
+struct MergeTransferDLQResponse {
+  // number of tasks which are processed successfully and removed from the DLQ
+  10: optional i32 mergedCount
+  20: optional binary nextPageToken
+}
+
+struct PurgeTransferDLQRequest {
+  10: optional i32 shardID
+  20: optional i64 (js.type = "Long") inclusiveEndTaskID
+}
+
+struct ReplicationDLQTask {
+  10: optional string domainId
+  20: optional string workflowId
+  30: optional string runId
+  40: optional i64 (js.type = "Long") taskId
+  50: optional i64 (js.type = "Long") firstEventId
+  60: optional i64 (js.type = "Long") nextEventId
+  70: optional i64 (js.type = "Long") version
+}
+
+struct ReadReplicationDLQRequest {
+  10: optional i32 shardID
+  20: optional string sourceCluster
+  30: optional i64 (js.type = "Long") inclusiveEndTaskID
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+}
+
+struct ReadReplicationDLQResponse {
+  10: optional list<ReplicationDLQTask> tasks
+  20: optional binary nextPageToken
+}
+
+struct MergeReplicationDLQRequest {
+  10: optional i32 shardID
+  20: optional string sourceCluster
+  30: optional i64 (js.type = "Long") inclusiveEndTaskID
+  40: optional i32 maximumPageSize
+  50: optional binary nextPageToken
+}
+
+struct MergeReplicationDLQResponse {
+  // number of tasks which are replicated again successfully and removed from the DLQ
+  10: optional i32 mergedCount
+  20: optional binary nextPageToken
+}
+
+struct PurgeReplicationDLQRequest {
+  10: optional i32 shardID
+  20: optional string sourceCluster
+  30: optional i64 (js.type = "Long") inclusiveEndTaskID
+}
+
+struct RemoveTaskRequest {
+  10: optional i32 shardID
+  20: optional i32 type
+  30: optional i64 (js.type = "Long") taskID
+  // only used by timer tasks, which are keyed by visibility timestamp and task ID
+  40: optional i64 (js.type = "Long") visibilityTimestamp
+}
+
+struct CloseShardRequest {
+  10: optional i32 shardID
+}
+
 enum TaskListType {
   /*
    * Decision type of tasklist
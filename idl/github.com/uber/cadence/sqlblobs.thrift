@@ -32,6 +32,9 @@ struct ShardInfo {
   34: optional map<string, i64> clusterTransferAckLevel
   36: optional map<string, i64> clusterTimerAckLevel
   38: optional string owner
+  40: optional map<string, i64> clusterTransferReadLevel
+  42: optional map<string, binary> clusterTransferAckBitmap
+  44: optional i64 (js.type = "Long") visibilityAckLevel
 }
 
 struct DomainInfo {
@@ -116,6 +119,7 @@ struct WorkflowExecutionInfo {
   110: optional string clientLibraryVersion
   112: optional string clientFeatureVersion
   114: optional string clientImpl
+  116: optional i64 (js.type = "Long") decisionFailureCount
 }
 
 struct ActivityInfo {
@@ -189,6 +193,7 @@ struct TaskInfo {
   12: optional binary runID
   13: optional i64 (js.type = "Long") scheduleID
   14: optional i64 (js.type = "Long") expiryTimeNanos
+  15: optional i64 (js.type = "Long") createdTimeNanos
 }
 
 struct TaskListInfo {
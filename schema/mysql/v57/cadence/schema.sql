@@ -15,6 +15,18 @@ CREATE TABLE domain_metadata (
 
 INSERT INTO domain_metadata (notification_version) VALUES (1);
 
+CREATE TABLE cluster_metadata (
+  metadata_partition INT NOT NULL,
+  cluster_name VARCHAR(255) NOT NULL,
+  history_shard_count INT NOT NULL,
+  initial_failover_version BIGINT NOT NULL,
+  search_attributes BLOB,
+  search_attributes_version BIGINT NOT NULL DEFAULT 0,
+  dynamic_config MEDIUMBLOB,
+  dynamic_config_version BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (metadata_partition)
+);
+
 CREATE TABLE shards (
   shard_id INT NOT NULL,
   --
@@ -33,6 +45,15 @@ CREATE TABLE transfer_tasks(
   PRIMARY KEY (shard_id, task_id)
 );
 
+CREATE TABLE transfer_dlq_tasks(
+  shard_id INT NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
 CREATE TABLE executions(
   shard_id INT NOT NULL,
   domain_id BINARY(16) NOT NULL,
@@ -106,6 +127,16 @@ CREATE TABLE replication_tasks (
   PRIMARY KEY (shard_id, task_id)
 );
 
+CREATE TABLE replication_dlq_tasks (
+  source_cluster_name VARCHAR(255) NOT NULL,
+  shard_id INT NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (source_cluster_name, shard_id, task_id)
+);
+
 CREATE TABLE timer_tasks (
   shard_id INT NOT NULL,
   visibility_timestamp DATETIME(6) NOT NULL,
@@ -222,6 +253,20 @@ CREATE TABLE signals_requested_sets (
   PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, signal_id)
 );
 
+-- operator annotations attached to a workflow execution, kept outside of the mutable state and history
+CREATE TABLE workflow_annotations (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  annotation_key VARCHAR(255) NOT NULL,
+  --
+  annotation_text TEXT NOT NULL,
+  author VARCHAR(255) NOT NULL,
+  created_time DATETIME(6) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, annotation_key)
+);
+
 -- history eventsV2: history_node stores history event data
 CREATE TABLE history_node (
   shard_id       INT NOT NULL,
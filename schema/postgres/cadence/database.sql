@@ -0,0 +1 @@
+CREATE DATABASE cadence;
\ No newline at end of file
@@ -0,0 +1,534 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/yarpc"
+)
+
+const (
+	redactedPayload = "<redacted>"
+)
+
+type (
+	// AccessLogHandler is simple wrapper over frontend service, writing a sampled access log of the API calls
+	AccessLogHandler struct {
+		config *Config
+		logger log.Logger
+		next   workflowserviceserver.Interface
+	}
+
+	domainGetter interface {
+		GetDomain() string
+	}
+
+	domainNameGetter interface {
+		GetName() string
+	}
+
+	identityGetter interface {
+		GetIdentity() string
+	}
+
+	workflowIDGetter interface {
+		GetWorkflowId() string
+	}
+
+	executionGetter interface {
+		GetExecution() *shared.WorkflowExecution
+	}
+
+	workflowExecutionGetter interface {
+		GetWorkflowExecution() *shared.WorkflowExecution
+	}
+)
+
+var _ workflowserviceserver.Interface = (*AccessLogHandler)(nil)
+
+// payload fields never written to the access log, they may carry customer data or credentials
+var accessLogRedactedFields = map[string]struct{}{
+	"input":                {},
+	"result":               {},
+	"details":              {},
+	"control":              {},
+	"executionContext":     {},
+	"queryArgs":            {},
+	"queryResult":          {},
+	"lastCompletionResult": {},
+	"signalInput":          {},
+	"memo":                 {},
+	"header":               {},
+	"searchAttributes":     {},
+	"taskToken":            {},
+	"securityToken":        {},
+}
+
+// NewAccessLogHandler creates a thrift handler writing the access log for the cadence service, frontend
+func NewAccessLogHandler(next workflowserviceserver.Interface, config *Config, logger log.Logger) *AccessLogHandler {
+	return &AccessLogHandler{
+		config: config,
+		logger: logger,
+		next:   next,
+	}
+}
+
+func (handler *AccessLogHandler) logAccess(
+	ctx context.Context,
+	api string,
+	request interface{},
+	startTime time.Time,
+	retError *error,
+) {
+
+	domain := ""
+	switch r := request.(type) {
+	case domainGetter:
+		domain = r.GetDomain()
+	case domainNameGetter:
+		// domain APIs carry the domain as the request name
+		domain = r.GetName()
+	}
+	sampleRate := handler.config.AccessLogSampleRate(dynamicconfig.DomainFilter(domain), dynamicconfig.APIFilter(api))
+	if sampleRate <= 0 || rand.Float64() >= sampleRate {
+		return
+	}
+
+	tags := []tag.Tag{
+		tag.WorkflowHandlerName(api),
+		tag.WorkflowDomainName(domain),
+		tag.Caller(yarpc.CallFromContext(ctx).Caller()),
+		tag.Latency(time.Since(startTime)),
+	}
+	if r, ok := request.(identityGetter); ok {
+		tags = append(tags, tag.Identity(r.GetIdentity()))
+	}
+	if workflowID := getRequestWorkflowID(request); workflowID != "" {
+		tags = append(tags, tag.WorkflowID(workflowID))
+	}
+	tags = append(tags, tag.Request(redactRequest(request)))
+	if *retError != nil {
+		tags = append(tags, tag.Error(*retError))
+	}
+	handler.logger.Info("Frontend access log.", tags...)
+}
+
+func getRequestWorkflowID(request interface{}) string {
+	switch r := request.(type) {
+	case workflowIDGetter:
+		return r.GetWorkflowId()
+	case executionGetter:
+		return r.GetExecution().GetWorkflowId()
+	case workflowExecutionGetter:
+		return r.GetWorkflowExecution().GetWorkflowId()
+	}
+	return ""
+}
+
+// redactRequest returns the request as a generic JSON document with all payload fields redacted
+func redactRequest(request interface{}) interface{} {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil
+	}
+	return redactPayloadFields(doc)
+}
+
+func redactPayloadFields(doc interface{}) interface{} {
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := accessLogRedactedFields[key]; ok {
+				v[key] = redactedPayload
+				continue
+			}
+			v[key] = redactPayloadFields(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactPayloadFields(value)
+		}
+	}
+	return doc
+}
+
+// DeprecateDomain API call
+func (handler *AccessLogHandler) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "DeprecateDomain", request, time.Now(), &retError)
+	return handler.next.DeprecateDomain(ctx, request)
+}
+
+// DescribeDomain API call
+func (handler *AccessLogHandler) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+) (resp *shared.DescribeDomainResponse, retError error) {
+
+	defer handler.logAccess(ctx, "DescribeDomain", request, time.Now(), &retError)
+	return handler.next.DescribeDomain(ctx, request)
+}
+
+// DescribeTaskList API call
+func (handler *AccessLogHandler) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+) (resp *shared.DescribeTaskListResponse, retError error) {
+
+	defer handler.logAccess(ctx, "DescribeTaskList", request, time.Now(), &retError)
+	return handler.next.DescribeTaskList(ctx, request)
+}
+
+// DescribeWorkflowExecution API call
+func (handler *AccessLogHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+) (resp *shared.DescribeWorkflowExecutionResponse, retError error) {
+
+	defer handler.logAccess(ctx, "DescribeWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.DescribeWorkflowExecution(ctx, request)
+}
+
+// GetWorkflowExecutionHistory API call
+func (handler *AccessLogHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+) (resp *shared.GetWorkflowExecutionHistoryResponse, retError error) {
+
+	defer handler.logAccess(ctx, "GetWorkflowExecutionHistory", request, time.Now(), &retError)
+	return handler.next.GetWorkflowExecutionHistory(ctx, request)
+}
+
+// ListClosedWorkflowExecutions API call
+func (handler *AccessLogHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+) (resp *shared.ListClosedWorkflowExecutionsResponse, retError error) {
+
+	defer handler.logAccess(ctx, "ListClosedWorkflowExecutions", request, time.Now(), &retError)
+	return handler.next.ListClosedWorkflowExecutions(ctx, request)
+}
+
+// CountWorkflowExecutions API call
+func (handler *AccessLogHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+) (resp *shared.CountWorkflowExecutionsResponse, retError error) {
+
+	defer handler.logAccess(ctx, "CountWorkflowExecutions", request, time.Now(), &retError)
+	return handler.next.CountWorkflowExecutions(ctx, request)
+}
+
+// ScanWorkflowExecutions API call
+func (handler *AccessLogHandler) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ScanWorkflowExecutionsRequest,
+) (resp *shared.ScanWorkflowExecutionsResponse, retError error) {
+
+	defer handler.logAccess(ctx, "ScanWorkflowExecutions", request, time.Now(), &retError)
+	return handler.next.ScanWorkflowExecutions(ctx, request)
+}
+
+// ListDomains API call
+func (handler *AccessLogHandler) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+) (resp *shared.ListDomainsResponse, retError error) {
+
+	defer handler.logAccess(ctx, "ListDomains", request, time.Now(), &retError)
+	return handler.next.ListDomains(ctx, request)
+}
+
+// ListOpenWorkflowExecutions API call
+func (handler *AccessLogHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+) (resp *shared.ListOpenWorkflowExecutionsResponse, retError error) {
+
+	defer handler.logAccess(ctx, "ListOpenWorkflowExecutions", request, time.Now(), &retError)
+	return handler.next.ListOpenWorkflowExecutions(ctx, request)
+}
+
+// PauseWorkflowExecution API call
+func (handler *AccessLogHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "PauseWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.PauseWorkflowExecution(ctx, request)
+}
+
+// PollForActivityTask API call
+func (handler *AccessLogHandler) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+) (resp *shared.PollForActivityTaskResponse, retError error) {
+
+	defer handler.logAccess(ctx, "PollForActivityTask", request, time.Now(), &retError)
+	return handler.next.PollForActivityTask(ctx, request)
+}
+
+// PollForDecisionTask API call
+func (handler *AccessLogHandler) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+) (resp *shared.PollForDecisionTaskResponse, retError error) {
+
+	defer handler.logAccess(ctx, "PollForDecisionTask", request, time.Now(), &retError)
+	return handler.next.PollForDecisionTask(ctx, request)
+}
+
+// QueryWorkflow API call
+func (handler *AccessLogHandler) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+) (resp *shared.QueryWorkflowResponse, retError error) {
+
+	defer handler.logAccess(ctx, "QueryWorkflow", request, time.Now(), &retError)
+	return handler.next.QueryWorkflow(ctx, request)
+}
+
+// RecordActivityTaskHeartbeat API call
+func (handler *AccessLogHandler) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+) (resp *shared.RecordActivityTaskHeartbeatResponse, retError error) {
+
+	defer handler.logAccess(ctx, "RecordActivityTaskHeartbeat", request, time.Now(), &retError)
+	return handler.next.RecordActivityTaskHeartbeat(ctx, request)
+}
+
+// RecordActivityTaskHeartbeatByID API call
+func (handler *AccessLogHandler) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+) (resp *shared.RecordActivityTaskHeartbeatResponse, retError error) {
+
+	defer handler.logAccess(ctx, "RecordActivityTaskHeartbeatByID", request, time.Now(), &retError)
+	return handler.next.RecordActivityTaskHeartbeatByID(ctx, request)
+}
+
+// RegisterDomain API call
+func (handler *AccessLogHandler) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RegisterDomain", request, time.Now(), &retError)
+	return handler.next.RegisterDomain(ctx, request)
+}
+
+// RequestCancelWorkflowExecution API call
+func (handler *AccessLogHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RequestCancelWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.RequestCancelWorkflowExecution(ctx, request)
+}
+
+// ResetStickyTaskList API call
+func (handler *AccessLogHandler) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+) (resp *shared.ResetStickyTaskListResponse, retError error) {
+
+	defer handler.logAccess(ctx, "ResetStickyTaskList", request, time.Now(), &retError)
+	return handler.next.ResetStickyTaskList(ctx, request)
+}
+
+// ResetWorkflowExecution API call
+func (handler *AccessLogHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+) (resp *shared.ResetWorkflowExecutionResponse, retError error) {
+
+	defer handler.logAccess(ctx, "ResetWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.ResetWorkflowExecution(ctx, request)
+}
+
+// RespondActivityTaskCanceled API call
+func (handler *AccessLogHandler) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RespondActivityTaskCanceled", request, time.Now(), &retError)
+	return handler.next.RespondActivityTaskCanceled(ctx, request)
+}
+
+// RespondActivityTaskCanceledByID API call
+func (handler *AccessLogHandler) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RespondActivityTaskCanceledByID", request, time.Now(), &retError)
+	return handler.next.RespondActivityTaskCanceledByID(ctx, request)
+}
+
+// RespondActivityTaskCompleted API call
+func (handler *AccessLogHandler) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RespondActivityTaskCompleted", request, time.Now(), &retError)
+	return handler.next.RespondActivityTaskCompleted(ctx, request)
+}
+
+// RespondActivityTaskCompletedByID API call
+func (handler *AccessLogHandler) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RespondActivityTaskCompletedByID", request, time.Now(), &retError)
+	return handler.next.RespondActivityTaskCompletedByID(ctx, request)
+}
+
+// RespondActivityTaskFailed API call
+func (handler *AccessLogHandler) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RespondActivityTaskFailed", request, time.Now(), &retError)
+	return handler.next.RespondActivityTaskFailed(ctx, request)
+}
+
+// RespondActivityTaskFailedByID API call
+func (handler *AccessLogHandler) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RespondActivityTaskFailedByID", request, time.Now(), &retError)
+	return handler.next.RespondActivityTaskFailedByID(ctx, request)
+}
+
+// RespondDecisionTaskCompleted API call
+func (handler *AccessLogHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+) (resp *shared.RespondDecisionTaskCompletedResponse, retError error) {
+
+	defer handler.logAccess(ctx, "RespondDecisionTaskCompleted", request, time.Now(), &retError)
+	return handler.next.RespondDecisionTaskCompleted(ctx, request)
+}
+
+// RespondDecisionTaskFailed API call
+func (handler *AccessLogHandler) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RespondDecisionTaskFailed", request, time.Now(), &retError)
+	return handler.next.RespondDecisionTaskFailed(ctx, request)
+}
+
+// RespondQueryTaskCompleted API call
+func (handler *AccessLogHandler) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "RespondQueryTaskCompleted", request, time.Now(), &retError)
+	return handler.next.RespondQueryTaskCompleted(ctx, request)
+}
+
+// ResumeWorkflowExecution API call
+func (handler *AccessLogHandler) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "ResumeWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.ResumeWorkflowExecution(ctx, request)
+}
+
+// SignalWithStartWorkflowExecution API call
+func (handler *AccessLogHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+) (resp *shared.StartWorkflowExecutionResponse, retError error) {
+
+	defer handler.logAccess(ctx, "SignalWithStartWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.SignalWithStartWorkflowExecution(ctx, request)
+}
+
+// SignalWorkflowExecution API call
+func (handler *AccessLogHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "SignalWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.SignalWorkflowExecution(ctx, request)
+}
+
+// StartWorkflowExecution API call
+func (handler *AccessLogHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (resp *shared.StartWorkflowExecutionResponse, retError error) {
+
+	defer handler.logAccess(ctx, "StartWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.StartWorkflowExecution(ctx, request)
+}
+
+// TerminateWorkflowExecution API call
+func (handler *AccessLogHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+) (retError error) {
+
+	defer handler.logAccess(ctx, "TerminateWorkflowExecution", request, time.Now(), &retError)
+	return handler.next.TerminateWorkflowExecution(ctx, request)
+}
+
+// UpdateDomain API call
+func (handler *AccessLogHandler) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+) (resp *shared.UpdateDomainResponse, retError error) {
+
+	defer handler.logAccess(ctx, "UpdateDomain", request, time.Now(), &retError)
+	return handler.next.UpdateDomain(ctx, request)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	accessLogHandlerSuite struct {
+		suite.Suite
+	}
+)
+
+func TestAccessLogHandlerSuite(t *testing.T) {
+	s := new(accessLogHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *accessLogHandlerSuite) TestGetRequestWorkflowID() {
+	s.Equal("wid-start", getRequestWorkflowID(&shared.StartWorkflowExecutionRequest{
+		WorkflowId: common.StringPtr("wid-start"),
+	}))
+	s.Equal("wid-history", getRequestWorkflowID(&shared.GetWorkflowExecutionHistoryRequest{
+		Execution: &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid-history")},
+	}))
+	s.Equal("wid-signal", getRequestWorkflowID(&shared.SignalWorkflowExecutionRequest{
+		WorkflowExecution: &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid-signal")},
+	}))
+	s.Equal("", getRequestWorkflowID(&shared.PollForDecisionTaskRequest{}))
+}
+
+func (s *accessLogHandlerSuite) TestRedactRequest() {
+	request := &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:      common.StringPtr("test-domain"),
+		WorkflowId:  common.StringPtr("test-workflow-id"),
+		Input:       []byte("secret input"),
+		SignalName:  common.StringPtr("test-signal"),
+		SignalInput: []byte("secret signal input"),
+		Memo: &shared.Memo{
+			Fields: map[string][]byte{"key": []byte("secret memo")},
+		},
+	}
+
+	doc, ok := redactRequest(request).(map[string]interface{})
+	s.True(ok)
+	s.Equal("test-domain", doc["domain"])
+	s.Equal("test-workflow-id", doc["workflowId"])
+	s.Equal("test-signal", doc["signalName"])
+	s.Equal(redactedPayload, doc["input"])
+	s.Equal(redactedPayload, doc["signalInput"])
+	s.Equal(redactedPayload, doc["memo"])
+	// the request itself must not be modified
+	s.Equal([]byte("secret input"), request.Input)
+}
+
+func (s *accessLogHandlerSuite) TestRedactRequest_Nested() {
+	request := &shared.RespondDecisionTaskCompletedRequest{
+		TaskToken: []byte("token"),
+		Decisions: []*shared.Decision{{
+			DecisionType: shared.DecisionTypeCompleteWorkflowExecution.Ptr(),
+			CompleteWorkflowExecutionDecisionAttributes: &shared.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("secret result"),
+			},
+		}},
+		Identity: common.StringPtr("test-identity"),
+	}
+
+	doc, ok := redactRequest(request).(map[string]interface{})
+	s.True(ok)
+	s.Equal(redactedPayload, doc["taskToken"])
+	s.Equal("test-identity", doc["identity"])
+	decisions, ok := doc["decisions"].([]interface{})
+	s.True(ok)
+	s.Len(decisions, 1)
+	attributes := decisions[0].(map[string]interface{})["completeWorkflowExecutionDecisionAttributes"].(map[string]interface{})
+	s.Equal(redactedPayload, attributes["result"])
+}
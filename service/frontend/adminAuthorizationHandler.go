@@ -0,0 +1,274 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"go.uber.org/yarpc"
+)
+
+type (
+	// AdminAuthorizationHandler is simple wrapper over admin service, checking the caller holds the admin role before
+	// passing the request on. Requests of a domain need the role in that domain, all others in every domain.
+	AdminAuthorizationHandler struct {
+		authorizer authorization.Authorizer
+		next       adminserviceserver.Interface
+	}
+)
+
+var _ adminserviceserver.Interface = (*AdminAuthorizationHandler)(nil)
+
+// NewAdminAuthorizationHandler creates a thrift handler authorizing the calls to the cadence admin service
+func NewAdminAuthorizationHandler(next adminserviceserver.Interface, authorizer authorization.Authorizer) *AdminAuthorizationHandler {
+	return &AdminAuthorizationHandler{
+		authorizer: authorizer,
+		next:       next,
+	}
+}
+
+func (handler *AdminAuthorizationHandler) authorize(ctx context.Context, api string, request interface{}) error {
+	attributes := &authorization.Attributes{
+		Token:   yarpc.CallFromContext(ctx).Header(common.AuthorizationTokenHeaderName),
+		APIName: authorization.AdminAPIPrefix + api,
+	}
+	if r, ok := request.(domainGetter); ok {
+		attributes.DomainName = r.GetDomain()
+	}
+
+	result, err := handler.authorizer.Authorize(ctx, attributes)
+	if err != nil {
+		return err
+	}
+	if result.Decision != authorization.DecisionAllow {
+		return errNotAuthorized
+	}
+	return nil
+}
+
+// AddSearchAttribute API call
+func (handler *AdminAuthorizationHandler) AddSearchAttribute(
+	ctx context.Context,
+	request *admin.AddSearchAttributeRequest,
+) error {
+
+	if err := handler.authorize(ctx, "AddSearchAttribute", request); err != nil {
+		return err
+	}
+	return handler.next.AddSearchAttribute(ctx, request)
+}
+
+// AddWorkflowExecutionAnnotation API call
+func (handler *AdminAuthorizationHandler) AddWorkflowExecutionAnnotation(
+	ctx context.Context,
+	request *admin.AddWorkflowExecutionAnnotationRequest,
+) error {
+
+	if err := handler.authorize(ctx, "AddWorkflowExecutionAnnotation", request); err != nil {
+		return err
+	}
+	return handler.next.AddWorkflowExecutionAnnotation(ctx, request)
+}
+
+// CloseShard API call
+func (handler *AdminAuthorizationHandler) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+) error {
+
+	if err := handler.authorize(ctx, "CloseShard", request); err != nil {
+		return err
+	}
+	return handler.next.CloseShard(ctx, request)
+}
+
+// DescribeHistoryHost API call
+func (handler *AdminAuthorizationHandler) DescribeHistoryHost(
+	ctx context.Context,
+	request *shared.DescribeHistoryHostRequest,
+) (*shared.DescribeHistoryHostResponse, error) {
+
+	if err := handler.authorize(ctx, "DescribeHistoryHost", request); err != nil {
+		return nil, err
+	}
+	return handler.next.DescribeHistoryHost(ctx, request)
+}
+
+// DescribeShardDistribution API call
+func (handler *AdminAuthorizationHandler) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+) (*admin.DescribeShardDistributionResponse, error) {
+
+	if err := handler.authorize(ctx, "DescribeShardDistribution", request); err != nil {
+		return nil, err
+	}
+	return handler.next.DescribeShardDistribution(ctx, request)
+}
+
+// DescribeWorkflowExecution API call
+func (handler *AdminAuthorizationHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *admin.DescribeWorkflowExecutionRequest,
+) (*admin.DescribeWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, "DescribeWorkflowExecution", request); err != nil {
+		return nil, err
+	}
+	return handler.next.DescribeWorkflowExecution(ctx, request)
+}
+
+// GetWorkflowExecutionRawHistory API call
+func (handler *AdminAuthorizationHandler) GetWorkflowExecutionRawHistory(
+	ctx context.Context,
+	request *admin.GetWorkflowExecutionRawHistoryRequest,
+) (*admin.GetWorkflowExecutionRawHistoryResponse, error) {
+
+	if err := handler.authorize(ctx, "GetWorkflowExecutionRawHistory", request); err != nil {
+		return nil, err
+	}
+	return handler.next.GetWorkflowExecutionRawHistory(ctx, request)
+}
+
+// MergeReplicationDLQ API call
+func (handler *AdminAuthorizationHandler) MergeReplicationDLQ(
+	ctx context.Context,
+	request *shared.MergeReplicationDLQRequest,
+) (*shared.MergeReplicationDLQResponse, error) {
+
+	if err := handler.authorize(ctx, "MergeReplicationDLQ", request); err != nil {
+		return nil, err
+	}
+	return handler.next.MergeReplicationDLQ(ctx, request)
+}
+
+// MergeTransferDLQ API call
+func (handler *AdminAuthorizationHandler) MergeTransferDLQ(
+	ctx context.Context,
+	request *shared.MergeTransferDLQRequest,
+) (*shared.MergeTransferDLQResponse, error) {
+
+	if err := handler.authorize(ctx, "MergeTransferDLQ", request); err != nil {
+		return nil, err
+	}
+	return handler.next.MergeTransferDLQ(ctx, request)
+}
+
+// PurgeReplicationDLQ API call
+func (handler *AdminAuthorizationHandler) PurgeReplicationDLQ(
+	ctx context.Context,
+	request *shared.PurgeReplicationDLQRequest,
+) error {
+
+	if err := handler.authorize(ctx, "PurgeReplicationDLQ", request); err != nil {
+		return err
+	}
+	return handler.next.PurgeReplicationDLQ(ctx, request)
+}
+
+// PurgeTransferDLQ API call
+func (handler *AdminAuthorizationHandler) PurgeTransferDLQ(
+	ctx context.Context,
+	request *shared.PurgeTransferDLQRequest,
+) error {
+
+	if err := handler.authorize(ctx, "PurgeTransferDLQ", request); err != nil {
+		return err
+	}
+	return handler.next.PurgeTransferDLQ(ctx, request)
+}
+
+// ReadReplicationDLQ API call
+func (handler *AdminAuthorizationHandler) ReadReplicationDLQ(
+	ctx context.Context,
+	request *shared.ReadReplicationDLQRequest,
+) (*shared.ReadReplicationDLQResponse, error) {
+
+	if err := handler.authorize(ctx, "ReadReplicationDLQ", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ReadReplicationDLQ(ctx, request)
+}
+
+// ReadTransferDLQ API call
+func (handler *AdminAuthorizationHandler) ReadTransferDLQ(
+	ctx context.Context,
+	request *shared.ReadTransferDLQRequest,
+) (*shared.ReadTransferDLQResponse, error) {
+
+	if err := handler.authorize(ctx, "ReadTransferDLQ", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ReadTransferDLQ(ctx, request)
+}
+
+// RemoveTask API call
+func (handler *AdminAuthorizationHandler) RemoveTask(
+	ctx context.Context,
+	request *shared.RemoveTaskRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RemoveTask", request); err != nil {
+		return err
+	}
+	return handler.next.RemoveTask(ctx, request)
+}
+
+// ResetWorkflowExecution API call
+func (handler *AdminAuthorizationHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, "ResetWorkflowExecution", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ResetWorkflowExecution(ctx, request)
+}
+
+// RollbackDynamicConfig API call
+func (handler *AdminAuthorizationHandler) RollbackDynamicConfig(
+	ctx context.Context,
+	request *admin.RollbackDynamicConfigRequest,
+) (*admin.RollbackDynamicConfigResponse, error) {
+
+	if err := handler.authorize(ctx, "RollbackDynamicConfig", request); err != nil {
+		return nil, err
+	}
+	return handler.next.RollbackDynamicConfig(ctx, request)
+}
+
+// UpdateDynamicConfig API call
+func (handler *AdminAuthorizationHandler) UpdateDynamicConfig(
+	ctx context.Context,
+	request *admin.UpdateDynamicConfigRequest,
+) (*admin.UpdateDynamicConfigResponse, error) {
+
+	if err := handler.authorize(ctx, "UpdateDynamicConfig", request); err != nil {
+		return nil, err
+	}
+	return handler.next.UpdateDynamicConfig(ctx, request)
+}
@@ -22,6 +22,9 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -36,17 +39,37 @@ import (
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattribute"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	historyService "github.com/uber/cadence/service/history"
 )
 
 var _ adminserviceserver.Interface = (*AdminHandler)(nil)
 
+const (
+	// annotation key and author are stored as VARCHAR(255) by the SQL persistence
+	maxAnnotationKeyLength    = 255
+	maxAnnotationAuthorLength = 255
+)
+
+var (
+	errAnnotationKeyNotSet     = &gen.BadRequestError{Message: "Annotation key not set on request."}
+	errAnnotationKeyTooLong    = &gen.BadRequestError{Message: "Annotation key length exceeds limit."}
+	errAnnotationAuthorTooLong = &gen.BadRequestError{Message: "Annotation author length exceeds limit."}
+	errDynamicConfigKeyNotSet  = &gen.BadRequestError{Message: "Dynamic config key not set on request."}
+	errDynamicConfigReadOnly   = &gen.BadRequestError{Message: "Dynamic config of this server cannot be updated."}
+
+	errInvalidDecisionFinishEventID = &gen.BadRequestError{Message: "A valid DecisionFinishEventId is not set on request."}
+	errReasonNotSet                 = &gen.BadRequestError{Message: "Reason is not set on request."}
+)
+
 type (
 	// AdminHandler - Thrift handler inteface for admin service
 	AdminHandler struct {
@@ -58,6 +81,9 @@ type (
 		metricsClient metrics.Client
 		historyMgr    persistence.HistoryManager
 		historyV2Mgr  persistence.HistoryV2Manager
+		searchAttrs   searchattribute.Registry
+		authorizer    authorization.Authorizer
+		dynamicConfig dynamicconfig.Client
 		startWG       sync.WaitGroup
 	}
 )
@@ -65,7 +91,8 @@ type (
 // NewAdminHandler creates a thrift handler for the cadence admin service
 func NewAdminHandler(
 	sVice service.Service, numberOfHistoryShards int, metadataMgr persistence.MetadataManager,
-	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager) *AdminHandler {
+	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+	searchAttrs searchattribute.Registry, authorizer authorization.Authorizer, dynamicConfig dynamicconfig.Client) *AdminHandler {
 	handler := &AdminHandler{
 		status:                common.DaemonStatusInitialized,
 		numberOfHistoryShards: numberOfHistoryShards,
@@ -73,6 +100,9 @@ func NewAdminHandler(
 		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
+		searchAttrs:           searchAttrs,
+		authorizer:            authorizer,
+		dynamicConfig:         dynamicConfig,
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -86,7 +116,7 @@ func (adh *AdminHandler) Start() error {
 	}
 
 	adh.domainCache.Start()
-	adh.Service.GetDispatcher().Register(adminserviceserver.New(adh))
+	adh.Service.GetDispatcher().Register(adminserviceserver.New(NewAdminAuthorizationHandler(adh, adh.authorizer)))
 	adh.Service.Start()
 
 	adh.history = adh.GetClientBean().GetHistoryClient()
@@ -104,6 +134,24 @@ func (adh *AdminHandler) Stop() {
 	adh.domainCache.Stop()
 }
 
+// AddSearchAttribute registers search attribute keys and the type of their values
+func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.AddSearchAttributeRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminAddSearchAttributeScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || len(request.SearchAttribute) == 0 {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.searchAttrs.AddSearchAttributes(request.SearchAttribute); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest) (resp *admin.DescribeWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -161,6 +209,52 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.D
 	return resp, err
 }
 
+// DescribeShardDistribution returns the history hosts which own each shard according to the membership ring
+func (adh *AdminHandler) DescribeShardDistribution(
+	ctx context.Context, request *admin.DescribeShardDistributionRequest) (resp *admin.DescribeShardDistributionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDescribeShardDistributionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	resolver, err := adh.GetMembershipMonitor().GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// hosts without any shard are reported as well, as they are the ones to look for when rebalancing
+	shardsByHost := make(map[string][]int32)
+	for _, member := range resolver.Members() {
+		shardsByHost[member.GetAddress()] = []int32{}
+	}
+	for shardID := 0; shardID < adh.numberOfHistoryShards; shardID++ {
+		host, err := resolver.Lookup(string(shardID))
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		shardsByHost[host.GetAddress()] = append(shardsByHost[host.GetAddress()], int32(shardID))
+	}
+
+	addresses := make([]string, 0, len(shardsByHost))
+	for address := range shardsByHost {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	hosts := make([]*admin.HostShardDistribution, 0, len(addresses))
+	for _, address := range addresses {
+		hosts = append(hosts, &admin.HostShardDistribution{
+			Address:  common.StringPtr(address),
+			ShardIDs: shardsByHost[address],
+		})
+	}
+	return &admin.DescribeShardDistributionResponse{
+		NumberOfShards: common.Int32Ptr(int32(adh.numberOfHistoryShards)),
+		Hosts:          hosts,
+	}, nil
+}
+
 // GetWorkflowExecutionRawHistory - retrieves the history of workflow execution
 func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	ctx context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest) (resp *admin.GetWorkflowExecutionRawHistoryResponse, retError error) {
@@ -266,6 +360,7 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	var historyBatches []*gen.History
 	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowId(), adh.numberOfHistoryShards)
 	_, historyBatches, token.PersistenceToken, size, err = historyService.PaginateHistory(
+		ctx,
 		adh.historyMgr,
 		adh.historyV2Mgr,
 		adh.metricsClient,
@@ -331,6 +426,358 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	return result, nil
 }
 
+// ReadTransferDLQ returns the transfer tasks in the DLQ of the given history shard
+func (adh *AdminHandler) ReadTransferDLQ(ctx context.Context, request *gen.ReadTransferDLQRequest) (resp *gen.ReadTransferDLQResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminReadTransferDLQScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.ReadTransferDLQ(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// MergeTransferDLQ processes the transfer tasks in the DLQ of the given history shard again
+func (adh *AdminHandler) MergeTransferDLQ(ctx context.Context, request *gen.MergeTransferDLQRequest) (resp *gen.MergeTransferDLQResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminMergeTransferDLQScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.MergeTransferDLQ(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PurgeTransferDLQ deletes the transfer tasks in the DLQ of the given history shard
+func (adh *AdminHandler) PurgeTransferDLQ(ctx context.Context, request *gen.PurgeTransferDLQRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminPurgeTransferDLQScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.PurgeTransferDLQ(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ReadReplicationDLQ returns the replication tasks from a source cluster in the DLQ of the given history shard
+func (adh *AdminHandler) ReadReplicationDLQ(ctx context.Context, request *gen.ReadReplicationDLQRequest) (resp *gen.ReadReplicationDLQResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminReadReplicationDLQScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.ReadReplicationDLQ(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// MergeReplicationDLQ replicates the workflows of the replication tasks in the DLQ of the given history shard again
+func (adh *AdminHandler) MergeReplicationDLQ(ctx context.Context, request *gen.MergeReplicationDLQRequest) (resp *gen.MergeReplicationDLQResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminMergeReplicationDLQScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.MergeReplicationDLQ(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PurgeReplicationDLQ deletes the replication tasks from a source cluster in the DLQ of the given history shard
+func (adh *AdminHandler) PurgeReplicationDLQ(ctx context.Context, request *gen.PurgeReplicationDLQRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminPurgeReplicationDLQScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.PurgeReplicationDLQ(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RemoveTask deletes a single transfer or timer task of the given history shard
+func (adh *AdminHandler) RemoveTask(ctx context.Context, request *gen.RemoveTaskRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminRemoveTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.RemoveTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// CloseShard closes the given history shard on the host owning it
+func (adh *AdminHandler) CloseShard(ctx context.Context, request *gen.CloseShardRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminCloseShardScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.CloseShard(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// AddWorkflowExecutionAnnotation attaches an operator annotation to a workflow execution
+func (adh *AdminHandler) AddWorkflowExecutionAnnotation(ctx context.Context, request *admin.AddWorkflowExecutionAnnotationRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminAddWorkflowExecutionAnnotationScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.Domain == nil || request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetKey() == "" {
+		return adh.error(errAnnotationKeyNotSet, scope)
+	}
+	if len(request.GetKey()) > maxAnnotationKeyLength {
+		return adh.error(errAnnotationKeyTooLong, scope)
+	}
+	if len(request.GetAuthor()) > maxAnnotationAuthorLength {
+		return adh.error(errAnnotationAuthorTooLong, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.AddWorkflowExecutionAnnotation(ctx, &hist.AddWorkflowExecutionAnnotationRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+		Annotation: &gen.WorkflowExecutionAnnotation{
+			Key:    request.Key,
+			Text:   common.StringPtr(request.GetText()),
+			Author: common.StringPtr(request.GetAuthor()),
+		},
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ResetWorkflowExecution resets a workflow execution to the given DecisionTaskCompleted event. Unlike the frontend
+// API it does not check the client version, so it can be used from operator tooling during an incident.
+func (adh *AdminHandler) ResetWorkflowExecution(
+	ctx context.Context, request *gen.ResetWorkflowExecutionRequest) (resp *gen.ResetWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminResetWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetWorkflowExecution().GetRunId() == "" {
+		return nil, adh.error(errRunIDNotSet, scope)
+	}
+	if request.GetDecisionFinishEventId() <= common.FirstEventID {
+		return nil, adh.error(errInvalidDecisionFinishEventID, scope)
+	}
+	if request.GetReason() == "" {
+		return nil, adh.error(errReasonNotSet, scope)
+	}
+	if request.RequestId == nil {
+		request.RequestId = common.StringPtr(uuid.New())
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.history.ResetWorkflowExecution(ctx, &hist.ResetWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		ResetRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// UpdateDynamicConfig sets the value of a dynamic config key on all the hosts of the cluster
+func (adh *AdminHandler) UpdateDynamicConfig(
+	ctx context.Context, request *admin.UpdateDynamicConfigRequest) (resp *admin.UpdateDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminUpdateDynamicConfigScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetKey() == "" {
+		return nil, adh.error(errDynamicConfigKeyNotSet, scope)
+	}
+	client, ok := adh.dynamicConfig.(dynamicconfig.VersionedClient)
+	if !ok {
+		return nil, adh.error(errDynamicConfigReadOnly, scope)
+	}
+
+	key, err := dynamicconfig.ParseKey(request.GetKey())
+	if err != nil {
+		return nil, adh.error(&gen.BadRequestError{Message: err.Error()}, scope)
+	}
+	filters := make(map[dynamicconfig.Filter]interface{}, len(request.Filters))
+	for _, f := range request.Filters {
+		filter, value, err := dynamicconfig.ParseFilter(f.GetName(), f.GetValue())
+		if err != nil {
+			return nil, adh.error(&gen.BadRequestError{Message: err.Error()}, scope)
+		}
+		filters[filter] = value
+	}
+	// an unset value removes the key for the filters
+	var value interface{}
+	if len(request.Value) > 0 {
+		if err := json.Unmarshal(request.Value, &value); err != nil {
+			return nil, adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Invalid dynamic config value: %v", err)}, scope)
+		}
+	}
+
+	version, err := client.UpdateValue(key, filters, value, request.GetChangedBy(), request.GetReason())
+	if err != nil {
+		if err == dynamicconfig.ErrConcurrentConfigUpdate {
+			return nil, adh.error(&gen.InternalServiceError{Message: err.Error()}, scope)
+		}
+		return nil, adh.error(err, scope)
+	}
+	return &admin.UpdateDynamicConfigResponse{Version: common.Int64Ptr(version)}, nil
+}
+
+// RollbackDynamicConfig restores the dynamic config version which preceded the latest change on all the hosts of the cluster
+func (adh *AdminHandler) RollbackDynamicConfig(
+	ctx context.Context, request *admin.RollbackDynamicConfigRequest) (resp *admin.RollbackDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminRollbackDynamicConfigScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	client, ok := adh.dynamicConfig.(dynamicconfig.VersionedClient)
+	if !ok {
+		return nil, adh.error(errDynamicConfigReadOnly, scope)
+	}
+
+	version, err := client.Rollback(request.GetChangedBy(), request.GetReason())
+	if err != nil {
+		if err == dynamicconfig.ErrNothingToRollback {
+			return nil, adh.error(&gen.BadRequestError{Message: err.Error()}, scope)
+		}
+		if err == dynamicconfig.ErrConcurrentConfigUpdate {
+			return nil, adh.error(&gen.InternalServiceError{Message: err.Error()}, scope)
+		}
+		return nil, adh.error(err, scope)
+	}
+	return &admin.RollbackDynamicConfigResponse{Version: common.Int64Ptr(version)}, nil
+}
+
+func (adh *AdminHandler) validateShardID(shardID *int32) error {
+	if shardID == nil || *shardID < 0 || int(*shardID) >= adh.numberOfHistoryShards {
+		return &gen.BadRequestError{Message: "Invalid ShardID."}
+	}
+	return nil
+}
+
 // startRequestProfile initiates recording of request metrics
 func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	adh.startWG.Wait()
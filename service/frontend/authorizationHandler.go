@@ -0,0 +1,578 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/service/worker/batcher"
+	"go.uber.org/yarpc"
+)
+
+type (
+	// AuthorizationHandler is simple wrapper over frontend service, checking the caller is allowed to call the API
+	// in the domain of the request before passing the request on
+	AuthorizationHandler struct {
+		authorizer      authorization.Authorizer
+		domainCache     cache.DomainCache
+		tokenSerializer common.TaskTokenSerializer
+		next            workflowserviceserver.Interface
+	}
+
+	taskTokenGetter interface {
+		GetTaskToken() []byte
+	}
+)
+
+var _ workflowserviceserver.Interface = (*AuthorizationHandler)(nil)
+
+var errNotAuthorized = &shared.AccessDeniedError{Message: "Not authorized to perform the operation."}
+
+// the APIs a batch job calls in its target domain, by batch type
+var batchOperationAPIs = map[string]string{
+	batcher.BatchTypeTerminate: "TerminateWorkflowExecution",
+	batcher.BatchTypeCancel:    "RequestCancelWorkflowExecution",
+	batcher.BatchTypeSignal:    "SignalWorkflowExecution",
+}
+
+// NewAuthorizationHandler creates a thrift handler authorizing the calls to the cadence service, frontend
+func NewAuthorizationHandler(
+	next workflowserviceserver.Interface,
+	authorizer authorization.Authorizer,
+	domainCache cache.DomainCache,
+) *AuthorizationHandler {
+	return &AuthorizationHandler{
+		authorizer:  authorizer,
+		domainCache: domainCache,
+		// the token is only parsed to find its domain, the history service verifies it
+		tokenSerializer: common.NewTaskTokenSerializer(nil, nil, nil, nil),
+		next:            next,
+	}
+}
+
+func (handler *AuthorizationHandler) authorize(ctx context.Context, api string, request interface{}) error {
+	return handler.authorizeDomain(ctx, api, handler.getRequestDomainName(request))
+}
+
+func (handler *AuthorizationHandler) authorizeDomain(ctx context.Context, api string, domainName string) error {
+	result, err := handler.authorizer.Authorize(ctx, &authorization.Attributes{
+		Token:      yarpc.CallFromContext(ctx).Header(common.AuthorizationTokenHeaderName),
+		APIName:    api,
+		DomainName: domainName,
+	})
+	if err != nil {
+		return err
+	}
+	if result.Decision != authorization.DecisionAllow {
+		return errNotAuthorized
+	}
+	return nil
+}
+
+// authorizeBatchJob checks the caller of a start of a batch job is allowed to apply the operation of the job in
+// its target domain. Batch jobs run in the system domain and the batcher applies their operations with its own
+// client, so this is the only place the grants of the caller are checked against the target domain.
+func (handler *AuthorizationHandler) authorizeBatchJob(
+	ctx context.Context,
+	domainName string,
+	workflowType *shared.WorkflowType,
+	input []byte,
+) error {
+
+	if domainName != common.SystemDomainName || workflowType.GetName() != batcher.BatchWFTypeName {
+		return nil
+	}
+	var params batcher.BatchParams
+	if err := json.Unmarshal(input, &params); err != nil {
+		return errNotAuthorized
+	}
+	api, ok := batchOperationAPIs[params.BatchType]
+	if !ok || params.DomainName == "" {
+		return errNotAuthorized
+	}
+	return handler.authorizeDomain(ctx, api, params.DomainName)
+}
+
+// getRequestDomainName returns the domain of the request, requests carrying a task token are resolved to the
+// domain of the token. It returns an empty name when the domain can not be found.
+func (handler *AuthorizationHandler) getRequestDomainName(request interface{}) string {
+	var domainID string
+	switch r := request.(type) {
+	case domainGetter:
+		return r.GetDomain()
+	case domainNameGetter:
+		// domain APIs carry the domain as the request name
+		return r.GetName()
+	case *shared.RespondQueryTaskCompletedRequest:
+		token, err := handler.tokenSerializer.DeserializeQueryTaskToken(r.TaskToken)
+		if err != nil {
+			return ""
+		}
+		domainID = token.DomainID
+	case taskTokenGetter:
+		token, err := handler.tokenSerializer.Deserialize(r.GetTaskToken())
+		if err != nil {
+			return ""
+		}
+		domainID = token.DomainID
+	}
+	if domainID == "" {
+		return ""
+	}
+
+	domainEntry, err := handler.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return ""
+	}
+	return domainEntry.GetInfo().Name
+}
+
+// DeprecateDomain API call
+func (handler *AuthorizationHandler) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+) error {
+
+	if err := handler.authorize(ctx, "DeprecateDomain", request); err != nil {
+		return err
+	}
+	return handler.next.DeprecateDomain(ctx, request)
+}
+
+// DescribeDomain API call
+func (handler *AuthorizationHandler) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+) (*shared.DescribeDomainResponse, error) {
+
+	if err := handler.authorize(ctx, "DescribeDomain", request); err != nil {
+		return nil, err
+	}
+	return handler.next.DescribeDomain(ctx, request)
+}
+
+// DescribeTaskList API call
+func (handler *AuthorizationHandler) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+) (*shared.DescribeTaskListResponse, error) {
+
+	if err := handler.authorize(ctx, "DescribeTaskList", request); err != nil {
+		return nil, err
+	}
+	return handler.next.DescribeTaskList(ctx, request)
+}
+
+// DescribeWorkflowExecution API call
+func (handler *AuthorizationHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, "DescribeWorkflowExecution", request); err != nil {
+		return nil, err
+	}
+	return handler.next.DescribeWorkflowExecution(ctx, request)
+}
+
+// GetWorkflowExecutionHistory API call
+func (handler *AuthorizationHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	if err := handler.authorize(ctx, "GetWorkflowExecutionHistory", request); err != nil {
+		return nil, err
+	}
+	return handler.next.GetWorkflowExecutionHistory(ctx, request)
+}
+
+// ListClosedWorkflowExecutions API call
+func (handler *AuthorizationHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+) (*shared.ListClosedWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, "ListClosedWorkflowExecutions", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ListClosedWorkflowExecutions(ctx, request)
+}
+
+// CountWorkflowExecutions API call
+func (handler *AuthorizationHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, "CountWorkflowExecutions", request); err != nil {
+		return nil, err
+	}
+	return handler.next.CountWorkflowExecutions(ctx, request)
+}
+
+// ScanWorkflowExecutions API call
+func (handler *AuthorizationHandler) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ScanWorkflowExecutionsRequest,
+) (*shared.ScanWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, "ScanWorkflowExecutions", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ScanWorkflowExecutions(ctx, request)
+}
+
+// ListDomains API call
+func (handler *AuthorizationHandler) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+) (*shared.ListDomainsResponse, error) {
+
+	if err := handler.authorize(ctx, "ListDomains", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ListDomains(ctx, request)
+}
+
+// ListOpenWorkflowExecutions API call
+func (handler *AuthorizationHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, "ListOpenWorkflowExecutions", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ListOpenWorkflowExecutions(ctx, request)
+}
+
+// PauseWorkflowExecution API call
+func (handler *AuthorizationHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, "PauseWorkflowExecution", request); err != nil {
+		return err
+	}
+	return handler.next.PauseWorkflowExecution(ctx, request)
+}
+
+// PollForActivityTask API call
+func (handler *AuthorizationHandler) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+) (*shared.PollForActivityTaskResponse, error) {
+
+	if err := handler.authorize(ctx, "PollForActivityTask", request); err != nil {
+		return nil, err
+	}
+	return handler.next.PollForActivityTask(ctx, request)
+}
+
+// PollForDecisionTask API call
+func (handler *AuthorizationHandler) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+) (*shared.PollForDecisionTaskResponse, error) {
+
+	if err := handler.authorize(ctx, "PollForDecisionTask", request); err != nil {
+		return nil, err
+	}
+	return handler.next.PollForDecisionTask(ctx, request)
+}
+
+// QueryWorkflow API call
+func (handler *AuthorizationHandler) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+) (*shared.QueryWorkflowResponse, error) {
+
+	if err := handler.authorize(ctx, "QueryWorkflow", request); err != nil {
+		return nil, err
+	}
+	return handler.next.QueryWorkflow(ctx, request)
+}
+
+// RecordActivityTaskHeartbeat API call
+func (handler *AuthorizationHandler) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	if err := handler.authorize(ctx, "RecordActivityTaskHeartbeat", request); err != nil {
+		return nil, err
+	}
+	return handler.next.RecordActivityTaskHeartbeat(ctx, request)
+}
+
+// RecordActivityTaskHeartbeatByID API call
+func (handler *AuthorizationHandler) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	if err := handler.authorize(ctx, "RecordActivityTaskHeartbeatByID", request); err != nil {
+		return nil, err
+	}
+	return handler.next.RecordActivityTaskHeartbeatByID(ctx, request)
+}
+
+// RegisterDomain API call
+func (handler *AuthorizationHandler) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RegisterDomain", request); err != nil {
+		return err
+	}
+	return handler.next.RegisterDomain(ctx, request)
+}
+
+// RequestCancelWorkflowExecution API call
+func (handler *AuthorizationHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RequestCancelWorkflowExecution", request); err != nil {
+		return err
+	}
+	return handler.next.RequestCancelWorkflowExecution(ctx, request)
+}
+
+// ResetStickyTaskList API call
+func (handler *AuthorizationHandler) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+) (*shared.ResetStickyTaskListResponse, error) {
+
+	if err := handler.authorize(ctx, "ResetStickyTaskList", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ResetStickyTaskList(ctx, request)
+}
+
+// ResetWorkflowExecution API call
+func (handler *AuthorizationHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, "ResetWorkflowExecution", request); err != nil {
+		return nil, err
+	}
+	return handler.next.ResetWorkflowExecution(ctx, request)
+}
+
+// RespondActivityTaskCanceled API call
+func (handler *AuthorizationHandler) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RespondActivityTaskCanceled", request); err != nil {
+		return err
+	}
+	return handler.next.RespondActivityTaskCanceled(ctx, request)
+}
+
+// RespondActivityTaskCanceledByID API call
+func (handler *AuthorizationHandler) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RespondActivityTaskCanceledByID", request); err != nil {
+		return err
+	}
+	return handler.next.RespondActivityTaskCanceledByID(ctx, request)
+}
+
+// RespondActivityTaskCompleted API call
+func (handler *AuthorizationHandler) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RespondActivityTaskCompleted", request); err != nil {
+		return err
+	}
+	return handler.next.RespondActivityTaskCompleted(ctx, request)
+}
+
+// RespondActivityTaskCompletedByID API call
+func (handler *AuthorizationHandler) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RespondActivityTaskCompletedByID", request); err != nil {
+		return err
+	}
+	return handler.next.RespondActivityTaskCompletedByID(ctx, request)
+}
+
+// RespondActivityTaskFailed API call
+func (handler *AuthorizationHandler) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RespondActivityTaskFailed", request); err != nil {
+		return err
+	}
+	return handler.next.RespondActivityTaskFailed(ctx, request)
+}
+
+// RespondActivityTaskFailedByID API call
+func (handler *AuthorizationHandler) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RespondActivityTaskFailedByID", request); err != nil {
+		return err
+	}
+	return handler.next.RespondActivityTaskFailedByID(ctx, request)
+}
+
+// RespondDecisionTaskCompleted API call
+func (handler *AuthorizationHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+) (*shared.RespondDecisionTaskCompletedResponse, error) {
+
+	if err := handler.authorize(ctx, "RespondDecisionTaskCompleted", request); err != nil {
+		return nil, err
+	}
+	return handler.next.RespondDecisionTaskCompleted(ctx, request)
+}
+
+// RespondDecisionTaskFailed API call
+func (handler *AuthorizationHandler) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RespondDecisionTaskFailed", request); err != nil {
+		return err
+	}
+	return handler.next.RespondDecisionTaskFailed(ctx, request)
+}
+
+// RespondQueryTaskCompleted API call
+func (handler *AuthorizationHandler) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+) error {
+
+	if err := handler.authorize(ctx, "RespondQueryTaskCompleted", request); err != nil {
+		return err
+	}
+	return handler.next.RespondQueryTaskCompleted(ctx, request)
+}
+
+// ResumeWorkflowExecution API call
+func (handler *AuthorizationHandler) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, "ResumeWorkflowExecution", request); err != nil {
+		return err
+	}
+	return handler.next.ResumeWorkflowExecution(ctx, request)
+}
+
+// SignalWithStartWorkflowExecution API call
+func (handler *AuthorizationHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, "SignalWithStartWorkflowExecution", request); err != nil {
+		return nil, err
+	}
+	if err := handler.authorizeBatchJob(ctx, request.GetDomain(), request.WorkflowType, request.Input); err != nil {
+		return nil, err
+	}
+	return handler.next.SignalWithStartWorkflowExecution(ctx, request)
+}
+
+// SignalWorkflowExecution API call
+func (handler *AuthorizationHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, "SignalWorkflowExecution", request); err != nil {
+		return err
+	}
+	return handler.next.SignalWorkflowExecution(ctx, request)
+}
+
+// StartWorkflowExecution API call
+func (handler *AuthorizationHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, "StartWorkflowExecution", request); err != nil {
+		return nil, err
+	}
+	if err := handler.authorizeBatchJob(ctx, request.GetDomain(), request.WorkflowType, request.Input); err != nil {
+		return nil, err
+	}
+	return handler.next.StartWorkflowExecution(ctx, request)
+}
+
+// TerminateWorkflowExecution API call
+func (handler *AuthorizationHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, "TerminateWorkflowExecution", request); err != nil {
+		return err
+	}
+	return handler.next.TerminateWorkflowExecution(ctx, request)
+}
+
+// UpdateDomain API call
+func (handler *AuthorizationHandler) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+) (*shared.UpdateDomainResponse, error) {
+
+	if err := handler.authorize(ctx, "UpdateDomain", request); err != nil {
+		return nil, err
+	}
+	return handler.next.UpdateDomain(ctx, request)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/batcher"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+)
+
+type (
+	authorizationHandlerSuite struct {
+		suite.Suite
+		mockDomainCache *cache.DomainCacheMock
+		handler         *AuthorizationHandler
+	}
+)
+
+func TestAuthorizationHandlerSuite(t *testing.T) {
+	s := new(authorizationHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *authorizationHandlerSuite) SetupTest() {
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockDomainCache.On("GetDomainByID", "shop-id").Return(
+		cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{ID: "shop-id", Name: "shop"}, &persistence.DomainConfig{}), nil)
+	s.handler = NewAuthorizationHandler(nil, authorization.NewRoleAuthorizer([]authorization.RoleGrant{
+		{Token: "service-token", DomainName: "shop", Role: authorization.RoleWrite},
+		{Token: "service-token", DomainName: common.SystemDomainName, Role: authorization.RoleWrite},
+	}), s.mockDomainCache)
+}
+
+func (s *authorizationHandlerSuite) TestGetRequestDomainName() {
+	taskToken, err := common.NewJSONTaskTokenSerializer().Serialize(&common.TaskToken{DomainID: "shop-id"})
+	s.NoError(err)
+	queryTaskToken, err := common.NewJSONTaskTokenSerializer().SerializeQueryTaskToken(&common.QueryTaskToken{DomainID: "shop-id"})
+	s.NoError(err)
+
+	s.Equal("shop", s.handler.getRequestDomainName(&shared.StartWorkflowExecutionRequest{Domain: common.StringPtr("shop")}))
+	s.Equal("shop", s.handler.getRequestDomainName(&shared.RegisterDomainRequest{Name: common.StringPtr("shop")}))
+	s.Equal("shop", s.handler.getRequestDomainName(&shared.RespondActivityTaskCompletedRequest{TaskToken: taskToken}))
+	s.Equal("shop", s.handler.getRequestDomainName(&shared.RecordActivityTaskHeartbeatRequest{TaskToken: taskToken}))
+	s.Equal("shop", s.handler.getRequestDomainName(&shared.RespondDecisionTaskCompletedRequest{TaskToken: taskToken}))
+	s.Equal("shop", s.handler.getRequestDomainName(&shared.RespondQueryTaskCompletedRequest{TaskToken: queryTaskToken}))
+	s.Equal("", s.handler.getRequestDomainName(&shared.RespondActivityTaskFailedRequest{TaskToken: []byte("malformed")}))
+	s.Equal("", s.handler.getRequestDomainName(&shared.ListDomainsRequest{}))
+}
+
+func (s *authorizationHandlerSuite) TestRespondActivityTaskCompleted_NotAuthorized() {
+	taskToken, err := common.NewJSONTaskTokenSerializer().Serialize(&common.TaskToken{DomainID: "shop-id"})
+	s.NoError(err)
+
+	// the test context carries no authorization token
+	err = s.handler.RespondActivityTaskCompleted(context.Background(), &shared.RespondActivityTaskCompletedRequest{
+		TaskToken: taskToken,
+	})
+	s.Equal(errNotAuthorized, err)
+
+	err = s.handler.RespondActivityTaskFailed(context.Background(), &shared.RespondActivityTaskFailedRequest{
+		TaskToken: []byte("malformed"),
+	})
+	s.Equal(errNotAuthorized, err)
+}
+
+func (s *authorizationHandlerSuite) TestAuthorizeBatchJob() {
+	ctx := s.newContextWithToken("service-token")
+	batchType := &shared.WorkflowType{Name: common.StringPtr(batcher.BatchWFTypeName)}
+	encode := func(params batcher.BatchParams) []byte {
+		input, err := json.Marshal(params)
+		s.NoError(err)
+		return input
+	}
+
+	// service-token can signal but not terminate the workflows of shop
+	s.NoError(s.handler.authorizeBatchJob(ctx, common.SystemDomainName, batchType,
+		encode(batcher.BatchParams{DomainName: "shop", BatchType: batcher.BatchTypeSignal})))
+	s.Equal(errNotAuthorized, s.handler.authorizeBatchJob(ctx, common.SystemDomainName, batchType,
+		encode(batcher.BatchParams{DomainName: "shop", BatchType: batcher.BatchTypeTerminate})))
+	s.Equal(errNotAuthorized, s.handler.authorizeBatchJob(ctx, common.SystemDomainName, batchType,
+		encode(batcher.BatchParams{DomainName: "other", BatchType: batcher.BatchTypeSignal})))
+	s.Equal(errNotAuthorized, s.handler.authorizeBatchJob(ctx, common.SystemDomainName, batchType, []byte("malformed")))
+
+	// other workflows are only authorized in their own domain
+	s.NoError(s.handler.authorizeBatchJob(ctx, common.SystemDomainName,
+		&shared.WorkflowType{Name: common.StringPtr("other-type")}, []byte("malformed")))
+	s.NoError(s.handler.authorizeBatchJob(ctx, "shop", batchType, []byte("malformed")))
+}
+
+func (s *authorizationHandlerSuite) TestAdminResetWorkflowExecution_NotAuthorized() {
+	adminHandler := NewAdminAuthorizationHandler(nil, authorization.NewRoleAuthorizer([]authorization.RoleGrant{
+		{Token: "service-token", DomainName: "shop", Role: authorization.RoleWrite},
+		{Token: "oncall-token", DomainName: "other", Role: authorization.RoleAdmin},
+	}))
+	request := &shared.ResetWorkflowExecutionRequest{Domain: common.StringPtr("shop")}
+
+	// resetting through the admin service needs the admin role in the domain, as through the frontend
+	_, err := adminHandler.ResetWorkflowExecution(s.newContextWithToken("service-token"), request)
+	s.Equal(errNotAuthorized, err)
+	_, err = adminHandler.ResetWorkflowExecution(s.newContextWithToken("oncall-token"), request)
+	s.Equal(errNotAuthorized, err)
+}
+
+func (s *authorizationHandlerSuite) newContextWithToken(token string) context.Context {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequest(&transport.Request{
+		Headers: transport.NewHeaders().With(common.AuthorizationTokenHeaderName, token),
+	}))
+	return ctx
+}
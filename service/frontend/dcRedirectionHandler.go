@@ -56,7 +56,7 @@ func NewDCRedirectionHandler(wfHandler *WorkflowHandler, policy config.DCRedirec
 		domainCache:        wfHandler.domainCache,
 		config:             wfHandler.config,
 		redirectionPolicy:  dcRedirectionPolicy,
-		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
+		tokenSerializer:    common.NewTaskTokenSerializer(nil, nil, nil, nil),
 		service:            wfHandler.Service,
 		frontendHandler:    wfHandler,
 	}
@@ -193,6 +193,42 @@ func (handler *DCRedirectionHandlerImpl) ListClosedWorkflowExecutions(
 	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).ListClosedWorkflowExecutions(ctx, request)
 }
 
+// CountWorkflowExecutions API call
+func (handler *DCRedirectionHandlerImpl) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	targetDC, err := handler.redirectionPolicy.GetTargetDataCenterByName(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	if targetDC == handler.currentClusterName {
+		return handler.frontendHandler.CountWorkflowExecutions(ctx, request)
+	}
+
+	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).CountWorkflowExecutions(ctx, request)
+}
+
+// ScanWorkflowExecutions API call
+func (handler *DCRedirectionHandlerImpl) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ScanWorkflowExecutionsRequest,
+) (*shared.ScanWorkflowExecutionsResponse, error) {
+
+	targetDC, err := handler.redirectionPolicy.GetTargetDataCenterByName(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	if targetDC == handler.currentClusterName {
+		return handler.frontendHandler.ScanWorkflowExecutions(ctx, request)
+	}
+
+	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).ScanWorkflowExecutions(ctx, request)
+}
+
 // ListOpenWorkflowExecutions API call
 func (handler *DCRedirectionHandlerImpl) ListOpenWorkflowExecutions(
 	ctx context.Context,
@@ -211,6 +247,24 @@ func (handler *DCRedirectionHandlerImpl) ListOpenWorkflowExecutions(
 	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).ListOpenWorkflowExecutions(ctx, request)
 }
 
+// PauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+) error {
+
+	targetDC, err := handler.redirectionPolicy.GetTargetDataCenterByName(request.GetDomain())
+	if err != nil {
+		return err
+	}
+
+	if targetDC == handler.currentClusterName {
+		return handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+	}
+
+	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).PauseWorkflowExecution(ctx, request)
+}
+
 // PollForActivityTask API call
 func (handler *DCRedirectionHandlerImpl) PollForActivityTask(
 	ctx context.Context,
@@ -552,6 +606,24 @@ func (handler *DCRedirectionHandlerImpl) RespondQueryTaskCompleted(
 	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).RespondQueryTaskCompleted(ctx, request)
 }
 
+// ResumeWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+) error {
+
+	targetDC, err := handler.redirectionPolicy.GetTargetDataCenterByName(request.GetDomain())
+	if err != nil {
+		return err
+	}
+
+	if targetDC == handler.currentClusterName {
+		return handler.frontendHandler.ResumeWorkflowExecution(ctx, request)
+	}
+
+	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).ResumeWorkflowExecution(ctx, request)
+}
+
 // SignalWithStartWorkflowExecution API call
 func (handler *DCRedirectionHandlerImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
@@ -147,6 +147,10 @@ func (d *domainHandlerImpl) registerDomain(ctx context.Context,
 		}
 	}
 
+	if err := d.validateDomainAliases(registerRequest.GetName(), registerRequest.Data); err != nil {
+		return err
+	}
+
 	domainRequest := &persistence.CreateDomainRequest{
 		Info: &persistence.DomainInfo{
 			ID:          uuid.New(),
@@ -409,6 +413,9 @@ func (d *domainHandlerImpl) updateDomain(ctx context.Context,
 		if updatedInfo.Data != nil {
 			configurationChanged = true
 			info.Data = d.mergeDomainData(info.Data, updatedInfo.Data)
+			if err := d.validateDomainAliases(info.Name, info.Data); err != nil {
+				return nil, err
+			}
 		}
 	}
 	if updateRequest.Configuration != nil {
@@ -623,6 +630,23 @@ func (d *domainHandlerImpl) mergeDomainData(old map[string]string, new map[strin
 	return old
 }
 
+// validateDomainAliases makes sure none of the deprecated names of a domain collides with a registered domain
+func (d *domainHandlerImpl) validateDomainAliases(domainName string, data map[string]string) error {
+	for _, alias := range common.GetDomainAliases(data) {
+		if alias == domainName {
+			return &shared.BadRequestError{Message: fmt.Sprintf("Domain alias %v is the domain name.", alias)}
+		}
+		_, err := d.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: alias})
+		if err == nil {
+			return &shared.BadRequestError{Message: fmt.Sprintf("Domain alias %v is the name of a registered domain.", alias)}
+		}
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *domainHandlerImpl) validateClusterName(clusterName string) error {
 	if _, ok := d.clusterMetadata.GetAllClusterFailoverVersions()[clusterName]; !ok {
 		errMsg := "Invalid cluster name: %s"
@@ -27,8 +27,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
 	dc "github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -141,3 +144,20 @@ func (s *domainHandlerSuite) TestMergeDomainData_Nil() {
 		"k1": "v2",
 	}, out)
 }
+
+func (s *domainHandlerSuite) TestValidateDomainAliases() {
+	handler := newDomainHandler(s.config, loggerimpl.NewNopLogger(), s.mockMetadataMgr, s.mockClusterMetadata, s.mockBlobstoreClient, s.mockDomainReplicator)
+
+	s.Nil(handler.validateDomainAliases("domain", nil))
+
+	err := handler.validateDomainAliases("domain", map[string]string{common.DomainDataKeyForAliases: "domain"})
+	s.IsType(&shared.BadRequestError{}, err)
+
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "registered"}).Return(&persistence.GetDomainResponse{}, nil).Once()
+	err = handler.validateDomainAliases("domain", map[string]string{common.DomainDataKeyForAliases: "registered"})
+	s.IsType(&shared.BadRequestError{}, err)
+
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "old-domain"}).Return(nil, &shared.EntityNotExistsError{}).Once()
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "older-domain"}).Return(nil, &shared.EntityNotExistsError{}).Once()
+	s.Nil(handler.validateDomainAliases("domain", map[string]string{common.DomainDataKeyForAliases: "old-domain, older-domain"}))
+}
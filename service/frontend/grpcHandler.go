@@ -0,0 +1,335 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type (
+	// GRPCHandler serves the proto defined WorkflowAPI by translating its requests to the shared types of the
+	// frontend handler, and the responses and errors back
+	GRPCHandler struct {
+		handler workflowserviceserver.Interface
+		encoder codec.BinaryEncoder
+	}
+)
+
+var _ apiv1.WorkflowAPIYARPCServer = (*GRPCHandler)(nil)
+
+// NewGRPCHandler creates the WorkflowAPI server in front of the given frontend handler
+func NewGRPCHandler(handler workflowserviceserver.Interface) *GRPCHandler {
+	return &GRPCHandler{
+		handler: handler,
+		encoder: codec.NewThriftRWEncoder(),
+	}
+}
+
+// StartWorkflowExecution starts a new workflow
+func (h *GRPCHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.StartWorkflowExecutionRequest,
+) (*apiv1.StartWorkflowExecutionResponse, error) {
+	response, err := h.handler.StartWorkflowExecution(ctx, toThriftStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &apiv1.StartWorkflowExecutionResponse{RunId: response.GetRunId()}, nil
+}
+
+// SignalWorkflowExecution signals a running workflow
+func (h *GRPCHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.SignalWorkflowExecutionRequest,
+) (*apiv1.SignalWorkflowExecutionResponse, error) {
+	if err := h.handler.SignalWorkflowExecution(ctx, toThriftSignalWorkflowExecutionRequest(request)); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &apiv1.SignalWorkflowExecutionResponse{}, nil
+}
+
+// SignalWithStartWorkflowExecution signals a workflow, starting it first when it is not running
+func (h *GRPCHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.SignalWithStartWorkflowExecutionRequest,
+) (*apiv1.SignalWithStartWorkflowExecutionResponse, error) {
+	response, err := h.handler.SignalWithStartWorkflowExecution(ctx, toThriftSignalWithStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &apiv1.SignalWithStartWorkflowExecutionResponse{RunId: response.GetRunId()}, nil
+}
+
+// RequestCancelWorkflowExecution requests the cancellation of a running workflow
+func (h *GRPCHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.RequestCancelWorkflowExecutionRequest,
+) (*apiv1.RequestCancelWorkflowExecutionResponse, error) {
+	if err := h.handler.RequestCancelWorkflowExecution(ctx, toThriftRequestCancelWorkflowExecutionRequest(request)); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &apiv1.RequestCancelWorkflowExecutionResponse{}, nil
+}
+
+// TerminateWorkflowExecution terminates a running workflow
+func (h *GRPCHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.TerminateWorkflowExecutionRequest,
+) (*apiv1.TerminateWorkflowExecutionResponse, error) {
+	if err := h.handler.TerminateWorkflowExecution(ctx, toThriftTerminateWorkflowExecutionRequest(request)); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &apiv1.TerminateWorkflowExecutionResponse{}, nil
+}
+
+// GetWorkflowExecutionHistoryStream reads the history of a workflow page by page and sends every page as soon as
+// it is read, the stream ends after the last page
+func (h *GRPCHandler) GetWorkflowExecutionHistoryStream(
+	request *apiv1.GetWorkflowExecutionHistoryStreamRequest,
+	stream apiv1.WorkflowAPIServiceGetWorkflowExecutionHistoryStreamYARPCServer,
+) error {
+	ctx := stream.Context()
+	getRequest := toThriftGetWorkflowExecutionHistoryRequest(request)
+	for {
+		response, err := h.handler.GetWorkflowExecutionHistory(ctx, getRequest)
+		if err != nil {
+			return toGRPCError(err)
+		}
+		history, err := h.encoder.Encode(response.History)
+		if err != nil {
+			return toGRPCError(err)
+		}
+		if err := stream.Send(&apiv1.GetWorkflowExecutionHistoryStreamResponse{History: history}); err != nil {
+			return err
+		}
+		if len(response.NextPageToken) == 0 {
+			return nil
+		}
+		getRequest.NextPageToken = response.NextPageToken
+	}
+}
+
+func toThriftStartWorkflowExecutionRequest(request *apiv1.StartWorkflowExecutionRequest) *shared.StartWorkflowExecutionRequest {
+	if request == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(request.GetDomain()),
+		WorkflowId:                          common.StringPtr(request.GetWorkflowId()),
+		WorkflowType:                        toThriftWorkflowType(request.GetWorkflowType()),
+		TaskList:                            toThriftTaskList(request.GetTaskList()),
+		Input:                               request.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(request.GetExecutionStartToCloseTimeoutSeconds()),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(request.GetTaskStartToCloseTimeoutSeconds()),
+		Identity:                            common.StringPtr(request.GetIdentity()),
+		RequestId:                           common.StringPtr(request.GetRequestId()),
+		WorkflowIdReusePolicy:               toThriftWorkflowIDReusePolicy(request.GetWorkflowIdReusePolicy()),
+		RetryPolicy:                         toThriftRetryPolicy(request.GetRetryPolicy()),
+		CronSchedule:                        common.StringPtr(request.GetCronSchedule()),
+		Memo:                                toThriftMemo(request.GetMemo()),
+		SearchAttributes:                    toThriftSearchAttributes(request.GetSearchAttributes()),
+	}
+}
+
+func toThriftSignalWorkflowExecutionRequest(request *apiv1.SignalWorkflowExecutionRequest) *shared.SignalWorkflowExecutionRequest {
+	if request == nil {
+		return nil
+	}
+	return &shared.SignalWorkflowExecutionRequest{
+		Domain:            common.StringPtr(request.GetDomain()),
+		WorkflowExecution: toThriftWorkflowExecution(request.GetWorkflowExecution()),
+		SignalName:        common.StringPtr(request.GetSignalName()),
+		Input:             request.GetInput(),
+		Identity:          common.StringPtr(request.GetIdentity()),
+		RequestId:         common.StringPtr(request.GetRequestId()),
+		Control:           request.GetControl(),
+	}
+}
+
+// toThriftSignalWithStartWorkflowExecutionRequest flattens the start request nested in the proto request
+func toThriftSignalWithStartWorkflowExecutionRequest(
+	request *apiv1.SignalWithStartWorkflowExecutionRequest,
+) *shared.SignalWithStartWorkflowExecutionRequest {
+	if request == nil {
+		return nil
+	}
+	start := request.GetStartRequest()
+	return &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(start.GetDomain()),
+		WorkflowId:                          common.StringPtr(start.GetWorkflowId()),
+		WorkflowType:                        toThriftWorkflowType(start.GetWorkflowType()),
+		TaskList:                            toThriftTaskList(start.GetTaskList()),
+		Input:                               start.GetInput(),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(start.GetExecutionStartToCloseTimeoutSeconds()),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(start.GetTaskStartToCloseTimeoutSeconds()),
+		Identity:                            common.StringPtr(start.GetIdentity()),
+		RequestId:                           common.StringPtr(start.GetRequestId()),
+		WorkflowIdReusePolicy:               toThriftWorkflowIDReusePolicy(start.GetWorkflowIdReusePolicy()),
+		SignalName:                          common.StringPtr(request.GetSignalName()),
+		SignalInput:                         request.GetSignalInput(),
+		Control:                             request.GetControl(),
+		RetryPolicy:                         toThriftRetryPolicy(start.GetRetryPolicy()),
+		CronSchedule:                        common.StringPtr(start.GetCronSchedule()),
+		Memo:                                toThriftMemo(start.GetMemo()),
+		SearchAttributes:                    toThriftSearchAttributes(start.GetSearchAttributes()),
+	}
+}
+
+func toThriftRequestCancelWorkflowExecutionRequest(
+	request *apiv1.RequestCancelWorkflowExecutionRequest,
+) *shared.RequestCancelWorkflowExecutionRequest {
+	if request == nil {
+		return nil
+	}
+	return &shared.RequestCancelWorkflowExecutionRequest{
+		Domain:            common.StringPtr(request.GetDomain()),
+		WorkflowExecution: toThriftWorkflowExecution(request.GetWorkflowExecution()),
+		Identity:          common.StringPtr(request.GetIdentity()),
+		RequestId:         common.StringPtr(request.GetRequestId()),
+	}
+}
+
+func toThriftTerminateWorkflowExecutionRequest(
+	request *apiv1.TerminateWorkflowExecutionRequest,
+) *shared.TerminateWorkflowExecutionRequest {
+	if request == nil {
+		return nil
+	}
+	return &shared.TerminateWorkflowExecutionRequest{
+		Domain:            common.StringPtr(request.GetDomain()),
+		WorkflowExecution: toThriftWorkflowExecution(request.GetWorkflowExecution()),
+		Reason:            common.StringPtr(request.GetReason()),
+		Details:           request.GetDetails(),
+		Identity:          common.StringPtr(request.GetIdentity()),
+		RequestId:         common.StringPtr(request.GetRequestId()),
+	}
+}
+
+func toThriftGetWorkflowExecutionHistoryRequest(
+	request *apiv1.GetWorkflowExecutionHistoryStreamRequest,
+) *shared.GetWorkflowExecutionHistoryRequest {
+	getRequest := &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:                 common.StringPtr(request.GetDomain()),
+		Execution:              toThriftWorkflowExecution(request.GetWorkflowExecution()),
+		HistoryEventFilterType: shared.HistoryEventFilterTypeAllEvent.Ptr(),
+	}
+	if request.GetMaximumPageSize() > 0 {
+		getRequest.MaximumPageSize = common.Int32Ptr(request.GetMaximumPageSize())
+	}
+	return getRequest
+}
+
+func toThriftWorkflowExecution(execution *apiv1.WorkflowExecution) *shared.WorkflowExecution {
+	if execution == nil {
+		return nil
+	}
+	return &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(execution.GetWorkflowId()),
+		RunId:      common.StringPtr(execution.GetRunId()),
+	}
+}
+
+func toThriftWorkflowType(workflowType *apiv1.WorkflowType) *shared.WorkflowType {
+	if workflowType == nil {
+		return nil
+	}
+	return &shared.WorkflowType{Name: common.StringPtr(workflowType.GetName())}
+}
+
+func toThriftTaskList(taskList *apiv1.TaskList) *shared.TaskList {
+	if taskList == nil {
+		return nil
+	}
+	return &shared.TaskList{Name: common.StringPtr(taskList.GetName())}
+}
+
+func toThriftRetryPolicy(policy *apiv1.RetryPolicy) *shared.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &shared.RetryPolicy{
+		InitialIntervalInSeconds:    common.Int32Ptr(policy.GetInitialIntervalInSeconds()),
+		BackoffCoefficient:          common.Float64Ptr(policy.GetBackoffCoefficient()),
+		MaximumIntervalInSeconds:    common.Int32Ptr(policy.GetMaximumIntervalInSeconds()),
+		MaximumAttempts:             common.Int32Ptr(policy.GetMaximumAttempts()),
+		NonRetriableErrorReasons:    policy.GetNonRetriableErrorReasons(),
+		ExpirationIntervalInSeconds: common.Int32Ptr(policy.GetExpirationIntervalInSeconds()),
+	}
+}
+
+func toThriftMemo(memo *apiv1.Memo) *shared.Memo {
+	if memo == nil {
+		return nil
+	}
+	return &shared.Memo{Fields: memo.GetFields()}
+}
+
+func toThriftSearchAttributes(attributes *apiv1.SearchAttributes) *shared.SearchAttributes {
+	if attributes == nil {
+		return nil
+	}
+	return &shared.SearchAttributes{IndexedFields: attributes.GetIndexedFields()}
+}
+
+// toThriftWorkflowIDReusePolicy leaves an unset policy to the server default
+func toThriftWorkflowIDReusePolicy(policy apiv1.WorkflowIdReusePolicy) *shared.WorkflowIdReusePolicy {
+	switch policy {
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY:
+		return shared.WorkflowIdReusePolicyAllowDuplicateFailedOnly.Ptr()
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE:
+		return shared.WorkflowIdReusePolicyAllowDuplicate.Ptr()
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE:
+		return shared.WorkflowIdReusePolicyRejectDuplicate.Ptr()
+	default:
+		return nil
+	}
+}
+
+// toGRPCError maps the errors of the frontend handler to the status codes gRPC clients can act upon
+func toGRPCError(err error) error {
+	switch err := err.(type) {
+	case *shared.BadRequestError:
+		return yarpcerrors.Newf(yarpcerrors.CodeInvalidArgument, "%s", err.Message)
+	case *shared.EntityNotExistsError:
+		return yarpcerrors.Newf(yarpcerrors.CodeNotFound, "%s", err.Message)
+	case *shared.WorkflowExecutionAlreadyStartedError:
+		return yarpcerrors.Newf(yarpcerrors.CodeAlreadyExists, "%s", err.GetMessage())
+	case *shared.CancellationAlreadyRequestedError:
+		return yarpcerrors.Newf(yarpcerrors.CodeAlreadyExists, "%s", err.Message)
+	case *shared.ServiceBusyError:
+		return yarpcerrors.Newf(yarpcerrors.CodeResourceExhausted, "%s", err.Message)
+	case *shared.LimitExceededError:
+		return yarpcerrors.Newf(yarpcerrors.CodeResourceExhausted, "%s", err.Message)
+	case *shared.DomainNotActiveError:
+		return yarpcerrors.Newf(yarpcerrors.CodeFailedPrecondition, "%s", err.Message)
+	case *yarpcerrors.Status:
+		return err
+	default:
+		return yarpcerrors.Newf(yarpcerrors.CodeInternal, "%s", err.Error())
+	}
+}
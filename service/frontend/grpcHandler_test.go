@@ -0,0 +1,238 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type (
+	grpcHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+		handler     *testGRPCFrontendHandler
+		grpcHandler *GRPCHandler
+	}
+
+	// testGRPCFrontendHandler only implements the APIs exercised by the tests
+	testGRPCFrontendHandler struct {
+		workflowserviceserver.Interface
+		signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest
+		signalWithStartErr     error
+		historyRequests        []*shared.GetWorkflowExecutionHistoryRequest
+		historyPages           []*shared.GetWorkflowExecutionHistoryResponse
+		historyErr             error
+	}
+
+	testHistoryStream struct {
+		responses []*apiv1.GetWorkflowExecutionHistoryStreamResponse
+	}
+)
+
+func TestGRPCHandlerSuite(t *testing.T) {
+	s := new(grpcHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *grpcHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.handler = &testGRPCFrontendHandler{}
+	s.grpcHandler = NewGRPCHandler(s.handler)
+}
+
+func (h *testGRPCFrontendHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+	h.signalWithStartRequest = request
+	if h.signalWithStartErr != nil {
+		return nil, h.signalWithStartErr
+	}
+	return &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("some random run ID")}, nil
+}
+
+func (h *testGRPCFrontendHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+	copied := *request
+	h.historyRequests = append(h.historyRequests, &copied)
+	if h.historyErr != nil {
+		return nil, h.historyErr
+	}
+	return h.historyPages[len(h.historyRequests)-1], nil
+}
+
+func (s *testHistoryStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testHistoryStream) Send(response *apiv1.GetWorkflowExecutionHistoryStreamResponse, options ...yarpc.StreamOption) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func (s *grpcHandlerSuite) TestSignalWithStartWorkflowExecution() {
+	request := &apiv1.SignalWithStartWorkflowExecutionRequest{
+		StartRequest: &apiv1.StartWorkflowExecutionRequest{
+			Domain:                              "some random domain",
+			WorkflowId:                          "some random workflow ID",
+			WorkflowType:                        &apiv1.WorkflowType{Name: "some random workflow type"},
+			TaskList:                            &apiv1.TaskList{Name: "some random task list"},
+			Input:                               []byte("some random input"),
+			ExecutionStartToCloseTimeoutSeconds: 60,
+			TaskStartToCloseTimeoutSeconds:      10,
+			RequestId:                           "some random request ID",
+			WorkflowIdReusePolicy:               apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			RetryPolicy: &apiv1.RetryPolicy{
+				InitialIntervalInSeconds: 1,
+				BackoffCoefficient:       2,
+				MaximumAttempts:          3,
+			},
+			Memo: &apiv1.Memo{Fields: map[string][]byte{"some random key": []byte("some random value")}},
+		},
+		SignalName:  "some random signal",
+		SignalInput: []byte("some random signal input"),
+	}
+
+	response, err := s.grpcHandler.SignalWithStartWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal("some random run ID", response.GetRunId())
+
+	thriftRequest := s.handler.signalWithStartRequest
+	s.Equal("some random domain", thriftRequest.GetDomain())
+	s.Equal("some random workflow ID", thriftRequest.GetWorkflowId())
+	s.Equal("some random workflow type", thriftRequest.GetWorkflowType().GetName())
+	s.Equal("some random task list", thriftRequest.GetTaskList().GetName())
+	s.Equal([]byte("some random input"), thriftRequest.GetInput())
+	s.Equal(int32(60), thriftRequest.GetExecutionStartToCloseTimeoutSeconds())
+	s.Equal(int32(10), thriftRequest.GetTaskStartToCloseTimeoutSeconds())
+	s.Equal("some random request ID", thriftRequest.GetRequestId())
+	s.Equal(shared.WorkflowIdReusePolicyRejectDuplicate, thriftRequest.GetWorkflowIdReusePolicy())
+	s.Equal(int32(1), thriftRequest.GetRetryPolicy().GetInitialIntervalInSeconds())
+	s.Equal(2.0, thriftRequest.GetRetryPolicy().GetBackoffCoefficient())
+	s.Equal(int32(3), thriftRequest.GetRetryPolicy().GetMaximumAttempts())
+	s.Equal([]byte("some random value"), thriftRequest.GetMemo().GetFields()["some random key"])
+	s.Equal("some random signal", thriftRequest.GetSignalName())
+	s.Equal([]byte("some random signal input"), thriftRequest.GetSignalInput())
+}
+
+func (s *grpcHandlerSuite) TestSignalWithStartWorkflowExecution_Error() {
+	s.handler.signalWithStartErr = &shared.BadRequestError{Message: "some random message"}
+
+	response, err := s.grpcHandler.SignalWithStartWorkflowExecution(context.Background(), &apiv1.SignalWithStartWorkflowExecutionRequest{})
+	s.Nil(response)
+	s.Equal(yarpcerrors.CodeInvalidArgument, yarpcerrors.FromError(err).Code())
+	s.Equal("some random message", yarpcerrors.FromError(err).Message())
+}
+
+func (s *grpcHandlerSuite) TestGetWorkflowExecutionHistoryStream() {
+	s.handler.historyPages = []*shared.GetWorkflowExecutionHistoryResponse{
+		{
+			History: &shared.History{Events: []*shared.HistoryEvent{
+				{EventId: common.Int64Ptr(1)},
+				{EventId: common.Int64Ptr(2)},
+			}},
+			NextPageToken: []byte("some random page token"),
+		},
+		{
+			History: &shared.History{Events: []*shared.HistoryEvent{
+				{EventId: common.Int64Ptr(3)},
+			}},
+		},
+	}
+	stream := &testHistoryStream{}
+
+	err := s.grpcHandler.GetWorkflowExecutionHistoryStream(&apiv1.GetWorkflowExecutionHistoryStreamRequest{
+		Domain:            "some random domain",
+		WorkflowExecution: &apiv1.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: "some random run ID"},
+		MaximumPageSize:   2,
+	}, stream)
+	s.NoError(err)
+
+	s.Len(s.handler.historyRequests, 2)
+	s.Equal("some random domain", s.handler.historyRequests[0].GetDomain())
+	s.Equal("some random workflow ID", s.handler.historyRequests[0].GetExecution().GetWorkflowId())
+	s.Equal("some random run ID", s.handler.historyRequests[0].GetExecution().GetRunId())
+	s.Equal(int32(2), s.handler.historyRequests[0].GetMaximumPageSize())
+	s.Nil(s.handler.historyRequests[0].NextPageToken)
+	s.Equal([]byte("some random page token"), s.handler.historyRequests[1].NextPageToken)
+
+	s.Len(stream.responses, 2)
+	var eventIDs []int64
+	for _, response := range stream.responses {
+		var history shared.History
+		s.NoError(codec.NewThriftRWEncoder().Decode(response.GetHistory(), &history))
+		for _, event := range history.Events {
+			eventIDs = append(eventIDs, event.GetEventId())
+		}
+	}
+	s.Equal([]int64{1, 2, 3}, eventIDs)
+}
+
+func (s *grpcHandlerSuite) TestGetWorkflowExecutionHistoryStream_Error() {
+	s.handler.historyErr = &shared.EntityNotExistsError{Message: "some random message"}
+	stream := &testHistoryStream{}
+
+	err := s.grpcHandler.GetWorkflowExecutionHistoryStream(&apiv1.GetWorkflowExecutionHistoryStreamRequest{}, stream)
+	s.Equal(yarpcerrors.CodeNotFound, yarpcerrors.FromError(err).Code())
+	s.Empty(stream.responses)
+}
+
+func (s *grpcHandlerSuite) TestToThriftWorkflowIDReusePolicy() {
+	s.Nil(toThriftWorkflowIDReusePolicy(apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID))
+	s.Equal(shared.WorkflowIdReusePolicyAllowDuplicateFailedOnly.Ptr(), toThriftWorkflowIDReusePolicy(apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY))
+	s.Equal(shared.WorkflowIdReusePolicyAllowDuplicate.Ptr(), toThriftWorkflowIDReusePolicy(apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE))
+	s.Equal(shared.WorkflowIdReusePolicyRejectDuplicate.Ptr(), toThriftWorkflowIDReusePolicy(apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE))
+}
+
+func (s *grpcHandlerSuite) TestToGRPCError() {
+	testCases := []struct {
+		err  error
+		code yarpcerrors.Code
+	}{
+		{err: &shared.BadRequestError{}, code: yarpcerrors.CodeInvalidArgument},
+		{err: &shared.EntityNotExistsError{}, code: yarpcerrors.CodeNotFound},
+		{err: &shared.WorkflowExecutionAlreadyStartedError{}, code: yarpcerrors.CodeAlreadyExists},
+		{err: &shared.CancellationAlreadyRequestedError{}, code: yarpcerrors.CodeAlreadyExists},
+		{err: &shared.ServiceBusyError{}, code: yarpcerrors.CodeResourceExhausted},
+		{err: &shared.LimitExceededError{}, code: yarpcerrors.CodeResourceExhausted},
+		{err: &shared.DomainNotActiveError{}, code: yarpcerrors.CodeFailedPrecondition},
+		{err: yarpcerrors.Newf(yarpcerrors.CodeDeadlineExceeded, "some random message"), code: yarpcerrors.CodeDeadlineExceeded},
+		{err: errors.New("some random error"), code: yarpcerrors.CodeInternal},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.code, yarpcerrors.FromError(toGRPCError(tc.err)).Code(), "%T", tc.err)
+	}
+}
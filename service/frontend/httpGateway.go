@@ -0,0 +1,315 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/timeline"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+)
+
+const (
+	// httpGatewayPathPrefix is the path prefix of all gateway operations, the operation name follows it
+	httpGatewayPathPrefix = "/api/v1/"
+
+	httpGatewayMaxRequestBytes = 4 * 1024 * 1024
+	httpGatewayRequestTimeout  = 70 * time.Second
+
+	// httpGatewayCallerName is the caller of the requests passed on by the gateway
+	httpGatewayCallerName = "cadence-http-gateway"
+	// httpGatewayBearerPrefix is the scheme of the Authorization header carrying the authorization token
+	httpGatewayBearerPrefix = "Bearer "
+)
+
+type (
+	// HTTPGateway exposes a subset of the frontend API as JSON over HTTP, for scripts and web UIs which can't speak Thrift
+	HTTPGateway struct {
+		handler    workflowserviceserver.Interface
+		logger     log.Logger
+		server     *http.Server
+		operations map[string]httpGatewayOperation
+	}
+
+	// httpGatewayOperation decodes the JSON request body, invokes the frontend API and returns the response
+	httpGatewayOperation func(ctx context.Context, body []byte) (interface{}, error)
+
+	httpGatewayError struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}
+
+	// httpGatewayTimelineRequest is the request of GetWorkflowExecutionTimeline, which has no Thrift counterpart
+	httpGatewayTimelineRequest struct {
+		Domain    string                    `json:"domain"`
+		Execution *shared.WorkflowExecution `json:"execution"`
+	}
+)
+
+// NewHTTPGateway creates a HTTP/JSON gateway in front of the given frontend handler, listening on the given address
+func NewHTTPGateway(handler workflowserviceserver.Interface, address string, logger log.Logger) *HTTPGateway {
+	g := &HTTPGateway{
+		handler: handler,
+		logger:  logger,
+	}
+	g.operations = map[string]httpGatewayOperation{
+		"StartWorkflowExecution":       g.startWorkflowExecution,
+		"SignalWorkflowExecution":      g.signalWorkflowExecution,
+		"QueryWorkflow":                g.queryWorkflow,
+		"DescribeWorkflowExecution":    g.describeWorkflowExecution,
+		"ListOpenWorkflowExecutions":   g.listOpenWorkflowExecutions,
+		"ListClosedWorkflowExecutions": g.listClosedWorkflowExecutions,
+		"GetWorkflowExecutionHistory":  g.getWorkflowExecutionHistory,
+		"GetWorkflowExecutionTimeline": g.getWorkflowExecutionTimeline,
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(httpGatewayPathPrefix, g)
+	g.server = &http.Server{
+		Addr:    address,
+		Handler: mux,
+	}
+	return g
+}
+
+// Start starts serving the gateway in the background
+func (g *HTTPGateway) Start() {
+	go func() {
+		g.logger.Info("HTTP gateway listen on ", tag.Address(g.server.Addr))
+		if err := g.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			g.logger.Error("HTTP gateway stopped unexpectedly", tag.Error(err))
+		}
+	}()
+}
+
+// Stop stops the gateway
+func (g *HTTPGateway) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := g.server.Shutdown(ctx); err != nil {
+		g.logger.Warn("HTTP gateway shutdown failed", tag.Error(err))
+	}
+}
+
+// ServeHTTP handles POST /api/v1/<Operation> with the JSON encoded request of the operation as body
+func (g *HTTPGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		g.writeError(w, http.StatusMethodNotAllowed, &shared.BadRequestError{Message: "Only POST is supported."})
+		return
+	}
+
+	operationName := strings.TrimPrefix(r.URL.Path, httpGatewayPathPrefix)
+	operation, ok := g.operations[operationName]
+	if !ok {
+		g.writeError(w, http.StatusNotFound, &shared.BadRequestError{Message: fmt.Sprintf("Unknown operation: %v.", r.URL.Path)})
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, httpGatewayMaxRequestBytes))
+	if err != nil {
+		g.writeError(w, http.StatusRequestEntityTooLarge, &shared.BadRequestError{Message: err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), httpGatewayRequestTimeout)
+	defer cancel()
+	ctx, err = newHTTPGatewayCallContext(ctx, operationName, r)
+	if err != nil {
+		g.writeError(w, http.StatusBadRequest, &shared.BadRequestError{Message: err.Error()})
+		return
+	}
+	resp, err := operation(ctx, body)
+	if err != nil {
+		g.writeError(w, httpStatusFromError(err), err)
+		return
+	}
+	g.writeJSON(w, http.StatusOK, resp)
+}
+
+// newHTTPGatewayCallContext makes the request look like an inbound RPC call to the frontend handler, so the handlers
+// reading the call headers, e.g. the authorization token, see the headers of the HTTP request
+func newHTTPGatewayCallContext(ctx context.Context, operationName string, r *http.Request) (context.Context, error) {
+	headers := transport.NewHeaders()
+	if authorization := r.Header.Get("Authorization"); strings.HasPrefix(authorization, httpGatewayBearerPrefix) {
+		headers = headers.With(common.AuthorizationTokenHeaderName, strings.TrimPrefix(authorization, httpGatewayBearerPrefix))
+	}
+	for _, name := range []string{common.LibraryVersionHeaderName, common.FeatureVersionHeaderName, common.ClientImplHeaderName} {
+		if value := r.Header.Get(name); value != "" {
+			headers = headers.With(name, value)
+		}
+	}
+
+	ctx, call := encoding.NewInboundCall(ctx)
+	err := call.ReadFromRequest(&transport.Request{
+		Caller:    httpGatewayCallerName,
+		Service:   common.FrontendServiceName,
+		Encoding:  transport.Encoding("json"),
+		Procedure: operationName,
+		Headers:   headers,
+	})
+	return ctx, err
+}
+
+func (g *HTTPGateway) startWorkflowExecution(ctx context.Context, body []byte) (interface{}, error) {
+	request := &shared.StartWorkflowExecutionRequest{}
+	if err := decodeHTTPGatewayRequest(body, request); err != nil {
+		return nil, err
+	}
+	return g.handler.StartWorkflowExecution(ctx, request)
+}
+
+func (g *HTTPGateway) signalWorkflowExecution(ctx context.Context, body []byte) (interface{}, error) {
+	request := &shared.SignalWorkflowExecutionRequest{}
+	if err := decodeHTTPGatewayRequest(body, request); err != nil {
+		return nil, err
+	}
+	return struct{}{}, g.handler.SignalWorkflowExecution(ctx, request)
+}
+
+func (g *HTTPGateway) queryWorkflow(ctx context.Context, body []byte) (interface{}, error) {
+	request := &shared.QueryWorkflowRequest{}
+	if err := decodeHTTPGatewayRequest(body, request); err != nil {
+		return nil, err
+	}
+	return g.handler.QueryWorkflow(ctx, request)
+}
+
+func (g *HTTPGateway) describeWorkflowExecution(ctx context.Context, body []byte) (interface{}, error) {
+	request := &shared.DescribeWorkflowExecutionRequest{}
+	if err := decodeHTTPGatewayRequest(body, request); err != nil {
+		return nil, err
+	}
+	return g.handler.DescribeWorkflowExecution(ctx, request)
+}
+
+func (g *HTTPGateway) listOpenWorkflowExecutions(ctx context.Context, body []byte) (interface{}, error) {
+	request := &shared.ListOpenWorkflowExecutionsRequest{}
+	if err := decodeHTTPGatewayRequest(body, request); err != nil {
+		return nil, err
+	}
+	return g.handler.ListOpenWorkflowExecutions(ctx, request)
+}
+
+func (g *HTTPGateway) listClosedWorkflowExecutions(ctx context.Context, body []byte) (interface{}, error) {
+	request := &shared.ListClosedWorkflowExecutionsRequest{}
+	if err := decodeHTTPGatewayRequest(body, request); err != nil {
+		return nil, err
+	}
+	return g.handler.ListClosedWorkflowExecutions(ctx, request)
+}
+
+func (g *HTTPGateway) getWorkflowExecutionHistory(ctx context.Context, body []byte) (interface{}, error) {
+	request := &shared.GetWorkflowExecutionHistoryRequest{}
+	if err := decodeHTTPGatewayRequest(body, request); err != nil {
+		return nil, err
+	}
+	return g.handler.GetWorkflowExecutionHistory(ctx, request)
+}
+
+// getWorkflowExecutionTimeline reads the whole history of the run and returns the spans of its work, so web UIs
+// don't need to pair the events themselves
+func (g *HTTPGateway) getWorkflowExecutionTimeline(ctx context.Context, body []byte) (interface{}, error) {
+	request := &httpGatewayTimelineRequest{}
+	if err := decodeHTTPGatewayRequest(body, request); err != nil {
+		return nil, err
+	}
+
+	var events []*shared.HistoryEvent
+	historyRequest := &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:                 common.StringPtr(request.Domain),
+		Execution:              request.Execution,
+		HistoryEventFilterType: shared.HistoryEventFilterTypeAllEvent.Ptr(),
+	}
+	for {
+		response, err := g.handler.GetWorkflowExecutionHistory(ctx, historyRequest)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, response.GetHistory().GetEvents()...)
+		if len(response.NextPageToken) == 0 {
+			return timeline.Build(events), nil
+		}
+		historyRequest.NextPageToken = response.NextPageToken
+	}
+}
+
+func (g *HTTPGateway) writeError(w http.ResponseWriter, status int, err error) {
+	errType := fmt.Sprintf("%T", err)
+	if !strings.HasPrefix(errType, "*shared.") {
+		// internal errors are already converted to plain errors by the frontend handler
+		errType = "*shared.InternalServiceError"
+	}
+	g.writeJSON(w, status, &httpGatewayError{
+		Type:    strings.TrimPrefix(errType, "*shared."),
+		Message: err.Error(),
+	})
+}
+
+func (g *HTTPGateway) writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		g.logger.Warn("HTTP gateway failed to write response", tag.Error(err))
+	}
+}
+
+func decodeHTTPGatewayRequest(body []byte, request interface{}) error {
+	if len(body) == 0 {
+		return errRequestNotSet
+	}
+	if err := json.Unmarshal(body, request); err != nil {
+		return &shared.BadRequestError{Message: fmt.Sprintf("Invalid JSON request: %v.", err)}
+	}
+	return nil
+}
+
+func httpStatusFromError(err error) int {
+	switch err.(type) {
+	case *shared.BadRequestError, *shared.DomainNotActiveError, *shared.QueryFailedError,
+		*shared.ClientVersionNotSupportedError:
+		return http.StatusBadRequest
+	case *shared.AccessDeniedError:
+		return http.StatusForbidden
+	case *shared.EntityNotExistsError:
+		return http.StatusNotFound
+	case *shared.WorkflowExecutionAlreadyStartedError, *shared.CancellationAlreadyRequestedError:
+		return http.StatusConflict
+	case *shared.LimitExceededError:
+		return http.StatusTooManyRequests
+	case *shared.ServiceBusyError:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/timeline"
+)
+
+type (
+	httpGatewaySuite struct {
+		suite.Suite
+		*require.Assertions
+		handler *testGatewayHandler
+		gateway *HTTPGateway
+	}
+
+	// testGatewayHandler only implements the APIs exercised by the tests
+	testGatewayHandler struct {
+		workflowserviceserver.Interface
+		describeRequest *shared.DescribeWorkflowExecutionRequest
+		describeErr     error
+		historyTokens   [][]byte
+		historyPages    []*shared.GetWorkflowExecutionHistoryResponse
+	}
+)
+
+func TestHTTPGatewaySuite(t *testing.T) {
+	s := new(httpGatewaySuite)
+	suite.Run(t, s)
+}
+
+func (s *httpGatewaySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.handler = &testGatewayHandler{}
+	s.gateway = NewHTTPGateway(s.handler, "", loggerimpl.NewNopLogger())
+}
+
+func (s *httpGatewaySuite) TestDescribeWorkflowExecution() {
+	recorder := s.post("/api/v1/DescribeWorkflowExecution",
+		`{"domain":"test-domain","execution":{"workflowId":"wid","runId":"rid"}}`)
+
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal("test-domain", s.handler.describeRequest.GetDomain())
+	s.Equal("wid", s.handler.describeRequest.GetExecution().GetWorkflowId())
+
+	resp := &shared.DescribeWorkflowExecutionResponse{}
+	s.NoError(json.Unmarshal(recorder.Body.Bytes(), resp))
+	s.Equal("wid", resp.GetWorkflowExecutionInfo().GetExecution().GetWorkflowId())
+}
+
+func (s *httpGatewaySuite) TestErrorMapping() {
+	s.handler.describeErr = &shared.EntityNotExistsError{Message: "not found"}
+	recorder := s.post("/api/v1/DescribeWorkflowExecution", `{"domain":"test-domain"}`)
+
+	s.Equal(http.StatusNotFound, recorder.Code)
+	resp := &httpGatewayError{}
+	s.NoError(json.Unmarshal(recorder.Body.Bytes(), resp))
+	s.Equal("EntityNotExistsError", resp.Type)
+}
+
+func (s *httpGatewaySuite) TestInvalidRequests() {
+	s.Equal(http.StatusNotFound, s.post("/api/v1/UnknownOperation", `{}`).Code)
+	s.Equal(http.StatusBadRequest, s.post("/api/v1/DescribeWorkflowExecution", `not json`).Code)
+	s.Equal(http.StatusBadRequest, s.post("/api/v1/DescribeWorkflowExecution", ``).Code)
+
+	recorder := httptest.NewRecorder()
+	s.gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/DescribeWorkflowExecution", nil))
+	s.Equal(http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func (s *httpGatewaySuite) TestAuthorizationToken() {
+	authorizer := authorization.NewRoleAuthorizer([]authorization.RoleGrant{
+		{Token: "viewer-token", DomainName: "test-domain", Role: authorization.RoleRead},
+	})
+	s.gateway = NewHTTPGateway(NewAuthorizationHandler(s.handler, authorizer, nil), "", loggerimpl.NewNopLogger())
+	body := `{"domain":"test-domain","execution":{"workflowId":"wid","runId":"rid"}}`
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/DescribeWorkflowExecution", strings.NewReader(body))
+	request.Header.Set("Authorization", "Bearer viewer-token")
+	recorder := httptest.NewRecorder()
+	s.gateway.ServeHTTP(recorder, request)
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal("wid", s.handler.describeRequest.GetExecution().GetWorkflowId())
+
+	request = httptest.NewRequest(http.MethodPost, "/api/v1/DescribeWorkflowExecution", strings.NewReader(body))
+	request.Header.Set("Authorization", "Bearer unknown-token")
+	recorder = httptest.NewRecorder()
+	s.gateway.ServeHTTP(recorder, request)
+	s.Equal(http.StatusForbidden, recorder.Code)
+
+	s.Equal(http.StatusForbidden, s.post("/api/v1/DescribeWorkflowExecution", body).Code)
+}
+
+func (s *httpGatewaySuite) TestGetWorkflowExecutionTimeline() {
+	s.handler.historyPages = []*shared.GetWorkflowExecutionHistoryResponse{
+		{
+			History: &shared.History{Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(1),
+					Timestamp: common.Int64Ptr(100),
+					EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+					WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+						WorkflowType: &shared.WorkflowType{Name: common.StringPtr("test-workflow")},
+					},
+				},
+				{
+					EventId:                              common.Int64Ptr(2),
+					Timestamp:                            common.Int64Ptr(200),
+					EventType:                            shared.EventTypeDecisionTaskScheduled.Ptr(),
+					DecisionTaskScheduledEventAttributes: &shared.DecisionTaskScheduledEventAttributes{},
+				},
+			}},
+			NextPageToken: []byte("next-page"),
+		},
+		{
+			History: &shared.History{Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(3),
+					Timestamp: common.Int64Ptr(300),
+					EventType: shared.EventTypeDecisionTaskStarted.Ptr(),
+					DecisionTaskStartedEventAttributes: &shared.DecisionTaskStartedEventAttributes{
+						ScheduledEventId: common.Int64Ptr(2),
+					},
+				},
+				{
+					EventId:   common.Int64Ptr(4),
+					Timestamp: common.Int64Ptr(400),
+					EventType: shared.EventTypeDecisionTaskCompleted.Ptr(),
+					DecisionTaskCompletedEventAttributes: &shared.DecisionTaskCompletedEventAttributes{
+						ScheduledEventId: common.Int64Ptr(2),
+					},
+				},
+			}},
+		},
+	}
+	recorder := s.post("/api/v1/GetWorkflowExecutionTimeline",
+		`{"domain":"test-domain","execution":{"workflowId":"wid","runId":"rid"}}`)
+
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal([][]byte{nil, []byte("next-page")}, s.handler.historyTokens)
+
+	resp := &timeline.Timeline{}
+	s.NoError(json.Unmarshal(recorder.Body.Bytes(), resp))
+	s.Len(resp.Spans, 2)
+	s.Equal(timeline.SpanTypeWorkflow, resp.Spans[0].Type)
+	s.Equal("test-workflow", resp.Spans[0].Name)
+	s.Equal(timeline.SpanStatusRunning, resp.Spans[0].Status)
+	s.Equal(timeline.SpanTypeDecision, resp.Spans[1].Type)
+	s.Equal(timeline.SpanStatusCompleted, resp.Spans[1].Status)
+	s.Equal(int64(3), resp.Spans[1].StartedEventID)
+	s.Equal(int64(4), resp.Spans[1].ClosedEventID)
+	s.Equal(int64(200), resp.Spans[1].Duration())
+}
+
+func (s *httpGatewaySuite) post(path string, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	s.gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	return recorder
+}
+
+func (h *testGatewayHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	h.describeRequest = request
+	if h.describeErr != nil {
+		return nil, h.describeErr
+	}
+	return &shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{
+			Execution: &shared.WorkflowExecution{WorkflowId: common.StringPtr(request.GetExecution().GetWorkflowId())},
+		},
+	}, nil
+}
+
+func (h *testGatewayHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	h.historyTokens = append(h.historyTokens, request.NextPageToken)
+	return h.historyPages[len(h.historyTokens)-1], nil
+}
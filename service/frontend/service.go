@@ -21,8 +21,12 @@
 package frontend
 
 import (
+	"time"
+
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/common"
+	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -30,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	espersistence "github.com/uber/cadence/common/persistence/elasticsearch"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
+	"github.com/uber/cadence/common/searchattribute"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -40,6 +45,7 @@ type Config struct {
 	NumHistoryShards                int
 	PersistenceMaxQPS               dynamicconfig.IntPropertyFn
 	VisibilityMaxPageSize           dynamicconfig.IntPropertyFnWithDomainFilter
+	VisibilityMaxCountScan          dynamicconfig.IntPropertyFnWithDomainFilter
 	EnableVisibilitySampling        dynamicconfig.BoolPropertyFn
 	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
 	VisibilityListMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
@@ -66,10 +72,28 @@ type Config struct {
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// encoding of new task tokens, HMAC keys they are signed and verified with, and whether unsigned tokens are still accepted
+	TaskTokenEncoding            dynamicconfig.StringPropertyFn
+	TaskTokenSigningKey          dynamicconfig.StringPropertyFn
+	TaskTokenPreviousSigningKeys dynamicconfig.StringPropertyFn
+	TaskTokenAcceptUnsigned      dynamicconfig.BoolPropertyFn
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
+	// AccessLogSampleRate is the fraction of requests written to the access log, filtered by domain and API
+	AccessLogSampleRate dynamicconfig.FloatPropertyFn
+
 	// Domain specific config
 	EnableDomainNotActiveAutoForwarding dynamicconfig.BoolPropertyFnWithDomainFilter
+
+	// SearchAttributesRefreshInterval is how long registered search attributes are cached
+	SearchAttributesRefreshInterval dynamicconfig.DurationPropertyFn
+
+	// ShutdownDrainDuration is how long the host keeps serving requests other than polls after it is asked to stop
+	ShutdownDrainDuration dynamicconfig.DurationPropertyFn
+
+	// HistoryHedgingDelay is how long the idempotent reads sent to history wait before they are hedged, 0 disables it
+	HistoryHedgingDelay dynamicconfig.DurationPropertyFn
 }
 
 // NewConfig returns new service config with default values
@@ -78,6 +102,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableVisibil
 		NumHistoryShards:                    numHistoryShards,
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.FrontendPersistenceMaxQPS, 2000),
 		VisibilityMaxPageSize:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
+		VisibilityMaxCountScan:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxCountScan, 10000),
 		EnableVisibilitySampling:            dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:     dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
 		VisibilityListMaxQPS:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityListMaxQPS, 1),
@@ -94,10 +119,18 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableVisibil
 		AdminOperationToken:                 dc.GetStringProperty(dynamicconfig.AdminOperationToken, "CadenceTeamONLY"),
 		DisableListVisibilityByFilter:       dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
-		BlobSizeLimitWarn:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1204),
+		BlobSizeLimitWarn:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		TaskTokenEncoding:                   dc.GetStringProperty(dynamicconfig.TaskTokenEncoding, common.TaskTokenEncodingJSON),
+		TaskTokenSigningKey:                 dc.GetStringProperty(dynamicconfig.TaskTokenSigningKey, ""),
+		TaskTokenPreviousSigningKeys:        dc.GetStringProperty(dynamicconfig.TaskTokenPreviousSigningKeys, ""),
+		TaskTokenAcceptUnsigned:             dc.GetBoolProperty(dynamicconfig.TaskTokenAcceptUnsigned, true),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		EnableDomainNotActiveAutoForwarding: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableDomainNotActiveAutoForwarding, false),
 		EnableClientVersionCheck:            dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, enableClientVersionCheck),
+		AccessLogSampleRate:                 dc.GetFloat64Property(dynamicconfig.FrontendAccessLogSampleRate, 0),
+		SearchAttributesRefreshInterval:     dc.GetDurationProperty(dynamicconfig.SearchAttributesRefreshInterval, 30*time.Second),
+		ShutdownDrainDuration:               dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 5*time.Second),
+		HistoryHedgingDelay:                 dc.GetDurationProperty(dynamicconfig.FrontendHistoryHedgingDelay, 0),
 	}
 }
 
@@ -138,13 +171,19 @@ func (s *Service) Start() {
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), params.BlobstoreClient, log)
 
 	metadata, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
 	if err != nil {
 		log.Fatal("failed to create metadata manager", tag.Error(err))
 	}
 
+	clusterMetadataMgr, err := pFactory.NewClusterMetadataManager()
+	if err != nil {
+		log.Fatal("failed to create cluster metadata manager", tag.Error(err))
+	}
+	searchAttrs := searchattribute.NewRegistry(clusterMetadataMgr, s.config.SearchAttributesRefreshInterval, log)
+
 	visibilityFromDB, err := pFactory.NewVisibilityManager()
 	if err != nil {
 		log.Fatal("failed to create visibility manager", tag.Error(err))
@@ -152,13 +191,13 @@ func (s *Service) Start() {
 
 	var visibilityFromES persistence.VisibilityManager
 	if s.config.EnableVisibilityToKafka() {
-		visibilityIndexName := params.ESConfig.Indices[common.VisibilityAppName]
+		visibilityIndexRouter := es.NewIndexRouter(params.ESConfig.Indices[common.VisibilityAppName], params.ESConfig.DomainIndices)
 		visibilityConfigForES := &config.VisibilityConfig{
 			MaxQPS:                 s.config.PersistenceMaxQPS,
 			VisibilityListMaxQPS:   s.config.ESVisibilityListMaxQPS,
 			ESIndexMaxResultWindow: s.config.ESIndexMaxResultWindow,
 		}
-		visibilityFromES = espersistence.NewESVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
+		visibilityFromES = espersistence.NewESVisibilityManager(visibilityIndexRouter, params.ESClient, visibilityConfigForES,
 			nil, base.GetMetricsClient(), log)
 	}
 	visibility := persistence.NewVisibilityManagerWrapper(visibilityFromDB, visibilityFromES, s.config.EnableReadVisibilityFromES)
@@ -184,18 +223,34 @@ func (s *Service) Start() {
 	}
 
 	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer,
-		params.BlobstoreClient)
+		params.BlobstoreClient, params.Authorizer, searchAttrs)
 	wfHandler.Start()
 	dcRedirectionHandler := NewDCRedirectionHandler(wfHandler, params.DCRedirectionPolicy)
-	base.GetDispatcher().Register(workflowserviceserver.New(dcRedirectionHandler))
-	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2)
+	authorizationHandler := NewAuthorizationHandler(dcRedirectionHandler, params.Authorizer, wfHandler.domainCache)
+	accessLogHandler := NewAccessLogHandler(authorizationHandler, s.config, log)
+	base.GetDispatcher().Register(workflowserviceserver.New(accessLogHandler))
+	base.GetDispatcher().Register(apiv1.BuildWorkflowAPIYARPCProcedures(NewGRPCHandler(accessLogHandler)))
+	var httpGateway *HTTPGateway
+	if params.HTTPGatewayAddress != "" {
+		httpGateway = NewHTTPGateway(accessLogHandler, params.HTTPGatewayAddress, log)
+		httpGateway.Start()
+	}
+	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, searchAttrs,
+		params.Authorizer, params.DynamicConfig)
 	adminHandler.Start()
 
 	log.Info("started", tag.Service(common.FrontendServiceName))
 
 	<-s.stopC
 
-	base.Stop()
+	wfHandler.PrepareToStop()
+	log.Info("draining", tag.Service(common.FrontendServiceName))
+	time.Sleep(s.config.ShutdownDrainDuration())
+
+	if httpGateway != nil {
+		httpGateway.Stop()
+	}
+	wfHandler.Stop()
 }
 
 // Stop stops the service
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -39,6 +40,7 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/blobstore/blob"
@@ -51,9 +53,11 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattribute"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/tokenbucket"
 	"github.com/uber/cadence/service/worker/archiver"
+	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/yarpcerrors"
 )
 
@@ -62,22 +66,25 @@ var _ workflowserviceserver.Interface = (*WorkflowHandler)(nil)
 type (
 	// WorkflowHandler - Thrift handler interface for workflow service
 	WorkflowHandler struct {
-		domainCache       cache.DomainCache
-		metadataMgr       persistence.MetadataManager
-		historyMgr        persistence.HistoryManager
-		historyV2Mgr      persistence.HistoryV2Manager
-		visibilityMgr     persistence.VisibilityManager
-		history           history.Client
-		matching          matching.Client
-		matchingRawClient matching.Client
-		tokenSerializer   common.TaskTokenSerializer
-		metricsClient     metrics.Client
-		startWG           sync.WaitGroup
-		rateLimiter       tokenbucket.TokenBucket
-		config            *Config
-		blobstoreClient   blobstore.Client
-		versionChecker    *versionChecker
-		domainHandler     *domainHandlerImpl
+		domainCache          cache.DomainCache
+		metadataMgr          persistence.MetadataManager
+		historyMgr           persistence.HistoryManager
+		historyV2Mgr         persistence.HistoryV2Manager
+		visibilityMgr        persistence.VisibilityManager
+		history              history.Client
+		matching             matching.Client
+		matchingRawClient    matching.Client
+		tokenSerializer      common.TaskTokenSerializer
+		metricsClient        metrics.Client
+		startWG              sync.WaitGroup
+		rateLimiter          tokenbucket.TokenBucket
+		config               *Config
+		blobstoreClient      blobstore.Client
+		versionChecker       *versionChecker
+		domainHandler        *domainHandlerImpl
+		authorizer           authorization.Authorizer
+		searchAttrsValidator *searchattribute.Validator
+		isShuttingDown       int32
 		service.Service
 	}
 
@@ -121,6 +128,9 @@ var (
 	errInvalidExecutionStartToCloseTimeoutSeconds = &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
 	errClientVersionNotSet                        = &gen.BadRequestError{Message: "Client version is not set on request."}
+	errInvalidWorkflowIDReusePolicy               = &gen.BadRequestError{Message: "Invalid WorkflowIdReusePolicy."}
+
+	errShuttingDown = &gen.ServiceBusyError{Message: "Frontend host is shutting down."}
 
 	// err for archival
 	errDomainHasNeverBeenEnabledForArchival = &gen.BadRequestError{Message: "Attempted to fetch history from archival, but domain has never been enabled for archival."}
@@ -134,6 +144,17 @@ var (
 	errTaskListTooLong     = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
+	errActivityIDTooLong   = &gen.BadRequestError{Message: "ActivityID length exceeds limit."}
+
+	// err for invalid characters or reserved prefix in IDs
+	errWorkflowIDInvalid  = &gen.BadRequestError{Message: "WorkflowID must be valid UTF-8 without control characters."}
+	errTaskListInvalid    = &gen.BadRequestError{Message: "TaskList must be valid UTF-8 without control characters."}
+	errActivityIDInvalid  = &gen.BadRequestError{Message: "ActivityID must be valid UTF-8 without control characters."}
+	errIdentityInvalid    = &gen.BadRequestError{Message: "Identity must be valid UTF-8 without control characters."}
+	errWorkflowIDReserved = &gen.BadRequestError{Message: "WorkflowID prefix " + common.ReservedIDPrefix + " is reserved for system workflows."}
+	errTaskListReserved   = &gen.BadRequestError{Message: "TaskList prefix " + common.ReservedIDPrefix + " is reserved for system workflows."}
+
+	errPollNotAuthorized = &gen.AccessDeniedError{Message: "Not authorized to poll the task list."}
 
 	// err indicating that this cluster is not the master, so cannot do domain registration or update
 	errNotMasterCluster                = &gen.BadRequestError{Message: "Cluster is not master cluster, cannot do domain registration or domain update."}
@@ -149,19 +170,21 @@ var (
 func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	visibilityMgr persistence.VisibilityManager, kafkaProducer messaging.Producer,
-	blobstoreClient blobstore.Client) *WorkflowHandler {
+	blobstoreClient blobstore.Client, authorizer authorization.Authorizer, searchAttrs searchattribute.Registry) *WorkflowHandler {
 	handler := &WorkflowHandler{
-		Service:         sVice,
-		config:          config,
-		metadataMgr:     metadataMgr,
-		historyMgr:      historyMgr,
-		historyV2Mgr:    historyV2Mgr,
-		visibilityMgr:   visibilityMgr,
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
-		domainCache:     cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
-		rateLimiter:     tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
-		blobstoreClient: blobstoreClient,
-		versionChecker:  &versionChecker{checkVersion: config.EnableClientVersionCheck()},
+		Service:              sVice,
+		config:               config,
+		metadataMgr:          metadataMgr,
+		historyMgr:           historyMgr,
+		historyV2Mgr:         historyV2Mgr,
+		visibilityMgr:        visibilityMgr,
+		tokenSerializer:      common.NewTaskTokenSerializer(config.TaskTokenEncoding, config.TaskTokenSigningKey, config.TaskTokenPreviousSigningKeys, config.TaskTokenAcceptUnsigned),
+		domainCache:          cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
+		rateLimiter:          tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
+		blobstoreClient:      blobstoreClient,
+		versionChecker:       &versionChecker{checkVersion: config.EnableClientVersionCheck()},
+		authorizer:           authorizer,
+		searchAttrsValidator: searchattribute.NewValidator(searchAttrs),
 		domainHandler: newDomainHandler(
 			config,
 			sVice.GetLogger(),
@@ -186,7 +209,9 @@ func (wh *WorkflowHandler) Start() error {
 	wh.Service.Start()
 	wh.domainCache.Start()
 
-	wh.history = wh.GetClientBean().GetHistoryClient()
+	wh.history = history.NewHedgedClient(wh.GetClientBean().GetHistoryClient(), func() time.Duration {
+		return wh.config.HistoryHedgingDelay()
+	})
 	wh.matchingRawClient = wh.GetClientBean().GetMatchingClient()
 	wh.matching = matching.NewRetryableClient(wh.matchingRawClient, common.CreateMatchingServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
@@ -197,17 +222,26 @@ func (wh *WorkflowHandler) Start() error {
 
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
+	wh.Service.Stop()
 	wh.domainCache.Stop()
 	wh.metadataMgr.Close()
 	wh.visibilityMgr.Close()
 	wh.historyMgr.Close()
-	wh.Service.Stop()
+}
+
+// PrepareToStop makes the handler reject new polls and report unhealthy, so pollers move on to other
+// frontend hosts while the completions of tasks already handed out still go through
+func (wh *WorkflowHandler) PrepareToStop() {
+	atomic.StoreInt32(&wh.isShuttingDown, 1)
 }
 
 // Health is for health check
 func (wh *WorkflowHandler) Health(ctx context.Context) (*health.HealthStatus, error) {
 	wh.startWG.Wait()
 	wh.GetLogger().Debug("Frontend health check endpoint reached.")
+	if atomic.LoadInt32(&wh.isShuttingDown) == 1 {
+		return &health.HealthStatus{Ok: false, Msg: common.StringPtr("frontend shutting down")}, nil
+	}
 	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr("frontend good")}
 	return hs, nil
 }
@@ -332,6 +366,10 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if atomic.LoadInt32(&wh.isShuttingDown) == 1 {
+		return nil, wh.error(errShuttingDown, scope)
+	}
+
 	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
@@ -352,8 +390,12 @@ func (wh *WorkflowHandler) PollForActivityTask(
 	if err := wh.validateTaskList(pollRequest.TaskList, scope); err != nil {
 		return nil, err
 	}
-	if len(pollRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(pollRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.authorizePoll(ctx, "PollForActivityTask", pollRequest.GetDomain(), pollRequest.TaskList); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	domainID, err := wh.domainCache.GetDomainID(pollRequest.GetDomain())
@@ -415,6 +457,10 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if atomic.LoadInt32(&wh.isShuttingDown) == 1 {
+		return nil, wh.error(errShuttingDown, scope)
+	}
+
 	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
@@ -431,14 +477,18 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(errDomainTooLong, scope)
 	}
 
-	if len(pollRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(pollRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if err := wh.validateTaskList(pollRequest.TaskList, scope); err != nil {
 		return nil, err
 	}
 
+	if err := wh.authorizePoll(ctx, "PollForDecisionTask", pollRequest.GetDomain(), pollRequest.TaskList); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	domainName := pollRequest.GetDomain()
 	domainID, err := wh.domainCache.GetDomainID(domainName)
 	if err != nil {
@@ -541,6 +591,9 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	if heartbeatRequest.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
+	if err := wh.validateID(heartbeatRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return nil, wh.error(err, scope)
+	}
 	taskToken, err := wh.tokenSerializer.Deserialize(heartbeatRequest.TaskToken)
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -637,6 +690,12 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 	if activityID == "" {
 		return nil, wh.error(errActivityIDNotSet, scope)
 	}
+	if err := wh.validateID(activityID, errActivityIDTooLong, errActivityIDInvalid); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	if err := wh.validateID(heartbeatRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	taskToken := &common.TaskToken{
 		DomainID:   domainID,
@@ -741,8 +800,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	if err != nil {
 		return wh.error(err, scope)
 	}
-	if len(completeRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(completeRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
 	}
 
 	// add domain tag to scope, so further metrics will have the domain tag
@@ -826,9 +885,12 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 	if activityID == "" {
 		return wh.error(errActivityIDNotSet, scope)
 	}
+	if err := wh.validateID(activityID, errActivityIDTooLong, errActivityIDInvalid); err != nil {
+		return wh.error(err, scope)
+	}
 
-	if len(completeRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(completeRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
 	}
 
 	taskToken := &common.TaskToken{
@@ -937,8 +999,8 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	if len(failedRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(failedRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
 	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
@@ -1007,8 +1069,11 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	if activityID == "" {
 		return wh.error(errActivityIDNotSet, scope)
 	}
-	if len(failedRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(activityID, errActivityIDTooLong, errActivityIDInvalid); err != nil {
+		return wh.error(err, scope)
+	}
+	if err := wh.validateID(failedRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
 	}
 
 	taskToken := &common.TaskToken{
@@ -1106,8 +1171,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	if len(cancelRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(cancelRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
 	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
@@ -1188,8 +1253,11 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 	if activityID == "" {
 		return wh.error(errActivityIDNotSet, scope)
 	}
-	if len(cancelRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(activityID, errActivityIDTooLong, errActivityIDInvalid); err != nil {
+		return wh.error(err, scope)
+	}
+	if err := wh.validateID(cancelRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
 	}
 
 	taskToken := &common.TaskToken{
@@ -1306,8 +1374,8 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 		return nil, wh.error(err, scope)
 	}
 
-	if len(completeRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(completeRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	completedResp := &gen.RespondDecisionTaskCompletedResponse{}
@@ -1377,8 +1445,8 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainEntry.GetInfo().Name))
 
-	if len(failedRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
-		return wh.error(errIdentityTooLong, scope)
+	if err := wh.validateID(failedRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
 	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
@@ -1496,8 +1564,12 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errWorkflowIDNotSet, scope)
 	}
 
-	if len(startRequest.GetWorkflowId()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errWorkflowIDTooLong, scope)
+	if err := wh.validateID(startRequest.GetWorkflowId(), errWorkflowIDTooLong, errWorkflowIDInvalid); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if common.HasReservedIDPrefix(startRequest.GetDomain(), startRequest.GetWorkflowId()) {
+		return nil, wh.error(errWorkflowIDReserved, scope)
 	}
 
 	if err := common.ValidateRetryPolicy(startRequest.RetryPolicy); err != nil {
@@ -1508,6 +1580,14 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope)
 	}
 
+	if err := validateWorkflowIDReusePolicy(startRequest.WorkflowIdReusePolicy); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.searchAttrsValidator.ValidateSearchAttributes(startRequest.SearchAttributes); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	wh.Service.GetLogger().Debug(
 		"Received StartWorkflowExecution. WorkflowID",
 		tag.WorkflowID(startRequest.GetWorkflowId()))
@@ -1516,14 +1596,6 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errWorkflowTypeNotSet, scope)
 	}
 
-	if len(startRequest.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errWorkflowTypeTooLong, scope)
-	}
-
-	if err := wh.validateTaskList(startRequest.TaskList, scope); err != nil {
-		return nil, err
-	}
-
 	if startRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
 		return nil, wh.error(errInvalidExecutionStartToCloseTimeoutSeconds, scope)
 	}
@@ -1540,6 +1612,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errRequestIDTooLong, scope)
 	}
 
+	if err := wh.validateID(startRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	maxDecisionTimeout := int32(wh.config.MaxDecisionStartToCloseTimeout(startRequest.GetDomain()))
 	// TODO: remove this assignment and logging in future, so that frontend will just return bad request for large decision timeout
 	if startRequest.GetTaskStartToCloseTimeoutSeconds() > startRequest.GetExecutionStartToCloseTimeoutSeconds() {
@@ -1566,17 +1642,33 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 
 	domainName := startRequest.GetDomain()
 	wh.Service.GetLogger().Debug("Start workflow execution request domain", tag.WorkflowDomainName(domainName))
-	domainID, err := wh.domainCache.GetDomainID(domainName)
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	domainID := domainEntry.GetInfo().ID
+
+	startRequest.WorkflowType, startRequest.TaskList = applyDomainStartRules(domainEntry, startRequest.WorkflowType,
+		startRequest.TaskList)
+	if len(startRequest.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errWorkflowTypeTooLong, scope)
+	}
+
+	if err := wh.validateTaskList(startRequest.TaskList, scope); err != nil {
+		return nil, err
+	}
+
+	if common.HasReservedIDPrefix(startRequest.GetDomain(), startRequest.TaskList.GetName()) {
+		return nil, wh.error(errTaskListReserved, scope)
+	}
 
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainName))
 
 	sizeLimitError := wh.config.BlobSizeLimitError(startRequest.GetDomain())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(startRequest.GetDomain())
-	actualSize := len(startRequest.Input) + common.GetSizeOfMapStringToByteArray(startRequest.Memo.GetFields())
+	actualSize := len(startRequest.Input) + common.GetSizeOfMapStringToByteArray(startRequest.Memo.GetFields()) +
+		common.GetSizeOfMapStringToByteArray(startRequest.SearchAttributes.GetIndexedFields())
 	if err := common.CheckEventBlobSizeLimit(
 		actualSize,
 		sizeLimitWarn,
@@ -1715,7 +1807,8 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			token.IsWorkflowRunning = isWorkflowRunning
 		}
 	} else {
-		if !isCloseEventOnly {
+		// only block on the first query when the caller is long polling for the close event
+		if !isCloseEventOnly || !isLongPoll {
 			queryNextEventID = common.FirstEventID
 		}
 		token.EventStoreVersion, token.BranchToken, runID, lastFirstEventID, nextEventID, isWorkflowRunning, err = queryHistory(domainID, execution, queryNextEventID)
@@ -1737,6 +1830,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	if isCloseEventOnly {
 		if !isWorkflowRunning {
 			history, _, err = wh.getHistory(
+				ctx,
 				scope,
 				domainID,
 				*execution,
@@ -1770,6 +1864,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			}
 		} else {
 			history, token.PersistenceToken, err = wh.getHistory(
+				ctx,
 				scope,
 				domainID,
 				*execution,
@@ -1851,6 +1946,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(errRequestIDTooLong, scope)
 	}
 
+	if err := wh.validateID(signalRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(signalRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -1922,8 +2021,12 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowId is not set on request."}, scope)
 	}
 
-	if len(signalWithStartRequest.GetWorkflowId()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errWorkflowIDTooLong, scope)
+	if err := wh.validateID(signalWithStartRequest.GetWorkflowId(), errWorkflowIDTooLong, errWorkflowIDInvalid); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if common.HasReservedIDPrefix(signalWithStartRequest.GetDomain(), signalWithStartRequest.GetWorkflowId()) {
+		return nil, wh.error(errWorkflowIDReserved, scope)
 	}
 
 	if signalWithStartRequest.GetSignalName() == "" {
@@ -1938,18 +2041,14 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowType is not set on request."}, scope)
 	}
 
-	if len(signalWithStartRequest.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errWorkflowTypeTooLong, scope)
-	}
-
-	if err := wh.validateTaskList(signalWithStartRequest.TaskList, scope); err != nil {
-		return nil, err
-	}
-
 	if len(signalWithStartRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errRequestIDTooLong, scope)
 	}
 
+	if err := wh.validateID(signalWithStartRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if signalWithStartRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
 		return nil, wh.error(&gen.BadRequestError{
 			Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}, scope)
@@ -1968,6 +2067,14 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
+	if err := validateWorkflowIDReusePolicy(signalWithStartRequest.WorkflowIdReusePolicy); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.searchAttrsValidator.ValidateSearchAttributes(signalWithStartRequest.SearchAttributes); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	maxDecisionTimeout := int32(wh.config.MaxDecisionStartToCloseTimeout(signalWithStartRequest.GetDomain()))
 	// TODO: remove this assignment and logging in future, so that frontend will just return bad request for large decision timeout
 	if signalWithStartRequest.GetTaskStartToCloseTimeoutSeconds() > signalWithStartRequest.GetExecutionStartToCloseTimeoutSeconds() {
@@ -1992,10 +2099,25 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 			Message: fmt.Sprintf("TaskStartToCloseTimeoutSeconds is larger than ExecutionStartToCloseTimeout or MaxDecisionStartToCloseTimeout (%ds).", maxDecisionTimeout)}, scope)
 	}
 
-	domainID, err := wh.domainCache.GetDomainID(signalWithStartRequest.GetDomain())
+	domainEntry, err := wh.domainCache.GetDomain(signalWithStartRequest.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	domainID := domainEntry.GetInfo().ID
+
+	signalWithStartRequest.WorkflowType, signalWithStartRequest.TaskList = applyDomainStartRules(domainEntry,
+		signalWithStartRequest.WorkflowType, signalWithStartRequest.TaskList)
+	if len(signalWithStartRequest.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errWorkflowTypeTooLong, scope)
+	}
+
+	if err := wh.validateTaskList(signalWithStartRequest.TaskList, scope); err != nil {
+		return nil, err
+	}
+
+	if common.HasReservedIDPrefix(signalWithStartRequest.GetDomain(), signalWithStartRequest.TaskList.GetName()) {
+		return nil, wh.error(errTaskListReserved, scope)
+	}
 
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(signalWithStartRequest.GetDomain()))
@@ -2014,7 +2136,8 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 	); err != nil {
 		return nil, wh.error(err, scope)
 	}
-	actualSize := len(signalWithStartRequest.Input) + common.GetSizeOfMapStringToByteArray(signalWithStartRequest.Memo.GetFields())
+	actualSize := len(signalWithStartRequest.Input) + common.GetSizeOfMapStringToByteArray(signalWithStartRequest.Memo.GetFields()) +
+		common.GetSizeOfMapStringToByteArray(signalWithStartRequest.SearchAttributes.GetIndexedFields())
 	if err := common.CheckEventBlobSizeLimit(
 		actualSize,
 		sizeLimitWarn,
@@ -2075,6 +2198,14 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 		return err
 	}
 
+	if len(terminateRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if err := wh.validateID(terminateRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(terminateRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -2094,6 +2225,112 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history. No new decision tasks are scheduled and user timers do not fire until it is resumed.
+func (wh *WorkflowHandler) PauseWorkflowExecution(ctx context.Context,
+	pauseRequest *gen.PauseWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope := wh.metricsClient.Scope(metrics.FrontendPauseWorkflowExecutionScope)
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(pauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if err := wh.validateID(pauseRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(pauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	// add domain tag to scope, so further metrics will have the domain tag
+	scope = scope.Tagged(metrics.DomainTag(pauseRequest.GetDomain()))
+
+	err = wh.history.PauseWorkflowExecution(ctx, &h.PauseWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event
+// in the history and scheduling a decision task.
+func (wh *WorkflowHandler) ResumeWorkflowExecution(ctx context.Context,
+	resumeRequest *gen.ResumeWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope := wh.metricsClient.Scope(metrics.FrontendResumeWorkflowExecutionScope)
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if resumeRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if resumeRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(resumeRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if err := wh.validateID(resumeRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(resumeRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	// add domain tag to scope, so further metrics will have the domain tag
+	scope = scope.Tagged(metrics.DomainTag(resumeRequest.GetDomain()))
+
+	err = wh.history.ResumeWorkflowExecution(ctx, &h.ResumeWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		ResumeRequest: resumeRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context,
@@ -2173,6 +2410,10 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return err
 	}
 
+	if err := wh.validateID(cancelRequest.GetIdentity(), errIdentityTooLong, errIdentityInvalid); err != nil {
+		return wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(cancelRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -2415,6 +2656,163 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	return resp, nil
 }
 
+// CountWorkflowExecutions - counts open or closed workflow executions in a domain which match the given filters
+func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context,
+	countRequest *gen.CountWorkflowExecutionsRequest) (resp *gen.CountWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope := wh.metricsClient.Scope(metrics.FrontendCountWorkflowExecutionsScope)
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if countRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if countRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if countRequest.StartTimeFilter == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "StartTimeFilter is required"}, scope)
+	}
+
+	if countRequest.StartTimeFilter.EarliestTime == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "EarliestTime in StartTimeFilter is required"}, scope)
+	}
+
+	if countRequest.StartTimeFilter.LatestTime == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}, scope)
+	}
+
+	filterCount := 0
+	if countRequest.ExecutionFilter != nil {
+		filterCount++
+	}
+	if countRequest.TypeFilter != nil {
+		filterCount++
+	}
+	if countRequest.StatusFilter != nil {
+		if !countRequest.GetClosed() {
+			return nil, wh.error(&gen.BadRequestError{Message: "StatusFilter is only allowed for closed executions"}, scope)
+		}
+		filterCount++
+	}
+
+	if filterCount > 1 {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: "Only one of ExecutionFilter, TypeFilter or StatusFilter is allowed"}, scope)
+	}
+
+	domain := countRequest.GetDomain()
+	if filterCount > 0 && wh.config.DisableListVisibilityByFilter(domain) {
+		return nil, wh.error(errNoPermission, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// add domain tag to scope, so further metrics will have the domain tag
+	scope = scope.Tagged(metrics.DomainTag(domain))
+
+	persistenceResp, err := wh.visibilityMgr.CountWorkflowExecutions(&persistence.CountWorkflowExecutionsRequest{
+		DomainUUID:        domainID,
+		Domain:            domain,
+		EarliestStartTime: countRequest.StartTimeFilter.GetEarliestTime(),
+		LatestStartTime:   countRequest.StartTimeFilter.GetLatestTime(),
+		Closed:            countRequest.GetClosed(),
+		WorkflowTypeName:  countRequest.TypeFilter.GetName(),
+		WorkflowID:        countRequest.ExecutionFilter.GetWorkflowId(),
+		Status:            countRequest.StatusFilter,
+		MaxCount:          int64(wh.config.VisibilityMaxCountScan(domain)),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return &gen.CountWorkflowExecutionsResponse{
+		Count: common.Int64Ptr(persistenceResp.Count),
+	}, nil
+}
+
+// ScanWorkflowExecutions - pages through all open and closed workflow executions in a domain, without ordering
+func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context,
+	scanRequest *gen.ScanWorkflowExecutionsRequest) (resp *gen.ScanWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope := wh.metricsClient.Scope(metrics.FrontendScanWorkflowExecutionsScope)
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if scanRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if scanRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if scanRequest.StartTimeFilter == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "StartTimeFilter is required"}, scope)
+	}
+
+	if scanRequest.StartTimeFilter.EarliestTime == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "EarliestTime in StartTimeFilter is required"}, scope)
+	}
+
+	if scanRequest.StartTimeFilter.LatestTime == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}, scope)
+	}
+
+	if scanRequest.GetMaximumPageSize() <= 0 {
+		scanRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.VisibilityMaxPageSize(scanRequest.GetDomain())))
+	}
+
+	domain := scanRequest.GetDomain()
+	domainID, err := wh.domainCache.GetDomainID(domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// add domain tag to scope, so further metrics will have the domain tag
+	scope = scope.Tagged(metrics.DomainTag(domain))
+
+	persistenceResp, err := wh.visibilityMgr.ScanWorkflowExecutions(&persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:        domainID,
+		Domain:            domain,
+		PageSize:          int(scanRequest.GetMaximumPageSize()),
+		NextPageToken:     scanRequest.NextPageToken,
+		EarliestStartTime: scanRequest.StartTimeFilter.GetEarliestTime(),
+		LatestStartTime:   scanRequest.StartTimeFilter.GetLatestTime(),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp = &gen.ScanWorkflowExecutionsResponse{}
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 func (wh *WorkflowHandler) ResetStickyTaskList(ctx context.Context, resetRequest *gen.ResetStickyTaskListRequest) (resp *gen.ResetStickyTaskListResponse, retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
@@ -2671,6 +3069,7 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 }
 
 func (wh *WorkflowHandler) getHistory(
+	ctx context.Context,
 	scope metrics.Scope,
 	domainID string,
 	execution gen.WorkflowExecution,
@@ -2687,7 +3086,7 @@ func (wh *WorkflowHandler) getHistory(
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
 		shardID := common.WorkflowIDToHistoryShard(*execution.WorkflowId, wh.config.NumHistoryShards)
 		var err error
-		historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(wh.historyV2Mgr, &persistence.ReadHistoryBranchRequest{
+		historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(ctx, wh.historyV2Mgr, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    firstEventID,
 			MaxEventID:    nextEventID,
@@ -2760,6 +3159,24 @@ func (wh *WorkflowHandler) startRequestProfile(scope metrics.Scope) tally.Stopwa
 	return sw
 }
 
+// authorizePoll checks that the caller is allowed to take tasks from the task list
+func (wh *WorkflowHandler) authorizePoll(ctx context.Context, apiName string, domainName string, taskList *gen.TaskList) error {
+	result, err := wh.authorizer.Authorize(ctx, &authorization.Attributes{
+		Token:          yarpc.CallFromContext(ctx).Header(common.AuthorizationTokenHeaderName),
+		APIName:        apiName,
+		DomainName:     domainName,
+		TaskList:       taskList.GetName(),
+		StickyTaskList: taskList.GetKind() == gen.TaskListKindSticky,
+	})
+	if err != nil {
+		return err
+	}
+	if result.Decision != authorization.DecisionAllow {
+		return errPollNotAuthorized
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) error(err error, scope metrics.Scope) error {
 	switch err := err.(type) {
 	case *gen.InternalServiceError:
@@ -2771,6 +3188,9 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope) error {
 	case *gen.BadRequestError:
 		scope.IncCounter(metrics.CadenceErrBadRequestCounter)
 		return err
+	case *common.BlobSizeExceedsLimitError:
+		scope.IncCounter(metrics.CadenceErrBadRequestCounter)
+		return err.BadRequestError()
 	case *gen.DomainNotActiveError:
 		scope.IncCounter(metrics.CadenceErrBadRequestCounter)
 		return err
@@ -2798,6 +3218,9 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope) error {
 	case *gen.ClientVersionNotSupportedError:
 		scope.IncCounter(metrics.CadenceErrClientVersionNotSupportedCounter)
 		return err
+	case *gen.AccessDeniedError:
+		scope.IncCounter(metrics.CadenceErrUnauthorizedCounter)
+		return err
 	case *yarpcerrors.Status:
 		if err.Code() == yarpcerrors.CodeDeadlineExceeded {
 			scope.IncCounter(metrics.CadenceErrContextTimeoutCounter)
@@ -2822,12 +3245,39 @@ func (wh *WorkflowHandler) validateTaskList(t *gen.TaskList, scope metrics.Scope
 	if t == nil || t.Name == nil || t.GetName() == "" {
 		return wh.error(errTaskListNotSet, scope)
 	}
-	if len(t.GetName()) > wh.config.MaxIDLengthLimit() {
-		return wh.error(errTaskListTooLong, scope)
+	if err := wh.validateID(t.GetName(), errTaskListTooLong, errTaskListInvalid); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// validateID checks the length and the characters of an ID set on a request
+func (wh *WorkflowHandler) validateID(id string, errTooLong error, errInvalid error) error {
+	if len(id) > wh.config.MaxIDLengthLimit() {
+		return errTooLong
+	}
+	if !common.IsValidIDString(id) {
+		return errInvalid
 	}
 	return nil
 }
 
+// applyDomainStartRules rewrites an aliased workflow type and fills in the task list of a workflow started without
+// one, using the rules configured in the domain data. This lets operators reroute new workflows during worker migrations.
+func applyDomainStartRules(domainEntry *cache.DomainCacheEntry, workflowType *gen.WorkflowType,
+	taskList *gen.TaskList) (*gen.WorkflowType, *gen.TaskList) {
+	data := domainEntry.GetInfo().Data
+	if alias, ok := common.GetWorkflowTypeAlias(data, workflowType.GetName()); ok {
+		workflowType = &gen.WorkflowType{Name: common.StringPtr(alias)}
+	}
+	if taskList.GetName() == "" {
+		if defaultTaskList, ok := common.GetDefaultTaskList(data); ok {
+			taskList = &gen.TaskList{Name: common.StringPtr(defaultTaskList)}
+		}
+	}
+	return workflowType, taskList
+}
+
 func (wh *WorkflowHandler) validateExecutionAndEmitMetrics(w *gen.WorkflowExecution, scope metrics.Scope) error {
 	err := validateExecution(w)
 	if err != nil {
@@ -2849,6 +3299,18 @@ func validateExecution(w *gen.WorkflowExecution) error {
 	return nil
 }
 
+func validateWorkflowIDReusePolicy(policy *gen.WorkflowIdReusePolicy) error {
+	if policy == nil {
+		return nil
+	}
+	for _, v := range gen.WorkflowIdReusePolicy_Values() {
+		if *policy == v {
+			return nil
+		}
+	}
+	return errInvalidWorkflowIDReusePolicy
+}
+
 func getDomainStatus(info *persistence.DomainInfo) *gen.DomainStatus {
 	switch info.Status {
 	case persistence.DomainStatusRegistered:
@@ -2910,6 +3372,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		}
 		scope = scope.Tagged(metrics.DomainTag(domain.GetInfo().Name))
 		history, persistenceToken, err = wh.getHistory(
+			ctx,
 			scope,
 			domainID,
 			*matchingResp.WorkflowExecution,
@@ -2951,6 +3414,8 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		History:                   history,
 		NextPageToken:             continuation,
 		WorkflowExecutionTaskList: matchingResp.WorkflowExecutionTaskList,
+		WorkflowStateSnapshot:     matchingResp.WorkflowStateSnapshot,
+		ContinueAsNewSuggested:    matchingResp.ContinueAsNewSuggested,
 	}
 
 	return resp, nil
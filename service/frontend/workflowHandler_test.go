@@ -32,11 +32,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/shared"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/blobstore/blob"
 	"github.com/uber/cadence/common/cache"
@@ -47,7 +49,10 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/memory"
+	"github.com/uber/cadence/common/searchattribute"
 	cs "github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	dc "github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 )
@@ -72,6 +77,8 @@ type (
 		mockClientBean      *client.MockClientBean
 		mockService         cs.Service
 		mockBlobstoreClient *mocks.BlobstoreClient
+		mockAuthorizer      *authorization.MockAuthorizer
+		searchAttrs         searchattribute.Registry
 	}
 )
 
@@ -100,6 +107,10 @@ func (s *workflowHandlerSuite) SetupTest() {
 	s.mockClientBean = &client.MockClientBean{}
 	s.mockService = cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean)
 	s.mockBlobstoreClient = &mocks.BlobstoreClient{}
+	s.mockAuthorizer = &authorization.MockAuthorizer{}
+	clusterMetadataMgr, err := memory.NewFactory(config.InMemory{Name: uuid.New()}, cluster.TestCurrentClusterName, s.logger).NewClusterMetadataStore()
+	s.NoError(err)
+	s.searchAttrs = searchattribute.NewRegistry(clusterMetadataMgr, dc.GetDurationPropertyFn(time.Minute), s.logger)
 }
 
 func (s *workflowHandlerSuite) TearDownTest() {
@@ -110,11 +121,12 @@ func (s *workflowHandlerSuite) TearDownTest() {
 	s.mockVisibilityMgr.AssertExpectations(s.T())
 	s.mockClientBean.AssertExpectations(s.T())
 	s.mockBlobstoreClient.AssertExpectations(s.T())
+	s.mockAuthorizer.AssertExpectations(s.T())
 }
 
 func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandler {
 	return NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
-		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient, s.mockAuthorizer, s.searchAttrs)
 }
 
 func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
@@ -215,6 +227,68 @@ func (s *workflowHandlerSuite) TestPollForTask_Failed_ContextTimeoutTooShort() {
 	assert.Equal(s.T(), common.ErrContextTimeoutTooShort, err)
 }
 
+func (s *workflowHandlerSuite) TestPollForTask_Failed_NotAuthorized() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	s.mockAuthorizer.On("Authorize", mock.Anything, &authorization.Attributes{
+		APIName:    "PollForDecisionTask",
+		DomainName: "test-domain",
+		TaskList:   "test-tasklist",
+	}).Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Once()
+	s.mockAuthorizer.On("Authorize", mock.Anything, &authorization.Attributes{
+		APIName:    "PollForActivityTask",
+		DomainName: "test-domain",
+		TaskList:   "test-tasklist",
+	}).Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.MinLongPollTimeout+time.Second)
+	defer cancel()
+
+	_, err := wh.PollForDecisionTask(ctx, &shared.PollForDecisionTaskRequest{
+		Domain:   common.StringPtr("test-domain"),
+		TaskList: &shared.TaskList{Name: common.StringPtr("test-tasklist")},
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errPollNotAuthorized, err)
+
+	_, err = wh.PollForActivityTask(ctx, &shared.PollForActivityTaskRequest{
+		Domain:   common.StringPtr("test-domain"),
+		TaskList: &shared.TaskList{Name: common.StringPtr("test-tasklist")},
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errPollNotAuthorized, err)
+}
+
+func (s *workflowHandlerSuite) TestPollForTask_Failed_ShuttingDown() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	wh.PrepareToStop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.MinLongPollTimeout+time.Second)
+	defer cancel()
+
+	_, err := wh.PollForDecisionTask(ctx, &shared.PollForDecisionTaskRequest{
+		Domain:   common.StringPtr("test-domain"),
+		TaskList: &shared.TaskList{Name: common.StringPtr("test-tasklist")},
+	})
+	assert.Equal(s.T(), errShuttingDown, err)
+
+	_, err = wh.PollForActivityTask(ctx, &shared.PollForActivityTaskRequest{
+		Domain:   common.StringPtr("test-domain"),
+		TaskList: &shared.TaskList{Name: common.StringPtr("test-tasklist")},
+	})
+	assert.Equal(s.T(), errShuttingDown, err)
+
+	status, err := wh.Health(ctx)
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), status.GetOk())
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -246,6 +320,67 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet
 	assert.Equal(s.T(), errRequestIDNotSet, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidWorkflowID() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	testCases := []struct {
+		workflowID string
+		err        error
+	}{
+		{workflowID: "workflow\x00id", err: errWorkflowIDInvalid},
+		{workflowID: "workflow\xffid", err: errWorkflowIDInvalid},
+		{workflowID: common.ReservedIDPrefix + "-workflow-id", err: errWorkflowIDReserved},
+	}
+	for _, tc := range testCases {
+		startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+			Domain:     common.StringPtr("test-domain"),
+			WorkflowId: common.StringPtr(tc.workflowID),
+			WorkflowType: &shared.WorkflowType{
+				Name: common.StringPtr("workflow-type"),
+			},
+			TaskList: &shared.TaskList{
+				Name: common.StringPtr("task-list"),
+			},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			RequestId:                           common.StringPtr(uuid.New()),
+		}
+		_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+		assert.Equal(s.T(), tc.err, err)
+	}
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_IdentityTooLong() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.MaxIDLengthLimit = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("domain"),
+		WorkflowId: common.StringPtr("workflow"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr("request"),
+		Identity:                            common.StringPtr("identity-over-the-limit"),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errIdentityTooLong, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -357,6 +492,10 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_TaskListNotSet(
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := s.getWorkflowHandler(config)
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	mockDomainCache := &cache.DomainCacheMock{}
+	mockDomainCache.On("GetDomain", "test-domain").Return(
+		cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{ID: "test-domain-id", Name: "test-domain"}, &persistence.DomainConfig{}), nil)
+	wh.domainCache = mockDomainCache
 	wh.startWG.Done()
 
 	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
@@ -384,6 +523,48 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_TaskListNotSet(
 	assert.Equal(s.T(), errTaskListNotSet, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_DomainStartRules() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	mockDomainCache := &cache.DomainCacheMock{}
+	mockDomainCache.On("GetDomain", "test-domain").Return(
+		cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{
+			ID:   "test-domain-id",
+			Name: "test-domain",
+			Data: map[string]string{
+				common.DomainDataKeyForDefaultTaskList:                           "default-task-list",
+				common.DomainDataKeyPrefixForWorkflowTypeAlias + "workflow-type": "workflow-type-v2",
+			},
+		}, &persistence.DomainConfig{}), nil)
+	wh.domainCache = mockDomainCache
+	mockHistoryClient := &mocks.HistoryClient{}
+	wh.history = mockHistoryClient
+	wh.startWG.Done()
+
+	mockHistoryClient.On("StartWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *h.StartWorkflowExecutionRequest) bool {
+		return request.GetDomainUUID() == "test-domain-id" &&
+			request.StartRequest.TaskList.GetName() == "default-task-list" &&
+			request.StartRequest.WorkflowType.GetName() == "workflow-type-v2"
+	})).Return(&shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("run-id")}, nil).Once()
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	resp, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.NoError(err)
+	s.Equal("run-id", resp.GetRunId())
+	mockHistoryClient.AssertExpectations(s.T())
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidExecutionStartToCloseTimeout() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -448,10 +629,77 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	assert.Equal(s.T(), errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidWorkflowIDReusePolicy() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	invalidPolicy := shared.WorkflowIdReusePolicy(100)
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		WorkflowIdReusePolicy:               &invalidPolicy,
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errInvalidWorkflowIDReusePolicy, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidSearchAttributes() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	err := s.searchAttrs.AddSearchAttributes(map[string]shared.IndexedValueType{
+		"CustomIntField": shared.IndexedValueTypeInt,
+	})
+	assert.NoError(s.T(), err)
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+		SearchAttributes: &shared.SearchAttributes{
+			IndexedFields: map[string][]byte{
+				"CustomIntField": []byte(`"not an int"`),
+			},
+		},
+	}
+	_, err = wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.IsType(s.T(), &shared.BadRequestError{}, err)
+
+	startWorkflowExecutionRequest.SearchAttributes.IndexedFields = map[string][]byte{
+		"UnknownField": []byte(`1`),
+	}
+	_, err = wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Equal(s.T(), &shared.BadRequestError{Message: "Search attribute UnknownField is not registered."}, err)
+}
+
 func (s *workflowHandlerSuite) getWorkflowHandlerWithParams(mService cs.Service, config *Config,
 	mMetadataManager persistence.MetadataManager, blobStore blobstore.Client) *WorkflowHandler {
 	return NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, blobStore)
+		s.mockVisibilityMgr, s.mockProducer, blobStore, s.mockAuthorizer, s.searchAttrs)
 }
 
 func (s *workflowHandlerSuite) TestRegisterDomain_Failure_BucketNotExists() {
@@ -924,6 +1172,48 @@ func (s *workflowHandlerSuite) TestHistoryArchived() {
 	s.False(wh.historyArchived(context.Background(), getHistoryRequest, "test-domain"))
 }
 
+func (s *workflowHandlerSuite) TestGetHistory_CloseEventOnly_NoLongPoll() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	clusterMetadata := &mocks.ClusterMetadata{}
+	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	clusterMetadata.On("ArchivalConfig").Return(cluster.NewArchivalConfig(cluster.ArchivalDisabled, "", false))
+	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean)
+	wh := s.getWorkflowHandlerWithParams(mService, config, s.mockMetadataMgr, s.mockBlobstoreClient)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	mockDomainCache := &cache.DomainCacheMock{}
+	mockDomainCache.On("GetDomainID", "test-domain").Return("test-domain-id", nil)
+	wh.domainCache = mockDomainCache
+	mockHistoryClient := &mocks.HistoryClient{}
+	wh.history = mockHistoryClient
+	wh.startWG.Done()
+
+	// a caller that does not long poll must not be blocked until the workflow closes
+	mockHistoryClient.On("GetMutableState", mock.Anything, mock.MatchedBy(func(request *h.GetMutableStateRequest) bool {
+		return request.GetExpectedNextEventId() == common.FirstEventID
+	})).Return(&h.GetMutableStateResponse{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("test-workflow-id"),
+			RunId:      common.StringPtr("test-run-id"),
+		},
+		NextEventId:       common.Int64Ptr(5),
+		LastFirstEventId:  common.Int64Ptr(3),
+		IsWorkflowRunning: common.BoolPtr(true),
+	}, nil).Once()
+
+	resp, err := wh.GetWorkflowExecutionHistory(context.Background(), &shared.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr("test-domain"),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("test-workflow-id"),
+		},
+		HistoryEventFilterType: shared.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	s.NoError(err)
+	s.Empty(resp.History.Events)
+	s.Nil(resp.NextPageToken)
+	mockHistoryClient.AssertExpectations(s.T())
+}
+
 func (s *workflowHandlerSuite) TestGetArchivedHistory_Failure_DomainCacheEntryError() {
 	config := s.newConfig()
 	mMetadataManager := &mocks.MetadataManager{}
@@ -1088,7 +1378,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 		NextPageToken: []byte{},
 		ShardID:       common.IntPtr(shardID),
 	}
-	s.mockHistoryV2Mgr.On("ReadHistoryBranch", req).Return(&persistence.ReadHistoryBranchResponse{
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, req).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents: []*workflow.HistoryEvent{
 			{
 				EventId: common.Int64Ptr(int64(1)),
@@ -1105,7 +1395,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, mBlobstore)
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	scope := wh.metricsClient.Scope(0)
-	history, token, err := wh.getHistory(scope, domainID, we, firstEventID, nextEventID, 0, []byte{}, nil, persistence.EventStoreVersionV2, []byte{})
+	history, token, err := wh.getHistory(context.Background(), scope, domainID, we, firstEventID, nextEventID, 0, []byte{}, nil, persistence.EventStoreVersionV2, []byte{})
 	s.NotNil(history)
 	s.Equal([]byte{}, token)
 	s.NoError(err)
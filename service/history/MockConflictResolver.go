@@ -21,6 +21,8 @@
 package history
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -33,12 +35,12 @@ type mockConflictResolver struct {
 var _ conflictResolver = (*mockConflictResolver)(nil)
 
 // reset is mock implementation for reset of conflictResolver
-func (_m *mockConflictResolver) reset(prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo) (mutableState, error) {
-	ret := _m.Called(prevRunID, requestID, replayEventID, info)
+func (_m *mockConflictResolver) reset(ctx context.Context, prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo) (mutableState, error) {
+	ret := _m.Called(ctx, prevRunID, requestID, replayEventID, info)
 
 	var r0 mutableState
-	if rf, ok := ret.Get(0).(func(string, string, int64, *persistence.WorkflowExecutionInfo) mutableState); ok {
-		r0 = rf(prevRunID, requestID, replayEventID, info)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, *persistence.WorkflowExecutionInfo) mutableState); ok {
+		r0 = rf(ctx, prevRunID, requestID, replayEventID, info)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(mutableState)
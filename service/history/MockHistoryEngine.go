@@ -386,6 +386,48 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(ctx context.Context, req
 	return r0
 }
 
+// PauseWorkflowExecution is mock implementation for PauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) PauseWorkflowExecution(ctx context.Context, request *gohistory.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution is mock implementation for ResumeWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResumeWorkflowExecution(ctx context.Context, request *gohistory.ResumeWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddWorkflowExecutionAnnotation is mock implementation for AddWorkflowExecutionAnnotation of HistoryEngine
+func (_m *MockHistoryEngine) AddWorkflowExecutionAnnotation(ctx context.Context, request *gohistory.AddWorkflowExecutionAnnotationRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.AddWorkflowExecutionAnnotationRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetWorkflowExecution is mock implementation for TerminateWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) ResetWorkflowExecution(ctx context.Context, request *gohistory.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
 	ret := _m.Called(request)
@@ -493,4 +535,154 @@ func (_m *MockHistoryEngine) SyncActivity(ctx context.Context, request *gohistor
 	return r0
 }
 
+// ReadTransferDLQ is mock implementation for ReadTransferDLQ of HistoryEngine
+func (_m *MockHistoryEngine) ReadTransferDLQ(ctx context.Context, request *shared.ReadTransferDLQRequest) (*shared.ReadTransferDLQResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ReadTransferDLQResponse
+	if rf, ok := ret.Get(0).(func(*shared.ReadTransferDLQRequest) *shared.ReadTransferDLQResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ReadTransferDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ReadTransferDLQRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeTransferDLQ is mock implementation for MergeTransferDLQ of HistoryEngine
+func (_m *MockHistoryEngine) MergeTransferDLQ(ctx context.Context, request *shared.MergeTransferDLQRequest) (*shared.MergeTransferDLQResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.MergeTransferDLQResponse
+	if rf, ok := ret.Get(0).(func(*shared.MergeTransferDLQRequest) *shared.MergeTransferDLQResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.MergeTransferDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.MergeTransferDLQRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeTransferDLQ is mock implementation for PurgeTransferDLQ of HistoryEngine
+func (_m *MockHistoryEngine) PurgeTransferDLQ(ctx context.Context, request *shared.PurgeTransferDLQRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.PurgeTransferDLQRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReadReplicationDLQ is mock implementation for ReadReplicationDLQ of HistoryEngine
+func (_m *MockHistoryEngine) ReadReplicationDLQ(ctx context.Context, request *shared.ReadReplicationDLQRequest) (*shared.ReadReplicationDLQResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ReadReplicationDLQResponse
+	if rf, ok := ret.Get(0).(func(*shared.ReadReplicationDLQRequest) *shared.ReadReplicationDLQResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ReadReplicationDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ReadReplicationDLQRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeReplicationDLQ is mock implementation for MergeReplicationDLQ of HistoryEngine
+func (_m *MockHistoryEngine) MergeReplicationDLQ(ctx context.Context, request *shared.MergeReplicationDLQRequest) (*shared.MergeReplicationDLQResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.MergeReplicationDLQResponse
+	if rf, ok := ret.Get(0).(func(*shared.MergeReplicationDLQRequest) *shared.MergeReplicationDLQResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.MergeReplicationDLQResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.MergeReplicationDLQRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeReplicationDLQ is mock implementation for PurgeReplicationDLQ of HistoryEngine
+func (_m *MockHistoryEngine) PurgeReplicationDLQ(ctx context.Context, request *shared.PurgeReplicationDLQRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.PurgeReplicationDLQRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveTask is mock implementation for RemoveTask of HistoryEngine
+func (_m *MockHistoryEngine) RemoveTask(ctx context.Context, request *shared.RemoveTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.RemoveTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeShard is mock implementation for DescribeShard of HistoryEngine
+func (_m *MockHistoryEngine) DescribeShard() *shared.HistoryShardStatus {
+	ret := _m.Called()
+
+	var r0 *shared.HistoryShardStatus
+	if rf, ok := ret.Get(0).(func() *shared.HistoryShardStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryShardStatus)
+		}
+	}
+
+	return r0
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
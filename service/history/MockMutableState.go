@@ -811,6 +811,38 @@ func (_m *mockMutableState) AddWorkflowExecutionStartedEvent(_a0 shared.Workflow
 	return r0
 }
 
+// AddWorkflowExecutionPausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) AddWorkflowExecutionPausedEvent(_a0 *shared.PauseWorkflowExecutionRequest) *shared.HistoryEvent {
+	ret := _m.Called(_a0)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(*shared.PauseWorkflowExecutionRequest) *shared.HistoryEvent); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	return r0
+}
+
+// AddWorkflowExecutionResumedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) AddWorkflowExecutionResumedEvent(_a0 *shared.ResumeWorkflowExecutionRequest) *shared.HistoryEvent {
+	ret := _m.Called(_a0)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(*shared.ResumeWorkflowExecutionRequest) *shared.HistoryEvent); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	return r0
+}
+
 // AddWorkflowExecutionTerminatedEvent provides a mock function with given fields: _a0
 func (_m *mockMutableState) AddWorkflowExecutionTerminatedEvent(_a0 *shared.TerminateWorkflowExecutionRequest) *shared.HistoryEvent {
 	ret := _m.Called(_a0)
@@ -1776,6 +1808,20 @@ func (_m *mockMutableState) IsStickyTaskListEnabled() bool {
 	return r0
 }
 
+// IsWorkflowExecutionPaused provides a mock function with given fields:
+func (_m *mockMutableState) IsWorkflowExecutionPaused() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsWorkflowExecutionRunning provides a mock function with given fields:
 func (_m *mockMutableState) IsWorkflowExecutionRunning() bool {
 	ret := _m.Called()
@@ -1940,13 +1986,13 @@ func (_m *mockMutableState) ReplicateDecisionTaskFailedEvent() {
 	_m.Called()
 }
 
-// ReplicateDecisionTaskScheduledEvent provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
-func (_m *mockMutableState) ReplicateDecisionTaskScheduledEvent(_a0 int64, _a1 int64, _a2 string, _a3 int32, _a4 int64) *decisionInfo {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+// ReplicateDecisionTaskScheduledEvent provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *mockMutableState) ReplicateDecisionTaskScheduledEvent(_a0 int64, _a1 int64, _a2 string, _a3 int32, _a4 int64, _a5 int64) *decisionInfo {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 *decisionInfo
-	if rf, ok := ret.Get(0).(func(int64, int64, string, int32, int64) *decisionInfo); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(0).(func(int64, int64, string, int32, int64, int64) *decisionInfo); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*decisionInfo)
@@ -2135,6 +2181,16 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionStartedEvent(_a0 string, _
 	_m.Called(_a0, _a1, _a2, _a3, _a4)
 }
 
+// ReplicateWorkflowExecutionPausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionPausedEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
+// ReplicateWorkflowExecutionResumedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionResumedEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
 // ReplicateWorkflowExecutionTerminatedEvent provides a mock function with given fields: _a0, _a1
 func (_m *mockMutableState) ReplicateWorkflowExecutionTerminatedEvent(_a0 int64, _a1 *shared.HistoryEvent) {
 	_m.Called(_a0, _a1)
@@ -38,19 +38,19 @@ type mockWorkflowExecutionContext struct {
 
 var _ workflowExecutionContext = (*mockWorkflowExecutionContext)(nil)
 
-func (_m *mockWorkflowExecutionContext) appendHistoryEvents(_a0 []*workflow.HistoryEvent, _a1 int64, _a2 bool) (int, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+func (_m *mockWorkflowExecutionContext) appendHistoryEvents(ctx context.Context, _a0 []*workflow.HistoryEvent, _a1 int64, _a2 bool) (int, error) {
+	ret := _m.Called(ctx, _a0, _a1, _a2)
 
 	var r0 int
-	if rf, ok := ret.Get(0).(func([]*workflow.HistoryEvent, int64, bool) int); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, []*workflow.HistoryEvent, int64, bool) int); ok {
+		r0 = rf(ctx, _a0, _a1, _a2)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*workflow.HistoryEvent, int64, bool) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, []*workflow.HistoryEvent, int64, bool) error); ok {
+		r1 = rf(ctx, _a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,12 +62,12 @@ func (_m *mockWorkflowExecutionContext) clear() {
 	_m.Called()
 }
 
-func (_m *mockWorkflowExecutionContext) continueAsNewWorkflowExecution(_a0 []byte, _a1 mutableState, _a2 []persistence.Task, _a3 []persistence.Task, _a4 int64) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+func (_m *mockWorkflowExecutionContext) continueAsNewWorkflowExecution(ctx context.Context, _a0 []byte, _a1 mutableState, _a2 []persistence.Task, _a3 []persistence.Task, _a4 int64) error {
+	ret := _m.Called(ctx, _a0, _a1, _a2, _a3, _a4)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, mutableState, []persistence.Task, []persistence.Task, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, mutableState, []persistence.Task, []persistence.Task, int64) error); ok {
+		r0 = rf(ctx, _a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -120,12 +120,12 @@ func (_m *mockWorkflowExecutionContext) getLogger() log.Logger {
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) loadWorkflowExecution() (mutableState, error) {
-	ret := _m.Called()
+func (_m *mockWorkflowExecutionContext) loadWorkflowExecution(ctx context.Context) (mutableState, error) {
+	ret := _m.Called(ctx)
 
 	var r0 mutableState
-	if rf, ok := ret.Get(0).(func() mutableState); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) mutableState); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(mutableState)
@@ -133,8 +133,8 @@ func (_m *mockWorkflowExecutionContext) loadWorkflowExecution() (mutableState, e
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -155,12 +155,12 @@ func (_m *mockWorkflowExecutionContext) lock(_a0 context.Context) error {
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) appendFirstBatchHistoryForContinueAsNew(_a0 mutableState, _a1 int64) error {
-	ret := _m.Called(_a0, _a1)
+func (_m *mockWorkflowExecutionContext) appendFirstBatchHistoryForContinueAsNew(ctx context.Context, _a0 mutableState, _a1 int64) error {
+	ret := _m.Called(ctx, _a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(mutableState, int64) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, mutableState, int64) error); ok {
+		r0 = rf(ctx, _a0, _a1)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -168,12 +168,12 @@ func (_m *mockWorkflowExecutionContext) appendFirstBatchHistoryForContinueAsNew(
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) replicateWorkflowExecution(_a0 *h.ReplicateEventsRequest, _a1 []persistence.Task, _a2 []persistence.Task, _a3 int64, _a4 int64, _a5 time.Time) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+func (_m *mockWorkflowExecutionContext) replicateWorkflowExecution(ctx context.Context, _a0 *h.ReplicateEventsRequest, _a1 []persistence.Task, _a2 []persistence.Task, _a3 int64, _a4 int64, _a5 time.Time) error {
+	ret := _m.Called(ctx, _a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*h.ReplicateEventsRequest, []persistence.Task, []persistence.Task, int64, int64, time.Time) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	if rf, ok := ret.Get(0).(func(context.Context, *h.ReplicateEventsRequest, []persistence.Task, []persistence.Task, int64, int64, time.Time) error); ok {
+		r0 = rf(ctx, _a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -181,12 +181,12 @@ func (_m *mockWorkflowExecutionContext) replicateWorkflowExecution(_a0 *h.Replic
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) resetMutableState(_a0 string, _a1 mutableState) (mutableState, error) {
-	ret := _m.Called(_a0, _a1)
+func (_m *mockWorkflowExecutionContext) resetMutableState(ctx context.Context, _a0 string, _a1 mutableState) (mutableState, error) {
+	ret := _m.Called(ctx, _a0, _a1)
 
 	var r0 mutableState
-	if rf, ok := ret.Get(0).(func(string, mutableState) mutableState); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, mutableState) mutableState); ok {
+		r0 = rf(ctx, _a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(mutableState)
@@ -194,8 +194,8 @@ func (_m *mockWorkflowExecutionContext) resetMutableState(_a0 string, _a1 mutabl
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, mutableState) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, mutableState) error); ok {
+		r1 = rf(ctx, _a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -203,11 +203,11 @@ func (_m *mockWorkflowExecutionContext) resetMutableState(_a0 string, _a1 mutabl
 	return r0, r1
 }
 
-func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(_a0 mutableState, _a1 bool, _a2, _a3 persistence.Task, _a4 mutableState, _a5, _a6, _a7, _a7_0 []persistence.Task, _a8 string, _a9, _a10 int64) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a7_0, _a8, _a9, _a10)
+func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(ctx context.Context, _a0 mutableState, _a1 bool, _a2, _a3 persistence.Task, _a4 mutableState, _a5, _a6, _a7, _a7_0 []persistence.Task, _a8 string, _a9, _a10 int64) error {
+	ret := _m.Called(ctx, _a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a7_0, _a8, _a9, _a10)
 	var r0 error
-	if rf, ok := ret.Get(1).(func(mutableState, bool, persistence.Task, persistence.Task, mutableState, []persistence.Task, []persistence.Task, []persistence.Task, []persistence.Task, string, int64, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a7_0, _a8, _a9, _a10)
+	if rf, ok := ret.Get(1).(func(context.Context, mutableState, bool, persistence.Task, persistence.Task, mutableState, []persistence.Task, []persistence.Task, []persistence.Task, []persistence.Task, string, int64, int64) error); ok {
+		r0 = rf(ctx, _a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a7_0, _a8, _a9, _a10)
 	} else {
 		r0 = ret.Error(1)
 	}
@@ -215,12 +215,12 @@ func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(_a0 mutableState,
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) scheduleNewDecision(_a0 []persistence.Task, _a1 []persistence.Task) ([]persistence.Task, []persistence.Task, error) {
-	ret := _m.Called(_a0, _a1)
+func (_m *mockWorkflowExecutionContext) scheduleNewDecision(ctx context.Context, _a0 []persistence.Task, _a1 []persistence.Task) ([]persistence.Task, []persistence.Task, error) {
+	ret := _m.Called(ctx, _a0, _a1)
 
 	var r0 []persistence.Task
-	if rf, ok := ret.Get(0).(func([]persistence.Task, []persistence.Task) []persistence.Task); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, []persistence.Task, []persistence.Task) []persistence.Task); ok {
+		r0 = rf(ctx, _a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]persistence.Task)
@@ -228,8 +228,8 @@ func (_m *mockWorkflowExecutionContext) scheduleNewDecision(_a0 []persistence.Ta
 	}
 
 	var r1 []persistence.Task
-	if rf, ok := ret.Get(1).(func([]persistence.Task, []persistence.Task) []persistence.Task); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, []persistence.Task, []persistence.Task) []persistence.Task); ok {
+		r1 = rf(ctx, _a0, _a1)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]persistence.Task)
@@ -237,8 +237,8 @@ func (_m *mockWorkflowExecutionContext) scheduleNewDecision(_a0 []persistence.Ta
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func([]persistence.Task, []persistence.Task) error); ok {
-		r2 = rf(_a0, _a1)
+	if rf, ok := ret.Get(2).(func(context.Context, []persistence.Task, []persistence.Task) error); ok {
+		r2 = rf(ctx, _a0, _a1)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -250,12 +250,12 @@ func (_m *mockWorkflowExecutionContext) unlock() {
 	_m.Called()
 }
 
-func (_m *mockWorkflowExecutionContext) updateHelper(_a0 []persistence.Task, _a1 []persistence.Task, _a2 int64, _a3 time.Time, _a4 bool, _a5 *historyBuilder, _a6 string) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+func (_m *mockWorkflowExecutionContext) updateHelper(ctx context.Context, _a0 []persistence.Task, _a1 []persistence.Task, _a2 int64, _a3 time.Time, _a4 bool, _a5 *historyBuilder, _a6 string) error {
+	ret := _m.Called(ctx, _a0, _a1, _a2, _a3, _a4, _a5, _a6)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]persistence.Task, []persistence.Task, int64, time.Time, bool, *historyBuilder, string) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	if rf, ok := ret.Get(0).(func(context.Context, []persistence.Task, []persistence.Task, int64, time.Time, bool, *historyBuilder, string) error); ok {
+		r0 = rf(ctx, _a0, _a1, _a2, _a3, _a4, _a5, _a6)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -263,12 +263,12 @@ func (_m *mockWorkflowExecutionContext) updateHelper(_a0 []persistence.Task, _a1
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) updateWorkflowExecution(_a0 []persistence.Task, _a1 []persistence.Task, _a2 int64) error {
-	ret := _m.Called(_a0, _a1, _a2)
+func (_m *mockWorkflowExecutionContext) updateWorkflowExecution(ctx context.Context, _a0 []persistence.Task, _a1 []persistence.Task, _a2 int64) error {
+	ret := _m.Called(ctx, _a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]persistence.Task, []persistence.Task, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, []persistence.Task, []persistence.Task, int64) error); ok {
+		r0 = rf(ctx, _a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -276,12 +276,12 @@ func (_m *mockWorkflowExecutionContext) updateWorkflowExecution(_a0 []persistenc
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithContext(_a0 []byte, _a1 []persistence.Task, _a2 []persistence.Task, _a3 int64) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithContext(ctx context.Context, _a0 []byte, _a1 []persistence.Task, _a2 []persistence.Task, _a3 int64) error {
+	ret := _m.Called(ctx, _a0, _a1, _a2, _a3)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, []persistence.Task, []persistence.Task, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, []persistence.Task, []persistence.Task, int64) error); ok {
+		r0 = rf(ctx, _a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -289,12 +289,12 @@ func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithContext(_a0 [
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithDeleteTask(_a0 []persistence.Task, _a1 []persistence.Task, _a2 persistence.Task, _a3 int64) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithDeleteTask(ctx context.Context, _a0 []persistence.Task, _a1 []persistence.Task, _a2 persistence.Task, _a3 int64) error {
+	ret := _m.Called(ctx, _a0, _a1, _a2, _a3)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]persistence.Task, []persistence.Task, persistence.Task, int64) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, []persistence.Task, []persistence.Task, persistence.Task, int64) error); ok {
+		r0 = rf(ctx, _a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -21,6 +21,8 @@
 package history
 
 import (
+	"context"
+
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
@@ -31,7 +33,7 @@ import (
 
 type (
 	conflictResolver interface {
-		reset(prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo) (mutableState, error)
+		reset(ctx context.Context, prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo) (mutableState, error)
 	}
 
 	conflictResolverImpl struct {
@@ -57,7 +59,7 @@ func newConflictResolver(shard ShardContext, context workflowExecutionContext, h
 	}
 }
 
-func (r *conflictResolverImpl) reset(prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo) (mutableState, error) {
+func (r *conflictResolverImpl) reset(ctx context.Context, prevRunID string, requestID string, replayEventID int64, info *persistence.WorkflowExecutionInfo) (mutableState, error) {
 	domainID := r.context.getDomainID()
 	execution := *r.context.getExecution()
 	startTime := info.StartTimestamp
@@ -76,7 +78,7 @@ func (r *conflictResolverImpl) reset(prevRunID string, requestID string, replayE
 
 	eventsToApply := replayNextEventID - common.FirstEventID
 	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
-		history, size, lastFirstEventID, nextPageToken, err = r.getHistory(domainID, execution, common.FirstEventID, replayNextEventID, nextPageToken, eventStoreVersion, branchToken)
+		history, size, lastFirstEventID, nextPageToken, err = r.getHistory(ctx, domainID, execution, common.FirstEventID, replayNextEventID, nextPageToken, eventStoreVersion, branchToken)
 		if err != nil {
 			r.logError("Conflict resolution err getting history.", err)
 			return nil, err
@@ -134,18 +136,18 @@ func (r *conflictResolverImpl) reset(prevRunID string, requestID string, replayE
 	resetMutableStateBuilder.UpdateReplicationStateLastEventID(sourceCluster, lastEvent.GetVersion(), replayEventID)
 
 	r.logger.Info("All events applied for execution.", tag.WorkflowResetNextEventID(resetMutableStateBuilder.GetNextEventID()))
-	msBuilder, err := r.context.resetMutableState(prevRunID, resetMutableStateBuilder)
+	msBuilder, err := r.context.resetMutableState(ctx, prevRunID, resetMutableStateBuilder)
 	if err != nil {
 		r.logError("Conflict resolution err reset workflow.", err)
 	}
 	return msBuilder, err
 }
 
-func (r *conflictResolverImpl) getHistory(domainID string, execution shared.WorkflowExecution, firstEventID,
+func (r *conflictResolverImpl) getHistory(ctx context.Context, domainID string, execution shared.WorkflowExecution, firstEventID,
 	nextEventID int64, nextPageToken []byte, eventStoreVersion int32, branchToken []byte) ([]*shared.HistoryEvent, int, int64, []byte, error) {
 
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
-		response, err := r.historyV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		response, err := r.historyV2Mgr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    firstEventID,
 			MaxEventID:    nextEventID,
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -167,7 +168,7 @@ func (s *conflictResolverSuite) TestGetHistory() {
 		NextPageToken:    pageToken,
 		LastFirstEventID: event1.GetEventId(),
 	}, nil)
-	history, _, firstEventID, token, err := s.conflictResolver.getHistory(domainID, execution, common.FirstEventID, nextEventID, nil, 0, nil)
+	history, _, firstEventID, token, err := s.conflictResolver.getHistory(context.Background(), domainID, execution, common.FirstEventID, nextEventID, nil, 0, nil)
 	s.Nil(err)
 	s.Equal(history, []*shared.HistoryEvent{event1, event2})
 	s.Equal(pageToken, token)
@@ -185,7 +186,7 @@ func (s *conflictResolverSuite) TestGetHistory() {
 		NextPageToken:    nil,
 		LastFirstEventID: event4.GetEventId(),
 	}, nil)
-	history, _, firstEventID, token, err = s.conflictResolver.getHistory(domainID, execution, common.FirstEventID, nextEventID, token, 0, nil)
+	history, _, firstEventID, token, err = s.conflictResolver.getHistory(context.Background(), domainID, execution, common.FirstEventID, nextEventID, token, 0, nil)
 	s.Nil(err)
 	s.Equal(history, []*shared.HistoryEvent{event3, event4, event5})
 	s.Empty(token)
@@ -261,7 +262,7 @@ func (s *conflictResolverSuite) TestReset() {
 	// this is only a shallow test, meaning
 	// the mutable state only has the minimal information
 	// so we can test the conflict resolver
-	s.mockExecutionMgr.On("ResetMutableState", &persistence.ResetMutableStateRequest{
+	s.mockExecutionMgr.On("ResetMutableState", mock.Anything, &persistence.ResetMutableStateRequest{
 		PrevRunID:     prevRunID,
 		ExecutionInfo: executionInfo,
 		ReplicationState: &persistence.ReplicationState{
@@ -286,7 +287,7 @@ func (s *conflictResolverSuite) TestReset() {
 		InsertSignalRequestedIDs:  []string{},
 		Encoding:                  common.EncodingType(s.mockShard.GetConfig().EventEncodingType(domainID)),
 	}).Return(nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, &persistence.GetWorkflowExecutionRequest{
 		DomainID:  domainID,
 		Execution: execution,
 	}).Return(&persistence.GetWorkflowExecutionResponse{}, nil).Once() // return empty resoonse since we are not testing the load
@@ -294,6 +295,6 @@ func (s *conflictResolverSuite) TestReset() {
 	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{}, nil), nil)
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
-	_, err := s.conflictResolver.reset(prevRunID, createRequestID, nextEventID-1, executionInfo)
+	_, err := s.conflictResolver.reset(context.Background(), prevRunID, createRequestID, nextEventID-1, executionInfo)
 	s.Nil(err)
 }
@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+// causes a decision task took too long to be dispatched after being scheduled
+const (
+	// the task was dispatched from a task list backlog, or a poller was already waiting when it was added to matching,
+	// the delay was spent behind the backlogged tasks
+	decisionScheduleLatencyCauseMatchingBacklog = "matching_backlog"
+	// no poller was waiting when the task was added to matching, the task had to wait for one
+	decisionScheduleLatencyCauseNoPollers = "no_pollers"
+	// the worker owning the sticky task list did not pick the task up before its schedule to start timeout
+	decisionScheduleLatencyCauseStickyTimeout = "sticky_timeout"
+)
+
+// getDecisionScheduleLatencyCause classifies the dispatch delay of a decision task from the timestamps matching
+// reports when recording it started
+func getDecisionScheduleLatencyCause(request *h.RecordDecisionTaskStartedRequest) string {
+	// a backlogged task waits behind the tasks ahead of it even when pollers are waiting
+	if request.GetBacklogCountHint() > 0 {
+		return decisionScheduleLatencyCauseMatchingBacklog
+	}
+	if request.IsSetTaskCreatedTimestamp() && request.GetPollStartedTimestamp() <= request.GetTaskCreatedTimestamp() {
+		return decisionScheduleLatencyCauseMatchingBacklog
+	}
+	return decisionScheduleLatencyCauseNoPollers
+}
+
+// reportDecisionScheduleLatency records how long a decision task waited to be dispatched and, if this exceeds the
+// threshold of the domain, reports it as a breach broken down by cause
+func (e *historyEngineImpl) reportDecisionScheduleLatency(scope int, domainName string,
+	execution workflow.WorkflowExecution, scheduleID int64, latency time.Duration, cause string) {
+
+	// timestamps reported by matching may come from a host whose clock is behind
+	if latency < 0 {
+		latency = 0
+	}
+
+	metricsScope := e.metricsClient.Scope(scope, metrics.DomainTag(domainName))
+	metricsScope.RecordTimer(metrics.DecisionScheduleToStartLatency, latency)
+
+	threshold := e.config.DecisionScheduleLatencyBreachThreshold(domainName)
+	if threshold <= 0 || latency < threshold {
+		return
+	}
+
+	metricsScope.Tagged(metrics.CauseTag(cause)).IncCounter(metrics.DecisionScheduleLatencyBreachCounter)
+	if e.config.EnableDecisionScheduleLatencyBreachLog(domainName) {
+		e.logger.Warn("Decision task schedule to start latency exceeded threshold.",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.WorkflowScheduleID(scheduleID),
+			tag.WorkflowDecisionScheduleLatencyCause(cause),
+			tag.Latency(latency))
+	}
+}
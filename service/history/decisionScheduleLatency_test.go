@@ -0,0 +1,48 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/common"
+)
+
+func Test_GetDecisionScheduleLatencyCause(t *testing.T) {
+	a := assert.New(t)
+
+	// poller was waiting before the task was created
+	request := &h.RecordDecisionTaskStartedRequest{
+		TaskCreatedTimestamp: common.Int64Ptr(200),
+		PollStartedTimestamp: common.Int64Ptr(100),
+	}
+	a.Equal(decisionScheduleLatencyCauseMatchingBacklog, getDecisionScheduleLatencyCause(request))
+
+	// poller arrived after the task was created
+	request.PollStartedTimestamp = common.Int64Ptr(300)
+	a.Equal(decisionScheduleLatencyCauseNoPollers, getDecisionScheduleLatencyCause(request))
+
+	// the task was dispatched from the backlog, even though the poller arrived after it was created
+	request.BacklogCountHint = common.Int64Ptr(1)
+	a.Equal(decisionScheduleLatencyCauseMatchingBacklog, getDecisionScheduleLatencyCause(request))
+}
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
@@ -113,7 +114,8 @@ func (e *eventsCacheImpl) getEvent(domainID, workflowID, runID string, firstEven
 	}
 
 	e.metricsClient.IncCounter(metrics.EventsCacheGetEventScope, metrics.CacheMissCounter)
-	event, err := e.getHistoryEventFromStore(domainID, workflowID, runID, firstEventID, eventID, eventStoreVersion, branchToken)
+	// the events cache is read through the mutable state, which does not carry the request context
+	event, err := e.getHistoryEventFromStore(context.Background(), domainID, workflowID, runID, firstEventID, eventID, eventStoreVersion, branchToken)
 	if err != nil {
 		e.metricsClient.IncCounter(metrics.EventsCacheGetEventScope, metrics.CacheFailures)
 		e.logger.Error("EventsCache unable to retrieve event from store",
@@ -147,7 +149,7 @@ func (e *eventsCacheImpl) deleteEvent(domainID, workflowID, runID string, eventI
 	e.Delete(key)
 }
 
-func (e *eventsCacheImpl) getHistoryEventFromStore(domainID, workflowID, runID string, firstEventID, eventID int64,
+func (e *eventsCacheImpl) getHistoryEventFromStore(ctx context.Context, domainID, workflowID, runID string, firstEventID, eventID int64,
 	eventStoreVersion int32, branchToken []byte) (*shared.HistoryEvent, error) {
 	e.metricsClient.IncCounter(metrics.EventsCacheGetFromStoreScope, metrics.CacheRequests)
 	sw := e.metricsClient.StartTimer(metrics.EventsCacheGetFromStoreScope, metrics.CacheLatency)
@@ -155,7 +157,7 @@ func (e *eventsCacheImpl) getHistoryEventFromStore(domainID, workflowID, runID s
 
 	var historyEvents []*shared.HistoryEvent
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
-		response, err := e.eventsV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		response, err := e.eventsV2Mgr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    firstEventID,
 			MaxEventID:    eventID + 1,
@@ -23,6 +23,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/pborman/uuid"
@@ -36,6 +37,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
@@ -71,6 +73,7 @@ type (
 		historyEventNotifier  historyEventNotifier
 		publisher             messaging.Producer
 		rateLimiter           tokenbucket.TokenBucket
+		hostLevelCache        cache.Cache
 		service.Service
 	}
 )
@@ -87,6 +90,9 @@ var (
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
+	errAnnotationNotSet        = &gen.BadRequestError{Message: "Annotation not set on request."}
+	errTaskIDNotSet            = &gen.BadRequestError{Message: "Task ID not set on request."}
+	errInvalidTaskType         = &gen.BadRequestError{Message: "Invalid task type, only transfer and timer tasks can be removed."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
 )
 
@@ -104,7 +110,7 @@ func NewHandler(sVice service.Service, config *Config, shardManager persistence.
 		historyV2Mgr:        historyV2Mgr,
 		visibilityMgr:       visibilityMgr,
 		executionMgrFactory: executionMgrFactory,
-		tokenSerializer:     common.NewJSONTaskTokenSerializer(),
+		tokenSerializer:     common.NewTaskTokenSerializer(config.TaskTokenEncoding, config.TaskTokenSigningKey, config.TaskTokenPreviousSigningKeys, config.TaskTokenAcceptUnsigned),
 		rateLimiter:         tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
 		publicClient:        publicClient,
 	}
@@ -152,6 +158,9 @@ func (h *Handler) Start() error {
 	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr, h.historyV2Mgr,
 		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
 	h.metricsClient = h.GetMetricsClient()
+	if h.config.HistoryCacheHostLevelMaxSize() > 0 {
+		h.hostLevelCache = newHostLevelCache(h.config, h.metricsClient)
+	}
 	h.historyEventNotifier = newHistoryEventNotifier(h.GetMetricsClient(), h.config.GetShardID)
 	// events notifier must starts before controller
 	h.historyEventNotifier.Start()
@@ -161,9 +170,13 @@ func (h *Handler) Start() error {
 }
 
 // Stop stops the handler
+// The host leaves the ring and stops taking requests first, so the shards move to other hosts while the engines
+// drain their in flight operations. Persistence is closed last, once nothing uses it anymore.
 func (h *Handler) Stop() {
-	h.domainCache.Stop()
+	h.Service.Stop()
 	h.controller.Stop()
+	h.historyEventNotifier.Stop()
+	h.domainCache.Stop()
 	h.shardManager.Close()
 	h.historyMgr.Close()
 	if h.historyV2Mgr != nil {
@@ -172,14 +185,12 @@ func (h *Handler) Stop() {
 	h.executionMgrFactory.Close()
 	h.metadataMgr.Close()
 	h.visibilityMgr.Close()
-	h.Service.Stop()
-	h.historyEventNotifier.Stop()
 }
 
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(context ShardContext) Engine {
 	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient,
-		h.publicClient, h.historyEventNotifier, h.publisher, h.config)
+		h.publicClient, h.historyEventNotifier, h.publisher, h.config, h.hostLevelCache)
 }
 
 // Health is for health check
@@ -631,10 +642,22 @@ func (h *Handler) DescribeHistoryHost(ctx context.Context,
 		},
 		ShardControllerStatus: &status,
 		Address:               common.StringPtr(h.GetHostInfo().GetAddress()),
+		Shards:                h.controller.shardStatuses(),
+		MembershipRing:        h.membershipRing(),
 	}
 	return resp, nil
 }
 
+func (h *Handler) membershipRing() []string {
+	members := h.hServiceResolver.Members()
+	addresses := make([]string, 0, len(members))
+	for _, member := range members {
+		addresses = append(addresses, member.GetAddress())
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
 // DescribeMutableState - returns the internal analysis of workflow execution state
 func (h *Handler) DescribeMutableState(ctx context.Context,
 	request *hist.DescribeMutableStateRequest) (resp *hist.DescribeMutableStateResponse, retError error) {
@@ -920,6 +943,120 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution by recording WorkflowExecutionPaused event
+// in the history.
+func (h *Handler) PauseWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.PauseWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.PauseRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a paused workflow execution by recording WorkflowExecutionResumed event
+// in the history.
+func (h *Handler) ResumeWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.ResumeWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryResumeWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.ResumeRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ResumeWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// AddWorkflowExecutionAnnotation attaches an operator annotation to a workflow execution
+func (h *Handler) AddWorkflowExecutionAnnotation(ctx context.Context,
+	wrappedRequest *hist.AddWorkflowExecutionAnnotationRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryAddWorkflowExecutionAnnotationScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	if wrappedRequest.Execution == nil {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+
+	if wrappedRequest.Annotation == nil {
+		return h.error(errAnnotationNotSet, scope, domainID, "")
+	}
+
+	workflowID := wrappedRequest.Execution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.AddWorkflowExecutionAnnotation(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *Handler) ResetWorkflowExecution(ctx context.Context,
@@ -1224,6 +1361,258 @@ func (h *Handler) SyncActivity(ctx context.Context, syncActivityRequest *hist.Sy
 	return nil
 }
 
+// ReadTransferDLQ returns the transfer tasks moved to the DLQ of a shard
+func (h *Handler) ReadTransferDLQ(ctx context.Context, request *gen.ReadTransferDLQRequest) (resp *gen.ReadTransferDLQResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReadTransferDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ReadTransferDLQ(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return resp, nil
+}
+
+// MergeTransferDLQ processes the transfer tasks in the DLQ of a shard again
+func (h *Handler) MergeTransferDLQ(ctx context.Context, request *gen.MergeTransferDLQRequest) (resp *gen.MergeTransferDLQResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryMergeTransferDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.MergeTransferDLQ(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return resp, nil
+}
+
+// PurgeTransferDLQ deletes the transfer tasks in the DLQ of a shard
+func (h *Handler) PurgeTransferDLQ(ctx context.Context, request *gen.PurgeTransferDLQRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPurgeTransferDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.PurgeTransferDLQ(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	return nil
+}
+
+// ReadReplicationDLQ returns the replication tasks from a source cluster moved to the DLQ of a shard
+func (h *Handler) ReadReplicationDLQ(ctx context.Context, request *gen.ReadReplicationDLQRequest) (resp *gen.ReadReplicationDLQResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReadReplicationDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ReadReplicationDLQ(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return resp, nil
+}
+
+// MergeReplicationDLQ replicates the workflows of the replication tasks in the DLQ of a shard again
+func (h *Handler) MergeReplicationDLQ(ctx context.Context, request *gen.MergeReplicationDLQRequest) (resp *gen.MergeReplicationDLQResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryMergeReplicationDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.MergeReplicationDLQ(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return resp, nil
+}
+
+// PurgeReplicationDLQ deletes the replication tasks from a source cluster in the DLQ of a shard
+func (h *Handler) PurgeReplicationDLQ(ctx context.Context, request *gen.PurgeReplicationDLQRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPurgeReplicationDLQScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.PurgeReplicationDLQ(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	return nil
+}
+
+// RemoveTask deletes a single transfer or timer task of a shard
+func (h *Handler) RemoveTask(ctx context.Context, request *gen.RemoveTaskRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRemoveTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+	if request.TaskID == nil {
+		return h.error(errTaskIDNotSet, scope, "", "")
+	}
+	switch request.GetType() {
+	case common.TaskTypeTransfer:
+	case common.TaskTypeTimer:
+		if request.VisibilityTimestamp == nil {
+			return h.error(errTimestampNotSet, scope, "", "")
+		}
+	default:
+		return h.error(errInvalidTaskType, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.RemoveTask(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	h.GetLogger().Info("Removed history task.",
+		tag.ShardID(int(request.GetShardID())),
+		tag.TaskType(int(request.GetType())),
+		tag.TaskID(request.GetTaskID()))
+	return nil
+}
+
+// CloseShard stops the engine of a shard owned by this host, the shard is acquired again on the next request to it
+func (h *Handler) CloseShard(ctx context.Context, request *gen.CloseShardRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryCloseShardScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	h.controller.removeEngineForShard(int(request.GetShardID()))
+	h.GetLogger().Info("Closed history shard.", tag.ShardID(int(request.GetShardID())))
+	return nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -1246,6 +1635,16 @@ func (h *Handler) convertError(err error) error {
 	case *persistence.CurrentWorkflowConditionFailedError:
 		err := err.(*persistence.CurrentWorkflowConditionFailedError)
 		return &gen.InternalServiceError{Message: err.Msg}
+	case *ce.MaxAttemptsExceededError:
+		return &gen.ServiceBusyError{Message: fmt.Sprintf("%v, please retry later", err)}
+	case *common.BlobSizeExceedsLimitError:
+		return err.(*common.BlobSizeExceedsLimitError).BadRequestError()
+	}
+
+	// internal sentinel errors indicate contention on the workflow execution, the caller can retry later
+	switch err {
+	case ErrConflict, ErrStaleState:
+		return &gen.ServiceBusyError{Message: fmt.Sprintf("%v, please retry later", err)}
 	}
 
 	return err
@@ -1298,16 +1697,7 @@ func (h *Handler) error(err error, scope int, domainID, workflowID string) error
 }
 
 func (h *Handler) getLoggerWithTags(domainID string, workflowID string) log.Logger {
-	logger := h.GetLogger()
-	if domainID != "" {
-		logger = logger.WithTags(tag.WorkflowDomainID(domainID))
-	}
-
-	if workflowID != "" {
-		logger = logger.WithTags(tag.WorkflowID(workflowID))
-	}
-
-	return logger
+	return log.WithWorkflowIDs(h.GetLogger(), domainID, workflowID, "")
 }
 
 func createShardOwnershipLostError(currentHost, ownerHost string) *hist.ShardOwnershipLostError {
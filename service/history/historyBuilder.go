@@ -186,6 +186,20 @@ func (b *historyBuilder) AddWorkflowExecutionTerminatedEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddWorkflowExecutionPausedEvent(
+	request *workflow.PauseWorkflowExecutionRequest) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionPausedEvent(request)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionResumedEvent(
+	request *workflow.ResumeWorkflowExecutionRequest) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionResumedEvent(request)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddContinuedAsNewEvent(decisionCompletedEventID int64, newRunID string,
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes)
@@ -469,6 +483,7 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.Initiator = startRequest.ContinueAsNewInitiator
 	attributes.FirstDecisionTaskBackoffSeconds = startRequest.FirstDecisionTaskBackoffSeconds
 	attributes.Memo = request.Memo
+	attributes.SearchAttributes = request.SearchAttributes
 
 	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
@@ -663,11 +678,34 @@ func (b *historyBuilder) newWorkflowExecutionTerminatedEvent(
 	attributes.Reason = common.StringPtr(common.StringDefault(request.Reason))
 	attributes.Details = request.Details
 	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	attributes.RequestId = request.RequestId
 	historyEvent.WorkflowExecutionTerminatedEventAttributes = attributes
 
 	return historyEvent
 }
 
+func (b *historyBuilder) newWorkflowExecutionPausedEvent(
+	request *workflow.PauseWorkflowExecutionRequest) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionPaused)
+	attributes := &workflow.WorkflowExecutionPausedEventAttributes{}
+	attributes.Reason = common.StringPtr(common.StringDefault(request.Reason))
+	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	historyEvent.WorkflowExecutionPausedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionResumedEvent(
+	request *workflow.ResumeWorkflowExecutionRequest) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionResumed)
+	attributes := &workflow.WorkflowExecutionResumedEventAttributes{}
+	attributes.Reason = common.StringPtr(common.StringDefault(request.Reason))
+	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	historyEvent.WorkflowExecutionResumedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newMarkerRecordedEventAttributes(decisionTaskCompletedEventID int64,
 	request *workflow.RecordMarkerDecisionAttributes) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeMarkerRecorded)
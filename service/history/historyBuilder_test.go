@@ -31,6 +31,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/historyrender"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/persistence"
@@ -976,5 +977,5 @@ func (s *historyBuilderSuite) validateRequestCancelExternalWorkflowExecutionFail
 }
 
 func (s *historyBuilderSuite) printHistory() string {
-	return s.builder.GetHistory().String()
+	return historyrender.String(s.builder.GetHistory().Events)
 }
@@ -48,6 +48,18 @@ type (
 		metricsClient    metrics.Client
 		config           *Config
 	}
+
+	// shardScopedCache stores the entries of one shard in a cache shared by all shards of the host,
+	// so the number of cached workflow contexts is bounded host wide and evicted in LRU order
+	shardScopedCache struct {
+		cache.Cache
+		metricsClient metrics.Client
+	}
+
+	shardScopedCacheKey struct {
+		owner *shardScopedCache
+		key   interface{}
+	}
 )
 
 const (
@@ -72,19 +84,50 @@ func newHistoryCache(shard ShardContext) *historyCache {
 	}
 }
 
+// newHostLevelCache creates the cache shared by the history caches of all shards of the host
+func newHostLevelCache(config *Config, metricsClient metrics.Client) cache.Cache {
+	opts := &cache.Options{}
+	opts.InitialCapacity = config.HistoryCacheInitialSize()
+	opts.TTL = config.HistoryCacheTTL()
+	opts.Pin = true
+	opts.RemovedFunc = func(interface{}) {
+		metricsClient.IncCounter(metrics.HistoryCacheGetOrCreateScope, metrics.CacheEvictionCounter)
+	}
+
+	return cache.New(config.HistoryCacheHostLevelMaxSize(), opts)
+}
+
+// newHistoryCacheWithHostLevelCache creates a history cache for the shard which keeps its entries in the host level cache.
+// Each instance uses its own keys, so contexts of a shard which moved away are never served again and age out of the cache
+func newHistoryCacheWithHostLevelCache(shard ShardContext, hostLevelCache cache.Cache) *historyCache {
+	return &historyCache{
+		Cache: &shardScopedCache{
+			Cache:         hostLevelCache,
+			metricsClient: shard.GetMetricsClient(),
+		},
+		shard:            shard,
+		executionManager: shard.GetExecutionManager(),
+		logger:           shard.GetLogger().WithTags(tag.ComponentHistoryCache),
+		metricsClient:    shard.GetMetricsClient(),
+		config:           shard.GetConfig(),
+	}
+}
+
+// getOrCreateWorkflowExecution is used by the queue processors, the persistence requests made
+// under the returned lock are issued as background requests
 func (c *historyCache) getOrCreateWorkflowExecution(domainID string,
 	execution workflow.WorkflowExecution) (workflowExecutionContext, releaseWorkflowExecutionFunc, error) {
-	return c.getOrCreateWorkflowExecutionWithTimeout(context.Background(), domainID, execution)
+	return c.getOrCreateWorkflowExecutionWithTimeout(backgroundCallerContext, domainID, execution)
 }
 
-func (c *historyCache) validateWorkflowExecutionInfo(domainID string, execution *workflow.WorkflowExecution) error {
+func (c *historyCache) validateWorkflowExecutionInfo(ctx context.Context, domainID string, execution *workflow.WorkflowExecution) error {
 	if execution.GetWorkflowId() == "" {
 		return &workflow.BadRequestError{Message: "Can't load workflow execution.  WorkflowId not set."}
 	}
 
 	// RunID is not provided, lets try to retrieve the RunID for current active execution
 	if execution.GetRunId() == "" {
-		response, err := c.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
+		response, err := c.getCurrentExecutionWithRetry(ctx, &persistence.GetCurrentExecutionRequest{
 			DomainID:   domainID,
 			WorkflowID: execution.GetWorkflowId(),
 		})
@@ -108,7 +151,7 @@ func (c *historyCache) getAndCreateWorkflowExecutionWithTimeout(ctx context.Cont
 	sw := c.metricsClient.StartTimer(metrics.HistoryCacheGetAndCreateScope, metrics.CacheLatency)
 	defer sw.Stop()
 
-	if err := c.validateWorkflowExecutionInfo(domainID, &execution); err != nil {
+	if err := c.validateWorkflowExecutionInfo(ctx, domainID, &execution); err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryCacheGetAndCreateScope, metrics.CacheFailures)
 		return nil, nil, nil, false, err
 	}
@@ -142,7 +185,7 @@ func (c *historyCache) getOrCreateWorkflowExecutionWithTimeout(ctx context.Conte
 
 	defer sw.Stop()
 
-	if err := c.validateWorkflowExecutionInfo(domainID, &execution); err != nil {
+	if err := c.validateWorkflowExecutionInfo(ctx, domainID, &execution); err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryCacheGetOrCreateScope, metrics.CacheFailures)
 		return nil, nil, err
 	}
@@ -193,7 +236,7 @@ func (c *historyCache) makeReleaseFunc(key definition.WorkflowIdentifier, status
 	}
 }
 
-func (c *historyCache) getCurrentExecutionWithRetry(
+func (c *historyCache) getCurrentExecutionWithRetry(ctx context.Context,
 	request *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryCacheGetCurrentExecutionScope, metrics.CacheRequests)
 	sw := c.metricsClient.StartTimer(metrics.HistoryCacheGetCurrentExecutionScope, metrics.CacheLatency)
@@ -202,12 +245,12 @@ func (c *historyCache) getCurrentExecutionWithRetry(
 	var response *persistence.GetCurrentExecutionResponse
 	op := func() error {
 		var err error
-		response, err = c.executionManager.GetCurrentExecution(request)
+		response, err = c.executionManager.GetCurrentExecution(ctx, request)
 
 		return err
 	}
 
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.RetryContext(ctx, op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryCacheGetCurrentExecutionScope, metrics.CacheFailures)
 		return nil, err
@@ -215,3 +258,59 @@ func (c *historyCache) getCurrentExecutionWithRetry(
 
 	return response, nil
 }
+
+func (c *shardScopedCache) scopedKey(key interface{}) shardScopedCacheKey {
+	return shardScopedCacheKey{owner: c, key: key}
+}
+
+// Get retrieves the value stored under the given key for this shard
+func (c *shardScopedCache) Get(key interface{}) interface{} {
+	return c.Cache.Get(c.scopedKey(key))
+}
+
+// Put puts a new value associated with a given key for this shard
+func (c *shardScopedCache) Put(key interface{}, value interface{}) interface{} {
+	existing := c.Cache.Put(c.scopedKey(key), value)
+	c.updateSizeGauge()
+	return existing
+}
+
+// PutIfNotExist puts a value associated with a given key for this shard if it does not exist
+func (c *shardScopedCache) PutIfNotExist(key interface{}, value interface{}) (interface{}, error) {
+	elem, err := c.Cache.PutIfNotExist(c.scopedKey(key), value)
+	c.updateSizeGauge()
+	return elem, err
+}
+
+// Delete deletes the key of this shard
+func (c *shardScopedCache) Delete(key interface{}) {
+	c.Cache.Delete(c.scopedKey(key))
+}
+
+// Release decrements the ref count of a pinned element of this shard
+func (c *shardScopedCache) Release(key interface{}) {
+	c.Cache.Release(c.scopedKey(key))
+}
+
+// Iterator is not supported, the shared cache holds entries of other shards
+func (c *shardScopedCache) Iterator() cache.Iterator {
+	panic("Iterator is not supported by shard scoped cache")
+}
+
+// Size returns the number of entries of this shard
+func (c *shardScopedCache) Size() int {
+	it := c.Cache.Iterator()
+	defer it.Close()
+
+	size := 0
+	for it.HasNext() {
+		if key, ok := it.Next().Key().(shardScopedCacheKey); ok && key.owner == c {
+			size++
+		}
+	}
+	return size
+}
+
+func (c *shardScopedCache) updateSizeGauge() {
+	c.metricsClient.UpdateGauge(metrics.HistoryCacheGetOrCreateScope, metrics.CacheSizeGauge, float64(c.Cache.Size()))
+}
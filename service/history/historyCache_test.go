@@ -240,3 +240,45 @@ func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess() {
 	s.Nil(context.(*workflowExecutionContextImpl).msBuilder)
 	release(nil)
 }
+
+func (s *historyCacheSuite) TestHistoryCacheHostLevel() {
+	config := s.mockShard.GetConfig()
+	config.HistoryCacheHostLevelMaxSize = dynamicconfig.GetIntPropertyFn(3)
+	hostLevelCache := newHostLevelCache(config, s.mockShard.GetMetricsClient())
+	cache1 := newHistoryCacheWithHostLevelCache(s.mockShard, hostLevelCache)
+	cache2 := newHistoryCacheWithHostLevelCache(s.mockShard, hostLevelCache)
+
+	domainID := "test_domain_id"
+	execution1 := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	mockMS1 := &mockMutableState{}
+	context, release, err := cache1.getOrCreateWorkflowExecution(domainID, execution1)
+	s.Nil(err)
+	context.(*workflowExecutionContextImpl).msBuilder = mockMS1
+	release(nil)
+
+	// the same execution cached by another shard does not share the context
+	context, release, err = cache2.getOrCreateWorkflowExecution(domainID, execution1)
+	s.Nil(err)
+	s.NotEqual(mockMS1, context.(*workflowExecutionContextImpl).msBuilder)
+	release(nil)
+	s.Equal(1, cache1.Size())
+	s.Equal(1, cache2.Size())
+
+	// the host level cache is full, the least recently used context is evicted
+	execution2 := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	_, release, err = cache1.getOrCreateWorkflowExecution(domainID, execution2)
+	s.Nil(err)
+	release(nil)
+	s.Equal(2, hostLevelCache.Size())
+
+	context, release, err = cache1.getOrCreateWorkflowExecution(domainID, execution1)
+	s.Nil(err)
+	s.NotEqual(mockMS1, context.(*workflowExecutionContextImpl).msBuilder)
+	release(nil)
+}
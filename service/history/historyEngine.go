@@ -25,8 +25,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -44,6 +48,8 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
+	"github.com/uber/cadence/common/xdc"
 	"github.com/uber/cadence/service/worker/archiver"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/cadence/.gen/go/shared"
@@ -55,6 +61,7 @@ const (
 	activityCancellationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancellationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancellationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	defaultDLQPageSize                        = 100
 )
 
 type (
@@ -67,6 +74,7 @@ type (
 		visibilityMgr        persistence.VisibilityManager
 		txProcessor          transferQueueProcessor
 		timerProcessor       timerQueueProcessor
+		taskScheduler        *taskScheduler
 		taskAllocator        taskAllocator
 		replicator           *historyReplicator
 		replicatorProcessor  queueProcessor
@@ -79,6 +87,11 @@ type (
 		config               *Config
 		archivalClient       archiver.Client
 		resetor              workflowResetor
+		historyRereplicators map[string]xdc.HistoryRereplicator
+
+		status             int32
+		operationsLock     sync.Mutex
+		inFlightOperations sync.WaitGroup
 	}
 
 	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
@@ -99,22 +112,24 @@ var (
 	ErrTaskDiscarded = errors.New("passive task pending for too long")
 	// ErrTaskRetry is the error indicating that the timer / transfer task should be retried.
 	ErrTaskRetry = errors.New("passive task should retry due to condition in mutable state is not met")
-	// ErrDuplicate is exported temporarily for integration test
-	ErrDuplicate = errors.New("Duplicate task, completing it")
 	// ErrConflict is exported temporarily for integration test
 	ErrConflict = errors.New("Conditional update failed")
-	// ErrMaxAttemptsExceeded is exported temporarily for integration test
-	ErrMaxAttemptsExceeded = errors.New("Maximum attempts exceeded to update history")
 	// ErrStaleState is the error returned during state update indicating that cached mutable state could be stale
 	ErrStaleState = errors.New("Cache mutable state could potentially be stale")
+	// errDuplicateTerminateRequest is returned when a terminate request was already applied to the workflow
+	errDuplicateTerminateRequest = errors.New("workflow execution already terminated by the same request")
 	// ErrActivityTaskNotFound is the error to indicate activity task could be duplicate and activity already completed
 	ErrActivityTaskNotFound = &workflow.EntityNotExistsError{Message: "Activity task not found."}
+	// ErrActivityTaskAlreadyCompleted is the error to indicate activity task was already completed, failed or timed out
+	ErrActivityTaskAlreadyCompleted = &workflow.EntityNotExistsError{Message: "Activity task already completed."}
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = &workflow.EntityNotExistsError{Message: "Workflow parent does not match."}
 	// ErrDeserializingToken is the error to indicate task token is invalid
 	ErrDeserializingToken = &workflow.BadRequestError{Message: "Error deserializing task token."}
+	// ErrExecutionContextTooLarge is the error to indicate the execution context of a decision exceeds the size limit
+	ErrExecutionContextTooLarge = &workflow.BadRequestError{Message: "ExecutionContext exceeds size limit."}
 	// ErrSignalOverSize is the error to indicate signal input size is > 256K
 	ErrSignalOverSize = &workflow.BadRequestError{Message: "Signal input size is over 256K."}
 	// ErrCancellationAlreadyRequested is the error indicating cancellation for target workflow is already requested
@@ -125,6 +140,8 @@ var (
 	ErrSignalsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for signal events"}
 	// ErrEventsAterWorkflowFinish is the error indicating server error trying to write events after workflow finish event
 	ErrEventsAterWorkflowFinish = &shared.InternalServiceError{Message: "error validating last event being workflow finish event."}
+	// ErrDomainDeprecated is the error indicating new workflow executions cannot be started in a deprecated domain
+	ErrDomainDeprecated = &workflow.BadRequestError{Message: "Domain is deprecated, new workflow executions cannot be started."}
 
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -146,6 +163,7 @@ func NewEngineWithShardContext(
 	historyEventNotifier historyEventNotifier,
 	publisher messaging.Producer,
 	config *Config,
+	hostLevelCache cache.Cache,
 ) Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 	shardWrapper := &shardContextWrapper{
@@ -158,7 +176,12 @@ func NewEngineWithShardContext(
 	executionManager := shard.GetExecutionManager()
 	historyManager := shard.GetHistoryManager()
 	historyV2Manager := shard.GetHistoryV2Manager()
-	historyCache := newHistoryCache(shard)
+	var historyCache *historyCache
+	if hostLevelCache != nil {
+		historyCache = newHistoryCacheWithHostLevelCache(shard, hostLevelCache)
+	} else {
+		historyCache = newHistoryCache(shard)
+	}
 	historyEngImpl := &historyEngineImpl{
 		currentClusterName:   currentClusterName,
 		shard:                shard,
@@ -166,7 +189,7 @@ func NewEngineWithShardContext(
 		historyV2Mgr:         historyV2Manager,
 		executionManager:     executionManager,
 		visibilityMgr:        visibilityMgr,
-		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
+		tokenSerializer:      common.NewTaskTokenSerializer(config.TaskTokenEncoding, config.TaskTokenSigningKey, config.TaskTokenPreviousSigningKeys, config.TaskTokenAcceptUnsigned),
 		historyCache:         historyCache,
 		logger:               logger.WithTags(tag.ComponentMatchingEngine),
 		throttledLogger:      shard.GetThrottledLogger().WithTags(tag.ComponentMatchingEngine),
@@ -176,6 +199,9 @@ func NewEngineWithShardContext(
 		archivalClient:       archiver.NewClient(shard.GetMetricsClient(), shard.GetLogger(), publicClient, shard.GetConfig().NumArchiveSystemWorkflows),
 	}
 
+	if config.TaskSchedulerEnabled() {
+		historyEngImpl.taskScheduler = newTaskScheduler(config, shard.GetDomainCache(), logger)
+	}
 	txProcessor := newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, logger)
 	historyEngImpl.timerProcessor = newTimerQueueProcessor(shard, historyEngImpl, matching, logger)
 	historyEngImpl.txProcessor = txProcessor
@@ -191,6 +217,23 @@ func NewEngineWithShardContext(
 	}
 	historyEngImpl.resetor = newWorkflowResetor(historyEngImpl, historyEngImpl.replicator)
 
+	historyEngImpl.historyRereplicators = make(map[string]xdc.HistoryRereplicator)
+	for clusterName := range shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
+		if clusterName != currentClusterName {
+			historyEngImpl.historyRereplicators[clusterName] = xdc.NewHistoryRereplicator(
+				currentClusterName,
+				shard.GetDomainCache(),
+				shard.GetService().GetClientBean().GetRemoteAdminClient(clusterName),
+				func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
+					return historyEngImpl.ReplicateRawEvents(ctx, request)
+				},
+				persistence.NewPayloadSerializer(),
+				historyRereplicationTimeout,
+				logger,
+			)
+		}
+	}
+
 	return historyEngImpl
 }
 
@@ -198,11 +241,18 @@ func NewEngineWithShardContext(
 // Make sure all the components are loaded lazily so start can return immediately.  This is important because
 // ShardController calls start sequentially for all the shards for a given host during startup.
 func (e *historyEngineImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
 	e.logger.Info("", tag.LifeCycleStarting)
 	defer e.logger.Info("", tag.LifeCycleStarted)
 
 	e.registerDomainFailoverCallback()
 
+	if e.taskScheduler != nil {
+		e.taskScheduler.Start()
+	}
 	e.txProcessor.Start()
 	e.timerProcessor.Start()
 	if e.replicatorProcessor != nil {
@@ -211,20 +261,56 @@ func (e *historyEngineImpl) Start() {
 }
 
 // Stop the service.
+// New operations are rejected with a shard ownership lost error so callers move on to the new owner of the shard,
+// operations already in flight are given up to ShardDrainTimeout to finish before the queue processors are stopped.
 func (e *historyEngineImpl) Stop() {
+	e.operationsLock.Lock()
+	stopped := atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusStarted, common.DaemonStatusStopped)
+	e.operationsLock.Unlock()
+	if !stopped {
+		return
+	}
+
 	e.logger.Info("", tag.LifeCycleStopping)
 	defer e.logger.Info("", tag.LifeCycleStopped)
 
+	if success := common.AwaitWaitGroup(&e.inFlightOperations, e.config.ShardDrainTimeout()); !success {
+		e.logger.Warn("Timed out draining in flight operations.", tag.LifeCycleStopTimedout)
+	}
+
 	e.txProcessor.Stop()
 	e.timerProcessor.Stop()
 	if e.replicatorProcessor != nil {
 		e.replicatorProcessor.Stop()
 	}
+	if e.taskScheduler != nil {
+		e.taskScheduler.Stop()
+	}
 
 	// unset the failover callback
 	e.shard.GetDomainCache().UnregisterDomainChangeCallback(e.shard.GetShardID())
 }
 
+// startOperation registers an API call modifying the shard, it fails once the engine is stopped
+func (e *historyEngineImpl) startOperation() error {
+	e.operationsLock.Lock()
+	defer e.operationsLock.Unlock()
+
+	if atomic.LoadInt32(&e.status) == common.DaemonStatusStopped {
+		return &persistence.ShardOwnershipLostError{
+			ShardID: e.shard.GetShardID(),
+			Msg:     "History engine of the shard is stopped.",
+		}
+	}
+	e.inFlightOperations.Add(1)
+	return nil
+}
+
+// finishOperation marks an API call registered by startOperation as done
+func (e *historyEngineImpl) finishOperation() {
+	e.inFlightOperations.Done()
+}
+
 func (e *historyEngineImpl) registerDomainFailoverCallback() {
 
 	// NOTE: READ BEFORE MODIFICATION
@@ -356,7 +442,7 @@ func (e *historyEngineImpl) generateFirstDecisionTask(domainID string, msBuilder
 	return transferTasks, di, nil
 }
 
-func (e *historyEngineImpl) appendFirstBatchHistoryEvents(msBuilder mutableState, domainID string, execution workflow.WorkflowExecution) (historySize int, err error) {
+func (e *historyEngineImpl) appendFirstBatchHistoryEvents(ctx context.Context, msBuilder mutableState, domainID string, execution workflow.WorkflowExecution) (historySize int, err error) {
 	// call FlushBufferedEvents to assign task id to event
 	// as well as update last event task id in new state builder
 	err = msBuilder.FlushBufferedEvents()
@@ -367,7 +453,7 @@ func (e *historyEngineImpl) appendFirstBatchHistoryEvents(msBuilder mutableState
 	startedEvent := events[0]
 	if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
 		branchToken := msBuilder.GetCurrentBranch()
-		historySize, err = e.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+		historySize, err = e.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 			IsNewBranch: true,
 			Info:        historyGarbageCleanupInfo(domainID, execution.GetWorkflowId(), execution.GetRunId()),
 			BranchToken: branchToken,
@@ -425,7 +511,9 @@ func generateFirstReplicationTask(msBuilder mutableState, clusterMetadata cluste
 	return replicationTasks
 }
 
-func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutionRequest, msBuilder mutableState, createMode int, prevRunID string, prevLastWriteVersion int64,
+// createWorkflow persists the new execution together with its transfer, timer and replication tasks,
+// including the first decision task, in a single conditional write
+func (e *historyEngineImpl) createWorkflow(ctx context.Context, startRequest *h.StartWorkflowExecutionRequest, msBuilder mutableState, createMode int, prevRunID string, prevLastWriteVersion int64,
 	firstDecisionTask *decisionInfo, transferTasks, timerTasks, replicationTasks []persistence.Task, clusterMetadata cluster.Metadata) (err error) {
 
 	request := startRequest.StartRequest
@@ -492,17 +580,25 @@ func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutio
 		createRequest.ExpirationSeconds = request.RetryPolicy.GetExpirationIntervalInSeconds()
 	}
 
-	_, err = e.shard.CreateWorkflowExecution(createRequest)
+	_, err = e.shard.CreateWorkflowExecution(ctx, createRequest)
 	return err
 }
 
 // StartWorkflowExecution starts a workflow execution
 func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startRequest *h.StartWorkflowExecutionRequest) (
 	resp *workflow.StartWorkflowExecutionResponse, retError error) {
+	if err := e.startOperation(); err != nil {
+		return nil, err
+	}
+	defer e.finishOperation()
+
 	domainEntry, retError := e.getActiveDomainEntry(startRequest.DomainUUID)
 	if retError != nil {
 		return
 	}
+	if retError = validateDomainAcceptsNewExecutions(domainEntry); retError != nil {
+		return
+	}
 	domainID := domainEntry.GetInfo().ID
 
 	request := startRequest.StartRequest
@@ -510,6 +606,10 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	if retError != nil {
 		return
 	}
+	retError = e.checkPayloadSizeLimit(domainEntry, request.GetWorkflowId(), request.Input, metrics.HistoryStartWorkflowExecutionScope)
+	if retError != nil {
+		return
+	}
 
 	execution := workflow.WorkflowExecution{
 		WorkflowId: request.WorkflowId,
@@ -563,7 +663,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	// set versions and timestamp for timer and transfer tasks
 	setTaskInfo(msBuilder.GetCurrentVersion(), time.Now(), transferTasks, timerTasks)
 
-	historySize, retError := e.appendFirstBatchHistoryEvents(msBuilder, domainID, execution)
+	historySize, retError := e.appendFirstBatchHistoryEvents(ctx, msBuilder, domainID, execution)
 	if retError != nil {
 		return
 	}
@@ -571,7 +671,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	shouldDeleteHistory := true
 	defer func() {
 		if shouldDeleteHistory {
-			e.deleteEvents(domainID, execution, eventStoreVersion, msBuilder.GetCurrentBranch())
+			e.deleteEvents(ctx, domainID, execution, eventStoreVersion, msBuilder.GetCurrentBranch())
 		}
 	}()
 
@@ -583,7 +683,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	createMode := persistence.CreateWorkflowModeBrandNew
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
-	retError = e.createWorkflow(startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+	retError = e.createWorkflow(ctx, startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 	if retError != nil {
 		t, ok := retError.(*persistence.WorkflowExecutionAlreadyStartedError)
 		if ok {
@@ -611,7 +711,13 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 			if retError != nil {
 				return
 			}
-			retError = e.createWorkflow(startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+			retError = e.createWorkflow(ctx, startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+			// a retry of this same request may have won the race and replaced the previous run in between
+			if t, ok := retError.(*persistence.CurrentWorkflowConditionFailedError); ok && t.RequestID == *request.RequestId {
+				return &workflow.StartWorkflowExecutionResponse{
+					RunId: common.StringPtr(t.RunID),
+				}, nil
+			}
 		}
 	}
 
@@ -655,6 +761,11 @@ func (e *historyEngineImpl) GetMutableState(ctx context.Context,
 	// if caller decide to long poll on workflow execution
 	// and the event ID we are looking for is smaller than current next event ID
 	if expectedNextEventID >= response.GetNextEventId() && response.GetIsWorkflowRunning() {
+		if expectedNextEventID == common.EndEventID {
+			// the caller only waits for the workflow to close
+			return e.waitWorkflowClose(ctx, domainID, execution, response)
+		}
+
 		subscriberID, channel, err := e.historyEventNotifier.WatchHistoryEvent(definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowId(), execution.GetRunId()))
 		if err != nil {
 			return nil, err
@@ -698,6 +809,41 @@ func (e *historyEngineImpl) GetMutableState(ctx context.Context,
 	return response, nil
 }
 
+func (e *historyEngineImpl) waitWorkflowClose(ctx context.Context, domainID string,
+	execution workflow.WorkflowExecution, response *h.GetMutableStateResponse) (*h.GetMutableStateResponse, error) {
+
+	identifier := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowId(), execution.GetRunId())
+	subscriberID, closeCh, err := e.historyEventNotifier.WatchWorkflowClose(identifier)
+	if err != nil {
+		return nil, err
+	}
+	defer e.historyEventNotifier.UnwatchWorkflowClose(identifier, subscriberID)
+
+	// check again in case the workflow closed before the subscription
+	response, err = e.getMutableState(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	if !response.GetIsWorkflowRunning() {
+		return response, nil
+	}
+
+	domainCache, err := e.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+	timer := time.NewTimer(e.shard.GetConfig().LongPollExpirationInterval(domainCache.GetInfo().Name))
+	defer timer.Stop()
+	select {
+	case <-closeCh:
+		return e.getMutableState(ctx, domainID, execution)
+	case <-timer.C:
+		return response, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 func (e *historyEngineImpl) getMutableState(ctx context.Context,
 	domainID string, execution workflow.WorkflowExecution) (retResp *h.GetMutableStateResponse, retError error) {
 
@@ -707,7 +853,7 @@ func (e *historyEngineImpl) getMutableState(ctx context.Context,
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, retError := context.loadWorkflowExecution()
+	msBuilder, retError := context.loadWorkflowExecution(ctx)
 	if retError != nil {
 		return
 	}
@@ -770,7 +916,7 @@ func (e *historyEngineImpl) DescribeMutableState(ctx context.Context,
 		retResp.MutableStateInCache, retError = e.toMutableStateJSON(msb)
 	}
 
-	msb, retError := dbCtx.loadWorkflowExecution()
+	msb, retError := dbCtx.loadWorkflowExecution(ctx)
 	retResp.MutableStateInDatabase, retError = e.toMutableStateJSON(msb)
 
 	return
@@ -794,6 +940,11 @@ func (e *historyEngineImpl) toMutableStateJSON(msb mutableState) (*string, error
 // 4. ClientFeatureVersion
 // 5. ClientImpl
 func (e *historyEngineImpl) ResetStickyTaskList(ctx context.Context, resetRequest *h.ResetStickyTaskListRequest) (*h.ResetStickyTaskListResponse, error) {
+	if err := e.startOperation(); err != nil {
+		return nil, err
+	}
+	defer e.finishOperation()
+
 	domainID, err := validateDomainUUID(resetRequest.DomainUUID)
 	if err != nil {
 		return nil, err
@@ -831,7 +982,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err1 := context.loadWorkflowExecution()
+	msBuilder, err1 := context.loadWorkflowExecution(ctx)
 	if err1 != nil {
 		return nil, err1
 	}
@@ -853,6 +1004,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 			StartTime:     common.Int64Ptr(executionInfo.StartTimestamp.UnixNano()),
 			HistoryLength: common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
 		},
+		ExecutionContext: executionInfo.ExecutionContext,
 	}
 	if executionInfo.ParentRunID != "" {
 		result.WorkflowExecutionInfo.ParentExecution = &workflow.WorkflowExecution{
@@ -872,50 +1024,175 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 		result.WorkflowExecutionInfo.CloseTime = common.Int64Ptr(completionEvent.GetTimestamp())
 	}
 
-	if len(msBuilder.GetPendingActivityInfos()) > 0 {
-		for _, ai := range msBuilder.GetPendingActivityInfos() {
-			p := &workflow.PendingActivityInfo{
-				ActivityID: common.StringPtr(ai.ActivityID),
-			}
-			state := workflow.PendingActivityStateScheduled
-			if ai.CancelRequested {
-				state = workflow.PendingActivityStateCancelRequested
-			} else if ai.StartedID != common.EmptyEventID {
-				state = workflow.PendingActivityStateStarted
-			}
-			p.State = &state
-			lastHeartbeatUnixNano := ai.LastHeartBeatUpdatedTime.UnixNano()
-			if lastHeartbeatUnixNano > 0 {
-				p.LastHeartbeatTimestamp = common.Int64Ptr(lastHeartbeatUnixNano)
-				p.HeartbeatDetails = ai.Details
-			}
-			// TODO: move to mutable state instead of loading it from event
-			scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
-			if !ok {
-				return nil, &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
-			}
-			p.ActivityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
-			if state == workflow.PendingActivityStateScheduled {
-				p.ScheduledTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
-			} else {
-				p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
-			}
-			if ai.HasRetryPolicy {
-				p.Attempt = common.Int32Ptr(ai.Attempt)
-				p.ExpirationTimestamp = common.Int64Ptr(ai.ExpirationTime.UnixNano())
-				if ai.MaximumAttempts != 0 {
-					p.MaximumAttempts = common.Int32Ptr(ai.MaximumAttempts)
-				}
+	pendingActivities, err := getPendingActivityInfos(msBuilder)
+	if err != nil {
+		return nil, err
+	}
+	result.PendingActivities = pendingActivities
+
+	if len(msBuilder.GetPendingChildExecutionInfos()) > 0 {
+		for _, ch := range msBuilder.GetPendingChildExecutionInfos() {
+			p := &workflow.PendingChildExecutionInfo{
+				WorkflowID:       common.StringPtr(ch.StartedWorkflowID),
+				RunID:            common.StringPtr(ch.StartedRunID),
+				WorkflowTypeName: common.StringPtr(ch.WorkflowTypeName),
+				InitiatedID:      common.Int64Ptr(ch.InitiatedID),
+				Domain:           common.StringPtr(ch.DomainName),
 			}
-			result.PendingActivities = append(result.PendingActivities, p)
+			result.PendingChildren = append(result.PendingChildren, p)
+		}
+	}
+
+	if msBuilder.HasPendingDecisionTask() {
+		di, ok := msBuilder.GetPendingDecision(executionInfo.DecisionScheduleID)
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to get pending decision."}
+		}
+		state := workflow.PendingDecisionStateScheduled
+		if di.StartedID != common.EmptyEventID {
+			state = workflow.PendingDecisionStateStarted
 		}
+		result.PendingDecision = &workflow.PendingDecisionInfo{
+			State:            &state,
+			ScheduledEventId: common.Int64Ptr(di.ScheduleID),
+			Attempt:          common.Int64Ptr(di.Attempt),
+		}
+		if state == workflow.PendingDecisionStateStarted {
+			result.PendingDecision.StartedEventId = common.Int64Ptr(di.StartedID)
+		}
+	}
+
+	annotations, err := e.getWorkflowExecutionAnnotations(ctx, domainID, executionInfo.WorkflowID, executionInfo.RunID)
+	if err != nil {
+		return nil, err
 	}
+	result.Annotations = annotations
 
 	return result, nil
 }
 
+// AddWorkflowExecutionAnnotation attaches an operator annotation to a workflow execution, the annotation
+// is stored outside of the mutable state so the execution itself is left untouched
+func (e *historyEngineImpl) AddWorkflowExecutionAnnotation(ctx context.Context,
+	request *h.AddWorkflowExecutionAnnotationRequest) (retError error) {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+
+	execution := *request.Execution
+
+	// load the execution to make sure it exists and to resolve the current run if no run ID is given
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, execution)
+	if err0 != nil {
+		return err0
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err1 := context.loadWorkflowExecution(ctx)
+	if err1 != nil {
+		return err1
+	}
+	executionInfo := msBuilder.GetExecutionInfo()
+
+	annotation := request.Annotation
+	return e.executionManager.PutWorkflowExecutionAnnotation(ctx, &persistence.PutWorkflowExecutionAnnotationRequest{
+		DomainID: domainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+			RunId:      common.StringPtr(executionInfo.RunID),
+		},
+		Annotation: &persistence.WorkflowExecutionAnnotation{
+			Key:         annotation.GetKey(),
+			Text:        annotation.GetText(),
+			Author:      annotation.GetAuthor(),
+			CreatedTime: e.shard.GetTimeSource().Now(),
+		},
+	})
+}
+
+func (e *historyEngineImpl) getWorkflowExecutionAnnotations(ctx context.Context, domainID, workflowID,
+	runID string) ([]*workflow.WorkflowExecutionAnnotation, error) {
+
+	response, err := e.executionManager.GetWorkflowExecutionAnnotations(ctx, &persistence.GetWorkflowExecutionAnnotationsRequest{
+		DomainID: domainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var annotations []*workflow.WorkflowExecutionAnnotation
+	for _, annotation := range response.Annotations {
+		annotations = append(annotations, &workflow.WorkflowExecutionAnnotation{
+			Key:       common.StringPtr(annotation.Key),
+			Text:      common.StringPtr(annotation.Text),
+			Author:    common.StringPtr(annotation.Author),
+			Timestamp: common.Int64Ptr(annotation.CreatedTime.UnixNano()),
+		})
+	}
+	return annotations, nil
+}
+
+func getPendingActivityInfos(msBuilder mutableState) ([]*workflow.PendingActivityInfo, error) {
+	var result []*workflow.PendingActivityInfo
+	for _, ai := range msBuilder.GetPendingActivityInfos() {
+		p := &workflow.PendingActivityInfo{
+			ActivityID: common.StringPtr(ai.ActivityID),
+		}
+		state := workflow.PendingActivityStateScheduled
+		if ai.CancelRequested {
+			state = workflow.PendingActivityStateCancelRequested
+		} else if ai.StartedID != common.EmptyEventID {
+			state = workflow.PendingActivityStateStarted
+		}
+		p.State = &state
+		lastHeartbeatUnixNano := ai.LastHeartBeatUpdatedTime.UnixNano()
+		if lastHeartbeatUnixNano > 0 {
+			p.LastHeartbeatTimestamp = common.Int64Ptr(lastHeartbeatUnixNano)
+			p.HeartbeatDetails = ai.Details
+		}
+		// TODO: move to mutable state instead of loading it from event
+		scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
+		}
+		p.ActivityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
+		if state == workflow.PendingActivityStateScheduled {
+			p.ScheduledTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
+		} else {
+			p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+		}
+		if ai.HasRetryPolicy {
+			p.Attempt = common.Int32Ptr(ai.Attempt)
+			p.ExpirationTimestamp = common.Int64Ptr(ai.ExpirationTime.UnixNano())
+			if ai.MaximumAttempts != 0 {
+				p.MaximumAttempts = common.Int32Ptr(ai.MaximumAttempts)
+			}
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
 func (e *historyEngineImpl) RecordDecisionTaskStarted(ctx context.Context,
 	request *h.RecordDecisionTaskStartedRequest) (retResp *h.RecordDecisionTaskStartedResponse, retError error) {
+	if err := e.startOperation(); err != nil {
+		return nil, err
+	}
+	defer e.finishOperation()
+
+	span, ctx := tracing.StartSpan(ctx, "history.RecordDecisionTaskStarted", tracing.WorkflowTags(
+		request.GetDomainUUID(), request.WorkflowExecution.GetWorkflowId(), request.WorkflowExecution.GetRunId())...)
+	defer func() { tracing.FinishSpan(span, retError) }()
 
 	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
 	if err != nil {
@@ -934,7 +1211,10 @@ func (e *historyEngineImpl) RecordDecisionTaskStarted(ctx context.Context,
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err0 := context.loadWorkflowExecution()
+		if err := common.IsValidContext(ctx); err != nil {
+			return nil, err
+		}
+		msBuilder, err0 := context.loadWorkflowExecution(ctx)
 		if err0 != nil {
 			return nil, err0
 		}
@@ -968,7 +1248,7 @@ Update_History_Loop:
 		if di.StartedID != common.EmptyEventID {
 			// If decision is started as part of the current request scope then return a positive response
 			if di.RequestID == requestID {
-				return e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di, request.PollRequest.GetIdentity()), nil
+				return e.createRecordDecisionTaskStartedResponseForPoll(domainID, msBuilder, di, request.PollRequest)
 			}
 
 			// Looks like DecisionTask already started as a result of another call.
@@ -977,6 +1257,10 @@ Update_History_Loop:
 			return nil, &h.EventAlreadyStartedError{Message: "Decision task already started."}
 		}
 
+		// only the first attempt of the first decision is measured, retries of a failing
+		// first decision would otherwise be reported as a slow start
+		isFirstDecision := msBuilder.GetPreviousStartedEventID() == common.EmptyEventID && di.Attempt == 0
+		scheduledTimestamp := di.Timestamp
 		_, di = msBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, request.PollRequest)
 		if di == nil {
 			// Unable to add DecisionTaskStarted event to history
@@ -996,23 +1280,46 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		if err3 := context.updateWorkflowExecution(nil, timerTasks, transactionID); err3 != nil {
+		if err3 := context.updateWorkflowExecution(ctx, nil, timerTasks, transactionID); err3 != nil {
 			if err3 == ErrConflict {
-				e.metricsClient.IncCounter(metrics.HistoryRecordDecisionTaskStartedScope,
-					metrics.ConcurrencyUpdateFailureCounter)
+				tracing.LogConflict(ctx, attempt)
+				e.metricsClient.Scope(metrics.HistoryRecordDecisionTaskStartedScope,
+					metrics.DomainTag(domainEntry.GetInfo().Name)).IncCounter(metrics.ConcurrencyUpdateFailureCounter)
 				continue Update_History_Loop
 			}
 			return nil, err3
 		}
 
-		return e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di, request.PollRequest.GetIdentity()), nil
+		// measured from the time the first decision is scheduled, so the backoff of cron and retried
+		// workflows is not counted
+		if isFirstDecision && scheduledTimestamp != 0 {
+			e.metricsClient.Scope(metrics.HistoryRecordDecisionTaskStartedScope,
+				metrics.DomainTag(domainEntry.GetInfo().Name)).RecordTimer(metrics.WorkflowStartToFirstDecisionLatency,
+				time.Since(time.Unix(0, scheduledTimestamp)))
+		}
+
+		// decisions scheduled before the scheduled time was tracked fall back to the time matching got the task
+		if scheduledTimestamp == 0 {
+			scheduledTimestamp = request.GetTaskCreatedTimestamp()
+		}
+		if scheduledTimestamp != 0 {
+			latency := time.Since(time.Unix(0, scheduledTimestamp))
+			e.reportDecisionScheduleLatency(metrics.HistoryRecordDecisionTaskStartedScope, domainEntry.GetInfo().Name,
+				*context.getExecution(), scheduleID, latency, getDecisionScheduleLatencyCause(request))
+		}
+
+		return e.createRecordDecisionTaskStartedResponseForPoll(domainID, msBuilder, di, request.PollRequest)
 	}
 
-	return nil, ErrMaxAttemptsExceeded
+	return nil, e.newMaxAttemptsExceededError(context)
 }
 
 func (e *historyEngineImpl) RecordActivityTaskStarted(ctx context.Context,
 	request *h.RecordActivityTaskStartedRequest) (*h.RecordActivityTaskStartedResponse, error) {
+	if err := e.startOperation(); err != nil {
+		return nil, err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
 	if err != nil {
@@ -1102,6 +1409,32 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(ctx context.Context,
 	return response, err
 }
 
+func (e *historyEngineImpl) newMaxAttemptsExceededError(context workflowExecutionContext) error {
+	return ce.NewMaxAttemptsExceededError(
+		e.shard.GetShardID(),
+		context.getDomainID(),
+		context.getExecution().GetWorkflowId(),
+		context.getExecution().GetRunId(),
+	)
+}
+
+// checkPayloadSizeLimit rejects payloads over the blob size limit of the domain, the frontend checks the
+// same limit but requests from other services reach the engine directly
+func (e *historyEngineImpl) checkPayloadSizeLimit(domainEntry *cache.DomainCacheEntry, workflowID string,
+	payload []byte, scope int) error {
+	domainName := domainEntry.GetInfo().Name
+	return common.CheckEventBlobSizeLimit(
+		len(payload),
+		e.config.BlobSizeLimitWarn(domainName),
+		e.config.BlobSizeLimitError(domainName),
+		domainEntry.GetInfo().ID,
+		workflowID,
+		"",
+		e.metricsClient.Scope(scope),
+		e.throttledLogger,
+	)
+}
+
 type decisionBlobSizeChecker struct {
 	sizeLimitWarn  int
 	sizeLimitError int
@@ -1115,8 +1448,12 @@ type decisionBlobSizeChecker struct {
 }
 
 func (c *decisionBlobSizeChecker) failWorkflowIfBlobSizeExceedsLimit(blob []byte, message string) (bool, error) {
+	return c.failWorkflowIfPayloadSizeExceedsLimit(len(blob), message)
+}
+
+func (c *decisionBlobSizeChecker) failWorkflowIfPayloadSizeExceedsLimit(size int, message string) (bool, error) {
 	err := common.CheckEventBlobSizeLimit(
-		len(blob),
+		size,
 		c.sizeLimitWarn,
 		c.sizeLimitError,
 		c.domainID,
@@ -1167,6 +1504,14 @@ func (e *historyEngineImpl) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	req *h.RespondDecisionTaskCompletedRequest,
 ) (response *h.RespondDecisionTaskCompletedResponse, retError error) {
+	if err := e.startOperation(); err != nil {
+		return nil, err
+	}
+	defer e.finishOperation()
+
+	span, ctx := tracing.StartSpan(ctx, "history.RespondDecisionTaskCompleted",
+		opentracing.Tag{Key: tracing.DomainIDTag, Value: req.GetDomainUUID()})
+	defer func() { tracing.FinishSpan(span, retError) }()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -1184,10 +1529,19 @@ func (e *historyEngineImpl) RespondDecisionTaskCompleted(
 		return nil, ErrDeserializingToken
 	}
 
+	if len(request.ExecutionContext) > e.config.ExecutionContextSizeLimitError(domainEntry.GetInfo().Name) {
+		return nil, ErrExecutionContextTooLarge
+	}
+
+	// invalid decisions fail the decision task like decisions with bad attributes do, once the workflow is loaded
+	decisionsFailCause, decisionsErr := validateDecisions(request.Decisions, e.config.DecisionCountLimitError(domainEntry.GetInfo().Name))
+
 	workflowExecution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(token.WorkflowID),
 		RunId:      common.StringPtr(token.RunID),
 	}
+	span.SetTag(tracing.WorkflowIDTag, token.WorkflowID)
+	span.SetTag(tracing.RunIDTag, token.RunID)
 
 	call := yarpc.CallFromContext(ctx)
 	clientLibVersion := call.Header(common.LibraryVersionHeaderName)
@@ -1216,7 +1570,10 @@ func (e *historyEngineImpl) RespondDecisionTaskCompleted(
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err1 := context.loadWorkflowExecution()
+		if err := common.IsValidContext(ctx); err != nil {
+			return nil, err
+		}
+		msBuilder, err1 := context.loadWorkflowExecution(ctx)
 		if err1 != nil {
 			return nil, err1
 		}
@@ -1245,12 +1602,14 @@ Update_History_Loop:
 		}
 
 		startedID := di.StartedID
+		decisionStartedTimestamp := di.Timestamp
 		completedEvent := msBuilder.AddDecisionTaskCompletedEvent(scheduleID, startedID, request)
 		if completedEvent == nil {
 			return nil, &workflow.InternalServiceError{Message: "Unable to add DecisionTaskCompleted event to history."}
 		}
 
 		failDecision := false
+		decisionRetryBackedOff := false
 		var failCause workflow.DecisionTaskFailedCause
 		var failMessage string
 		var err error
@@ -1280,22 +1639,40 @@ Update_History_Loop:
 		sizeChecker.completedID = completedID
 		sizeChecker.msBuilder = msBuilder
 
+		decisions := request.Decisions
+		if decisionsErr != nil {
+			failDecision = true
+			failCause = decisionsFailCause
+			failMessage = decisionsErr.Error()
+			err = decisionsErr
+			decisions = nil
+		}
+
 	Process_Decision_Loop:
-		for _, d := range request.Decisions {
+		for i, d := range decisions {
 			switch *d.DecisionType {
 			case workflow.DecisionTypeScheduleActivityTask:
 				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
 					metrics.DecisionTypeScheduleActivityCounter)
-				targetDomainID := domainID
+				targetDomainEntry := domainEntry
 				attributes := d.ScheduleActivityTaskDecisionAttributes
 				// First check if we need to use a different target domain to schedule activity
 				if attributes.Domain != nil {
 					// TODO: Error handling for ActivitySchedule failed when domain lookup fails
-					domainEntry, err := e.shard.GetDomainCache().GetDomain(*attributes.Domain)
+					targetDomainEntry, err = e.shard.GetDomainCache().GetDomain(*attributes.Domain)
 					if err != nil {
 						return nil, &workflow.InternalServiceError{Message: "Unable to schedule activity across domain."}
 					}
-					targetDomainID = domainEntry.GetInfo().ID
+				}
+				targetDomainID := targetDomainEntry.GetInfo().ID
+				applyActivityTaskListOverride(attributes, targetDomainEntry)
+				// the task list may come from an override of the target domain, so it can only be checked here
+				if attributes != nil && attributes.TaskList.GetName() == "" {
+					err = newDecisionBadRequestError(i, "TaskList is not set on decision.")
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadScheduleActivityAttributes
+					failMessage = err.Error()
+					break Process_Decision_Loop
 				}
 
 				if err = validateActivityScheduleAttributes(attributes, executionInfo.WorkflowTimeout, maxIDLengthLimit); err != nil {
@@ -1304,6 +1681,12 @@ Update_History_Loop:
 					failMessage = err.Error()
 					break Process_Decision_Loop
 				}
+				if _, ok := msBuilder.GetActivityByActivityID(attributes.GetActivityId()); ok {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadScheduleActivityAttributes
+					failMessage = fmt.Sprintf("ActivityId %v is already used by a pending activity.", attributes.GetActivityId())
+					break Process_Decision_Loop
+				}
 
 				failWorkflow, err := sizeChecker.failWorkflowIfBlobSizeExceedsLimit(attributes.Input, "ScheduleActivityTaskDecisionAttributes.Input exceeds size limit.")
 				if err != nil {
@@ -1361,7 +1744,7 @@ Update_History_Loop:
 					}
 				} else {
 					// this is a cron workflow
-					startEvent, err := getWorkflowStartedEvent(e.historyMgr, e.historyV2Mgr, msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), e.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(), common.IntPtr(e.shard.GetShardID()))
+					startEvent, err := getWorkflowStartedEvent(ctx, e.historyMgr, e.historyV2Mgr, msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), e.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(), common.IntPtr(e.shard.GetShardID()))
 					if err != nil {
 						return nil, err
 					}
@@ -1420,45 +1803,9 @@ Update_History_Loop:
 					break Process_Decision_Loop
 				}
 
-				backoffInterval := msBuilder.GetRetryBackoffDuration(failedAttributes.GetReason())
-				continueAsNewInitiator := workflow.ContinueAsNewInitiatorRetryPolicy
-				if backoffInterval == common.NoRetryBackoff {
-					backoffInterval = msBuilder.GetCronBackoffDuration()
-					continueAsNewInitiator = workflow.ContinueAsNewInitiatorCronSchedule
-				}
-
-				if backoffInterval == cron.NoBackoff {
-					// no retry or cron
-					if evt := msBuilder.AddFailWorkflowEvent(completedID, failedAttributes); evt == nil {
-						return nil, &workflow.InternalServiceError{Message: "Unable to add fail workflow event."}
-					}
-				} else {
-					// retry or cron with backoff
-					startEvent, err := getWorkflowStartedEvent(e.historyMgr, e.historyV2Mgr, msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), e.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(), common.IntPtr(e.shard.GetShardID()))
-					if err != nil {
-						return nil, err
-					}
-
-					startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
-					continueAsNewAttributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
-						WorkflowType:                        startAttributes.WorkflowType,
-						TaskList:                            startAttributes.TaskList,
-						RetryPolicy:                         startAttributes.RetryPolicy,
-						Input:                               startAttributes.Input,
-						ExecutionStartToCloseTimeoutSeconds: startAttributes.ExecutionStartToCloseTimeoutSeconds,
-						TaskStartToCloseTimeoutSeconds:      startAttributes.TaskStartToCloseTimeoutSeconds,
-						BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(backoffInterval.Seconds())),
-						Initiator:                           continueAsNewInitiator.Ptr(),
-						FailureReason:                       failedAttributes.Reason,
-						FailureDetails:                      failedAttributes.Details,
-						LastCompletionResult:                startAttributes.LastCompletionResult,
-						CronSchedule:                        common.StringPtr(msBuilder.GetExecutionInfo().CronSchedule),
-					}
-
-					if _, continueAsNewBuilder, err = msBuilder.AddContinueAsNewEvent(completedID, completedID, domainEntry,
-						startAttributes.GetParentWorkflowDomain(), continueAsNewAttributes, eventStoreVersion); err != nil {
-						return nil, err
-					}
+				if continueAsNewBuilder, err = e.failWorkflowWithRetry(ctx, msBuilder, domainEntry, workflowExecution, completedID,
+					failedAttributes, eventStoreVersion); err != nil {
+					return nil, err
 				}
 
 				isComplete = true
@@ -1569,7 +1916,10 @@ Update_History_Loop:
 					failMessage = err.Error()
 					break Process_Decision_Loop
 				}
-				failWorkflow, err := sizeChecker.failWorkflowIfBlobSizeExceedsLimit(attributes.Details, "RecordMarkerDecisionAttributes.Details exceeds size limit.")
+				failWorkflow, err := sizeChecker.failWorkflowIfPayloadSizeExceedsLimit(
+					getRecordMarkerPayloadSize(attributes),
+					"RecordMarkerDecisionAttributes.Details and Header exceed size limit.",
+				)
 				if err != nil {
 					return nil, err
 				}
@@ -1596,6 +1946,13 @@ Update_History_Loop:
 				} else {
 					foreignDomainEntry, err := e.shard.GetDomainCache().GetDomain(attributes.GetDomain())
 					if err != nil {
+						if _, ok := err.(*workflow.EntityNotExistsError); ok {
+							// retrying the decision would never succeed, so fail it and let the worker see the cause
+							failDecision = true
+							failCause = workflow.DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes
+							failMessage = fmt.Sprintf("Unknown target domain: %v.", attributes.GetDomain())
+							break Process_Decision_Loop
+						}
 						return nil, &workflow.InternalServiceError{
 							Message: fmt.Sprintf("Unable to cancel workflow across domain: %v.", attributes.GetDomain())}
 					}
@@ -1642,6 +1999,13 @@ Update_History_Loop:
 				} else {
 					foreignDomainEntry, err := e.shard.GetDomainCache().GetDomain(attributes.GetDomain())
 					if err != nil {
+						if _, ok := err.(*workflow.EntityNotExistsError); ok {
+							// retrying the decision would never succeed, so fail it and let the worker see the cause
+							failDecision = true
+							failCause = workflow.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes
+							failMessage = fmt.Sprintf("Unknown target domain: %v.", attributes.GetDomain())
+							break Process_Decision_Loop
+						}
 						return nil, &workflow.InternalServiceError{
 							Message: fmt.Sprintf("Unable to signal workflow across domain: %v.", attributes.GetDomain())}
 					}
@@ -1755,8 +2119,14 @@ Update_History_Loop:
 			}
 		}
 
+		// a decision with invalid attributes fails the decision task, so repeated bad decisions are counted as
+		// decision attempts, the validation error is still returned once the failure is persisted
+		var badDecisionErr error
 		if err != nil {
-			return nil, err
+			if !failDecision {
+				return nil, err
+			}
+			badDecisionErr = err
 		}
 
 		if failDecision {
@@ -1766,7 +2136,8 @@ Update_History_Loop:
 				tag.WorkflowRunID(token.RunID),
 				tag.WorkflowDomainID(domainID))
 			var err1 error
-			msBuilder, err1 = e.failDecision(context, scheduleID, startedID, failCause, []byte(failMessage), request)
+			var firstEventID int64
+			msBuilder, firstEventID, err1 = e.failDecision(ctx, context, scheduleID, startedID, failCause, []byte(failMessage), request)
 			if err1 != nil {
 				return nil, err1
 			}
@@ -1774,6 +2145,41 @@ Update_History_Loop:
 			isComplete = false
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+
+			// only the decisions of the worker failing validation are counted, decision timeouts and decisions
+			// failing because new events came in are not
+			attemptsLimit := e.config.DecisionFailureAttemptsLimit(domainEntry.GetInfo().Name)
+			failureCount := msBuilder.GetExecutionInfo().DecisionFailureCount
+			if attemptsLimit > 0 && failureCount >= int64(attemptsLimit) {
+				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
+					metrics.DecisionFailureAttemptsExceededCounter)
+				e.logger.Warn("Failing the workflow after repeated decision failures.",
+					tag.WorkflowDecisionFailCause(int64(failCause)),
+					tag.Attempt(int32(failureCount)),
+					tag.WorkflowID(token.WorkflowID),
+					tag.WorkflowRunID(token.RunID),
+					tag.WorkflowDomainID(domainID))
+				attributes := &workflow.FailWorkflowExecutionDecisionAttributes{
+					Reason: common.StringPtr(common.FailureReasonDecisionFailureAttemptsExceeded),
+					Details: []byte(fmt.Sprintf("Decision task failed %v consecutive times, last cause %v: %v",
+						failureCount, failCause, failMessage)),
+				}
+				// events received during the decision are written before the workflow closes
+				if err := msBuilder.FlushBufferedEvents(); err != nil {
+					return nil, err
+				}
+				// the workflow is retried or started again by its cron schedule like for a FailWorkflowExecution decision
+				if continueAsNewBuilder, err1 = e.failWorkflowWithRetry(ctx, msBuilder, domainEntry, workflowExecution, firstEventID,
+					attributes, eventStoreVersion); err1 != nil {
+					return nil, err1
+				}
+				isComplete = true
+				hasUnhandledEvents = false
+			} else if backoffTimer := tBuilder.AddDecisionRetryBackoffTimerTask(msBuilder.GetExecutionInfo().DecisionAttempt); backoffTimer != nil {
+				// the decision keeps failing, its next attempt is scheduled by the backoff timer
+				timerTasks = append(timerTasks, backoffTimer)
+				decisionRetryBackedOff = true
+			}
 		}
 
 		if tt := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); tt != nil {
@@ -1785,9 +2191,12 @@ Update_History_Loop:
 			}
 		}
 
+		// the worker does not get a new decision task along with a validation error
+		returnNewDecisionTask := request.GetReturnNewDecisionTask() && badDecisionErr == nil
 		// Schedule another decision task if new events came in during this decision or if request forced to
-		createNewDecisionTask := !isComplete && (hasUnhandledEvents ||
-			request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+		// Paused workflows don't get new decisions until resumed
+		createNewDecisionTask := !isComplete && !msBuilder.IsWorkflowExecutionPaused() && !decisionRetryBackedOff &&
+			(hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			di := msBuilder.AddDecisionTaskScheduledEvent()
@@ -1796,7 +2205,7 @@ Update_History_Loop:
 			}
 			newDecisionTaskScheduledID = di.ScheduleID
 			// skip transfer task for decision if request asking to return new decision task
-			if !request.GetReturnNewDecisionTask() {
+			if !returnNewDecisionTask {
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
 					TaskList:   di.TaskList,
@@ -1840,46 +2249,66 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
+		// The stored execution context is only replaced when the decision sets one, or removed when asked to
+		executionContext := executionInfo.ExecutionContext
+		if request.GetClearExecutionContext() {
+			executionContext = nil
+		} else if request.ExecutionContext != nil {
+			executionContext = request.ExecutionContext
+		}
+
 		var updateErr error
 		if continueAsNewBuilder != nil {
 			continueAsNewTimerTasks = msBuilder.GetContinueAsNew().TimerTasks
-			updateErr = context.continueAsNewWorkflowExecution(request.ExecutionContext, continueAsNewBuilder,
+			updateErr = context.continueAsNewWorkflowExecution(ctx, executionContext, continueAsNewBuilder,
 				transferTasks, timerTasks, transactionID)
 		} else {
-			updateErr = context.updateWorkflowExecutionWithContext(request.ExecutionContext, transferTasks, timerTasks,
+			updateErr = context.updateWorkflowExecutionWithContext(ctx, executionContext, transferTasks, timerTasks,
 				transactionID)
 		}
 
 		if updateErr != nil {
 			if updateErr == ErrConflict {
-				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
-					metrics.ConcurrencyUpdateFailureCounter)
+				tracing.LogConflict(ctx, attempt)
+				e.metricsClient.Scope(metrics.HistoryRespondDecisionTaskCompletedScope,
+					metrics.DomainTag(domainEntry.GetInfo().Name)).IncCounter(metrics.ConcurrencyUpdateFailureCounter)
 				continue Update_History_Loop
 			}
 
 			return nil, updateErr
 		}
 
+		e.metricsClient.Scope(metrics.HistoryRespondDecisionTaskCompletedScope,
+			metrics.DomainTag(domainEntry.GetInfo().Name)).RecordTimer(metrics.DecisionStartToCloseLatency,
+			time.Since(time.Unix(0, decisionStartedTimestamp)))
+
 		// add continueAsNewTimerTask
 		timerTasks = append(timerTasks, continueAsNewTimerTasks...)
 		// Inform timer about the new ones.
 		e.timerProcessor.NotifyNewTimers(e.currentClusterName, e.shard.GetCurrentTime(e.currentClusterName), timerTasks)
 
 		response = &h.RespondDecisionTaskCompletedResponse{}
-		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
+		if returnNewDecisionTask && createNewDecisionTask {
 			di, _ := msBuilder.GetPendingDecision(newDecisionTaskScheduledID)
 			response.StartedResponse = e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di, request.GetIdentity())
 			// sticky is always enabled when worker request for new decision task from RespondDecisionTaskCompleted
 			response.StartedResponse.StickyExecutionEnabled = common.BoolPtr(true)
 		}
 
+		if badDecisionErr != nil {
+			return nil, badDecisionErr
+		}
 		return response, nil
 	}
 
-	return nil, ErrMaxAttemptsExceeded
+	return nil, e.newMaxAttemptsExceededError(context)
 }
 
 func (e *historyEngineImpl) RespondDecisionTaskFailed(ctx context.Context, req *h.RespondDecisionTaskFailedRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -1898,27 +2327,51 @@ func (e *historyEngineImpl) RespondDecisionTaskFailed(ctx context.Context, req *
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, workflowExecution, false, true,
-		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, workflowExecution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
 			}
 
 			scheduleID := token.ScheduleID
 			di, isRunning := msBuilder.GetPendingDecision(scheduleID)
+
+			// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
+			// some extreme cassandra failure cases.
+			if !isRunning && scheduleID >= msBuilder.GetNextEventID() {
+				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskFailedScope, metrics.StaleMutableStateCounter)
+				return nil, ErrStaleState
+			}
+
 			if !isRunning || di.Attempt != token.ScheduleAttempt || di.StartedID == common.EmptyEventID {
 				return nil, &workflow.EntityNotExistsError{Message: "Decision task not found."}
 			}
 
+			e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskFailedScope, metrics.FailedDecisionsCounter)
+			e.logger.Info("Decision task failed by worker.", tag.WorkflowDecisionFailCause(int64(request.GetCause())),
+				tag.WorkflowID(token.WorkflowID),
+				tag.WorkflowRunID(token.RunID),
+				tag.WorkflowDomainID(domainID))
+
+			// DecisionTaskFailed event is only written for the first attempt, subsequent failures just bump the attempt
+			// of the transient decision.
 			msBuilder.AddDecisionTaskFailedEvent(di.ScheduleID, di.StartedID, request.GetCause(), request.Details,
 				request.GetIdentity(), "", "", "", 0)
 
-			return nil, nil
+			// the decision keeps failing, its next attempt is scheduled by the backoff timer
+			if backoffTimer := tBuilder.AddDecisionRetryBackoffTimerTask(msBuilder.GetExecutionInfo().DecisionAttempt); backoffTimer != nil {
+				return &updateWorkflowAction{timerTasks: []persistence.Task{backoffTimer}}, nil
+			}
+			return &updateWorkflowAction{createDecision: true}, nil
 		})
 }
 
 // RespondActivityTaskCompleted completes an activity task.
 func (e *historyEngineImpl) RespondActivityTaskCompleted(ctx context.Context, req *h.RespondActivityTaskCompletedRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -1959,7 +2412,12 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(ctx context.Context, re
 				return nil, ErrStaleState
 			}
 
-			if !isRunning || ai.StartedID == common.EmptyEventID ||
+			// the activity was scheduled before and is no longer pending, so this is a duplicate or stale completion
+			if !isRunning {
+				return nil, ErrActivityTaskAlreadyCompleted
+			}
+
+			if ai.StartedID == common.EmptyEventID ||
 				(token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)) {
 				return nil, ErrActivityTaskNotFound
 			}
@@ -1974,6 +2432,10 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(ctx context.Context, re
 
 // RespondActivityTaskFailed completes an activity task failure.
 func (e *historyEngineImpl) RespondActivityTaskFailed(ctx context.Context, req *h.RespondActivityTaskFailedRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -2039,6 +2501,10 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(ctx context.Context, req *
 
 // RespondActivityTaskCanceled completes an activity task failure.
 func (e *historyEngineImpl) RespondActivityTaskCanceled(ctx context.Context, req *h.RespondActivityTaskCanceledRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -2101,6 +2567,10 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(ctx context.Context, req
 // - For reporting progress of the activity, this can be done even if the liveness is not configured.
 func (e *historyEngineImpl) RecordActivityTaskHeartbeat(ctx context.Context,
 	req *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error) {
+	if err := e.startOperation(); err != nil {
+		return nil, err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -2171,6 +2641,10 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(ctx context.Context,
 // RequestCancelWorkflowExecution records request cancellation event for workflow execution
 func (e *historyEngineImpl) RequestCancelWorkflowExecution(ctx context.Context,
 	req *h.RequestCancelWorkflowExecutionRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -2225,6 +2699,10 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(ctx context.Context,
 }
 
 func (e *historyEngineImpl) SignalWorkflowExecution(ctx context.Context, signalRequest *h.SignalWorkflowExecutionRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(signalRequest.DomainUUID)
 	if err != nil {
@@ -2233,6 +2711,10 @@ func (e *historyEngineImpl) SignalWorkflowExecution(ctx context.Context, signalR
 	domainID := domainEntry.GetInfo().ID
 
 	request := signalRequest.SignalRequest
+	if err := e.checkPayloadSizeLimit(domainEntry, request.WorkflowExecution.GetWorkflowId(), request.Input,
+		metrics.HistorySignalWorkflowExecutionScope); err != nil {
+		return err
+	}
 	parentExecution := signalRequest.ExternalWorkflowExecution
 	childWorkflowOnly := signalRequest.GetChildWorkflowOnly()
 	execution := workflow.WorkflowExecution{
@@ -2283,6 +2765,10 @@ func (e *historyEngineImpl) SignalWorkflowExecution(ctx context.Context, signalR
 
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context, signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (
 	retResp *workflow.StartWorkflowExecutionResponse, retError error) {
+	if err := e.startOperation(); err != nil {
+		return nil, err
+	}
+	defer e.finishOperation()
 
 	domainEntry, retError := e.getActiveDomainEntry(signalWithStartRequest.DomainUUID)
 	if retError != nil {
@@ -2294,6 +2780,14 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	execution := workflow.WorkflowExecution{
 		WorkflowId: sRequest.WorkflowId,
 	}
+	if retError = e.checkPayloadSizeLimit(domainEntry, sRequest.GetWorkflowId(), sRequest.Input,
+		metrics.HistorySignalWithStartWorkflowExecutionScope); retError != nil {
+		return
+	}
+	if retError = e.checkPayloadSizeLimit(domainEntry, sRequest.GetWorkflowId(), sRequest.SignalInput,
+		metrics.HistorySignalWithStartWorkflowExecutionScope); retError != nil {
+		return
+	}
 
 	var prevMutableState mutableState
 	attempt := 0
@@ -2304,8 +2798,11 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 		defer func() { release(retError) }()
 	Just_Signal_Loop:
 		for ; attempt < conditionalRetryCount; attempt++ {
+			if err := common.IsValidContext(ctx); err != nil {
+				return nil, err
+			}
 			// workflow not exist, will create workflow then signal
-			msBuilder, err1 := context.loadWorkflowExecution()
+			msBuilder, err1 := context.loadWorkflowExecution(ctx)
 			if err1 != nil {
 				if _, ok := err1.(*workflow.EntityNotExistsError); ok {
 					break
@@ -2334,8 +2831,8 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 
 			var transferTasks []persistence.Task
 			var timerTasks []persistence.Task
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the workflow is paused
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.IsWorkflowExecutionPaused() {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				if di == nil {
 					return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -2361,8 +2858,10 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 
 			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 			// the history and try the operation again.
-			if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+			if err := context.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID); err != nil {
 				if err == ErrConflict {
+					e.metricsClient.Scope(metrics.HistorySignalWithStartWorkflowExecutionScope,
+						metrics.DomainTag(domainEntry.GetInfo().Name)).IncCounter(metrics.ConcurrencyUpdateFailureCounter)
 					continue Just_Signal_Loop
 				}
 				return nil, err
@@ -2371,7 +2870,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 			return &workflow.StartWorkflowExecutionResponse{RunId: context.getExecution().RunId}, nil
 		} // end for Just_Signal_Loop
 		if attempt == conditionalRetryCount {
-			return nil, ErrMaxAttemptsExceeded
+			return nil, e.newMaxAttemptsExceededError(context)
 		}
 	} else {
 		if _, ok := err0.(*workflow.EntityNotExistsError); !ok {
@@ -2380,6 +2879,11 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 		// workflow not exist, will create workflow then signal
 	}
 
+	// existing executions of a deprecated domain can still be signaled, but no new ones can be started
+	if retError = validateDomainAcceptsNewExecutions(domainEntry); retError != nil {
+		return
+	}
+
 	// Start workflow and signal
 	startRequest := getStartRequest(domainID, sRequest)
 	request := startRequest.StartRequest
@@ -2456,7 +2960,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	// set versions and timestamp for timer and transfer tasks
 	setTaskInfo(msBuilder.GetCurrentVersion(), time.Now(), transferTasks, timerTasks)
 
-	historySize, retError := e.appendFirstBatchHistoryEvents(msBuilder, domainID, execution)
+	historySize, retError := e.appendFirstBatchHistoryEvents(ctx, msBuilder, domainID, execution)
 	if retError != nil {
 		return
 	}
@@ -2464,7 +2968,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	shouldDeleteHistory := true
 	defer func() {
 		if shouldDeleteHistory {
-			e.deleteEvents(domainID, execution, eventStoreVersion, msBuilder.GetCurrentBranch())
+			e.deleteEvents(ctx, domainID, execution, eventStoreVersion, msBuilder.GetCurrentBranch())
 		}
 	}()
 
@@ -2475,10 +2979,10 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 		createMode := persistence.CreateWorkflowModeWorkflowIDReuse
 		prevRunID := prevMutableState.GetExecutionInfo().RunID
 		lastWriteVersion := prevMutableState.GetLastWriteVersion()
-		retError = e.createWorkflow(startRequest, msBuilder, createMode, prevRunID, lastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+		retError = e.createWorkflow(ctx, startRequest, msBuilder, createMode, prevRunID, lastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 	} else {
 		createMode := persistence.CreateWorkflowModeBrandNew
-		retError = e.createWorkflow(startRequest, msBuilder, createMode, "", 0, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+		retError = e.createWorkflow(ctx, startRequest, msBuilder, createMode, "", 0, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 	}
 
 	t, ok := retError.(*persistence.WorkflowExecutionAlreadyStartedError)
@@ -2503,6 +3007,10 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 
 // RemoveSignalMutableState remove the signal request id in signal_requested for deduplicate
 func (e *historyEngineImpl) RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
 	if err != nil {
@@ -2528,6 +3036,10 @@ func (e *historyEngineImpl) RemoveSignalMutableState(ctx context.Context, reques
 }
 
 func (e *historyEngineImpl) TerminateWorkflowExecution(ctx context.Context, terminateRequest *h.TerminateWorkflowExecutionRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(terminateRequest.DomainUUID)
 	if err != nil {
@@ -2541,9 +3053,12 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(ctx context.Context, term
 		RunId:      request.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, execution, true, false,
+	err = e.updateWorkflowExecution(ctx, domainID, execution, true, false,
 		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.IsWorkflowExecutionRunning() {
+				if isDuplicateTerminateRequest(msBuilder, request.GetRequestId()) {
+					return nil, errDuplicateTerminateRequest
+				}
 				return nil, ErrWorkflowCompleted
 			}
 
@@ -2558,10 +3073,107 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(ctx context.Context, term
 
 			return nil, nil
 		})
+	if err == errDuplicateTerminateRequest {
+		// workflow is already terminated by this very request, e.g. a retried operator action
+		return nil
+	}
+	return err
+}
+
+// isDuplicateTerminateRequest returns true if the closed workflow was terminated by a request with the given ID
+func isDuplicateTerminateRequest(msBuilder mutableState, requestID string) bool {
+	if requestID == "" || msBuilder.GetExecutionInfo().CloseStatus != persistence.WorkflowCloseStatusTerminated {
+		return false
+	}
+	event, ok := msBuilder.GetCompletionEvent()
+	return ok && event.WorkflowExecutionTerminatedEventAttributes.GetRequestId() == requestID
+}
+
+// PauseWorkflowExecution suspends decision scheduling and user timers of a running workflow until it is resumed.
+// Activities which are already scheduled keep running and their results are recorded as usual.
+func (e *historyEngineImpl) PauseWorkflowExecution(ctx context.Context, pauseRequest *h.PauseWorkflowExecutionRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
+
+	domainEntry, err := e.getActiveDomainEntry(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false, false,
+		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if msBuilder.IsWorkflowExecutionPaused() {
+				return nil, &workflow.BadRequestError{Message: "Workflow execution is already paused."}
+			}
+
+			if msBuilder.AddWorkflowExecutionPausedEvent(request) == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+
+			return nil, nil
+		})
+}
+
+// ResumeWorkflowExecution resumes a paused workflow, scheduling a decision and re-arming user timers which expired
+// while the workflow was paused.
+func (e *historyEngineImpl) ResumeWorkflowExecution(ctx context.Context, resumeRequest *h.ResumeWorkflowExecutionRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
+
+	domainEntry, err := e.getActiveDomainEntry(resumeRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := resumeRequest.ResumeRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
+		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if !msBuilder.IsWorkflowExecutionPaused() {
+				return nil, &workflow.BadRequestError{Message: "Workflow execution is not paused."}
+			}
+
+			if msBuilder.AddWorkflowExecutionResumedEvent(request) == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to resume workflow execution."}
+			}
+
+			var timerTasks []persistence.Task
+			tBuilder.loadUserTimers(msBuilder)
+			if tt := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); tt != nil {
+				timerTasks = append(timerTasks, tt)
+			}
+			return timerTasks, nil
+		})
 }
 
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(ctx context.Context, scheduleRequest *h.ScheduleDecisionTaskRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(scheduleRequest.DomainUUID)
 	if err != nil {
@@ -2601,6 +3213,10 @@ func (e *historyEngineImpl) ScheduleDecisionTask(ctx context.Context, scheduleRe
 
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(ctx context.Context, completionRequest *h.RecordChildExecutionCompletedRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
 
 	domainEntry, err := e.getActiveDomainEntry(completionRequest.DomainUUID)
 	if err != nil {
@@ -2652,14 +3268,82 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(ctx context.Context, c
 }
 
 func (e *historyEngineImpl) ReplicateEvents(ctx context.Context, replicateRequest *h.ReplicateEventsRequest) error {
-	return e.replicator.ApplyEvents(ctx, replicateRequest)
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
+
+	err := e.replicator.ApplyEvents(ctx, replicateRequest)
+	if _, ok := err.(*workflow.BadRequestError); ok && e.config.ReplicationDLQEnabled() {
+		return e.moveReplicationTaskToDLQ(ctx, replicateRequest, err)
+	}
+	return err
+}
+
+// moveReplicationTaskToDLQ parks replicated events which can not be applied in the DLQ of their source
+// cluster, so they no longer block the replication of this shard, returns the apply error if parking fails
+func (e *historyEngineImpl) moveReplicationTaskToDLQ(ctx context.Context, request *h.ReplicateEventsRequest, applyErr error) error {
+	logger := e.logger.WithTags(
+		tag.SourceCluster(request.GetSourceCluster()),
+		tag.WorkflowDomainID(request.GetDomainUUID()),
+		tag.WorkflowID(request.WorkflowExecution.GetWorkflowId()),
+		tag.WorkflowRunID(request.WorkflowExecution.GetRunId()),
+		tag.WorkflowFirstEventID(request.GetFirstEventId()),
+		tag.WorkflowNextEventID(request.GetNextEventId()),
+	)
+
+	taskID, err := e.shard.GetNextTransferTaskID()
+	if err != nil {
+		logger.Error("Fail to move replication task to DLQ.", tag.Error(err))
+		return applyErr
+	}
+	lastReplicationInfo := make(map[string]*persistence.ReplicationInfo, len(request.ReplicationInfo))
+	for clusterName, info := range request.ReplicationInfo {
+		lastReplicationInfo[clusterName] = &persistence.ReplicationInfo{
+			Version:     info.GetVersion(),
+			LastEventID: info.GetLastEventId(),
+		}
+	}
+
+	if err := e.executionManager.PutReplicationDLQTask(ctx, &persistence.PutReplicationDLQTaskRequest{
+		SourceClusterName: request.GetSourceCluster(),
+		TaskInfo: &persistence.ReplicationTaskInfo{
+			DomainID:            request.GetDomainUUID(),
+			WorkflowID:          request.WorkflowExecution.GetWorkflowId(),
+			RunID:               request.WorkflowExecution.GetRunId(),
+			TaskID:              taskID,
+			TaskType:            persistence.ReplicationTaskTypeHistory,
+			FirstEventID:        request.GetFirstEventId(),
+			NextEventID:         request.GetNextEventId(),
+			Version:             request.GetVersion(),
+			LastReplicationInfo: lastReplicationInfo,
+			EventStoreVersion:   request.GetEventStoreVersion(),
+			ResetWorkflow:       request.GetResetWorkflow(),
+		},
+	}); err != nil {
+		logger.Error("Fail to move replication task to DLQ.", tag.Error(err))
+		return applyErr
+	}
+	e.metricsClient.IncCounter(metrics.ReplicateHistoryEventsScope, metrics.TaskMovedToDLQCounter)
+	logger.Error("Fail to apply replication task, moved to DLQ.", tag.Error(applyErr), tag.OperationCritical)
+	return nil
 }
 
 func (e *historyEngineImpl) ReplicateRawEvents(ctx context.Context, replicateRequest *h.ReplicateRawEventsRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
+
 	return e.replicator.ApplyRawEvents(ctx, replicateRequest)
 }
 
 func (e *historyEngineImpl) SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
+
 	clusterName := request.GetSourceCluster()
 	now := time.Unix(0, request.GetTimestamp())
 
@@ -2674,10 +3358,243 @@ func (e *historyEngineImpl) SyncShardStatus(ctx context.Context, request *h.Sync
 }
 
 func (e *historyEngineImpl) SyncActivity(ctx context.Context, request *h.SyncActivityRequest) (retError error) {
+	if err := e.startOperation(); err != nil {
+		return err
+	}
+	defer e.finishOperation()
+
 	return e.replicator.SyncActivity(ctx, request)
 }
 
+// ReadTransferDLQ returns one page of the transfer tasks moved to the DLQ of this shard
+func (e *historyEngineImpl) ReadTransferDLQ(ctx context.Context, request *workflow.ReadTransferDLQRequest) (*workflow.ReadTransferDLQResponse, error) {
+	response, err := e.getTransferDLQTasks(ctx, request.InclusiveEndTaskID, request.MaximumPageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*workflow.TransferDLQTask, 0, len(response.Tasks))
+	for _, task := range response.Tasks {
+		tasks = append(tasks, &workflow.TransferDLQTask{
+			DomainId:            common.StringPtr(task.DomainID),
+			WorkflowId:          common.StringPtr(task.WorkflowID),
+			RunId:               common.StringPtr(task.RunID),
+			TaskId:              common.Int64Ptr(task.TaskID),
+			TaskType:            common.Int32Ptr(int32(task.TaskType)),
+			VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+			ScheduleId:          common.Int64Ptr(task.ScheduleID),
+			Version:             common.Int64Ptr(task.Version),
+		})
+	}
+	return &workflow.ReadTransferDLQResponse{
+		Tasks:         tasks,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// MergeTransferDLQ processes one page of the transfer tasks in the DLQ of this shard again,
+// tasks processed successfully are removed from the DLQ, failed ones are kept
+func (e *historyEngineImpl) MergeTransferDLQ(ctx context.Context, request *workflow.MergeTransferDLQRequest) (*workflow.MergeTransferDLQResponse, error) {
+	response, err := e.getTransferDLQTasks(ctx, request.InclusiveEndTaskID, request.MaximumPageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedCount := int32(0)
+	for _, task := range response.Tasks {
+		if err := e.txProcessor.ProcessDLQTask(task); err != nil {
+			e.logger.Warn("Fail to merge transfer task from DLQ.",
+				tag.ShardID(e.shard.GetShardID()),
+				tag.TaskID(task.TaskID),
+				tag.Error(err))
+			continue
+		}
+
+		if err := e.executionManager.RangeDeleteTransferDLQTasks(ctx, &persistence.RangeDeleteTransferDLQTasksRequest{
+			ExclusiveBeginTaskID: task.TaskID - 1,
+			InclusiveEndTaskID:   task.TaskID,
+		}); err != nil {
+			return nil, err
+		}
+		mergedCount++
+	}
+	return &workflow.MergeTransferDLQResponse{
+		MergedCount:   common.Int32Ptr(mergedCount),
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// DescribeShard returns the range ID, queue ack levels and history cache size of this shard
+func (e *historyEngineImpl) DescribeShard() *workflow.HistoryShardStatus {
+	return &workflow.HistoryShardStatus{
+		ShardId:                  common.Int32Ptr(int32(e.shard.GetShardID())),
+		RangeId:                  common.Int64Ptr(e.shard.GetRangeID()),
+		TransferAckLevel:         common.Int64Ptr(e.shard.GetTransferAckLevel()),
+		TimerAckLevel:            common.Int64Ptr(e.shard.GetTimerAckLevel().UnixNano()),
+		ReplicationAckLevel:      common.Int64Ptr(e.shard.GetReplicatorAckLevel()),
+		NumOfItemsInHistoryCache: common.Int32Ptr(int32(e.historyCache.Size())),
+	}
+}
+
+// PurgeTransferDLQ deletes the transfer tasks in the DLQ of this shard up to the given task ID
+func (e *historyEngineImpl) PurgeTransferDLQ(ctx context.Context, request *workflow.PurgeTransferDLQRequest) error {
+	inclusiveEndTaskID := int64(math.MaxInt64)
+	if request.InclusiveEndTaskID != nil {
+		inclusiveEndTaskID = request.GetInclusiveEndTaskID()
+	}
+
+	return e.executionManager.RangeDeleteTransferDLQTasks(ctx, &persistence.RangeDeleteTransferDLQTasksRequest{
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   inclusiveEndTaskID,
+	})
+}
+
+// ReadReplicationDLQ returns one page of the replication tasks from the source cluster moved to the DLQ of this shard
+func (e *historyEngineImpl) ReadReplicationDLQ(ctx context.Context, request *workflow.ReadReplicationDLQRequest) (*workflow.ReadReplicationDLQResponse, error) {
+	response, err := e.getReplicationDLQTasks(ctx, request.GetSourceCluster(), request.InclusiveEndTaskID,
+		request.MaximumPageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*workflow.ReplicationDLQTask, 0, len(response.Tasks))
+	for _, task := range response.Tasks {
+		tasks = append(tasks, &workflow.ReplicationDLQTask{
+			DomainId:     common.StringPtr(task.DomainID),
+			WorkflowId:   common.StringPtr(task.WorkflowID),
+			RunId:        common.StringPtr(task.RunID),
+			TaskId:       common.Int64Ptr(task.TaskID),
+			FirstEventId: common.Int64Ptr(task.FirstEventID),
+			NextEventId:  common.Int64Ptr(task.NextEventID),
+			Version:      common.Int64Ptr(task.Version),
+		})
+	}
+	return &workflow.ReadReplicationDLQResponse{
+		Tasks:         tasks,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// MergeReplicationDLQ replicates the events of one page of the replication tasks in the DLQ of this shard
+// again from the source cluster, tasks replicated successfully are removed from the DLQ, failed ones are kept
+func (e *historyEngineImpl) MergeReplicationDLQ(ctx context.Context, request *workflow.MergeReplicationDLQRequest) (*workflow.MergeReplicationDLQResponse, error) {
+	rereplicator, ok := e.historyRereplicators[request.GetSourceCluster()]
+	if !ok {
+		return nil, &workflow.BadRequestError{
+			Message: fmt.Sprintf("Source cluster %v is not a remote cluster.", request.GetSourceCluster()),
+		}
+	}
+	response, err := e.getReplicationDLQTasks(ctx, request.GetSourceCluster(), request.InclusiveEndTaskID,
+		request.MaximumPageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedCount := int32(0)
+	for _, task := range response.Tasks {
+		if err := rereplicator.SendMultiWorkflowHistory(task.DomainID, task.WorkflowID,
+			task.RunID, task.FirstEventID, task.RunID, task.NextEventID); err != nil {
+			e.logger.Warn("Fail to merge replication task from DLQ.",
+				tag.ShardID(e.shard.GetShardID()),
+				tag.SourceCluster(request.GetSourceCluster()),
+				tag.TaskID(task.TaskID),
+				tag.Error(err))
+			continue
+		}
+
+		if err := e.executionManager.RangeDeleteReplicationDLQTasks(ctx, &persistence.RangeDeleteReplicationDLQTasksRequest{
+			SourceClusterName:    request.GetSourceCluster(),
+			ExclusiveBeginTaskID: task.TaskID - 1,
+			InclusiveEndTaskID:   task.TaskID,
+		}); err != nil {
+			return nil, err
+		}
+		mergedCount++
+	}
+	return &workflow.MergeReplicationDLQResponse{
+		MergedCount:   common.Int32Ptr(mergedCount),
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// PurgeReplicationDLQ deletes the replication tasks from the source cluster in the DLQ of this shard up to the given task ID
+func (e *historyEngineImpl) PurgeReplicationDLQ(ctx context.Context, request *workflow.PurgeReplicationDLQRequest) error {
+	inclusiveEndTaskID := int64(math.MaxInt64)
+	if request.InclusiveEndTaskID != nil {
+		inclusiveEndTaskID = request.GetInclusiveEndTaskID()
+	}
+
+	return e.executionManager.RangeDeleteReplicationDLQTasks(ctx, &persistence.RangeDeleteReplicationDLQTasksRequest{
+		SourceClusterName:    request.GetSourceCluster(),
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   inclusiveEndTaskID,
+	})
+}
+
+// RemoveTask deletes a single transfer or timer task of this shard
+func (e *historyEngineImpl) RemoveTask(ctx context.Context, request *workflow.RemoveTaskRequest) error {
+	switch request.GetType() {
+	case common.TaskTypeTransfer:
+		return e.executionManager.CompleteTransferTask(ctx, &persistence.CompleteTransferTaskRequest{
+			TaskID: request.GetTaskID(),
+		})
+	case common.TaskTypeTimer:
+		return e.executionManager.CompleteTimerTask(ctx, &persistence.CompleteTimerTaskRequest{
+			VisibilityTimestamp: time.Unix(0, request.GetVisibilityTimestamp()),
+			TaskID:              request.GetTaskID(),
+		})
+	default:
+		return errInvalidTaskType
+	}
+}
+
+func (e *historyEngineImpl) getTransferDLQTasks(ctx context.Context, inclusiveEndTaskID *int64, pageSize *int32,
+	nextPageToken []byte) (*persistence.GetTransferDLQTasksResponse, error) {
+
+	maxReadLevel := int64(math.MaxInt64)
+	if inclusiveEndTaskID != nil {
+		maxReadLevel = *inclusiveEndTaskID
+	}
+	batchSize := defaultDLQPageSize
+	if pageSize != nil && *pageSize > 0 {
+		batchSize = int(*pageSize)
+	}
+
+	return e.executionManager.GetTransferDLQTasks(ctx, &persistence.GetTransferDLQTasksRequest{
+		ReadLevel:     0,
+		MaxReadLevel:  maxReadLevel,
+		BatchSize:     batchSize,
+		NextPageToken: nextPageToken,
+	})
+}
+
+func (e *historyEngineImpl) getReplicationDLQTasks(ctx context.Context, sourceCluster string, inclusiveEndTaskID *int64,
+	pageSize *int32, nextPageToken []byte) (*persistence.GetReplicationDLQTasksResponse, error) {
+
+	maxReadLevel := int64(math.MaxInt64)
+	if inclusiveEndTaskID != nil {
+		maxReadLevel = *inclusiveEndTaskID
+	}
+	batchSize := defaultDLQPageSize
+	if pageSize != nil && *pageSize > 0 {
+		batchSize = int(*pageSize)
+	}
+
+	return e.executionManager.GetReplicationDLQTasks(ctx, &persistence.GetReplicationDLQTasksRequest{
+		SourceClusterName: sourceCluster,
+		ReadLevel:         0,
+		MaxReadLevel:      maxReadLevel,
+		BatchSize:         batchSize,
+		NextPageToken:     nextPageToken,
+	})
+}
+
 func (e *historyEngineImpl) ResetWorkflowExecution(ctx context.Context, resetRequest *h.ResetWorkflowExecutionRequest) (response *workflow.ResetWorkflowExecutionResponse, retError error) {
+	if err := e.startOperation(); err != nil {
+		return nil, err
+	}
+	defer e.finishOperation()
+
 	return e.resetor.ResetWorkflowExecution(ctx, resetRequest)
 }
 
@@ -2700,6 +3617,10 @@ type updateWorkflowAction struct {
 
 func (e *historyEngineImpl) updateWorkflowExecutionWithAction(ctx context.Context, domainID string, execution workflow.WorkflowExecution,
 	action func(builder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error)) (retError error) {
+	span, ctx := tracing.StartSpan(ctx, "history.updateWorkflowExecution",
+		tracing.WorkflowTags(domainID, execution.GetWorkflowId(), execution.GetRunId())...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	context, release, err0 := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, execution)
 	if err0 != nil {
 		return err0
@@ -2708,7 +3629,10 @@ func (e *historyEngineImpl) updateWorkflowExecutionWithAction(ctx context.Contex
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err1 := context.loadWorkflowExecution()
+		if err := common.IsValidContext(ctx); err != nil {
+			return err
+		}
+		msBuilder, err1 := context.loadWorkflowExecution(ctx)
 		if err1 != nil {
 			return err1
 		}
@@ -2742,8 +3666,8 @@ Update_History_Loop:
 		}
 
 		if postActions.createDecision {
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the workflow is paused
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.IsWorkflowExecutionPaused() {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				if di == nil {
 					return &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -2770,8 +3694,9 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
+				tracing.LogConflict(ctx, attempt)
 				continue Update_History_Loop
 			}
 			return err
@@ -2779,7 +3704,7 @@ Update_History_Loop:
 		e.timerProcessor.NotifyNewTimers(e.currentClusterName, e.shard.GetCurrentTime(e.currentClusterName), timerTasks)
 		return nil
 	}
-	return ErrMaxAttemptsExceeded
+	return e.newMaxAttemptsExceededError(context)
 }
 
 func (e *historyEngineImpl) updateWorkflowExecution(ctx context.Context, domainID string, execution workflow.WorkflowExecution,
@@ -2868,17 +3793,69 @@ func (e *historyEngineImpl) createRecordDecisionTaskStartedResponse(domainID str
 	}
 	response.EventStoreVersion = common.Int32Ptr(msBuilder.GetEventStoreVersion())
 	response.BranchToken = msBuilder.GetCurrentBranch()
+	if e.isContinueAsNewSuggested(domainID, msBuilder) {
+		response.ContinueAsNewSuggested = common.BoolPtr(true)
+	}
 
 	return response
 }
 
-func (e *historyEngineImpl) deleteEvents(domainID string, execution workflow.WorkflowExecution, eventStoreVersion int32, branchToken []byte) {
+// isContinueAsNewSuggested tells whether the history has grown past the warning size or count limits of the domain,
+// so the worker can continue as new before the workflow gets terminated at the error limits
+func (e *historyEngineImpl) isContinueAsNewSuggested(domainID string, msBuilder mutableState) bool {
+	// the limits are configured per domain name, fall back to the global limits if the domain cannot be resolved
+	domainName := ""
+	if domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainID); err == nil {
+		domainName = domainEntry.GetInfo().Name
+	}
+	historyCount := int(msBuilder.GetNextEventID()) - 1
+	historySize := int(msBuilder.GetHistorySize())
+	return historySize > e.config.HistorySizeLimitWarn(domainName) || historyCount > e.config.HistoryCountLimitWarn(domainName)
+}
+
+// createRecordDecisionTaskStartedResponseForPoll also attaches the workflow state snapshot when the poller asked for it
+func (e *historyEngineImpl) createRecordDecisionTaskStartedResponseForPoll(domainID string, msBuilder mutableState,
+	di *decisionInfo, pollRequest *workflow.PollForDecisionTaskRequest) (*h.RecordDecisionTaskStartedResponse, error) {
+	response := e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di, pollRequest.GetIdentity())
+	if pollRequest.GetIncludeWorkflowStateSnapshot() {
+		snapshot, err := createWorkflowStateSnapshot(msBuilder)
+		if err != nil {
+			return nil, err
+		}
+		response.WorkflowStateSnapshot = snapshot
+	}
+	return response, nil
+}
+
+// createWorkflowStateSnapshot summarizes the mutable state so a worker can make decisions without replaying history
+func createWorkflowStateSnapshot(msBuilder mutableState) (*workflow.WorkflowStateSnapshot, error) {
+	pendingActivities, err := getPendingActivityInfos(msBuilder)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &workflow.WorkflowStateSnapshot{
+		PendingActivities: pendingActivities,
+	}
+	for _, ti := range msBuilder.GetPendingTimerInfos() {
+		snapshot.PendingTimers = append(snapshot.PendingTimers, &workflow.PendingTimerInfo{
+			TimerId:         common.StringPtr(ti.TimerID),
+			StartedEventId:  common.Int64Ptr(ti.StartedID),
+			ExpiryTimestamp: common.Int64Ptr(ti.ExpiryTime.UnixNano()),
+		})
+	}
+	if lastProcessedEvent := msBuilder.GetExecutionInfo().LastProcessedEvent; lastProcessedEvent != common.EmptyEventID {
+		snapshot.LastProcessedEventId = common.Int64Ptr(lastProcessedEvent)
+	}
+	return snapshot, nil
+}
+
+func (e *historyEngineImpl) deleteEvents(ctx context.Context, domainID string, execution workflow.WorkflowExecution, eventStoreVersion int32, branchToken []byte) {
 	// We created the history events but failed to create workflow execution, so cleanup the history which could cause
 	// us to leak history events which are never cleaned up. Cleaning up the events is absolutely safe here as they
 	// are always created for a unique run_id which is not visible beyond this call yet.
 	// TODO: Handle error on deletion of execution history
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
-		e.historyV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+		e.historyV2Mgr.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
 			BranchToken: branchToken,
 			ShardID:     common.IntPtr(e.shard.GetShardID()),
 		})
@@ -2890,30 +3867,90 @@ func (e *historyEngineImpl) deleteEvents(domainID string, execution workflow.Wor
 	}
 }
 
-func (e *historyEngineImpl) failDecision(context workflowExecutionContext, scheduleID, startedID int64,
-	cause workflow.DecisionTaskFailedCause, details []byte, request *workflow.RespondDecisionTaskCompletedRequest) (mutableState, error) {
+// failDecision also returns the ID of the first event of the batch the failure is written in
+func (e *historyEngineImpl) failDecision(ctx context.Context, context workflowExecutionContext, scheduleID, startedID int64,
+	cause workflow.DecisionTaskFailedCause, details []byte, request *workflow.RespondDecisionTaskCompletedRequest) (mutableState, int64, error) {
 	// Clear any updates we have accumulated so far
 	context.clear()
 
 	// Reload workflow execution so we can apply the decision task failure event
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	msBuilder.AddDecisionTaskFailedEvent(scheduleID, startedID, cause, nil, request.GetIdentity(), "", "", "", 0)
+	firstEventID := msBuilder.GetNextEventID()
+	msBuilder.AddDecisionTaskFailedEvent(scheduleID, startedID, cause, details, request.GetIdentity(), "", "", "", 0)
 
 	// Return new builder back to the caller for further updates
-	return msBuilder, nil
+	return msBuilder, firstEventID, nil
+}
+
+// failWorkflowWithRetry closes the workflow as failed, or continues it as new when its retry policy or cron schedule starts
+// it again, in which case the builder of the new run is returned
+func (e *historyEngineImpl) failWorkflowWithRetry(
+	ctx context.Context,
+	msBuilder mutableState,
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution workflow.WorkflowExecution,
+	decisionCompletedEventID int64,
+	failedAttributes *workflow.FailWorkflowExecutionDecisionAttributes,
+	eventStoreVersion int32,
+) (mutableState, error) {
+
+	backoffInterval := msBuilder.GetRetryBackoffDuration(failedAttributes.GetReason())
+	continueAsNewInitiator := workflow.ContinueAsNewInitiatorRetryPolicy
+	if backoffInterval == common.NoRetryBackoff {
+		backoffInterval = msBuilder.GetCronBackoffDuration()
+		continueAsNewInitiator = workflow.ContinueAsNewInitiatorCronSchedule
+	}
+
+	if backoffInterval == cron.NoBackoff {
+		// no retry or cron
+		if evt := msBuilder.AddFailWorkflowEvent(decisionCompletedEventID, failedAttributes); evt == nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to add fail workflow event."}
+		}
+		return nil, nil
+	}
+
+	// retry or cron with backoff
+	startEvent, err := getWorkflowStartedEvent(ctx, e.historyMgr, e.historyV2Mgr, msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), e.logger, domainEntry.GetInfo().ID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(), common.IntPtr(e.shard.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+
+	startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
+	continueAsNewAttributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        startAttributes.WorkflowType,
+		TaskList:                            startAttributes.TaskList,
+		RetryPolicy:                         startAttributes.RetryPolicy,
+		Input:                               startAttributes.Input,
+		ExecutionStartToCloseTimeoutSeconds: startAttributes.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      startAttributes.TaskStartToCloseTimeoutSeconds,
+		BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(backoffInterval.Seconds())),
+		Initiator:                           continueAsNewInitiator.Ptr(),
+		FailureReason:                       failedAttributes.Reason,
+		FailureDetails:                      failedAttributes.Details,
+		LastCompletionResult:                startAttributes.LastCompletionResult,
+		CronSchedule:                        common.StringPtr(msBuilder.GetExecutionInfo().CronSchedule),
+	}
+
+	_, continueAsNewBuilder, err := msBuilder.AddContinueAsNewEvent(decisionCompletedEventID, decisionCompletedEventID, domainEntry,
+		startAttributes.GetParentWorkflowDomain(), continueAsNewAttributes, eventStoreVersion)
+	if err != nil {
+		return nil, err
+	}
+	return continueAsNewBuilder, nil
 }
 
 func (e *historyEngineImpl) getTimerBuilder(we *workflow.WorkflowExecution) *timerBuilder {
-	log := e.logger.WithTags(tag.WorkflowID(we.GetWorkflowId()), tag.WorkflowRunID(we.GetRunId()))
-	return newTimerBuilder(e.shard.GetConfig(), log, clock.NewRealTimeSource())
+	logger := log.WithWorkflowIDs(e.logger, "", we.GetWorkflowId(), we.GetRunId())
+	return newTimerBuilder(e.shard.GetConfig(), logger, clock.NewRealTimeSource())
 }
 
-func (s *shardContextWrapper) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
-	resp, err := s.ShardContext.UpdateWorkflowExecution(request)
+func (s *shardContextWrapper) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (
+	*persistence.UpdateWorkflowExecutionResponse, error) {
+	resp, err := s.ShardContext.UpdateWorkflowExecution(ctx, request)
 	if err == nil {
 		s.txProcessor.NotifyNewTask(s.currentClusterName, request.TransferTasks)
 		if len(request.ReplicationTasks) > 0 {
@@ -2923,9 +3960,9 @@ func (s *shardContextWrapper) UpdateWorkflowExecution(request *persistence.Updat
 	return resp, err
 }
 
-func (s *shardContextWrapper) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
+func (s *shardContextWrapper) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (
 	*persistence.CreateWorkflowExecutionResponse, error) {
-	resp, err := s.ShardContext.CreateWorkflowExecution(request)
+	resp, err := s.ShardContext.CreateWorkflowExecution(ctx, request)
 	if err == nil {
 		s.txProcessor.NotifyNewTask(s.currentClusterName, request.TransferTasks)
 		if len(request.ReplicationTasks) > 0 {
@@ -2941,6 +3978,61 @@ func (s *shardContextWrapper) NotifyNewHistoryEvent(event *historyEventNotificat
 	return err
 }
 
+// applyActivityTaskListOverride fills in the task list of an activity scheduled without one,
+// using the routing configured for the activity type in the target domain data
+func applyActivityTaskListOverride(attributes *workflow.ScheduleActivityTaskDecisionAttributes, domainEntry *cache.DomainCacheEntry) {
+	if attributes == nil || attributes.TaskList.GetName() != "" {
+		return
+	}
+	if taskList, ok := common.GetActivityTaskListOverride(domainEntry.GetInfo().Data, attributes.ActivityType.GetName()); ok {
+		attributes.TaskList = &workflow.TaskList{Name: common.StringPtr(taskList)}
+	}
+}
+
+// validateDecisions checks the decisions which can not be applied to any workflow, it returns the cause the decision
+// task is failed with along with the error
+func validateDecisions(decisions []*workflow.Decision, maxDecisions int) (workflow.DecisionTaskFailedCause, error) {
+	if maxDecisions > 0 && len(decisions) > maxDecisions {
+		return workflow.DecisionTaskFailedCauseBadDecisions, &workflow.BadRequestError{
+			Message: fmt.Sprintf("Decision count %v exceeds limit %v.", len(decisions), maxDecisions),
+		}
+	}
+
+	activityIDs := make(map[string]int)
+	for i, d := range decisions {
+		if d == nil || d.DecisionType == nil {
+			return workflow.DecisionTaskFailedCauseBadDecisions, newDecisionBadRequestError(i, "DecisionType is not set.")
+		}
+		if !isKnownDecisionType(d.GetDecisionType()) {
+			return workflow.DecisionTaskFailedCauseBadDecisions,
+				newDecisionBadRequestError(i, fmt.Sprintf("Unknown decision type: %v.", d.GetDecisionType()))
+		}
+		if d.GetDecisionType() != workflow.DecisionTypeScheduleActivityTask || d.ScheduleActivityTaskDecisionAttributes == nil {
+			continue
+		}
+		activityID := d.ScheduleActivityTaskDecisionAttributes.GetActivityId()
+		if first, ok := activityIDs[activityID]; ok && activityID != "" {
+			return workflow.DecisionTaskFailedCauseBadScheduleActivityAttributes,
+				newDecisionBadRequestError(i, fmt.Sprintf("ActivityId %v is already used by decision at index %v.", activityID, first))
+		}
+		activityIDs[activityID] = i
+	}
+	return 0, nil
+}
+
+func isKnownDecisionType(decisionType workflow.DecisionType) bool {
+	for _, known := range workflow.DecisionType_Values() {
+		if decisionType == known {
+			return true
+		}
+	}
+	return false
+}
+
+func newDecisionBadRequestError(index int, message string) error {
+	return &workflow.BadRequestError{Message: fmt.Sprintf("Decision at index %v: %v", index, message)}
+}
+
 func validateActivityScheduleAttributes(attributes *workflow.ScheduleActivityTaskDecisionAttributes, wfTimeout int32, maxIDLengthLimit int) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "ScheduleActivityTaskDecisionAttributes is not set on decision."}
@@ -2966,6 +4058,14 @@ func validateActivityScheduleAttributes(attributes *workflow.ScheduleActivityTas
 		return &workflow.BadRequestError{Message: "ActivityID exceeds length limit."}
 	}
 
+	if !common.IsValidIDString(attributes.GetActivityId()) {
+		return &workflow.BadRequestError{Message: "ActivityID must be valid UTF-8 without control characters."}
+	}
+
+	if !common.IsValidIDString(attributes.TaskList.GetName()) {
+		return &workflow.BadRequestError{Message: "TaskList must be valid UTF-8 without control characters."}
+	}
+
 	if len(attributes.GetActivityType().GetName()) > maxIDLengthLimit {
 		return &workflow.BadRequestError{Message: "ActivityType exceeds length limit."}
 	}
@@ -3087,6 +4187,18 @@ func validateRecordMarkerAttributes(attributes *workflow.RecordMarkerDecisionAtt
 	return nil
 }
 
+// getRecordMarkerPayloadSize returns the size of the marker details together with its header fields,
+// since both are persisted in the marker recorded event
+func getRecordMarkerPayloadSize(attributes *workflow.RecordMarkerDecisionAttributes) int {
+	size := len(attributes.Details)
+	if attributes.Header != nil {
+		for key, value := range attributes.Header.Fields {
+			size += len(key) + len(value)
+		}
+	}
+	return size
+}
+
 func validateCompleteWorkflowExecutionAttributes(attributes *workflow.CompleteWorkflowExecutionDecisionAttributes) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "CompleteWorkflowExecutionDecisionAttributes is not set on decision."}
@@ -3224,6 +4336,10 @@ func validateStartChildExecutionAttributes(parentInfo *persistence.WorkflowExecu
 		return &workflow.BadRequestError{Message: "WorkflowId exceeds length limit."}
 	}
 
+	if !common.IsValidIDString(attributes.GetWorkflowId()) {
+		return &workflow.BadRequestError{Message: "WorkflowId must be valid UTF-8 without control characters."}
+	}
+
 	if len(attributes.WorkflowType.GetName()) > maxIDLengthLimit {
 		return &workflow.BadRequestError{Message: "WorkflowType exceeds length limit."}
 	}
@@ -3319,6 +4435,13 @@ func getActiveDomainEntryFromShard(shard ShardContext, domainUUID *string) (*cac
 	return domainEntry, nil
 }
 
+func validateDomainAcceptsNewExecutions(domainEntry *cache.DomainCacheEntry) error {
+	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
+		return ErrDomainDeprecated
+	}
+	return nil
+}
+
 func getScheduleID(activityID string, msBuilder mutableState) (int64, error) {
 	if activityID == "" {
 		return 0, &workflow.BadRequestError{Message: "Neither ActivityID nor ScheduleID is provided"}
@@ -3346,16 +4469,17 @@ func getStartRequest(domainID string,
 		RetryPolicy:                         request.RetryPolicy,
 		CronSchedule:                        request.CronSchedule,
 		Memo:                                request.Memo,
+		SearchAttributes:                    request.SearchAttributes,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
 	return startRequest
 }
 
-func getWorkflowStartedEvent(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, eventStoreVersion int32, branchToken []byte, logger log.Logger, domainID, workflowID, runID string, shardID *int) (*workflow.HistoryEvent, error) {
+func getWorkflowStartedEvent(ctx context.Context, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, eventStoreVersion int32, branchToken []byte, logger log.Logger, domainID, workflowID, runID string, shardID *int) (*workflow.HistoryEvent, error) {
 	var events []*workflow.HistoryEvent
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
-		response, err := historyV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		response, err := historyV2Mgr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 			BranchToken:   branchToken,
 			MinEventID:    common.FirstEventID,
 			MaxEventID:    common.FirstEventID + 1,
@@ -3388,7 +4512,7 @@ func getWorkflowStartedEvent(historyMgr persistence.HistoryManager, historyV2Mgr
 	}
 
 	if len(events) == 0 {
-		logger.WithTags(tag.WorkflowID(workflowID), tag.WorkflowRunID(runID))
+		logger = log.WithWorkflowIDs(logger, domainID, workflowID, runID)
 		logError(logger, errNoHistoryFound.Error(), errNoHistoryFound)
 		return nil, errNoHistoryFound
 	}
@@ -3441,7 +4565,7 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(prevStartRequestID,
 		msg := "Workflow execution already finished. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: reject duplicate workflow ID."
 		return getWorkflowAlreadyStartedError(msg, prevStartRequestID, execution.GetWorkflowId(), prevRunID)
 	default:
-		return &workflow.InternalServiceError{Message: "Failed to process start workflow reuse policy."}
+		return &workflow.BadRequestError{Message: "Invalid workflow ID reuse policy."}
 	}
 
 	return nil
@@ -66,6 +66,9 @@ type (
 			*workflow.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *h.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error
+		ResumeWorkflowExecution(ctx context.Context, request *h.ResumeWorkflowExecutionRequest) error
+		AddWorkflowExecutionAnnotation(ctx context.Context, request *h.AddWorkflowExecutionAnnotationRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *h.RecordChildExecutionCompletedRequest) error
@@ -73,6 +76,14 @@ type (
 		ReplicateRawEvents(ctx context.Context, request *h.ReplicateRawEventsRequest) error
 		SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
+		ReadTransferDLQ(ctx context.Context, request *workflow.ReadTransferDLQRequest) (*workflow.ReadTransferDLQResponse, error)
+		MergeTransferDLQ(ctx context.Context, request *workflow.MergeTransferDLQRequest) (*workflow.MergeTransferDLQResponse, error)
+		PurgeTransferDLQ(ctx context.Context, request *workflow.PurgeTransferDLQRequest) error
+		ReadReplicationDLQ(ctx context.Context, request *workflow.ReadReplicationDLQRequest) (*workflow.ReadReplicationDLQResponse, error)
+		MergeReplicationDLQ(ctx context.Context, request *workflow.MergeReplicationDLQRequest) (*workflow.MergeReplicationDLQResponse, error)
+		PurgeReplicationDLQ(ctx context.Context, request *workflow.PurgeReplicationDLQRequest) error
+		RemoveTask(ctx context.Context, request *workflow.RemoveTaskRequest) error
+		DescribeShard() *workflow.HistoryShardStatus
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -115,6 +126,7 @@ type (
 		NotifyNewTask(clusterName string, transferTasks []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		ProcessDLQTask(task *persistence.TransferTaskInfo) error
 	}
 
 	// TODO the timer queue processor and the one below, timer processor
@@ -148,5 +160,9 @@ type (
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		WatchHistoryEvent(identifier definition.WorkflowIdentifier) (string, chan *historyEventNotification, error)
 		UnwatchHistoryEvent(identifier definition.WorkflowIdentifier, subscriberID string) error
+		// WatchWorkflowClose returns a channel closed once the workflow is no longer running,
+		// the subscription is removed at that point without an unwatch
+		WatchWorkflowClose(identifier definition.WorkflowIdentifier) (string, <-chan struct{}, error)
+		UnwatchWorkflowClose(identifier definition.WorkflowIdentifier, subscriberID string)
 	}
 )
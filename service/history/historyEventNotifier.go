@@ -53,6 +53,10 @@ type (
 		// 1. expected number of subscriber per workflow is low, i.e. < 5
 		// 2. update to this map is already guarded by GetAndDo API provided by ConcurrentTxMap
 		eventsPubsubs collection.ConcurrentTxMap
+		// concurrent map with key workflowIdentifier, value map[string]chan struct{}.
+		// the channels are closed and the entry is removed once the workflow is no longer running,
+		// so subscribers only unwatch when they stop waiting before the close
+		closePubsubs collection.ConcurrentTxMap
 	}
 )
 
@@ -75,11 +79,11 @@ func newHistoryEventNotification(domainID string, workflowExecution *gen.Workflo
 
 func newHistoryEventNotifier(metrics metrics.Client, workflowIDToShardID func(string) int) *historyEventNotifierImpl {
 	hashFn := func(key interface{}) uint32 {
-		notification, ok := key.(historyEventNotification)
+		identifier, ok := key.(definition.WorkflowIdentifier)
 		if !ok {
 			return 0
 		}
-		return uint32(workflowIDToShardID(notification.id.WorkflowID))
+		return uint32(workflowIDToShardID(identifier.WorkflowID))
 	}
 	return &historyEventNotifierImpl{
 		metrics:    metrics,
@@ -90,6 +94,7 @@ func newHistoryEventNotifier(metrics metrics.Client, workflowIDToShardID func(st
 		workflowIDToShardID: workflowIDToShardID,
 
 		eventsPubsubs: collection.NewShardedConcurrentTxMap(1024, hashFn),
+		closePubsubs:  collection.NewShardedConcurrentTxMap(1024, hashFn),
 	}
 }
 
@@ -149,6 +154,46 @@ func (notifier *historyEventNotifierImpl) UnwatchHistoryEvent(
 	return nil
 }
 
+func (notifier *historyEventNotifierImpl) WatchWorkflowClose(
+	identifier definition.WorkflowIdentifier) (string, <-chan struct{}, error) {
+
+	channel := make(chan struct{})
+	subscriberID := uuid.New()
+	subscribers := map[string]chan struct{}{
+		subscriberID: channel,
+	}
+
+	_, _, err := notifier.closePubsubs.PutOrDo(identifier, subscribers, func(key interface{}, value interface{}) error {
+		subscribers := value.(map[string]chan struct{})
+
+		if _, ok := subscribers[subscriberID]; ok {
+			// UUID collision
+			return &gen.InternalServiceError{
+				Message: "Unable to watch on workflow execution close.",
+			}
+		}
+		subscribers[subscriberID] = channel
+		return nil
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return subscriberID, channel, nil
+}
+
+func (notifier *historyEventNotifierImpl) UnwatchWorkflowClose(
+	identifier definition.WorkflowIdentifier, subscriberID string) {
+
+	// the subscriber is already gone if the workflow closed in the meantime
+	notifier.closePubsubs.RemoveIf(identifier, func(key interface{}, value interface{}) bool {
+		subscribers := value.(map[string]chan struct{})
+		delete(subscribers, subscriberID)
+		return len(subscribers) == 0
+	})
+}
+
 func (notifier *historyEventNotifierImpl) dispatchHistoryEventNotification(event *historyEventNotification) {
 	identifier := event.id
 
@@ -161,12 +206,30 @@ func (notifier *historyEventNotifierImpl) dispatchHistoryEventNotification(event
 			select {
 			case channel <- event:
 			default:
-				// in case the channel is already filled with message
-				// this should NOT happen, unless there is a bug or high load
+				// the subscriber has not consumed the previous notification yet, replace it with
+				// this one, since the latest notification supersedes the older ones and must not
+				// be lost, e.g. the workflow close
+				select {
+				case <-channel:
+				default:
+				}
+				select {
+				case channel <- event:
+				default:
+				}
 			}
 		}
 		return nil
 	})
+
+	if !event.isWorkflowRunning {
+		notifier.closePubsubs.RemoveIf(identifier, func(key interface{}, value interface{}) bool {
+			for _, channel := range value.(map[string]chan struct{}) {
+				close(channel)
+			}
+			return true
+		})
+	}
 }
 
 func (notifier *historyEventNotifierImpl) enqueueHistoryEventNotification(event *historyEventNotification) {
@@ -97,6 +97,41 @@ func (s *historyEventNotifierSuite) TestSingleSubscriberWatchingEvents() {
 	s.Nil(err)
 }
 
+func (s *historyEventNotifierSuite) TestSubscriberReceivesLatestEvent() {
+	domainID := "domain ID"
+	execution := &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("workflow ID"),
+		RunId:      common.StringPtr("run ID"),
+	}
+	identifier := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowId(), execution.GetRunId())
+	newEvent := newHistoryEventNotification(domainID, execution, 3, 18, 5, true)
+	closeEvent := newHistoryEventNotification(domainID, execution, 18, 20, 5, false)
+
+	subscriberID, channel, err := s.historyEventNotifier.WatchHistoryEvent(identifier)
+	s.Nil(err)
+
+	// the subscriber does not consume the first notification before the workflow closes
+	s.historyEventNotifier.NotifyNewHistoryEvent(newEvent)
+	s.historyEventNotifier.NotifyNewHistoryEvent(closeEvent)
+
+	timerChan := time.NewTimer(time.Second * 2).C
+	for {
+		select {
+		case msg := <-channel:
+			if msg == newEvent {
+				continue
+			}
+			s.Equal(closeEvent, msg)
+		case <-timerChan:
+			s.Fail("close event is not delivered")
+		}
+		break
+	}
+
+	err = s.historyEventNotifier.UnwatchHistoryEvent(identifier, subscriberID)
+	s.Nil(err)
+}
+
 func (s *historyEventNotifierSuite) TestMultipleSubscriberWatchingEvents() {
 	domainID := "domain ID"
 	execution := &gen.WorkflowExecution{
@@ -140,3 +175,47 @@ func (s *historyEventNotifierSuite) TestMultipleSubscriberWatchingEvents() {
 	s.historyEventNotifier.NotifyNewHistoryEvent(historyEvent)
 	waitGroup.Wait()
 }
+
+func (s *historyEventNotifierSuite) TestWatchWorkflowClose() {
+	domainID := "domain ID"
+	execution := &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("workflow ID"),
+		RunId:      common.StringPtr("run ID"),
+	}
+	identifier := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowId(), execution.GetRunId())
+
+	subscriberID, closeCh, err := s.historyEventNotifier.WatchWorkflowClose(identifier)
+	s.Nil(err)
+
+	s.historyEventNotifier.NotifyNewHistoryEvent(newHistoryEventNotification(domainID, execution, 3, 18, 5, true))
+	select {
+	case <-closeCh:
+		s.Fail("close channel is closed while the workflow is running")
+	case <-time.NewTimer(time.Millisecond * 100).C:
+	}
+
+	s.historyEventNotifier.NotifyNewHistoryEvent(newHistoryEventNotification(domainID, execution, 18, 20, 5, false))
+	select {
+	case <-closeCh:
+	case <-time.NewTimer(time.Second * 2).C:
+		s.Fail("close channel is not closed after the workflow closed")
+	}
+
+	// the subscription is removed with the close, unwatching afterwards is a no-op
+	s.False(s.historyEventNotifier.closePubsubs.Contains(identifier))
+	s.historyEventNotifier.UnwatchWorkflowClose(identifier, subscriberID)
+}
+
+func (s *historyEventNotifierSuite) TestUnwatchWorkflowClose() {
+	identifier := definition.NewWorkflowIdentifier("domain ID", "workflow ID", "run ID")
+
+	subscriberID1, _, err := s.historyEventNotifier.WatchWorkflowClose(identifier)
+	s.Nil(err)
+	subscriberID2, _, err := s.historyEventNotifier.WatchWorkflowClose(identifier)
+	s.Nil(err)
+
+	s.historyEventNotifier.UnwatchWorkflowClose(identifier, subscriberID1)
+	s.True(s.historyEventNotifier.closePubsubs.Contains(identifier))
+	s.historyEventNotifier.UnwatchWorkflowClose(identifier, subscriberID2)
+	s.False(s.historyEventNotifier.closePubsubs.Contains(identifier))
+}
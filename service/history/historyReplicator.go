@@ -161,7 +161,7 @@ func (r *historyReplicator) SyncActivity(ctx context.Context, request *h.SyncAct
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
 			return err
@@ -255,7 +255,7 @@ func (r *historyReplicator) SyncActivity(ctx context.Context, request *h.SyncAct
 		timerTasks = append(timerTasks, tt)
 	}
 
-	return r.updateMutableStateWithTimer(context, msBuilder, now, timerTasks)
+	return r.updateMutableStateWithTimer(ctx, context, msBuilder, now, timerTasks)
 }
 
 func (r *historyReplicator) ApplyRawEvents(ctx context.Context, requestIn *h.ReplicateRawEventsRequest) (retError error) {
@@ -355,7 +355,7 @@ func (r *historyReplicator) ApplyEvents(ctx context.Context, request *h.Replicat
 	firstEvent := request.History.Events[0]
 	switch firstEvent.GetEventType() {
 	case shared.EventTypeWorkflowExecutionStarted:
-		_, err := context.loadWorkflowExecution()
+		_, err := context.loadWorkflowExecution(ctx)
 		if err == nil {
 			// Workflow execution already exist, looks like a duplicate start event, it is safe to ignore it
 			logger.Debug(fmt.Sprintf("Dropping stale replication task for start event."))
@@ -371,7 +371,7 @@ func (r *historyReplicator) ApplyEvents(ctx context.Context, request *h.Replicat
 	default:
 		// apply events, other than simple start workflow execution
 		// the continue as new + start workflow execution combination will also be processed here
-		msBuilder, err := context.loadWorkflowExecution()
+		msBuilder, err := context.loadWorkflowExecution(ctx)
 		if err != nil {
 			if _, ok := err.(*shared.EntityNotExistsError); !ok {
 				return err
@@ -471,7 +471,7 @@ func (r *historyReplicator) ApplyOtherEventsVersionChecking(ctx context.Context,
 		// Replication state is already on a higher version, we can drop this event
 		logger.Info("Dropping stale replication task.")
 		r.metricsClient.IncCounter(metrics.ReplicateHistoryEventsScope, metrics.StaleReplicationEventsCounter)
-		_, err = r.garbageCollectSignals(context, msBuilder, request.History.Events)
+		_, err = r.garbageCollectSignals(ctx, context, msBuilder, request.History.Events)
 		return nil, err
 	}
 
@@ -545,7 +545,7 @@ func (r *historyReplicator) ApplyOtherEventsVersionChecking(ctx context.Context,
 		return nil, ErrCorruptedReplicationInfo
 	}
 
-	err = r.flushEventsBuffer(context, msBuilder)
+	err = r.flushEventsBuffer(ctx, context, msBuilder)
 	if err != nil {
 		return nil, err
 	}
@@ -640,7 +640,7 @@ func (r *historyReplicator) ApplyReplicationTask(ctx context.Context, context wo
 			return err
 		}
 		// continueAsNew
-		err = context.appendFirstBatchHistoryForContinueAsNew(newRunStateBuilder, transactionID)
+		err = context.appendFirstBatchHistoryForContinueAsNew(ctx, newRunStateBuilder, transactionID)
 		if err != nil {
 			return err
 		}
@@ -658,7 +658,7 @@ func (r *historyReplicator) ApplyReplicationTask(ctx context.Context, context wo
 			return err2
 		}
 		now := time.Unix(0, lastEvent.GetTimestamp())
-		err = context.replicateWorkflowExecution(request, sBuilder.getTransferTasks(), sBuilder.getTimerTasks(), lastEvent.GetEventId(), transactionID, now)
+		err = context.replicateWorkflowExecution(ctx, request, sBuilder.getTransferTasks(), sBuilder.getTimerTasks(), lastEvent.GetEventId(), transactionID, now)
 	}
 
 	if err == nil {
@@ -700,7 +700,7 @@ func (r *historyReplicator) replicateWorkflowStarted(ctx context.Context, contex
 
 	var historySize int
 	if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-		historySize, err = r.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+		historySize, err = r.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 			IsNewBranch:   true,
 			Info:          historyGarbageCleanupInfo(domainID, execution.GetWorkflowId(), execution.GetRunId()),
 			BranchToken:   msBuilder.GetCurrentBranch(),
@@ -795,13 +795,13 @@ func (r *historyReplicator) replicateWorkflowStarted(ctx context.Context, contex
 		if !isBrandNew {
 			createRequest.CreateWorkflowMode = persistence.CreateWorkflowModeWorkflowIDReuse
 		}
-		_, err = r.shard.CreateWorkflowExecution(createRequest)
+		_, err = r.shard.CreateWorkflowExecution(ctx, createRequest)
 		return err
 	}
 	deleteHistory := func() {
 		// this function should be only called when we drop start workflow execution
 		if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-			r.shard.GetHistoryV2Manager().DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+			r.shard.GetHistoryV2Manager().DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
 				BranchToken: msBuilder.GetCurrentBranch(),
 				ShardID:     common.IntPtr(r.shard.GetShardID()),
 			})
@@ -907,7 +907,7 @@ func (r *historyReplicator) conflictResolutionTerminateCurrentRunningIfNotSelf(c
 	// cannot use history cache to get current workflow since there can be deadlock
 	domainID := msBuilder.GetExecutionInfo().DomainID
 	workflowID := msBuilder.GetExecutionInfo().WorkflowID
-	resp, err := r.shard.GetExecutionManager().GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+	resp, err := r.shard.GetExecutionManager().GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
 		DomainID:   domainID,
 		WorkflowID: workflowID,
 	})
@@ -958,7 +958,7 @@ func (r *historyReplicator) getCurrentWorkflowMutableState(ctx context.Context,
 		return nil, nil, nil, err
 	}
 
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		// no matter what error happen, we need to retry
 		release(err)
@@ -980,7 +980,7 @@ func (r *historyReplicator) terminateWorkflow(ctx context.Context, domainID stri
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(ctx)
 	if err != nil {
 		return err
 	}
@@ -1061,20 +1061,26 @@ func (r *historyReplicator) resetMutableState(ctx context.Context, context workf
 		return nil, nil
 	}
 
+	executionInfo := msBuilder.GetExecutionInfo()
+	// events after the reset point only exist on the losing branch and are dropped by the reset
+	discardedEvents := executionInfo.NextEventID - 1 - lastEventID
 	resolver := r.getNewConflictResolver(context, logger)
-	msBuilder, err = resolver.reset(currentRunID, uuid.New(), lastEventID, msBuilder.GetExecutionInfo())
+	msBuilder, err = resolver.reset(ctx, currentRunID, uuid.New(), lastEventID, executionInfo)
 	logger.Info("Completed Resetting of workflow execution.")
 	if err != nil {
 		return nil, err
 	}
+	if discardedEvents > 0 {
+		r.metricsClient.AddCounter(metrics.ReplicateHistoryEventsScope, metrics.HistoryConflictDiscardedEventsCounter, discardedEvents)
+	}
 	return msBuilder, nil
 }
 
-func (r *historyReplicator) updateMutableStateOnly(context workflowExecutionContext, msBuilder mutableState) error {
-	return r.updateMutableStateWithTimer(context, msBuilder, time.Time{}, nil)
+func (r *historyReplicator) updateMutableStateOnly(ctx context.Context, context workflowExecutionContext, msBuilder mutableState) error {
+	return r.updateMutableStateWithTimer(ctx, context, msBuilder, time.Time{}, nil)
 }
 
-func (r *historyReplicator) updateMutableStateWithTimer(context workflowExecutionContext, msBuilder mutableState, now time.Time, timerTasks []persistence.Task) error {
+func (r *historyReplicator) updateMutableStateWithTimer(ctx context.Context, context workflowExecutionContext, msBuilder mutableState, now time.Time, timerTasks []persistence.Task) error {
 	// Generate a transaction ID for appending events to history
 	transactionID, err := r.shard.GetNextTransferTaskID()
 	if err != nil {
@@ -1085,7 +1091,7 @@ func (r *historyReplicator) updateMutableStateWithTimer(context workflowExecutio
 	// so nothing on the replication state should be changed
 	lastWriteVersion := msBuilder.GetLastWriteVersion()
 	sourceCluster := r.clusterMetadata.ClusterNameForFailoverVersion(lastWriteVersion)
-	return context.updateHelper(nil, timerTasks, transactionID, now, false, nil, sourceCluster)
+	return context.updateHelper(ctx, nil, timerTasks, transactionID, now, false, nil, sourceCluster)
 }
 
 func (r *historyReplicator) notify(clusterName string, now time.Time, transferTasks []persistence.Task,
@@ -1114,7 +1120,7 @@ func (r *historyReplicator) deserializeBlob(blob *workflow.DataBlob) ([]*workflo
 	return historyEvents, nil
 }
 
-func (r *historyReplicator) flushEventsBuffer(context workflowExecutionContext, msBuilder mutableState) error {
+func (r *historyReplicator) flushEventsBuffer(ctx context.Context, context workflowExecutionContext, msBuilder mutableState) error {
 
 	if !msBuilder.IsWorkflowExecutionRunning() || !msBuilder.HasBufferedEvents() || !r.canModifyWorkflow(msBuilder) {
 		return nil
@@ -1135,10 +1141,10 @@ func (r *historyReplicator) flushEventsBuffer(context workflowExecutionContext,
 	if err != nil {
 		return err
 	}
-	return context.updateWorkflowExecution(nil, nil, transactionID)
+	return context.updateWorkflowExecution(ctx, nil, nil, transactionID)
 }
 
-func (r *historyReplicator) garbageCollectSignals(context workflowExecutionContext,
+func (r *historyReplicator) garbageCollectSignals(ctx context.Context, context workflowExecutionContext,
 	msBuilder mutableState, events []*workflow.HistoryEvent) (bool, error) {
 
 	// this function modify the mutable state passed in applying stale signals
@@ -1173,7 +1179,7 @@ func (r *historyReplicator) garbageCollectSignals(context workflowExecutionConte
 	if err != nil {
 		return false, err
 	}
-	return true, context.updateWorkflowExecution(nil, nil, transactionID)
+	return true, context.updateWorkflowExecution(ctx, nil, nil, transactionID)
 }
 
 func (r *historyReplicator) canModifyWorkflow(msBuilder mutableState) bool {
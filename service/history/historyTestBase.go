@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -229,6 +230,65 @@ func (s *TestShardContext) UpdateTransferClusterAckLevel(cluster string, ackLeve
 	defer s.Unlock()
 
 	s.shardInfo.ClusterTransferAckLevel[cluster] = ackLevel
+	delete(s.shardInfo.ClusterTransferReadLevel, cluster)
+	delete(s.shardInfo.ClusterTransferAckBitmap, cluster)
+	return nil
+}
+
+// GetTransferClusterQueueState test implementation
+func (s *TestShardContext) GetTransferClusterQueueState(cluster string) persistence.TransferQueueState {
+	s.RLock()
+	defer s.RUnlock()
+
+	ackLevel, ok := s.shardInfo.ClusterTransferAckLevel[cluster]
+	if !ok {
+		ackLevel = s.shardInfo.TransferAckLevel
+	}
+	state := persistence.TransferQueueState{
+		AckLevel:  ackLevel,
+		ReadLevel: ackLevel,
+	}
+	if readLevel, ok := s.shardInfo.ClusterTransferReadLevel[cluster]; ok && readLevel > ackLevel {
+		state.ReadLevel = readLevel
+		state.AckBitmap = s.shardInfo.ClusterTransferAckBitmap[cluster]
+	}
+	return state
+}
+
+// UpdateTransferClusterQueueState test implementation
+func (s *TestShardContext) UpdateTransferClusterQueueState(cluster string, state persistence.TransferQueueState) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardInfo.ClusterTransferAckLevel[cluster] = state.AckLevel
+	if s.shardInfo.ClusterTransferReadLevel == nil {
+		s.shardInfo.ClusterTransferReadLevel = make(map[string]int64)
+	}
+	s.shardInfo.ClusterTransferReadLevel[cluster] = state.ReadLevel
+	if s.shardInfo.ClusterTransferAckBitmap == nil {
+		s.shardInfo.ClusterTransferAckBitmap = make(map[string][]byte)
+	}
+	s.shardInfo.ClusterTransferAckBitmap[cluster] = state.AckBitmap
+	return nil
+}
+
+// GetVisibilityAckLevel test implementation
+func (s *TestShardContext) GetVisibilityAckLevel() int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.shardInfo.VisibilityAckLevel == 0 {
+		return s.shardInfo.TransferAckLevel
+	}
+	return s.shardInfo.VisibilityAckLevel
+}
+
+// UpdateVisibilityAckLevel test implementation
+func (s *TestShardContext) UpdateVisibilityAckLevel(ackLevel int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardInfo.VisibilityAckLevel = ackLevel
 	return nil
 }
 
@@ -361,13 +421,14 @@ func (s *TestShardContext) UpdateDomainNotificationVersion(domainNotificationVer
 }
 
 // CreateWorkflowExecution test implementation
-func (s *TestShardContext) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
+func (s *TestShardContext) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (
 	*persistence.CreateWorkflowExecutionResponse, error) {
-	return s.executionMgr.CreateWorkflowExecution(request)
+	return s.executionMgr.CreateWorkflowExecution(ctx, request)
 }
 
 // UpdateWorkflowExecution test implementation
-func (s *TestShardContext) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+func (s *TestShardContext) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (
+	*persistence.UpdateWorkflowExecutionResponse, error) {
 	// assign IDs for the timer tasks. They need to be assigned under shard lock.
 	clusterMetadata := s.GetService().GetClusterMetadata()
 	clusterName := clusterMetadata.GetCurrentClusterName()
@@ -392,7 +453,7 @@ func (s *TestShardContext) UpdateWorkflowExecution(request *persistence.UpdateWo
 		s.logger.Info(fmt.Sprintf("%v: TestShardContext: Assigning timer (timestamp: %v, seq: %v)",
 			time.Now().UTC(), visibilityTs, task.GetTaskID()))
 	}
-	resp, err := s.executionMgr.UpdateWorkflowExecution(request)
+	resp, err := s.executionMgr.UpdateWorkflowExecution(ctx, request)
 	return resp, err
 }
 
@@ -417,13 +478,13 @@ func (s *TestShardContext) GetTimerMaxReadLevel(cluster string) time.Time {
 }
 
 // ResetMutableState test implementation
-func (s *TestShardContext) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
-	return s.executionMgr.ResetMutableState(request)
+func (s *TestShardContext) ResetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error {
+	return s.executionMgr.ResetMutableState(ctx, request)
 }
 
 // ResetWorkflowExecution test implementation
-func (s *TestShardContext) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
-	return s.executionMgr.ResetWorkflowExecution(request)
+func (s *TestShardContext) ResetWorkflowExecution(ctx context.Context, request *persistence.ResetWorkflowExecutionRequest) error {
+	return s.executionMgr.ResetWorkflowExecution(ctx, request)
 }
 
 // AppendHistoryEvents test implementation
@@ -433,10 +494,10 @@ func (s *TestShardContext) AppendHistoryEvents(request *persistence.AppendHistor
 }
 
 // AppendHistoryV2Events append history V2 events
-func (s *TestShardContext) AppendHistoryV2Events(
+func (s *TestShardContext) AppendHistoryV2Events(ctx context.Context,
 	request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error) {
 	request.ShardID = common.IntPtr(s.shardID)
-	resp, err := s.historyV2Mgr.AppendHistoryNodes(request)
+	resp, err := s.historyV2Mgr.AppendHistoryNodes(ctx, request)
 	return resp.Size, err
 }
 
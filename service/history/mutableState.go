@@ -43,7 +43,7 @@ type (
 		DecisionTimeout int32
 		TaskList        string // This is only needed to communicate tasklist used after AddDecisionTaskScheduledEvent
 		Attempt         int64
-		Timestamp       int64
+		Timestamp       int64 // Time the decision was scheduled while it is pending, time a transient decision was started once started
 	}
 
 	mutableState interface {
@@ -89,6 +89,8 @@ type (
 		AddWorkflowExecutionCanceledEvent(int64, *workflow.CancelWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) *workflow.HistoryEvent
 		AddWorkflowExecutionStartedEvent(workflow.WorkflowExecution, *h.StartWorkflowExecutionRequest) *workflow.HistoryEvent
+		AddWorkflowExecutionPausedEvent(*workflow.PauseWorkflowExecutionRequest) *workflow.HistoryEvent
+		AddWorkflowExecutionResumedEvent(*workflow.ResumeWorkflowExecutionRequest) *workflow.HistoryEvent
 		AddWorkflowExecutionTerminatedEvent(*workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent
 		AfterAddDecisionTaskCompletedEvent(int64)
 		BeforeAddDecisionTaskCompletedEvent()
@@ -155,6 +157,7 @@ type (
 		IsCancelRequested() (bool, string)
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
+		IsWorkflowExecutionPaused() bool
 		IsWorkflowExecutionRunning() bool
 		Load(*persistence.WorkflowMutableState)
 		ReplicateActivityInfo(*h.SyncActivityRequest, bool) error
@@ -173,7 +176,7 @@ type (
 		ReplicateChildWorkflowExecutionTimedOutEvent(*workflow.HistoryEvent)
 		ReplicateDecisionTaskCompletedEvent(int64, int64)
 		ReplicateDecisionTaskFailedEvent()
-		ReplicateDecisionTaskScheduledEvent(int64, int64, string, int32, int64) *decisionInfo
+		ReplicateDecisionTaskScheduledEvent(int64, int64, string, int32, int64, int64) *decisionInfo
 		ReplicateDecisionTaskStartedEvent(*decisionInfo, int64, int64, int64, string, int64) *decisionInfo
 		ReplicateDecisionTaskTimedOutEvent(workflow.TimeoutType)
 		ReplicateExternalWorkflowExecutionCancelRequested(*workflow.HistoryEvent)
@@ -195,6 +198,8 @@ type (
 		ReplicateWorkflowExecutionFailedEvent(int64, *workflow.HistoryEvent)
 		ReplicateWorkflowExecutionSignaled(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionStartedEvent(string, *string, workflow.WorkflowExecution, string, *workflow.HistoryEvent)
+		ReplicateWorkflowExecutionPausedEvent(*workflow.HistoryEvent)
+		ReplicateWorkflowExecutionResumedEvent(*workflow.HistoryEvent)
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *workflow.HistoryEvent)
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *workflow.HistoryEvent)
 		ResetSnapshot(string) *persistence.ResetMutableStateRequest
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -1264,6 +1265,11 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	return e.executionInfo.State != persistence.WorkflowStateCompleted
 }
 
+// IsWorkflowExecutionPaused returns true if the workflow is running but its decisions and user timers are suspended
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.State == persistence.WorkflowStatePaused
+}
+
 func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	if e.executionInfo.CancelRequested {
 		return e.executionInfo.CancelRequested, e.executionInfo.CancelRequestID
@@ -1331,6 +1337,13 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(d
 		CronSchedule:                        attributes.CronSchedule,
 	}
 
+	// the continue as new decision has no search attributes, the new run keeps those of the previous run
+	previousStartEvent, ok := previousExecutionState.GetStartEvent()
+	if !ok {
+		return nil
+	}
+	createRequest.SearchAttributes = previousStartEvent.WorkflowExecutionStartedEventAttributes.SearchAttributes
+
 	req := &h.StartWorkflowExecutionRequest{
 		DomainUUID:                      common.StringPtr(domainID),
 		StartRequest:                    createRequest,
@@ -1474,13 +1487,24 @@ func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() *decisionInfo {
 		}
 	}
 
+	if e.executionInfo.DecisionAttempt > 0 && e.executionInfo.DecisionAttempt >= int64(e.config.DecisionRetryCriticalAttempts()) {
+		e.shard.GetMetricsClient().IncCounter(metrics.HistoryScheduleDecisionTaskScope, metrics.DecisionRetryCriticalCounter)
+		e.logger.Warn("Decision keeps failing, backing off its retries.",
+			tag.WorkflowDomainID(e.executionInfo.DomainID),
+			tag.WorkflowID(e.executionInfo.WorkflowID),
+			tag.WorkflowRunID(e.executionInfo.RunID),
+			tag.Attempt(int32(e.executionInfo.DecisionAttempt)))
+	}
+
 	var newDecisionEvent *workflow.HistoryEvent
 	scheduleID := e.GetNextEventID() // we will generate the schedule event later for repeatedly failing decisions
+	scheduledTimestamp := time.Now().UnixNano()
 	// Avoid creating new history events when decisions are continuously failing
 	if e.executionInfo.DecisionAttempt == 0 {
 		newDecisionEvent = e.hBuilder.AddDecisionTaskScheduledEvent(taskList, startToCloseTimeoutSeconds,
 			e.executionInfo.DecisionAttempt)
 		scheduleID = newDecisionEvent.GetEventId()
+		scheduledTimestamp = newDecisionEvent.GetTimestamp()
 	}
 
 	return e.ReplicateDecisionTaskScheduledEvent(
@@ -1489,6 +1513,7 @@ func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() *decisionInfo {
 		taskList,
 		startToCloseTimeoutSeconds,
 		e.executionInfo.DecisionAttempt,
+		scheduledTimestamp,
 	)
 }
 
@@ -1522,7 +1547,7 @@ func (e *mutableStateBuilder) ReplicateTransientDecisionTaskScheduled() *decisio
 }
 
 func (e *mutableStateBuilder) ReplicateDecisionTaskScheduledEvent(version, scheduleID int64, taskList string,
-	startToCloseTimeoutSeconds int32, attempt int64) *decisionInfo {
+	startToCloseTimeoutSeconds int32, attempt int64, scheduledTimestamp int64) *decisionInfo {
 	di := &decisionInfo{
 		Version:         version,
 		ScheduleID:      scheduleID,
@@ -1531,6 +1556,7 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskScheduledEvent(version, sched
 		DecisionTimeout: startToCloseTimeoutSeconds,
 		TaskList:        taskList,
 		Attempt:         attempt,
+		Timestamp:       scheduledTimestamp,
 	}
 
 	e.UpdateDecision(di)
@@ -1584,7 +1610,10 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskStartedEvent(di *decisionInfo
 		di, _ = e.GetPendingDecision(scheduleID)
 	}
 
-	e.executionInfo.State = persistence.WorkflowStateRunning
+	// a decision dispatched before the workflow was paused can still be started, keep the paused state
+	if e.executionInfo.State != persistence.WorkflowStatePaused {
+		e.executionInfo.State = persistence.WorkflowStateRunning
+	}
 	// Update mutable decision state
 	di = &decisionInfo{
 		Version:         version,
@@ -1618,6 +1647,7 @@ func (e *mutableStateBuilder) BeforeAddDecisionTaskCompletedEvent() {
 
 func (e *mutableStateBuilder) AfterAddDecisionTaskCompletedEvent(startedID int64) {
 	e.executionInfo.LastProcessedEvent = startedID
+	e.executionInfo.DecisionFailureCount = 0
 }
 
 func (e *mutableStateBuilder) AddDecisionTaskCompletedEvent(scheduleEventID, startedEventID int64,
@@ -1744,10 +1774,14 @@ func (e *mutableStateBuilder) AddDecisionTaskFailedEvent(scheduleEventID int64,
 	}
 
 	e.ReplicateDecisionTaskFailedEvent()
+	if isBadDecisionCause(cause) {
+		e.executionInfo.DecisionFailureCount++
+	}
 
 	// always clear decision attempt for reset
 	if cause == workflow.DecisionTaskFailedCauseResetWorkflow {
 		e.executionInfo.DecisionAttempt = 0
+		e.executionInfo.DecisionFailureCount = 0
 	}
 	return event
 }
@@ -1756,6 +1790,22 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskFailedEvent() {
 	e.FailDecision(true)
 }
 
+// isBadDecisionCause returns whether the decision task failed because the decisions of the worker are invalid, as
+// opposed to new events coming in, a failure reported by the worker or a failure forced by the server
+func isBadDecisionCause(cause workflow.DecisionTaskFailedCause) bool {
+	switch cause {
+	case workflow.DecisionTaskFailedCauseUnhandledDecision,
+		workflow.DecisionTaskFailedCauseResetStickyTasklist,
+		workflow.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure,
+		workflow.DecisionTaskFailedCauseForceCloseDecision,
+		workflow.DecisionTaskFailedCauseFailoverCloseDecision,
+		workflow.DecisionTaskFailedCauseResetWorkflow:
+		return false
+	default:
+		return true
+	}
+}
+
 func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID int64,
 	attributes *workflow.ScheduleActivityTaskDecisionAttributes) (*workflow.HistoryEvent, *persistence.ActivityInfo) {
 	if ai, ok := e.GetActivityInfo(e.GetNextEventID()); ok {
@@ -2478,6 +2528,48 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTerminatedEvent(firstEve
 	e.writeEventToCache(event)
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	request *workflow.PauseWorkflowExecutionRequest) *workflow.HistoryEvent {
+	if !e.IsWorkflowExecutionRunning() || e.IsWorkflowExecutionPaused() {
+		e.logger.Warn("Invalid history builder state for action",
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowActionWorkflowPaused,
+			tag.WorkflowState(e.executionInfo.State))
+		return nil
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(request)
+	e.ReplicateWorkflowExecutionPausedEvent(event)
+
+	return event
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(event *workflow.HistoryEvent) {
+	e.executionInfo.State = persistence.WorkflowStatePaused
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionResumedEvent(
+	request *workflow.ResumeWorkflowExecutionRequest) *workflow.HistoryEvent {
+	if !e.IsWorkflowExecutionPaused() {
+		e.logger.Warn("Invalid history builder state for action",
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowActionWorkflowResumed,
+			tag.WorkflowState(e.executionInfo.State))
+		return nil
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionResumedEvent(request)
+	e.ReplicateWorkflowExecutionResumedEvent(event)
+
+	return event
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionResumedEvent(event *workflow.HistoryEvent) {
+	e.executionInfo.State = persistence.WorkflowStateRunning
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 	signalName string, input []byte, identity string) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -293,3 +294,54 @@ func (s *mutableStateSuite) TestTrimEvents() {
 		},
 	}, output)
 }
+
+func (s *mutableStateSuite) TestIsBadDecisionCause() {
+	s.True(isBadDecisionCause(workflow.DecisionTaskFailedCauseBadScheduleActivityAttributes))
+	s.True(isBadDecisionCause(workflow.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes))
+	s.True(isBadDecisionCause(workflow.DecisionTaskFailedCauseBadDecisions))
+	s.False(isBadDecisionCause(workflow.DecisionTaskFailedCauseUnhandledDecision))
+	s.False(isBadDecisionCause(workflow.DecisionTaskFailedCauseResetStickyTasklist))
+	s.False(isBadDecisionCause(workflow.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure))
+	s.False(isBadDecisionCause(workflow.DecisionTaskFailedCauseForceCloseDecision))
+	s.False(isBadDecisionCause(workflow.DecisionTaskFailedCauseFailoverCloseDecision))
+	s.False(isBadDecisionCause(workflow.DecisionTaskFailedCauseResetWorkflow))
+}
+
+func (s *mutableStateSuite) TestContinueAsNewKeepsSearchAttributes() {
+	domainID := "some random domain ID"
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr("some random run ID"),
+	}
+	s.msBuilder.executionInfo.DomainID = domainID
+	s.msBuilder.executionInfo.WorkflowID = execution.GetWorkflowId()
+	s.msBuilder.executionInfo.RunID = execution.GetRunId()
+	s.msBuilder.executionInfo.TaskList = "some random task list"
+	s.msBuilder.executionInfo.WorkflowTypeName = "some random workflow type"
+
+	searchAttributes := &workflow.SearchAttributes{
+		IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"some random value"`)},
+	}
+	s.mockEventsCache.On("getEvent", domainID, execution.GetWorkflowId(), execution.GetRunId(),
+		common.FirstEventID, common.FirstEventID, mock.Anything, mock.Anything).Return(&workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(common.FirstEventID),
+		EventType: workflow.EventTypeWorkflowExecutionStarted.Ptr(),
+		WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+			SearchAttributes: searchAttributes,
+		},
+	}, nil).Once()
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	newStateBuilder := newMutableStateBuilder(cluster.TestCurrentClusterName, s.mockShard, s.mockEventsCache, s.logger)
+	newExecution := workflow.WorkflowExecution{
+		WorkflowId: execution.WorkflowId,
+		RunId:      common.StringPtr("some random new run ID"),
+	}
+	startedEvent := newStateBuilder.addWorkflowExecutionStartedEventForContinueAsNew(domainID, nil, newExecution, s.msBuilder,
+		&workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		})
+	s.NotNil(startedEvent)
+	s.Equal(searchAttributes, startedEvent.WorkflowExecutionStartedEventAttributes.SearchAttributes)
+	s.mockEventsCache.AssertExpectations(s.T())
+}
@@ -28,9 +28,11 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/collection"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 )
 
 type (
@@ -46,17 +48,26 @@ type (
 		logger        log.Logger
 		metricsClient metrics.Client
 		finishedChan  chan struct{}
+		// when set, the read level and the tasks completed above the ack level are checkpointed as well
+		updateQueueState func(state persistence.TransferQueueState) error
 
 		sync.RWMutex
 		outstandingTasks map[int64]bool
 		readLevel        int64
 		ackLevel         int64
 		isReadFinished   bool
+		// tasks completed above the ack level before the processor restarted, these are
+		// acked when read again instead of being dispatched a second time
+		completedTasks    map[int64]struct{}
+		restoredReadLevel int64
 	}
 )
 
 const (
 	warnPendingTasks = 2000
+	// max size in bytes of the checkpointed bitmap of tasks completed above the ack level,
+	// completed tasks not fitting in the bitmap are processed again after a restart
+	maxQueueAckBitmapSize = 8 * 1024
 )
 
 func newQueueAckMgr(shard ShardContext, options *QueueProcessorOptions, processor processor, ackLevel int64, logger log.Logger) *queueAckMgrImpl {
@@ -75,6 +86,17 @@ func newQueueAckMgr(shard ShardContext, options *QueueProcessorOptions, processo
 	}
 }
 
+func newQueueAckMgrWithState(shard ShardContext, options *QueueProcessorOptions, processor processor,
+	state persistence.TransferQueueState, updateQueueState func(state persistence.TransferQueueState) error,
+	logger log.Logger) *queueAckMgrImpl {
+
+	a := newQueueAckMgr(shard, options, processor, state.AckLevel, logger)
+	a.updateQueueState = updateQueueState
+	a.completedTasks = decodeQueueAckBitmap(state.AckLevel, state.AckBitmap)
+	a.restoredReadLevel = state.ReadLevel
+	return a
+}
+
 func newQueueFailoverAckMgr(shard ShardContext, options *QueueProcessorOptions, processor processor, ackLevel int64, logger log.Logger) *queueAckMgrImpl {
 
 	return &queueAckMgrImpl{
@@ -115,6 +137,12 @@ func (a *queueAckMgrImpl) readQueueTasks() ([]queueTaskInfo, bool, error) {
 		a.isReadFinished = true
 	}
 
+	filterCompleted := len(a.completedTasks) > 0
+	dispatchTasks := tasks
+	if filterCompleted {
+		dispatchTasks = make([]queueTaskInfo, 0, len(tasks))
+	}
+
 TaskFilterLoop:
 	for _, task := range tasks {
 		_, isLoaded := a.outstandingTasks[task.GetTaskID()]
@@ -131,10 +159,26 @@ TaskFilterLoop:
 		}
 		a.logger.Debug(fmt.Sprintf("Moving read level: %v", task.GetTaskID()))
 		a.readLevel = task.GetTaskID()
+		if !filterCompleted {
+			a.outstandingTasks[task.GetTaskID()] = false
+			continue TaskFilterLoop
+		}
+
+		if _, ok := a.completedTasks[task.GetTaskID()]; ok {
+			// completed before restart, do not process it again
+			delete(a.completedTasks, task.GetTaskID())
+			a.outstandingTasks[task.GetTaskID()] = true
+			continue TaskFilterLoop
+		}
 		a.outstandingTasks[task.GetTaskID()] = false
+		dispatchTasks = append(dispatchTasks, task)
+	}
+
+	if a.readLevel >= a.restoredReadLevel {
+		a.completedTasks = nil
 	}
 
-	return tasks, morePage, nil
+	return dispatchTasks, morePage, nil
 }
 
 func (a *queueAckMgrImpl) completeQueueTask(taskID int64) {
@@ -188,6 +232,8 @@ func (a *queueAckMgrImpl) updateQueueAckLevel() {
 		a.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTransferActivePendingTasksTimer, time.Duration(pendingTasks))
 	case metrics.TransferStandbyQueueProcessorScope:
 		a.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTransferStandbyPendingTasksTimer, time.Duration(pendingTasks))
+	case metrics.VisibilityQueueProcessorScope:
+		a.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoVisibilityPendingTasksTimer, time.Duration(pendingTasks))
 	}
 
 MoveAckLevelLoop:
@@ -213,9 +259,69 @@ MoveAckLevelLoop:
 		return
 	}
 
+	updateQueueState := a.updateQueueState
+	var state persistence.TransferQueueState
+	if updateQueueState != nil {
+		state = a.getQueueStateLocked()
+	}
 	a.Unlock()
-	if err := a.processor.updateAckLevel(ackLevel); err != nil {
+
+	var err error
+	if updateQueueState != nil {
+		err = updateQueueState(state)
+	} else {
+		err = a.processor.updateAckLevel(ackLevel)
+	}
+	if err != nil {
 		a.metricsClient.IncCounter(a.options.MetricScope, metrics.AckLevelUpdateFailedCounter)
 		a.logger.Error("Error updating ack level for shard", tag.Error(err), tag.OperationFailed)
 	}
 }
+
+func (a *queueAckMgrImpl) getQueueStateLocked() persistence.TransferQueueState {
+	var completedTaskIDs []int64
+	for taskID, acked := range a.outstandingTasks {
+		if acked {
+			completedTaskIDs = append(completedTaskIDs, taskID)
+		}
+	}
+	// tasks completed before restart which are not read again yet are still completed
+	for taskID := range a.completedTasks {
+		completedTaskIDs = append(completedTaskIDs, taskID)
+	}
+
+	return persistence.TransferQueueState{
+		AckLevel:  a.ackLevel,
+		ReadLevel: collection.MaxInt64(a.readLevel, a.restoredReadLevel),
+		AckBitmap: encodeQueueAckBitmap(a.ackLevel, completedTaskIDs),
+	}
+}
+
+// encodeQueueAckBitmap sets bit i for task ID ackLevel+1+i, task IDs not fitting in maxQueueAckBitmapSize are dropped
+func encodeQueueAckBitmap(ackLevel int64, taskIDs []int64) []byte {
+	var bitmap []byte
+	for _, taskID := range taskIDs {
+		offset := taskID - ackLevel - 1
+		if offset < 0 || offset >= maxQueueAckBitmapSize*8 {
+			continue
+		}
+		index := int(offset / 8)
+		if index >= len(bitmap) {
+			bitmap = append(bitmap, make([]byte, index+1-len(bitmap))...)
+		}
+		bitmap[index] |= 1 << uint(offset%8)
+	}
+	return bitmap
+}
+
+func decodeQueueAckBitmap(ackLevel int64, bitmap []byte) map[int64]struct{} {
+	taskIDs := make(map[int64]struct{})
+	for index, b := range bitmap {
+		for bit := uint(0); bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				taskIDs[ackLevel+1+int64(index*8)+int64(bit)] = struct{}{}
+			}
+		}
+	}
+	return taskIDs
+}
@@ -21,10 +21,12 @@
 package history
 
 import (
+	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/client"
@@ -297,6 +299,154 @@ func (s *queueAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 	s.Equal(taskID3, s.queueAckMgr.getQueueAckLevel())
 }
 
+func (s *queueAckMgrSuite) TestReadCompleteUpdate_WithQueueState() {
+	var persisted p.TransferQueueState
+	updateQueueState := func(state p.TransferQueueState) error {
+		persisted = state
+		return nil
+	}
+	s.queueAckMgr = newQueueAckMgrWithState(s.mockShard, &QueueProcessorOptions{
+		MetricScope: metrics.TransferActiveQueueProcessorScope,
+	}, s.mockProcessor, p.TransferQueueState{AckLevel: 50, ReadLevel: 61, AckBitmap: []byte{0x00, 0x01}}, updateQueueState, s.logger)
+
+	// task 59 is completed before restart
+	tasksInput := []queueTaskInfo{
+		&p.TransferTaskInfo{TaskID: 52},
+		&p.TransferTaskInfo{TaskID: 55},
+	}
+	s.mockProcessor.On("readTasks", int64(50)).Return(tasksInput, true, nil).Once()
+
+	tasksOutput, _, err := s.queueAckMgr.readQueueTasks()
+	s.Nil(err)
+	s.Equal(tasksInput, tasksOutput)
+
+	s.queueAckMgr.completeQueueTask(55)
+	s.queueAckMgr.updateQueueAckLevel()
+	s.Equal(p.TransferQueueState{AckLevel: 50, ReadLevel: 61, AckBitmap: []byte{0x10, 0x01}}, persisted)
+
+	// restart from the checkpoint, tasks 55 and 59 are acked without being dispatched again
+	s.queueAckMgr = newQueueAckMgrWithState(s.mockShard, &QueueProcessorOptions{
+		MetricScope: metrics.TransferActiveQueueProcessorScope,
+	}, s.mockProcessor, persisted, updateQueueState, s.logger)
+	tasksInput = []queueTaskInfo{
+		&p.TransferTaskInfo{TaskID: 52},
+		&p.TransferTaskInfo{TaskID: 55},
+		&p.TransferTaskInfo{TaskID: 59},
+		&p.TransferTaskInfo{TaskID: 61},
+		&p.TransferTaskInfo{TaskID: 65},
+	}
+	s.mockProcessor.On("readTasks", int64(50)).Return(tasksInput, false, nil).Once()
+
+	tasksOutput, _, err = s.queueAckMgr.readQueueTasks()
+	s.Nil(err)
+	s.Equal([]queueTaskInfo{tasksInput[0], tasksInput[3], tasksInput[4]}, tasksOutput)
+
+	s.queueAckMgr.completeQueueTask(52)
+	s.queueAckMgr.updateQueueAckLevel()
+	s.Equal(p.TransferQueueState{AckLevel: 59, ReadLevel: 65}, persisted)
+}
+
+// TestRestartRecovery_NoTaskLostOrReapplied simulates crashes at arbitrary points while reading,
+// completing and checkpointing tasks, and verifies every task is applied, and only tasks completed
+// after the last checkpoint before a crash are applied again.
+func (s *queueAckMgrSuite) TestRestartRecovery_NoTaskLostOrReapplied() {
+	batchSize := 10
+	for seed := int64(0); seed < 20; seed++ {
+		rnd := rand.New(rand.NewSource(seed))
+
+		// task IDs are sparse since the shard allocates them for all task types
+		var taskIDs []int64
+		taskID := int64(0)
+		for i := 0; i < 200; i++ {
+			taskID += 1 + rnd.Int63n(5)
+			taskIDs = append(taskIDs, taskID)
+		}
+		lastTaskID := taskIDs[len(taskIDs)-1]
+
+		readTasks := func(readLevel int64) []queueTaskInfo {
+			var tasks []queueTaskInfo
+			for _, id := range taskIDs {
+				if id > readLevel && len(tasks) < batchSize {
+					tasks = append(tasks, &p.TransferTaskInfo{TaskID: id})
+				}
+			}
+			return tasks
+		}
+		processor := &MockProcessor{}
+		processor.On("readTasks", mock.Anything).Return(
+			readTasks,
+			func(readLevel int64) bool {
+				tasks := readTasks(readLevel)
+				return len(tasks) > 0 && tasks[len(tasks)-1].GetTaskID() < lastTaskID
+			},
+			nil,
+		)
+
+		var persisted p.TransferQueueState
+		applied := make(map[int64]int)
+		allowedReapply := make(map[int64]int)
+		newAckMgr := func() *queueAckMgrImpl {
+			return newQueueAckMgrWithState(s.mockShard, &QueueProcessorOptions{
+				MetricScope: metrics.TransferActiveQueueProcessorScope,
+			}, processor, persisted, func(state p.TransferQueueState) error {
+				persisted = state
+				return nil
+			}, s.logger)
+		}
+
+		for round := 0; round < 20; round++ {
+			ackMgr := newAckMgr()
+			var pending []int64
+			uncheckpointed := make(map[int64]struct{})
+			crashAfter := 1 + rnd.Intn(60)
+			for step := 0; step < crashAfter; step++ {
+				switch rnd.Intn(3) {
+				case 0:
+					tasks, _, err := ackMgr.readQueueTasks()
+					s.NoError(err)
+					for _, task := range tasks {
+						pending = append(pending, task.GetTaskID())
+					}
+				case 1:
+					if len(pending) == 0 {
+						continue
+					}
+					index := rnd.Intn(len(pending))
+					id := pending[index]
+					pending = append(pending[:index], pending[index+1:]...)
+					applied[id]++
+					uncheckpointed[id] = struct{}{}
+					ackMgr.completeQueueTask(id)
+				case 2:
+					ackMgr.updateQueueAckLevel()
+					uncheckpointed = make(map[int64]struct{})
+				}
+			}
+			// crash, completions not checkpointed yet are lost
+			for id := range uncheckpointed {
+				allowedReapply[id]++
+			}
+		}
+
+		// recover and drain the queue without crashing
+		ackMgr := newAckMgr()
+		for persisted.AckLevel < lastTaskID {
+			tasks, _, err := ackMgr.readQueueTasks()
+			s.NoError(err)
+			for _, task := range tasks {
+				applied[task.GetTaskID()]++
+				ackMgr.completeQueueTask(task.GetTaskID())
+			}
+			ackMgr.updateQueueAckLevel()
+		}
+
+		for _, id := range taskIDs {
+			s.True(applied[id] >= 1, "seed %v: task %v is lost", seed, id)
+			s.True(applied[id] <= 1+allowedReapply[id], "seed %v: task %v is applied %v times", seed, id, applied[id])
+		}
+	}
+}
+
 // Tests for failover ack manager
 func (s *queueFailoverAckMgrSuite) SetupSuite() {
 
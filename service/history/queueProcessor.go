@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"sync/atomic"
@@ -51,6 +52,7 @@ type (
 		UpdateAckInterval                  dynamicconfig.DurationPropertyFn
 		UpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 		MaxRetryCount                      dynamicconfig.IntPropertyFn
+		EnableDLQ                          dynamicconfig.BoolPropertyFn
 		MetricScope                        int
 	}
 
@@ -64,6 +66,8 @@ type (
 		rateLimiter   tokenbucket.TokenBucket // Read rate limiter
 		ackMgr        queueAckMgr
 		retryPolicy   backoff.RetryPolicy
+		// when set, tasks are executed by the shared task scheduler instead of the workers below
+		taskScheduler *taskScheduler
 
 		// worker coroutines notification
 		workerNotificationChans []chan struct{}
@@ -75,6 +79,19 @@ type (
 		shutdownWG sync.WaitGroup
 		shutdownCh chan struct{}
 	}
+
+	// queueSchedulerTask adapts a queue task to be executed by the task scheduler
+	queueSchedulerTask struct {
+		processor         *queueProcessorBase
+		task              queueTaskInfo
+		priority          int
+		logger            log.Logger
+		startTime         time.Time
+		attempt           int
+		filtered          bool
+		shouldProcessTask bool
+		scope             int
+	}
 )
 
 var (
@@ -82,6 +99,13 @@ var (
 
 	loadDomainEntryForQueueTaskRetryDelay = 100 * time.Millisecond
 	loadQueueTaskThrottleRetryDelay       = 5 * time.Second
+
+	// scheduled tasks never wait for a retry notification, the task scheduler backs off instead
+	closedNotificationChan = newClosedNotificationChan()
+
+	// the persistence requests of the queue processors are shed before the API requests
+	// when the persistence QPS budget is exhausted
+	backgroundCallerContext = persistence.WithCallerType(context.Background(), persistence.CallerTypeBackground)
 )
 
 func newQueueProcessorBase(clusterName string, shard ShardContext, options *QueueProcessorOptions, processor processor, queueAckMgr queueAckMgr, logger log.Logger) *queueProcessorBase {
@@ -154,10 +178,12 @@ func (p *queueProcessorBase) processorPump() {
 	tasksCh := make(chan queueTaskInfo, p.options.BatchSize())
 
 	var workerWG sync.WaitGroup
-	for i := 0; i < p.options.WorkerCount(); i++ {
-		workerWG.Add(1)
-		notificationChan := p.workerNotificationChans[i]
-		go p.taskWorker(tasksCh, notificationChan, &workerWG)
+	if p.taskScheduler == nil {
+		for i := 0; i < p.options.WorkerCount(); i++ {
+			workerWG.Add(1)
+			notificationChan := p.workerNotificationChans[i]
+			go p.taskWorker(tasksCh, notificationChan, &workerWG)
+		}
 	}
 
 	jitter := backoff.NewJitter()
@@ -230,6 +256,13 @@ func (p *queueProcessorBase) processBatch(tasksCh chan<- queueTaskInfo) {
 	}
 
 	for _, task := range tasks {
+		if p.taskScheduler != nil {
+			if !p.taskScheduler.submit(p.newSchedulerTask(task), p.shutdownCh) {
+				return
+			}
+			continue
+		}
+
 		select {
 		case tasksCh <- task:
 		case <-p.shutdownCh:
@@ -333,10 +366,29 @@ FilterLoop:
 				return
 			}
 			incAttempt()
+			if p.moveTaskToDLQ(task, scope, attempt, logger) {
+				p.ackTaskOnce(task, scope, false, startTime, attempt)
+				return
+			}
 		}
 	}
 }
 
+func (p *queueProcessorBase) newSchedulerTask(task queueTaskInfo) *queueSchedulerTask {
+	priority := taskPriorityDefault
+	if transferTask, ok := task.(*persistence.TransferTaskInfo); ok {
+		priority = getTransferTaskPriority(transferTask.TaskType)
+	}
+
+	return &queueSchedulerTask{
+		processor: p,
+		task:      task,
+		priority:  priority,
+		logger:    p.initializeLoggerForTask(task),
+		startTime: time.Now(),
+	}
+}
+
 func (p *queueProcessorBase) processTaskOnce(notificationChan <-chan struct{}, task queueTaskInfo, shouldProcessTask bool, logger log.Logger) (int, error) {
 	select {
 	case <-notificationChan:
@@ -402,6 +454,42 @@ func (p *queueProcessorBase) handleTaskError(scope int, startTime time.Time,
 	return err
 }
 
+// moveTaskToDLQ moves a transfer task which keeps failing into the DLQ of the shard, so it
+// no longer holds back the ack level, returns true if the task can be acked
+func (p *queueProcessorBase) moveTaskToDLQ(task queueTaskInfo, scope int, attempt int, logger log.Logger) bool {
+	if p.options.EnableDLQ == nil || !p.options.EnableDLQ() || attempt < p.options.MaxRetryCount() {
+		return false
+	}
+	transferTask, ok := task.(*persistence.TransferTaskInfo)
+	if !ok {
+		return false
+	}
+
+	if err := p.shard.GetExecutionManager().PutTransferDLQTask(backgroundCallerContext, &persistence.PutTransferDLQTaskRequest{
+		TaskInfo: transferTask,
+	}); err != nil {
+		logger.Error("Fail to move task to DLQ.", tag.Error(err))
+		return false
+	}
+	p.metricsClient.IncCounter(scope, metrics.TaskMovedToDLQCounter)
+	logger.Error("Task exceeded max retry count, moved to DLQ.", tag.OperationCritical)
+	return true
+}
+
+// processDLQTask makes one attempt of a task read back from the DLQ, the task is not
+// tracked by the ack manager since it is already below the ack level
+func (p *queueProcessorBase) processDLQTask(task queueTaskInfo) error {
+	logger := p.initializeLoggerForTask(task)
+	shouldProcessTask, err := p.processor.getTaskFilter()(task)
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	scope, err := p.processTaskOnce(closedNotificationChan, task, shouldProcessTask, logger)
+	return p.handleTaskError(scope, startTime, closedNotificationChan, err, logger)
+}
+
 func (p *queueProcessorBase) ackTaskOnce(task queueTaskInfo, scope int, reportMetrics bool, startTime time.Time, attempt int) {
 	p.ackMgr.completeQueueTask(task.GetTaskID())
 	if reportMetrics {
@@ -416,28 +504,83 @@ func (p *queueProcessorBase) ackTaskOnce(task queueTaskInfo, scope int, reportMe
 }
 
 func (p *queueProcessorBase) initializeLoggerForTask(task queueTaskInfo) log.Logger {
-	logger := p.logger.WithTags(
-		tag.ShardID(p.shard.GetShardID()),
+	logger := log.WithShard(p.logger, p.shard.GetShardID()).WithTags(
 		tag.TaskID(task.GetTaskID()),
 		tag.FailoverVersion(task.GetVersion()),
 		tag.TaskType(task.GetTaskType()))
 
 	switch task := task.(type) {
 	case *persistence.TransferTaskInfo:
-		logger = logger.WithTags(
-			tag.WorkflowID(task.RunID),
-			tag.WorkflowRunID(task.WorkflowID),
-			tag.WorkflowDomainID(task.DomainID))
+		logger = log.WithWorkflowIDs(logger, task.DomainID, task.WorkflowID, task.RunID)
 
 		logger.Debug("Processing transfer task")
 	case *persistence.ReplicationTaskInfo:
-		logger = logger.WithTags(
-			tag.WorkflowID(task.RunID),
-			tag.WorkflowRunID(task.WorkflowID),
-			tag.WorkflowDomainID(task.DomainID))
+		logger = log.WithWorkflowIDs(logger, task.DomainID, task.WorkflowID, task.RunID)
 
 		logger.Debug("Processing replication task")
 	}
 
 	return logger
 }
+
+func (t *queueSchedulerTask) getDomainID() string {
+	switch task := t.task.(type) {
+	case *persistence.TransferTaskInfo:
+		return task.DomainID
+	case *persistence.ReplicationTaskInfo:
+		return task.DomainID
+	}
+	return ""
+}
+
+func (t *queueSchedulerTask) getPriority() int {
+	return t.priority
+}
+
+func (t *queueSchedulerTask) execute() error {
+	p := t.processor
+	select {
+	case <-p.shutdownCh:
+		// this must return without ack
+		return nil
+	default:
+	}
+
+	var err error
+	if !t.filtered {
+		t.shouldProcessTask, err = p.processor.getTaskFilter()(t.task)
+		if err != nil {
+			t.incAttempt(err)
+			return err
+		}
+		t.filtered = true
+	}
+
+	t.scope, err = p.processTaskOnce(closedNotificationChan, t.task, t.shouldProcessTask, t.logger)
+	err = p.handleTaskError(t.scope, t.startTime, closedNotificationChan, err, t.logger)
+	if err != nil {
+		t.incAttempt(err)
+		if p.moveTaskToDLQ(t.task, t.scope, t.attempt, t.logger) {
+			p.ackTaskOnce(t.task, t.scope, false, t.startTime, t.attempt)
+			return nil
+		}
+		return err
+	}
+
+	p.ackTaskOnce(t.task, t.scope, t.shouldProcessTask, t.startTime, t.attempt)
+	return nil
+}
+
+func (t *queueSchedulerTask) incAttempt(err error) {
+	t.attempt++
+	if t.attempt >= t.processor.options.MaxRetryCount() {
+		t.processor.metricsClient.RecordTimer(t.scope, metrics.TaskAttemptTimer, time.Duration(t.attempt))
+		t.logger.Error("Critical error processing queue task, retrying.", tag.Error(err), tag.OperationCritical)
+	}
+}
+
+func newClosedNotificationChan() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}
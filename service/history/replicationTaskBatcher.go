@@ -0,0 +1,130 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// replicationTaskBatcher publishes the replication tasks of a shard in batches per set of target
+	// clusters, publishing blocks until the batch holding the task is published so that the task is
+	// only acked once it reached the messaging layer
+	replicationTaskBatcher struct {
+		sync.Mutex
+		shardID       int
+		producer      messaging.Producer
+		config        *Config
+		metricsClient metrics.Client
+		logger        log.Logger
+		batches       map[string]*replicationTaskBatch
+	}
+
+	replicationTaskBatch struct {
+		targetClusters []string
+		tasks          []*replicator.ReplicationTask
+		flushTimer     *time.Timer
+		// closed once the batch is published, err is the outcome
+		doneCh chan struct{}
+		err    error
+	}
+)
+
+func newReplicationTaskBatcher(shardID int, producer messaging.Producer, config *Config,
+	metricsClient metrics.Client, logger log.Logger) *replicationTaskBatcher {
+	return &replicationTaskBatcher{
+		shardID:       shardID,
+		producer:      producer,
+		config:        config,
+		metricsClient: metricsClient,
+		logger:        logger,
+		batches:       make(map[string]*replicationTaskBatch),
+	}
+}
+
+func (b *replicationTaskBatcher) publish(targetClusters []string, task *replicator.ReplicationTask) error {
+	if !b.config.EnableReplicationTaskBatching() {
+		return b.producer.Publish(task)
+	}
+
+	sortedClusters := append([]string(nil), targetClusters...)
+	sort.Strings(sortedClusters)
+	key := strings.Join(sortedClusters, ",")
+
+	b.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &replicationTaskBatch{
+			targetClusters: sortedClusters,
+			doneCh:         make(chan struct{}),
+		}
+		b.batches[key] = batch
+		batch.flushTimer = time.AfterFunc(b.config.ReplicationTaskBatchFlushInterval(), func() {
+			b.flush(key, batch)
+		})
+	}
+	batch.tasks = append(batch.tasks, task)
+	full := len(batch.tasks) >= b.config.ReplicationTaskBatchMaxTasks()
+	b.Unlock()
+
+	if full {
+		b.flush(key, batch)
+	}
+	<-batch.doneCh
+	return batch.err
+}
+
+func (b *replicationTaskBatcher) flush(key string, batch *replicationTaskBatch) {
+	b.Lock()
+	if b.batches[key] != batch {
+		// already flushed by the timer or by the task which filled it
+		b.Unlock()
+		return
+	}
+	delete(b.batches, key)
+	b.Unlock()
+
+	batch.flushTimer.Stop()
+	batch.err = b.publishBatch(batch)
+	close(batch.doneCh)
+}
+
+func (b *replicationTaskBatcher) publishBatch(batch *replicationTaskBatch) error {
+	b.metricsClient.RecordTimer(metrics.ReplicatorTaskBatchScope, metrics.ReplicationTaskBatchSize,
+		time.Duration(len(batch.tasks)))
+	// a single task is published as a batch too, batches are partitioned by shard while single tasks are
+	// partitioned by workflow, mixing them would let the messaging layer reorder the tasks of a workflow
+	batchTask, err := messaging.NewReplicationTaskBatch(b.shardID, batch.targetClusters, batch.tasks)
+	if err != nil {
+		b.logger.Error("Failed to encode replication task batch.", tag.ShardID(b.shardID), tag.Error(err))
+		return err
+	}
+	return b.producer.Publish(batchTask)
+}
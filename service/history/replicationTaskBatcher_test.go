@@ -0,0 +1,130 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	replicationTaskBatcherSuite struct {
+		suite.Suite
+		mockProducer *mocks.KafkaProducer
+		config       *Config
+		batcher      *replicationTaskBatcher
+	}
+)
+
+func TestReplicationTaskBatcherSuite(t *testing.T) {
+	s := new(replicationTaskBatcherSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationTaskBatcherSuite) SetupTest() {
+	s.mockProducer = &mocks.KafkaProducer{}
+	s.config = NewDynamicConfigForTest()
+	s.config.EnableReplicationTaskBatching = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ReplicationTaskBatchMaxTasks = dynamicconfig.GetIntPropertyFn(2)
+	s.config.ReplicationTaskBatchFlushInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	s.batcher = newReplicationTaskBatcher(1, s.mockProducer, s.config,
+		metrics.NewClient(tally.NoopScope, metrics.History), loggerimpl.NewNopLogger())
+}
+
+func (s *replicationTaskBatcherSuite) TearDownTest() {
+	s.mockProducer.AssertExpectations(s.T())
+}
+
+func (s *replicationTaskBatcherSuite) TestPublish_BatchingDisabled() {
+	s.config.EnableReplicationTaskBatching = dynamicconfig.GetBoolPropertyFn(false)
+	task := newTestSyncActivityReplicationTask("some random workflow ID")
+	s.mockProducer.On("Publish", task).Return(nil).Once()
+
+	s.Nil(s.batcher.publish([]string{"active", "standby"}, task))
+}
+
+func (s *replicationTaskBatcherSuite) TestPublish_FlushedWhenFull() {
+	tasks := []*replicator.ReplicationTask{
+		newTestSyncActivityReplicationTask("some random workflow ID"),
+		newTestSyncActivityReplicationTask("another random workflow ID"),
+	}
+	var published *replicator.ReplicationTask
+	s.mockProducer.On("Publish", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		published = args.Get(0).(*replicator.ReplicationTask)
+	}).Once()
+
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func(task *replicator.ReplicationTask) {
+			defer wg.Done()
+			// target clusters in any order belong to the same batch
+			s.Nil(s.batcher.publish([]string{"standby", "active"}, task))
+		}(task)
+	}
+	wg.Wait()
+
+	s.Equal(replicator.ReplicationTaskTypeBatch, published.GetTaskType())
+	s.Equal(int32(1), published.BatchTaskAttributes.GetShardId())
+	s.Equal([]string{"active", "standby"}, published.BatchTaskAttributes.TargetClusters)
+	unpacked, err := messaging.UnpackReplicationTaskBatch(published)
+	s.Nil(err)
+	s.Len(unpacked, 2)
+}
+
+func (s *replicationTaskBatcherSuite) TestPublish_FlushedOnInterval() {
+	s.config.ReplicationTaskBatchFlushInterval = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+	task := newTestSyncActivityReplicationTask("some random workflow ID")
+	var published *replicator.ReplicationTask
+	s.mockProducer.On("Publish", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		published = args.Get(0).(*replicator.ReplicationTask)
+	}).Once()
+
+	s.Nil(s.batcher.publish([]string{"active", "standby"}, task))
+	// a batch of a single task is still published as a batch, keyed by the shard like any other batch
+	s.Equal(replicator.ReplicationTaskTypeBatch, published.GetTaskType())
+	unpacked, err := messaging.UnpackReplicationTaskBatch(published)
+	s.Nil(err)
+	s.Equal([]*replicator.ReplicationTask{task}, unpacked)
+}
+
+func newTestSyncActivityReplicationTask(workflowID string) *replicator.ReplicationTask {
+	return &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeSyncActivity.Ptr(),
+		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
+			DomainId:    common.StringPtr("some random domain ID"),
+			WorkflowId:  common.StringPtr(workflowID),
+			ScheduledId: common.Int64Ptr(5),
+		},
+	}
+}
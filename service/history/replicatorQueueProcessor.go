@@ -21,12 +21,14 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -45,6 +47,7 @@ type (
 		historyMgr            persistence.HistoryManager
 		historyV2Mgr          persistence.HistoryV2Manager
 		replicator            messaging.Producer
+		batcher               *replicationTaskBatcher
 		metricsClient         metrics.Client
 		options               *QueueProcessorOptions
 		logger                log.Logger
@@ -95,6 +98,7 @@ func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache,
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
 		replicator:            replicator,
+		batcher:               newReplicationTaskBatcher(shard.GetShardID(), replicator, config, shard.GetMetricsClient(), logger),
 		metricsClient:         shard.GetMetricsClient(),
 		options:               options,
 		logger:                logger,
@@ -124,7 +128,7 @@ func (p *replicatorQueueProcessorImpl) process(qTask queueTaskInfo, shouldProces
 	case persistence.ReplicationTaskTypeSyncActivity:
 		err := p.processSyncActivityTask(task)
 		if err == nil {
-			err = p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
+			err = p.executionMgr.CompleteReplicationTask(backgroundCallerContext, &persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
 		}
 		return metrics.ReplicatorTaskSyncActivityScope, err
 	case persistence.ReplicationTaskTypeHistory:
@@ -133,7 +137,7 @@ func (p *replicatorQueueProcessorImpl) process(qTask queueTaskInfo, shouldProces
 			err = errHistoryNotFoundTask
 		}
 		if err == nil {
-			err = p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
+			err = p.executionMgr.CompleteReplicationTask(backgroundCallerContext, &persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
 		}
 		return metrics.ReplicatorTaskHistoryScope, err
 	default:
@@ -158,7 +162,7 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 	}
 	defer func() { release(retError) }()
 
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(backgroundCallerContext)
 	if err != nil {
 		if _, ok := err.(*shared.EntityNotExistsError); ok {
 			return nil
@@ -201,7 +205,11 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 		},
 	}
 
-	return p.replicator.Publish(replicationTask)
+	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return err
+	}
+	return p.batcher.publish(getTargetClusters(domainEntry), replicationTask)
 }
 
 func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persistence.ReplicationTaskInfo) error {
@@ -210,26 +218,31 @@ func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persi
 	if err != nil {
 		return err
 	}
-	targetClusters := []string{}
-	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
-		targetClusters = append(targetClusters, cluster.ClusterName)
-	}
-	replicationTask, err := GenerateReplicationTask(targetClusters, task, p.historyMgr, p.historyV2Mgr, p.metricsClient, p.logger, nil, common.IntPtr(p.shard.GetShardID()))
+	targetClusters := getTargetClusters(domainEntry)
+	replicationTask, err := GenerateReplicationTask(backgroundCallerContext, targetClusters, task, p.historyMgr, p.historyV2Mgr, p.metricsClient, p.logger, nil, common.IntPtr(p.shard.GetShardID()))
 	if err != nil || replicationTask == nil {
 		return err
 	}
 
-	return p.replicator.Publish(replicationTask)
+	return p.batcher.publish(targetClusters, replicationTask)
+}
+
+func getTargetClusters(domainEntry *cache.DomainCacheEntry) []string {
+	targetClusters := []string{}
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		targetClusters = append(targetClusters, cluster.ClusterName)
+	}
+	return targetClusters
 }
 
 // GenerateReplicationTask generate replication task
-func GenerateReplicationTask(targetClusters []string, task *persistence.ReplicationTaskInfo,
+func GenerateReplicationTask(ctx context.Context, targetClusters []string, task *persistence.ReplicationTaskInfo,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	metricsClient metrics.Client, logger log.Logger, history *shared.History, shardID *int,
 ) (*replicator.ReplicationTask, error) {
 	var err error
 	if history == nil {
-		history, _, err = GetAllHistory(historyMgr, historyV2Mgr, metricsClient, logger, false,
+		history, _, err = GetAllHistory(ctx, historyMgr, historyV2Mgr, metricsClient, logger, false,
 			task.DomainID, task.WorkflowID, task.RunID, task.FirstEventID, task.NextEventID, task.EventStoreVersion, task.BranchToken, shardID)
 		if err != nil {
 			return nil, err
@@ -248,7 +261,7 @@ func GenerateReplicationTask(targetClusters []string, task *persistence.Replicat
 		if lastEvent.GetEventType() == shared.EventTypeWorkflowExecutionContinuedAsNew {
 			// Check if this is replication task for ContinueAsNew event, then retrieve the history for new execution
 			newRunID := lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId()
-			newRunHistory, _, err = GetAllHistory(historyMgr, historyV2Mgr, metricsClient, logger, false,
+			newRunHistory, _, err = GetAllHistory(ctx, historyMgr, historyV2Mgr, metricsClient, logger, false,
 				task.DomainID, task.WorkflowID, newRunID, common.FirstEventID, int64(3), task.NewRunEventStoreVersion, task.NewRunBranchToken, shardID)
 			if err != nil {
 				return nil, err
@@ -277,7 +290,7 @@ func GenerateReplicationTask(targetClusters []string, task *persistence.Replicat
 	return ret, nil
 }
 func (p *replicatorQueueProcessorImpl) readTasks(readLevel int64) ([]queueTaskInfo, bool, error) {
-	response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+	response, err := p.executionMgr.GetReplicationTasks(backgroundCallerContext, &persistence.GetReplicationTasksRequest{
 		ReadLevel:    readLevel,
 		MaxReadLevel: p.shard.GetTransferMaxReadLevel(),
 		BatchSize:    p.options.BatchSize(),
@@ -319,7 +332,7 @@ func (p *replicatorQueueProcessorImpl) updateAckLevel(ackLevel int64) error {
 }
 
 // GetAllHistory return history
-func GetAllHistory(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+func GetAllHistory(ctx context.Context, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	metricsClient metrics.Client, logger log.Logger, byBatch bool,
 	domainID string, workflowID string, runID string, firstEventID int64,
 	nextEventID int64, eventStoreVersion int32, branchToken []byte, shardID *int) (*shared.History, []*shared.History, error) {
@@ -338,7 +351,7 @@ func GetAllHistory(historyMgr persistence.HistoryManager, historyV2Mgr persisten
 
 	for hasMore := true; hasMore; hasMore = len(pageToken) > 0 {
 		pageHistoryEvents, pageHistoryBatches, pageToken, pageHistorySize, err = PaginateHistory(
-			historyMgr, historyV2Mgr, metricsClient, logger, byBatch,
+			ctx, historyMgr, historyV2Mgr, metricsClient, logger, byBatch,
 			domainID, workflowID, runID, firstEventID, nextEventID, pageToken,
 			eventStoreVersion, branchToken, defaultHistoryPageSize, shardID,
 		)
@@ -370,7 +383,7 @@ func GetAllHistory(historyMgr persistence.HistoryManager, historyV2Mgr persisten
 }
 
 // PaginateHistory return paged history
-func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
+func PaginateHistory(ctx context.Context, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	metricsClient metrics.Client, logger log.Logger, byBatch bool,
 	domainID, workflowID, runID string, firstEventID,
 	nextEventID int64, tokenIn []byte, eventStoreVersion int32, branchToken []byte, pageSize int, shardID *int) ([]*shared.HistoryEvent, []*shared.History, []byte, int, error) {
@@ -390,7 +403,7 @@ func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persist
 			ShardID:       shardID,
 		}
 		if byBatch {
-			response, err := historyV2Mgr.ReadHistoryBranchByBatch(req)
+			response, err := historyV2Mgr.ReadHistoryBranchByBatch(ctx, req)
 			if err != nil {
 				return nil, nil, nil, 0, err
 			}
@@ -401,7 +414,7 @@ func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persist
 			tokenOut = response.NextPageToken
 
 		} else {
-			response, err := historyV2Mgr.ReadHistoryBranch(req)
+			response, err := historyV2Mgr.ReadHistoryBranch(ctx, req)
 			if err != nil {
 				return nil, nil, nil, 0, err
 			}
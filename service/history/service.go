@@ -54,6 +54,9 @@ type Config struct {
 	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
 	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
 	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	// Max size of the cache shared by all shards of this host, 0 keeps a separate cache per shard.
+	// Change of this config requires host restart
+	HistoryCacheHostLevelMaxSize dynamicconfig.IntPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
@@ -64,6 +67,7 @@ type Config struct {
 	// ShardController settings
 	RangeSizeBits        uint
 	AcquireShardInterval dynamicconfig.DurationPropertyFn
+	ShardDrainTimeout    dynamicconfig.DurationPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay dynamicconfig.DurationPropertyFn
@@ -89,6 +93,7 @@ type Config struct {
 	TransferTaskBatchSize                               dynamicconfig.IntPropertyFn
 	TransferTaskWorkerCount                             dynamicconfig.IntPropertyFn
 	TransferTaskMaxRetryCount                           dynamicconfig.IntPropertyFn
+	TransferTaskDLQEnabled                              dynamicconfig.BoolPropertyFn
 	TransferProcessorStartDelay                         dynamicconfig.DurationPropertyFn
 	TransferProcessorFailoverStartDelay                 dynamicconfig.DurationPropertyFn
 	TransferProcessorCompleteTransferFailureRetryCount  dynamicconfig.IntPropertyFn
@@ -100,6 +105,26 @@ type Config struct {
 	TransferProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	TransferProcessorCompleteTransferInterval           dynamicconfig.DurationPropertyFn
 
+	// TaskScheduler settings
+	TaskSchedulerEnabled               dynamicconfig.BoolPropertyFn
+	TaskSchedulerWorkerCount           dynamicconfig.IntPropertyFn
+	TaskSchedulerQueueSize             dynamicconfig.IntPropertyFn
+	TaskSchedulerHighPriorityWeight    dynamicconfig.IntPropertyFn
+	TaskSchedulerDefaultPriorityWeight dynamicconfig.IntPropertyFn
+	TaskSchedulerLowPriorityWeight     dynamicconfig.IntPropertyFn
+	TaskSchedulerDomainWeight          dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// VisibilityQueueProcessor settings
+	VisibilityTaskBatchSize                               dynamicconfig.IntPropertyFn
+	VisibilityTaskWorkerCount                             dynamicconfig.IntPropertyFn
+	VisibilityTaskMaxRetryCount                           dynamicconfig.IntPropertyFn
+	VisibilityProcessorStartDelay                         dynamicconfig.DurationPropertyFn
+	VisibilityProcessorMaxPollRPS                         dynamicconfig.IntPropertyFn
+	VisibilityProcessorMaxPollInterval                    dynamicconfig.DurationPropertyFn
+	VisibilityProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
+	VisibilityProcessorUpdateAckInterval                  dynamicconfig.DurationPropertyFn
+	VisibilityProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
+
 	// ReplicatorQueueProcessor settings
 	ReplicatorTaskBatchSize                               dynamicconfig.IntPropertyFn
 	ReplicatorTaskWorkerCount                             dynamicconfig.IntPropertyFn
@@ -110,6 +135,10 @@ type Config struct {
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
 	ReplicatorProcessorUpdateAckInterval                  dynamicconfig.DurationPropertyFn
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
+	EnableReplicationTaskBatching                         dynamicconfig.BoolPropertyFn
+	ReplicationTaskBatchMaxTasks                          dynamicconfig.IntPropertyFn
+	ReplicationTaskBatchFlushInterval                     dynamicconfig.DurationPropertyFn
+	ReplicationDLQEnabled                                 dynamicconfig.BoolPropertyFn
 
 	// Persistence settings
 	ExecutionMgrNumConns dynamicconfig.IntPropertyFn
@@ -119,6 +148,17 @@ type Config struct {
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// DecisionRetryCriticalAttempts is the attempt after which a continuously failing decision is reported
+	DecisionRetryCriticalAttempts dynamicconfig.IntPropertyFn
+	// DecisionRetryInitialBackoff is the delay before the second retry of a continuously failing decision
+	DecisionRetryInitialBackoff dynamicconfig.DurationPropertyFn
+	// DecisionRetryMaxBackoff caps the doubling delay before the retries of a continuously failing decision
+	DecisionRetryMaxBackoff dynamicconfig.DurationPropertyFn
+	// DecisionScheduleLatencyBreachThreshold is the schedule to dispatch delay above which a decision is reported
+	DecisionScheduleLatencyBreachThreshold dynamicconfig.DurationPropertyFnWithDomainFilter
+	// EnableDecisionScheduleLatencyBreachLog also logs the reported decisions
+	EnableDecisionScheduleLatencyBreachLog dynamicconfig.BoolPropertyFnWithDomainFilter
+
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
 	// ShardSyncMinInterval the minimal time interval which the shard info should be sync to remote
@@ -141,6 +181,18 @@ type Config struct {
 	HistorySizeLimitWarn   dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
+	// max size of the execution context a decision can store on its workflow
+	ExecutionContextSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
+	// max number of decisions a decision task can complete with
+	DecisionCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
+	// number of consecutive decision failures after which the workflow is failed
+	DecisionFailureAttemptsLimit dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// encoding of new task tokens, HMAC keys they are signed and verified with, and whether unsigned tokens are still accepted
+	TaskTokenEncoding            dynamicconfig.StringPropertyFn
+	TaskTokenSigningKey          dynamicconfig.StringPropertyFn
+	TaskTokenPreviousSigningKeys dynamicconfig.StringPropertyFn
+	TaskTokenAcceptUnsigned      dynamicconfig.BoolPropertyFn
 
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 }
@@ -161,11 +213,13 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		HistoryCacheInitialSize:                               dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                                   dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
 		HistoryCacheTTL:                                       dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
+		HistoryCacheHostLevelMaxSize:                          dc.GetIntProperty(dynamicconfig.HistoryCacheHostLevelMaxSize, 0),
 		EventsCacheInitialSize:                                dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                                    dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
 		EventsCacheTTL:                                        dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
 		RangeSizeBits:                                         20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                                  dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
+		ShardDrainTimeout:                                     dc.GetDurationProperty(dynamicconfig.ShardDrainTimeout, 5*time.Second),
 		StandbyClusterDelay:                                   dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, 5*time.Minute),
 		TimerTaskBatchSize:                                    dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerTaskWorkerCount:                                  dc.GetIntProperty(dynamicconfig.TimerTaskWorkerCount, 10),
@@ -187,6 +241,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		TransferProcessorMaxPollRPS:                           dc.GetIntProperty(dynamicconfig.TransferProcessorMaxPollRPS, 20),
 		TransferTaskWorkerCount:                               dc.GetIntProperty(dynamicconfig.TransferTaskWorkerCount, 10),
 		TransferTaskMaxRetryCount:                             dc.GetIntProperty(dynamicconfig.TransferTaskMaxRetryCount, 100),
+		TransferTaskDLQEnabled:                                dc.GetBoolProperty(dynamicconfig.TransferTaskDLQEnabled, false),
 		TransferProcessorStartDelay:                           dc.GetDurationProperty(dynamicconfig.TransferProcessorStartDelay, 1*time.Microsecond),
 		TransferProcessorFailoverStartDelay:                   dc.GetDurationProperty(dynamicconfig.TransferProcessorFailoverStartDelay, 5*time.Second),
 		TransferProcessorCompleteTransferFailureRetryCount:    dc.GetIntProperty(dynamicconfig.TransferProcessorCompleteTransferFailureRetryCount, 10),
@@ -195,6 +250,22 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		TransferProcessorUpdateAckInterval:                    dc.GetDurationProperty(dynamicconfig.TransferProcessorUpdateAckInterval, 30*time.Second),
 		TransferProcessorUpdateAckIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.TransferProcessorUpdateAckIntervalJitterCoefficient, 0.15),
 		TransferProcessorCompleteTransferInterval:             dc.GetDurationProperty(dynamicconfig.TransferProcessorCompleteTransferInterval, 60*time.Second),
+		TaskSchedulerEnabled:                                  dc.GetBoolProperty(dynamicconfig.TaskSchedulerEnabled, false),
+		TaskSchedulerWorkerCount:                              dc.GetIntProperty(dynamicconfig.TaskSchedulerWorkerCount, 20),
+		TaskSchedulerQueueSize:                                dc.GetIntProperty(dynamicconfig.TaskSchedulerQueueSize, 1000),
+		TaskSchedulerHighPriorityWeight:                       dc.GetIntProperty(dynamicconfig.TaskSchedulerHighPriorityWeight, 5),
+		TaskSchedulerDefaultPriorityWeight:                    dc.GetIntProperty(dynamicconfig.TaskSchedulerDefaultPriorityWeight, 3),
+		TaskSchedulerLowPriorityWeight:                        dc.GetIntProperty(dynamicconfig.TaskSchedulerLowPriorityWeight, 1),
+		TaskSchedulerDomainWeight:                             dc.GetIntPropertyFilteredByDomain(dynamicconfig.TaskSchedulerDomainWeight, 1),
+		VisibilityTaskBatchSize:                               dc.GetIntProperty(dynamicconfig.VisibilityTaskBatchSize, 100),
+		VisibilityTaskWorkerCount:                             dc.GetIntProperty(dynamicconfig.VisibilityTaskWorkerCount, 10),
+		VisibilityTaskMaxRetryCount:                           dc.GetIntProperty(dynamicconfig.VisibilityTaskMaxRetryCount, 100),
+		VisibilityProcessorStartDelay:                         dc.GetDurationProperty(dynamicconfig.VisibilityProcessorStartDelay, 1*time.Microsecond),
+		VisibilityProcessorMaxPollRPS:                         dc.GetIntProperty(dynamicconfig.VisibilityProcessorMaxPollRPS, 20),
+		VisibilityProcessorMaxPollInterval:                    dc.GetDurationProperty(dynamicconfig.VisibilityProcessorMaxPollInterval, 1*time.Minute),
+		VisibilityProcessorMaxPollIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.VisibilityProcessorMaxPollIntervalJitterCoefficient, 0.15),
+		VisibilityProcessorUpdateAckInterval:                  dc.GetDurationProperty(dynamicconfig.VisibilityProcessorUpdateAckInterval, 30*time.Second),
+		VisibilityProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.VisibilityProcessorUpdateAckIntervalJitterCoefficient, 0.15),
 		ReplicatorTaskBatchSize:                               dc.GetIntProperty(dynamicconfig.ReplicatorTaskBatchSize, 100),
 		ReplicatorTaskWorkerCount:                             dc.GetIntProperty(dynamicconfig.ReplicatorTaskWorkerCount, 10),
 		ReplicatorTaskMaxRetryCount:                           dc.GetIntProperty(dynamicconfig.ReplicatorTaskMaxRetryCount, 100),
@@ -204,10 +275,19 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		ReplicatorProcessorMaxPollIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorMaxPollIntervalJitterCoefficient, 0.15),
 		ReplicatorProcessorUpdateAckInterval:                  dc.GetDurationProperty(dynamicconfig.ReplicatorProcessorUpdateAckInterval, 5*time.Second),
 		ReplicatorProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorUpdateAckIntervalJitterCoefficient, 0.15),
+		EnableReplicationTaskBatching:                         dc.GetBoolProperty(dynamicconfig.EnableReplicationTaskBatching, false),
+		ReplicationTaskBatchMaxTasks:                          dc.GetIntProperty(dynamicconfig.ReplicationTaskBatchMaxTasks, 10),
+		ReplicationTaskBatchFlushInterval:                     dc.GetDurationProperty(dynamicconfig.ReplicationTaskBatchFlushInterval, 100*time.Millisecond),
+		ReplicationDLQEnabled:                                 dc.GetBoolProperty(dynamicconfig.ReplicationDLQEnabled, false),
 		ExecutionMgrNumConns:                                  dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns, 50),
 		HistoryMgrNumConns:                                    dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch:                            dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
 		MaximumSignalsPerExecution:                            dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution, 0),
+		DecisionRetryCriticalAttempts:                         dc.GetIntProperty(dynamicconfig.DecisionRetryCriticalAttempts, 10),
+		DecisionRetryInitialBackoff:                           dc.GetDurationProperty(dynamicconfig.DecisionRetryInitialBackoff, time.Second),
+		DecisionRetryMaxBackoff:                               dc.GetDurationProperty(dynamicconfig.DecisionRetryMaxBackoff, 10*time.Minute),
+		DecisionScheduleLatencyBreachThreshold:                dc.GetDurationPropertyFilteredByDomain(dynamicconfig.DecisionScheduleLatencyBreachThreshold, 10*time.Second),
+		EnableDecisionScheduleLatencyBreachLog:                dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableDecisionScheduleLatencyBreachLog, false),
 		ShardUpdateMinInterval:                                dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
 		ShardSyncMinInterval:                                  dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
 
@@ -219,12 +299,21 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		NumArchiveSystemWorkflows: dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows, 1000),
 
 		BlobSizeLimitError:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
-		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 256*1024),
+		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1024),
 		HistorySizeLimitError:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitError, 200*1024*1024),
 		HistorySizeLimitWarn:   dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitWarn, 50*1024*1024),
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
 
+		ExecutionContextSizeLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.ExecutionContextSizeLimitError, 256*1024),
+		DecisionCountLimitError:        dc.GetIntPropertyFilteredByDomain(dynamicconfig.DecisionCountLimitError, 10000),
+		DecisionFailureAttemptsLimit:   dc.GetIntPropertyFilteredByDomain(dynamicconfig.DecisionFailureAttemptsLimit, 0),
+
+		TaskTokenEncoding:            dc.GetStringProperty(dynamicconfig.TaskTokenEncoding, common.TaskTokenEncodingJSON),
+		TaskTokenSigningKey:          dc.GetStringProperty(dynamicconfig.TaskTokenSigningKey, ""),
+		TaskTokenPreviousSigningKeys: dc.GetStringProperty(dynamicconfig.TaskTokenPreviousSigningKeys, ""),
+		TaskTokenAcceptUnsigned:      dc.GetBoolProperty(dynamicconfig.TaskTokenAcceptUnsigned, true),
+
 		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 20),
 	}
 
@@ -281,7 +370,7 @@ func (s *Service) Start() {
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, params.BlobstoreClient, log)
 
 	shardMgr, err := pFactory.NewShardManager()
 	if err != nil {
@@ -304,7 +393,7 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Fatal("Creating visibility producer failed", tag.Error(err))
 		}
-		esVisibility = espersistence.NewESVisibilityManager("", nil, nil, visibilityProducer,
+		esVisibility = espersistence.NewESVisibilityManager(nil, nil, nil, visibilityProducer,
 			s.metricsClient, log)
 	}
 	visibility = persistence.NewVisibilityManagerWrapper(visibility, esVisibility, dynamicconfig.GetBoolPropertyFnFilteredByDomain(false))
@@ -325,7 +414,8 @@ func (s *Service) Start() {
 	log.Info("started", tag.Service(common.HistoryServiceName))
 
 	<-s.stopC
-	base.Stop()
+	// releases the shards owned by this host after draining their in flight operations
+	handler.Stop()
 }
 
 // Stop stops the service
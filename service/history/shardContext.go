@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -42,6 +44,7 @@ type (
 	// ShardContext represents a history engine shard
 	ShardContext interface {
 		GetShardID() int
+		GetRangeID() int64
 		GetService() service.Service
 		GetExecutionManager() persistence.ExecutionManager
 		GetHistoryManager() persistence.HistoryManager
@@ -54,6 +57,10 @@ type (
 		UpdateTransferAckLevel(ackLevel int64) error
 		GetTransferClusterAckLevel(cluster string) int64
 		UpdateTransferClusterAckLevel(cluster string, ackLevel int64) error
+		GetTransferClusterQueueState(cluster string) persistence.TransferQueueState
+		UpdateTransferClusterQueueState(cluster string, state persistence.TransferQueueState) error
+		GetVisibilityAckLevel() int64
+		UpdateVisibilityAckLevel(ackLevel int64) error
 		GetReplicatorAckLevel() int64
 		UpdateReplicatorAckLevel(ackLevel int64) error
 		GetTimerAckLevel() time.Time
@@ -68,13 +75,14 @@ type (
 		GetAllTimerFailoverLevels() map[string]persistence.TimerFailoverLevel
 		GetDomainNotificationVersion() int64
 		UpdateDomainNotificationVersion(domainNotificationVersion int64) error
-		CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
+		CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (
 			*persistence.CreateWorkflowExecutionResponse, error)
-		UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error)
-		ResetMutableState(request *persistence.ResetMutableStateRequest) error
-		ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error
+		UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (
+			*persistence.UpdateWorkflowExecutionResponse, error)
+		ResetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error
+		ResetWorkflowExecution(ctx context.Context, request *persistence.ResetWorkflowExecutionRequest) error
 		AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (int, error)
-		AppendHistoryV2Events(request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error)
+		AppendHistoryV2Events(ctx context.Context, request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error)
 		NotifyNewHistoryEvent(event *historyEventNotification) error
 		GetConfig() *Config
 		GetEventsCache() eventsCache
@@ -132,6 +140,10 @@ func (s *shardContextImpl) GetShardID() int {
 	return s.shardID
 }
 
+func (s *shardContextImpl) GetRangeID() int64 {
+	return atomic.LoadInt64(&s.rangeID)
+}
+
 func (s *shardContextImpl) GetService() service.Service {
 	return s.service
 }
@@ -214,6 +226,70 @@ func (s *shardContextImpl) UpdateTransferClusterAckLevel(cluster string, ackLeve
 	defer s.Unlock()
 
 	s.shardInfo.ClusterTransferAckLevel[cluster] = ackLevel
+	// the read level and ack bitmap are relative to the ack level they are checkpointed with
+	delete(s.shardInfo.ClusterTransferReadLevel, cluster)
+	delete(s.shardInfo.ClusterTransferAckBitmap, cluster)
+	s.shardInfo.StolenSinceRenew = 0
+	return s.updateShardInfoLocked()
+}
+
+func (s *shardContextImpl) GetTransferClusterQueueState(cluster string) persistence.TransferQueueState {
+	s.RLock()
+	defer s.RUnlock()
+
+	ackLevel, ok := s.shardInfo.ClusterTransferAckLevel[cluster]
+	if !ok {
+		ackLevel = s.shardInfo.TransferAckLevel
+	}
+	state := persistence.TransferQueueState{
+		AckLevel:  ackLevel,
+		ReadLevel: ackLevel,
+	}
+	if readLevel, ok := s.shardInfo.ClusterTransferReadLevel[cluster]; ok && readLevel > ackLevel {
+		state.ReadLevel = readLevel
+		state.AckBitmap = s.shardInfo.ClusterTransferAckBitmap[cluster]
+	}
+	return state
+}
+
+func (s *shardContextImpl) UpdateTransferClusterQueueState(cluster string, state persistence.TransferQueueState) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardInfo.ClusterTransferAckLevel[cluster] = state.AckLevel
+	if s.shardInfo.ClusterTransferReadLevel == nil {
+		s.shardInfo.ClusterTransferReadLevel = make(map[string]int64)
+	}
+	s.shardInfo.ClusterTransferReadLevel[cluster] = state.ReadLevel
+	if s.shardInfo.ClusterTransferAckBitmap == nil {
+		s.shardInfo.ClusterTransferAckBitmap = make(map[string][]byte)
+	}
+	if len(state.AckBitmap) == 0 {
+		delete(s.shardInfo.ClusterTransferAckBitmap, cluster)
+	} else {
+		s.shardInfo.ClusterTransferAckBitmap[cluster] = state.AckBitmap
+	}
+	s.shardInfo.StolenSinceRenew = 0
+	return s.updateShardInfoLocked()
+}
+
+func (s *shardContextImpl) GetVisibilityAckLevel() int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	// shards persisted before the visibility queue was split out have no visibility ack level,
+	// the transfer ack level is a safe starting point for them
+	if s.shardInfo.VisibilityAckLevel == 0 {
+		return s.shardInfo.TransferAckLevel
+	}
+	return s.shardInfo.VisibilityAckLevel
+}
+
+func (s *shardContextImpl) UpdateVisibilityAckLevel(ackLevel int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardInfo.VisibilityAckLevel = ackLevel
 	s.shardInfo.StolenSinceRenew = 0
 	return s.updateShardInfoLocked()
 }
@@ -366,7 +442,7 @@ func (s *shardContextImpl) UpdateTimerMaxReadLevel(cluster string) time.Time {
 	return s.timerMaxReadLevelMap[cluster]
 }
 
-func (s *shardContextImpl) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
+func (s *shardContextImpl) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (
 	*persistence.CreateWorkflowExecutionResponse, error) {
 
 	// do not try to get domain cache within shard lock
@@ -411,10 +487,13 @@ func (s *shardContextImpl) CreateWorkflowExecution(request *persistence.CreateWo
 
 Create_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		if err := common.IsValidContext(ctx); err != nil {
+			return nil, err
+		}
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
 
-		response, err := s.executionManager.CreateWorkflowExecution(request)
+		response, err := s.executionManager.CreateWorkflowExecution(ctx, request)
 		if err != nil {
 			switch err.(type) {
 			case *shared.WorkflowExecutionAlreadyStartedError,
@@ -453,14 +532,15 @@ Create_Loop:
 		return response, err
 	}
 
-	return nil, ErrMaxAttemptsExceeded
+	return nil, ce.NewMaxAttemptsExceededError(s.shardID, request.DomainID, request.Execution.GetWorkflowId(), request.Execution.GetRunId())
 }
 
 func (s *shardContextImpl) getDefaultEncoding(domainEntry *cache.DomainCacheEntry) common.EncodingType {
 	return common.EncodingType(s.config.EventEncodingType(domainEntry.GetInfo().Name))
 }
 
-func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+func (s *shardContextImpl) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (
+	*persistence.UpdateWorkflowExecutionResponse, error) {
 
 	// do not try to get domain cache within shard lock
 	domainEntry, err := s.domainCache.GetDomainByID(request.ExecutionInfo.DomainID)
@@ -531,9 +611,12 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 
 Update_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		if err := common.IsValidContext(ctx); err != nil {
+			return nil, err
+		}
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		resp, err := s.executionManager.UpdateWorkflowExecution(request)
+		resp, err := s.executionManager.UpdateWorkflowExecution(ctx, request)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ConditionFailedError,
@@ -571,7 +654,7 @@ Update_Loop:
 		return resp, err
 	}
 
-	return nil, ErrMaxAttemptsExceeded
+	return nil, ce.NewMaxAttemptsExceededError(s.shardID, request.ExecutionInfo.DomainID, request.ExecutionInfo.WorkflowID, request.ExecutionInfo.RunID)
 }
 
 func (s *shardContextImpl) allocateTransferIDsLocked(tasks []persistence.Task, transferMaxReadLevel *int64) error {
@@ -587,7 +670,7 @@ func (s *shardContextImpl) allocateTransferIDsLocked(tasks []persistence.Task, t
 	return nil
 }
 
-func (s *shardContextImpl) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
+func (s *shardContextImpl) ResetWorkflowExecution(ctx context.Context, request *persistence.ResetWorkflowExecutionRequest) error {
 	// do not try to get domain cache within shard lock
 	domainEntry, err := s.domainCache.GetDomainByID(request.CurrExecutionInfo.DomainID)
 	if err != nil {
@@ -631,9 +714,12 @@ func (s *shardContextImpl) ResetWorkflowExecution(request *persistence.ResetWork
 	}
 Reset_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		if err := common.IsValidContext(ctx); err != nil {
+			return err
+		}
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		err := s.executionManager.ResetWorkflowExecution(request)
+		err := s.executionManager.ResetWorkflowExecution(ctx, request)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ConditionFailedError,
@@ -671,10 +757,10 @@ Reset_Loop:
 		return err
 	}
 
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(s.shardID, request.CurrExecutionInfo.DomainID, request.CurrExecutionInfo.WorkflowID, request.CurrExecutionInfo.RunID)
 }
 
-func (s *shardContextImpl) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
+func (s *shardContextImpl) ResetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error {
 	// do not try to get domain cache within shard lock
 	domainEntry, err := s.domainCache.GetDomainByID(request.ExecutionInfo.DomainID)
 	if err != nil {
@@ -687,9 +773,12 @@ func (s *shardContextImpl) ResetMutableState(request *persistence.ResetMutableSt
 
 Reset_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		if err := common.IsValidContext(ctx); err != nil {
+			return err
+		}
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		err := s.executionManager.ResetMutableState(request)
+		err := s.executionManager.ResetMutableState(ctx, request)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ConditionFailedError,
@@ -727,10 +816,10 @@ Reset_Loop:
 		return err
 	}
 
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(s.shardID, request.ExecutionInfo.DomainID, request.ExecutionInfo.WorkflowID, request.ExecutionInfo.RunID)
 }
 
-func (s *shardContextImpl) AppendHistoryV2Events(
+func (s *shardContextImpl) AppendHistoryV2Events(ctx context.Context,
 	request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error) {
 
 	domainEntry, err := s.domainCache.GetDomainByID(domainID)
@@ -739,6 +828,7 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
 	request.ShardID = common.IntPtr(s.shardID)
+	request.DomainID = domainID
 	size := 0
 	defer func() {
 		// N.B. - Dual emit here makes sense so that we can see aggregate timer stats across all
@@ -755,7 +845,7 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 				tag.WorkflowHistorySizeBytes(size))
 		}
 	}()
-	resp, err0 := s.historyV2Mgr.AppendHistoryNodes(request)
+	resp, err0 := s.historyV2Mgr.AppendHistoryNodes(ctx, request)
 	if resp != nil {
 		size = resp.Size
 	}
@@ -1188,6 +1278,14 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 	for k, v := range shardInfo.ClusterTimerAckLevel {
 		clusterTimerAckLevel[k] = v
 	}
+	clusterTransferReadLevel := make(map[string]int64)
+	for k, v := range shardInfo.ClusterTransferReadLevel {
+		clusterTransferReadLevel[k] = v
+	}
+	clusterTransferAckBitmap := make(map[string][]byte)
+	for k, v := range shardInfo.ClusterTransferAckBitmap {
+		clusterTransferAckBitmap[k] = v
+	}
 	shardInfoCopy := &persistence.ShardInfo{
 		ShardID:                   shardInfo.ShardID,
 		Owner:                     shardInfo.Owner,
@@ -1200,6 +1298,9 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 		TimerFailoverLevels:       timerFailoverLevels,
 		ClusterTransferAckLevel:   clusterTransferAckLevel,
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
+		ClusterTransferReadLevel:  clusterTransferReadLevel,
+		ClusterTransferAckBitmap:  clusterTransferAckBitmap,
+		VisibilityAckLevel:        shardInfo.VisibilityAckLevel,
 		DomainNotificationVersion: shardInfo.DomainNotificationVersion,
 	}
 
@@ -26,6 +26,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
@@ -38,6 +39,8 @@ import (
 
 const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
+	// shardControllerShutdownTimeout bounds how long the engines of all shards together get to stop on shutdown
+	shardControllerShutdownTimeout = 30 * time.Second
 )
 
 type (
@@ -143,8 +146,8 @@ func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence
 		engineFactory:   factory,
 		host:            host,
 		config:          config,
-		logger:          logger.WithTags(tag.ShardID(shardID)),
-		throttledLogger: throttledLog.WithTags(tag.ShardID(shardID)),
+		logger:          log.WithShard(logger, shardID),
+		throttledLogger: log.WithShard(throttledLog, shardID),
 		metricsClient:   metricsClient,
 	}, nil
 }
@@ -349,14 +352,26 @@ AcquireLoop:
 	c.metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.NumShardsGauge, float64(c.numShards()))
 }
 
+// doShutdown stops the engines of all shards in parallel, so every shard drains its in flight operations at the
+// same time. The lock is only held to take the shards out of the controller, not while the engines stop.
 func (c *shardController) doShutdown() {
 	c.logger.Info("", tag.LifeCycleStopping, tag.Address(c.host.Identity()))
 	c.Lock()
-	defer c.Unlock()
-	for _, item := range c.historyShards {
-		item.stopEngine()
-	}
+	historyShards := c.historyShards
 	c.historyShards = nil
+	c.Unlock()
+
+	var stopWG sync.WaitGroup
+	for _, item := range historyShards {
+		stopWG.Add(1)
+		go func(item *historyShardsItem) {
+			defer stopWG.Done()
+			item.stopEngine()
+		}(item)
+	}
+	if success := common.AwaitWaitGroup(&stopWG, shardControllerShutdownTimeout); !success {
+		c.logger.Warn("Timed out stopping the history shards.", tag.LifeCycleStopTimedout, tag.Address(c.host.Identity()))
+	}
 }
 
 func (c *shardController) processShardClosedEvents() {
@@ -391,6 +406,25 @@ func (c *shardController) shardIDs() []int32 {
 	return ids
 }
 
+func (c *shardController) shardStatuses() []*workflow.HistoryShardStatus {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	statuses := make([]*workflow.HistoryShardStatus, 0, len(items))
+	for _, item := range items {
+		item.RLock()
+		if item.status == historyShardsItemStatusStarted {
+			statuses = append(statuses, item.engine.DescribeShard())
+		}
+		item.RUnlock()
+	}
+	return statuses
+}
+
 func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine, error) {
 	i.RLock()
 	if i.status == historyShardsItemStatusStarted {
@@ -30,7 +30,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
@@ -571,6 +573,70 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *shardControllerSuite) TestShardControllerStopsShardsInParallel() {
+	numShards := 4
+	s.config.NumberOfShards = numShards
+
+	stopDelay := 200 * time.Millisecond
+	historyEngines := make(map[int]*MockHistoryEngine)
+	for shardID := 0; shardID < numShards; shardID++ {
+		mockEngine := &MockHistoryEngine{}
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+		mockEngine.On("Stop").Return().After(stopDelay).Once()
+	}
+
+	// when shard is initialized, it will use the 2 mock function below to initialize the "current" time of each cluster
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestSingleDCAllClusterFailoverVersions)
+	s.controller.acquireShards()
+	s.Equal(numShards, s.controller.numShards())
+
+	startTime := time.Now()
+	s.controller.doShutdown()
+	s.True(time.Since(startTime) < time.Duration(numShards)*stopDelay)
+	s.Equal(0, s.controller.numShards())
+
+	for _, mockEngine := range historyEngines {
+		mockEngine.AssertExpectations(s.T())
+	}
+}
+
+func (s *shardControllerSuite) TestShardStatuses() {
+	numShards := 4
+	s.config.NumberOfShards = numShards
+
+	myShards := []int{0, 2}
+	for _, shardID := range myShards {
+		mockEngine := &MockHistoryEngine{}
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+		mockEngine.On("DescribeShard").Return(&workflow.HistoryShardStatus{
+			ShardId: common.Int32Ptr(int32(shardID)),
+			RangeId: common.Int64Ptr(6),
+		})
+	}
+	for _, shardID := range []int{1, 3} {
+		ownerHost := fmt.Sprintf("test-shard-statuses-host-%v", shardID)
+		s.mockServiceResolver.On("Lookup", string(shardID)).Return(membership.NewHostInfo(ownerHost, nil), nil).Once()
+	}
+
+	// when shard is initialized, it will use the 2 mock function below to initialize the "current" time of each cluster
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestSingleDCAllClusterFailoverVersions)
+	s.controller.acquireShards()
+
+	statuses := s.controller.shardStatuses()
+	s.Equal(len(myShards), len(statuses))
+	shardIDs := map[int32]bool{}
+	for _, status := range statuses {
+		shardIDs[status.GetShardId()] = true
+		s.Equal(int64(6), status.GetRangeId())
+	}
+	for _, shardID := range myShards {
+		s.True(shardIDs[int32(shardID)])
+	}
+}
+
 func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *MockHistoryEngine, currentRangeID,
 	newRangeID int64) {
 
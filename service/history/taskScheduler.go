@@ -0,0 +1,348 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	taskPriorityHigh = iota
+	taskPriorityDefault
+	taskPriorityLow
+
+	numTaskPriorities
+)
+
+const (
+	taskSchedulerRetryInitialInterval = 50 * time.Millisecond
+	taskSchedulerRetryMaxInterval     = 10 * time.Second
+)
+
+type (
+	// schedulerTask is a unit of work which can be executed by the task scheduler
+	schedulerTask interface {
+		getDomainID() string
+		getPriority() int
+		// execute makes one attempt of the task, a non nil error means the task should be retried
+		execute() error
+	}
+
+	// taskScheduler is shared by the queue processors of one shard, tasks are dispatched to
+	// a bounded pool of workers in weighted round robin order, first across task priorities
+	// and then across domains within one priority, so a hot domain cannot starve others.
+	taskScheduler struct {
+		config      *Config
+		domainCache cache.DomainCache
+		logger      log.Logger
+		retryPolicy backoff.RetryPolicy
+
+		sync.Mutex
+		queues         []*taskSchedulerQueue
+		priorityIndex  int
+		priorityCredit int
+
+		// limits the number of tasks submitted but not yet finished
+		pendingCh  chan struct{}
+		notifyCh   chan struct{}
+		workerCh   chan *taskSchedulerEntry
+		status     int32
+		shutdownWG sync.WaitGroup
+		shutdownCh chan struct{}
+	}
+
+	// taskSchedulerQueue holds the tasks of one priority, grouped by domain
+	taskSchedulerQueue struct {
+		domainIDs    []string
+		tasks        map[string]*list.List
+		domainIndex  int
+		domainCredit int
+		size         int
+	}
+
+	taskSchedulerEntry struct {
+		task    schedulerTask
+		attempt int
+	}
+)
+
+func newTaskScheduler(config *Config, domainCache cache.DomainCache, logger log.Logger) *taskScheduler {
+	retryPolicy := backoff.NewExponentialRetryPolicy(taskSchedulerRetryInitialInterval)
+	retryPolicy.SetMaximumInterval(taskSchedulerRetryMaxInterval)
+	retryPolicy.SetExpirationInterval(backoff.NoInterval)
+
+	queues := make([]*taskSchedulerQueue, numTaskPriorities)
+	for i := range queues {
+		queues[i] = &taskSchedulerQueue{tasks: make(map[string]*list.List)}
+	}
+
+	return &taskScheduler{
+		config:      config,
+		domainCache: domainCache,
+		logger:      logger.WithTags(tag.ComponentTaskScheduler),
+		retryPolicy: retryPolicy,
+		queues:      queues,
+		pendingCh:   make(chan struct{}, config.TaskSchedulerQueueSize()),
+		notifyCh:    make(chan struct{}, 1),
+		workerCh:    make(chan *taskSchedulerEntry),
+		status:      common.DaemonStatusInitialized,
+		shutdownCh:  make(chan struct{}),
+	}
+}
+
+func (s *taskScheduler) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	s.logger.Info("", tag.LifeCycleStarting)
+	defer s.logger.Info("", tag.LifeCycleStarted)
+
+	workerCount := s.config.TaskSchedulerWorkerCount()
+	s.shutdownWG.Add(workerCount + 1)
+	for i := 0; i < workerCount; i++ {
+		go s.taskWorker()
+	}
+	go s.dispatchLoop()
+}
+
+func (s *taskScheduler) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	s.logger.Info("", tag.LifeCycleStopping)
+	defer s.logger.Info("", tag.LifeCycleStopped)
+
+	close(s.shutdownCh)
+
+	if success := common.AwaitWaitGroup(&s.shutdownWG, time.Minute); !success {
+		s.logger.Warn("", tag.LifeCycleStopTimedout)
+	}
+}
+
+// submit adds the task to the scheduler, blocking while the scheduler is full.
+// Returns false if either the scheduler or the caller is shutting down.
+func (s *taskScheduler) submit(task schedulerTask, cancelCh <-chan struct{}) bool {
+	select {
+	case s.pendingCh <- struct{}{}:
+	case <-cancelCh:
+		return false
+	case <-s.shutdownCh:
+		return false
+	}
+
+	s.enqueue(&taskSchedulerEntry{task: task})
+	return true
+}
+
+func (s *taskScheduler) enqueue(entry *taskSchedulerEntry) {
+	s.Lock()
+	s.queues[s.getPriority(entry.task)].push(entry)
+	s.Unlock()
+
+	select {
+	case s.notifyCh <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
+}
+
+func (s *taskScheduler) dispatchLoop() {
+	defer s.shutdownWG.Done()
+
+	for {
+		s.Lock()
+		entry := s.nextLocked()
+		s.Unlock()
+
+		if entry == nil {
+			select {
+			case <-s.notifyCh:
+				continue
+			case <-s.shutdownCh:
+				return
+			}
+		}
+
+		select {
+		case s.workerCh <- entry:
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+func (s *taskScheduler) taskWorker() {
+	defer s.shutdownWG.Done()
+
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case entry := <-s.workerCh:
+			if err := entry.task.execute(); err != nil {
+				s.retry(entry)
+				continue
+			}
+			<-s.pendingCh
+		}
+	}
+}
+
+// retry re-enqueues the task after a backoff, the worker is released in the meantime
+func (s *taskScheduler) retry(entry *taskSchedulerEntry) {
+	delay := s.retryPolicy.ComputeNextDelay(0, entry.attempt)
+	if delay < 0 {
+		delay = taskSchedulerRetryMaxInterval
+	}
+	entry.attempt++
+
+	time.AfterFunc(delay, func() {
+		select {
+		case <-s.shutdownCh:
+		default:
+			s.enqueue(entry)
+		}
+	})
+}
+
+// nextLocked picks the next task in weighted round robin order, caller must hold the lock
+func (s *taskScheduler) nextLocked() *taskSchedulerEntry {
+	for i := 0; i < numTaskPriorities; i++ {
+		queue := s.queues[s.priorityIndex]
+		if queue.size > 0 {
+			if s.priorityCredit <= 0 {
+				s.priorityCredit = s.getPriorityWeight(s.priorityIndex)
+			}
+			entry := queue.pop(s.getDomainWeight)
+			s.priorityCredit--
+			if s.priorityCredit == 0 || queue.size == 0 {
+				s.nextPriorityLocked()
+			}
+			return entry
+		}
+		s.nextPriorityLocked()
+	}
+	return nil
+}
+
+func (s *taskScheduler) nextPriorityLocked() {
+	s.priorityIndex = (s.priorityIndex + 1) % numTaskPriorities
+	s.priorityCredit = 0
+}
+
+func (s *taskScheduler) getPriority(task schedulerTask) int {
+	priority := task.getPriority()
+	if priority < 0 || priority >= numTaskPriorities {
+		return taskPriorityDefault
+	}
+	return priority
+}
+
+func (s *taskScheduler) getPriorityWeight(priority int) int {
+	var weight int
+	switch priority {
+	case taskPriorityHigh:
+		weight = s.config.TaskSchedulerHighPriorityWeight()
+	case taskPriorityLow:
+		weight = s.config.TaskSchedulerLowPriorityWeight()
+	default:
+		weight = s.config.TaskSchedulerDefaultPriorityWeight()
+	}
+	return collection.MaxInt(weight, 1)
+}
+
+func (s *taskScheduler) getDomainWeight(domainID string) int {
+	domainName := ""
+	if domainEntry, err := s.domainCache.GetDomainByID(domainID); err == nil {
+		domainName = domainEntry.GetInfo().Name
+	}
+	return collection.MaxInt(s.config.TaskSchedulerDomainWeight(domainName), 1)
+}
+
+func (q *taskSchedulerQueue) push(entry *taskSchedulerEntry) {
+	domainID := entry.task.getDomainID()
+	tasks, ok := q.tasks[domainID]
+	if !ok {
+		tasks = list.New()
+		q.tasks[domainID] = tasks
+		q.domainIDs = append(q.domainIDs, domainID)
+	}
+	tasks.PushBack(entry)
+	q.size++
+}
+
+func (q *taskSchedulerQueue) pop(getDomainWeight func(string) int) *taskSchedulerEntry {
+	domainID := q.domainIDs[q.domainIndex]
+	if q.domainCredit <= 0 {
+		q.domainCredit = getDomainWeight(domainID)
+	}
+
+	tasks := q.tasks[domainID]
+	entry := tasks.Remove(tasks.Front()).(*taskSchedulerEntry)
+	q.size--
+	q.domainCredit--
+
+	if tasks.Len() == 0 {
+		delete(q.tasks, domainID)
+		q.domainIDs = append(q.domainIDs[:q.domainIndex], q.domainIDs[q.domainIndex+1:]...)
+		q.domainCredit = 0
+	} else if q.domainCredit == 0 {
+		q.domainIndex++
+	}
+	if q.domainIndex >= len(q.domainIDs) {
+		q.domainIndex = 0
+	}
+	return entry
+}
+
+func getTransferTaskPriority(taskType int) int {
+	switch taskType {
+	case persistence.TransferTaskTypeDecisionTask, persistence.TransferTaskTypeActivityTask:
+		return taskPriorityHigh
+	case persistence.TransferTaskTypeCloseExecution, persistence.TransferTaskTypeRecordWorkflowStarted:
+		return taskPriorityLow
+	default:
+		return taskPriorityDefault
+	}
+}
+
+func getTimerTaskPriority(taskType int) int {
+	switch taskType {
+	case persistence.TaskTypeDecisionTimeout, persistence.TaskTypeActivityTimeout, persistence.TaskTypeActivityRetryTimer:
+		return taskPriorityHigh
+	case persistence.TaskTypeDeleteHistoryEvent:
+		return taskPriorityLow
+	default:
+		return taskPriorityDefault
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	taskSchedulerSuite struct {
+		suite.Suite
+		mockDomainCache *cache.DomainCacheMock
+		config          *Config
+		scheduler       *taskScheduler
+	}
+
+	testSchedulerTask struct {
+		name     string
+		domainID string
+		priority int
+		failures int
+		doneCh   chan struct{}
+	}
+)
+
+func TestTaskSchedulerSuite(t *testing.T) {
+	s := new(taskSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskSchedulerSuite) SetupTest() {
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(
+		func(domainID string) *cache.DomainCacheEntry {
+			return cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{ID: domainID, Name: domainID}, nil)
+		},
+		nil,
+	)
+
+	s.config = NewDynamicConfigForTest()
+	s.config.TaskSchedulerWorkerCount = dynamicconfig.GetIntPropertyFn(2)
+	s.config.TaskSchedulerHighPriorityWeight = dynamicconfig.GetIntPropertyFn(2)
+	s.config.TaskSchedulerDefaultPriorityWeight = dynamicconfig.GetIntPropertyFn(1)
+	s.config.TaskSchedulerLowPriorityWeight = dynamicconfig.GetIntPropertyFn(1)
+	s.config.TaskSchedulerDomainWeight = func(domain string) int {
+		if domain == "hot" {
+			return 2
+		}
+		return 1
+	}
+	s.scheduler = newTaskScheduler(s.config, s.mockDomainCache, loggerimpl.NewDevelopmentForTest(s.Suite))
+}
+
+func (s *taskSchedulerSuite) TearDownTest() {
+	s.scheduler.Stop()
+}
+
+func (s *taskSchedulerSuite) TestNext_PriorityWeightedRoundRobin() {
+	for _, task := range []*testSchedulerTask{
+		{name: "h1", priority: taskPriorityHigh},
+		{name: "h2", priority: taskPriorityHigh},
+		{name: "h3", priority: taskPriorityHigh},
+		{name: "h4", priority: taskPriorityHigh},
+		{name: "d1", priority: taskPriorityDefault},
+		{name: "d2", priority: taskPriorityDefault},
+		{name: "l1", priority: taskPriorityLow},
+		{name: "l2", priority: taskPriorityLow},
+	} {
+		task.domainID = "domain"
+		s.scheduler.enqueue(&taskSchedulerEntry{task: task})
+	}
+
+	s.Equal([]string{"h1", "h2", "d1", "l1", "h3", "h4", "d2", "l2"}, s.drain())
+}
+
+func (s *taskSchedulerSuite) TestNext_DomainWeightedRoundRobin() {
+	for _, task := range []*testSchedulerTask{
+		{name: "hot1", domainID: "hot"},
+		{name: "hot2", domainID: "hot"},
+		{name: "hot3", domainID: "hot"},
+		{name: "hot4", domainID: "hot"},
+		{name: "hot5", domainID: "hot"},
+		{name: "cold1", domainID: "cold"},
+		{name: "cold2", domainID: "cold"},
+	} {
+		task.priority = taskPriorityHigh
+		s.scheduler.enqueue(&taskSchedulerEntry{task: task})
+	}
+
+	s.Equal([]string{"hot1", "hot2", "cold1", "hot3", "hot4", "cold2", "hot5"}, s.drain())
+}
+
+func (s *taskSchedulerSuite) TestSubmit_RetryWithBackoff() {
+	s.scheduler.Start()
+
+	task := &testSchedulerTask{
+		name:     "retry",
+		domainID: "domain",
+		priority: taskPriorityDefault,
+		failures: 2,
+		doneCh:   make(chan struct{}),
+	}
+	s.True(s.scheduler.submit(task, make(chan struct{})))
+
+	select {
+	case <-task.doneCh:
+	case <-time.After(5 * time.Second):
+		s.Fail("task is not completed")
+	}
+	s.Equal(0, task.failures)
+}
+
+func (s *taskSchedulerSuite) TestSubmit_Cancelled() {
+	s.config.TaskSchedulerQueueSize = dynamicconfig.GetIntPropertyFn(1)
+	s.scheduler = newTaskScheduler(s.config, s.mockDomainCache, loggerimpl.NewDevelopmentForTest(s.Suite))
+
+	cancelCh := make(chan struct{})
+	s.True(s.scheduler.submit(&testSchedulerTask{name: "t1", domainID: "domain"}, cancelCh))
+	close(cancelCh)
+	s.False(s.scheduler.submit(&testSchedulerTask{name: "t2", domainID: "domain"}, cancelCh))
+}
+
+func (s *taskSchedulerSuite) drain() []string {
+	var names []string
+	for {
+		entry := s.scheduler.nextLocked()
+		if entry == nil {
+			return names
+		}
+		names = append(names, entry.task.(*testSchedulerTask).name)
+	}
+}
+
+func (t *testSchedulerTask) getDomainID() string {
+	return t.domainID
+}
+
+func (t *testSchedulerTask) getPriority() int {
+	return t.priority
+}
+
+func (t *testSchedulerTask) execute() error {
+	if t.failures > 0 {
+		t.failures--
+		return errors.New("some random error")
+	}
+	close(t.doneCh)
+	return nil
+}
@@ -153,6 +153,20 @@ func (tb *timerBuilder) AddScheduleToStartDecisionTimoutTask(scheduleID, schedul
 	return timeOutTask
 }
 
+// AddDecisionRetryBackoffTimerTask - Add a timer scheduling the next attempt of a continuously failing decision,
+// returns nil if the attempt is to be scheduled right away.
+func (tb *timerBuilder) AddDecisionRetryBackoffTimerTask(attempt int64) *persistence.WorkflowBackoffTimerTask {
+	backoff := getDecisionRetryBackoff(attempt, tb.config.DecisionRetryInitialBackoff(), tb.config.DecisionRetryMaxBackoff())
+	if backoff <= 0 {
+		return nil
+	}
+	tb.logger.Debug(fmt.Sprintf("Adding Decision Retry Backoff: with backoff: %v, Attempt: %v", backoff, attempt))
+	return &persistence.WorkflowBackoffTimerTask{
+		VisibilityTimestamp: tb.timeSource.Now().Add(backoff),
+		TimeoutType:         persistence.WorkflowBackoffTimeoutTypeDecisionRetry,
+	}
+}
+
 // TODO this function is only used by tiemr queue processor test
 func (tb *timerBuilder) AddScheduleToCloseActivityTimeout(
 	ai *persistence.ActivityInfo) (*persistence.ActivityTimeoutTask, error) {
@@ -435,6 +449,23 @@ func (tb *timerBuilder) createNewTask(td *timerDetails) persistence.Task {
 	return nil
 }
 
+// getDecisionRetryBackoff returns the delay before the given attempt of a failing decision is scheduled, the first
+// retry is scheduled right away and the delay doubles with every retry after it, so a worker crashing on the
+// decision does not keep the history engine in a hot loop
+func getDecisionRetryBackoff(attempt int64, initialBackoff time.Duration, maxBackoff time.Duration) time.Duration {
+	if attempt <= 1 || initialBackoff <= 0 {
+		return 0
+	}
+	backoff := initialBackoff
+	for i := int64(2); i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
 func compareTimerIDLess(first *TimerSequenceID, second *TimerSequenceID) bool {
 	if first.VisibilityTimestamp.Before(second.VisibilityTimestamp) {
 		return true
@@ -234,3 +234,26 @@ func (s *timerBuilderProcessorSuite) TestDecodeKey() {
 	taskID := TimerSequenceID{VisibilityTimestamp: time.Unix(0, 0), TaskID: 1}
 	s.logger.Info(fmt.Sprintf("Timer: %s, expiry: %v", TimerSequenceID(taskID), taskID.VisibilityTimestamp))
 }
+
+func (s *timerBuilderProcessorSuite) TestGetDecisionRetryBackoff() {
+	maxBackoff := 100 * time.Second
+	s.Equal(time.Duration(0), getDecisionRetryBackoff(0, 10*time.Second, maxBackoff))
+	s.Equal(time.Duration(0), getDecisionRetryBackoff(1, 10*time.Second, maxBackoff))
+	s.Equal(10*time.Second, getDecisionRetryBackoff(2, 10*time.Second, maxBackoff))
+	s.Equal(40*time.Second, getDecisionRetryBackoff(4, 10*time.Second, maxBackoff))
+	s.Equal(maxBackoff, getDecisionRetryBackoff(100, 10*time.Second, maxBackoff))
+	s.Equal(time.Duration(0), getDecisionRetryBackoff(5, 0, maxBackoff))
+}
+
+func (s *timerBuilderProcessorSuite) TestAddDecisionRetryBackoffTimerTask() {
+	now := time.Now()
+	tb := newTimerBuilder(s.config, s.logger, &mockTimeSource{currTime: now})
+
+	s.Nil(tb.AddDecisionRetryBackoffTimerTask(1))
+
+	task := tb.AddDecisionRetryBackoffTimerTask(3)
+	s.NotNil(task)
+	s.Equal(persistence.WorkflowBackoffTimeoutTypeDecisionRetry, task.TimeoutType)
+	s.Equal(now.Add(getDecisionRetryBackoff(3, s.config.DecisionRetryInitialBackoff(), s.config.DecisionRetryMaxBackoff())),
+		task.VisibilityTimestamp)
+}
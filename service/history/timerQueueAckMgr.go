@@ -27,6 +27,7 @@ import (
 	"sync"
 	"time"
 
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -355,14 +356,14 @@ func (t *timerQueueAckMgrImpl) getTimerTasks(minTimestamp time.Time, maxTimestam
 
 	retryCount := t.config.TimerProcessorGetFailureRetryCount()
 	for attempt := 0; attempt < retryCount; attempt++ {
-		response, err := t.executionMgr.GetTimerIndexTasks(request)
+		response, err := t.executionMgr.GetTimerIndexTasks(backgroundCallerContext, request)
 		if err == nil {
 			return response.Timers, response.NextPageToken, nil
 		}
 		backoff := time.Duration(attempt * 100)
 		time.Sleep(backoff * time.Millisecond)
 	}
-	return nil, nil, ErrMaxAttemptsExceeded
+	return nil, nil, ce.NewMaxAttemptsExceededError(t.shard.GetShardID(), "", "", "")
 }
 
 func (t *timerQueueAckMgrImpl) isProcessNow(expiryTime time.Time) bool {
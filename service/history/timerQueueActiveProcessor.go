@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cron"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -103,6 +104,7 @@ func newTimerQueueActiveProcessor(shard ShardContext, historyService *historyEng
 		config:           shard.GetConfig(),
 	}
 	processor.timerQueueProcessorBase.timerProcessor = processor
+	processor.timerQueueProcessorBase.taskScheduler = historyService.taskScheduler
 	return processor
 }
 
@@ -177,6 +179,7 @@ func newTimerQueueFailoverProcessor(shard ShardContext, historyService *historyE
 		timerQueueAckMgr: timerQueueAckMgr,
 	}
 	processor.timerQueueProcessorBase.timerProcessor = processor
+	processor.timerQueueProcessorBase.taskScheduler = historyService.taskScheduler
 	return updateShardAckLevel, processor
 }
 
@@ -282,6 +285,15 @@ Update_History_Loop:
 			}
 
 			if isExpired := tBuilder.IsTimerExpired(td, task.VisibilityTimestamp); isExpired {
+				if msBuilder.IsWorkflowExecutionPaused() {
+					// User timers do not fire while the workflow is paused, the timer task is re-created on resume.
+					if td.TaskCreated {
+						ti.TaskID = TimerTaskStatusNone
+						msBuilder.UpdateUserTimer(ti.TimerID, ti)
+					}
+					continue ExpireUserTimers
+				}
+
 				// Add TimerFired event to history.
 				if msBuilder.AddTimerFiredEvent(ti.StartedID, ti.TimerID) == nil {
 					return errFailedToAddTimerFiredEvent
@@ -314,7 +326,7 @@ Update_History_Loop:
 		}
 		return err
 	}
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(t.shard.GetShardID(), task.DomainID, task.WorkflowID, task.RunID)
 }
 
 func (t *timerQueueActiveProcessorImpl) processActivityTimeout(timerTask *persistence.TimerTaskInfo) (retError error) {
@@ -413,7 +425,8 @@ Update_History_Loop:
 			case workflow.TimeoutTypeScheduleToClose:
 				{
 					t.metricsClient.IncCounter(metrics.TimerActiveTaskActivityTimeoutScope, metrics.ScheduleToCloseTimeoutCounter)
-					if msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID, timeoutType, nil) == nil {
+					// carry the last heartbeat details so the workflow can resume the progress of a started activity
+					if msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID, timeoutType, ai.Details) == nil {
 						return errFailedToAddTimeoutEvent
 					}
 					updateHistory = true
@@ -423,7 +436,7 @@ Update_History_Loop:
 				{
 					t.metricsClient.IncCounter(metrics.TimerActiveTaskActivityTimeoutScope, metrics.StartToCloseTimeoutCounter)
 					if ai.StartedID != common.EmptyEventID {
-						if msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID, timeoutType, nil) == nil {
+						if msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID, timeoutType, ai.Details) == nil {
 							return errFailedToAddTimeoutEvent
 						}
 						updateHistory = true
@@ -475,7 +488,7 @@ Update_History_Loop:
 
 		return nil
 	}
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(t.shard.GetShardID(), timerTask.DomainID, timerTask.WorkflowID, timerTask.RunID)
 }
 
 func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(task *persistence.TimerTaskInfo) (retError error) {
@@ -509,12 +522,22 @@ Update_History_Loop:
 		}
 
 		scheduleNewDecision := false
+		stickyTimeout := int32(0)
 		switch task.TimeoutType {
 		case int(workflow.TimeoutTypeStartToClose):
 			t.metricsClient.IncCounter(metrics.TimerActiveTaskDecisionTimeoutScope, metrics.StartToCloseTimeoutCounter)
 			if di.Attempt == task.ScheduleAttempt {
 				// Add a decision task timeout event.
 				msBuilder.AddDecisionTaskTimedOutEvent(scheduleID, di.StartedID)
+				tBuilder := t.historyService.getTimerBuilder(context.getExecution())
+				if backoffTimer := tBuilder.AddDecisionRetryBackoffTimerTask(msBuilder.GetExecutionInfo().DecisionAttempt); backoffTimer != nil {
+					// the decision keeps timing out, its next attempt is scheduled by the backoff timer
+					err := t.updateWorkflowExecution(context, msBuilder, false, false, []persistence.Task{backoffTimer}, nil)
+					if err == ErrConflict {
+						continue Update_History_Loop
+					}
+					return err
+				}
 				scheduleNewDecision = true
 			}
 		case int(workflow.TimeoutTypeScheduleToStart):
@@ -522,6 +545,8 @@ Update_History_Loop:
 			// decision schedule to start timeout only apply to sticky decision
 			// check if scheduled decision still pending and not started yet
 			if di.Attempt == task.ScheduleAttempt && di.StartedID == common.EmptyEventID && msBuilder.IsStickyTaskListEnabled() {
+				// adding the timeout event clears stickiness, so capture the timeout first
+				stickyTimeout = msBuilder.GetExecutionInfo().StickyScheduleToStartTimeout
 				timeoutEvent := msBuilder.AddDecisionTaskScheduleToStartTimeoutEvent(scheduleID)
 				if timeoutEvent == nil {
 					// Unable to add DecisionTaskTimedout event to history
@@ -541,14 +566,33 @@ Update_History_Loop:
 				if err == ErrConflict {
 					continue Update_History_Loop
 				}
+				return err
 			}
-			return err
+			if stickyTimeout > 0 {
+				t.reportStickyDecisionScheduleLatency(task, scheduleID, stickyTimeout)
+			}
+			return nil
 		}
 
 		return nil
 
 	}
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(t.shard.GetShardID(), task.DomainID, task.WorkflowID, task.RunID)
+}
+
+func (t *timerQueueActiveProcessorImpl) reportStickyDecisionScheduleLatency(task *persistence.TimerTaskInfo,
+	scheduleID int64, stickyTimeout int32) {
+
+	domainName := ""
+	if domainEntry, err := t.shard.GetDomainCache().GetDomainByID(task.DomainID); err == nil {
+		domainName = domainEntry.GetInfo().Name
+	}
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+	t.historyService.reportDecisionScheduleLatency(metrics.TimerActiveTaskDecisionTimeoutScope, domainName, execution,
+		scheduleID, time.Duration(stickyTimeout)*time.Second, decisionScheduleLatencyCauseStickyTimeout)
 }
 
 func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(task *persistence.TimerTaskInfo) (retError error) {
@@ -559,9 +603,12 @@ func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(task *persis
 	}
 	defer func() { release(retError) }()
 
-	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
+	switch task.TimeoutType {
+	case persistence.WorkflowBackoffTimeoutTypeRetry:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
-	} else {
+	case persistence.WorkflowBackoffTimeoutTypeDecisionRetry:
+		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.DecisionRetryBackoffTimerCount)
+	default:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowCronBackoffTimerCount)
 	}
 
@@ -574,13 +621,21 @@ Update_History_Loop:
 			return nil
 		}
 
-		if msBuilder.GetPreviousStartedEventID() != common.EmptyEventID ||
-			msBuilder.HasPendingDecisionTask() {
+		if msBuilder.HasPendingDecisionTask() {
 			// already has decision task
 			return nil
 		}
+		if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeDecisionRetry {
+			if msBuilder.GetExecutionInfo().DecisionAttempt == 0 {
+				// the decision succeeded in the meantime
+				return nil
+			}
+		} else if msBuilder.GetPreviousStartedEventID() != common.EmptyEventID {
+			// first decision task already processed
+			return nil
+		}
 
-		// schedule first decision task
+		// schedule first decision task, or the next attempt of a failing decision
 		err = t.updateWorkflowExecution(context, msBuilder, true, false, nil, nil)
 		if err != nil {
 			if err == ErrConflict {
@@ -590,7 +645,7 @@ Update_History_Loop:
 		return err
 	}
 
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(t.shard.GetShardID(), task.DomainID, task.WorkflowID, task.RunID)
 }
 
 func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persistence.TimerTaskInfo) error {
@@ -653,6 +708,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 			Name: &ai.TaskList,
 		}
 		scheduleToStartTimeout := ai.ScheduleToStartTimeout
+		scheduledTimestamp := ai.ScheduledTime.UnixNano()
 
 		release(nil) // release earlier as we don't need the lock anymore
 		err = t.matchingClient.AddActivityTask(nil, &m.AddActivityTaskRequest{
@@ -662,6 +718,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 			TaskList:                      taskList,
 			ScheduleId:                    &scheduledID,
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+			ScheduledTimestamp:            common.Int64Ptr(scheduledTimestamp),
 		})
 
 		t.logger.Debug(fmt.Sprintf("Adding ActivityTask for retry, WorkflowID: %v, RunID: %v, ScheduledID: %v, TaskList: %v, Attempt: %v, Err: %v",
@@ -676,7 +733,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 		}
 	}
 
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(t.shard.GetShardID(), task.DomainID, task.WorkflowID, task.RunID)
 }
 
 func (t *timerQueueActiveProcessorImpl) processWorkflowTimeout(task *persistence.TimerTaskInfo) (retError error) {
@@ -739,7 +796,7 @@ Update_History_Loop:
 		}
 
 		// workflow timeout, but a retry or cron is needed, so we do continue as new to retry or cron
-		startEvent, err := getWorkflowStartedEvent(t.historyService.historyMgr, t.historyService.historyV2Mgr, msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), t.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(), common.IntPtr(t.shard.GetShardID()))
+		startEvent, err := getWorkflowStartedEvent(backgroundCallerContext, t.historyService.historyMgr, t.historyService.historyV2Mgr, msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), t.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(), common.IntPtr(t.shard.GetShardID()))
 		if err != nil {
 			return err
 		}
@@ -791,7 +848,7 @@ Update_History_Loop:
 		}
 
 		timersToNotify := append(timerTasks, msBuilder.GetContinueAsNew().TimerTasks...)
-		err = context.continueAsNewWorkflowExecution(nil, continueAsNewBuilder, transferTasks, timerTasks, transactionID)
+		err = context.continueAsNewWorkflowExecution(backgroundCallerContext, nil, continueAsNewBuilder, transferTasks, timerTasks, transactionID)
 
 		if err != nil {
 			if err == ErrConflict {
@@ -802,7 +859,7 @@ Update_History_Loop:
 		}
 		return err
 	}
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(t.shard.GetShardID(), task.DomainID, task.WorkflowID, task.RunID)
 }
 
 func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
@@ -818,7 +875,7 @@ func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 	var err error
 	if scheduleNewDecision {
 		// Schedule a new decision.
-		transferTasks, timerTasks, err = context.scheduleNewDecision(transferTasks, timerTasks)
+		transferTasks, timerTasks, err = context.scheduleNewDecision(backgroundCallerContext, transferTasks, timerTasks)
 		if err != nil {
 			return err
 		}
@@ -843,7 +900,7 @@ func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 		return err1
 	}
 
-	err = context.updateWorkflowExecutionWithDeleteTask(transferTasks, timerTasks, clearTimerTask, transactionID)
+	err = context.updateWorkflowExecutionWithDeleteTask(backgroundCallerContext, transferTasks, timerTasks, clearTimerTask, transactionID)
 	if err != nil {
 		if isShardOwnershiptLostError(err) {
 			// Shard is stolen.  Stop timer processing to reduce duplicates
@@ -207,23 +207,23 @@ func (s *timerQueueProcessor2Suite) TestTimerUpdateTimesOut() {
 		EventID:             di.ScheduleID}
 	timerIndexResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{timerTask}}
 
-	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(timerIndexResponse, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(timerIndexResponse, nil).Once()
 
 	for i := 0; i < 2; i++ {
 		ms := createMutableState(builder)
 		wfResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(wfResponse, nil).Once()
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(wfResponse, nil).Once()
 	}
 
-	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(
 		&persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}, nil)
 
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, errors.New("FAILED")).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, errors.New("FAILED")).Once()
 	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil)
 
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
 		// Done.
 		waitCh <- struct{}{}
 	}).Once()
@@ -243,6 +243,102 @@ func (s *timerQueueProcessor2Suite) TestTimerUpdateTimesOut() {
 	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Stop()
 }
 
+func (s *timerQueueProcessor2Suite) TestActivityStartToCloseTimeout_KeepsHeartbeatDetails() {
+	domainID := testDomainActiveID
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("activity-timesout-details-test"),
+		RunId: common.StringPtr(validRunID)}
+	taskList := "task-activity-times-out"
+	heartbeatDetails := []byte("heartbeat-progress")
+
+	builder := newMutableStateBuilderWithEventV2(cluster.TestCurrentClusterName, s.mockShard, s.mockEventsCache, s.logger, we.GetRunId())
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                            common.TaskListPtr(workflow.TaskList{Name: common.StringPtr(taskList)}),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+	}
+	builder.AddWorkflowExecutionStartedEvent(we, &history.StartWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		StartRequest: startRequest,
+	})
+
+	di := addDecisionTaskScheduledEvent(builder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(builder, di.ScheduleID, taskList, uuid.New())
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(builder, di.ScheduleID, decisionStartedEvent.GetEventId(), nil, "identity")
+	activityScheduledEvent, ai := addActivityTaskScheduledEvent(builder, decisionCompletedEvent.GetEventId(), "activity",
+		"activity_type", taskList, nil, 100, 10, 0)
+	addActivityTaskStartedEvent(builder, activityScheduledEvent.GetEventId(), taskList, "identity")
+	builder.UpdateActivityProgress(ai, &workflow.RecordActivityTaskHeartbeatRequest{Details: heartbeatDetails})
+	// the activity was started long enough ago for its start to close timeout to have expired
+	ai.ScheduledTime = ai.ScheduledTime.Add(-time.Minute)
+	ai.StartedTime = ai.ScheduledTime
+
+	waitCh := make(chan struct{})
+
+	mockTS := &mockTimeSource{currTime: time.Now()}
+
+	taskID := int64(100)
+	timerTask := &persistence.TimerTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          we.GetWorkflowId(),
+		RunID:               we.GetRunId(),
+		TaskID:              taskID,
+		TaskType:            persistence.TaskTypeActivityTimeout,
+		TimeoutType:         int(workflow.TimeoutTypeStartToClose),
+		VisibilityTimestamp: mockTS.Now(),
+		EventID:             activityScheduledEvent.GetEventId()}
+	timerIndexResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{timerTask}}
+
+	ms := createMutableState(builder)
+	wfResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(wfResponse, nil).Once()
+
+	var timedOutAttributes *workflow.ActivityTaskTimedOutEventAttributes
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(arguments mock.Arguments) {
+		request := arguments.Get(1).(*p.AppendHistoryNodesRequest)
+		for _, event := range request.Events {
+			if event.GetEventType() == workflow.EventTypeActivityTaskTimedOut {
+				timedOutAttributes = event.ActivityTaskTimedOutEventAttributes
+			}
+		}
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		// Done.
+		waitCh <- struct{}{}
+	}).Once()
+
+	// Start timer Processor.
+	emptyResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+		waitCh <- struct{}{}
+	}).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+		waitCh <- struct{}{}
+	}).Once() // for lookAheadTask
+	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Start()
+	<-waitCh
+	<-waitCh
+
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(timerIndexResponse, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(emptyResponse, nil) // for lookAheadTask
+	s.mockHistoryEngine.timerProcessor.NotifyNewTimers(
+		cluster.TestCurrentClusterName,
+		s.mockShard.GetCurrentTime(cluster.TestCurrentClusterName),
+		[]persistence.Task{&persistence.ActivityTimeoutTask{
+			VisibilityTimestamp: timerTask.VisibilityTimestamp,
+			TimeoutType:         timerTask.TimeoutType,
+			EventID:             timerTask.EventID,
+		}})
+
+	<-waitCh
+	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Stop()
+
+	s.NotNil(timedOutAttributes)
+	s.Equal(workflow.TimeoutTypeStartToClose, timedOutAttributes.GetTimeoutType())
+	s.Equal(heartbeatDetails, timedOutAttributes.Details)
+}
+
 func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 	domainID := testDomainActiveID
 	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("workflow-timesout-test"),
@@ -283,10 +379,10 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 
 	ms := createMutableState(builder)
 	wfResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(wfResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(wfResponse, nil).Once()
 
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
 		// Done.
 		waitCh <- struct{}{}
 	}).Once()
@@ -295,18 +391,18 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 
 	// Start timer Processor.
 	emptyResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}
-	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
 		waitCh <- struct{}{}
 	}).Once()
-	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
 		waitCh <- struct{}{}
 	}).Once() // for lookAheadTask
 	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Start()
 	<-waitCh
 	<-waitCh
 
-	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(timerIndexResponse, nil).Once()
-	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil) // for lookAheadTask
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(timerIndexResponse, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything, mock.Anything).Return(emptyResponse, nil) // for lookAheadTask
 	s.mockHistoryEngine.timerProcessor.NotifyNewTimers(
 		cluster.TestCurrentClusterName,
 		s.mockShard.GetCurrentTime(cluster.TestCurrentClusterName),
@@ -317,3 +413,77 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 	<-waitCh
 	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Stop()
 }
+
+func (s *timerQueueProcessor2Suite) TestDeleteHistoryEvent_DeletesMutableStateLast() {
+	s.mockClusterMetadata.On("ArchivalConfig").Return(cluster.NewArchivalConfig(cluster.ArchivalDisabled, "", false))
+	timerTask := s.prepareDeleteHistoryEvent()
+
+	// mutable state has to outlive the history branch, so a retry can still locate it
+	var deleted []string
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything, mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+		deleted = append(deleted, "history")
+	}).Once()
+	s.mockVisibilityMgr.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+		deleted = append(deleted, "visibility")
+	}).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+		deleted = append(deleted, "execution")
+	}).Once()
+
+	err := s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
+	s.Nil(err)
+	s.Equal([]string{"history", "visibility", "execution"}, deleted)
+}
+
+func (s *timerQueueProcessor2Suite) TestDeleteHistoryEvent_DomainRemoved() {
+	s.mockClusterMetadata.On("ArchivalConfig").Return(cluster.NewArchivalConfig(cluster.ArchivalEnabled, "test-bucket", false))
+	s.mockMetadataMgr.ExpectedCalls = nil
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(nil, &workflow.EntityNotExistsError{})
+	timerTask := s.prepareDeleteHistoryEvent()
+
+	// there is nothing left to archive to, so the workflow is deleted instead of retrying the task
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockVisibilityMgr.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
+	s.Nil(err)
+}
+
+func (s *timerQueueProcessor2Suite) prepareDeleteHistoryEvent() *persistence.TimerTaskInfo {
+	domainID := testDomainActiveID
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("delete-history-event-test"),
+		RunId: common.StringPtr(validRunID)}
+	taskList := "task-delete-history-event"
+	s.mockHistoryEngine.visibilityMgr = s.mockVisibilityMgr
+
+	builder := newMutableStateBuilderWithEventV2(cluster.TestCurrentClusterName, s.mockShard, s.mockEventsCache, s.logger, we.GetRunId())
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return().Once()
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                            common.TaskListPtr(workflow.TaskList{Name: common.StringPtr(taskList)}),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+	}
+	builder.AddWorkflowExecutionStartedEvent(we, &history.StartWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		StartRequest: startRequest,
+	})
+	di := addDecisionTaskScheduledEvent(builder)
+
+	ms := createMutableState(builder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	ms.ExecutionInfo.CloseStatus = persistence.WorkflowCloseStatusCompleted
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+
+	return &persistence.TimerTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          we.GetWorkflowId(),
+		RunID:               we.GetRunId(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeDeleteHistoryEvent,
+		VisibilityTimestamp: time.Now(),
+		EventID:             di.ScheduleID,
+	}
+}
@@ -74,6 +74,8 @@ type (
 		rateLimiter      tokenbucket.TokenBucket
 		startDelay       dynamicconfig.DurationPropertyFn
 		retryPolicy      backoff.RetryPolicy
+		// when set, tasks are executed by the shared task scheduler instead of the workers below
+		taskScheduler *taskScheduler
 
 		// worker coroutines notification
 		workerNotificationChans []chan struct{}
@@ -87,6 +89,18 @@ type (
 		newTimeLock sync.Mutex
 		newTime     time.Time
 	}
+
+	// timerSchedulerTask adapts a timer task to be executed by the task scheduler
+	timerSchedulerTask struct {
+		processor         *timerQueueProcessorBase
+		task              *persistence.TimerTaskInfo
+		logger            log.Logger
+		startTime         time.Time
+		attempt           int
+		filtered          bool
+		shouldProcessTask bool
+		scope             int
+	}
 )
 
 func newTimerQueueProcessorBase(scope int, shard ShardContext, historyService *historyEngineImpl,
@@ -162,10 +176,12 @@ func (t *timerQueueProcessorBase) processorPump() {
 	defer t.shutdownWG.Done()
 
 	var workerWG sync.WaitGroup
-	for i := 0; i < t.numOfWorker; i++ {
-		workerWG.Add(1)
-		notificationChan := t.workerNotificationChans[i]
-		go t.taskWorker(&workerWG, notificationChan)
+	if t.taskScheduler == nil {
+		for i := 0; i < t.numOfWorker; i++ {
+			workerWG.Add(1)
+			notificationChan := t.workerNotificationChans[i]
+			go t.taskWorker(&workerWG, notificationChan)
+		}
 	}
 
 RetryProcessor:
@@ -371,6 +387,13 @@ func (t *timerQueueProcessorBase) readAndFanoutTimerTasks() (*persistence.TimerT
 	}
 
 	for _, task := range timerTasks {
+		if t.taskScheduler != nil {
+			if !t.taskScheduler.submit(t.newSchedulerTask(task), t.shutdownCh) {
+				return nil, nil
+			}
+			continue
+		}
+
 		// We have a timer to fire.
 		select {
 		case t.tasksCh <- task:
@@ -457,6 +480,15 @@ FilterLoop:
 	}
 }
 
+func (t *timerQueueProcessorBase) newSchedulerTask(task *persistence.TimerTaskInfo) *timerSchedulerTask {
+	return &timerSchedulerTask{
+		processor: t,
+		task:      task,
+		logger:    t.initializeLoggerForTask(task),
+		startTime: time.Now(),
+	}
+}
+
 func (t *timerQueueProcessorBase) processTaskOnce(notificationChan <-chan struct{}, task *persistence.TimerTaskInfo, shouldProcessTask bool, logger log.Logger) (int, error) {
 	select {
 	case <-notificationChan:
@@ -584,11 +616,16 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 	}
 
 	clusterArchivalStatus := t.shard.GetService().GetClusterMetadata().ArchivalConfig().GetArchivalStatus()
+	domainArchivalStatus := workflow.ArchivalStatusDisabled
 	domainCacheEntry, err := t.historyService.shard.GetDomainCache().GetDomainByID(task.DomainID)
 	if err != nil {
-		return err
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+			return err
+		}
+		// the domain has been removed, there is nothing left to archive to, so just delete
+	} else {
+		domainArchivalStatus = domainCacheEntry.GetConfig().ArchivalStatus
 	}
-	domainArchivalStatus := domainCacheEntry.GetConfig().ArchivalStatus
 	switch clusterArchivalStatus {
 	case cluster.ArchivalDisabled:
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
@@ -609,17 +646,19 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 }
 
 func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
-	err := t.deleteWorkflowExecution(task)
+	// mutable state is deleted last, so that a retry after a partial failure can still
+	// load it and locate the history branch that has not been removed yet
+	err := t.deleteWorkflowHistory(task, msBuilder)
 	if err != nil {
 		return err
 	}
 
-	err = t.deleteWorkflowHistory(task, msBuilder)
+	err = t.deleteWorkflowVisibility(task)
 	if err != nil {
 		return err
 	}
 
-	err = t.deleteWorkflowVisibility(task)
+	err = t.deleteWorkflowExecution(task)
 	if err != nil {
 		return err
 	}
@@ -669,7 +708,7 @@ func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInf
 
 func (t *timerQueueProcessorBase) deleteWorkflowExecution(task *persistence.TimerTaskInfo) error {
 	op := func() error {
-		return t.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		return t.executionManager.DeleteWorkflowExecution(backgroundCallerContext, &persistence.DeleteWorkflowExecutionRequest{
 			DomainID:   task.DomainID,
 			WorkflowID: task.WorkflowID,
 			RunID:      task.RunID,
@@ -689,7 +728,7 @@ func (t *timerQueueProcessorBase) deleteWorkflowHistory(task *persistence.TimerT
 				tag.TaskID(task.GetTaskID()),
 				tag.FailoverVersion(task.GetVersion()),
 				tag.TaskType(task.GetTaskType()))
-			return persistence.DeleteWorkflowExecutionHistoryV2(t.historyService.historyV2Mgr, msBuilder.GetCurrentBranch(), common.IntPtr(t.shard.GetShardID()), logger)
+			return persistence.DeleteWorkflowExecutionHistoryV2(backgroundCallerContext, t.historyService.historyV2Mgr, msBuilder.GetCurrentBranch(), common.IntPtr(t.shard.GetShardID()), logger)
 		}
 		return t.historyService.historyMgr.DeleteWorkflowExecutionHistory(
 			&persistence.DeleteWorkflowExecutionHistoryRequest{
@@ -726,3 +765,49 @@ func (t *timerQueueProcessorBase) getTimerTaskType(taskType int) string {
 	}
 	return "UnKnown"
 }
+
+func (t *timerSchedulerTask) getDomainID() string {
+	return t.task.DomainID
+}
+
+func (t *timerSchedulerTask) getPriority() int {
+	return getTimerTaskPriority(t.task.TaskType)
+}
+
+func (t *timerSchedulerTask) execute() error {
+	p := t.processor
+	select {
+	case <-p.shutdownCh:
+		// this must return without ack
+		return nil
+	default:
+	}
+
+	var err error
+	if !t.filtered {
+		t.shouldProcessTask, err = p.timerProcessor.getTaskFilter()(t.task)
+		if err != nil {
+			t.incAttempt(err)
+			return err
+		}
+		t.filtered = true
+	}
+
+	t.scope, err = p.processTaskOnce(closedNotificationChan, t.task, t.shouldProcessTask, t.logger)
+	err = p.handleTaskError(t.scope, t.startTime, closedNotificationChan, err, t.logger)
+	if err != nil {
+		t.incAttempt(err)
+		return err
+	}
+
+	p.ackTaskOnce(t.task, t.scope, t.shouldProcessTask, t.startTime, t.attempt)
+	return nil
+}
+
+func (t *timerSchedulerTask) incAttempt(err error) {
+	t.attempt++
+	if t.attempt >= t.processor.config.TimerTaskMaxRetryCount() {
+		t.processor.metricsClient.RecordTimer(t.scope, metrics.TaskAttemptTimer, time.Duration(t.attempt))
+		t.logger.Error("Critical error processing timer task, retrying.", tag.Error(err), tag.OperationCritical)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/historyrender"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
@@ -974,5 +975,5 @@ func (s *timerQueueProcessorSuite) TestTimersOnClosedWorkflow() {
 }
 
 func (s *timerQueueProcessorSuite) printHistory(builder mutableState) string {
-	return builder.GetHistoryBuilder().GetHistory().String()
+	return historyrender.String(builder.GetHistoryBuilder().GetHistory().Events)
 }
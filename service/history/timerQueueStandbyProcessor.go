@@ -319,7 +319,7 @@ func (t *timerQueueStandbyProcessorImpl) processActivityTimeout(timerTask *persi
 		// since the job being done here is update the activity and possibly write a timer task to DB
 		// also need to reset the current version.
 		msBuilder.UpdateReplicationStateVersion(lastWriteVersion, true)
-		err = context.updateHelper(nil, newTimerTasks, transactionID, now, false, nil, sourceCluster)
+		err = context.updateHelper(backgroundCallerContext, nil, newTimerTasks, transactionID, now, false, nil, sourceCluster)
 		if err == nil {
 			t.notifyNewTimers(newTimerTasks)
 		}
@@ -382,6 +382,11 @@ func (t *timerQueueStandbyProcessorImpl) processWorkflowBackoffTimer(timerTask *
 		}
 	}
 
+	if timerTask.TimeoutType == persistence.WorkflowBackoffTimeoutTypeDecisionRetry {
+		// the attempts of a failing decision are transient, there is nothing replicated to wait for
+		return nil
+	}
+
 	return t.processTimer(timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
 
 		if msBuilder.GetPreviousStartedEventID() != common.EmptyEventID ||
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cron"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -71,6 +72,7 @@ func newTransferQueueActiveProcessor(shard ShardContext, historyService *history
 		UpdateAckInterval:                  config.TransferProcessorUpdateAckInterval,
 		UpdateAckIntervalJitterCoefficient: config.TransferProcessorUpdateAckIntervalJitterCoefficient,
 		MaxRetryCount:                      config.TransferTaskMaxRetryCount,
+		EnableDLQ:                          config.TransferTaskDLQEnabled,
 		MetricScope:                        metrics.TransferActiveQueueProcessorScope,
 	}
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
@@ -108,8 +110,13 @@ func newTransferQueueActiveProcessor(shard ShardContext, historyService *history
 		),
 	}
 
-	queueAckMgr := newQueueAckMgr(shard, options, processor, shard.GetTransferClusterAckLevel(currentClusterName), logger)
+	updateTransferQueueState := func(state persistence.TransferQueueState) error {
+		return shard.UpdateTransferClusterQueueState(currentClusterName, state)
+	}
+	queueAckMgr := newQueueAckMgrWithState(shard, options, processor,
+		shard.GetTransferClusterQueueState(currentClusterName), updateTransferQueueState, logger)
 	queueProcessorBase := newQueueProcessorBase(currentClusterName, shard, options, processor, queueAckMgr, logger)
+	queueProcessorBase.taskScheduler = historyService.taskScheduler
 	processor.queueAckMgr = queueAckMgr
 	processor.queueProcessorBase = queueProcessorBase
 
@@ -131,6 +138,7 @@ func newTransferQueueFailoverProcessor(shard ShardContext, historyService *histo
 		UpdateAckInterval:                  config.TransferProcessorUpdateAckInterval,
 		UpdateAckIntervalJitterCoefficient: config.TransferProcessorUpdateAckIntervalJitterCoefficient,
 		MaxRetryCount:                      config.TransferTaskMaxRetryCount,
+		EnableDLQ:                          config.TransferTaskDLQEnabled,
 		MetricScope:                        metrics.TransferActiveQueueProcessorScope,
 	}
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
@@ -186,6 +194,7 @@ func newTransferQueueFailoverProcessor(shard ShardContext, historyService *histo
 
 	queueAckMgr := newQueueFailoverAckMgr(shard, options, processor, minLevel, logger)
 	queueProcessorBase := newQueueProcessorBase(currentClusterName, shard, options, processor, queueAckMgr, logger)
+	queueProcessorBase.taskScheduler = historyService.taskScheduler
 	processor.queueAckMgr = queueAckMgr
 	processor.queueProcessorBase = queueProcessorBase
 	return updateTransferAckLevel, processor
@@ -244,10 +253,8 @@ func (t *transferQueueActiveProcessorImpl) process(qTask queueTaskInfo, shouldPr
 		return metrics.TransferActiveTaskStartChildExecutionScope, err
 
 	case persistence.TransferTaskTypeRecordWorkflowStarted:
-		if shouldProcessTask {
-			err = t.processRecordWorkflowStarted(task)
-		}
-		return metrics.TransferActiveTaskRecordWorkflowStartedScope, err
+		// visibility records are written by the visibility queue processor
+		return metrics.TransferActiveTaskRecordWorkflowStartedScope, nil
 
 	default:
 		return metrics.TransferActiveQueueProcessorScope, errUnknownTransferTask
@@ -288,10 +295,11 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	scheduledTimestamp := ai.ScheduledTime.UnixNano()
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, timeout)
+	return t.pushActivity(task, timeout, scheduledTimestamp)
 }
 
 func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence.TransferTaskInfo) (retError error) {
@@ -334,6 +342,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	executionInfo := msBuilder.GetExecutionInfo()
 	workflowTimeout := executionInfo.WorkflowTimeout
 	decisionTimeout := common.MinInt32(workflowTimeout, common.MaxTaskTimeout)
+	scheduledTimestamp := di.Timestamp
 
 	// NOTE: previously this section check whether mutable state has enabled
 	// sticky decision, if so convert the decision to a sticky decision.
@@ -350,7 +359,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(task, tasklist, decisionTimeout)
+	return t.pushDecision(task, tasklist, decisionTimeout, scheduledTimestamp)
 }
 
 func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persistence.TransferTaskInfo) (retError error) {
@@ -384,71 +393,44 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 		return nil
 	}
 
+	// the visibility record of the closed workflow is written by the visibility queue processor,
+	// here we only need to communicate the result to parent execution if this is Child Workflow execution
 	executionInfo := msBuilder.GetExecutionInfo()
 	replyToParentWorkflow := msBuilder.HasParentExecution() && executionInfo.CloseStatus != persistence.WorkflowCloseStatusContinuedAsNew
+	if !replyToParentWorkflow {
+		return nil
+	}
+
 	completionEvent, ok := msBuilder.GetCompletionEvent()
-	var wfCloseTime int64
 	if !ok {
-		if replyToParentWorkflow {
-			return &workflow.InternalServiceError{Message: "Unable to get workflow completion event."}
-		}
-
-		// This is need for backwards compatibility
-		// TODO: remove usage of getLastUpdatedTimestamp after release 0.5.4, only use completionEvent timestamp
-		wfCloseTime = getLastUpdatedTimestamp(msBuilder)
-	} else {
-		wfCloseTime = completionEvent.GetTimestamp()
+		return &workflow.InternalServiceError{Message: "Unable to get workflow completion event."}
 	}
 	parentDomainID := executionInfo.ParentDomainID
 	parentWorkflowID := executionInfo.ParentWorkflowID
 	parentRunID := executionInfo.ParentRunID
 	initiatedID := executionInfo.InitiatedID
 
-	workflowTypeName := executionInfo.WorkflowTypeName
-	workflowStartTimestamp := executionInfo.StartTimestamp.UnixNano()
-	workflowCloseTimestamp := wfCloseTime
-	workflowCloseStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
-	workflowHistoryLength := msBuilder.GetNextEventID() - 1
-
-	startEvent, ok := msBuilder.GetStartEvent()
-	if !ok && replyToParentWorkflow {
-		return &workflow.InternalServiceError{Message: "Unable to get workflow start event."}
-	}
-	workflowExecutionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
-	visibilityMemo := getVisibilityMemo(startEvent)
-
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	err = t.recordWorkflowClosed(
-		domainID, execution, workflowTypeName, workflowStartTimestamp, workflowExecutionTimestamp.UnixNano(),
-		workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, task.GetTaskID(), visibilityMemo,
-	)
-	if err != nil {
-		return err
-	}
-
-	// Communicate the result to parent execution if this is Child Workflow execution
-	if replyToParentWorkflow {
-		err = t.historyClient.RecordChildExecutionCompleted(nil, &h.RecordChildExecutionCompletedRequest{
-			DomainUUID: common.StringPtr(parentDomainID),
-			WorkflowExecution: &workflow.WorkflowExecution{
-				WorkflowId: common.StringPtr(parentWorkflowID),
-				RunId:      common.StringPtr(parentRunID),
-			},
-			InitiatedId: common.Int64Ptr(initiatedID),
-			CompletedExecution: &workflow.WorkflowExecution{
-				WorkflowId: common.StringPtr(task.WorkflowID),
-				RunId:      common.StringPtr(task.RunID),
-			},
-			CompletionEvent: completionEvent,
-		})
+	err = t.historyClient.RecordChildExecutionCompleted(nil, &h.RecordChildExecutionCompletedRequest{
+		DomainUUID: common.StringPtr(parentDomainID),
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(parentWorkflowID),
+			RunId:      common.StringPtr(parentRunID),
+		},
+		InitiatedId: common.Int64Ptr(initiatedID),
+		CompletedExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(task.RunID),
+		},
+		CompletionEvent: completionEvent,
+	})
 
-		// Check to see if the error is non-transient, in which case reset the error and continue with processing
-		switch err.(type) {
-		case *workflow.EntityNotExistsError:
-			err = nil
-		}
+	// Check to see if the error is non-transient, in which case reset the error and continue with processing
+	switch err.(type) {
+	case *workflow.EntityNotExistsError:
+		err = nil
 	}
 	return err
 }
@@ -838,49 +820,6 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) processRecordWorkflowStarted(task *persistence.TransferTaskInfo) (retError error) {
-	var err error
-	execution := workflow.WorkflowExecution{
-		WorkflowId: common.StringPtr(task.WorkflowID),
-		RunId:      common.StringPtr(task.RunID),
-	}
-
-	// get workflow timeout
-	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
-	if err != nil {
-		return err
-	}
-	defer func() { release(retError) }()
-
-	var msBuilder mutableState
-	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
-	if err != nil {
-		return err
-	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
-		return nil
-	}
-
-	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, msBuilder.GetStartVersion(), task.Version, task)
-	if err != nil {
-		return err
-	} else if !ok {
-		return nil
-	}
-
-	executionInfo := msBuilder.GetExecutionInfo()
-	workflowTimeout := executionInfo.WorkflowTimeout
-	wfTypeName := executionInfo.WorkflowTypeName
-	startTimestamp := executionInfo.StartTimestamp.UnixNano()
-	startEvent, _ := msBuilder.GetStartEvent()
-	executionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
-	visibilityMemo := getVisibilityMemo(startEvent)
-
-	// release the context lock since we no longer need mutable state builder and
-	// the rest of logic is making RPC call, which takes time.
-	release(nil)
-	return t.recordWorkflowStarted(task.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(), workflowTimeout, task.GetTaskID(), visibilityMemo)
-}
-
 func (t *transferQueueActiveProcessorImpl) recordChildExecutionStarted(task *persistence.TransferTaskInfo,
 	context workflowExecutionContext, initiatedAttributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes,
 	runID string) error {
@@ -1065,7 +1004,7 @@ func (t *transferQueueActiveProcessorImpl) updateWorkflowExecution(domainID stri
 	createDecisionTask bool, action func(builder mutableState) error) error {
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		msBuilder, err1 := context.loadWorkflowExecution()
+		msBuilder, err1 := context.loadWorkflowExecution(backgroundCallerContext)
 		if err1 != nil {
 			return err1
 		}
@@ -1079,7 +1018,7 @@ Update_History_Loop:
 		if createDecisionTask {
 			// Create a transfer task to schedule a decision task
 			var err error
-			transferTasks, timerTasks, err = context.scheduleNewDecision(transferTasks, timerTasks)
+			transferTasks, timerTasks, err = context.scheduleNewDecision(backgroundCallerContext, transferTasks, timerTasks)
 			if err != nil {
 				return err
 			}
@@ -1093,7 +1032,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(backgroundCallerContext, transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
 			}
@@ -1105,7 +1044,12 @@ Update_History_Loop:
 		return nil
 	}
 
-	return ErrMaxAttemptsExceeded
+	return ce.NewMaxAttemptsExceededError(
+		t.shard.GetShardID(),
+		context.getDomainID(),
+		context.getExecution().GetWorkflowId(),
+		context.getExecution().GetRunId(),
+	)
 }
 
 func (t *transferQueueActiveProcessorImpl) SignalExecutionWithRetry(signalRequest *h.SignalWorkflowExecutionRequest) error {
@@ -62,12 +62,14 @@ type (
 		shutdownChan          chan struct{}
 		activeTaskProcessor   *transferQueueActiveProcessorImpl
 		standbyTaskProcessors map[string]*transferQueueStandbyProcessorImpl
+		visibilityProcessor   *visibilityQueueProcessorImpl
 	}
 )
 
 func newTransferQueueProcessor(shard ShardContext, historyService *historyEngineImpl,
 	visibilityMgr persistence.VisibilityManager, matchingClient matching.Client,
 	historyClient history.Client, logger log.Logger) *transferQueueProcessorImpl {
+	visibilityProcessor := newVisibilityQueueProcessor(shard, historyService, visibilityMgr, matchingClient, logger)
 	logger = logger.WithTags(tag.ComponentTransferQueue)
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 	taskAllocator := newTaskAllocator(shard)
@@ -108,6 +110,7 @@ func newTransferQueueProcessor(shard ShardContext, historyService *historyEngine
 		shutdownChan:          make(chan struct{}),
 		activeTaskProcessor:   newTransferQueueActiveProcessor(shard, historyService, visibilityMgr, matchingClient, historyClient, taskAllocator, logger),
 		standbyTaskProcessors: standbyTaskProcessors,
+		visibilityProcessor:   visibilityProcessor,
 	}
 }
 
@@ -121,6 +124,7 @@ func (t *transferQueueProcessorImpl) Start() {
 			standbyTaskProcessor.Start()
 		}
 	}
+	t.visibilityProcessor.Start()
 
 	go t.completeTransferLoop()
 }
@@ -135,12 +139,18 @@ func (t *transferQueueProcessorImpl) Stop() {
 			standbyTaskProcessor.Stop()
 		}
 	}
+	t.visibilityProcessor.Stop()
 	close(t.shutdownChan)
 }
 
 // NotifyNewTask - Notify the processor about the new active / standby transfer task arrival.
 // This should be called each time new transfer task arrives, otherwise tasks maybe delayed.
 func (t *transferQueueProcessorImpl) NotifyNewTask(clusterName string, transferTasks []persistence.Task) {
+	// visibility tasks are processed regardless of which cluster the domain is active in
+	if len(transferTasks) != 0 {
+		t.visibilityProcessor.notifyNewTask()
+	}
+
 	if clusterName == t.currentClusterName {
 		// we will ignore the current time passed in, since the active processor process task immediately
 		if len(transferTasks) != 0 {
@@ -199,6 +209,11 @@ func (t *transferQueueProcessorImpl) UnlockTaskPrrocessing() {
 	t.taskAllocator.unlock()
 }
 
+// ProcessDLQTask makes one attempt of a task read back from the DLQ using the active processor
+func (t *transferQueueProcessorImpl) ProcessDLQTask(task *persistence.TransferTaskInfo) error {
+	return t.activeTaskProcessor.processDLQTask(task)
+}
+
 func (t *transferQueueProcessorImpl) completeTransferLoop() {
 	timer := time.NewTimer(t.config.TransferProcessorCompleteTransferInterval())
 	defer timer.Stop()
@@ -230,6 +245,11 @@ func (t *transferQueueProcessorImpl) completeTransfer() error {
 	lowerAckLevel := t.ackLevel
 	upperAckLevel := t.activeTaskProcessor.queueAckMgr.getQueueAckLevel()
 
+	// transfer tasks carrying visibility records must not be deleted before they are written
+	if ackLevel := t.visibilityProcessor.queueAckMgr.getQueueAckLevel(); upperAckLevel > ackLevel {
+		upperAckLevel = ackLevel
+	}
+
 	if t.isGlobalDomainEnabled {
 		for _, standbyTaskProcessor := range t.standbyTaskProcessors {
 			ackLevel := standbyTaskProcessor.queueAckMgr.getQueueAckLevel()
@@ -253,7 +273,7 @@ func (t *transferQueueProcessorImpl) completeTransfer() error {
 	t.metricsClient.IncCounter(metrics.TransferQueueProcessorScope, metrics.TaskBatchCompleteCounter)
 
 	if lowerAckLevel < upperAckLevel {
-		err := t.shard.GetExecutionManager().RangeCompleteTransferTask(&persistence.RangeCompleteTransferTaskRequest{
+		err := t.shard.GetExecutionManager().RangeCompleteTransferTask(backgroundCallerContext, &persistence.RangeCompleteTransferTaskRequest{
 			ExclusiveBeginTaskID: lowerAckLevel,
 			InclusiveEndTaskID:   upperAckLevel,
 		})
@@ -70,7 +70,7 @@ func newTransferQueueProcessorBase(shard ShardContext, options *QueueProcessorOp
 }
 
 func (t *transferQueueProcessorBase) readTasks(readLevel int64) ([]queueTaskInfo, bool, error) {
-	response, err := t.executionManager.GetTransferTasks(&persistence.GetTransferTasksRequest{
+	response, err := t.executionManager.GetTransferTasks(backgroundCallerContext, &persistence.GetTransferTasksRequest{
 		ReadLevel:    readLevel,
 		MaxReadLevel: t.maxReadAckLevel(),
 		BatchSize:    t.options.BatchSize(),
@@ -96,7 +96,8 @@ func (t *transferQueueProcessorBase) queueShutdown() error {
 	return t.transferQueueShutdown()
 }
 
-func (t *transferQueueProcessorBase) pushActivity(task *persistence.TransferTaskInfo, activityScheduleToStartTimeout int32) error {
+func (t *transferQueueProcessorBase) pushActivity(task *persistence.TransferTaskInfo, activityScheduleToStartTimeout int32,
+	scheduledTimestamp int64) error {
 	if task.TaskType != persistence.TransferTaskTypeActivityTask {
 		t.logger.Fatal("Cannot process non activity task", tag.TaskType(task.GetTaskType()))
 	}
@@ -111,12 +112,14 @@ func (t *transferQueueProcessorBase) pushActivity(task *persistence.TransferTask
 		TaskList:                      &workflow.TaskList{Name: &task.TaskList},
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
+		ScheduledTimestamp:            common.Int64Ptr(scheduledTimestamp),
 	})
 
 	return err
 }
 
-func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTaskInfo, tasklist *workflow.TaskList, decisionScheduleToStartTimeout int32) error {
+func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTaskInfo, tasklist *workflow.TaskList, decisionScheduleToStartTimeout int32,
+	scheduledTimestamp int64) error {
 	if task.TaskType != persistence.TransferTaskTypeDecisionTask {
 		t.logger.Fatal("Cannot process non decision task", tag.TaskType(task.GetTaskType()))
 	}
@@ -130,6 +133,7 @@ func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTask
 		TaskList:                      tasklist,
 		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
+		ScheduledTimestamp:            common.Int64Ptr(scheduledTimestamp),
 	})
 
 	return err
@@ -137,7 +141,8 @@ func (t *transferQueueProcessorBase) pushDecision(task *persistence.TransferTask
 
 func (t *transferQueueProcessorBase) recordWorkflowStarted(
 	domainID string, execution workflow.WorkflowExecution, workflowTypeName string, startTimeUnixNano,
-	executionTimeUnixNano int64, workflowTimeout int32, taskID int64, visibilityMemo *workflow.Memo) error {
+	executionTimeUnixNano int64, workflowTimeout int32, taskID int64, visibilityMemo *workflow.Memo,
+	searchAttributes map[string][]byte) error {
 
 	domain := defaultDomainName
 	isSampledEnabled := false
@@ -168,6 +173,7 @@ func (t *transferQueueProcessorBase) recordWorkflowStarted(
 		WorkflowTimeout:    int64(workflowTimeout),
 		TaskID:             taskID,
 		Memo:               visibilityMemo,
+		SearchAttributes:   searchAttributes,
 	}
 
 	return t.visibilityMgr.RecordWorkflowExecutionStarted(request)
@@ -243,3 +249,10 @@ func getVisibilityMemo(startEvent *workflow.HistoryEvent) *workflow.Memo {
 	}
 	return startEvent.WorkflowExecutionStartedEventAttributes.Memo
 }
+
+func getSearchAttributes(startEvent *workflow.HistoryEvent) map[string][]byte {
+	if startEvent == nil {
+		return nil
+	}
+	return startEvent.WorkflowExecutionStartedEventAttributes.GetSearchAttributes().GetIndexedFields()
+}
@@ -101,7 +101,11 @@ func newTransferQueueStandbyProcessor(clusterName string, shard ShardContext, hi
 		historyRereplicator: historyRereplicator,
 	}
 
-	queueAckMgr := newQueueAckMgr(shard, options, processor, shard.GetTransferClusterAckLevel(clusterName), logger)
+	updateTransferQueueState := func(state persistence.TransferQueueState) error {
+		return shard.UpdateTransferClusterQueueState(clusterName, state)
+	}
+	queueAckMgr := newQueueAckMgrWithState(shard, options, processor,
+		shard.GetTransferClusterQueueState(clusterName), updateTransferQueueState, logger)
 	queueProcessorBase := newQueueProcessorBase(clusterName, shard, options, processor, queueAckMgr, logger)
 	processor.queueAckMgr = queueAckMgr
 	processor.queueProcessorBase = queueProcessorBase
@@ -139,9 +143,9 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo, shouldP
 		return metrics.TransferStandbyTaskDecisionScope, err
 
 	case persistence.TransferTaskTypeCloseExecution:
-		// guarantee the processing of workflow execution close
-		err = t.processCloseExecution(task)
-		return metrics.TransferStandbyTaskCloseExecutionScope, err
+		// visibility records are written by the visibility queue processor,
+		// and the parent is notified by the active cluster
+		return metrics.TransferStandbyTaskCloseExecutionScope, nil
 
 	case persistence.TransferTaskTypeCancelExecution:
 		if shouldProcessTask {
@@ -162,10 +166,8 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo, shouldP
 		return metrics.TransferStandbyTaskStartChildExecutionScope, err
 
 	case persistence.TransferTaskTypeRecordWorkflowStarted:
-		if shouldProcessTask {
-			err = t.processRecordWorkflowStarted(task)
-		}
-		return metrics.TransferStandbyTaskRecordWorkflowStartedScope, err
+		// visibility records are written by the visibility queue processor
+		return metrics.TransferStandbyTaskRecordWorkflowStartedScope, nil
 
 	default:
 		return metrics.TransferStandbyQueueProcessorScope, errUnknownTransferTask
@@ -175,6 +177,7 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo, shouldP
 func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *persistence.TransferTaskInfo) error {
 
 	var activityScheduleToStartTimeout *int32
+	var activityScheduledTimestamp int64
 	processTaskIfClosed := false
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		activityInfo, isPending := msBuilder.GetActivityInfo(transferTask.ScheduleID)
@@ -197,6 +200,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 			}
 
 			activityScheduleToStartTimeout = common.Int32Ptr(common.MinInt32(activityInfo.ScheduleToStartTimeout, common.MaxTaskTimeout))
+			activityScheduledTimestamp = activityInfo.ScheduledTime.UnixNano()
 			return nil
 		}
 
@@ -207,13 +211,14 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 		}
 
 		timeout := common.MinInt32(*activityScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushActivity(transferTask, timeout)
+		err := t.pushActivity(transferTask, timeout, activityScheduledTimestamp)
 		return err
 	})
 }
 
 func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *persistence.TransferTaskInfo) error {
 	var decisionScheduleToStartTimeout *int32
+	var decisionScheduledTimestamp int64
 	var tasklist *workflow.TaskList
 	processTaskIfClosed := false
 
@@ -242,6 +247,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 			}
 
 			decisionScheduleToStartTimeout = common.Int32Ptr(decisionTimeout)
+			decisionScheduledTimestamp = decisionInfo.Timestamp
 			tasklist = &workflow.TaskList{Name: &transferTask.TaskList}
 			return nil
 		}
@@ -253,63 +259,11 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 		}
 
 		timeout := common.MinInt32(*decisionScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushDecision(transferTask, tasklist, timeout)
+		err := t.pushDecision(transferTask, tasklist, timeout, decisionScheduledTimestamp)
 		return err
 	})
 }
 
-func (t *transferQueueStandbyProcessorImpl) processCloseExecution(transferTask *persistence.TransferTaskInfo) error {
-
-	processTaskIfClosed := true
-	execution := workflow.WorkflowExecution{
-		WorkflowId: common.StringPtr(transferTask.WorkflowID),
-		RunId:      common.StringPtr(transferTask.RunID),
-	}
-
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
-
-		if msBuilder.IsWorkflowExecutionRunning() {
-			// this can happen if workflow is reset.
-			return nil
-		}
-
-		completionEvent, ok := msBuilder.GetCompletionEvent()
-		var wfCloseTime int64
-		if !ok {
-			// This is need for backwards compatibility
-			// TODO: remove usage of getLastUpdatedTimestamp after release 0.5.4, only use completionEvent timestamp
-			wfCloseTime = getLastUpdatedTimestamp(msBuilder)
-		} else {
-			wfCloseTime = completionEvent.GetTimestamp()
-		}
-
-		executionInfo := msBuilder.GetExecutionInfo()
-		workflowTypeName := executionInfo.WorkflowTypeName
-		workflowStartTimestamp := executionInfo.StartTimestamp.UnixNano()
-		workflowCloseTimestamp := wfCloseTime
-		workflowCloseStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
-		workflowHistoryLength := msBuilder.GetNextEventID() - 1
-		startEvent, _ := msBuilder.GetStartEvent()
-		workflowExecutionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
-		visibilityMemo := getVisibilityMemo(startEvent)
-
-		ok, err := verifyTaskVersion(t.shard, t.logger, transferTask.DomainID, msBuilder.GetLastWriteVersion(), transferTask.Version, transferTask)
-		if err != nil {
-			return err
-		} else if !ok {
-			return nil
-		}
-
-		// DO NOT REPLY TO PARENT
-		// since event replication should be done by active cluster
-
-		return t.recordWorkflowClosed(
-			transferTask.DomainID, execution, workflowTypeName, workflowStartTimestamp, workflowExecutionTimestamp.UnixNano(),
-			workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, transferTask.GetTaskID(), visibilityMemo,
-		)
-	}, standbyTaskPostActionNoOp) // no op post action, since the entire workflow is finished
-}
-
 func (t *transferQueueStandbyProcessorImpl) processCancelExecution(transferTask *persistence.TransferTaskInfo, lastAttempt bool) error {
 
 	var nextEventID *int64
@@ -422,35 +376,6 @@ func (t *transferQueueStandbyProcessorImpl) processStartChildExecution(transferT
 	}, postProcessingFn)
 }
 
-func (t *transferQueueStandbyProcessorImpl) processRecordWorkflowStarted(transferTask *persistence.TransferTaskInfo) error {
-	processTaskIfClosed := false
-
-	execution := workflow.WorkflowExecution{
-		WorkflowId: common.StringPtr(transferTask.WorkflowID),
-		RunId:      common.StringPtr(transferTask.RunID),
-	}
-
-	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
-		ok, err := verifyTaskVersion(t.shard, t.logger, transferTask.DomainID, msBuilder.GetStartVersion(), transferTask.Version, transferTask)
-		if err != nil {
-			return err
-		} else if !ok {
-			return nil
-		}
-
-		executionInfo := msBuilder.GetExecutionInfo()
-		workflowTimeout := executionInfo.WorkflowTimeout
-		wfTypeName := executionInfo.WorkflowTypeName
-		startTimestamp := executionInfo.StartTimestamp.UnixNano()
-		startEvent, _ := msBuilder.GetStartEvent()
-		executionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
-		visibilityMemo := getVisibilityMemo(startEvent)
-
-		return t.recordWorkflowStarted(transferTask.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(),
-			workflowTimeout, transferTask.GetTaskID(), visibilityMemo)
-	}, standbyTaskPostActionNoOp)
-}
-
 func (t *transferQueueStandbyProcessorImpl) processTransfer(processTaskIfClosed bool, transferTask *persistence.TransferTaskInfo,
 	action func(mutableState) error, postAction func() error) (retError error) {
 	context, release, err := t.cache.getOrCreateWorkflowExecution(t.getDomainIDAndWorkflowExecution(transferTask))
@@ -0,0 +1,251 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// visibilityQueueProcessorImpl records workflow executions into the visibility store.
+	// It reads the same transfer tasks as the transfer queue processors, but only acts on the
+	// visibility ones, with its own workers, rate limits and ack level, so slow visibility
+	// writes do not hold back the rest of the transfer tasks of the shard.
+	visibilityQueueProcessorImpl struct {
+		shard                ShardContext
+		options              *QueueProcessorOptions
+		cache                *historyCache
+		logger               log.Logger
+		metricsClient        metrics.Client
+		visibilityTaskFilter queueTaskFilter
+		*transferQueueProcessorBase
+		*queueProcessorBase
+		queueAckMgr
+	}
+)
+
+func newVisibilityQueueProcessor(shard ShardContext, historyService *historyEngineImpl,
+	visibilityMgr persistence.VisibilityManager, matchingClient matching.Client, logger log.Logger) *visibilityQueueProcessorImpl {
+	config := shard.GetConfig()
+	options := &QueueProcessorOptions{
+		StartDelay:                         config.VisibilityProcessorStartDelay,
+		BatchSize:                          config.VisibilityTaskBatchSize,
+		WorkerCount:                        config.VisibilityTaskWorkerCount,
+		MaxPollRPS:                         config.VisibilityProcessorMaxPollRPS,
+		MaxPollInterval:                    config.VisibilityProcessorMaxPollInterval,
+		MaxPollIntervalJitterCoefficient:   config.VisibilityProcessorMaxPollIntervalJitterCoefficient,
+		UpdateAckInterval:                  config.VisibilityProcessorUpdateAckInterval,
+		UpdateAckIntervalJitterCoefficient: config.VisibilityProcessorUpdateAckIntervalJitterCoefficient,
+		MaxRetryCount:                      config.VisibilityTaskMaxRetryCount,
+		MetricScope:                        metrics.VisibilityQueueProcessorScope,
+	}
+	logger = logger.WithTags(tag.ComponentVisibilityQueue)
+
+	visibilityTaskFilter := func(qTask queueTaskInfo) (bool, error) {
+		task, ok := qTask.(*persistence.TransferTaskInfo)
+		if !ok {
+			return false, errUnexpectedQueueTask
+		}
+		return isVisibilityTransferTask(task), nil
+	}
+	maxReadAckLevel := func() int64 {
+		return shard.GetTransferMaxReadLevel()
+	}
+	updateVisibilityAckLevel := func(ackLevel int64) error {
+		return shard.UpdateVisibilityAckLevel(ackLevel)
+	}
+	visibilityQueueShutdown := func() error {
+		return nil
+	}
+
+	processor := &visibilityQueueProcessorImpl{
+		shard:                shard,
+		options:              options,
+		cache:                historyService.historyCache,
+		logger:               logger,
+		metricsClient:        historyService.metricsClient,
+		visibilityTaskFilter: visibilityTaskFilter,
+		transferQueueProcessorBase: newTransferQueueProcessorBase(
+			shard, options, visibilityMgr, matchingClient,
+			maxReadAckLevel, updateVisibilityAckLevel, visibilityQueueShutdown, logger,
+		),
+	}
+
+	queueAckMgr := newQueueAckMgr(shard, options, processor, shard.GetVisibilityAckLevel(), logger)
+	queueProcessorBase := newQueueProcessorBase(shard.GetService().GetClusterMetadata().GetCurrentClusterName(), shard, options, processor, queueAckMgr, logger)
+	processor.queueAckMgr = queueAckMgr
+	processor.queueProcessorBase = queueProcessorBase
+
+	return processor
+}
+
+// isVisibilityTransferTask returns true if the transfer task writes to the visibility store
+func isVisibilityTransferTask(task *persistence.TransferTaskInfo) bool {
+	switch task.TaskType {
+	case persistence.TransferTaskTypeRecordWorkflowStarted,
+		persistence.TransferTaskTypeCloseExecution:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *visibilityQueueProcessorImpl) getTaskFilter() queueTaskFilter {
+	return t.visibilityTaskFilter
+}
+
+func (t *visibilityQueueProcessorImpl) notifyNewTask() {
+	t.queueProcessorBase.notifyNewTask()
+}
+
+func (t *visibilityQueueProcessorImpl) process(qTask queueTaskInfo, shouldProcessTask bool) (int, error) {
+	task, ok := qTask.(*persistence.TransferTaskInfo)
+	if !ok {
+		return metrics.VisibilityQueueProcessorScope, errUnexpectedQueueTask
+	}
+
+	var err error
+	switch task.TaskType {
+	case persistence.TransferTaskTypeRecordWorkflowStarted:
+		if shouldProcessTask {
+			err = t.processRecordWorkflowStarted(task)
+		}
+		return metrics.VisibilityTaskRecordWorkflowStartedScope, err
+
+	case persistence.TransferTaskTypeCloseExecution:
+		if shouldProcessTask {
+			err = t.processCloseExecution(task)
+		}
+		return metrics.VisibilityTaskCloseExecutionScope, err
+
+	default:
+		// all other transfer tasks are handled by the transfer queue processors
+		return metrics.VisibilityQueueProcessorScope, nil
+	}
+}
+
+func (t *visibilityQueueProcessorImpl) processRecordWorkflowStarted(task *persistence.TransferTaskInfo) (retError error) {
+	var err error
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+
+	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	var msBuilder mutableState
+	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, msBuilder.GetStartVersion(), task.Version, task)
+	if err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	workflowTimeout := executionInfo.WorkflowTimeout
+	wfTypeName := executionInfo.WorkflowTypeName
+	startTimestamp := executionInfo.StartTimestamp.UnixNano()
+	startEvent, _ := msBuilder.GetStartEvent()
+	executionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
+	visibilityMemo := getVisibilityMemo(startEvent)
+	searchAttributes := getSearchAttributes(startEvent)
+
+	// release the context lock since we no longer need mutable state builder and
+	// the rest of logic is making RPC call, which takes time.
+	release(nil)
+	return t.recordWorkflowStarted(task.DomainID, execution, wfTypeName, startTimestamp, executionTimestamp.UnixNano(), workflowTimeout,
+		task.GetTaskID(), visibilityMemo, searchAttributes)
+}
+
+func (t *visibilityQueueProcessorImpl) processCloseExecution(task *persistence.TransferTaskInfo) (retError error) {
+	var err error
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+
+	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	var msBuilder mutableState
+	msBuilder, err = loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	} else if msBuilder == nil || msBuilder.IsWorkflowExecutionRunning() {
+		// this can happen if workflow is reset.
+		return nil
+	}
+
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, msBuilder.GetLastWriteVersion(), task.Version, task)
+	if err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	completionEvent, ok := msBuilder.GetCompletionEvent()
+	var wfCloseTime int64
+	if !ok {
+		// This is need for backwards compatibility
+		// TODO: remove usage of getLastUpdatedTimestamp after release 0.5.4, only use completionEvent timestamp
+		wfCloseTime = getLastUpdatedTimestamp(msBuilder)
+	} else {
+		wfCloseTime = completionEvent.GetTimestamp()
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	workflowTypeName := executionInfo.WorkflowTypeName
+	workflowStartTimestamp := executionInfo.StartTimestamp.UnixNano()
+	workflowCloseTimestamp := wfCloseTime
+	workflowCloseStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
+	workflowHistoryLength := msBuilder.GetNextEventID() - 1
+	startEvent, _ := msBuilder.GetStartEvent()
+	workflowExecutionTimestamp := getWorkflowExecutionTimestamp(msBuilder, startEvent)
+	visibilityMemo := getVisibilityMemo(startEvent)
+
+	// release the context lock since we no longer need mutable state builder and
+	// the rest of logic is making RPC call, which takes time.
+	release(nil)
+	return t.recordWorkflowClosed(
+		task.DomainID, execution, workflowTypeName, workflowStartTimestamp, workflowExecutionTimestamp.UnixNano(),
+		workflowCloseTimestamp, workflowCloseStatus, workflowHistoryLength, task.GetTaskID(), visibilityMemo,
+	)
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	visibilityQueueProcessorSuite struct {
+		suite.Suite
+
+		mockShardManager *mocks.ShardManager
+		logger           log.Logger
+
+		mockMetadataMgr     *mocks.MetadataManager
+		mockVisibilityMgr   *mocks.VisibilityManager
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockHistoryMgr      *mocks.HistoryManager
+		mockHistoryV2Mgr    *mocks.HistoryV2Manager
+		mockMatchingClient  *mocks.MatchingClient
+		mockShard           ShardContext
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockProducer        *mocks.KafkaProducer
+		mockMessagingClient messaging.Client
+		mockQueueAckMgr     *MockQueueAckMgr
+		mockClientBean      *client.MockClientBean
+		mockService         service.Service
+
+		version                  int64
+		visibilityQueueProcessor *visibilityQueueProcessorImpl
+	}
+)
+
+func TestVisibilityQueueProcessorSuite(t *testing.T) {
+	s := new(visibilityQueueProcessorSuite)
+	suite.Run(t, s)
+}
+
+func (s *visibilityQueueProcessorSuite) SetupTest() {
+	shardID := 0
+	s.logger = loggerimpl.NewDevelopmentForTest(s.Suite)
+	s.mockShardManager = &mocks.ShardManager{}
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
+	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.mockMatchingClient = &mocks.MatchingClient{}
+	s.mockMetadataMgr = &mocks.MetadataManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.version = int64(4096)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
+		Info:           &persistence.DomainInfo{ID: validDomainID},
+		Config:         &persistence.DomainConfig{Retention: 1},
+		IsGlobalDomain: true,
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			// Clusters attr is not used.
+		},
+		FailoverVersion: s.version,
+		TableVersion:    persistence.DomainTableVersionV1,
+	}, nil)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	s.mockProducer = &mocks.KafkaProducer{}
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	s.mockMessagingClient = mocks.NewMockMessagingClient(s.mockProducer, nil)
+	s.mockClientBean = &client.MockClientBean{}
+	s.mockService = service.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, metricsClient, s.mockClientBean)
+
+	shardContext := &shardContextImpl{
+		service:                   s.mockService,
+		shardInfo:                 &persistence.ShardInfo{ShardID: shardID, RangeID: 1, TransferAckLevel: 0},
+		transferSequenceNumber:    1,
+		executionManager:          s.mockExecutionMgr,
+		shardManager:              s.mockShardManager,
+		historyMgr:                s.mockHistoryMgr,
+		historyV2Mgr:              s.mockHistoryV2Mgr,
+		maxTransferSequenceNumber: 100000,
+		closeCh:                   make(chan int, 100),
+		config:                    NewDynamicConfigForTest(),
+		logger:                    s.logger,
+		domainCache:               cache.NewDomainCache(s.mockMetadataMgr, s.mockClusterMetadata, metricsClient, s.logger),
+		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
+		timerMaxReadLevelMap:      make(map[string]time.Time),
+	}
+	shardContext.eventsCache = newEventsCache(shardContext)
+	s.mockShard = shardContext
+
+	h := &historyEngineImpl{
+		currentClusterName: s.mockShard.GetService().GetClusterMetadata().GetCurrentClusterName(),
+		shard:              s.mockShard,
+		historyMgr:         s.mockHistoryMgr,
+		historyV2Mgr:       s.mockHistoryV2Mgr,
+		executionManager:   s.mockExecutionMgr,
+		historyCache:       newHistoryCache(s.mockShard),
+		logger:             s.logger,
+		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
+		metricsClient:      s.mockShard.GetMetricsClient(),
+	}
+	s.visibilityQueueProcessor = newVisibilityQueueProcessor(s.mockShard, h, s.mockVisibilityMgr, s.mockMatchingClient, s.logger)
+	s.mockQueueAckMgr = &MockQueueAckMgr{}
+	s.visibilityQueueProcessor.queueAckMgr = s.mockQueueAckMgr
+	s.visibilityQueueProcessor.queueProcessorBase.ackMgr = s.mockQueueAckMgr
+}
+
+func (s *visibilityQueueProcessorSuite) TearDownTest() {
+	s.mockShardManager.AssertExpectations(s.T())
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryMgr.AssertExpectations(s.T())
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+	s.mockMatchingClient.AssertExpectations(s.T())
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+	s.mockProducer.AssertExpectations(s.T())
+	s.mockQueueAckMgr.AssertExpectations(s.T())
+	s.mockClientBean.AssertExpectations(s.T())
+}
+
+func (s *visibilityQueueProcessorSuite) TestTaskFilter() {
+	filter := s.visibilityQueueProcessor.getTaskFilter()
+	for taskType, expected := range map[int]bool{
+		persistence.TransferTaskTypeRecordWorkflowStarted: true,
+		persistence.TransferTaskTypeCloseExecution:        true,
+		persistence.TransferTaskTypeActivityTask:          false,
+		persistence.TransferTaskTypeDecisionTask:          false,
+		persistence.TransferTaskTypeCancelExecution:       false,
+		persistence.TransferTaskTypeSignalExecution:       false,
+		persistence.TransferTaskTypeStartChildExecution:   false,
+	} {
+		shouldProcess, err := filter(&persistence.TransferTaskInfo{TaskType: taskType})
+		s.Nil(err)
+		s.Equal(expected, shouldProcess)
+	}
+}
+
+func (s *visibilityQueueProcessorSuite) TestUpdateAckLevel() {
+	ackLevel := int64(123)
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.VisibilityAckLevel == ackLevel
+	})).Return(nil).Once()
+
+	err := s.visibilityQueueProcessor.updateTransferAckLevel(ackLevel)
+	s.Nil(err)
+	s.Equal(ackLevel, s.mockShard.GetVisibilityAckLevel())
+}
+
+func (s *visibilityQueueProcessorSuite) TestProcessRecordWorkflowStartedTask() {
+	domainID := "some random domain ID"
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+	cronSchedule := "@every 5s"
+	backoffSeconds := int32(5)
+	searchAttributes := map[string][]byte{"CustomKeywordField": []byte(`"some keyword"`)}
+
+	msBuilder := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(),
+		s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+
+	event := msBuilder.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+				CronSchedule:                        common.StringPtr(cronSchedule),
+				SearchAttributes:                    &workflow.SearchAttributes{IndexedFields: searchAttributes},
+			},
+			FirstDecisionTaskBackoffSeconds: common.Int32Ptr(backoffSeconds),
+		},
+	)
+
+	taskID := int64(59)
+	msBuilder.UpdateReplicationStateLastEventID(s.mockClusterMetadata.GetCurrentClusterName(), s.version, event.GetEventId())
+
+	msBuilder.UpdateReplicationStateVersion(s.version+1, false)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	msBuilder.UpdateReplicationStateLastEventID(s.mockClusterMetadata.GetCurrentClusterName(), di.Version, di.ScheduleID)
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		TaskID:     taskID,
+		TaskList:   taskListName,
+		TaskType:   persistence.TransferTaskTypeRecordWorkflowStarted,
+		ScheduleID: event.GetEventId(),
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	executionInfo := msBuilder.GetExecutionInfo()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", &persistence.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:         domainID,
+		Execution:          execution,
+		WorkflowTypeName:   workflowType,
+		StartTimestamp:     executionInfo.StartTimestamp.UnixNano(),
+		ExecutionTimestamp: executionInfo.StartTimestamp.Add(time.Duration(backoffSeconds) * time.Second).UnixNano(),
+		WorkflowTimeout:    int64(executionInfo.WorkflowTimeout),
+		TaskID:             taskID,
+		SearchAttributes:   searchAttributes,
+	}).Return(nil).Once()
+
+	_, err := s.visibilityQueueProcessor.process(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *visibilityQueueProcessorSuite) TestProcessCloseExecution() {
+	domainID := "some random domain ID"
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	parentExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random parent workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	msBuilder := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(),
+		s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	msBuilder.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+			ParentExecutionInfo: &history.ParentExecutionInfo{
+				DomainUUID:  common.StringPtr("some random parent domain ID"),
+				Domain:      common.StringPtr("some random parent domain Name"),
+				Execution:   &parentExecution,
+				InitiatedId: common.Int64Ptr(3222),
+			},
+		},
+	)
+
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	event := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "some random identity")
+
+	taskID := int64(59)
+	event = addCompleteWorkflowEvent(msBuilder, event.GetEventId(), nil)
+	msBuilder.UpdateReplicationStateLastEventID(s.mockClusterMetadata.GetCurrentClusterName(), s.version, event.GetEventId())
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		TaskID:     taskID,
+		TaskList:   taskListName,
+		TaskType:   persistence.TransferTaskTypeCloseExecution,
+		ScheduleID: event.GetEventId(),
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	// the parent is notified by the transfer queue processor, not here
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.DomainUUID == domainID &&
+			request.Execution.GetRunId() == execution.GetRunId() &&
+			request.WorkflowTypeName == workflowType &&
+			request.Status == workflow.WorkflowExecutionCloseStatusCompleted &&
+			request.CloseTimestamp == event.GetTimestamp() &&
+			request.TaskID == taskID
+	})).Return(nil).Once()
+
+	_, err := s.visibilityQueueProcessor.process(transferTask, true)
+	s.Nil(err)
+}
+
+func (s *visibilityQueueProcessorSuite) TestProcessNonVisibilityTask() {
+	transferTask := &persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   "some random domain ID",
+		WorkflowID: "some random workflow ID",
+		RunID:      uuid.New(),
+		TaskID:     int64(59),
+		TaskList:   "some random task list",
+		TaskType:   persistence.TransferTaskTypeActivityTask,
+		ScheduleID: int64(5),
+	}
+
+	scope, err := s.visibilityQueueProcessor.process(transferTask, false)
+	s.Nil(err)
+	s.Equal(metrics.VisibilityQueueProcessorScope, scope)
+}
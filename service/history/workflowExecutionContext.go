@@ -46,24 +46,24 @@ const (
 
 type (
 	workflowExecutionContext interface {
-		appendHistoryEvents(history []*workflow.HistoryEvent, transactionID int64, doLastEventValidation bool) (int, error)
+		appendHistoryEvents(ctx context.Context, history []*workflow.HistoryEvent, transactionID int64, doLastEventValidation bool) (int, error)
 		clear()
-		continueAsNewWorkflowExecution(context []byte, newStateBuilder mutableState, transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error
+		continueAsNewWorkflowExecution(ctx context.Context, executionContext []byte, newStateBuilder mutableState, transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error
 		getDomainID() string
 		getExecution() *workflow.WorkflowExecution
 		getLogger() log.Logger
-		loadWorkflowExecution() (mutableState, error)
+		loadWorkflowExecution(ctx context.Context) (mutableState, error)
 		lock(context.Context) error
-		appendFirstBatchHistoryForContinueAsNew(newStateBuilder mutableState, transactionID int64) error
-		replicateWorkflowExecution(request *h.ReplicateEventsRequest, transferTasks []persistence.Task, timerTasks []persistence.Task, lastEventID, transactionID int64, now time.Time) error
-		resetMutableState(prevRunID string, resetBuilder mutableState) (mutableState, error)
-		resetWorkflowExecution(currMutableState mutableState, updateCurr bool, closeTask, cleanupTask persistence.Task, newMutableState mutableState, transferTasks, timerTasks, currReplicationTasks, insertReplicationTasks []persistence.Task, baseRunID string, forkRunNextEventID, prevRunVersion int64) (retError error)
-		scheduleNewDecision(transferTasks []persistence.Task, timerTasks []persistence.Task) ([]persistence.Task, []persistence.Task, error)
+		appendFirstBatchHistoryForContinueAsNew(ctx context.Context, newStateBuilder mutableState, transactionID int64) error
+		replicateWorkflowExecution(ctx context.Context, request *h.ReplicateEventsRequest, transferTasks []persistence.Task, timerTasks []persistence.Task, lastEventID, transactionID int64, now time.Time) error
+		resetMutableState(ctx context.Context, prevRunID string, resetBuilder mutableState) (mutableState, error)
+		resetWorkflowExecution(ctx context.Context, currMutableState mutableState, updateCurr bool, closeTask, cleanupTask persistence.Task, newMutableState mutableState, transferTasks, timerTasks, currReplicationTasks, insertReplicationTasks []persistence.Task, baseRunID string, forkRunNextEventID, prevRunVersion int64) (retError error)
+		scheduleNewDecision(ctx context.Context, transferTasks []persistence.Task, timerTasks []persistence.Task) ([]persistence.Task, []persistence.Task, error)
 		unlock()
-		updateHelper(transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64, now time.Time, createReplicationTask bool, standbyHistoryBuilder *historyBuilder, sourceCluster string) error
-		updateWorkflowExecution(transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error
-		updateWorkflowExecutionWithContext(context []byte, transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error
-		updateWorkflowExecutionWithDeleteTask(transferTasks []persistence.Task, timerTasks []persistence.Task, deleteTimerTask persistence.Task, transactionID int64) error
+		updateHelper(ctx context.Context, transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64, now time.Time, createReplicationTask bool, standbyHistoryBuilder *historyBuilder, sourceCluster string) error
+		updateWorkflowExecution(ctx context.Context, transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error
+		updateWorkflowExecutionWithContext(ctx context.Context, executionContext []byte, transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error
+		updateWorkflowExecutionWithDeleteTask(ctx context.Context, transferTasks []persistence.Task, timerTasks []persistence.Task, deleteTimerTask persistence.Task, transactionID int64) error
 	}
 )
 
@@ -99,10 +99,7 @@ func newWorkflowExecutionContext(
 	executionManager persistence.ExecutionManager,
 	logger log.Logger,
 ) *workflowExecutionContextImpl {
-	lg := logger.WithTags(
-		tag.WorkflowID(domainID),
-		tag.WorkflowRunID(execution.GetWorkflowId()),
-		tag.WorkflowDomainID(execution.GetRunId()))
+	lg := log.WithWorkflow(logger, domainID, &execution)
 
 	return &workflowExecutionContextImpl{
 		domainID:          domainID,
@@ -136,8 +133,8 @@ func (c *workflowExecutionContextImpl) getLogger() log.Logger {
 	return c.logger
 }
 
-func (c *workflowExecutionContextImpl) loadWorkflowExecution() (mutableState, error) {
-	err := c.loadWorkflowExecutionInternal()
+func (c *workflowExecutionContextImpl) loadWorkflowExecution(ctx context.Context) (mutableState, error) {
+	err := c.loadWorkflowExecutionInternal(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -148,12 +145,12 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecution() (mutableState, er
 	return c.msBuilder, nil
 }
 
-func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
+func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal(ctx context.Context) error {
 	if c.msBuilder != nil {
 		return nil
 	}
 
-	response, err := c.getWorkflowExecutionWithRetry(&persistence.GetWorkflowExecutionRequest{
+	response, err := c.getWorkflowExecutionWithRetry(ctx, &persistence.GetWorkflowExecutionRequest{
 		DomainID:  c.domainID,
 		Execution: c.workflowExecution,
 	})
@@ -181,26 +178,26 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
 	return nil
 }
 
-func (c *workflowExecutionContextImpl) resetMutableState(prevRunID string, resetBuilder mutableState) (mutableState,
+func (c *workflowExecutionContextImpl) resetMutableState(ctx context.Context, prevRunID string, resetBuilder mutableState) (mutableState,
 	error) {
 	// this only resets one mutableState for a workflow
 	snapshotRequest := resetBuilder.ResetSnapshot(prevRunID)
 	snapshotRequest.Condition = c.updateCondition
 
-	err := c.shard.ResetMutableState(snapshotRequest)
+	err := c.shard.ResetMutableState(ctx, snapshotRequest)
 	if err != nil {
 		return nil, err
 	}
 
 	c.clear()
-	return c.loadWorkflowExecution()
+	return c.loadWorkflowExecution(ctx)
 }
 
 // this reset is more complex than "resetMutableState", it involes currentMutableState and newMutableState:
 // 1. append history to new run
 // 2. append history to current run if current run is not closed
 // 3. update mutableState(terminate current run if not closed) and create new run
-func (c *workflowExecutionContextImpl) resetWorkflowExecution(currMutableState mutableState, updateCurr bool, closeTask, cleanupTask persistence.Task,
+func (c *workflowExecutionContextImpl) resetWorkflowExecution(ctx context.Context, currMutableState mutableState, updateCurr bool, closeTask, cleanupTask persistence.Task,
 	newMutableState mutableState, newTransferTasks, newTimerTasks, currReplicationTasks, insertReplicationTasks []persistence.Task, baseRunID string,
 	baseRunNextEventID, prevRunVersion int64) (retError error) {
 
@@ -244,7 +241,7 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(currMutableState m
 	if updateCurr {
 		hBuilder := currMutableState.GetHistoryBuilder()
 		var size int
-		size, retError = c.appendHistoryEvents(hBuilder.GetHistory().GetEvents(), transactionID, true)
+		size, retError = c.appendHistoryEvents(ctx, hBuilder.GetHistory().GetEvents(), transactionID, true)
 		if retError != nil {
 			return
 		}
@@ -253,7 +250,7 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(currMutableState m
 
 	// Note: we already made sure that newMutableState is using eventsV2
 	hBuilder := newMutableState.GetHistoryBuilder()
-	size, retError := c.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+	size, retError := c.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 		IsNewBranch:   false,
 		BranchToken:   newMutableState.GetCurrentBranch(),
 		Events:        hBuilder.GetHistory().GetEvents(),
@@ -305,37 +302,37 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(currMutableState m
 		InsertRequestCancelInfos: snapshotRequest.InsertRequestCancelInfos,
 	}
 
-	return c.shard.ResetWorkflowExecution(resetWFReq)
+	return c.shard.ResetWorkflowExecution(ctx, resetWFReq)
 }
 
-func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithContext(context []byte, transferTasks []persistence.Task,
-	timerTasks []persistence.Task, transactionID int64) error {
-	c.msBuilder.GetExecutionInfo().ExecutionContext = context
+func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithContext(ctx context.Context, executionContext []byte,
+	transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error {
+	c.msBuilder.GetExecutionInfo().ExecutionContext = executionContext
 
-	return c.updateWorkflowExecution(transferTasks, timerTasks, transactionID)
+	return c.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID)
 }
 
-func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRunAndContext(context []byte, transferTasks []persistence.Task,
-	timerTasks []persistence.Task, transactionID int64, newStateBuilder mutableState) error {
-	c.msBuilder.GetExecutionInfo().ExecutionContext = context
+func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRunAndContext(ctx context.Context, executionContext []byte,
+	transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64, newStateBuilder mutableState) error {
+	c.msBuilder.GetExecutionInfo().ExecutionContext = executionContext
 
-	return c.updateWorkflowExecutionWithNewRun(transferTasks, timerTasks, transactionID, newStateBuilder)
+	return c.updateWorkflowExecutionWithNewRun(ctx, transferTasks, timerTasks, transactionID, newStateBuilder)
 }
 
-func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithDeleteTask(transferTasks []persistence.Task,
+func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithDeleteTask(ctx context.Context, transferTasks []persistence.Task,
 	timerTasks []persistence.Task, deleteTimerTask persistence.Task, transactionID int64) error {
 	c.deleteTimerTask = deleteTimerTask
 
-	return c.updateWorkflowExecution(transferTasks, timerTasks, transactionID)
+	return c.updateWorkflowExecution(ctx, transferTasks, timerTasks, transactionID)
 }
 
-func (c *workflowExecutionContextImpl) replicateWorkflowExecution(request *h.ReplicateEventsRequest,
+func (c *workflowExecutionContextImpl) replicateWorkflowExecution(ctx context.Context, request *h.ReplicateEventsRequest,
 	transferTasks []persistence.Task, timerTasks []persistence.Task, lastEventID, transactionID int64, now time.Time) error {
 	nextEventID := lastEventID + 1
 	c.msBuilder.GetExecutionInfo().SetNextEventID(nextEventID)
 
 	standbyHistoryBuilder := newHistoryBuilderFromEvents(request.History.Events, c.logger)
-	return c.updateHelper(transferTasks, timerTasks, transactionID, now, false, standbyHistoryBuilder, request.GetSourceCluster())
+	return c.updateHelper(ctx, transferTasks, timerTasks, transactionID, now, false, standbyHistoryBuilder, request.GetSourceCluster())
 }
 
 func (c *workflowExecutionContextImpl) updateVersion() error {
@@ -357,7 +354,7 @@ func (c *workflowExecutionContextImpl) updateVersion() error {
 	return nil
 }
 
-func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRun(transferTasks []persistence.Task,
+func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRun(ctx context.Context, transferTasks []persistence.Task,
 	timerTasks []persistence.Task, transactionID int64, newStateBuilder mutableState) error {
 	if c.msBuilder.GetReplicationState() != nil {
 		currentVersion := c.msBuilder.GetCurrentVersion()
@@ -378,7 +375,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRun(transfe
 					workflow.DecisionTaskFailedCauseFailoverCloseDecision, nil, identityHistoryService, "", "", "", 0)
 
 				var transT, timerT []persistence.Task
-				transT, timerT, err := c.scheduleNewDecision(transT, timerT)
+				transT, timerT, err := c.scheduleNewDecision(ctx, transT, timerT)
 				if err != nil {
 					return err
 				}
@@ -395,21 +392,21 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRun(transfe
 	}
 
 	now := time.Now()
-	return c.update(transferTasks, timerTasks, transactionID, now, c.createReplicationTask, nil, "", newStateBuilder)
+	return c.update(ctx, transferTasks, timerTasks, transactionID, now, c.createReplicationTask, nil, "", newStateBuilder)
 }
 
-func (c *workflowExecutionContextImpl) updateWorkflowExecution(transferTasks []persistence.Task,
+func (c *workflowExecutionContextImpl) updateWorkflowExecution(ctx context.Context, transferTasks []persistence.Task,
 	timerTasks []persistence.Task, transactionID int64) error {
-	return c.updateWorkflowExecutionWithNewRun(transferTasks, timerTasks, transactionID, nil)
+	return c.updateWorkflowExecutionWithNewRun(ctx, transferTasks, timerTasks, transactionID, nil)
 }
 
-func (c *workflowExecutionContextImpl) updateHelper(transferTasks []persistence.Task, timerTasks []persistence.Task,
+func (c *workflowExecutionContextImpl) updateHelper(ctx context.Context, transferTasks []persistence.Task, timerTasks []persistence.Task,
 	transactionID int64, now time.Time,
 	createReplicationTask bool, standbyHistoryBuilder *historyBuilder, sourceCluster string) (errRet error) {
-	return c.update(transferTasks, timerTasks, transactionID, now, createReplicationTask, standbyHistoryBuilder, sourceCluster, nil)
+	return c.update(ctx, transferTasks, timerTasks, transactionID, now, createReplicationTask, standbyHistoryBuilder, sourceCluster, nil)
 }
 
-func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task, timerTasks []persistence.Task,
+func (c *workflowExecutionContextImpl) update(ctx context.Context, transferTasks []persistence.Task, timerTasks []persistence.Task,
 	transactionID int64, now time.Time,
 	createReplicationTask bool, standbyHistoryBuilder *historyBuilder, sourceCluster string, newStateBuilder mutableState) (errRet error) {
 
@@ -424,7 +421,10 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 	updates, err := c.msBuilder.CloseUpdateSession()
 	if err != nil {
 		if err == ErrBufferedEventsLimitExceeded {
-			if err1 := c.failInflightDecision(); err1 != nil {
+			c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.BufferedEventsLimitExceededCounter)
+			c.logger.Warn("Buffered events limit exceeded, force closing in-flight decision.",
+				tag.WorkflowNextEventID(c.msBuilder.GetNextEventID()))
+			if err1 := c.failInflightDecision(ctx); err1 != nil {
 				return err1
 			}
 
@@ -482,7 +482,7 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 	if hasNewStandbyHistoryEvents {
 		firstEvent := standbyHistoryBuilder.GetFirstEvent()
 		// Note: standby events has no transient decision events
-		newHistorySize, err = c.appendHistoryEvents(standbyHistoryBuilder.history, transactionID, true)
+		newHistorySize, err = c.appendHistoryEvents(ctx, standbyHistoryBuilder.history, transactionID, true)
 		if err != nil {
 			return err
 		}
@@ -496,14 +496,14 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 		// Transient decision events need to be written as a separate batch
 		if activeHistoryBuilder.HasTransientEvents() {
 			// transient decision events batch should not perform last event check
-			newHistorySize, err = c.appendHistoryEvents(activeHistoryBuilder.transientHistory, transactionID, false)
+			newHistorySize, err = c.appendHistoryEvents(ctx, activeHistoryBuilder.transientHistory, transactionID, false)
 			if err != nil {
 				return err
 			}
 		}
 
 		var size int
-		size, err = c.appendHistoryEvents(activeHistoryBuilder.history, transactionID, true)
+		size, err = c.appendHistoryEvents(ctx, activeHistoryBuilder.history, transactionID, true)
 		if err != nil {
 			return err
 		}
@@ -512,9 +512,14 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 		newHistorySize += size
 
 		// enforce history size/count limit (only on active side)
+		// the limits are configured per domain name, fall back to the global limits if the domain cannot be resolved
+		domainName := ""
+		if entry, err := c.shard.GetDomainCache().GetDomainByID(executionInfo.DomainID); err == nil && entry != nil && entry.GetInfo() != nil {
+			domainName = entry.GetInfo().Name
+		}
 		config := c.shard.GetConfig()
-		sizeLimitWarn := config.HistorySizeLimitWarn(executionInfo.DomainID)
-		countLimitWarn := config.HistoryCountLimitWarn(executionInfo.DomainID)
+		sizeLimitWarn := config.HistorySizeLimitWarn(domainName)
+		countLimitWarn := config.HistoryCountLimitWarn(domainName)
 		historyCount := int(c.msBuilder.GetNextEventID()) - 1
 		historySize := int(c.msBuilder.GetHistorySize()) + newHistorySize
 
@@ -522,8 +527,8 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 		// domains along with the individual domains stats
 		c.metricsClient.RecordTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.HistorySize, time.Duration(historySize))
 		c.metricsClient.RecordTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.HistoryCount, time.Duration(historyCount))
-		if entry, err := c.shard.GetDomainCache().GetDomainByID(executionInfo.DomainID); err == nil && entry != nil && entry.GetInfo() != nil {
-			scope := c.metricsClient.Scope(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.DomainTag(entry.GetInfo().Name))
+		if domainName != "" {
+			scope := c.metricsClient.Scope(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.DomainTag(domainName))
 			scope.RecordTimer(metrics.HistorySize, time.Duration(historySize))
 			scope.RecordTimer(metrics.HistoryCount, time.Duration(historyCount))
 		}
@@ -537,12 +542,12 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 				tag.WorkflowHistorySize(historySize),
 				tag.WorkflowEventCount(historyCount))
 
-			sizeLimitError := config.HistorySizeLimitError(executionInfo.DomainID)
-			countLimitError := config.HistoryCountLimitError(executionInfo.DomainID)
+			sizeLimitError := config.HistorySizeLimitError(domainName)
+			countLimitError := config.HistoryCountLimitError(domainName)
 			if (historySize > sizeLimitError || historyCount > countLimitError) && c.msBuilder.IsWorkflowExecutionRunning() {
 				// hard terminate workflow if it is still running
-				c.clear()                            // discard pending changes
-				_, err1 := c.loadWorkflowExecution() // reload mutable state
+				c.clear()                               // discard pending changes
+				_, err1 := c.loadWorkflowExecution(ctx) // reload mutable state
 				if err1 != nil {
 					return err1
 				}
@@ -579,7 +584,7 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 				if err1 != nil {
 					return err1
 				}
-				newHistorySize, err = c.appendHistoryEvents(activeHistoryBuilder.history, terminateTransactionID, true)
+				newHistorySize, err = c.appendHistoryEvents(ctx, activeHistoryBuilder.history, terminateTransactionID, true)
 				if err != nil {
 					return err
 				}
@@ -637,7 +642,7 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 
 	var resp *persistence.UpdateWorkflowExecutionResponse
 	var err1 error
-	if resp, err1 = c.updateWorkflowExecutionWithRetry(&persistence.UpdateWorkflowExecutionRequest{
+	if resp, err1 = c.updateWorkflowExecutionWithRetry(ctx, &persistence.UpdateWorkflowExecutionRequest{
 		ExecutionInfo:                 executionInfo,
 		ReplicationState:              c.msBuilder.GetReplicationState(),
 		TransferTasks:                 transferTasks,
@@ -705,7 +710,7 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 	return nil
 }
 
-func (c *workflowExecutionContextImpl) appendHistoryEvents(history []*workflow.HistoryEvent,
+func (c *workflowExecutionContextImpl) appendHistoryEvents(ctx context.Context, history []*workflow.HistoryEvent,
 	transactionID int64, doLastEventValidation bool) (int, error) {
 
 	if doLastEventValidation {
@@ -719,7 +724,7 @@ func (c *workflowExecutionContextImpl) appendHistoryEvents(history []*workflow.H
 	var err error
 
 	if c.msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-		historySize, err = c.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+		historySize, err = c.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 			IsNewBranch:   false,
 			BranchToken:   c.msBuilder.GetCurrentBranch(),
 			Events:        history,
@@ -752,15 +757,15 @@ func (c *workflowExecutionContextImpl) appendHistoryEvents(history []*workflow.H
 	return historySize, nil
 }
 
-func (c *workflowExecutionContextImpl) continueAsNewWorkflowExecution(context []byte, newStateBuilder mutableState,
-	transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error {
+func (c *workflowExecutionContextImpl) continueAsNewWorkflowExecution(ctx context.Context, executionContext []byte,
+	newStateBuilder mutableState, transferTasks []persistence.Task, timerTasks []persistence.Task, transactionID int64) error {
 
-	err1 := c.appendFirstBatchHistoryForContinueAsNew(newStateBuilder, transactionID)
+	err1 := c.appendFirstBatchHistoryForContinueAsNew(ctx, newStateBuilder, transactionID)
 	if err1 != nil {
 		return err1
 	}
 
-	err2 := c.updateWorkflowExecutionWithNewRunAndContext(context, transferTasks, timerTasks, transactionID, newStateBuilder)
+	err2 := c.updateWorkflowExecutionWithNewRunAndContext(ctx, executionContext, transferTasks, timerTasks, transactionID, newStateBuilder)
 	if err2 != nil {
 		// TODO: Delete new execution if update fails due to conflict or shard being lost
 	}
@@ -768,7 +773,7 @@ func (c *workflowExecutionContextImpl) continueAsNewWorkflowExecution(context []
 	return err2
 }
 
-func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(newStateBuilder mutableState,
+func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(ctx context.Context, newStateBuilder mutableState,
 	transactionID int64) error {
 	executionInfo := newStateBuilder.GetExecutionInfo()
 	domainID := executionInfo.DomainID
@@ -782,7 +787,7 @@ func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(n
 	var historySize int
 	var err error
 	if newStateBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
-		historySize, err = c.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
+		historySize, err = c.shard.AppendHistoryV2Events(ctx, &persistence.AppendHistoryNodesRequest{
 			IsNewBranch:   true,
 			Info:          historyGarbageCleanupInfo(domainID, newExecution.GetWorkflowId(), newExecution.GetRunId()),
 			BranchToken:   newStateBuilder.GetCurrentBranch(),
@@ -809,17 +814,17 @@ func (c *workflowExecutionContextImpl) appendFirstBatchHistoryForContinueAsNew(n
 	return err
 }
 
-func (c *workflowExecutionContextImpl) getWorkflowExecutionWithRetry(
+func (c *workflowExecutionContextImpl) getWorkflowExecutionWithRetry(ctx context.Context,
 	request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
 	var response *persistence.GetWorkflowExecutionResponse
 	op := func() error {
 		var err error
-		response, err = c.executionManager.GetWorkflowExecution(request)
+		response, err = c.executionManager.GetWorkflowExecution(ctx, request)
 
 		return err
 	}
 
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.RetryContext(ctx, op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 	if err != nil {
 		return nil, err
 	}
@@ -827,16 +832,16 @@ func (c *workflowExecutionContextImpl) getWorkflowExecutionWithRetry(
 	return response, nil
 }
 
-func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithRetry(
+func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithRetry(ctx context.Context,
 	request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
 	resp := &persistence.UpdateWorkflowExecutionResponse{}
 	op := func() error {
 		var err error
-		resp, err = c.shard.UpdateWorkflowExecution(request)
+		resp, err = c.shard.UpdateWorkflowExecution(ctx, request)
 		return err
 	}
 
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.RetryContext(ctx, op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 	return resp, err
 }
 
@@ -850,15 +855,16 @@ func (c *workflowExecutionContextImpl) clear() {
 // and may append more tasks to it.  It also returns back the slice with new tasks appended to it.  It is expected
 // caller to assign returned slice to original passed in slices.  For this reason we return the original slices
 // even if the method fails due to an error on loading workflow execution.
-func (c *workflowExecutionContextImpl) scheduleNewDecision(transferTasks []persistence.Task,
+func (c *workflowExecutionContextImpl) scheduleNewDecision(ctx context.Context, transferTasks []persistence.Task,
 	timerTasks []persistence.Task) ([]persistence.Task, []persistence.Task, error) {
-	msBuilder, err := c.loadWorkflowExecution()
+	msBuilder, err := c.loadWorkflowExecution(ctx)
 	if err != nil {
 		return transferTasks, timerTasks, err
 	}
 
 	executionInfo := msBuilder.GetExecutionInfo()
-	if !msBuilder.HasPendingDecisionTask() {
+	// decisions for a paused workflow are scheduled when it is resumed
+	if !msBuilder.HasPendingDecisionTask() && !msBuilder.IsWorkflowExecutionPaused() {
 		di := msBuilder.AddDecisionTaskScheduledEvent()
 		if di == nil {
 			return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -879,11 +885,11 @@ func (c *workflowExecutionContextImpl) scheduleNewDecision(transferTasks []persi
 	return transferTasks, timerTasks, nil
 }
 
-func (c *workflowExecutionContextImpl) failInflightDecision() error {
+func (c *workflowExecutionContextImpl) failInflightDecision(ctx context.Context) error {
 	c.clear()
 
 	// Reload workflow execution so we can apply the decision task failure event
-	msBuilder, err1 := c.loadWorkflowExecution()
+	msBuilder, err1 := c.loadWorkflowExecution(ctx)
 	if err1 != nil {
 		return err1
 	}
@@ -893,7 +899,7 @@ func (c *workflowExecutionContextImpl) failInflightDecision() error {
 			workflow.DecisionTaskFailedCauseForceCloseDecision, nil, identityHistoryService, "", "", "", 0)
 
 		var transT, timerT []persistence.Task
-		transT, timerT, err1 = c.scheduleNewDecision(transT, timerT)
+		transT, timerT, err1 = c.scheduleNewDecision(ctx, transT, timerT)
 		if err1 != nil {
 			return err1
 		}
@@ -903,7 +909,7 @@ func (c *workflowExecutionContextImpl) failInflightDecision() error {
 		if err1 != nil {
 			return err1
 		}
-		err1 = c.updateWorkflowExecution(transT, timerT, transactionID)
+		err1 = c.updateWorkflowExecution(ctx, transT, timerT, transactionID)
 		if err1 != nil {
 			return err1
 		}
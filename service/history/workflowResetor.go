@@ -92,13 +92,13 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(ctx context.Context, resetR
 		return
 	}
 	defer func() { baseRelease(retError) }()
-	baseMutableState, retError := baseContext.loadWorkflowExecution()
+	baseMutableState, retError := baseContext.loadWorkflowExecution(ctx)
 	if retError != nil {
 		return
 	}
 
 	// also load the current run of the workflow, it can be different from the base runID
-	resp, retError := w.eng.shard.GetExecutionManager().GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+	resp, retError := w.eng.shard.GetExecutionManager().GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
 		DomainID:   domainID,
 		WorkflowID: request.WorkflowExecution.GetWorkflowId(),
 	})
@@ -123,7 +123,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(ctx context.Context, resetR
 			return
 		}
 		defer func() { currRelease(retError) }()
-		currMutableState, retError = currContext.loadWorkflowExecution()
+		currMutableState, retError = currContext.loadWorkflowExecution(ctx)
 		if retError != nil {
 			return
 		}
@@ -138,6 +138,14 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(ctx context.Context, resetR
 		return
 	}
 
+	// the current record is expected to be either running or completed when resetting
+	if currMutableState.IsWorkflowExecutionPaused() {
+		retError = &workflow.BadRequestError{
+			Message: fmt.Sprintf("Current workflow is paused, resume it before reset."),
+		}
+		return
+	}
+
 	// before changing mutable state
 	prevRunVersion := currMutableState.GetLastWriteVersion()
 	// terminate the current run if it is running
@@ -155,7 +163,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(ctx context.Context, resetR
 	// complete the fork process at the end, it is OK even if this defer fails, because our timer task can still clean up correctly
 	defer func() {
 		if newMutableState != nil && len(newMutableState.GetExecutionInfo().GetCurrentBranch()) > 0 {
-			w.eng.historyV2Mgr.CompleteForkBranch(&persistence.CompleteForkBranchRequest{
+			w.eng.historyV2Mgr.CompleteForkBranch(ctx, &persistence.CompleteForkBranchRequest{
 				BranchToken: newMutableState.GetExecutionInfo().GetCurrentBranch(),
 				Success:     retError == nil,
 				ShardID:     common.IntPtr(w.eng.shard.GetShardID()),
@@ -175,7 +183,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(ctx context.Context, resetR
 	currReplicationTasks, insertReplicationTasks := w.generateReplicationTasksForReset(terminateCurr, currMutableState, newMutableState, domainEntry)
 
 	// finally, write to persistence
-	retError = currContext.resetWorkflowExecution(currMutableState, terminateCurr, closeTask, cleanupTask, newMutableState, transferTasks, timerTasks, currReplicationTasks, insertReplicationTasks, baseExecution.GetRunId(), baseMutableState.GetNextEventID(), prevRunVersion)
+	retError = currContext.resetWorkflowExecution(ctx, currMutableState, terminateCurr, closeTask, cleanupTask, newMutableState, transferTasks, timerTasks, currReplicationTasks, insertReplicationTasks, baseExecution.GetRunId(), baseMutableState.GetNextEventID(), prevRunVersion)
 
 	if retError == nil {
 		w.eng.txProcessor.NotifyNewTask(w.eng.currentClusterName, transferTasks)
@@ -314,7 +322,7 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(ctx context.Context,
 	baseRunID := baseMutableState.GetExecutionInfo().RunID
 
 	// replay history to reset point(exclusive) to rebuild mutableState
-	forkEventVersion, wfTimeoutSecs, receivedSignals, continueRunID, newStateBuilder, retError := w.replayHistoryEvents(resetDecisionCompletedEventID, requestedID, baseMutableState, newRunID)
+	forkEventVersion, wfTimeoutSecs, receivedSignals, continueRunID, newStateBuilder, retError := w.replayHistoryEvents(ctx, resetDecisionCompletedEventID, requestedID, baseMutableState, newRunID)
 	if retError != nil {
 		return
 	}
@@ -384,7 +392,7 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(ctx context.Context,
 	)
 
 	// fork a new history branch
-	forkResp, retError := w.eng.historyV2Mgr.ForkHistoryBranch(&persistence.ForkHistoryBranchRequest{
+	forkResp, retError := w.eng.historyV2Mgr.ForkHistoryBranch(ctx, &persistence.ForkHistoryBranchRequest{
 		ForkBranchToken: baseMutableState.GetCurrentBranch(),
 		ForkNodeID:      resetDecisionCompletedEventID,
 		Info:            historyGarbageCleanupInfo(domainID, workflowID, newRunID),
@@ -497,7 +505,7 @@ func (w *workflowResetorImpl) replayReceivedSignals(ctx context.Context, receive
 			if err != nil {
 				return err
 			}
-			continueMutableState, err = continueContext.loadWorkflowExecution()
+			continueMutableState, err = continueContext.loadWorkflowExecution(ctx)
 			if err != nil {
 				return err
 			}
@@ -517,7 +525,7 @@ func (w *workflowResetorImpl) replayReceivedSignals(ctx context.Context, receive
 		}
 		for {
 			var readResp *persistence.ReadHistoryBranchByBatchResponse
-			readResp, err := w.eng.historyV2Mgr.ReadHistoryBranchByBatch(readReq)
+			readResp, err := w.eng.historyV2Mgr.ReadHistoryBranchByBatch(ctx, readReq)
 			if err != nil {
 				return err
 			}
@@ -586,7 +594,7 @@ func getRespondActivityTaskFailedRequestFromActivity(ai *persistence.ActivityInf
 	}
 }
 
-func (w *workflowResetorImpl) replayHistoryEvents(decisionFinishEventID int64, requestID string, prevMutableState mutableState, newRunID string) (forkEventVersion, wfTimeoutSecs int64, receivedSignalsAfterReset []*workflow.HistoryEvent, continueRunID string, sBuilder stateBuilder, retError error) {
+func (w *workflowResetorImpl) replayHistoryEvents(ctx context.Context, decisionFinishEventID int64, requestID string, prevMutableState mutableState, newRunID string) (forkEventVersion, wfTimeoutSecs int64, receivedSignalsAfterReset []*workflow.HistoryEvent, continueRunID string, sBuilder stateBuilder, retError error) {
 	clusterMetadata := w.eng.shard.GetService().GetClusterMetadata()
 
 	prevExecution := workflow.WorkflowExecution{
@@ -609,7 +617,7 @@ func (w *workflowResetorImpl) replayHistoryEvents(decisionFinishEventID int64, r
 
 	for {
 		var readResp *persistence.ReadHistoryBranchByBatchResponse
-		readResp, retError = w.eng.historyV2Mgr.ReadHistoryBranchByBatch(readReq)
+		readResp, retError = w.eng.historyV2Mgr.ReadHistoryBranchByBatch(ctx, readReq)
 		if retError != nil {
 			return
 		}
@@ -749,7 +757,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(ctx context.Context, request *h.Re
 		return baseErr
 	}
 	defer func() { baseRelease(retError) }()
-	baseMutableState, retError = baseContext.loadWorkflowExecution()
+	baseMutableState, retError = baseContext.loadWorkflowExecution(ctx)
 	if retError != nil {
 		return
 	}
@@ -773,21 +781,21 @@ func (w *workflowResetorImpl) ApplyResetEvent(ctx context.Context, request *h.Re
 			return currErr
 		}
 		defer func() { currRelease(retError) }()
-		currMutableState, retError = currContext.loadWorkflowExecution()
+		currMutableState, retError = currContext.loadWorkflowExecution(ctx)
 		if retError != nil {
 			return
 		}
 	}
 	// before changing mutable state
 	prevRunVersion := currMutableState.GetLastWriteVersion()
-	newMsBuilder, newRunTransferTasks, newRunTimerTasks, retError = w.replicateResetEvent(baseMutableState, &baseExecution, historyAfterReset, resetAttr.GetForkEventVersion())
+	newMsBuilder, newRunTransferTasks, newRunTimerTasks, retError = w.replicateResetEvent(ctx, baseMutableState, &baseExecution, historyAfterReset, resetAttr.GetForkEventVersion())
 	if retError != nil {
 		return
 	}
 
 	// fork a new history branch
 	shardID := common.IntPtr(w.eng.shard.GetShardID())
-	forkResp, retError := w.eng.historyV2Mgr.ForkHistoryBranch(&persistence.ForkHistoryBranchRequest{
+	forkResp, retError := w.eng.historyV2Mgr.ForkHistoryBranch(ctx, &persistence.ForkHistoryBranchRequest{
 		ForkBranchToken: baseMutableState.GetCurrentBranch(),
 		ForkNodeID:      decisionFinishEventID,
 		Info:            historyGarbageCleanupInfo(domainID, workflowID, resetAttr.GetNewRunId()),
@@ -797,7 +805,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(ctx context.Context, request *h.Re
 		return
 	}
 	defer func() {
-		w.eng.historyV2Mgr.CompleteForkBranch(&persistence.CompleteForkBranchRequest{
+		w.eng.historyV2Mgr.CompleteForkBranch(ctx, &persistence.CompleteForkBranchRequest{
 			BranchToken: newMsBuilder.GetExecutionInfo().GetCurrentBranch(),
 			Success:     retError == nil,
 			ShardID:     shardID,
@@ -810,7 +818,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(ctx context.Context, request *h.Re
 	hBuilder.history = historyAfterReset
 	newMsBuilder.SetHistoryBuilder(hBuilder)
 
-	retError = currContext.resetWorkflowExecution(currMutableState, false, nil, nil, newMsBuilder, newRunTransferTasks, newRunTimerTasks, nil, nil, baseExecution.GetRunId(), baseMutableState.GetNextEventID(), prevRunVersion)
+	retError = currContext.resetWorkflowExecution(ctx, currMutableState, false, nil, nil, newMsBuilder, newRunTransferTasks, newRunTimerTasks, nil, nil, baseExecution.GetRunId(), baseMutableState.GetNextEventID(), prevRunVersion)
 	if retError != nil {
 		return
 	}
@@ -819,7 +827,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(ctx context.Context, request *h.Re
 	return nil
 }
 
-func (w *workflowResetorImpl) replicateResetEvent(baseMutableState mutableState, baseExecution *workflow.WorkflowExecution, newRunHistory []*workflow.HistoryEvent, forkEventVersion int64) (newMsBuilder mutableState, transferTasks, timerTasks []persistence.Task, retError error) {
+func (w *workflowResetorImpl) replicateResetEvent(ctx context.Context, baseMutableState mutableState, baseExecution *workflow.WorkflowExecution, newRunHistory []*workflow.HistoryEvent, forkEventVersion int64) (newMsBuilder mutableState, transferTasks, timerTasks []persistence.Task, retError error) {
 	domainID := baseMutableState.GetExecutionInfo().DomainID
 	workflowID := baseMutableState.GetExecutionInfo().WorkflowID
 	firstEvent := newRunHistory[0]
@@ -845,7 +853,7 @@ func (w *workflowResetorImpl) replicateResetEvent(baseMutableState mutableState,
 	}
 	for {
 		var readResp *persistence.ReadHistoryBranchByBatchResponse
-		readResp, retError = w.eng.historyV2Mgr.ReadHistoryBranchByBatch(readReq)
+		readResp, retError = w.eng.historyV2Mgr.ReadHistoryBranchByBatch(ctx, readReq)
 		if retError != nil {
 			return
 		}
@@ -742,16 +742,16 @@ func (s *resetorSuite) TestResetWorkflowExecution_NoReplication() {
 		Size: 200,
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReq).Return(nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Maybe()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, mock.Anything).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReq).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Maybe()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(appendV1Resp, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(appendV2Resp, nil).Once()
-	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(appendV2Resp, nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
 
 	response, err := s.historyEngine.ResetWorkflowExecution(context.Background(), request)
@@ -768,7 +768,7 @@ func (s *resetorSuite) TestResetWorkflowExecution_NoReplication() {
 	s.Equal(4, len(calls))
 	appendCall := calls[2]
 	s.Equal("AppendHistoryNodes", appendCall.Method)
-	appendReq, ok := appendCall.Arguments[0].(*p.AppendHistoryNodesRequest)
+	appendReq, ok := appendCall.Arguments[1].(*p.AppendHistoryNodesRequest)
 	s.Equal(true, ok)
 	s.Equal(newBranchToken, appendReq.BranchToken)
 	s.Equal(false, appendReq.IsNewBranch)
@@ -792,7 +792,7 @@ func (s *resetorSuite) TestResetWorkflowExecution_NoReplication() {
 	s.Equal(4, len(calls))
 	resetCall := calls[3]
 	s.Equal("ResetWorkflowExecution", resetCall.Method)
-	resetReq, ok := resetCall.Arguments[0].(*p.ResetWorkflowExecutionRequest)
+	resetReq, ok := resetCall.Arguments[1].(*p.ResetWorkflowExecutionRequest)
 	s.True(resetReq.CurrExecutionInfo.LastEventTaskID > 0)
 	resetReq.CurrExecutionInfo.LastEventTaskID = 0
 	s.Equal(true, ok)
@@ -1424,11 +1424,11 @@ func (s *resetorSuite) TestResetWorkflowExecution_NoReplication_WithRequestCance
 		}
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything).Return(nil).Maybe()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, mock.Anything).Return(nil).Maybe()
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
 
 	_, err = s.historyEngine.ResetWorkflowExecution(context.Background(), request)
@@ -2034,17 +2034,17 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_WithTerminatingCur
 		Size: 200,
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReq).Return(nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Maybe()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, mock.Anything).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReq).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Maybe()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(appendV1Resp, nil).Once()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(appendV2Resp, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(appendV2Resp, nil).Once()
 	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", mock.Anything).Return("active")
-	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
 
 	response, err := s.historyEngine.ResetWorkflowExecution(context.Background(), request)
@@ -2061,7 +2061,7 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_WithTerminatingCur
 	s.Equal(4, len(calls))
 	appendCall := calls[2]
 	s.Equal("AppendHistoryNodes", appendCall.Method)
-	appendReq, ok := appendCall.Arguments[0].(*p.AppendHistoryNodesRequest)
+	appendReq, ok := appendCall.Arguments[1].(*p.AppendHistoryNodesRequest)
 	s.Equal(true, ok)
 	s.Equal(newBranchToken, appendReq.BranchToken)
 	s.Equal(false, appendReq.IsNewBranch)
@@ -2083,7 +2083,7 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_WithTerminatingCur
 	s.Equal(4, len(calls))
 	resetCall := calls[3]
 	s.Equal("ResetWorkflowExecution", resetCall.Method)
-	resetReq, ok := resetCall.Arguments[0].(*p.ResetWorkflowExecutionRequest)
+	resetReq, ok := resetCall.Arguments[1].(*p.ResetWorkflowExecutionRequest)
 	s.True(resetReq.CurrExecutionInfo.LastEventTaskID > 0)
 	resetReq.CurrExecutionInfo.LastEventTaskID = 0
 	s.Equal(true, ok)
@@ -2729,12 +2729,12 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_NotActive() {
 		ShardID:     common.IntPtr(s.shardID),
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, mock.Anything).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Once()
 	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", mock.Anything).Return("standby")
 	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
 
@@ -3339,16 +3339,16 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_NoTerminatingCurre
 		Size: 200,
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gcurResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReq).Return(nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Maybe()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(appendV2Resp, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gcurResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, mock.Anything).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReq).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Maybe()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(appendV2Resp, nil).Once()
 	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", mock.Anything).Return("active")
-	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	response, err := s.historyEngine.ResetWorkflowExecution(context.Background(), request)
 	s.Nil(err)
 	s.NotNil(response.RunId)
@@ -3363,7 +3363,7 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_NoTerminatingCurre
 	s.Equal(4, len(calls))
 	appendCall := calls[2]
 	s.Equal("AppendHistoryNodes", appendCall.Method)
-	appendReq, ok := appendCall.Arguments[0].(*p.AppendHistoryNodesRequest)
+	appendReq, ok := appendCall.Arguments[1].(*p.AppendHistoryNodesRequest)
 	s.Equal(true, ok)
 	s.Equal(newBranchToken, appendReq.BranchToken)
 	s.Equal(false, appendReq.IsNewBranch)
@@ -3385,7 +3385,7 @@ func (s *resetorSuite) TestResetWorkflowExecution_Replication_NoTerminatingCurre
 	s.Equal(4, len(calls))
 	resetCall := calls[3]
 	s.Equal("ResetWorkflowExecution", resetCall.Method)
-	resetReq, ok := resetCall.Arguments[0].(*p.ResetWorkflowExecutionRequest)
+	resetReq, ok := resetCall.Arguments[1].(*p.ResetWorkflowExecutionRequest)
 	s.Equal(true, ok)
 	s.Equal(false, resetReq.UpdateCurr)
 	s.Equal(p.WorkflowStateCompleted, resetReq.PrevRunState)
@@ -4039,14 +4039,14 @@ func (s *resetorSuite) TestApplyReset() {
 		History:           historyAfterReset,
 	}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("GetWorkflowExecution", currGwmsRequest).Return(currGwmsResponse, nil).Once()
-	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", readHistoryReq).Return(readHistoryResp, nil).Once()
-	s.mockHistoryV2Mgr.On("ForkHistoryBranch", forkReq).Return(forkResp, nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReq).Return(nil).Once()
-	s.mockHistoryV2Mgr.On("CompleteForkBranch", completeReqErr).Return(nil).Maybe()
-	s.mockHistoryV2Mgr.On("AppendHistoryNodes", appendV2Req).Return(appendV2Resp, nil).Once()
-	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, forkGwmsRequest).Return(forkGwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, currGwmsRequest).Return(currGwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, readHistoryReq).Return(readHistoryResp, nil).Once()
+	s.mockHistoryV2Mgr.On("ForkHistoryBranch", mock.Anything, forkReq).Return(forkResp, nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReq).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("CompleteForkBranch", mock.Anything, completeReqErr).Return(nil).Maybe()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, appendV2Req).Return(appendV2Resp, nil).Once()
+	s.mockExecutionMgr.On("ResetWorkflowExecution", mock.Anything, mock.Anything).Return(nil).Once()
 	err := s.resetor.ApplyResetEvent(context.Background(), request, domainID, wid, currRunID)
 	s.Nil(err)
 
@@ -4060,7 +4060,7 @@ func (s *resetorSuite) TestApplyReset() {
 	s.Equal(4, len(calls))
 	appendCall := calls[2]
 	s.Equal("AppendHistoryNodes", appendCall.Method)
-	appendReq, ok := appendCall.Arguments[0].(*p.AppendHistoryNodesRequest)
+	appendReq, ok := appendCall.Arguments[1].(*p.AppendHistoryNodesRequest)
 	s.Equal(true, ok)
 	s.Equal(newBranchToken, appendReq.BranchToken)
 	s.Equal(false, appendReq.IsNewBranch)
@@ -4082,7 +4082,7 @@ func (s *resetorSuite) TestApplyReset() {
 	s.Equal(3, len(calls))
 	resetCall := calls[2]
 	s.Equal("ResetWorkflowExecution", resetCall.Method)
-	resetReq, ok := resetCall.Arguments[0].(*p.ResetWorkflowExecutionRequest)
+	resetReq, ok := resetCall.Arguments[1].(*p.ResetWorkflowExecutionRequest)
 	s.Equal(true, ok)
 	s.Equal(false, resetReq.UpdateCurr)
 	s.Equal(p.WorkflowStateCompleted, resetReq.PrevRunState)
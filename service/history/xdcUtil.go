@@ -30,8 +30,6 @@ import (
 )
 
 var (
-	standbyTaskPostActionNoOp = func() error { return nil }
-
 	standbyTrensferTaskPostActionTaskDiscarded = func(nextEventID *int64, transferTask *persistence.TransferTaskInfo, logger log.Logger) error {
 		if nextEventID == nil {
 			return nil
@@ -91,7 +89,7 @@ func verifyTaskVersion(shard ShardContext, logger log.Logger, domainID string, v
 // load mutable state, if mutable state's next event ID <= task ID, will attempt to refresh
 // if still mutable state's next event ID <= task ID, will return nil, nil
 func loadMutableStateForTransferTask(context workflowExecutionContext, transferTask *persistence.TransferTaskInfo, metricsClient metrics.Client, logger log.Logger) (mutableState, error) {
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(backgroundCallerContext)
 	if err != nil {
 		if _, ok := err.(*workflow.EntityNotExistsError); ok {
 			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
@@ -113,7 +111,7 @@ func loadMutableStateForTransferTask(context workflowExecutionContext, transferT
 		logger.Debug(fmt.Sprintf("Transfer Task Processor: task event ID: %v >= MS NextEventID: %v.", transferTask.ScheduleID, msBuilder.GetNextEventID()))
 		context.clear()
 
-		msBuilder, err = context.loadWorkflowExecution()
+		msBuilder, err = context.loadWorkflowExecution(backgroundCallerContext)
 		if err != nil {
 			return nil, err
 		}
@@ -131,7 +129,7 @@ func loadMutableStateForTransferTask(context workflowExecutionContext, transferT
 // load mutable state, if mutable state's next event ID <= task ID, will attempt to refresh
 // if still mutable state's next event ID <= task ID, will return nil, nil
 func loadMutableStateForTimerTask(context workflowExecutionContext, timerTask *persistence.TimerTaskInfo, metricsClient metrics.Client, logger log.Logger) (mutableState, error) {
-	msBuilder, err := context.loadWorkflowExecution()
+	msBuilder, err := context.loadWorkflowExecution(backgroundCallerContext)
 	if err != nil {
 		if _, ok := err.(*workflow.EntityNotExistsError); ok {
 			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
@@ -153,7 +151,7 @@ func loadMutableStateForTimerTask(context workflowExecutionContext, timerTask *p
 		logger.Debug(fmt.Sprintf("Timer Task Processor: task event ID: %v >= MS NextEventID: %v.", timerTask.EventID, msBuilder.GetNextEventID()))
 		context.clear()
 
-		msBuilder, err = context.loadWorkflowExecution()
+		msBuilder, err = context.loadWorkflowExecution(backgroundCallerContext)
 		if err != nil {
 			return nil, err
 		}
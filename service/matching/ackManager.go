@@ -22,6 +22,7 @@ package matching
 
 import (
 	"sync"
+	"time"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -31,19 +32,26 @@ import (
 // Used to convert out of order acks into ackLevel movement.
 type ackManager struct {
 	sync.RWMutex
-	outstandingTasks map[int64]bool // key->TaskID, value->(true for acked, false->for non acked)
-	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
-	ackLevel         int64          // Maximum TaskID below which all tasks are acked
+	outstandingTasks map[int64]bool      // key->TaskID, value->(true for acked, false->for non acked)
+	createdTimes     map[int64]time.Time // key->TaskID, value->creation time of the non acked task
+	readLevel        int64               // Maximum TaskID inserted into outstandingTasks
+	ackLevel         int64               // Maximum TaskID below which all tasks are acked
 	backlogCounter   atomic.Int64
 	logger           log.Logger
 }
 
 func newAckManager(logger log.Logger) ackManager {
-	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
+	return ackManager{
+		logger:           logger,
+		outstandingTasks: make(map[int64]bool),
+		createdTimes:     make(map[int64]time.Time),
+		readLevel:        -1,
+		ackLevel:         -1,
+	}
 }
 
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
-func (m *ackManager) addTask(taskID int64) {
+func (m *ackManager) addTask(taskID int64, createdTime time.Time) {
 	m.Lock()
 	defer m.Unlock()
 	if m.readLevel >= taskID {
@@ -56,6 +64,7 @@ func (m *ackManager) addTask(taskID int64) {
 		m.logger.Fatal("Already present in outstanding tasks", tag.TaskID(taskID))
 	}
 	m.outstandingTasks[taskID] = false // true is for acked
+	m.createdTimes[taskID] = createdTime
 	m.backlogCounter.Inc()
 }
 
@@ -95,6 +104,7 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	defer m.Unlock()
 	if completed, ok := m.outstandingTasks[taskID]; ok && !completed {
 		m.outstandingTasks[taskID] = true
+		delete(m.createdTimes, taskID)
 		m.backlogCounter.Dec()
 	}
 	// Update ackLevel
@@ -114,3 +124,17 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
+
+// Returns the creation time of the oldest non acked task, or zero time if there is none
+// or if it was created before creation times were persisted.
+func (m *ackManager) getOldestTaskCreatedTime() time.Time {
+	m.RLock()
+	defer m.RUnlock()
+	oldestTaskID := int64(-1)
+	for taskID := range m.createdTimes {
+		if oldestTaskID == -1 || taskID < oldestTaskID {
+			oldestTaskID = taskID
+		}
+	}
+	return m.createdTimes[oldestTaskID]
+}
@@ -83,18 +83,20 @@ func (h *Handler) Start() error {
 	h.metricsClient = h.Service.GetMetricsClient()
 	h.engine = NewEngine(
 		h.taskPersistence, h.GetClientBean().GetHistoryClient(), h.config, h.Service.GetLogger(), h.Service.GetMetricsClient(), h.domainCache,
+		h.GetHostInfo(),
 	)
 	h.startWG.Done()
 	return nil
 }
 
 // Stop stops the handler
+// The host leaves the ring and stops taking requests before the task lists are unloaded and persistence is closed
 func (h *Handler) Stop() {
+	h.Service.Stop()
 	h.engine.Stop()
 	h.domainCache.Stop()
 	h.taskPersistence.Close()
 	h.metadataMgr.Close()
-	h.Service.Stop()
 }
 
 // Health is for health check
@@ -125,7 +127,7 @@ func (h *Handler) AddActivityTask(ctx context.Context, addRequest *m.AddActivity
 		return h.handleErr(errMatchingHostThrottle, scope)
 	}
 
-	syncMatch, err := h.engine.AddActivityTask(addRequest)
+	syncMatch, err := h.engine.AddActivityTask(ctx, addRequest)
 	if syncMatch {
 		h.metricsClient.RecordTimer(scope, metrics.SyncMatchLatency, time.Since(startT))
 	}
@@ -145,7 +147,7 @@ func (h *Handler) AddDecisionTask(ctx context.Context, addRequest *m.AddDecision
 		return h.handleErr(errMatchingHostThrottle, scope)
 	}
 
-	syncMatch, err := h.engine.AddDecisionTask(addRequest)
+	syncMatch, err := h.engine.AddDecisionTask(ctx, addRequest)
 	if syncMatch {
 		h.metricsClient.RecordTimer(scope, metrics.SyncMatchLatency, time.Since(startT))
 	}
@@ -26,7 +26,10 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
 	m "github.com/uber/cadence/.gen/go/matching"
@@ -35,10 +38,13 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 )
 
 // Implements matching.Engine
@@ -50,8 +56,10 @@ type matchingEngineImpl struct {
 	tokenSerializer common.TaskTokenSerializer
 	logger          log.Logger
 	metricsClient   metrics.Client
-	taskListsLock   sync.RWMutex                   // locks mutation of taskLists
+	taskListsLock   sync.RWMutex                   // locks mutation of taskLists and taskListsAccess
 	taskLists       map[taskListID]taskListManager // Convert to LRU cache
+	// last access time in unix nanos of each loaded task list, used to unload the least recently used one
+	taskListsAccess map[taskListID]*int64
 	config          *Config
 	queryMapLock    sync.Mutex
 	// map from query TaskID (which is a UUID generated in QueryWorkflow() call) to a channel that QueryWorkflow()
@@ -59,6 +67,7 @@ type matchingEngineImpl struct {
 	// unblock QueryWorkflow() call.
 	queryTaskMap map[string]chan *queryResult
 	domainCache  cache.DomainCache
+	hostInfo     *membership.HostInfo
 }
 
 type taskListID struct {
@@ -78,8 +87,6 @@ var (
 	persistenceOperationRetryPolicy    = common.CreatePersistanceRetryPolicy()
 	historyServiceOperationRetryPolicy = common.CreateHistoryServiceRetryPolicy()
 
-	// ErrNoTasks is exported temporarily for integration test
-	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
 
 	pollerIDKey pollerIDCtxKey = "pollerID"
@@ -89,6 +96,9 @@ var (
 const (
 	maxQueryWaitCount = 5
 	maxQueryLoopCount = 5
+
+	// stickyTaskListLoadCheckInterval is how often a sticky poll checks whether its task list was loaded
+	stickyTaskListLoadCheckInterval = 100 * time.Millisecond
 )
 
 func (t *taskListID) String() string {
@@ -113,18 +123,21 @@ func NewEngine(taskManager persistence.TaskManager,
 	logger log.Logger,
 	metricsClient metrics.Client,
 	domainCache cache.DomainCache,
+	hostInfo *membership.HostInfo,
 ) Engine {
 
 	return &matchingEngineImpl{
 		taskManager:     taskManager,
 		historyService:  historyService,
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		tokenSerializer: common.NewTaskTokenSerializer(config.TaskTokenEncoding, config.TaskTokenSigningKey, config.TaskTokenPreviousSigningKeys, config.TaskTokenAcceptUnsigned),
 		taskLists:       make(map[taskListID]taskListManager),
+		taskListsAccess: make(map[taskListID]*int64),
 		logger:          logger.WithTags(tag.ComponentMatchingEngine),
 		metricsClient:   metricsClient,
 		config:          config,
 		queryTaskMap:    make(map[string]chan *queryResult),
 		domainCache:     domainCache,
+		hostInfo:        hostInfo,
 	}
 }
 
@@ -172,6 +185,7 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID,
 	// and return avoiding the write lock
 	e.taskListsLock.RLock()
 	if result, ok := e.taskLists[*taskList]; ok {
+		e.touchTaskList(taskList)
 		e.taskListsLock.RUnlock()
 		return result, nil
 	}
@@ -179,6 +193,7 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID,
 	// If it gets here, write lock and check again in case a task list is created between the two locks
 	e.taskListsLock.Lock()
 	if result, ok := e.taskLists[*taskList]; ok {
+		e.touchTaskList(taskList)
 		e.taskListsLock.Unlock()
 		return result, nil
 	}
@@ -189,8 +204,14 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID,
 		e.logger.Info("", tag.LifeCycleStartFailed, tag.WorkflowTaskListName(taskList.taskListName), tag.WorkflowTaskListType(taskList.taskType), tag.Error(err))
 		return nil, err
 	}
+	evicted := e.evictTaskListIfFull()
 	e.taskLists[*taskList] = mgr
+	e.touchTaskList(taskList)
+	e.updateLoadedTaskListGauge()
 	e.taskListsLock.Unlock()
+	if evicted != nil {
+		evicted.Stop()
+	}
 	err = mgr.Start()
 	if err != nil {
 		e.logger.Info("", tag.LifeCycleStartFailed, tag.WorkflowTaskListName(taskList.taskListName), tag.WorkflowTaskListType(taskList.taskType), tag.Error(err))
@@ -205,18 +226,72 @@ func (e *matchingEngineImpl) updateTaskList(taskList *taskListID, mgr taskListMa
 	e.taskListsLock.Lock()
 	defer e.taskListsLock.Unlock()
 	e.taskLists[*taskList] = mgr
+	e.touchTaskList(taskList)
 }
 
 func (e *matchingEngineImpl) removeTaskListManager(id *taskListID) {
 	e.taskListsLock.Lock()
 	defer e.taskListsLock.Unlock()
 	delete(e.taskLists, *id)
+	delete(e.taskListsAccess, *id)
+	e.updateLoadedTaskListGauge()
+}
+
+// touchTaskList records an access to the task list, caller must hold taskListsLock
+func (e *matchingEngineImpl) touchTaskList(id *taskListID) {
+	now := time.Now().UnixNano()
+	if lastAccess, ok := e.taskListsAccess[*id]; ok {
+		atomic.StoreInt64(lastAccess, now)
+		return
+	}
+	// only reached with the write lock held, the read lock path always finds the task list
+	e.taskListsAccess[*id] = &now
+}
+
+// evictTaskListIfFull removes the least recently used task list when the host holds MaxTaskListsPerHost
+// task lists and returns its manager to be stopped outside of the lock, caller must hold taskListsLock for write
+func (e *matchingEngineImpl) evictTaskListIfFull() taskListManager {
+	maxTaskLists := e.config.MaxTaskListsPerHost()
+	if maxTaskLists <= 0 || len(e.taskLists) < maxTaskLists {
+		return nil
+	}
+
+	var oldestID taskListID
+	oldestAccess := int64(math.MaxInt64)
+	for id := range e.taskLists {
+		lastAccess := int64(0)
+		if access, ok := e.taskListsAccess[id]; ok {
+			lastAccess = atomic.LoadInt64(access)
+		}
+		if lastAccess < oldestAccess {
+			oldestID = id
+			oldestAccess = lastAccess
+		}
+	}
+
+	evicted := e.taskLists[oldestID]
+	delete(e.taskLists, oldestID)
+	delete(e.taskListsAccess, oldestID)
+	e.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.TaskListEvictedCounter)
+	e.logger.Info("Unloading least recently used task list, host task list limit reached.",
+		tag.WorkflowTaskListName(oldestID.taskListName), tag.WorkflowTaskListType(oldestID.taskType))
+	return evicted
+}
+
+// updateLoadedTaskListGauge emits the number of loaded task lists, caller must hold taskListsLock
+func (e *matchingEngineImpl) updateLoadedTaskListGauge() {
+	e.metricsClient.UpdateGauge(metrics.MatchingTaskListMgrScope, metrics.LoadedTaskListGauge, float64(len(e.taskLists)))
 }
 
 // AddDecisionTask either delivers task directly to waiting poller or save it into task list persistence.
-func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskRequest) (bool, error) {
+func (e *matchingEngineImpl) AddDecisionTask(ctx context.Context, addRequest *m.AddDecisionTaskRequest) (
+	syncMatch bool, retError error) {
 	domainID := addRequest.GetDomainUUID()
 	taskListName := addRequest.TaskList.GetName()
+	span, _ := tracing.StartSpan(ctx, "matching.AddDecisionTask", taskListSpanTags(domainID, taskListName,
+		addRequest.Execution)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	taskListKind := common.TaskListKindPtr(addRequest.TaskList.GetKind())
 	e.logger.Debug(fmt.Sprintf("Received AddDecisionTask for taskList=%v, WorkflowID=%v, RunID=%v, ScheduleToStartTimeout=%v",
 		addRequest.TaskList.GetName(), addRequest.Execution.GetWorkflowId(), addRequest.Execution.GetRunId(),
@@ -232,15 +307,22 @@ func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskReques
 		WorkflowID:             addRequest.Execution.GetWorkflowId(),
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
+		CreatedTime:            time.Now(),
+		ScheduledTime:          scheduledTime(addRequest.GetScheduledTimestamp()),
 	}
 	return tlMgr.AddTask(addRequest.Execution, taskInfo)
 }
 
 // AddActivityTask either delivers task directly to waiting poller or save it into task list persistence.
-func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskRequest) (bool, error) {
+func (e *matchingEngineImpl) AddActivityTask(ctx context.Context, addRequest *m.AddActivityTaskRequest) (
+	syncMatch bool, retError error) {
 	domainID := addRequest.GetDomainUUID()
 	sourceDomainID := addRequest.GetSourceDomainUUID()
 	taskListName := addRequest.TaskList.GetName()
+	span, _ := tracing.StartSpan(ctx, "matching.AddActivityTask", taskListSpanTags(domainID, taskListName,
+		addRequest.Execution)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+
 	taskListKind := common.TaskListKindPtr(addRequest.TaskList.GetKind())
 	e.logger.Debug(fmt.Sprintf("Received AddActivityTask for taskList=%v WorkflowID=%v, RunID=%v",
 		taskListName, addRequest.Execution.WorkflowId, addRequest.Execution.RunId))
@@ -255,6 +337,8 @@ func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskReques
 		WorkflowID:             addRequest.Execution.GetWorkflowId(),
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
+		CreatedTime:            time.Now(),
+		ScheduledTime:          scheduledTime(addRequest.GetScheduledTimestamp()),
 	}
 	return tlMgr.AddTask(addRequest.Execution, taskInfo)
 }
@@ -263,11 +347,14 @@ var errQueryBeforeFirstDecisionCompleted = errors.New("query cannot be handled b
 
 // PollForDecisionTask tries to get the decision task using exponential backoff.
 func (e *matchingEngineImpl) PollForDecisionTask(ctx context.Context, req *m.PollForDecisionTaskRequest) (
-	*m.PollForDecisionTaskResponse, error) {
+	retResp *m.PollForDecisionTaskResponse, retError error) {
 	domainID := req.GetDomainUUID()
 	pollerID := req.GetPollerID()
 	request := req.PollRequest
 	taskListName := request.TaskList.GetName()
+	span, ctx := tracing.StartSpan(ctx, "matching.PollForDecisionTask", taskListSpanTags(domainID, taskListName, nil)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
+	pollStartedTime := time.Now()
 	e.logger.Debug("Received PollForDecisionTask for taskList", tag.WorkflowTaskListName(taskListName))
 pollLoop:
 	for {
@@ -284,7 +371,7 @@ pollLoop:
 		tCtx, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
-			if err == ErrNoTasks || err == errPumpClosed {
+			if ce.IsNoTasksError(err) || err == errPumpClosed {
 				return emptyPollForDecisionTaskResponse, nil
 			}
 			return nil, err
@@ -334,21 +421,37 @@ pollLoop:
 			return e.createPollForDecisionTaskResponse(tCtx, resp), nil
 		}
 
+		if tCtx.tlMgr.startedTasks.isStarted(tCtx.info, persistence.TaskListTypeDecision) {
+			e.logger.Debug(fmt.Sprintf("Duplicated decision task taskList=%v, taskID=%v",
+				taskListName, tCtx.info.TaskID))
+			e.metricsClient.IncCounter(metrics.MatchingPollForDecisionTaskScope, metrics.StartedTaskCacheHitCounter)
+			tCtx.completeTask(nil)
+			continue pollLoop
+		}
+
 		// Generate a unique requestId for this task which will be used for all retries
 		requestID := uuid.New()
-		resp, err := tCtx.RecordDecisionTaskStartedWithRetry(ctx, &h.RecordDecisionTaskStartedRequest{
-			DomainUUID:        common.StringPtr(domainID),
-			WorkflowExecution: &tCtx.workflowExecution,
-			ScheduleId:        &tCtx.info.ScheduleID,
-			TaskId:            &tCtx.info.TaskID,
-			RequestId:         common.StringPtr(requestID),
-			PollRequest:       request,
-		})
+		recordRequest := &h.RecordDecisionTaskStartedRequest{
+			DomainUUID:           common.StringPtr(domainID),
+			WorkflowExecution:    &tCtx.workflowExecution,
+			ScheduleId:           &tCtx.info.ScheduleID,
+			TaskId:               &tCtx.info.TaskID,
+			RequestId:            common.StringPtr(requestID),
+			PollRequest:          request,
+			PollStartedTimestamp: common.Int64Ptr(pollStartedTime.UnixNano()),
+			BacklogCountHint:     common.Int64Ptr(tCtx.backlogCountHint),
+		}
+		// tasks persisted before created time was tracked have none
+		if tCtx.info.CreatedTime.After(epochStartTime) {
+			recordRequest.TaskCreatedTimestamp = common.Int64Ptr(tCtx.info.CreatedTime.UnixNano())
+		}
+		resp, err := tCtx.RecordDecisionTaskStartedWithRetry(ctx, recordRequest)
 		if err != nil {
 			switch err.(type) {
 			case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
 				e.logger.Debug(fmt.Sprintf("Duplicated decision task taskList=%v, taskID=%v",
 					taskListName, tCtx.info.TaskID))
+				tCtx.tlMgr.startedTasks.recordStarted(tCtx.info, persistence.TaskListTypeDecision)
 				tCtx.completeTask(nil)
 			default:
 				tCtx.completeTask(err)
@@ -356,7 +459,9 @@ pollLoop:
 
 			continue pollLoop
 		}
+		tCtx.tlMgr.startedTasks.recordStarted(tCtx.info, persistence.TaskListTypeDecision)
 		tCtx.completeTask(nil)
+		e.logDispatchTrace(tCtx)
 		return e.createPollForDecisionTaskResponse(tCtx, resp), nil
 	}
 }
@@ -365,11 +470,13 @@ pollLoop:
 // completed and return it to user. If a task from task manager is already started, return an empty response, without
 // error. Timeouts handled by the timer queue.
 func (e *matchingEngineImpl) PollForActivityTask(ctx context.Context, req *m.PollForActivityTaskRequest) (
-	*workflow.PollForActivityTaskResponse, error) {
+	retResp *workflow.PollForActivityTaskResponse, retError error) {
 	domainID := req.GetDomainUUID()
 	pollerID := req.GetPollerID()
 	request := req.PollRequest
 	taskListName := request.TaskList.GetName()
+	span, ctx := tracing.StartSpan(ctx, "matching.PollForActivityTask", taskListSpanTags(domainID, taskListName, nil)...)
+	defer func() { tracing.FinishSpan(span, retError) }()
 	e.logger.Debug(fmt.Sprintf("Received PollForActivityTask for taskList=%v", taskListName))
 pollLoop:
 	for {
@@ -391,11 +498,18 @@ pollLoop:
 		tCtx, err := e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
-			if err == ErrNoTasks || err == errPumpClosed {
+			if ce.IsNoTasksError(err) || err == errPumpClosed {
 				return emptyPollForActivityTaskResponse, nil
 			}
 			return nil, err
 		}
+		if tCtx.tlMgr.startedTasks.isStarted(tCtx.info, persistence.TaskListTypeActivity) {
+			e.logger.Debug(fmt.Sprintf("Duplicated activity task taskList=%v, taskID=%v",
+				taskListName, tCtx.info.TaskID))
+			e.metricsClient.IncCounter(metrics.MatchingPollForActivityTaskScope, metrics.StartedTaskCacheHitCounter)
+			tCtx.completeTask(nil)
+			continue pollLoop
+		}
 		// Generate a unique requestId for this task which will be used for all retries
 		requestID := uuid.New()
 		resp, err := tCtx.RecordActivityTaskStartedWithRetry(ctx, &h.RecordActivityTaskStartedRequest{
@@ -411,6 +525,7 @@ pollLoop:
 			case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
 				e.logger.Debug(fmt.Sprintf("Duplicated activity task taskList=%v, taskID=%v",
 					taskListName, tCtx.info.TaskID))
+				tCtx.tlMgr.startedTasks.recordStarted(tCtx.info, persistence.TaskListTypeActivity)
 				tCtx.completeTask(nil)
 			default:
 				tCtx.completeTask(err)
@@ -418,7 +533,9 @@ pollLoop:
 
 			continue pollLoop
 		}
+		tCtx.tlMgr.startedTasks.recordStarted(tCtx.info, persistence.TaskListTypeActivity)
 		tCtx.completeTask(nil)
+		e.logDispatchTrace(tCtx)
 		return e.createPollForActivityTaskResponse(tCtx, resp), nil
 	}
 }
@@ -544,9 +661,18 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(ctx context.Context, request
 
 	taskList := newTaskListID(domainID, taskListName, taskListType)
 	taskListKind := common.TaskListKindPtr(request.TaskList.GetKind())
-	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
-	if err != nil {
-		return err
+	var tlMgr taskListManager
+	if *taskListKind == workflow.TaskListKindSticky {
+		var ok bool
+		// a sticky task list which is not loaded has no pollers to cancel
+		if tlMgr, ok = e.getStickyTaskListManager(taskList); !ok {
+			return nil
+		}
+	} else {
+		var err error
+		if tlMgr, err = e.getTaskListManager(taskList, taskListKind); err != nil {
+			return err
+		}
 	}
 
 	tlMgr.CancelPoller(pollerID)
@@ -563,6 +689,13 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 
 	taskList := newTaskListID(domainID, taskListName, taskListType)
 	taskListKind := common.TaskListKindPtr(request.DescRequest.TaskList.GetKind())
+	if *taskListKind == workflow.TaskListKindSticky {
+		tlMgr, ok := e.getStickyTaskListManager(taskList)
+		if !ok {
+			return &workflow.DescribeTaskListResponse{Pollers: []*workflow.PollerInfo{}}, nil
+		}
+		return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	}
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
 		return nil, err
@@ -575,18 +708,69 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 func (e *matchingEngineImpl) getTask(
 	ctx context.Context, taskList *taskListID, maxDispatchPerSecond *float64, taskListKind *workflow.TaskListKind,
 ) (*taskContext, error) {
-	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
+	var tlMgr taskListManager
+	var err error
+	if taskListKind != nil && *taskListKind == workflow.TaskListKindSticky {
+		tlMgr, err = e.waitForStickyTaskListManager(ctx, taskList)
+	} else {
+		tlMgr, err = e.getTaskListManager(taskList, taskListKind)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return tlMgr.GetTaskContext(ctx, maxDispatchPerSecond)
 }
 
+// getStickyTaskListManager returns the manager of a sticky task list if it is loaded. Sticky task lists are only
+// loaded by the decision tasks history adds to them, the kind of a poll is set by the client and so never loads one.
+// This way a poll marked sticky can not take the tasks of a normal task list.
+func (e *matchingEngineImpl) getStickyTaskListManager(taskList *taskListID) (taskListManager, bool) {
+	e.taskListsLock.RLock()
+	defer e.taskListsLock.RUnlock()
+	result, ok := e.taskLists[*taskList]
+	if !ok || result.getTaskListKind() != persistence.TaskListKindSticky {
+		return nil, false
+	}
+	e.touchTaskList(taskList)
+	return result, true
+}
+
+// waitForStickyTaskListManager waits until the sticky task list of a poll is loaded, a poll which ends before that
+// returns no tasks as if the task list was empty
+func (e *matchingEngineImpl) waitForStickyTaskListManager(ctx context.Context, taskList *taskListID) (taskListManager, error) {
+	if tlMgr, ok := e.getStickyTaskListManager(taskList); ok {
+		return tlMgr, nil
+	}
+	domainEntry, err := e.domainCache.GetDomainByID(taskList.domainID)
+	if err != nil {
+		return nil, err
+	}
+	timer := time.NewTimer(e.config.LongPollExpirationInterval(
+		domainEntry.GetInfo().Name, taskList.taskListName, taskList.taskType))
+	defer timer.Stop()
+	ticker := time.NewTicker(stickyTaskListLoadCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ce.NewNoTasksError(taskList.domainID, taskList.taskListName, taskList.taskType)
+		case <-timer.C:
+			return nil, ce.NewNoTasksError(taskList.domainID, taskList.taskListName, taskList.taskType)
+		case <-ticker.C:
+			if tlMgr, ok := e.getStickyTaskListManager(taskList); ok {
+				return tlMgr, nil
+			}
+		}
+	}
+}
+
 func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
 	e.taskListsLock.Lock()
 	tlMgr, ok := e.taskLists[*id]
 	if ok {
 		delete(e.taskLists, *id)
+		delete(e.taskListsAccess, *id)
+		e.updateLoadedTaskListGauge()
 	}
 	e.taskListsLock.Unlock()
 	if ok {
@@ -594,6 +778,19 @@ func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
 	}
 }
 
+// logDispatchTrace logs how a started task was dispatched, so a slow task can be broken down from its task token
+func (e *matchingEngineImpl) logDispatchTrace(context *taskContext) {
+	if context.dispatchTrace == nil {
+		return
+	}
+	e.logger.Info("Task dispatched.",
+		tag.WorkflowDomainID(context.info.DomainID),
+		tag.WorkflowID(context.info.WorkflowID),
+		tag.WorkflowRunID(context.info.RunID),
+		tag.WorkflowScheduleID(context.info.ScheduleID),
+		tag.WorkflowTaskDispatchTrace(context.dispatchTrace))
+}
+
 // Populate the decision task response based on context and scheduled/started events.
 func (e *matchingEngineImpl) createPollForDecisionTaskResponse(context *taskContext,
 	historyResponse *h.RecordDecisionTaskStartedResponse) *m.PollForDecisionTaskResponse {
@@ -616,6 +813,7 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(context *taskCont
 			RunID:           task.RunID,
 			ScheduleID:      historyResponse.GetScheduledEventId(),
 			ScheduleAttempt: historyResponse.GetAttempt(),
+			DispatchTrace:   context.dispatchTrace,
 		}
 		token, _ = e.tokenSerializer.Serialize(taskoken)
 	}
@@ -661,6 +859,7 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(context *taskCont
 		RunID:           task.RunID,
 		ScheduleID:      task.ScheduleID,
 		ScheduleAttempt: historyResponse.GetAttempt(),
+		DispatchTrace:   context.dispatchTrace,
 	}
 
 	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
@@ -668,9 +867,39 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(context *taskCont
 	response.HeartbeatDetails = historyResponse.HeartbeatDetails
 	response.WorkflowType = historyResponse.WorkflowType
 	response.WorkflowDomain = historyResponse.WorkflowDomain
+	populateActivityTaskDeadlines(response, time.Now())
 	return response
 }
 
+// populateActivityTaskDeadlines sets the server time along with the absolute schedule-to-close and
+// start-to-close deadlines on the response, so workers whose clocks are skewed from the server can
+// derive the remaining duration from the difference instead of their local time.
+func populateActivityTaskDeadlines(response *workflow.PollForActivityTaskResponse, now time.Time) {
+	response.CurrentTimestamp = common.Int64Ptr(now.UnixNano())
+
+	// schedule to close timer is reset on every retry attempt
+	scheduledTimestamp := response.GetScheduledTimestampOfThisAttempt()
+	if scheduledTimestamp == 0 {
+		scheduledTimestamp = response.GetScheduledTimestamp()
+	}
+	if scheduledTimestamp != 0 && response.GetScheduleToCloseTimeoutSeconds() > 0 {
+		response.ScheduleToCloseDeadline = common.Int64Ptr(scheduledTimestamp +
+			int64(response.GetScheduleToCloseTimeoutSeconds())*int64(time.Second))
+	}
+	if response.GetStartedTimestamp() != 0 && response.GetStartToCloseTimeoutSeconds() > 0 {
+		response.StartToCloseDeadline = common.Int64Ptr(response.GetStartedTimestamp() +
+			int64(response.GetStartToCloseTimeoutSeconds())*int64(time.Second))
+	}
+}
+
+// scheduledTime converts the schedule timestamp history passed with a task, zero if history did not pass it
+func scheduledTime(scheduledTimestamp int64) time.Time {
+	if scheduledTimestamp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, scheduledTimestamp)
+}
+
 func newTaskListID(domainID, taskListName string, taskType int) *taskListID {
 	return &taskListID{domainID: domainID, taskListName: taskListName, taskType: taskType}
 }
@@ -678,3 +907,12 @@ func newTaskListID(domainID, taskListName string, taskType int) *taskListID {
 func workflowExecutionPtr(execution workflow.WorkflowExecution) *workflow.WorkflowExecution {
 	return &execution
 }
+
+// taskListSpanTags returns the tags for the span of a task list operation, the execution is only known when adding tasks
+func taskListSpanTags(domainID, taskListName string, execution *workflow.WorkflowExecution) []opentracing.Tag {
+	tags := []opentracing.Tag{{Key: tracing.TaskListTag, Value: taskListName}}
+	if execution == nil {
+		return append(tags, opentracing.Tag{Key: tracing.DomainIDTag, Value: domainID})
+	}
+	return append(tags, tracing.WorkflowTags(domainID, execution.GetWorkflowId(), execution.GetRunId())...)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -124,6 +125,7 @@ func newMatchingEngine(
 		taskManager:     taskMgr,
 		historyService:  historyClient,
 		taskLists:       make(map[taskListID]taskListManager),
+		taskListsAccess: make(map[taskListID]*int64),
 		logger:          logger,
 		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Matching),
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
@@ -148,11 +150,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	const t4 = 340
 	const t5 = 360
 
-	m.addTask(t1)
+	m.addTask(t1, time.Time{})
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t1, m.getReadLevel())
 
-	m.addTask(t2)
+	m.addTask(t2, time.Time{})
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t2, m.getReadLevel())
 
@@ -168,11 +170,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(300, m.getReadLevel())
 
-	m.addTask(t3)
+	m.addTask(t3, time.Time{})
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t3, m.getReadLevel())
 
-	m.addTask(t4)
+	m.addTask(t4, time.Time{})
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t4, m.getReadLevel())
 
@@ -188,6 +190,26 @@ func (s *matchingEngineSuite) TestAckManager() {
 	s.EqualValues(t5, m.getReadLevel())
 }
 
+func (s *matchingEngineSuite) TestAckManager_OldestTaskCreatedTime() {
+	m := newAckManager(s.logger)
+	s.True(m.getOldestTaskCreatedTime().IsZero())
+
+	now := time.Now()
+	m.addTask(200, now.Add(-time.Minute))
+	m.addTask(220, now.Add(-time.Second))
+	m.addTask(240, now)
+	s.Equal(now.Add(-time.Minute), m.getOldestTaskCreatedTime())
+
+	m.completeTask(220)
+	s.Equal(now.Add(-time.Minute), m.getOldestTaskCreatedTime())
+
+	m.completeTask(200)
+	s.Equal(now, m.getOldestTaskCreatedTime())
+
+	m.completeTask(240)
+	s.True(m.getOldestTaskCreatedTime().IsZero())
+}
+
 func (s *matchingEngineSuite) TestPollForActivityTasksEmptyResult() {
 	s.PollForTasksEmptyResultTest(s.callContext, persistence.TaskListTypeActivity)
 }
@@ -214,6 +236,51 @@ func (s *matchingEngineSuite) TestPollForDecisionTasks() {
 	s.PollForDecisionTasksResultTest()
 }
 
+func (s *matchingEngineSuite) TestPollForDecisionTasks_StickyPollOnNormalTaskList() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	stickyTlKind := workflow.TaskListKindSticky
+	identity := "selfDrivingToaster"
+	runID := "run1"
+	workflowID := "workflow1"
+	execution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+	scheduleID := int64(0)
+
+	_, err := s.matchingEngine.AddDecisionTask(context.Background(), &matching.AddDecisionTaskRequest{
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &execution,
+		ScheduleId:                    &scheduleID,
+		TaskList:                      &workflow.TaskList{Name: &tl},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+	})
+	s.NoError(err)
+
+	// the task list is normal, so a poll marking it sticky must not get its task
+	resp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: &tl, Kind: &stickyTlKind},
+			Identity: &identity},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForDecisionTaskResponse, resp)
+
+	// a sticky poll does not load a sticky task list either
+	unknownTl := "unknownToast"
+	resp, err = s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: &unknownTl, Kind: &stickyTlKind},
+			Identity: &identity},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForDecisionTaskResponse, resp)
+	_, ok := s.matchingEngine.getStickyTaskListManager(newTaskListID(domainID, unknownTl, persistence.TaskListTypeDecision))
+	s.False(ok)
+}
+
 func (s *matchingEngineSuite) PollForDecisionTasksResultTest() {
 
 	domainID := "domainId"
@@ -263,7 +330,7 @@ func (s *matchingEngineSuite) PollForDecisionTasksResultTest() {
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 	}
 
-	_, err := s.matchingEngine.AddDecisionTask(&addRequest)
+	_, err := s.matchingEngine.AddDecisionTask(context.Background(), &addRequest)
 	s.NoError(err)
 
 	taskList := &workflow.TaskList{}
@@ -394,7 +461,7 @@ func (s *matchingEngineSuite) AddTasksTest(taskType int) {
 				ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 			}
 
-			_, err = s.matchingEngine.AddActivityTask(&addRequest)
+			_, err = s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 		} else {
 			addRequest := matching.AddDecisionTaskRequest{
 				DomainUUID:                    common.StringPtr(domainID),
@@ -404,7 +471,7 @@ func (s *matchingEngineSuite) AddTasksTest(taskType int) {
 				ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 			}
 
-			_, err = s.matchingEngine.AddDecisionTask(&addRequest)
+			_, err = s.matchingEngine.AddDecisionTask(context.Background(), &addRequest)
 		}
 		s.NoError(err)
 	}
@@ -453,12 +520,12 @@ func (s *matchingEngineSuite) TestTaskWriterShutdown() {
 	// now attempt to add a task
 	scheduleID := int64(5)
 	addRequest.ScheduleId = &scheduleID
-	_, err = s.matchingEngine.AddActivityTask(&addRequest)
+	_, err = s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 	s.Error(err)
 
 	// test race
 	tlmImpl.taskWriter.stopped = 0
-	_, err = s.matchingEngine.AddActivityTask(&addRequest)
+	_, err = s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 	s.Error(err)
 	tlmImpl.taskWriter.stopped = 1 // reset it back to old value
 }
@@ -495,7 +562,7 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 		}
 
-		_, err := s.matchingEngine.AddActivityTask(&addRequest)
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 		s.NoError(err)
 	}
 	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
@@ -554,6 +621,9 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 		s.Equal(true, validateTimeRange(time.Unix(0, *result.StartedTimestamp), time.Minute))
 		s.Equal(int32(50), *result.StartToCloseTimeoutSeconds)
 		s.Equal(int32(10), *result.HeartbeatTimeoutSeconds)
+		s.Equal(true, validateTimeRange(time.Unix(0, *result.CurrentTimestamp), time.Minute))
+		s.Equal(*result.ScheduledTimestamp+int64(100*time.Second), *result.ScheduleToCloseDeadline)
+		s.Equal(*result.StartedTimestamp+int64(50*time.Second), *result.StartToCloseDeadline)
 		token := &common.TaskToken{
 			DomainID:   domainID,
 			WorkflowID: workflowID,
@@ -669,7 +739,7 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 			TaskList:                      taskList,
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 		}
-		_, err := s.matchingEngine.AddActivityTask(&addRequest)
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 		wg.Wait()
 		s.NoError(err)
 		s.NoError(pollErr)
@@ -801,7 +871,7 @@ func (s *matchingEngineSuite) concurrentPublishConsumeActivities(
 					ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 				}
 
-				_, err := s.matchingEngine.AddActivityTask(&addRequest)
+				_, err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 				if err != nil {
 					s.logger.Info("Failure in AddActivityTask", tag.Error(err))
 					i--
@@ -935,7 +1005,7 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeDecisions() {
 					ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 				}
 
-				_, err := s.matchingEngine.AddDecisionTask(&addRequest)
+				_, err := s.matchingEngine.AddDecisionTask(context.Background(), &addRequest)
 				if err != nil {
 					panic(err)
 				}
@@ -1089,7 +1159,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 					ScheduleToStartTimeoutSeconds: common.Int32Ptr(600),
 				}
 
-				_, err := engine.AddActivityTask(&addRequest)
+				_, err := engine.AddActivityTask(context.Background(), &addRequest)
 				if err != nil {
 					if _, ok := err.(*persistence.ConditionFailedError); ok {
 						i-- // retry adding
@@ -1243,7 +1313,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 					ScheduleToStartTimeoutSeconds: common.Int32Ptr(600),
 				}
 
-				_, err := engine.AddDecisionTask(&addRequest)
+				_, err := engine.AddDecisionTask(context.Background(), &addRequest)
 				if err != nil {
 					if _, ok := err.(*persistence.ConditionFailedError); ok {
 						i-- // retry adding
@@ -1368,7 +1438,7 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 	}
 
-	_, err := s.matchingEngine.AddActivityTask(&addRequest)
+	_, err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 	s.NoError(err)
 	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
 
@@ -1389,6 +1459,35 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestTaskListEvictedWhenHostLimitReached() {
+	s.matchingEngine.config.MaxTaskListsPerHost = dynamicconfig.GetIntPropertyFn(2)
+	tlKind := common.TaskListKindPtr(workflow.TaskListKindNormal)
+	tlID1 := newTaskListID("domainId", "tl1", persistence.TaskListTypeActivity)
+	tlID2 := newTaskListID("domainId", "tl2", persistence.TaskListTypeActivity)
+	tlID3 := newTaskListID("domainId", "tl3", persistence.TaskListTypeActivity)
+
+	_, err := s.matchingEngine.getTaskListManager(tlID1, tlKind)
+	s.NoError(err)
+	_, err = s.matchingEngine.getTaskListManager(tlID2, tlKind)
+	s.NoError(err)
+	// make tl2 the least recently used task list
+	_, err = s.matchingEngine.getTaskListManager(tlID1, tlKind)
+	s.NoError(err)
+
+	_, err = s.matchingEngine.getTaskListManager(tlID3, tlKind)
+	s.NoError(err)
+
+	s.Equal(2, len(s.matchingEngine.getTaskLists(100)))
+	s.matchingEngine.taskListsLock.RLock()
+	_, ok1 := s.matchingEngine.taskLists[*tlID1]
+	_, ok2 := s.matchingEngine.taskLists[*tlID2]
+	_, ok3 := s.matchingEngine.taskLists[*tlID3]
+	s.matchingEngine.taskListsLock.RUnlock()
+	s.True(ok1)
+	s.False(ok2)
+	s.True(ok3)
+}
+
 func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 	runID := "run1"
 	workflowID := "workflow1"
@@ -1417,7 +1516,7 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 		}
 
-		_, err := s.matchingEngine.AddActivityTask(&addRequest)
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 		s.NoError(err)
 	}
 
@@ -1558,7 +1657,7 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 				// simulates creating a task whos scheduledToStartTimeout is already expired
 				addRequest.ScheduleToStartTimeoutSeconds = common.Int32Ptr(-5)
 			}
-			_, err := s.matchingEngine.AddActivityTask(&addRequest)
+			_, err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 			s.NoError(err)
 		}
 
@@ -1596,6 +1695,174 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 	}
 }
 
+func (s *matchingEngineSuite) TestPollForActivityTask_DispatchTrace() {
+	s.matchingEngine.config.EnableTaskDispatchTrace = dynamicconfig.GetBoolPropertyFnFilteredByTaskListInfo(true)
+	s.matchingEngine.hostInfo = membership.NewHostInfo("matching-host", nil)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	taskList := &workflow.TaskList{Name: &tl}
+	workflowExecution := workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")}
+	activityID := "activityId1"
+	scheduleID := int64(3)
+
+	_, err := s.matchingEngine.AddActivityTask(context.Background(), &matching.AddActivityTaskRequest{
+		SourceDomainUUID:              common.StringPtr(domainID),
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflowExecution,
+		ScheduleId:                    &scheduleID,
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	})
+	s.NoError(err)
+
+	s.historyClient.On("RecordActivityTaskStarted", mock.Anything,
+		mock.AnythingOfType("*history.RecordActivityTaskStartedRequest")).Return(
+		func(ctx context.Context, taskRequest *gohistory.RecordActivityTaskStartedRequest) *gohistory.RecordActivityTaskStartedResponse {
+			return &gohistory.RecordActivityTaskStartedResponse{
+				ScheduledEvent: newActivityTaskScheduledEvent(*taskRequest.ScheduleId, 0,
+					&workflow.ScheduleActivityTaskDecisionAttributes{
+						ActivityId:                    &activityID,
+						TaskList:                      taskList,
+						ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity1")},
+						ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+						ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+						StartToCloseTimeoutSeconds:    common.Int32Ptr(100),
+						HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+					}),
+				StartedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			}
+		}, nil)
+
+	result, err := s.matchingEngine.PollForActivityTask(s.callContext, &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: taskList,
+			Identity: common.StringPtr("nobody"),
+		},
+	})
+	s.NoError(err)
+	s.NotEmpty(result.TaskToken)
+
+	token, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
+	s.NoError(err)
+	s.Equal(scheduleID, token.ScheduleID)
+	s.NotNil(token.DispatchTrace)
+	s.Equal("matching-host", token.DispatchTrace.Host)
+	s.Equal(tl, token.DispatchTrace.TaskList)
+	s.True(token.DispatchTrace.BufferWait >= 0)
+}
+
+func (s *matchingEngineSuite) TestPollForActivityTask_StartedTaskDropped() {
+	s.matchingEngine.config.StartedTaskCacheSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(100)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+	taskList := &workflow.TaskList{Name: &tl}
+	workflowExecution := workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")}
+	activityID := "activityId1"
+	scheduleID := int64(3)
+	addRequest := &matching.AddActivityTaskRequest{
+		SourceDomainUUID:              common.StringPtr(domainID),
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflowExecution,
+		ScheduleId:                    &scheduleID,
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	}
+	pollRequest := &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: taskList,
+			Identity: common.StringPtr("nobody"),
+		},
+	}
+
+	// a redelivery of a task history already started is dropped without asking history
+	startedTask := &persistence.TaskInfo{
+		DomainID:   domainID,
+		RunID:      workflowExecution.GetRunId(),
+		ScheduleID: scheduleID,
+	}
+	_, err := s.matchingEngine.AddActivityTask(context.Background(), addRequest)
+	s.NoError(err)
+	tlMgr, ok := s.matchingEngine.taskLists[*tlID].(*taskListManagerImpl)
+	s.True(ok)
+	tlMgr.startedTasks.recordStarted(startedTask, persistence.TaskListTypeActivity)
+	result, err := s.matchingEngine.PollForActivityTask(s.callContext, pollRequest)
+	s.NoError(err)
+	s.Empty(result.TaskToken)
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+	s.historyClient.AssertNotCalled(s.T(), "RecordActivityTaskStarted", mock.Anything, mock.Anything)
+
+	// a retry of the activity reuses the schedule ID and is dispatched again
+	s.historyClient.On("RecordActivityTaskStarted", mock.Anything,
+		mock.AnythingOfType("*history.RecordActivityTaskStartedRequest")).Return(
+		&gohistory.RecordActivityTaskStartedResponse{
+			ScheduledEvent: newActivityTaskScheduledEvent(scheduleID, 0,
+				&workflow.ScheduleActivityTaskDecisionAttributes{
+					ActivityId:                    &activityID,
+					TaskList:                      taskList,
+					ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity1")},
+					ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+					StartToCloseTimeoutSeconds:    common.Int32Ptr(100),
+					HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+				}),
+			StartedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			Attempt:          common.Int64Ptr(1),
+		}, nil).Once()
+	_, err = s.matchingEngine.AddActivityTask(context.Background(), addRequest)
+	s.NoError(err)
+	result, err = s.matchingEngine.PollForActivityTask(s.callContext, pollRequest)
+	s.NoError(err)
+	s.NotEmpty(result.TaskToken)
+	s.True(tlMgr.startedTasks.isStarted(startedTask, persistence.TaskListTypeActivity))
+
+	// the TTL is read on every lookup
+	s.matchingEngine.config.StartedTaskCacheTTL = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(0)
+	s.False(tlMgr.startedTasks.isStarted(startedTask, persistence.TaskListTypeActivity))
+	s.matchingEngine.config.StartedTaskCacheTTL = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+
+	// another host may have owned the task list in between, a reload starts with an empty cache
+	tlMgr.startedTasks.recordStarted(startedTask, persistence.TaskListTypeActivity)
+	s.matchingEngine.unloadTaskList(tlID)
+	_, err = s.matchingEngine.getTaskListManager(tlID, common.TaskListKindPtr(workflow.TaskListKindNormal))
+	s.NoError(err)
+	reloaded, ok := s.matchingEngine.taskLists[*tlID].(*taskListManagerImpl)
+	s.True(ok)
+	s.NotEqual(tlMgr, reloaded)
+	s.False(reloaded.startedTasks.isStarted(startedTask, persistence.TaskListTypeActivity))
+}
+
+func (s *matchingEngineSuite) TestPopulateActivityTaskDeadlines() {
+	now := time.Now()
+	scheduled := now.Add(-time.Hour).UnixNano()
+	scheduledThisAttempt := now.Add(-time.Minute).UnixNano()
+	started := now.Add(-time.Second).UnixNano()
+
+	response := &workflow.PollForActivityTaskResponse{
+		ScheduledTimestamp:              common.Int64Ptr(scheduled),
+		ScheduledTimestampOfThisAttempt: common.Int64Ptr(scheduledThisAttempt),
+		ScheduleToCloseTimeoutSeconds:   common.Int32Ptr(100),
+		StartedTimestamp:                common.Int64Ptr(started),
+		StartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+	}
+	populateActivityTaskDeadlines(response, now)
+	s.Equal(now.UnixNano(), response.GetCurrentTimestamp())
+	s.Equal(scheduledThisAttempt+int64(100*time.Second), response.GetScheduleToCloseDeadline())
+	s.Equal(started+int64(10*time.Second), response.GetStartToCloseDeadline())
+
+	response = &workflow.PollForActivityTaskResponse{
+		ScheduledTimestamp:            common.Int64Ptr(scheduled),
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+	}
+	populateActivityTaskDeadlines(response, now)
+	s.Equal(scheduled+int64(100*time.Second), response.GetScheduleToCloseDeadline())
+	s.Nil(response.StartToCloseDeadline)
+}
+
 func (s *matchingEngineSuite) setupRecordActivityTaskStartedMock(tlName string) {
 	activityTypeName := "activity1"
 	activityID := "activityId1"
@@ -1731,7 +1998,7 @@ func newTestTaskListManager() *testTaskListManager {
 }
 
 // LeaseTaskList provides a mock function with given fields: request
-func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
+func (m *testTaskManager) LeaseTaskList(ctx context.Context, request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
 	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1751,7 +2018,7 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 }
 
 // UpdateTaskList provides a mock function with given fields: request
-func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
+func (m *testTaskManager) UpdateTaskList(ctx context.Context, request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
 	m.logger.Debug(fmt.Sprintf("UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel))
 
 	tli := request.TaskListInfo
@@ -1769,7 +2036,7 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 }
 
 // CompleteTask provides a mock function with given fields: request
-func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
+func (m *testTaskManager) CompleteTask(ctx context.Context, request *persistence.CompleteTaskRequest) error {
 	m.logger.Debug(fmt.Sprintf("CompleteTask taskID=%v, ackLevel=%v", request.TaskID, request.TaskList.AckLevel))
 	if request.TaskID <= 0 {
 		panic(fmt.Errorf("Invalid taskID=%v", request.TaskID))
@@ -1785,7 +2052,7 @@ func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 	return nil
 }
 
-func (m *testTaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
+func (m *testTaskManager) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (int, error) {
 	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskListName, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1800,11 +2067,11 @@ func (m *testTaskManager) CompleteTasksLessThan(request *persistence.CompleteTas
 }
 
 func (m *testTaskManager) ListTaskList(
-	request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
+	ctx context.Context, request *persistence.ListTaskListRequest) (*persistence.ListTaskListResponse, error) {
 	return nil, fmt.Errorf("unsupported operation")
 }
 
-func (m *testTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+func (m *testTaskManager) DeleteTaskList(ctx context.Context, request *persistence.DeleteTaskListRequest) error {
 	m.Lock()
 	defer m.Unlock()
 	key := newTaskListID(request.DomainID, request.TaskListName, request.TaskListType)
@@ -1813,7 +2080,7 @@ func (m *testTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequ
 }
 
 // CreateTask provides a mock function with given fields: request
-func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+func (m *testTaskManager) CreateTasks(ctx context.Context, request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
 	domainID := request.TaskListInfo.DomainID
 	taskList := request.TaskListInfo.Name
 	taskType := request.TaskListInfo.TaskType
@@ -1866,7 +2133,7 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 }
 
 // GetTasks provides a mock function with given fields: request
-func (m *testTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+func (m *testTaskManager) GetTasks(ctx context.Context, request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, request.MaxReadLevel))
 
 	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskList, request.TaskType))
@@ -1947,5 +2214,7 @@ func defaultTestConfig() *Config {
 	config := NewConfig(dynamicconfig.NewNopCollection())
 	config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
 	config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
+	// the concurrency tests add many tasks with the same schedule ID
+	config.StartedTaskCacheSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(0)
 	return config
 }
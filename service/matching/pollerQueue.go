@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/list"
+	"sync"
+)
+
+type (
+	// pollerQueue lines up the pollers waiting on a task list so tasks are handed out in the order the pollers
+	// arrived, each poller receives on its own channel and tasks are sent to the poller at the head of the queue
+	pollerQueue struct {
+		sync.Mutex
+		waiting *list.List
+		// headChanged is closed, and replaced, whenever another poller becomes the head of the queue
+		headChanged chan struct{}
+	}
+
+	// waitingPoller is the place of a poller in the queue
+	waitingPoller struct {
+		tasks   chan *getTaskResult
+		element *list.Element
+	}
+)
+
+func newPollerQueue() *pollerQueue {
+	return &pollerQueue{
+		waiting:     list.New(),
+		headChanged: make(chan struct{}),
+	}
+}
+
+// enqueue adds a poller at the tail of the queue
+func (q *pollerQueue) enqueue() *waitingPoller {
+	q.Lock()
+	defer q.Unlock()
+
+	poller := &waitingPoller{tasks: make(chan *getTaskResult)}
+	poller.element = q.waiting.PushBack(poller)
+	if q.waiting.Len() == 1 {
+		q.notifyHeadChanged()
+	}
+	return poller
+}
+
+// leave removes a poller from the queue, once it got a task or gave up waiting
+func (q *pollerQueue) leave(poller *waitingPoller) {
+	q.Lock()
+	defer q.Unlock()
+
+	wasHead := q.waiting.Front() == poller.element
+	q.waiting.Remove(poller.element)
+	if wasHead {
+		q.notifyHeadChanged()
+	}
+}
+
+// head returns the channel of the poller at the head of the queue, nil when no poller is waiting, and a channel
+// closed once the head changes
+func (q *pollerQueue) head() (chan<- *getTaskResult, <-chan struct{}) {
+	q.Lock()
+	defer q.Unlock()
+
+	var tasks chan<- *getTaskResult
+	if front := q.waiting.Front(); front != nil {
+		tasks = front.Value.(*waitingPoller).tasks
+	}
+	return tasks, q.headChanged
+}
+
+func (q *pollerQueue) notifyHeadChanged() {
+	close(q.headChanged)
+	q.headChanged = make(chan struct{})
+}
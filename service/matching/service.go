@@ -47,11 +47,31 @@ type Config struct {
 	LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 	MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 	MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	// Tasks read per recent poller, bounded by GetTasksBatchSize, 0 always reads GetTasksBatchSize
+	GetTasksBatchSizePerPoller dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	// Max number of task lists loaded on this host, least recently used ones are unloaded beyond it
+	MaxTaskListsPerHost dynamicconfig.IntPropertyFn
+	// Number of started tasks a task list remembers to drop their redeliveries without asking history, and for how long
+	StartedTaskCacheSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	StartedTaskCacheTTL  dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 
 	// taskWriter configuration
 	OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 	MaxTaskBatchSize                dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
+	// embed a dispatch trace in task tokens and log it when the task is started
+	EnableTaskDispatchTrace dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+	// tag task list manager metrics with the task list name
+	EnableTaskListMetrics dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+	// hand out tasks to waiting pollers in the order they arrived
+	EnableFairPollerDispatch dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+
+	// encoding of new task tokens, HMAC keys they are signed and verified with, and whether unsigned tokens are still accepted
+	TaskTokenEncoding            dynamicconfig.StringPropertyFn
+	TaskTokenSigningKey          dynamicconfig.StringPropertyFn
+	TaskTokenPreviousSigningKeys dynamicconfig.StringPropertyFn
+	TaskTokenAcceptUnsigned      dynamicconfig.BoolPropertyFn
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 }
 
@@ -63,14 +83,25 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
 		RangeSize:                       100000,
 		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
+		GetTasksBatchSizePerPoller:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSizePerPoller, 0),
 		UpdateAckInterval:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval, 1*time.Minute),
 		IdleTasklistCheckInterval:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval, 5*time.Minute),
 		MaxTasklistIdleTime:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime, 5*time.Minute),
 		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
 		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
+		MaxTaskListsPerHost:             dc.GetIntProperty(dynamicconfig.MatchingMaxTaskListsPerHost, 0),
+		StartedTaskCacheSize:            dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingStartedTaskCacheSize, 1000),
+		StartedTaskCacheTTL:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingStartedTaskCacheTTL, 10*time.Minute),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
+		EnableTaskDispatchTrace:         dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableTaskDispatchTrace, false),
+		EnableTaskListMetrics:           dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableTaskListMetrics, false),
+		EnableFairPollerDispatch:        dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableFairPollerDispatch, false),
+		TaskTokenEncoding:               dc.GetStringProperty(dynamicconfig.TaskTokenEncoding, common.TaskTokenEncodingJSON),
+		TaskTokenSigningKey:             dc.GetStringProperty(dynamicconfig.TaskTokenSigningKey, ""),
+		TaskTokenPreviousSigningKeys:    dc.GetStringProperty(dynamicconfig.TaskTokenPreviousSigningKeys, ""),
+		TaskTokenAcceptUnsigned:         dc.GetBoolProperty(dynamicconfig.TaskTokenAcceptUnsigned, true),
 		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
 	}
 }
@@ -106,7 +137,7 @@ func (s *Service) Start() {
 
 	pConfig := params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), params.BlobstoreClient, log)
 
 	taskPersistence, err := pFactory.NewTaskManager()
 	if err != nil {
@@ -123,7 +154,8 @@ func (s *Service) Start() {
 
 	log.Info("started", tag.Service(common.MatchingServiceName))
 	<-s.stopC
-	base.Stop()
+	// unloads the task lists owned by this host so their leases can be taken over right away
+	handler.Stop()
 }
 
 // Stop stops the service
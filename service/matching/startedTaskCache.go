@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	startedTaskKey struct {
+		domainID   string
+		runID      string
+		scheduleID int64
+		taskType   int
+	}
+
+	// startedTaskCache remembers the tasks of a task list history reported as started or gone, so redeliveries
+	// of the same task are dropped without asking history again. It lives with the task list manager, so it is
+	// empty whenever the task list is loaded and never holds entries from a previous ownership of the task list
+	startedTaskCache struct {
+		maxSize func() int
+		ttl     func() time.Duration
+
+		sync.Mutex
+		size  int
+		cache cache.Cache // nil when disabled
+	}
+)
+
+func newStartedTaskCache(maxSize func() int, ttl func() time.Duration) *startedTaskCache {
+	return &startedTaskCache{
+		maxSize: maxSize,
+		ttl:     ttl,
+	}
+}
+
+func (c *startedTaskCache) isStarted(task *persistence.TaskInfo, taskType int) bool {
+	tasks := c.getCache()
+	if tasks == nil {
+		return false
+	}
+	key := newStartedTaskKey(task, taskType)
+	recorded, ok := tasks.Get(key).(time.Time)
+	if !ok {
+		return false
+	}
+	if time.Since(recorded) > c.ttl() {
+		tasks.Delete(key)
+		return false
+	}
+	return true
+}
+
+func (c *startedTaskCache) recordStarted(task *persistence.TaskInfo, taskType int) {
+	tasks := c.getCache()
+	if tasks == nil {
+		return
+	}
+	tasks.Put(newStartedTaskKey(task, taskType), time.Now())
+}
+
+// invalidate forgets the outcome of a task when history schedules it again, as retried activities and transient
+// decisions reuse the schedule ID of the previous attempt
+func (c *startedTaskCache) invalidate(task *persistence.TaskInfo, taskType int) {
+	tasks := c.getCache()
+	if tasks == nil {
+		return
+	}
+	tasks.Delete(newStartedTaskKey(task, taskType))
+}
+
+// getCache returns the cache sized after the current config, a size change starts over with an empty cache
+func (c *startedTaskCache) getCache() cache.Cache {
+	size := c.maxSize()
+	c.Lock()
+	defer c.Unlock()
+	if size <= 0 {
+		c.size = 0
+		c.cache = nil
+		return nil
+	}
+	if size != c.size {
+		c.size = size
+		c.cache = cache.NewLRU(size)
+	}
+	return c.cache
+}
+
+func newStartedTaskKey(task *persistence.TaskInfo, taskType int) startedTaskKey {
+	return startedTaskKey{
+		domainID:   task.DomainID,
+		runID:      task.RunID,
+		scheduleID: task.ScheduleID,
+		taskType:   taskType,
+	}
+}
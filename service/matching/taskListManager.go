@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -46,6 +47,12 @@ const (
 	// Time budget for empty task to propagate through the function stack and be returned to
 	// pollForActivityTask or pollForDecisionTask handler.
 	returnEmptyTaskTimeBudget time.Duration = time.Second
+
+	// Maximum time a sync match waits for the dispatch rate limiter before the task goes to the backlog.
+	maxSyncMatchDispatchDelay time.Duration = time.Second
+	// Minimum schedule to start time a task must have left when it is handed over to a poller by sync match,
+	// leaving the poller enough time to record the task as started.
+	minSyncMatchDispatchBudget time.Duration = 100 * time.Millisecond
 )
 
 // NOTE: Is this good enough for stress tests?
@@ -75,6 +82,7 @@ type (
 		LongPollExpirationInterval func() time.Duration
 		RangeSize                  int64
 		GetTasksBatchSize          func() int
+		GetTasksBatchSizePerPoller func() int
 		UpdateAckInterval          func() time.Duration
 		IdleTasklistCheckInterval  func() time.Duration
 		MaxTasklistIdleTime        func() time.Duration
@@ -83,6 +91,11 @@ type (
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
+		EnableTaskDispatchTrace         func() bool
+		EnableTaskListMetrics           func() bool
+		EnableFairPollerDispatch        func() bool
+		StartedTaskCacheSize            func() int
+		StartedTaskCacheTTL             func() time.Duration
 	}
 
 	// Contains information needed for current task transition from queue to Workflow execution history.
@@ -93,6 +106,7 @@ type (
 		workflowExecution s.WorkflowExecution
 		queryTaskInfo     *queryTaskInfo
 		backlogCountHint  int64
+		dispatchTrace     *common.DispatchTrace // nil unless dispatch tracing is enabled
 	}
 
 	queryTaskInfo struct {
@@ -112,6 +126,10 @@ type (
 
 		// pollerHistory stores poller which poll from this tasklist in last few minutes
 		pollerHistory *pollerHistory
+		// pollerQueue orders the waiting pollers when fair dispatch is enabled
+		pollerQueue *pollerQueue
+		// startedTasks drops redeliveries of tasks history already started
+		startedTasks *startedTaskCache
 
 		taskWriter *taskWriter
 		taskBuffer chan *persistence.TaskInfo // tasks loaded from persistence
@@ -186,6 +204,9 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		GetTasksBatchSize: func() int {
 			return config.GetTasksBatchSize(domain, taskListName, taskType)
 		},
+		GetTasksBatchSizePerPoller: func() int {
+			return config.GetTasksBatchSizePerPoller(domain, taskListName, taskType)
+		},
 		UpdateAckInterval: func() time.Duration {
 			return config.UpdateAckInterval(domain, taskListName, taskType)
 		},
@@ -213,6 +234,21 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		MaxTaskBatchSize: func() int {
 			return config.MaxTaskBatchSize(domain, taskListName, taskType)
 		},
+		EnableTaskDispatchTrace: func() bool {
+			return config.EnableTaskDispatchTrace(domain, taskListName, taskType)
+		},
+		EnableTaskListMetrics: func() bool {
+			return config.EnableTaskListMetrics(domain, taskListName, taskType)
+		},
+		EnableFairPollerDispatch: func() bool {
+			return config.EnableFairPollerDispatch(domain, taskListName, taskType)
+		},
+		StartedTaskCacheSize: func() int {
+			return config.StartedTaskCacheSize(domain, taskListName, taskType)
+		},
+		StartedTaskCacheTTL: func() time.Duration {
+			return config.StartedTaskCacheTTL(domain, taskListName, taskType)
+		},
 	}, nil
 }
 
@@ -243,6 +279,12 @@ func newTaskListManagerWithRateLimiter(
 		taskListKind = common.TaskListKindPtr(s.TaskListKindNormal)
 	}
 
+	domainScope := domainTaggedMetricScope(e.domainCache, taskList.domainID, e.metricsClient, metrics.MatchingTaskListMgrScope)
+	// sticky task lists are per worker and short lived, never tag them to keep metrics cardinality bounded
+	if *taskListKind != s.TaskListKindSticky && config.EnableTaskListMetrics() {
+		domainScope = domainScope.Tagged(metrics.TaskListTag(taskList.taskListName))
+	}
+
 	db := newTaskListDB(e.taskManager, taskList.domainID, taskList.taskListName, taskList.taskType, int(*taskListKind), e.logger)
 	tlMgr := &taskListManagerImpl{
 		domainCache:             domainCache,
@@ -254,9 +296,9 @@ func newTaskListManagerWithRateLimiter(
 		cancelCtx:               ctx,
 		cancelFunc:              cancel,
 		taskListID:              taskList,
-		logger: e.logger.WithTags(tag.WorkflowTaskListName(taskList.taskListName),
-			tag.WorkflowTaskListType(taskList.taskType)),
-		domainScope:         domainTaggedMetricScope(e.domainCache, taskList.domainID, e.metricsClient, metrics.MatchingTaskListMgrScope),
+		logger: log.WithTaskList(e.logger, taskList.domainID, taskList.taskListName,
+			taskList.taskType),
+		domainScope:         domainScope,
 		db:                  db,
 		taskAckManager:      newAckManager(e.logger),
 		taskGC:              newTaskGC(db, config),
@@ -264,6 +306,8 @@ func newTaskListManagerWithRateLimiter(
 		queryTasksForPoll:   make(chan *getTaskResult),
 		config:              config,
 		pollerHistory:       newPollerHistory(),
+		pollerQueue:         newPollerQueue(),
+		startedTasks:        newStartedTaskCache(config.StartedTaskCacheSize, config.StartedTaskCacheTTL),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
 		rateLimiter:         rl,
 		taskListKind:        int(*taskListKind),
@@ -309,7 +353,8 @@ func (c *taskListManagerImpl) Stop() {
 
 func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) (syncMatch bool, err error) {
 	c.startWG.Wait()
-	_, err = c.executeWithRetry(func() (interface{}, error) {
+	c.startedTasks.invalidate(taskInfo, c.taskListID.taskType)
+	_, err = c.executeWithRetry(c.cancelCtx, func() (interface{}, error) {
 
 		domainEntry, err := c.domainCache.GetDomainByID(taskInfo.DomainID)
 		if err != nil {
@@ -380,11 +425,29 @@ func (c *taskListManagerImpl) GetTaskContext(
 		queryTaskInfo:     result.queryTask, // non-nil for query task
 		backlogCountHint:  c.taskAckManager.getBacklogCountHint(),
 	}
+	if result.queryTask == nil && c.config.EnableTaskDispatchTrace() {
+		tCtx.dispatchTrace = c.newDispatchTrace(task, result.C != nil, time.Now())
+	}
 	return tCtx, nil
 }
 
+func (c *taskListManagerImpl) newDispatchTrace(task *persistence.TaskInfo, syncMatch bool, now time.Time) *common.DispatchTrace {
+	trace := &common.DispatchTrace{
+		TaskList:  c.taskListID.taskListName,
+		SyncMatch: syncMatch,
+	}
+	if c.engine.hostInfo != nil {
+		trace.Host = c.engine.hostInfo.Identity()
+	}
+	// tasks persisted before created time was tracked have none
+	if task.CreatedTime.After(epochStartTime) {
+		trace.BufferWait = int64(now.Sub(task.CreatedTime))
+	}
+	return trace
+}
+
 func (c *taskListManagerImpl) persistAckLevel() error {
-	return c.db.UpdateState(c.taskAckManager.getAckLevel())
+	return c.db.UpdateState(c.cancelCtx, c.taskAckManager.getAckLevel())
 }
 
 func (c *taskListManagerImpl) getAckLevel() (ackLevel int64) {
@@ -401,12 +464,15 @@ func (c *taskListManagerImpl) getTaskListKind() int {
 // There is no correspondent initiateTaskPoll as append is initiated in getTasksPump
 func (c *taskListManagerImpl) completeTaskPoll(taskID int64) int64 {
 	ackLevel := c.taskAckManager.completeTask(taskID)
-	c.taskGC.Run(ackLevel)
+	c.taskGC.Run(c.cancelCtx, ackLevel)
 	return ackLevel
 }
 
 // Loads task from taskBuffer (which is populated from persistence) or from sync match to add task call
 func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond *float64) (*getTaskResult, error) {
+	sw := c.domainScope.StartTimer(metrics.PollLatency)
+	defer sw.Stop()
+
 	childCtxTimeout := c.config.LongPollExpirationInterval()
 	if deadline, ok := ctx.Deadline(); ok {
 		// We need to set a shorter timeout than the original ctx; otherwise, by the time ctx deadline is
@@ -458,6 +524,14 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// value. Last poller wins if different pollers provide different values
 	c.rateLimiter.UpdateMaxDispatch(maxDispatchPerSecond)
 
+	// with fair dispatch the poller waits in the queue on its own channel and activity and decision tasks are sent
+	// to the poller waiting the longest, query tasks go to any poller as they are only sync matched
+	if tasksForPoll != nil && c.config.EnableFairPollerDispatch() {
+		poller := c.pollerQueue.enqueue()
+		defer c.pollerQueue.leave(poller)
+		tasksForPoll = poller.tasks
+	}
+
 	select {
 	case result := <-tasksForPoll:
 		if result.syncMatch {
@@ -473,7 +547,24 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		return result, nil
 	case <-childCtx.Done():
 		c.domainScope.IncCounter(metrics.PollTimeoutCounter)
-		return nil, ErrNoTasks
+		return nil, ce.NewNoTasksError(c.taskListID.domainID, c.taskListID.taskListName, c.taskListID.taskType)
+	}
+}
+
+// dispatchTask blocks until a poller takes the task, either one waiting on the shared channel or the head of the
+// fair dispatch queue, false if shutdownCh is closed first
+func (c *taskListManagerImpl) dispatchTask(result *getTaskResult, shutdownCh <-chan struct{}) bool {
+	for {
+		head, headChanged := c.pollerQueue.head()
+		select {
+		case c.tasksForPoll <- result:
+			return true
+		case head <- result:
+			return true
+		case <-headChanged:
+		case <-shutdownCh:
+			return false
+		}
 	}
 }
 
@@ -490,11 +581,11 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 func (c *taskListManagerImpl) renewLeaseWithRetry() (taskListState, error) {
 	var newState taskListState
 	op := func() (err error) {
-		newState, err = c.db.RenewLease()
+		newState, err = c.db.RenewLease(c.cancelCtx)
 		return
 	}
 	c.domainScope.IncCounter(metrics.LeaseRequestCounter)
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.RetryContext(c.cancelCtx, op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 	if err != nil {
 		c.domainScope.IncCounter(metrics.LeaseFailureCounter)
 		c.engine.unloadTaskList(c.taskListID)
@@ -547,7 +638,7 @@ func (c *taskListManagerImpl) GetAllPollerInfo() []*s.PollerInfo {
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// (readLevel, ackLevel, backlogCountHint, creation time of the oldest task and taskIDBlock).
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse {
 	response := &s.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
 	if !includeTaskListStatus {
@@ -565,6 +656,9 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.De
 			EndID:   common.Int64Ptr(taskIDBlock.end),
 		},
 	}
+	if createdTime := c.taskAckManager.getOldestTaskCreatedTime(); createdTime.After(epochStartTime) {
+		response.TaskListStatus.OldestTaskCreatedTimestamp = common.Int64Ptr(createdTime.UnixNano())
+	}
 
 	return response
 }
@@ -573,6 +667,8 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.De
 // When this method returns non nil response without error it is guaranteed that the task is started
 // and sent to a poller. So it not necessary to persist it.
 // Returns (nil, nil) if there is no waiting poller which indicates that task has to be persisted.
+// Returns errAddTasklistThrottled if the task cannot be dispatched within the rate limit or early enough to be
+// started before its schedule to start deadline, in which case the task has to be persisted as well.
 func (c *taskListManagerImpl) trySyncMatch(task *persistence.TaskInfo) (*persistence.CreateTasksResponse, error) {
 	if !c.config.EnableSyncMatch() {
 		return nil, nil
@@ -581,28 +677,60 @@ func (c *taskListManagerImpl) trySyncMatch(task *persistence.TaskInfo) (*persist
 	// But it is getTask result from the point of view of a poll operation.
 	request := &getTaskResult{task: task, C: make(chan *syncMatchResponse, 1), syncMatch: true}
 
+	maxDelay := maxSyncMatchDispatchDelay
+	if deadline, ok := syncMatchDispatchDeadline(task); ok {
+		// the poller must be able to start the task before its schedule to start deadline,
+		// otherwise the task would time out while held by the worker
+		if budget := deadline.Sub(time.Now()) - minSyncMatchDispatchBudget; budget < maxDelay {
+			maxDelay = budget
+		}
+		if maxDelay < 0 {
+			c.domainScope.IncCounter(metrics.SyncMatchDeadlineCounter)
+			return nil, errAddTasklistThrottled
+		}
+	}
+
 	rsv := c.rateLimiter.Reserve()
 	// If we have to wait too long for reservation, better to store in task buffer and handle later.
-	if !rsv.OK() || rsv.Delay() > time.Second {
+	if !rsv.OK() || rsv.Delay() > maxDelay {
 		if rsv.OK() { // if we were indeed given a reservation, return it before we bail out
 			rsv.Cancel()
 		}
-		c.domainScope.IncCounter(metrics.SyncThrottleCounter)
+		if rsv.OK() && rsv.Delay() <= maxSyncMatchDispatchDelay {
+			// only the schedule to start deadline of the task prevented the sync match
+			c.domainScope.IncCounter(metrics.SyncMatchDeadlineCounter)
+		} else {
+			c.domainScope.IncCounter(metrics.SyncThrottleCounter)
+		}
 		return nil, errAddTasklistThrottled
 	}
 	time.Sleep(rsv.Delay())
+	head, _ := c.pollerQueue.head()
 	select {
 	case c.tasksForPoll <- request: // poller goroutine picked up the task
 		r := <-request.C
 		return r.response, r.err
+	case head <- request: // poller at the head of the fair dispatch queue picked up the task
+		r := <-request.C
+		return r.response, r.err
 	default: // no poller waiting for tasks
 		rsv.Cancel()
 		return nil, nil
 	}
 }
 
+// syncMatchDispatchDeadline returns the time by which a poller has to be handed the task for it to
+// be started before its schedule to start timeout, false if the task has no such deadline
+func syncMatchDispatchDeadline(task *persistence.TaskInfo) (time.Time, bool) {
+	if task.ScheduleToStartTimeout <= 0 || task.ScheduledTime.IsZero() {
+		return time.Time{}, false
+	}
+	return task.ScheduledTime.Add(time.Duration(task.ScheduleToStartTimeout) * time.Second), true
+}
+
 // Retry operation on transient error. On rangeID update by another process calls c.Stop().
 func (c *taskListManagerImpl) executeWithRetry(
+	ctx context.Context,
 	operation func() (interface{}, error)) (result interface{}, err error) {
 
 	op := func() error {
@@ -611,7 +739,7 @@ func (c *taskListManagerImpl) executeWithRetry(
 	}
 
 	var retryCount int64
-	err = backoff.Retry(op, persistenceOperationRetryPolicy, func(err error) bool {
+	err = backoff.RetryContext(ctx, op, persistenceOperationRetryPolicy, func(err error) bool {
 		c.logger.Debug(fmt.Sprintf("Retry executeWithRetry as task list range has changed. retryCount=%v, errType=%T", retryCount, err))
 		if _, ok := err.(*persistence.ConditionFailedError); ok {
 			return false
@@ -642,7 +770,7 @@ func (c *taskContext) RecordDecisionTaskStartedWithRetry(ctx context.Context,
 		resp, err = c.tlMgr.engine.historyService.RecordDecisionTaskStarted(ctx, request)
 		return err
 	}
-	err = backoff.Retry(op, historyServiceOperationRetryPolicy, func(err error) bool {
+	err = backoff.RetryContext(ctx, op, historyServiceOperationRetryPolicy, func(err error) bool {
 		switch err.(type) {
 		case *s.EntityNotExistsError, *h.EventAlreadyStartedError:
 			return false
@@ -659,7 +787,7 @@ func (c *taskContext) RecordActivityTaskStartedWithRetry(ctx context.Context,
 		resp, err = c.tlMgr.engine.historyService.RecordActivityTaskStarted(ctx, request)
 		return err
 	}
-	err = backoff.Retry(op, historyServiceOperationRetryPolicy, func(err error) bool {
+	err = backoff.RetryContext(ctx, op, historyServiceOperationRetryPolicy, func(err error) bool {
 		switch err.(type) {
 		case *s.EntityNotExistsError, *h.EventAlreadyStartedError:
 			return false
@@ -690,7 +818,7 @@ func (c *taskContext) completeTask(err error) {
 		// again the underlying reason for failing to start will be resolved.
 		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
 		// re-written to persistence frequently.
-		_, err = tlMgr.executeWithRetry(func() (interface{}, error) {
+		_, err = tlMgr.executeWithRetry(tlMgr.cancelCtx, func() (interface{}, error) {
 			return tlMgr.taskWriter.appendTask(&c.workflowExecution, c.info)
 		})
 
@@ -21,6 +21,7 @@
 package matching
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -117,6 +118,136 @@ func TestIsTaskAddedRecently(t *testing.T) {
 	require.False(t, tlm.isTaskAddedRecently(time.Time{}))
 }
 
+func TestGetTasksBatchSize(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(100)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	require.Equal(t, 100, tlm.getTasksBatchSize())
+
+	cfg.GetTasksBatchSizePerPoller = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(30)
+	tlm = createTestTaskListManagerWithConfig(cfg)
+	require.Equal(t, 30, tlm.getTasksBatchSize())
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("poller1"), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("poller2"), nil)
+	require.Equal(t, 60, tlm.getTasksBatchSize())
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("poller3"), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("poller4"), nil)
+	require.Equal(t, 100, tlm.getTasksBatchSize())
+}
+
+func TestGetTask_FairPollerDispatch(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.EnableFairPollerDispatch = dynamicconfig.GetBoolPropertyFnFilteredByTaskListInfo(true)
+	cfg.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Second)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+
+	waitForPollers := func(count int) {
+		for i := 0; i < 100; i++ {
+			tlm.pollerQueue.Lock()
+			waiting := tlm.pollerQueue.waiting.Len()
+			tlm.pollerQueue.Unlock()
+			if waiting == count {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("expected %v waiting pollers", count)
+	}
+
+	received := make(chan string, 3)
+	poll := func(poller string) {
+		result, err := tlm.getTask(context.Background(), nil)
+		if err == nil {
+			received <- poller + ":" + result.task.WorkflowID
+		}
+	}
+	// pollers arrive one after the other
+	for i, poller := range []string{"poller1", "poller2", "poller3"} {
+		go poll(poller)
+		waitForPollers(i + 1)
+	}
+
+	for _, expected := range []string{"poller1:wf1", "poller2:wf2", "poller3:wf3"} {
+		workflowID := expected[len(expected)-3:]
+		require.True(t, tlm.dispatchTask(&getTaskResult{task: &persistence.TaskInfo{WorkflowID: workflowID}}, nil))
+		require.Equal(t, expected, <-received)
+	}
+	waitForPollers(0)
+}
+
+func TestDispatchTask_FairPollerDispatchHeadLeaves(t *testing.T) {
+	tlm := createTestTaskListManager()
+
+	// a poller that gave up waiting hands the head of the queue to the next one
+	first := tlm.pollerQueue.enqueue()
+	second := tlm.pollerQueue.enqueue()
+	dispatched := make(chan bool, 1)
+	go func() {
+		dispatched <- tlm.dispatchTask(&getTaskResult{task: &persistence.TaskInfo{WorkflowID: "wf1"}}, nil)
+	}()
+	time.Sleep(10 * time.Millisecond) // let the dispatcher block on the head of the queue
+	tlm.pollerQueue.leave(first)
+	result := <-second.tasks
+	require.Equal(t, "wf1", result.task.WorkflowID)
+	require.True(t, <-dispatched)
+	tlm.pollerQueue.leave(second)
+
+	// no poller waiting, the dispatch gives up on shutdown
+	shutdownCh := make(chan struct{})
+	close(shutdownCh)
+	require.False(t, tlm.dispatchTask(&getTaskResult{task: &persistence.TaskInfo{WorkflowID: "wf2"}}, shutdownCh))
+}
+
+func TestSyncMatchDispatchDeadline(t *testing.T) {
+	// the deadline counts from when history scheduled the task, not from when matching received it
+	scheduledTime := time.Now().Add(-2 * time.Second)
+	deadline, ok := syncMatchDispatchDeadline(&persistence.TaskInfo{
+		ScheduleToStartTimeout: 5, ScheduledTime: scheduledTime, CreatedTime: time.Now()})
+	require.True(t, ok)
+	require.Equal(t, scheduledTime.Add(5*time.Second), deadline)
+
+	_, ok = syncMatchDispatchDeadline(&persistence.TaskInfo{ScheduleToStartTimeout: 0, ScheduledTime: scheduledTime})
+	require.False(t, ok)
+	_, ok = syncMatchDispatchDeadline(&persistence.TaskInfo{ScheduleToStartTimeout: 5, CreatedTime: time.Now()})
+	require.False(t, ok)
+}
+
+func TestTrySyncMatch_DispatchDeadline(t *testing.T) {
+	tlm := createTestTaskListManager()
+
+	var matched int32
+	pollerDone := make(chan struct{})
+	go func() {
+		defer close(pollerDone)
+		timer := time.NewTimer(time.Second)
+		defer timer.Stop()
+		for {
+			select {
+			case result := <-tlm.tasksForPoll:
+				atomic.AddInt32(&matched, 1)
+				result.C <- &syncMatchResponse{response: &persistence.CreateTasksResponse{}}
+			case <-timer.C:
+				return
+			}
+		}
+	}()
+	time.Sleep(10 * time.Millisecond) // let the poller block on tasksForPoll
+
+	// task which can no longer be started before its schedule to start deadline goes to the backlog
+	expiring := &persistence.TaskInfo{ScheduleToStartTimeout: 1, ScheduledTime: time.Now().Add(-time.Second), CreatedTime: time.Now()}
+	resp, err := tlm.trySyncMatch(expiring)
+	require.Equal(t, errAddTasklistThrottled, err)
+	require.Nil(t, resp)
+	require.Equal(t, int32(0), atomic.LoadInt32(&matched))
+
+	fresh := &persistence.TaskInfo{ScheduleToStartTimeout: 10, ScheduledTime: time.Now(), CreatedTime: time.Now()}
+	resp, err = tlm.trySyncMatch(fresh)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, int32(1), atomic.LoadInt32(&matched))
+	<-pollerDone
+}
+
 func TestDescribeTaskList(t *testing.T) {
 	startTaskID := int64(1)
 	taskCount := int64(3)
@@ -128,8 +259,9 @@ func TestDescribeTaskList(t *testing.T) {
 	tlm.db.ackLevel = int64(0)
 	tlm.taskAckManager.setAckLevel(tlm.db.ackLevel)
 
+	createdTime := time.Now().Add(-time.Minute)
 	for i := int64(0); i < taskCount; i++ {
-		tlm.taskAckManager.addTask(startTaskID + i)
+		tlm.taskAckManager.addTask(startTaskID+i, createdTime.Add(time.Duration(i)*time.Second))
 	}
 
 	includeTaskStatus := false
@@ -143,6 +275,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Zero(t, taskListStatus.GetAckLevel())
 	require.Equal(t, taskCount, taskListStatus.GetReadLevel())
 	require.Equal(t, taskCount, taskListStatus.GetBacklogCountHint())
+	require.Equal(t, createdTime.UnixNano(), taskListStatus.GetOldestTaskCreatedTimestamp())
 	require.True(t, taskListStatus.GetRatePerSecond() > (_defaultTaskDispatchRPS-1))
 	require.True(t, taskListStatus.GetRatePerSecond() < (_defaultTaskDispatchRPS+1))
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
@@ -172,6 +305,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.NotNil(t, taskListStatus)
 	require.Equal(t, taskCount, taskListStatus.GetAckLevel())
 	require.Zero(t, taskListStatus.GetBacklogCountHint())
+	require.Nil(t, taskListStatus.OldestTaskCreatedTimestamp)
 }
 
 func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
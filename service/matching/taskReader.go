@@ -56,9 +56,7 @@ deliverBufferTasksLoop:
 			if !ok { // Task list getTasks pump is shutdown
 				break deliverBufferTasksLoop
 			}
-			select {
-			case c.tasksForPoll <- &getTaskResult{task: task}:
-			case <-c.deliverBufferShutdownCh:
+			if !c.dispatchTask(&getTaskResult{task: task}, c.deliverBufferShutdownCh) {
 				break deliverBufferTasksLoop
 			}
 		case <-c.deliverBufferShutdownCh:
@@ -120,6 +118,7 @@ getTasksPumpLoop:
 					}
 					// keep going as saving ack is not critical
 				}
+				c.emitBacklogAge()
 				c.signalNewTask() // periodically signal pump to check persistence for tasks
 				updateAckTimer = time.NewTimer(c.config.UpdateAckInterval())
 			}
@@ -139,8 +138,8 @@ getTasksPumpLoop:
 }
 
 func (c *taskListManagerImpl) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
-	response, err := c.executeWithRetry(func() (interface{}, error) {
-		return c.db.GetTasks(readLevel, maxReadLevel, c.config.GetTasksBatchSize())
+	response, err := c.executeWithRetry(c.cancelCtx, func() (interface{}, error) {
+		return c.db.GetTasks(c.cancelCtx, readLevel, maxReadLevel, c.getTasksBatchSize())
 	})
 	if err != nil {
 		return nil, err
@@ -148,6 +147,24 @@ func (c *taskListManagerImpl) getTaskBatchWithRange(readLevel int64, maxReadLeve
 	return response.(*persistence.GetTasksResponse).Tasks, err
 }
 
+// getTasksBatchSize scales the number of tasks read at once with the number of recent pollers when a per poller
+// batch size is configured, so task lists with few pollers do not read more than they can dispatch
+func (c *taskListManagerImpl) getTasksBatchSize() int {
+	batchSize := c.config.GetTasksBatchSize()
+	perPoller := c.config.GetTasksBatchSizePerPoller()
+	if perPoller <= 0 {
+		return batchSize
+	}
+	pollers := len(c.GetAllPollerInfo())
+	if pollers < 1 {
+		pollers = 1
+	}
+	if adaptive := pollers * perPoller; adaptive < batchSize {
+		return adaptive
+	}
+	return batchSize
+}
+
 // Returns a batch of tasks from persistence starting form current read level.
 // Also return a number that can be used to update readLevel
 // Also return a bool to indicate whether read is finished
@@ -179,13 +196,23 @@ func (c *taskListManagerImpl) isTaskExpired(t *persistence.TaskInfo, now time.Ti
 	return t.Expiry.After(epochStartTime) && time.Now().After(t.Expiry)
 }
 
+// emitBacklogAge records how long the oldest non acked task of the task list has been waiting,
+// which keeps growing when the task list is not drained, unlike the backlog count.
+func (c *taskListManagerImpl) emitBacklogAge() {
+	createdTime := c.taskAckManager.getOldestTaskCreatedTime()
+	if !createdTime.After(epochStartTime) {
+		return
+	}
+	c.domainScope.RecordTimer(metrics.TaskBacklogAgeTimer, time.Since(createdTime))
+}
+
 func (c *taskListManagerImpl) isIdle(lastWriteTime time.Time) bool {
 	return !c.isTaskAddedRecently(lastWriteTime) && len(c.GetAllPollerInfo()) == 0
 }
 
 func (c *taskListManagerImpl) handleIdleTimeout() {
 	c.persistAckLevel()
-	c.taskGC.RunNow(c.taskAckManager.getAckLevel())
+	c.taskGC.RunNow(c.cancelCtx, c.taskAckManager.getAckLevel())
 	c.Stop()
 }
 
@@ -197,7 +224,7 @@ func (c *taskListManagerImpl) addTasksToBuffer(
 			c.domainScope.IncCounter(metrics.ExpiredTasksCounter)
 			continue
 		}
-		c.taskAckManager.addTask(t.TaskID)
+		c.taskAckManager.addTask(t.TaskID, t.CreatedTime)
 		select {
 		case c.taskBuffer <- t:
 			return true
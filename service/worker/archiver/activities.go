@@ -211,9 +211,9 @@ func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 }
 
 func getBlob(ctx context.Context, historyBlobReader HistoryBlobReader, blobPage int) (*HistoryBlob, error) {
-	blob, err := historyBlobReader.GetBlob(blobPage)
+	blob, err := historyBlobReader.GetBlob(ctx, blobPage)
 	op := func() error {
-		blob, err = historyBlobReader.GetBlob(blobPage)
+		blob, err = historyBlobReader.GetBlob(ctx, blobPage)
 		return err
 	}
 	for err != nil {
@@ -351,12 +351,12 @@ func deleteHistoryV1(ctx context.Context, container *BootstrapContainer, request
 }
 
 func deleteHistoryV2(ctx context.Context, container *BootstrapContainer, request ArchiveRequest) error {
-	err := persistence.DeleteWorkflowExecutionHistoryV2(container.HistoryV2Manager, request.BranchToken, common.IntPtr(request.ShardID), container.Logger)
+	err := persistence.DeleteWorkflowExecutionHistoryV2(ctx, container.HistoryV2Manager, request.BranchToken, common.IntPtr(request.ShardID), container.Logger)
 	if err == nil {
 		return nil
 	}
 	op := func() error {
-		return persistence.DeleteWorkflowExecutionHistoryV2(container.HistoryV2Manager, request.BranchToken, common.IntPtr(request.ShardID), container.Logger)
+		return persistence.DeleteWorkflowExecutionHistoryV2(ctx, container.HistoryV2Manager, request.BranchToken, common.IntPtr(request.ShardID), container.Logger)
 	}
 	for err != nil {
 		if !common.IsPersistenceTransientError(err) {
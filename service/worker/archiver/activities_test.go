@@ -355,7 +355,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Fail_ReadBlobNonRetryableErr
 	mockBlobstore := &mocks.BlobstoreClient{}
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return(nil, blobstore.ErrBlobNotExists).Once()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(nil, errPersistenceNonRetryable)
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(nil, errPersistenceNonRetryable)
 	container := &BootstrapContainer{
 		Logger:            s.logger,
 		MetricsClient:     s.metricsClient,
@@ -386,7 +386,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Fail_ReadBlobTimeout() {
 	mockBlobstore := &mocks.BlobstoreClient{}
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return(nil, blobstore.ErrBlobNotExists).Once()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(nil, errPersistenceRetryable)
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(nil, errPersistenceRetryable)
 	container := &BootstrapContainer{
 		Logger:            s.logger,
 		MetricsClient:     s.metricsClient,
@@ -420,7 +420,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Fail_CouldNotRunCheck() {
 	mockBlobstore.On("Download", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("some error"))
 	mockBlobstore.On("IsRetryableError", mock.Anything).Return(false)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{},
 	}, nil)
 	container := &BootstrapContainer{
@@ -456,7 +456,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Fail_CheckFailed() {
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{"is_last": "true"}, nil)
 	mockBlobstore.On("Download", mock.Anything, mock.Anything, mock.Anything).Return(&blob.Blob{Body: []byte{1, 2, 3, 4}}, nil)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{},
 	}, nil)
 	container := &BootstrapContainer{
@@ -492,7 +492,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Fail_UploadBlobNonRetryableE
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some error"))
 	mockBlobstore.On("IsRetryableError", mock.Anything).Return(false)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{},
 	}, nil)
 	container := &BootstrapContainer{
@@ -528,7 +528,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Fail_UploadBlobTimeout() {
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some error"))
 	mockBlobstore.On("IsRetryableError", mock.Anything).Return(true)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{},
 	}, nil)
 	container := &BootstrapContainer{
@@ -562,7 +562,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Success_BlobDoesNotAlreadyEx
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return(nil, blobstore.ErrBlobNotExists).Once()
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", mock.Anything).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{
 			IsLast: common.BoolPtr(true),
 		},
@@ -604,7 +604,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Success_ConcurrentUploads()
 	mockBlobstore.On("GetTags", mock.Anything, mock.Anything, secondKey).Return(nil, blobstore.ErrBlobNotExists).Once()
 	mockBlobstore.On("Upload", mock.Anything, mock.Anything, secondKey, mock.Anything).Return(nil).Once()
 	mockHistoryBlobReader := &HistoryBlobReaderMock{}
-	mockHistoryBlobReader.On("GetBlob", common.FirstBlobPageToken+1).Return(&HistoryBlob{
+	mockHistoryBlobReader.On("GetBlob", mock.Anything, common.FirstBlobPageToken+1).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{
 			IsLast: common.BoolPtr(true),
 		},
@@ -637,7 +637,7 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Success_ConcurrentUploads()
 func (s *activitiesSuite) TestDeleteHistoryActivity_Fail_DeleteFromV2NonRetryableError() {
 	s.metricsClient.On("IncCounter", metrics.ArchiverDeleteHistoryActivityScope, metrics.ArchiverNonRetryableErrorCount).Once()
 	mockHistoryV2Manager := &mocks.HistoryV2Manager{}
-	mockHistoryV2Manager.On("DeleteHistoryBranch", mock.Anything).Return(errPersistenceNonRetryable)
+	mockHistoryV2Manager.On("DeleteHistoryBranch", mock.Anything, mock.Anything).Return(errPersistenceNonRetryable)
 	container := &BootstrapContainer{
 		Logger:           s.logger,
 		MetricsClient:    s.metricsClient,
@@ -663,7 +663,7 @@ func (s *activitiesSuite) TestDeleteHistoryActivity_Fail_DeleteFromV2NonRetryabl
 func (s *activitiesSuite) TestDeleteHistoryActivity_Fail_TimeoutOnDeleteHistoryV2() {
 	s.metricsClient.On("IncCounter", metrics.ArchiverDeleteHistoryActivityScope, metrics.CadenceErrContextTimeoutCounter).Once()
 	mockHistoryV2Manager := &mocks.HistoryV2Manager{}
-	mockHistoryV2Manager.On("DeleteHistoryBranch", mock.Anything).Return(errPersistenceRetryable)
+	mockHistoryV2Manager.On("DeleteHistoryBranch", mock.Anything, mock.Anything).Return(errPersistenceRetryable)
 	container := &BootstrapContainer{
 		Logger:           s.logger,
 		MetricsClient:    s.metricsClient,
@@ -21,6 +21,7 @@
 package archiver
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -38,7 +39,7 @@ makes any concurrent uploads of history safe.
 type (
 	// HistoryBlobIterator is used to get history blobs
 	HistoryBlobIterator interface {
-		Next() (*HistoryBlob, error)
+		Next(ctx context.Context) (*HistoryBlob, error)
 		HasNext() bool
 	}
 
@@ -100,11 +101,11 @@ func NewHistoryBlobIterator(
 // Next returns historyBlob and advances iterator.
 // Returns error if iterator is empty, or if history could not be read.
 // If error is returned then no iterator is state is advanced.
-func (i *historyBlobIterator) Next() (*HistoryBlob, error) {
+func (i *historyBlobIterator) Next(ctx context.Context) (*HistoryBlob, error) {
 	if !i.HasNext() {
 		return nil, errIteratorDepleted
 	}
-	events, nextPersistencePageToken, historyEndReached, err := i.readBlobEvents(i.persistencePageToken)
+	events, nextPersistencePageToken, historyEndReached, err := i.readBlobEvents(ctx, i.persistencePageToken)
 	if err != nil {
 		return nil, err
 	}
@@ -160,14 +161,14 @@ func (i *historyBlobIterator) HasNext() bool {
 // 2. NextPageToken: The page token that should be used to fetch the next chunk of history
 // 3. HistoryEndReached: True if fetched all history beyond page starting from given pageToken
 // 4. Error: Any error that occurred while reading history
-func (i *historyBlobIterator) readBlobEvents(pageToken []byte) ([]*shared.HistoryEvent, []byte, bool, error) {
-	historyEvents, size, nextPageToken, err := i.readHistory(pageToken)
+func (i *historyBlobIterator) readBlobEvents(ctx context.Context, pageToken []byte) ([]*shared.HistoryEvent, []byte, bool, error) {
+	historyEvents, size, nextPageToken, err := i.readHistory(ctx, pageToken)
 	if err != nil {
 		return nil, nil, false, err
 	}
 	// Exceeding target blob size is fine because blob will be compressed anyways (just generally want to avoid creating very small blobs).
 	for len(nextPageToken) > 0 && size < i.config.TargetArchivalBlobSize(i.domain) {
-		currHistoryEvents, currSize, currNextPageToken, err := i.readHistory(nextPageToken)
+		currHistoryEvents, currSize, currNextPageToken, err := i.readHistory(ctx, nextPageToken)
 		if err != nil {
 			return nil, nil, false, err
 		}
@@ -181,7 +182,7 @@ func (i *historyBlobIterator) readBlobEvents(pageToken []byte) ([]*shared.Histor
 // readHistory fetches a single page of history events identified by given pageToken.
 // Does not modify any iterator state (i.e. calls to readHistory are idempotent).
 // Returns historyEvents, size, nextPageToken and error.
-func (i *historyBlobIterator) readHistory(pageToken []byte) ([]*shared.HistoryEvent, int, []byte, error) {
+func (i *historyBlobIterator) readHistory(ctx context.Context, pageToken []byte) ([]*shared.HistoryEvent, int, []byte, error) {
 	if i.eventStoreVersion == persistence.EventStoreVersionV2 {
 		req := &persistence.ReadHistoryBranchRequest{
 			BranchToken:   i.branchToken,
@@ -191,7 +192,7 @@ func (i *historyBlobIterator) readHistory(pageToken []byte) ([]*shared.HistoryEv
 			NextPageToken: pageToken,
 			ShardID:       common.IntPtr(i.shardID),
 		}
-		return persistence.ReadFullPageV2Events(i.historyV2Manager, req)
+		return persistence.ReadFullPageV2Events(ctx, i.historyV2Manager, req)
 	}
 	req := &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID: i.domainID,
@@ -22,7 +22,11 @@
 
 package archiver
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // HistoryBlobIteratorMock is an autogenerated mock type for the HistoryBlobIterator type
 type HistoryBlobIteratorMock struct {
@@ -43,13 +47,13 @@ func (_m *HistoryBlobIteratorMock) HasNext() bool {
 	return r0
 }
 
-// Next provides a mock function with given fields:
-func (_m *HistoryBlobIteratorMock) Next() (*HistoryBlob, error) {
-	ret := _m.Called()
+// Next provides a mock function with given fields: ctx
+func (_m *HistoryBlobIteratorMock) Next(ctx context.Context) (*HistoryBlob, error) {
+	ret := _m.Called(ctx)
 
 	var r0 *HistoryBlob
-	if rf, ok := ret.Get(0).(func() *HistoryBlob); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *HistoryBlob); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*HistoryBlob)
@@ -57,8 +61,8 @@ func (_m *HistoryBlobIteratorMock) Next() (*HistoryBlob, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -21,6 +21,7 @@
 package archiver
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/stretchr/testify/mock"
@@ -81,9 +82,9 @@ func (s *HistoryBlobIteratorSuite) SetupTest() {
 
 func (s *HistoryBlobIteratorSuite) TestReadHistory_Failed_EventsV2() {
 	mockHistoryV2Manager := &mocks.HistoryV2Manager{}
-	mockHistoryV2Manager.On("ReadHistoryBranch", mock.Anything).Return(nil, errors.New("got error reading history branch"))
+	mockHistoryV2Manager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(nil, errors.New("got error reading history branch"))
 	itr := s.constructTestHistoryBlobIterator(nil, mockHistoryV2Manager, nil)
-	events, size, nextPageToken, err := itr.readHistory([]byte{})
+	events, size, nextPageToken, err := itr.readHistory(context.Background(), []byte{})
 	s.Error(err)
 	s.Nil(events)
 	s.Zero(size)
@@ -97,9 +98,9 @@ func (s *HistoryBlobIteratorSuite) TestReadHistory_Success_EventsV2() {
 		NextPageToken: []byte{},
 		Size:          100,
 	}
-	mockHistoryV2Manager.On("ReadHistoryBranch", mock.Anything).Return(&resp, nil)
+	mockHistoryV2Manager.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&resp, nil)
 	itr := s.constructTestHistoryBlobIterator(nil, mockHistoryV2Manager, nil)
-	events, size, nextPageToken, err := itr.readHistory([]byte{})
+	events, size, nextPageToken, err := itr.readHistory(context.Background(), []byte{})
 	s.NoError(err)
 	s.NotNil(events)
 	s.Equal(100, size)
@@ -110,7 +111,7 @@ func (s *HistoryBlobIteratorSuite) TestReadHistory_Failed_EventsV1() {
 	mockHistoryManager := &mocks.HistoryManager{}
 	mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(nil, errors.New("error getting workflow execution history"))
 	itr := s.constructTestHistoryBlobIterator(mockHistoryManager, nil, nil)
-	events, size, nextPageToken, err := itr.readHistory([]byte{})
+	events, size, nextPageToken, err := itr.readHistory(context.Background(), []byte{})
 	s.Error(err)
 	s.Nil(events)
 	s.Zero(size)
@@ -127,7 +128,7 @@ func (s *HistoryBlobIteratorSuite) TestReadHistory_Success_EventsV1() {
 	}
 	mockHistoryManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(&resp, nil)
 	itr := s.constructTestHistoryBlobIterator(mockHistoryManager, nil, nil)
-	events, size, nextPageToken, err := itr.readHistory([]byte{})
+	events, size, nextPageToken, err := itr.readHistory(context.Background(), []byte{})
 	s.NotNil(events)
 	s.Equal(100, size)
 	s.Empty(nextPageToken)
@@ -147,7 +148,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Fail_FirstCallToReadHistor
 	historyManager, pageTokens := s.constructMockHistoryManager(0, pages...)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(pageTokens[0])
+	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(context.Background(), pageTokens[0])
 	s.Nil(events)
 	s.Nil(nextPageToken)
 	s.False(historyEndReached)
@@ -175,7 +176,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Fail_NonFirstCallToReadHis
 	historyManager, pageTokens := s.constructMockHistoryManager(1, pages...)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, nil)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(pageTokens[0])
+	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(context.Background(), pageTokens[0])
 	s.Nil(events)
 	s.Nil(nextPageToken)
 	s.False(historyEndReached)
@@ -212,7 +213,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_ReadToHistoryEnd()
 	config := constructConfig(testDefaultPersistencePageSize, 1000)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(pageTokens[0])
+	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(context.Background(), pageTokens[0])
 	s.NotNil(events)
 	s.Len(events, 75)
 	s.Len(nextPageToken, 0)
@@ -250,7 +251,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_TargetSizeSatisfie
 	config := constructConfig(testDefaultPersistencePageSize, 250)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(pageTokens[0])
+	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(context.Background(), pageTokens[0])
 	s.NotNil(events)
 	s.Len(events, 25)
 	s.NotEmpty(nextPageToken)
@@ -289,7 +290,7 @@ func (s *HistoryBlobIteratorSuite) TestReadBlobEvents_Success_TargetSizeSatisfie
 	config := constructConfig(testDefaultPersistencePageSize, 301)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config)
 	startingIteratorState := s.copyIteratorState(itr)
-	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(pageTokens[0])
+	events, nextPageToken, historyEndReached, err := itr.readBlobEvents(context.Background(), pageTokens[0])
 	s.NotNil(events)
 	s.Len(events, 75)
 	s.Len(nextPageToken, 0)
@@ -327,7 +328,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Fail_IteratorDepleted() {
 	config := constructConfig(testDefaultPersistencePageSize, 301)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config)
 	startingIteratorState := s.copyIteratorState(itr)
-	blob, err := itr.Next()
+	blob, err := itr.Next(context.Background())
 	expectedIteratorState := iteratorState{
 		// when iteration is finished page token is not advanced
 		blobPageToken:        startingIteratorState.blobPageToken,
@@ -346,7 +347,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Fail_IteratorDepleted() {
 	s.NoError(err)
 	s.False(itr.HasNext())
 
-	blob, err = itr.Next()
+	blob, err = itr.Next(context.Background())
 	s.Error(err)
 	s.Nil(blob)
 	s.assertStateMatches(expectedIteratorState, itr)
@@ -381,7 +382,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Fail_ReturnErrOnSecondCallToNext() {
 	config := constructConfig(testDefaultPersistencePageSize, 250)
 	itr := s.constructTestHistoryBlobIterator(historyManager, nil, config)
 	startingIteratorState := s.copyIteratorState(itr)
-	blob, err := itr.Next()
+	blob, err := itr.Next(context.Background())
 	expectedIteratorState := iteratorState{
 		blobPageToken:        startingIteratorState.blobPageToken + 1,
 		persistencePageToken: pageTokens[2],
@@ -398,7 +399,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Fail_ReturnErrOnSecondCallToNext() {
 	s.NoError(err)
 	s.True(itr.HasNext())
 
-	blob, err = itr.Next()
+	blob, err = itr.Next(context.Background())
 	s.Error(err)
 	s.Nil(blob)
 	s.assertStateMatches(expectedIteratorState, itr)
@@ -428,7 +429,7 @@ func (s *HistoryBlobIteratorSuite) TestNext_Success_TenCallsToNext() {
 	for i := 0; i < 10; i++ {
 		s.assertStateMatches(expectedIteratorState, itr)
 		s.True(itr.HasNext())
-		blob, err := itr.Next()
+		blob, err := itr.Next(context.Background())
 		s.NoError(err)
 		s.NotNil(blob)
 		s.Equal(common.FirstEventID+int64(i*100), *blob.Header.FirstEventID)
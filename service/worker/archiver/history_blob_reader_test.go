@@ -21,6 +21,8 @@
 package archiver
 
 import (
+	"context"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber/cadence/common"
@@ -49,7 +51,7 @@ func (s *HistoryBlobReaderSuite) TestGetBlob_AlreadyInCache() {
 			},
 		}},
 	}
-	hb, err := reader.GetBlob(1)
+	hb, err := reader.GetBlob(context.Background(), 1)
 	s.NoError(err)
 	s.Equal("test-domain-name", *hb.Header.DomainName)
 }
@@ -57,7 +59,7 @@ func (s *HistoryBlobReaderSuite) TestGetBlob_AlreadyInCache() {
 func (s *HistoryBlobReaderSuite) TestGetBlob_SuccessfulAddToCache() {
 	mockIterator := &HistoryBlobIteratorMock{}
 	mockIterator.On("HasNext").Return(true)
-	mockIterator.On("Next").Return(&HistoryBlob{
+	mockIterator.On("Next", mock.Anything).Return(&HistoryBlob{
 		Header: &HistoryBlobHeader{
 			DomainName:       common.StringPtr("test-domain-name"),
 			CurrentPageToken: common.IntPtr(5),
@@ -67,7 +69,7 @@ func (s *HistoryBlobReaderSuite) TestGetBlob_SuccessfulAddToCache() {
 		itr:   mockIterator,
 		cache: make(map[int]*HistoryBlob),
 	}
-	hb, err := reader.GetBlob(5)
+	hb, err := reader.GetBlob(context.Background(), 5)
 	s.NoError(err)
 	s.Equal("test-domain-name", *hb.Header.DomainName)
 }
@@ -79,7 +81,7 @@ func (s *HistoryBlobReaderSuite) TestGetBlob_OutOfBounds() {
 		itr:   mockIterator,
 		cache: make(map[int]*HistoryBlob),
 	}
-	hb, err := reader.GetBlob(1)
+	hb, err := reader.GetBlob(context.Background(), 1)
 	s.Equal(errPageTokenOutOfBounds, err)
 	s.Nil(hb)
 }
@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the batcher sub-system
+	BootstrapParams struct {
+		// SDKClient is an instance of cadence sdk client, used to poll for the batch workflows
+		SDKClient workflowserviceclient.Interface
+		// FrontendClient is the client the batch operations are applied with
+		FrontendClient frontend.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+	}
+
+	// batcherContext is the context object that get's
+	// passed around within the batch activities
+	batcherContext struct {
+		frontendClient frontend.Client
+		metricsClient  metrics.Client
+		logger         log.Logger
+	}
+
+	// Batcher is the background sub-system that runs batch jobs, each job applies an
+	// operation (terminate, cancel or signal) to all open workflow executions selected
+	// by a visibility query
+	Batcher struct {
+		sdkClient  workflowserviceclient.Interface
+		tallyScope tally.Scope
+		zapLogger  *zap.Logger
+		context    batcherContext
+	}
+)
+
+// New returns a new instance of batcher daemon
+func New(params *BootstrapParams) *Batcher {
+	zapLogger, err := zap.NewProduction()
+	if err != nil {
+		params.Logger.Fatal("failed to initialize zap logger", tag.Error(err))
+	}
+	return &Batcher{
+		sdkClient:  params.SDKClient,
+		tallyScope: params.TallyScope,
+		zapLogger:  zapLogger,
+		context: batcherContext{
+			frontendClient: params.FrontendClient,
+			metricsClient:  params.MetricsClient,
+			logger:         params.Logger,
+		},
+	}
+}
+
+// Start starts the batcher worker, batch jobs are started on demand, e.g. by the CLI
+func (b *Batcher) Start() error {
+	workerOpts := worker.Options{
+		Logger:                                 b.zapLogger,
+		MetricsScope:                           b.tallyScope,
+		MaxConcurrentActivityExecutionSize:     maxConcurrentActivityExecutionSize,
+		MaxConcurrentDecisionTaskExecutionSize: maxConcurrentDecisionTaskExecutionSize,
+		BackgroundActivityContext:              context.WithValue(context.Background(), batcherContextKey, b.context),
+	}
+	worker := worker.New(b.sdkClient, common.SystemDomainName, BatcherTaskListName, workerOpts)
+	return worker.Start()
+}
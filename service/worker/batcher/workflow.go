@@ -0,0 +1,418 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/tokenbucket"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+)
+
+type contextKey int
+
+const (
+	batcherContextKey = contextKey(0)
+
+	maxConcurrentActivityExecutionSize     = 10
+	maxConcurrentDecisionTaskExecutionSize = 10
+	infiniteDuration                       = 20 * 365 * 24 * time.Hour
+
+	// BatcherTaskListName is the task list the batch jobs are processed on
+	BatcherTaskListName = "cadence-sys-batcher-tasklist"
+	// BatchWFTypeName is the workflow type of batch jobs
+	BatchWFTypeName   = "cadence-sys-batch-workflow"
+	batchActivityName = "cadence-sys-batch-activity"
+
+	batcherIdentity = "cadence-sys-batcher"
+	// reason of the activity failures which are not worth retrying, e.g. an invalid query
+	nonRetryableErrReason = "cadence-sys-batcher-non-retryable-error"
+)
+
+// batch operations
+const (
+	// BatchTypeTerminate terminates the selected executions
+	BatchTypeTerminate = "terminate"
+	// BatchTypeCancel requests cancellation of the selected executions
+	BatchTypeCancel = "cancel"
+	// BatchTypeSignal signals the selected executions
+	BatchTypeSignal = "signal"
+)
+
+const (
+	defaultRPS                      = 50
+	defaultConcurrency              = 5
+	defaultPageSize                 = 1000
+	defaultAttemptsOnRetryableError = 3
+	defaultActivityHeartBeatTimeout = 10 * time.Second
+)
+
+var (
+	batchActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          10 * time.Second,
+		BackoffCoefficient:       1.7,
+		MaximumInterval:          5 * time.Minute,
+		ExpirationInterval:       infiniteDuration,
+		NonRetriableErrorReasons: []string{nonRetryableErrReason},
+	}
+
+	errDomainNotSet          = errors.New("domain is not set")
+	errSystemDomain          = errors.New("batch jobs are not allowed on the system domain")
+	errReasonNotSet          = errors.New("reason is not set")
+	errStartTimeRangeNotSet  = errors.New("start time range of the query is not set")
+	errMultipleQueryFilters  = errors.New("only one of workflow ID or workflow type can be queried")
+	errSignalNameNotSet      = errors.New("signal name is not set")
+	errUnsupportedBatchType  = errors.New("unsupported batch type")
+	errInvalidRateParameters = errors.New("RPS, concurrency and page size must not be negative")
+)
+
+type (
+	// BatchQuery selects the open workflow executions a batch job is applied to
+	BatchQuery struct {
+		// EarliestStartTime and LatestStartTime bound the start time of the executions, both are required
+		EarliestStartTime time.Time
+		LatestStartTime   time.Time
+		// WorkflowID and WorkflowType optionally narrow the query, at most one of them can be set
+		WorkflowID   string
+		WorkflowType string
+	}
+
+	// SignalParams is the signal sent by a signal batch job
+	SignalParams struct {
+		SignalName string
+		Input      string
+	}
+
+	// BatchParams is the input of a batch job
+	BatchParams struct {
+		DomainName string
+		Query      BatchQuery
+		// Reason is recorded on every operation applied by the batch job
+		Reason string
+		// BatchType is one of terminate, cancel or signal
+		BatchType    string
+		SignalParams SignalParams
+
+		// RPS is the maximum rate of operations applied by the batch job
+		RPS int
+		// Concurrency is the number of operations applied in parallel
+		Concurrency int
+		// PageSize is the number of executions read from visibility at once
+		PageSize int
+		// AttemptsOnRetryableError is the number of attempts of an operation failing with a transient error
+		AttemptsOnRetryableError int
+		// ActivityHeartBeatTimeout is the heartbeat timeout of the batch activity
+		ActivityHeartBeatTimeout time.Duration
+	}
+
+	// HeartBeatDetails is the progress of a batch job, it is recorded as the heartbeat of the batch
+	// activity while the job runs and returned as the result of the job once it completes
+	HeartBeatDetails struct {
+		// PageToken is the visibility page token of the next page to process
+		PageToken   []byte
+		CurrentPage int
+		// TotalEstimate is the number of matching executions counted when the job started
+		TotalEstimate int64
+		SuccessCount  int
+		ErrorCount    int
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
+	activity.RegisterWithOptions(BatchActivity, activity.RegisterOptions{Name: batchActivityName})
+}
+
+// BatchWorkflow is the workflow of a batch job, it can be described and stopped like any other workflow
+func BatchWorkflow(ctx workflow.Context, params BatchParams) (HeartBeatDetails, error) {
+	params = setDefaultParams(params)
+	if err := validateParams(params); err != nil {
+		return HeartBeatDetails{}, err
+	}
+
+	opts := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       params.ActivityHeartBeatTimeout,
+		RetryPolicy:            &batchActivityRetryPolicy,
+	}
+	var result HeartBeatDetails
+	err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), batchActivityName, params).Get(ctx, &result)
+	return result, err
+}
+
+// BatchActivity applies the operation of a batch job to the selected executions page by page, a retried
+// activity resumes from the page recorded by its last heartbeat
+func BatchActivity(ctx context.Context, params BatchParams) (HeartBeatDetails, error) {
+	batcher := ctx.Value(batcherContextKey).(batcherContext)
+	logger := batcher.logger.WithTags(
+		tag.WorkflowDomainName(params.DomainName),
+		tag.WorkflowID(activity.GetInfo(ctx).WorkflowExecution.ID))
+
+	hbd := HeartBeatDetails{}
+	startOver := true
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err == nil {
+			startOver = false
+		} else {
+			logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+	if startOver {
+		resp, err := batcher.frontendClient.CountWorkflowExecutions(ctx, &shared.CountWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(params.DomainName),
+			StartTimeFilter: startTimeFilter(params.Query),
+			ExecutionFilter: executionFilter(params.Query),
+			TypeFilter:      typeFilter(params.Query),
+		})
+		if err != nil {
+			return HeartBeatDetails{}, toActivityError(err)
+		}
+		hbd = HeartBeatDetails{TotalEstimate: resp.GetCount()}
+	}
+
+	limiter := tokenbucket.New(params.RPS, clock.NewRealTimeSource())
+	taskCh := make(chan *shared.WorkflowExecution, params.PageSize)
+	respCh := make(chan error, params.PageSize)
+	defer close(taskCh)
+	for i := 0; i < params.Concurrency; i++ {
+		go startTaskProcessor(ctx, batcher, params, limiter, taskCh, respCh)
+	}
+
+	for {
+		resp, err := batcher.frontendClient.ListOpenWorkflowExecutions(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(params.DomainName),
+			MaximumPageSize: common.Int32Ptr(int32(params.PageSize)),
+			NextPageToken:   hbd.PageToken,
+			StartTimeFilter: startTimeFilter(params.Query),
+			ExecutionFilter: executionFilter(params.Query),
+			TypeFilter:      typeFilter(params.Query),
+		})
+		if err != nil {
+			return HeartBeatDetails{}, toActivityError(err)
+		}
+
+		for _, info := range resp.Executions {
+			taskCh <- info.Execution
+		}
+		// the page is only marked as done once every execution of it was processed, a retry of the
+		// activity applies the operation to the current page again
+		progress := hbd
+		for range resp.Executions {
+			select {
+			case err := <-respCh:
+				if err == nil {
+					progress.SuccessCount++
+				} else {
+					progress.ErrorCount++
+				}
+				activity.RecordHeartbeat(ctx, progress)
+			case <-ctx.Done():
+				return HeartBeatDetails{}, ctx.Err()
+			}
+		}
+
+		hbd = progress
+		hbd.CurrentPage++
+		hbd.PageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, hbd)
+		if len(hbd.PageToken) == 0 {
+			break
+		}
+	}
+
+	logger.Info("Batch job completed.",
+		tag.Counter(hbd.SuccessCount),
+		tag.Number(int64(hbd.ErrorCount)))
+	return hbd, nil
+}
+
+func startTaskProcessor(
+	ctx context.Context,
+	batcher batcherContext,
+	params BatchParams,
+	limiter tokenbucket.TokenBucket,
+	taskCh chan *shared.WorkflowExecution,
+	respCh chan error,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case execution, ok := <-taskCh:
+			if !ok {
+				return
+			}
+			err := processTask(ctx, batcher, params, limiter, execution)
+			if err != nil {
+				batcher.logger.Error("Failed to apply batch operation.",
+					tag.WorkflowDomainName(params.DomainName),
+					tag.WorkflowID(execution.GetWorkflowId()),
+					tag.WorkflowRunID(execution.GetRunId()),
+					tag.Error(err))
+			}
+			respCh <- err
+		}
+	}
+}
+
+func processTask(
+	ctx context.Context,
+	batcher batcherContext,
+	params BatchParams,
+	limiter tokenbucket.TokenBucket,
+	execution *shared.WorkflowExecution,
+) error {
+	var err error
+	for attempt := 0; attempt < params.AttemptsOnRetryableError; attempt++ {
+		for !limiter.Consume(1, time.Second) {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+
+		err = applyOperation(ctx, batcher, params, execution)
+		switch err.(type) {
+		case nil:
+			return nil
+		case *shared.EntityNotExistsError, *shared.CancellationAlreadyRequestedError:
+			// the execution closed or was canceled since it was listed, nothing left to do
+			return nil
+		}
+		if !common.IsWhitelistServiceTransientError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func applyOperation(ctx context.Context, batcher batcherContext, params BatchParams, execution *shared.WorkflowExecution) error {
+	switch params.BatchType {
+	case BatchTypeTerminate:
+		return batcher.frontendClient.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{
+			Domain:            common.StringPtr(params.DomainName),
+			WorkflowExecution: execution,
+			Reason:            common.StringPtr(params.Reason),
+			Identity:          common.StringPtr(batcherIdentity),
+		})
+	case BatchTypeCancel:
+		return batcher.frontendClient.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{
+			Domain:            common.StringPtr(params.DomainName),
+			WorkflowExecution: execution,
+			Identity:          common.StringPtr(batcherIdentity),
+		})
+	case BatchTypeSignal:
+		return batcher.frontendClient.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(params.DomainName),
+			WorkflowExecution: execution,
+			SignalName:        common.StringPtr(params.SignalParams.SignalName),
+			Input:             []byte(params.SignalParams.Input),
+			Identity:          common.StringPtr(batcherIdentity),
+		})
+	default:
+		return errUnsupportedBatchType
+	}
+}
+
+func toActivityError(err error) error {
+	if common.IsWhitelistServiceTransientError(err) {
+		return err
+	}
+	return cadence.NewCustomError(nonRetryableErrReason, err.Error())
+}
+
+func startTimeFilter(query BatchQuery) *shared.StartTimeFilter {
+	return &shared.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(query.EarliestStartTime.UnixNano()),
+		LatestTime:   common.Int64Ptr(query.LatestStartTime.UnixNano()),
+	}
+}
+
+func executionFilter(query BatchQuery) *shared.WorkflowExecutionFilter {
+	if query.WorkflowID == "" {
+		return nil
+	}
+	return &shared.WorkflowExecutionFilter{WorkflowId: common.StringPtr(query.WorkflowID)}
+}
+
+func typeFilter(query BatchQuery) *shared.WorkflowTypeFilter {
+	if query.WorkflowType == "" {
+		return nil
+	}
+	return &shared.WorkflowTypeFilter{Name: common.StringPtr(query.WorkflowType)}
+}
+
+func setDefaultParams(params BatchParams) BatchParams {
+	if params.RPS == 0 {
+		params.RPS = defaultRPS
+	}
+	if params.Concurrency == 0 {
+		params.Concurrency = defaultConcurrency
+	}
+	if params.PageSize == 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.AttemptsOnRetryableError <= 0 {
+		params.AttemptsOnRetryableError = defaultAttemptsOnRetryableError
+	}
+	if params.ActivityHeartBeatTimeout <= 0 {
+		params.ActivityHeartBeatTimeout = defaultActivityHeartBeatTimeout
+	}
+	return params
+}
+
+func validateParams(params BatchParams) error {
+	switch {
+	case params.DomainName == "":
+		return errDomainNotSet
+	case params.DomainName == common.SystemDomainName:
+		return errSystemDomain
+	case params.Reason == "":
+		return errReasonNotSet
+	case params.Query.EarliestStartTime.IsZero() || params.Query.LatestStartTime.IsZero():
+		return errStartTimeRangeNotSet
+	case params.Query.WorkflowID != "" && params.Query.WorkflowType != "":
+		return errMultipleQueryFilters
+	case params.RPS < 0 || params.Concurrency < 0 || params.PageSize < 0:
+		return errInvalidRateParameters
+	}
+
+	switch params.BatchType {
+	case BatchTypeTerminate, BatchTypeCancel:
+		return nil
+	case BatchTypeSignal:
+		if params.SignalParams.SignalName == "" {
+			return errSignalNameNotSet
+		}
+		return nil
+	default:
+		return fmt.Errorf("%v: %v", errUnsupportedBatchType, params.BatchType)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+type batcherWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestBatcherWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(batcherWorkflowTestSuite))
+}
+
+func (s *batcherWorkflowTestSuite) TestWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(batchActivityName, mock.Anything, mock.Anything).Return(HeartBeatDetails{SuccessCount: 2}, nil)
+	env.ExecuteWorkflow(BatchWFTypeName, s.newParams())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	var result HeartBeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(2, result.SuccessCount)
+}
+
+func (s *batcherWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	params := s.newParams()
+	params.DomainName = common.SystemDomainName
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(BatchWFTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *batcherWorkflowTestSuite) TestValidateParams() {
+	params := setDefaultParams(s.newParams())
+	s.NoError(validateParams(params))
+
+	params.BatchType = BatchTypeSignal
+	s.Equal(errSignalNameNotSet, validateParams(params))
+	params.SignalParams.SignalName = "signal"
+	s.NoError(validateParams(params))
+
+	params.Query.WorkflowID = "wid"
+	s.Equal(errMultipleQueryFilters, validateParams(params))
+	params.Query.WorkflowID = ""
+
+	params.Query.EarliestStartTime = time.Time{}
+	s.Equal(errStartTimeRangeNotSet, validateParams(params))
+}
+
+func (s *batcherWorkflowTestSuite) TestBatchActivity() {
+	frontendClient := &mocks.FrontendClient{}
+	frontendClient.On("CountWorkflowExecutions", mock.Anything, mock.Anything).
+		Return(&shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(3)}, nil)
+	frontendClient.On("ListOpenWorkflowExecutions", mock.Anything, mock.MatchedBy(func(req *shared.ListOpenWorkflowExecutionsRequest) bool {
+		return len(req.NextPageToken) == 0
+	})).Return(&shared.ListOpenWorkflowExecutionsResponse{
+		Executions:    []*shared.WorkflowExecutionInfo{s.newExecutionInfo("wid1"), s.newExecutionInfo("wid2")},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	frontendClient.On("ListOpenWorkflowExecutions", mock.Anything, mock.Anything).Return(&shared.ListOpenWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{s.newExecutionInfo("wid3")},
+	}, nil).Once()
+	frontendClient.On("TerminateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *shared.TerminateWorkflowExecutionRequest) bool {
+		return req.WorkflowExecution.GetWorkflowId() == "wid2"
+	})).Return(&shared.EntityNotExistsError{})
+	frontendClient.On("TerminateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *shared.TerminateWorkflowExecutionRequest) bool {
+		return req.WorkflowExecution.GetWorkflowId() == "wid3"
+	})).Return(&shared.BadRequestError{})
+	frontendClient.On("TerminateWorkflowExecution", mock.Anything, mock.Anything).Return(nil)
+
+	env := s.NewTestActivityEnvironment()
+	ctx := batcherContext{
+		frontendClient: frontendClient,
+		metricsClient:  metrics.NewClient(tally.NoopScope, metrics.Worker),
+		logger:         loggerimpl.NewLogger(zap.NewNop()),
+	}
+	env.SetTestTimeout(time.Second * 5)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), batcherContextKey, ctx),
+	})
+	val, err := env.ExecuteActivity(batchActivityName, setDefaultParams(s.newParams()))
+	s.NoError(err)
+
+	var result HeartBeatDetails
+	s.NoError(val.Get(&result))
+	s.Equal(int64(3), result.TotalEstimate)
+	s.Equal(2, result.CurrentPage)
+	s.Equal(2, result.SuccessCount)
+	s.Equal(1, result.ErrorCount)
+	s.Empty(result.PageToken)
+	frontendClient.AssertNumberOfCalls(s.T(), "TerminateWorkflowExecution", 3)
+}
+
+func (s *batcherWorkflowTestSuite) newParams() BatchParams {
+	now := time.Now()
+	return BatchParams{
+		DomainName: "test-domain",
+		Query: BatchQuery{
+			EarliestStartTime: now.Add(-time.Hour),
+			LatestStartTime:   now,
+			WorkflowType:      "test-workflow-type",
+		},
+		Reason:    "test-reason",
+		BatchType: BatchTypeTerminate,
+	}
+}
+
+func (s *batcherWorkflowTestSuite) newExecutionInfo(workflowID string) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr("rid"),
+		},
+	}
+}
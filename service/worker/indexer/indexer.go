@@ -34,13 +34,14 @@ import (
 type (
 	// Indexer used to consumer data from kafka then send to ElasticSearch
 	Indexer struct {
-		config              *Config
-		kafkaClient         messaging.Client
-		esClient            es.Client
-		logger              log.Logger
-		metricsClient       metrics.Client
-		visibilityProcessor *indexProcessor
-		visibilityIndexName string
+		config                *Config
+		kafkaClient           messaging.Client
+		esClient              es.Client
+		logger                log.Logger
+		metricsClient         metrics.Client
+		visibilityProcessor   *indexProcessor
+		visibilityIndexName   string
+		visibilityIndexRouter es.IndexRouter
 	}
 
 	// Config contains all configs for indexer
@@ -63,12 +64,13 @@ func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esC
 	logger = logger.WithTags(tag.ComponentIndexer)
 
 	return &Indexer{
-		config:              config,
-		kafkaClient:         client,
-		esClient:            esClient,
-		logger:              logger,
-		metricsClient:       metricsClient,
-		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
+		config:                config,
+		kafkaClient:           client,
+		esClient:              esClient,
+		logger:                logger,
+		metricsClient:         metricsClient,
+		visibilityIndexName:   esConfig.Indices[common.VisibilityAppName],
+		visibilityIndexRouter: es.NewIndexRouter(esConfig.Indices[common.VisibilityAppName], esConfig.DomainIndices),
 	}
 }
 
@@ -77,7 +79,7 @@ func (x Indexer) Start() error {
 	visibilityApp := common.VisibilityAppName
 	visConsumerName := getConsumerName(x.visibilityIndexName)
 	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
-		visibilityProcessorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
+		visibilityProcessorName, x.visibilityIndexRouter, x.config, x.logger, x.metricsClient)
 	return x.visibilityProcessor.Start()
 }
 
@@ -45,7 +45,7 @@ type indexProcessor struct {
 	esClient        es.Client
 	esProcessor     ESProcessor
 	esProcessorName string
-	esIndexName     string
+	esIndexRouter   es.IndexRouter
 	config          *Config
 	logger          log.Logger
 	metricsClient   metrics.Client
@@ -68,14 +68,14 @@ var (
 )
 
 func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esClient es.Client,
-	esProcessorName, esIndexName string, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
+	esProcessorName string, esIndexRouter es.IndexRouter, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
 	return &indexProcessor{
 		appName:         appName,
 		consumerName:    consumerName,
 		kafkaClient:     kafkaClient,
 		esClient:        esClient,
 		esProcessorName: esProcessorName,
-		esIndexName:     esIndexName,
+		esIndexRouter:   esIndexRouter,
 		config:          config,
 		logger:          logger.WithTags(tag.ComponentIndexerProcessor),
 		metricsClient:   metricsClient,
@@ -204,7 +204,7 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
 		doc := p.generateESDoc(indexMsg, keyToKafkaMsg)
 		req = elastic.NewBulkIndexRequest().
-			Index(p.esIndexName).
+			Index(p.esIndexRouter.GetIndexName(indexMsg.GetDomainID())).
 			Type(esDocType).
 			Id(docID).
 			VersionType(versionTypeExternal).
@@ -213,7 +213,7 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 	case indexer.MessageTypeDelete:
 		keyToKafkaMsg = docID
 		req = elastic.NewBulkDeleteRequest().
-			Index(p.esIndexName).
+			Index(p.esIndexRouter.GetIndexName(indexMsg.GetDomainID())).
 			Type(esDocType).
 			Id(docID).
 			VersionType(versionTypeExternal).
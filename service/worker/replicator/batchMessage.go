@@ -0,0 +1,61 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"sync/atomic"
+
+	"github.com/uber/cadence/common/messaging"
+)
+
+type (
+	// batchMessage is the message handed to each task of a replication task batch, the underlying
+	// message is acked once every task of the batch is acked, or nacked as soon as one of them is
+	batchMessage struct {
+		messaging.Message
+		pending int32
+		nacked  int32
+	}
+)
+
+var _ messaging.Message = (*batchMessage)(nil)
+
+func newBatchMessage(msg messaging.Message, numTasks int) *batchMessage {
+	return &batchMessage{
+		Message: msg,
+		pending: int32(numTasks),
+	}
+}
+
+func (m *batchMessage) Ack() error {
+	if atomic.AddInt32(&m.pending, -1) == 0 && atomic.LoadInt32(&m.nacked) == 0 {
+		return m.Message.Ack()
+	}
+	return nil
+}
+
+func (m *batchMessage) Nack() error {
+	// the whole batch is retried, tasks already applied are deduped by the history service
+	if atomic.CompareAndSwapInt32(&m.nacked, 0, 1) {
+		return m.Message.Nack()
+	}
+	return nil
+}
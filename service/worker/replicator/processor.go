@@ -190,6 +190,11 @@ func (p *replicationTaskProcessor) decodeMsgAndSubmit(msg messaging.Message) {
 		return
 	}
 
+	p.submit(replicationTask, msg, logger)
+}
+
+func (p *replicationTaskProcessor) submit(replicationTask *replicator.ReplicationTask, msg messaging.Message, logger log.Logger) {
+	var err error
 SubmitLoop:
 	for {
 		var scope int
@@ -207,6 +212,9 @@ SubmitLoop:
 		case replicator.ReplicationTaskTypeHistory:
 			scope = metrics.HistoryReplicationTaskScope
 			err = p.handleHistoryReplicationTask(replicationTask, msg, logger)
+		case replicator.ReplicationTaskTypeBatch:
+			scope = metrics.BatchReplicationTaskScope
+			err = p.handleBatchReplicationTask(replicationTask, msg, logger)
 		default:
 			logger.Error("Unknown task type.")
 			scope = metrics.ReplicatorScope
@@ -321,6 +329,35 @@ func (p *replicationTaskProcessor) handleHistoryReplicationTask(task *replicator
 	return p.sequentialTaskProcessor.Submit(historyReplicationTask)
 }
 
+func (p *replicationTaskProcessor) handleBatchReplicationTask(task *replicator.ReplicationTask, msg messaging.Message, logger log.Logger) error {
+	p.metricsClient.IncCounter(metrics.BatchReplicationTaskScope, metrics.ReplicatorMessages)
+	if task.BatchTaskAttributes == nil {
+		return ErrEmptyReplicationTask
+	}
+	tasks, err := messaging.UnpackReplicationTaskBatch(task)
+	if err != nil {
+		logger.Error("Failed to unpack replication task batch.", tag.Error(err))
+		return ErrDeserializeReplicationTask
+	}
+	if len(tasks) == 0 {
+		p.ackMsg(msg, logger)
+		return nil
+	}
+
+	for _, batchTask := range tasks {
+		if batchTask.TaskType == nil || batchTask.GetTaskType() == replicator.ReplicationTaskTypeBatch {
+			return ErrUnknownReplicationTask
+		}
+	}
+
+	// the tasks of the batch are submitted in order, the message is acked once all of them are
+	batchMsg := newBatchMessage(msg, len(tasks))
+	for _, batchTask := range tasks {
+		p.submit(batchTask, batchMsg, logger)
+	}
+	return nil
+}
+
 func (p *replicationTaskProcessor) updateFailureMetric(scope int, err error) {
 	// Always update failure counter for all replicator errors
 	p.metricsClient.IncCounter(scope, metrics.ReplicatorFailures)
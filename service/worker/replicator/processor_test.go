@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
 	messageMocks "github.com/uber/cadence/common/messaging/mocks"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
@@ -163,6 +164,55 @@ func (s *replicationTaskProcessorSuite) TestDecodeMsgAndSubmit_Domain_FailedThen
 	s.processor.decodeMsgAndSubmit(s.mockMsg)
 }
 
+func (s *replicationTaskProcessorSuite) TestDecodeMsgAndSubmit_Batch_Success() {
+	replicationAttrs := []*replicator.DomainTaskAttributes{
+		{DomainOperation: replicator.DomainOperationCreate.Ptr(), ID: common.StringPtr("some random domain ID")},
+		{DomainOperation: replicator.DomainOperationUpdate.Ptr(), ID: common.StringPtr("some random domain ID")},
+	}
+	var tasks []*replicator.ReplicationTask
+	for _, replicationAttr := range replicationAttrs {
+		tasks = append(tasks, &replicator.ReplicationTask{
+			TaskType:             replicator.ReplicationTaskTypeDomain.Ptr(),
+			DomainTaskAttributes: replicationAttr,
+		})
+		s.mockDomainReplicator.On("HandleReceivingTask", replicationAttr).Return(nil).Once()
+	}
+	replicationTask, err := messaging.NewReplicationTaskBatch(1, []string{cluster.TestAlternativeClusterName}, tasks)
+	s.Nil(err)
+	replicationTaskBinary, err := s.msgEncoder.Encode(replicationTask)
+	s.Nil(err)
+	s.mockMsg.On("Value").Return(replicationTaskBinary)
+	// acked once for the whole batch
+	s.mockMsg.On("Ack").Return(nil).Once()
+
+	s.processor.decodeMsgAndSubmit(s.mockMsg)
+}
+
+func (s *replicationTaskProcessorSuite) TestDecodeMsgAndSubmit_Batch_Failed() {
+	replicationAttrs := []*replicator.DomainTaskAttributes{
+		{DomainOperation: replicator.DomainOperationCreate.Ptr(), ID: common.StringPtr("some random domain ID")},
+		{DomainOperation: replicator.DomainOperationUpdate.Ptr(), ID: common.StringPtr("some random domain ID")},
+	}
+	var tasks []*replicator.ReplicationTask
+	for _, replicationAttr := range replicationAttrs {
+		tasks = append(tasks, &replicator.ReplicationTask{
+			TaskType:             replicator.ReplicationTaskTypeDomain.Ptr(),
+			DomainTaskAttributes: replicationAttr,
+		})
+	}
+	s.mockDomainReplicator.On("HandleReceivingTask", replicationAttrs[0]).Return(nil).Once()
+	s.mockDomainReplicator.On("HandleReceivingTask", replicationAttrs[1]).Return(&shared.BadRequestError{}).Once()
+	replicationTask, err := messaging.NewReplicationTaskBatch(1, []string{cluster.TestAlternativeClusterName}, tasks)
+	s.Nil(err)
+	replicationTaskBinary, err := s.msgEncoder.Encode(replicationTask)
+	s.Nil(err)
+	s.mockMsg.On("Value").Return(replicationTaskBinary)
+	// the whole batch is retried
+	s.mockMsg.On("Nack").Return(nil).Once()
+
+	s.processor.decodeMsgAndSubmit(s.mockMsg)
+}
+
 func (s *replicationTaskProcessorSuite) TestDecodeMsgAndSubmit_SyncShard_Success() {
 	replicationAttr := &replicator.SyncShardStatusTaskAttributes{
 		SourceCluster: common.StringPtr("some random source cluster"),
@@ -158,7 +158,7 @@ func (s *Scanner) startWorkflow(client cclient.Client) error {
 
 func (s *Scanner) buildContext() error {
 	cfg := &s.context.cfg
-	pFactory := pfactory.New(cfg.Persistence, cfg.ClusterMetadata.GetCurrentClusterName(), s.context.metricsClient, s.context.logger)
+	pFactory := pfactory.New(cfg.Persistence, cfg.ClusterMetadata.GetCurrentClusterName(), s.context.metricsClient, nil, s.context.logger)
 	domainDB, err := pFactory.NewMetadataManager(pfactory.MetadataV1V2)
 	if err != nil {
 		return err
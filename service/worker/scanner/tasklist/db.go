@@ -21,6 +21,7 @@
 package tasklist
 
 import (
+	"context"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
@@ -30,11 +31,14 @@ import (
 
 var retryForeverPolicy = newRetryForeverPolicy()
 
+// the scavenger runs in the background, its requests are shed first by the persistence rate limiter
+var scavengerCallerContext = p.WithCallerType(context.Background(), p.CallerTypeBackground)
+
 func (s *Scavenger) completeTasks(key *taskListKey, taskID int64, limit int) (int, error) {
 	var n int
 	var err error
 	s.retryForever(func() error {
-		n, err = s.db.CompleteTasksLessThan(&p.CompleteTasksLessThanRequest{
+		n, err = s.db.CompleteTasksLessThan(scavengerCallerContext, &p.CompleteTasksLessThanRequest{
 			DomainID:     key.DomainID,
 			TaskListName: key.Name,
 			TaskType:     key.TaskType,
@@ -50,7 +54,7 @@ func (s *Scavenger) getTasks(key *taskListKey, batchSize int) (*p.GetTasksRespon
 	var err error
 	var resp *p.GetTasksResponse
 	s.retryForever(func() error {
-		resp, err = s.db.GetTasks(&p.GetTasksRequest{
+		resp, err = s.db.GetTasks(scavengerCallerContext, &p.GetTasksRequest{
 			DomainID:  key.DomainID,
 			TaskList:  key.Name,
 			TaskType:  key.TaskType,
@@ -66,7 +70,7 @@ func (s *Scavenger) listTaskList(pageSize int, pageToken []byte) (*p.ListTaskLis
 	var err error
 	var resp *p.ListTaskListResponse
 	s.retryForever(func() error {
-		resp, err = s.db.ListTaskList(&p.ListTaskListRequest{
+		resp, err = s.db.ListTaskList(scavengerCallerContext, &p.ListTaskListRequest{
 			PageSize:  pageSize,
 			PageToken: pageToken,
 		})
@@ -78,7 +82,7 @@ func (s *Scavenger) listTaskList(pageSize int, pageToken []byte) (*p.ListTaskLis
 func (s *Scavenger) deleteTaskList(key *taskListKey, rangeID int64) error {
 	// retry only on service busy errors
 	return backoff.Retry(func() error {
-		return s.db.DeleteTaskList(&p.DeleteTaskListRequest{
+		return s.db.DeleteTaskList(scavengerCallerContext, &p.DeleteTaskListRequest{
 			DomainID:     key.DomainID,
 			TaskListName: key.Name,
 			TaskListType: key.TaskType,
@@ -25,6 +25,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
@@ -37,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
+	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
@@ -49,6 +51,7 @@ type (
 	// 1. Replicator: Handles applying replication tasks generated by remote clusters.
 	// 2. Indexer: Handles uploading of visibility records to elastic search.
 	// 3. Archiver: Handles archival of workflow histories.
+	// 4. Batcher: Runs batch jobs applying an operation to the workflow executions selected by a visibility query.
 	Service struct {
 		stopC         chan struct{}
 		isStopped     int32
@@ -64,7 +67,10 @@ type (
 		ArchiverConfig  *archiver.Config
 		IndexerCfg      *indexer.Config
 		ScannerCfg      *scanner.Config
+		EnableBatcher   dynamicconfig.BoolPropertyFn
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
+		// delay after which the idempotent reads of the batcher are hedged to a second frontend host
+		FrontendHedgingDelay dynamicconfig.DurationPropertyFn
 	}
 )
 
@@ -112,7 +118,9 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			Persistence:       &params.PersistenceConfig,
 			ClusterMetadata:   params.ClusterMetadata,
 		},
-		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
+		EnableBatcher:        dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
+		ThrottledLogRPS:      dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
+		FrontendHedgingDelay: dc.GetDurationProperty(dynamicconfig.WorkerFrontendHedgingDelay, 0),
 	}
 }
 
@@ -126,7 +134,7 @@ func (s *Service) Start() {
 
 	pConfig := s.params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.ReplicationCfg.PersistenceMaxQPS())
-	pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.logger)
+	pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.params.BlobstoreClient, s.logger)
 
 	if base.GetClusterMetadata().IsGlobalDomainEnabled() {
 		s.startReplicator(base, pFactory)
@@ -139,6 +147,9 @@ func (s *Service) Start() {
 	}
 
 	s.startScanner(base)
+	if s.config.EnableBatcher() {
+		s.startBatcher(base)
+	}
 
 	s.logger.Info("service started", tag.ComponentWorker)
 	<-s.stopC
@@ -173,6 +184,24 @@ func (s *Service) startScanner(base service.Service) {
 	}
 }
 
+func (s *Service) startBatcher(base service.Service) {
+	s.ensureSystemDomainExists(s.params.PublicClient)
+	frontendClient := frontend.NewHedgedClient(base.GetClientBean().GetFrontendClient(), func() time.Duration {
+		return s.config.FrontendHedgingDelay()
+	})
+	params := &batcher.BootstrapParams{
+		SDKClient:      s.params.PublicClient,
+		FrontendClient: frontendClient,
+		MetricsClient:  s.metricsClient,
+		Logger:         s.logger,
+		TallyScope:     s.params.MetricScope,
+	}
+	batcher := batcher.New(params)
+	if err := batcher.Start(); err != nil {
+		s.logger.Fatal("error starting batcher", tag.Error(err))
+	}
+}
+
 func (s *Service) startReplicator(base service.Service, pFactory persistencefactory.Factory) {
 	metadataV2Mgr, err := pFactory.NewMetadataManager(persistencefactory.MetadataV2)
 	if err != nil {
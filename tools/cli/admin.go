@@ -55,6 +55,10 @@ func newAdminWorkflowCommands() []cli.Command {
 					Name:  FlagOutputFilenameWithAlias,
 					Usage: "output file",
 				},
+				cli.BoolFlag{
+					Name:  FlagFlattenAttributes,
+					Usage: "Write the output file as canonical JSON with flattened event attributes",
+				},
 
 				// for cassandra connection
 				cli.StringFlag{
@@ -109,7 +113,7 @@ func newAdminWorkflowCommands() []cli.Command {
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
-			Usage:   "Delete current workflow execution and the mutableState record",
+			Usage:   "Delete the history, queue tasks, visibility records and the mutableState record of a closed workflow execution",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagWorkflowIDWithAlias,
@@ -123,6 +127,10 @@ func newAdminWorkflowCommands() []cli.Command {
 					Name:  FlagSkipErrorModeWithAlias,
 					Usage: "skip errors when deleting history",
 				},
+				cli.BoolFlag{
+					Name:  FlagForce,
+					Usage: "delete the workflow execution even if it is still running, for runs which cannot be terminated",
+				},
 
 				// for cassandra connection
 				cli.StringFlag{
@@ -146,6 +154,21 @@ func newAdminWorkflowCommands() []cli.Command {
 					Name:  FlagKeyspace,
 					Usage: "cassandra keyspace",
 				},
+				cli.StringFlag{
+					Name:  FlagVisibilityKeyspace,
+					Value: "cadence_visibility",
+					Usage: "cassandra keyspace of the visibility records",
+				},
+
+				// for ElasticSearch visibility
+				cli.StringFlag{
+					Name:  FlagURL,
+					Usage: "URL of ElasticSearch cluster, the document of the workflow execution is deleted as well when set",
+				},
+				cli.StringFlag{
+					Name:  FlagIndex,
+					Usage: "ElasticSearch index name of the visibility documents",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminDeleteWorkflow(c)
@@ -182,6 +205,14 @@ func newAdminHistoryHostCommands() []cli.Command {
 				AdminDescribeHistoryHost(c)
 			},
 		},
+		{
+			Name:    "shards",
+			Aliases: []string{"sh"},
+			Usage:   "Describe the distribution of shards over history hosts",
+			Action: func(c *cli.Context) {
+				AdminDescribeShardDistribution(c)
+			},
+		},
 		{
 			Name:    "getshard",
 			Aliases: []string{"gsh"},
@@ -200,6 +231,47 @@ func newAdminHistoryHostCommands() []cli.Command {
 				AdminGetShardID(c)
 			},
 		},
+		{
+			Name:    "removetask",
+			Aliases: []string{"rmtk"},
+			Usage:   "Remove a transfer or timer task which blocks the queue processor of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskType,
+					Value: "transfer",
+					Usage: "Task type: transfer, timer",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskID,
+					Usage: "TaskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskVisibilityTimestamp,
+					Usage: "Task visibility timestamp in nano seconds, required for timer task",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRemoveTask(c)
+			},
+		},
+		{
+			Name:    "closeshard",
+			Aliases: []string{"clsh"},
+			Usage:   "Close a shard on the history host owning it, the shard is acquired again on the next request",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCloseShard(c)
+			},
+		},
 	}
 }
 
@@ -245,6 +317,45 @@ func newAdminDomainCommands() []cli.Command {
 				AdminGetDomainIDOrName(c)
 			},
 		},
+		{
+			Name:  "rename",
+			Usage: "Rename a local domain, the previous name keeps resolving to the domain as a deprecated alias",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "DomainName",
+				},
+				cli.StringFlag{
+					Name:  FlagNewDomainName,
+					Usage: "New name of the domain",
+				},
+
+				// for cassandra connection
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "cassandra host address",
+				},
+				cli.IntFlag{
+					Name:  FlagPort,
+					Usage: "cassandra port for the host (default is 9042)",
+				},
+				cli.StringFlag{
+					Name:  FlagUsername,
+					Usage: "cassandra username",
+				},
+				cli.StringFlag{
+					Name:  FlagPassword,
+					Usage: "cassandra password",
+				},
+				cli.StringFlag{
+					Name:  FlagKeyspace,
+					Usage: "cassandra keyspace",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRenameDomain(c)
+			},
+		},
 	}
 }
 
@@ -531,3 +642,120 @@ func newAdminTaskListCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminVisibilityCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "closedcount",
+			Aliases: []string{"cc"},
+			Usage:   "Count closed workflow executions of a domain per day, directly from visibility store",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomainID,
+					Usage: "Domain ID(uuid)",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "EarliestTime of close time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "LatestTime of close time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+				},
+
+				// for cassandra connection
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "cassandra host address",
+				},
+				cli.IntFlag{
+					Name:  FlagPort,
+					Usage: "cassandra port for the host (default is 9042)",
+				},
+				cli.StringFlag{
+					Name:  FlagUsername,
+					Usage: "cassandra username",
+				},
+				cli.StringFlag{
+					Name:  FlagPassword,
+					Usage: "cassandra password",
+				},
+				cli.StringFlag{
+					Name:  FlagKeyspace,
+					Usage: "cassandra keyspace of visibility",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCountClosedWorkflowsByDay(c)
+			},
+		},
+	}
+}
+
+func newAdminClusterCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "add-search-attr",
+			Aliases: []string{"asa"},
+			Usage:   "Register a search attribute key and the type of its values",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSearchAttributesKey,
+					Usage: "Search attribute key, must start with a letter and contain only letters, digits and underscores",
+				},
+				cli.StringFlag{
+					Name:  FlagSearchAttributesType,
+					Usage: "Search attribute type: STRING, KEYWORD, INT, BOOL or DATETIME",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminAddSearchAttribute(c)
+			},
+		},
+	}
+}
+
+func newAdminDynamicConfigCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Set the value of a dynamic config key on all the hosts of the cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Dynamic config key, e.g. frontend.rps",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagDynamicConfigFilter,
+					Usage: "Optional filter in the format of name=value, e.g. domainName=samples-domain, can be repeated",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigValue,
+					Usage: "JSON encoded value, e.g. 1000 or \"10s\", omit it to remove the value for the filters",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason of the change",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "rollback",
+			Aliases: []string{"rb"},
+			Usage:   "Restore the dynamic config version which preceded the latest change on all the hosts of the cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason of the rollback",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRollbackDynamicConfig(c)
+			},
+		},
+	}
+}
@@ -21,16 +21,23 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/olivere/elastic"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/historyrender"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/persistence"
 	cassp "github.com/uber/cadence/common/persistence/cassandra"
@@ -38,7 +45,11 @@ import (
 	"github.com/urfave/cli"
 )
 
-const maxEventID = 9999
+const (
+	maxEventID = 9999
+
+	deleteTasksBatchSize = 1000
+)
 
 // AdminShowWorkflow shows history
 func AdminShowWorkflow(c *cli.Context) {
@@ -103,18 +114,20 @@ func AdminShowWorkflow(c *cli.Context) {
 			ErrorAndExit("DeserializeBatchEvents err", err)
 		}
 		allEvents.Events = append(allEvents.Events, historyBatch...)
-		for _, e := range historyBatch {
-			jsonstr, err := json.Marshal(e)
-			if err != nil {
-				ErrorAndExit("json.Marshal err", err)
-			}
-			fmt.Println(string(jsonstr))
+		if err := historyrender.WriteTable(os.Stdout, historyBatch, 0); err != nil {
+			ErrorAndExit("Failed to print history batch.", err)
 		}
 	}
 	fmt.Printf("======== total batches %v, total blob len: %v ======\n", len(history), totalSize)
 
 	if outputFileName != "" {
-		data, err := json.Marshal(allEvents.Events)
+		var data []byte
+		var err error
+		if c.Bool(FlagFlattenAttributes) {
+			data, err = historyrender.ToJSON(allEvents.Events)
+		} else {
+			data, err = json.Marshal(allEvents.Events)
+		}
 		if err != nil {
 			ErrorAndExit("Failed to serialize history data.", err)
 		}
@@ -173,10 +186,10 @@ func describeMutableState(c *cli.Context) *admin.DescribeWorkflowExecutionRespon
 	return resp
 }
 
-// AdminDeleteWorkflow describe a new workflow execution for admin
+// AdminDeleteWorkflow deletes the history, the outstanding queue tasks, the visibility records and the mutable state
+// of a workflow execution. Running executions are only deleted with the force flag, as the deletion does not close them.
 func AdminDeleteWorkflow(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
-	rid := c.String(FlagRunID)
 
 	resp := describeMutableState(c)
 	msStr := resp.GetMutableStateInDatabase()
@@ -186,7 +199,12 @@ func AdminDeleteWorkflow(c *cli.Context) {
 		ErrorAndExit("json.Unmarshal err", err)
 	}
 	domainID := ms.ExecutionInfo.DomainID
+	// the run ID is optional, the described run is the current one then
+	rid := ms.ExecutionInfo.RunID
 	skipError := c.Bool(FlagSkipErrorMode)
+	if ms.ExecutionInfo.State != persistence.WorkflowStateCompleted && !c.Bool(FlagForce) {
+		ErrorAndExit("Workflow execution is still running, terminate it first or use --force for runs which cannot be terminated.", nil)
+	}
 	session := connectToCassandra(c)
 	shardID := resp.GetShardId()
 	shardIDInt, err := strconv.Atoi(shardID)
@@ -233,6 +251,9 @@ func AdminDeleteWorkflow(c *cli.Context) {
 	}
 
 	exeStore := cassp.NewWorkflowExecutionPersistenceFromSession(session, shardIDInt, loggerimpl.NewNopLogger())
+	deleteWorkflowTasks(exeStore, domainID, wid, rid, skipError)
+	deleteWorkflowVisibility(c, domainID, wid, rid, ms.ExecutionInfo.StartTimestamp, skipError)
+
 	req := &persistence.DeleteWorkflowExecutionRequest{
 		DomainID:   domainID,
 		WorkflowID: wid,
@@ -249,6 +270,22 @@ func AdminDeleteWorkflow(c *cli.Context) {
 	}
 	fmt.Println("delete mutableState row successfully")
 
+	// the current row may already point to a newer run of the workflow, which has to be kept
+	currentResp, err := exeStore.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: wid,
+	})
+	if err != nil {
+		if skipError {
+			fmt.Println("get current row failed, ", err)
+		} else {
+			ErrorAndExit("get current row failed", err)
+		}
+	} else if currentResp.RunID != rid {
+		fmt.Printf("current row points to run %v, keep it\n", currentResp.RunID)
+		return
+	}
+
 	err = exeStore.DeleteWorkflowCurrentRow(req)
 	if err != nil {
 		if skipError {
@@ -260,6 +297,128 @@ func AdminDeleteWorkflow(c *cli.Context) {
 	fmt.Println("delete current row successfully")
 }
 
+// deleteWorkflowTasks removes the outstanding transfer and timer tasks of the run from the queues of its shard
+func deleteWorkflowTasks(exeStore persistence.ExecutionStore, domainID, wid, rid string, skipError bool) {
+	isRunTask := func(taskDomainID, taskWorkflowID, taskRunID string) bool {
+		return taskDomainID == domainID && taskWorkflowID == wid && taskRunID == rid
+	}
+
+	deleted := 0
+	var pageToken []byte
+	for {
+		resp, err := exeStore.GetTransferTasks(&persistence.GetTransferTasksRequest{
+			ReadLevel:     0,
+			MaxReadLevel:  math.MaxInt64,
+			BatchSize:     deleteTasksBatchSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			if !skipError {
+				ErrorAndExit("read transfer tasks failed", err)
+			}
+			fmt.Println("read transfer tasks failed, ", err)
+			break
+		}
+		for _, task := range resp.Tasks {
+			if !isRunTask(task.DomainID, task.WorkflowID, task.RunID) {
+				continue
+			}
+			if err := exeStore.CompleteTransferTask(&persistence.CompleteTransferTaskRequest{TaskID: task.TaskID}); err != nil {
+				if !skipError {
+					ErrorAndExit("delete transfer task failed", err)
+				}
+				fmt.Println("delete transfer task failed, ", err)
+				continue
+			}
+			deleted++
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	fmt.Printf("delete %v transfer tasks successfully\n", deleted)
+
+	deleted = 0
+	pageToken = nil
+	for {
+		resp, err := exeStore.GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+			MinTimestamp:  time.Unix(0, 0),
+			MaxTimestamp:  time.Unix(0, math.MaxInt64),
+			BatchSize:     deleteTasksBatchSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			if !skipError {
+				ErrorAndExit("read timer tasks failed", err)
+			}
+			fmt.Println("read timer tasks failed, ", err)
+			break
+		}
+		for _, task := range resp.Timers {
+			if !isRunTask(task.DomainID, task.WorkflowID, task.RunID) {
+				continue
+			}
+			err := exeStore.CompleteTimerTask(&persistence.CompleteTimerTaskRequest{
+				VisibilityTimestamp: task.VisibilityTimestamp,
+				TaskID:              task.TaskID,
+			})
+			if err != nil {
+				if !skipError {
+					ErrorAndExit("delete timer task failed", err)
+				}
+				fmt.Println("delete timer task failed, ", err)
+				continue
+			}
+			deleted++
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	fmt.Printf("delete %v timer tasks successfully\n", deleted)
+}
+
+// deleteWorkflowVisibility removes the open and closed visibility records of the run from the visibility keyspace,
+// and its document from ElasticSearch when the ElasticSearch URL is given
+func deleteWorkflowVisibility(c *cli.Context, domainID, wid, rid string, startTime time.Time, skipError bool) {
+	session := connectToCassandraKeyspace(c, getRequiredOption(c, FlagVisibilityKeyspace))
+	visibilityStore := cassp.NewVisibilityPersistenceFromSession(session, loggerimpl.NewNopLogger())
+	err := visibilityStore.DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:       domainID,
+		WorkflowID:     wid,
+		RunID:          rid,
+		StartTimestamp: startTime.UnixNano(),
+	})
+	if err != nil {
+		if !skipError {
+			ErrorAndExit("delete visibility records failed", err)
+		}
+		fmt.Println("delete visibility records failed, ", err)
+	} else {
+		fmt.Println("delete visibility records successfully")
+	}
+
+	if !c.IsSet(FlagURL) {
+		return
+	}
+	esClient := getESClient(c)
+	_, err = esClient.Delete().
+		Index(getRequiredOption(c, FlagIndex)).
+		Type(esDocType).
+		Id(wid + esDocIDDelimiter + rid).
+		Do(context.Background())
+	if err != nil && !elastic.IsNotFound(err) {
+		if !skipError {
+			ErrorAndExit("delete ElasticSearch document failed", err)
+		}
+		fmt.Println("delete ElasticSearch document failed, ", err)
+		return
+	}
+	fmt.Println("delete ElasticSearch document successfully")
+}
+
 func readOneRow(query *gocql.Query) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
 	err := query.MapScan(result)
@@ -267,6 +426,10 @@ func readOneRow(query *gocql.Query) (map[string]interface{}, error) {
 }
 
 func connectToCassandra(c *cli.Context) *gocql.Session {
+	return connectToCassandraKeyspace(c, getRequiredOption(c, FlagKeyspace))
+}
+
+func connectToCassandraKeyspace(c *cli.Context, ksp string) *gocql.Session {
 	host := getRequiredOption(c, FlagAddress)
 	if !c.IsSet(FlagPort) {
 		ErrorAndExit("port is required", nil)
@@ -274,7 +437,6 @@ func connectToCassandra(c *cli.Context) *gocql.Session {
 	port := c.Int(FlagPort)
 	user := c.String(FlagUsername)
 	pw := c.String(FlagPassword)
-	ksp := getRequiredOption(c, FlagKeyspace)
 
 	clusterCfg, err := cassandra.NewCassandraCluster(host, port, user, pw, ksp, 10)
 	clusterCfg.SerialConsistency = gocql.LocalSerial
@@ -334,6 +496,61 @@ func AdminGetDomainIDOrName(c *cli.Context) {
 	}
 }
 
+// AdminRenameDomain renames a domain and records the previous name as an alias of the domain, so workers and
+// clients still using it keep working until they move to the new name
+func AdminRenameDomain(c *cli.Context) {
+	domainName := getRequiredOption(c, FlagDomain)
+	newDomainName := getRequiredOption(c, FlagNewDomainName)
+
+	session := connectToCassandra(c)
+	metadataMgr := cassp.NewMetadataManagerProxyFromSession(session, "", loggerimpl.NewNopLogger())
+	defer metadataMgr.Close()
+
+	resp, err := metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		ErrorAndExit("Failed to get domain", err)
+	}
+	if resp.IsGlobalDomain {
+		// the rename is not replicated, the name of a global domain would diverge between clusters
+		ErrorAndExit("Renaming a global domain is not supported", nil)
+	}
+	metadata, err := metadataMgr.GetMetadata()
+	if err != nil {
+		ErrorAndExit("Failed to get domain metadata", err)
+	}
+
+	aliases := []string{domainName}
+	for _, alias := range common.GetDomainAliases(resp.Info.Data) {
+		if alias != newDomainName && alias != domainName {
+			aliases = append(aliases, alias)
+		}
+	}
+	data := make(map[string]string, len(resp.Info.Data)+1)
+	for k, v := range resp.Info.Data {
+		data[k] = v
+	}
+	data[common.DomainDataKeyForAliases] = strings.Join(aliases, ",")
+	info := *resp.Info
+	info.Name = newDomainName
+	info.Data = data
+
+	err = metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+		Info:                        &info,
+		Config:                      resp.Config,
+		ReplicationConfig:           resp.ReplicationConfig,
+		ConfigVersion:               resp.ConfigVersion,
+		FailoverVersion:             resp.FailoverVersion,
+		FailoverNotificationVersion: resp.FailoverNotificationVersion,
+		NotificationVersion:         metadata.NotificationVersion,
+		TableVersion:                resp.TableVersion,
+		PreviousName:                domainName,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to rename domain", err)
+	}
+	fmt.Printf("Domain %v renamed to %v, %v resolves to the domain as a deprecated alias\n", domainName, newDomainName, domainName)
+}
+
 // AdminGetShardID get shardID
 func AdminGetShardID(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -347,6 +564,134 @@ func AdminGetShardID(c *cli.Context) {
 	fmt.Printf("ShardID for workflowID: %v is %v \n", wid, shardID)
 }
 
+// AdminRemoveTask removes a transfer or timer task of a shard
+func AdminRemoveTask(c *cli.Context) {
+	if !c.IsSet(FlagShardID) || !c.IsSet(FlagTaskID) {
+		ErrorAndExit("shard_id and task_id are required", nil)
+	}
+
+	req := &shared.RemoveTaskRequest{
+		ShardID: common.Int32Ptr(int32(c.Int(FlagShardID))),
+		TaskID:  common.Int64Ptr(c.Int64(FlagTaskID)),
+	}
+	switch c.String(FlagTaskType) {
+	case "transfer":
+		req.Type = common.Int32Ptr(common.TaskTypeTransfer)
+	case "timer":
+		if !c.IsSet(FlagTaskVisibilityTimestamp) {
+			ErrorAndExit("task_timestamp is required for timer task", nil)
+		}
+		req.Type = common.Int32Ptr(common.TaskTypeTimer)
+		req.VisibilityTimestamp = common.Int64Ptr(c.Int64(FlagTaskVisibilityTimestamp))
+	default:
+		ErrorAndExit("Unknown task type: "+c.String(FlagTaskType), nil)
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if err := adminClient.RemoveTask(ctx, req); err != nil {
+		ErrorAndExit("Remove task failed", err)
+	}
+	fmt.Printf("Task %v has been removed from shard %v, close the shard to reload its queues.\n", req.GetTaskID(), req.GetShardID())
+}
+
+// AdminCloseShard closes a shard on the history host owning it
+func AdminCloseShard(c *cli.Context) {
+	if !c.IsSet(FlagShardID) {
+		ErrorAndExit("shard_id is required", nil)
+	}
+	shardID := c.Int(FlagShardID)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &shared.CloseShardRequest{ShardID: common.Int32Ptr(int32(shardID))}
+	if err := adminClient.CloseShard(ctx, req); err != nil {
+		ErrorAndExit("Close shard failed", err)
+	}
+	fmt.Printf("Shard %v has been closed.\n", shardID)
+}
+
+// AdminAddSearchAttribute registers a search attribute key
+func AdminAddSearchAttribute(c *cli.Context) {
+	key := getRequiredOption(c, FlagSearchAttributesKey)
+	typeName := getRequiredOption(c, FlagSearchAttributesType)
+
+	var valueType shared.IndexedValueType
+	if err := valueType.UnmarshalText([]byte(strings.ToUpper(typeName))); err != nil {
+		ErrorAndExit("Unknown search attribute type", err)
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &admin.AddSearchAttributeRequest{
+		SearchAttribute: map[string]shared.IndexedValueType{
+			key: valueType,
+		},
+	}
+	if err := adminClient.AddSearchAttribute(ctx, req); err != nil {
+		ErrorAndExit("Add search attribute failed", err)
+	}
+	fmt.Printf("Search attribute %v of type %v has been registered.\n", key, valueType)
+}
+
+// AdminUpdateDynamicConfig sets the value of a dynamic config key
+func AdminUpdateDynamicConfig(c *cli.Context) {
+	key := getRequiredOption(c, FlagDynamicConfigKey)
+
+	var filters []*admin.DynamicConfigFilter
+	for _, filter := range c.StringSlice(FlagDynamicConfigFilter) {
+		parts := strings.SplitN(filter, "=", 2)
+		if len(parts) != 2 {
+			ErrorAndExit(fmt.Sprintf("Invalid filter %v, expected name=value", filter), nil)
+		}
+		filters = append(filters, &admin.DynamicConfigFilter{
+			Name:  common.StringPtr(parts[0]),
+			Value: common.StringPtr(parts[1]),
+		})
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &admin.UpdateDynamicConfigRequest{
+		Key:       common.StringPtr(key),
+		Filters:   filters,
+		ChangedBy: common.StringPtr(getCliIdentity()),
+		Reason:    common.StringPtr(c.String(FlagReason)),
+	}
+	if c.IsSet(FlagDynamicConfigValue) {
+		req.Value = []byte(c.String(FlagDynamicConfigValue))
+	}
+	resp, err := adminClient.UpdateDynamicConfig(ctx, req)
+	if err != nil {
+		ErrorAndExit("Update dynamic config failed", err)
+	}
+	fmt.Printf("Dynamic config %v has been updated, version %v.\n", key, resp.GetVersion())
+}
+
+// AdminRollbackDynamicConfig restores the dynamic config version which preceded the latest change
+func AdminRollbackDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RollbackDynamicConfig(ctx, &admin.RollbackDynamicConfigRequest{
+		ChangedBy: common.StringPtr(getCliIdentity()),
+		Reason:    common.StringPtr(c.String(FlagReason)),
+	})
+	if err != nil {
+		ErrorAndExit("Rollback dynamic config failed", err)
+	}
+	fmt.Printf("Dynamic config has been rolled back, version %v.\n", resp.GetVersion())
+}
+
 // AdminDescribeHistoryHost describes history host
 func AdminDescribeHistoryHost(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -382,6 +727,21 @@ func AdminDescribeHistoryHost(c *cli.Context) {
 
 	if !printFully {
 		resp.ShardIDs = nil
+		resp.Shards = nil
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// AdminDescribeShardDistribution describes which history host owns each shard
+func AdminDescribeShardDistribution(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeShardDistribution(ctx, &admin.DescribeShardDistributionRequest{})
+	if err != nil {
+		ErrorAndExit("Describe shard distribution failed", err)
 	}
 	prettyPrintJSONObject(resp)
 }
@@ -23,6 +23,7 @@ package cli
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -467,17 +468,17 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 	}
 
 	histV1 := cassandra.NewHistoryPersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyMgr := persistence.NewHistoryManagerImpl(histV1, loggerimpl.NewNopLogger())
+	historyMgr := persistence.NewHistoryManagerImpl(histV1, persistence.NewNoopPayloadCrypter(), loggerimpl.NewNopLogger())
 
 	histV2 := cassandra.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, loggerimpl.NewNopLogger())
+	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, persistence.NewNoopPayloadCrypter(), loggerimpl.NewNopLogger())
 
 	exeM := cassandra.NewWorkflowExecutionPersistenceFromSession(session, shardID, loggerimpl.NewNopLogger())
-	exeMgr := persistence.NewExecutionManagerImpl(exeM, loggerimpl.NewNopLogger())
+	exeMgr := persistence.NewExecutionManagerImpl(exeM, persistence.NewNoopPayloadCrypter(), loggerimpl.NewNopLogger())
 
 	for {
 		fmt.Printf("Start rereplicate for wid: %v, rid:%v \n", wid, rid)
-		resp, err := exeMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		resp, err := exeMgr.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{
 			DomainID: domainID,
 			Execution: shared.WorkflowExecution{
 				WorkflowId: common.StringPtr(wid),
@@ -507,7 +508,7 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 			BranchToken:         exeInfo.GetCurrentBranch(),
 		}
 
-		_, historyBatches, err := history.GetAllHistory(historyMgr, historyV2Mgr, nil, loggerimpl.NewNopLogger(), true,
+		_, historyBatches, err := history.GetAllHistory(context.Background(), historyMgr, historyV2Mgr, nil, loggerimpl.NewNopLogger(), true,
 			domainID, wid, rid, minID, maxID, exeInfo.EventStoreVersion, exeInfo.GetCurrentBranch(), common.IntPtr(shardID))
 
 		if err != nil {
@@ -524,7 +525,7 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 			if lastEvent.GetEventType() == shared.EventTypeWorkflowExecutionContinuedAsNew {
 				continueAsNew = true
 				newRunID = lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId()
-				resp, err := exeMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+				resp, err := exeMgr.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{
 					DomainID: domainID,
 					Execution: shared.WorkflowExecution{
 						WorkflowId: common.StringPtr(wid),
@@ -540,7 +541,7 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 			taskTemplate.Version = firstEvent.GetVersion()
 			taskTemplate.FirstEventID = firstEvent.GetEventId()
 			taskTemplate.NextEventID = lastEvent.GetEventId() + 1
-			task, err := history.GenerateReplicationTask(targets, taskTemplate, historyMgr, historyV2Mgr, nil, loggerimpl.NewNopLogger(), batch, common.IntPtr(shardID))
+			task, err := history.GenerateReplicationTask(context.Background(), targets, taskTemplate, historyMgr, historyV2Mgr, nil, loggerimpl.NewNopLogger(), batch, common.IntPtr(shardID))
 			if err != nil {
 				ErrorAndExit("GenerateReplicationTask error", err)
 			}
@@ -72,16 +72,21 @@ func AdminDescribeTaskList(c *cli.Context) {
 
 func printTaskListStatus(taskListStatus *s.TaskListStatus) {
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
+	oldestTaskCreatedTime := ""
+	if taskListStatus.OldestTaskCreatedTimestamp != nil {
+		oldestTaskCreatedTime = convertTime(taskListStatus.GetOldestTaskCreatedTimestamp(), false)
+	}
 
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
-	table.SetHeader([]string{"Read Level", "Ack Level", "Backlog", "Lease Start TaskID", "Lease End TaskID"})
+	table.SetHeader([]string{"Read Level", "Ack Level", "Backlog", "Oldest Task Created Time", "Lease Start TaskID", "Lease End TaskID"})
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
 	table.Append([]string{strconv.FormatInt(taskListStatus.GetReadLevel(), 10),
 		strconv.FormatInt(taskListStatus.GetAckLevel(), 10),
 		strconv.FormatInt(taskListStatus.GetBacklogCountHint(), 10),
+		oldestTaskCreatedTime,
 		strconv.FormatInt(taskIDBlock.GetStartID(), 10),
 		strconv.FormatInt(taskIDBlock.GetEndID(), 10)})
 	table.Render()
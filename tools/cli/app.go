@@ -41,6 +41,11 @@ func NewCliApp() *cli.App {
 			Usage:  "host:port for cadence frontend service",
 			EnvVar: "CADENCE_CLI_ADDRESS",
 		},
+		cli.StringFlag{
+			Name:   FlagGRPCAddress,
+			Usage:  "host:port for the gRPC port of cadence frontend service, full histories are streamed from it when set",
+			EnvVar: "CADENCE_CLI_GRPC_ADDRESS",
+		},
 		cli.StringFlag{
 			Name:   FlagDomainWithAlias,
 			Usage:  "cadence workflow domain",
@@ -72,6 +77,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence tasklist",
 			Subcommands: newTaskListCommands(),
 		},
+		{
+			Name:        "batch",
+			Aliases:     []string{"b"},
+			Usage:       "Operate batch jobs, which terminate, cancel or signal many workflows at once",
+			Subcommands: newBatchCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -113,6 +124,24 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on taskList",
 					Subcommands: newAdminTaskListCommands(),
 				},
+				{
+					Name:        "visibility",
+					Aliases:     []string{"vis"},
+					Usage:       "Run admin operation on visibility store",
+					Subcommands: newAdminVisibilityCommands(),
+				},
+				{
+					Name:        "cluster",
+					Aliases:     []string{"cl"},
+					Usage:       "Run admin operation on cluster",
+					Subcommands: newAdminClusterCommands(),
+				},
+				{
+					Name:        "dynamicconfig",
+					Aliases:     []string{"dc"},
+					Usage:       "Run admin operation on the dynamic config of the cluster",
+					Subcommands: newAdminDynamicConfigCommands(),
+				},
 			},
 		},
 	}
@@ -35,6 +35,7 @@ import (
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	serverFrontendTest "github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
 	serverShared "github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
@@ -69,6 +70,10 @@ func (m *clientFactoryMock) ServerAdminClient(c *cli.Context) serverAdmin.Interf
 	return m.serverAdminClient
 }
 
+func (m *clientFactoryMock) WorkflowAPIClient(c *cli.Context) apiv1.WorkflowAPIYARPCClient {
+	return nil
+}
+
 // this is the mock for yarpcCallOptions, make sure length are the same
 var callOptions = []interface{}{gomock.Any(), gomock.Any(), gomock.Any()}
 
@@ -76,6 +81,7 @@ var commands = []string{
 	"domain", "d",
 	"workflow", "wf",
 	"tasklist", "tl",
+	"batch", "b",
 }
 
 var domainName = "cli-test-domain"
@@ -291,6 +297,35 @@ func (s *cliAppSuite) TestShowHistory_PrintDateTime() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestShowHistory_Timeline() {
+	startedType := serverShared.EventTypeWorkflowExecutionStarted
+	scheduledType := serverShared.EventTypeActivityTaskScheduled
+	resp := &serverShared.GetWorkflowExecutionHistoryResponse{
+		History: &serverShared.History{
+			Events: []*serverShared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(1),
+					EventType: &startedType,
+					WorkflowExecutionStartedEventAttributes: &serverShared.WorkflowExecutionStartedEventAttributes{
+						WorkflowType: &serverShared.WorkflowType{Name: common.StringPtr("TestWorkflow")},
+					},
+				},
+				{
+					EventId:   common.Int64Ptr(2),
+					EventType: &scheduledType,
+					ActivityTaskScheduledEventAttributes: &serverShared.ActivityTaskScheduledEventAttributes{
+						ActivityId:   common.StringPtr("activity-1"),
+						ActivityType: &serverShared.ActivityType{Name: common.StringPtr("TestActivity")},
+					},
+				},
+			},
+		},
+	}
+	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "show", "-w", "wid", "--format", "timeline"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestStartWorkflow() {
 	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
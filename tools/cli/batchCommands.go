@@ -0,0 +1,170 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/urfave/cli"
+	s "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/client"
+)
+
+const batchJobTimeout = 365 * 24 * time.Hour
+
+// StartBatchJob starts a batch job
+func StartBatchJob(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	params := batcher.BatchParams{
+		DomainName: domain,
+		Query: batcher.BatchQuery{
+			EarliestStartTime: time.Unix(0, parseTime(c.String(FlagEarliestTime), 0)),
+			LatestStartTime:   time.Unix(0, parseTime(c.String(FlagLatestTime), time.Now().UnixNano())),
+			WorkflowID:        c.String(FlagWorkflowID),
+			WorkflowType:      c.String(FlagWorkflowType),
+		},
+		Reason:    getRequiredOption(c, FlagReason),
+		BatchType: getRequiredOption(c, FlagBatchType),
+		RPS:       c.Int(FlagRPS),
+	}
+	if params.BatchType == batcher.BatchTypeSignal {
+		params.SignalParams = batcher.SignalParams{
+			SignalName: getRequiredOption(c, FlagSignalName),
+			Input:      processJSONInput(c),
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	countRequest := &shared.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr(domain),
+		StartTimeFilter: &shared.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(params.Query.EarliestStartTime.UnixNano()),
+			LatestTime:   common.Int64Ptr(params.Query.LatestStartTime.UnixNano()),
+		},
+	}
+	if params.Query.WorkflowID != "" {
+		countRequest.ExecutionFilter = &shared.WorkflowExecutionFilter{WorkflowId: common.StringPtr(params.Query.WorkflowID)}
+	}
+	if params.Query.WorkflowType != "" {
+		countRequest.TypeFilter = &shared.WorkflowTypeFilter{Name: common.StringPtr(params.Query.WorkflowType)}
+	}
+	countResp, err := cFactory.ServerFrontendClient(c).CountWorkflowExecutions(ctx, countRequest)
+	if err != nil {
+		ErrorAndExit("Failed to count the workflows matching the query.", err)
+	}
+
+	jobID := uuid.New()
+	options := client.StartWorkflowOptions{
+		ID:                           jobID,
+		TaskList:                     batcher.BatcherTaskListName,
+		ExecutionStartToCloseTimeout: batchJobTimeout,
+	}
+	_, err = getBatchClient(c).StartWorkflow(ctx, options, batcher.BatchWFTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start batch job.", err)
+	}
+	fmt.Printf("Batch job %v started, it applies to about %v workflows.\n", jobID, countResp.GetCount())
+}
+
+// DescribeBatchJob describes the progress of a batch job
+func DescribeBatchJob(c *cli.Context) {
+	jobID := getRequiredOption(c, FlagJobID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := cFactory.ClientFrontendClient(c).DescribeWorkflowExecution(ctx, &s.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(common.SystemDomainName),
+		Execution: &s.WorkflowExecution{WorkflowId: common.StringPtr(jobID)},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe batch job.", err)
+	}
+
+	output := map[string]interface{}{}
+	var progress batcher.HeartBeatDetails
+	info := resp.WorkflowExecutionInfo
+	if info.CloseStatus == nil {
+		output["status"] = "Running"
+		// the progress is the heartbeat of the batch activity until the job completes
+		if len(resp.PendingActivities) > 0 && len(resp.PendingActivities[0].HeartbeatDetails) > 0 {
+			if err := json.Unmarshal(resp.PendingActivities[0].HeartbeatDetails, &progress); err != nil {
+				ErrorAndExit("Failed to decode batch job progress.", err)
+			}
+		}
+	} else {
+		output["status"] = info.GetCloseStatus().String()
+		if info.GetCloseStatus() == s.WorkflowExecutionCloseStatusCompleted {
+			if err := getBatchClient(c).GetWorkflow(ctx, jobID, "").Get(ctx, &progress); err != nil {
+				ErrorAndExit("Failed to get batch job result.", err)
+			}
+		}
+	}
+	output["startTime"] = convertTime(info.GetStartTime(), false)
+	output["totalEstimate"] = progress.TotalEstimate
+	output["successCount"] = progress.SuccessCount
+	output["errorCount"] = progress.ErrorCount
+	output["currentPage"] = progress.CurrentPage
+	prettyPrintJSONObject(output)
+}
+
+// ListBatchJobs lists the running batch jobs
+func ListBatchJobs(c *cli.Context) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := cFactory.ClientFrontendClient(c).ListOpenWorkflowExecutions(ctx, &s.ListOpenWorkflowExecutionsRequest{
+		Domain: common.StringPtr(common.SystemDomainName),
+		StartTimeFilter: &s.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+		TypeFilter: &s.WorkflowTypeFilter{Name: common.StringPtr(batcher.BatchWFTypeName)},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to list batch jobs.", err)
+	}
+	for _, info := range resp.Executions {
+		fmt.Printf("%v, started at %v\n", info.Execution.GetWorkflowId(), convertTime(info.GetStartTime(), false))
+	}
+}
+
+// TerminateBatchJob stops a batch job
+func TerminateBatchJob(c *cli.Context) {
+	jobID := getRequiredOption(c, FlagJobID)
+	reason := getRequiredOption(c, FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := getBatchClient(c).TerminateWorkflow(ctx, jobID, "", reason, nil); err != nil {
+		ErrorAndExit("Failed to terminate batch job.", err)
+	}
+	fmt.Printf("Batch job %v terminated.\n", jobID)
+}
+
+func getBatchClient(c *cli.Context) client.Client {
+	return client.NewClient(cFactory.ClientFrontendClient(c), common.SystemDomainName, &client.Options{})
+}
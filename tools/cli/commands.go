@@ -38,7 +38,10 @@ import (
 	"github.com/olekukonko/tablewriter"
 	"github.com/pborman/uuid"
 	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/timeline"
 	"github.com/urfave/cli"
 	s "go.uber.org/cadence/.gen/go/shared"
 	"go.uber.org/cadence/client"
@@ -59,6 +62,8 @@ const (
 	defaultContextTimeout            = defaultContextTimeoutInSeconds * time.Second
 	defaultContextTimeoutForLongPoll = 2 * time.Minute
 
+	historyFormatTimeline = "timeline"
+
 	defaultDecisionTimeoutInSeconds = 10
 	defaultPageSizeForList          = 500
 	defaultWorkflowIDReusePolicy    = s.WorkflowIdReusePolicyAllowDuplicateFailedOnly
@@ -385,6 +390,15 @@ func ShowHistoryWithWID(c *cli.Context) {
 }
 
 func showHistoryHelper(c *cli.Context, wid, rid string) {
+	switch format := c.String(FlagFormat); format {
+	case "":
+	case historyFormatTimeline:
+		showTimelineHelper(c, wid, rid)
+		return
+	default:
+		ErrorAndExit(fmt.Sprintf("Unknown format: %v.", format), nil)
+	}
+
 	wfClient := getWorkflowClient(c)
 
 	printDateTime := c.Bool(FlagPrintDateTime)
@@ -397,8 +411,28 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 		maxFieldLength = c.Int(FlagMaxFieldLength)
 	}
 
+	if printFully && outputFileName == "" {
+		if workflowAPIClient := cFactory.WorkflowAPIClient(c); workflowAPIClient != nil {
+			printHistoryStream(c, workflowAPIClient, wid, rid, maxFieldLength)
+			return
+		}
+	}
+
 	ctx, cancel := newContext(c)
 	defer cancel()
+
+	if printFully && outputFileName == "" { // dump everything as pages arrive, without buffering the whole history
+		iter := wfClient.GetWorkflowHistory(ctx, wid, rid, false, s.HistoryEventFilterTypeAllEvent)
+		for iter.HasNext() {
+			e, err := iter.Next()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
+			}
+			fmt.Println(anyToString(e, true, maxFieldLength))
+		}
+		return
+	}
+
 	history, err := GetHistory(ctx, wfClient, wid, rid)
 	if err != nil {
 		ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
@@ -450,6 +484,102 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 	}
 }
 
+// printHistoryStream dumps the events of every page pushed by the history stream of the frontend as it arrives
+func printHistoryStream(c *cli.Context, workflowAPIClient apiv1.WorkflowAPIYARPCClient, wid, rid string, maxFieldLength int) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+
+	stream, err := workflowAPIClient.GetWorkflowExecutionHistoryStream(ctx, &apiv1.GetWorkflowExecutionHistoryStreamRequest{
+		Domain:            domain,
+		WorkflowExecution: &apiv1.WorkflowExecution{WorkflowId: wid, RunId: rid},
+	})
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
+	}
+	encoder := codec.NewThriftRWEncoder()
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
+		}
+		var history shared.History
+		if err := encoder.Decode(response.GetHistory(), &history); err != nil {
+			ErrorAndExit("Failed to decode history page.", err)
+		}
+		for _, e := range history.Events {
+			fmt.Println(anyToString(e, true, maxFieldLength))
+		}
+	}
+}
+
+func showTimelineHelper(c *cli.Context, wid, rid string) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	printRawTime := c.Bool(FlagPrintRawTime)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	var events []*shared.HistoryEvent
+	var token []byte
+	for {
+		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, &shared.GetWorkflowExecutionHistoryRequest{
+			Domain: common.StringPtr(domain),
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(wid),
+				RunId:      common.StringPtr(rid),
+			},
+			NextPageToken: token,
+		})
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
+		}
+		events = append(events, resp.History.GetEvents()...)
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+
+	formatTimestamp := func(timestamp int64) string {
+		if timestamp == 0 {
+			return ""
+		}
+		if printRawTime {
+			return strconv.FormatInt(timestamp, 10)
+		}
+		return convertTime(timestamp, false)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Type", "ID", "Name", "Status", "Attempt", "Scheduled", "Started", "Closed", "Duration"})
+	table.SetHeaderLine(false)
+	for _, span := range timeline.Build(events).Spans {
+		duration := ""
+		if span.ClosedTimestamp != 0 {
+			duration = time.Duration(span.Duration()).String()
+		}
+		table.Append([]string{
+			string(span.Type),
+			span.ID,
+			span.Name,
+			string(span.Status),
+			strconv.FormatInt(span.Attempt, 10),
+			formatTimestamp(span.ScheduledTimestamp),
+			formatTimestamp(span.StartedTimestamp),
+			formatTimestamp(span.ClosedTimestamp),
+			duration,
+		})
+	}
+	table.Render()
+}
+
 // StartWorkflow starts a new workflow execution
 func StartWorkflow(c *cli.Context) {
 	startWorkflowHelper(c, false)
@@ -685,7 +815,7 @@ func QueryWorkflow(c *cli.Context) {
 
 // QueryWorkflowUsingStackTrace query workflow execution using __stack_trace as query type
 func QueryWorkflowUsingStackTrace(c *cli.Context) {
-	queryWorkflowHelper(c, "__stack_trace")
+	queryWorkflowHelper(c, common.QueryTypeStackTrace)
 }
 
 func queryWorkflowHelper(c *cli.Context, queryType string) {
@@ -839,6 +969,8 @@ type describeWorkflowExecutionResponse struct {
 	ExecutionConfiguration *shared.WorkflowExecutionConfiguration
 	WorkflowExecutionInfo  workflowExecutionInfo
 	PendingActivities      []*pendingActivityInfo
+	PendingChildren        []*shared.PendingChildExecutionInfo
+	PendingDecision        *shared.PendingDecisionInfo
 }
 
 // workflowExecutionInfo has same fields as shared.WorkflowExecutionInfo, but has datetime instead of raw time
@@ -903,6 +1035,8 @@ func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecu
 		ExecutionConfiguration: resp.ExecutionConfiguration,
 		WorkflowExecutionInfo:  executionInfo,
 		PendingActivities:      pendingActs,
+		PendingChildren:        resp.PendingChildren,
+		PendingDecision:        resp.PendingDecision,
 	}
 }
 
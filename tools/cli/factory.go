@@ -25,18 +25,21 @@ import (
 
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 	"go.uber.org/zap"
 )
 
 const (
-	cadenceClientName      = "cadence-client"
-	cadenceFrontendService = "cadence-frontend"
+	cadenceClientName           = "cadence-client"
+	cadenceFrontendService      = "cadence-frontend"
+	cadenceFrontendGRPCOutbound = "cadence-frontend-grpc"
 )
 
 // ClientFactory is used to construct rpc clients
@@ -44,6 +47,8 @@ type ClientFactory interface {
 	ClientFrontendClient(c *cli.Context) clientFrontend.Interface
 	ServerFrontendClient(c *cli.Context) serverFrontend.Interface
 	ServerAdminClient(c *cli.Context) serverAdmin.Interface
+	// WorkflowAPIClient returns nil when no gRPC address of the frontend is given
+	WorkflowAPIClient(c *cli.Context) apiv1.WorkflowAPIYARPCClient
 }
 
 type clientFactory struct {
@@ -82,6 +87,15 @@ func (b *clientFactory) ServerAdminClient(c *cli.Context) serverAdmin.Interface
 	return serverAdmin.New(b.dispatcher.ClientConfig(cadenceFrontendService))
 }
 
+// WorkflowAPIClient builds a client of the proto WorkflowAPI, served on the gRPC port
+func (b *clientFactory) WorkflowAPIClient(c *cli.Context) apiv1.WorkflowAPIYARPCClient {
+	if c.GlobalString(FlagGRPCAddress) == "" {
+		return nil
+	}
+	b.ensureDispatcher(c)
+	return apiv1.NewWorkflowAPIYARPCClient(b.dispatcher.ClientConfig(cadenceFrontendGRPCOutbound))
+}
+
 func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 	if b.dispatcher != nil {
 		return
@@ -97,13 +111,22 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 		b.logger.Fatal("Failed to create transport channel", zap.Error(err))
 	}
 
+	outbounds := yarpc.Outbounds{
+		cadenceFrontendService: {Unary: ch.NewSingleOutbound(b.hostPort)},
+	}
+	if grpcAddress := c.GlobalString(FlagGRPCAddress); grpcAddress != "" {
+		outbounds[cadenceFrontendGRPCOutbound] = transport.Outbounds{
+			ServiceName: cadenceFrontendService,
+			Stream:      grpc.NewTransport().NewSingleOutbound(grpcAddress),
+		}
+	}
+
 	b.dispatcher = yarpc.NewDispatcher(yarpc.Config{
-		Name: cadenceClientName,
-		Outbounds: yarpc.Outbounds{
-			cadenceFrontendService: {Unary: ch.NewSingleOutbound(b.hostPort)},
-		},
+		Name:      cadenceClientName,
+		Outbounds: outbounds,
 		OutboundMiddleware: yarpc.OutboundMiddleware{
-			Unary: &versionMiddleware{},
+			Unary:  &versionMiddleware{},
+			Stream: &versionMiddleware{},
 		},
 	})
 
@@ -117,6 +140,15 @@ type versionMiddleware struct {
 }
 
 func (vm *versionMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
-	request.Headers = request.Headers.With(common.LibraryVersionHeaderName, "1.0.0").With(common.FeatureVersionHeaderName, "1.0.0").With(common.ClientImplHeaderName, "cli")
+	request.Headers = vm.withVersionHeaders(request.Headers)
 	return out.Call(ctx, request)
 }
+
+func (vm *versionMiddleware) CallStream(ctx context.Context, request *transport.StreamRequest, out transport.StreamOutbound) (*transport.ClientStream, error) {
+	request.Meta.Headers = vm.withVersionHeaders(request.Meta.Headers)
+	return out.CallStream(ctx, request)
+}
+
+func (vm *versionMiddleware) withVersionHeaders(headers transport.Headers) transport.Headers {
+	return headers.With(common.LibraryVersionHeaderName, "1.0.0").With(common.FeatureVersionHeaderName, "1.0.0").With(common.ClientImplHeaderName, "cli")
+}
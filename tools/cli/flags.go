@@ -28,8 +28,10 @@ const (
 	FlagUsername                    = "username"
 	FlagPassword                    = "password"
 	FlagKeyspace                    = "keyspace"
+	FlagVisibilityKeyspace          = "visibility_keyspace"
 	FlagAddress                     = "address"
 	FlagAddressWithAlias            = FlagAddress + ", ad"
+	FlagGRPCAddress                 = "grpc_address"
 	FlagHistoryAddress              = "history_address"
 	FlagHistoryAddressWithAlias     = FlagHistoryAddress + ", had"
 	FlagDomainID                    = "domain_id"
@@ -43,6 +45,9 @@ const (
 	FlagTreeID                      = "tree_id"
 	FlagBranchID                    = "branch_id"
 	FlagNumberOfShards              = "number_of_shards"
+	FlagTaskID                      = "task_id"
+	FlagTaskType                    = "task_type"
+	FlagTaskVisibilityTimestamp     = "task_timestamp"
 	FlagRunIDWithAlias              = FlagRunID + ", rid, r"
 	FlagTargetCluster               = "target_cluster"
 	FlagMinEventID                  = "min_event_id"
@@ -115,6 +120,14 @@ const (
 	FlagNameWithAlias               = FlagName + ", n"
 	FlagOutputFilename              = "output_filename"
 	FlagOutputFilenameWithAlias     = FlagOutputFilename + ", of"
+	FlagFlattenAttributes           = "flatten_attributes"
+	FlagJobID                       = "job_id"
+	FlagJobIDWithAlias              = FlagJobID + ", jid"
+	FlagBatchType                   = "batch_type"
+	FlagBatchTypeWithAlias          = FlagBatchType + ", bt"
+	FlagSignalName                  = "signal_name"
+	FlagSignalNameWithAlias         = FlagSignalName + ", sig"
+	FlagRPS                         = "rps"
 	FlagQueryType                   = "query_type"
 	FlagQueryTypeWithAlias          = FlagQueryType + ", qt"
 	FlagShowDetail                  = "show_detail"
@@ -124,6 +137,7 @@ const (
 	FlagClusters                    = "clusters"
 	FlagClustersWithAlias           = FlagClusters + ", cl"
 	FlagDomainData                  = "domain_data"
+	FlagNewDomainName               = "new_domain"
 	FlagDomainDataWithAlias         = FlagDomainData + ", dmd"
 	FlagEventID                     = "event_id"
 	FlagEventIDWithAlias            = FlagEventID + ", eid"
@@ -145,6 +159,13 @@ const (
 	FlagIndex                       = "index"
 	FlagBatchSize                   = "batch_size"
 	FlagBatchSizeWithAlias          = FlagBatchSize + ", bs"
+	FlagFormat                      = "format"
+	FlagForce                       = "force"
+	FlagSearchAttributesKey         = "search_attr_key"
+	FlagSearchAttributesType        = "search_attr_type"
+	FlagDynamicConfigKey            = "dynamic_config_key"
+	FlagDynamicConfigFilter         = "dynamic_config_filter"
+	FlagDynamicConfigValue          = "dynamic_config_value"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -193,6 +214,10 @@ func getFlagsForShowID() []cli.Flag {
 			Usage: "Maximum length for each attribute field",
 			Value: defaultMaxFieldLength,
 		},
+		cli.StringFlag{
+			Name:  FlagFormat,
+			Usage: "Output format, timeline prints the activities, timers, child workflows and decisions with their spans",
+		},
 	}
 }
 